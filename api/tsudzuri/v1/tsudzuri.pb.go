@@ -8,9 +8,11 @@ package tsudzuriv1
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -25,30 +27,5210 @@ const (
 )
 
 type Page struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	InviteCode string                 `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	Links      []*Link                `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	// source_page_id is the ID of the page this page was duplicated from, if any.
+	SourcePageId string `protobuf:"bytes,5,opt,name=source_page_id,json=sourcePageId,proto3" json:"source_page_id,omitempty"`
+	// sections hold the links that belong to a section. links only contains links outside of any section.
+	Sections []*Section `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
+	// unread_count is the number of links the caller has not read yet.
+	UnreadCount int32 `protobuf:"varint,7,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	// checklist reports whether the links have a shared done flag.
+	Checklist bool `protobuf:"varint,8,opt,name=checklist,proto3" json:"checklist,omitempty"`
+	// progress is the number of links marked done. It is only set in checklist mode.
+	Progress *ChecklistProgress `protobuf:"bytes,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	// archived reports whether the page is read-only.
+	Archived bool `protobuf:"varint,10,opt,name=archived,proto3" json:"archived,omitempty"`
+	// pinned reports whether the caller pinned the page. It is only set by ListPages.
+	Pinned bool `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// description is the Markdown source of the page description.
+	Description string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// description_html is the description rendered to sanitized HTML, safe to embed as is.
+	DescriptionHtml string `protobuf:"bytes,13,opt,name=description_html,json=descriptionHtml,proto3" json:"description_html,omitempty"`
+	// icon is a single emoji shown with the title, or empty.
+	Icon string `protobuf:"bytes,14,opt,name=icon,proto3" json:"icon,omitempty"`
+	// color is the accent color in "#rrggbb" form, or empty.
+	Color     string                 `protobuf:"bytes,15,opt,name=color,proto3" json:"color,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Creator   *PageUser              `protobuf:"bytes,18,opt,name=creator,proto3" json:"creator,omitempty"`
+	// members are the creator followed by the invited users.
+	Members []*PageUser `protobuf:"bytes,19,rep,name=members,proto3" json:"members,omitempty"`
+	// inbox reports whether the page is the caller's inbox for captured links. It is only set by ListPages.
+	Inbox bool `protobuf:"varint,20,opt,name=inbox,proto3" json:"inbox,omitempty"`
+	// slug is the current short name of the page, usable wherever a page ID is.
+	Slug          string `protobuf:"bytes,21,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Page) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Page) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Page) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *Page) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Page) GetSourcePageId() string {
+	if x != nil {
+		return x.SourcePageId
+	}
+	return ""
+}
+
+func (x *Page) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Page) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *Page) GetChecklist() bool {
+	if x != nil {
+		return x.Checklist
+	}
+	return false
+}
+
+func (x *Page) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Page) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Page) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Page) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Page) GetDescriptionHtml() string {
+	if x != nil {
+		return x.DescriptionHtml
+	}
+	return ""
+}
+
+func (x *Page) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Page) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Page) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Page) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Page) GetCreator() *PageUser {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *Page) GetMembers() []*PageUser {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Page) GetInbox() bool {
+	if x != nil {
+		return x.Inbox
+	}
+	return false
+}
+
+func (x *Page) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// PageUser is the public information of a user shown on a page. It never contains contact details.
+type PageUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageUser) Reset() {
+	*x = PageUser{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageUser) ProtoMessage() {}
+
+func (x *PageUser) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageUser.ProtoReflect.Descriptor instead.
+func (*PageUser) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PageUser) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PageUser) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PageUser) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ChecklistProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Done          int32                  `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistProgress) Reset() {
+	*x = ChecklistProgress{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistProgress) ProtoMessage() {}
+
+func (x *ChecklistProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistProgress.ProtoReflect.Descriptor instead.
+func (*ChecklistProgress) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChecklistProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *ChecklistProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Links         []*Link                `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Section) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Section) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Section) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Section) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type Link struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Url       string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Memo      string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Priority  int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Id        string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	SectionId string                 `protobuf:"bytes,5,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// reactions are the number of members per reaction, most used first.
+	Reactions []*ReactionCount `protobuf:"bytes,6,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// my_reaction is the caller's reaction, or empty if the caller has not reacted.
+	MyReaction string `protobuf:"bytes,7,opt,name=my_reaction,json=myReaction,proto3" json:"my_reaction,omitempty"`
+	// votes is the number of members who reacted to the link.
+	Votes int32 `protobuf:"varint,8,opt,name=votes,proto3" json:"votes,omitempty"`
+	// state is the caller's own status on the link: "unread", "read" or "done".
+	State string `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	// done is the shared done flag of the link in checklist mode.
+	Done bool `protobuf:"varint,10,opt,name=done,proto3" json:"done,omitempty"`
+	// done_by_user_id is the ID of the member who marked the link done. It is empty if that member was deleted.
+	DoneByUserId string                 `protobuf:"bytes,11,opt,name=done_by_user_id,json=doneByUserId,proto3" json:"done_by_user_id,omitempty"`
+	DoneAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=done_at,json=doneAt,proto3" json:"done_at,omitempty"`
+	// health is the outcome of the last background check of the url: "ok", "redirected" or "broken".
+	// It is empty if the link has not been checked yet.
+	Health string `protobuf:"bytes,13,opt,name=health,proto3" json:"health,omitempty"`
+	// health_status_code is the HTTP status of the last check, or 0 if the host could not be reached.
+	HealthStatusCode int32 `protobuf:"varint,14,opt,name=health_status_code,json=healthStatusCode,proto3" json:"health_status_code,omitempty"`
+	// redirect_url is where the url redirects to when health is "redirected".
+	RedirectUrl   string                 `protobuf:"bytes,15,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Link) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Link) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Link) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Link) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Link) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *Link) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Link) GetMyReaction() string {
+	if x != nil {
+		return x.MyReaction
+	}
+	return ""
+}
+
+func (x *Link) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Link) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Link) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Link) GetDoneByUserId() string {
+	if x != nil {
+		return x.DoneByUserId
+	}
+	return ""
+}
+
+func (x *Link) GetDoneAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoneAt
+	}
+	return nil
+}
+
+func (x *Link) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *Link) GetHealthStatusCode() int32 {
+	if x != nil {
+		return x.HealthStatusCode
+	}
+	return 0
+}
+
+func (x *Link) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *Link) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type ReactionCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// emoji is the reaction emoji, or "+1" for a plain upvote.
+	Emoji         string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreatePageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// template_id creates the page from a template. An empty title falls back to the template title.
+	TemplateId    string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePageRequest) Reset() {
+	*x = CreatePageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePageRequest) ProtoMessage() {}
+
+func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePageRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type GetPageRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// link_order is "priority" (default) for the manual order or "votes" for the most voted links first.
+	LinkOrder     string `protobuf:"bytes,2,opt,name=link_order,json=linkOrder,proto3" json:"link_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPageRequest) Reset() {
+	*x = GetPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageRequest) ProtoMessage() {}
+
+func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageRequest.ProtoReflect.Descriptor instead.
+func (*GetPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *GetPageRequest) GetLinkOrder() string {
+	if x != nil {
+		return x.LinkOrder
+	}
+	return ""
+}
+
+type ListPagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// include_archived lists archived pages as well. They are hidden by default.
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPagesRequest) Reset() {
+	*x = ListPagesRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPagesRequest) ProtoMessage() {}
+
+func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPagesRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPagesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListPagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         []*Page                `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPagesResponse) Reset() {
+	*x = ListPagesResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPagesResponse) ProtoMessage() {}
+
+func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPagesResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPagesResponse) GetPages() []*Page {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+type EditPageRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Links  []*LinkInput           `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+	// description, icon and color are left unchanged when unset. An empty value clears them.
+	Description   *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Icon          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color         *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPageRequest) Reset() {
+	*x = EditPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPageRequest) ProtoMessage() {}
+
+func (x *EditPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPageRequest.ProtoReflect.Descriptor instead.
+func (*EditPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EditPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *EditPageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EditPageRequest) GetLinks() []*LinkInput {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *EditPageRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *EditPageRequest) GetIcon() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *EditPageRequest) GetColor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+type LinkInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkInput) Reset() {
+	*x = LinkInput{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkInput) ProtoMessage() {}
+
+func (x *LinkInput) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkInput.ProtoReflect.Descriptor instead.
+func (*LinkInput) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LinkInput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkInput) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *LinkInput) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type DeletePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePageRequest) Reset() {
+	*x = DeletePageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePageRequest) ProtoMessage() {}
+
+func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeletePageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type AddLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Memo          string                 `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLinkRequest) Reset() {
+	*x = AddLinkRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLinkRequest) ProtoMessage() {}
+
+func (x *AddLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLinkRequest.ProtoReflect.Descriptor instead.
+func (*AddLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddLinkRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *AddLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddLinkRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type RemoveLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLinkRequest) Reset() {
+	*x = RemoveLinkRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLinkRequest) ProtoMessage() {}
+
+func (x *RemoveLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLinkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveLinkRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *RemoveLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BatchAddLinksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// links are appended to the end of the page in the given order.
+	Links         []*BatchAddLinksRequest_Link `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddLinksRequest) Reset() {
+	*x = BatchAddLinksRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddLinksRequest) ProtoMessage() {}
+
+func (x *BatchAddLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchAddLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchAddLinksRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *BatchAddLinksRequest) GetLinks() []*BatchAddLinksRequest_Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type BatchRemoveLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkIds       []string               `protobuf:"bytes,2,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRemoveLinksRequest) Reset() {
+	*x = BatchRemoveLinksRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRemoveLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRemoveLinksRequest) ProtoMessage() {}
+
+func (x *BatchRemoveLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRemoveLinksRequest.ProtoReflect.Descriptor instead.
+func (*BatchRemoveLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchRemoveLinksRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *BatchRemoveLinksRequest) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
+	}
+	return nil
+}
+
+type MoveLinksRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SourcePageId string                 `protobuf:"bytes,1,opt,name=source_page_id,json=sourcePageId,proto3" json:"source_page_id,omitempty"`
+	TargetPageId string                 `protobuf:"bytes,2,opt,name=target_page_id,json=targetPageId,proto3" json:"target_page_id,omitempty"`
+	LinkIds      []string               `protobuf:"bytes,3,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"`
+	// copy keeps the links on the source page.
+	Copy          bool `protobuf:"varint,4,opt,name=copy,proto3" json:"copy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveLinksRequest) Reset() {
+	*x = MoveLinksRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLinksRequest) ProtoMessage() {}
+
+func (x *MoveLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLinksRequest.ProtoReflect.Descriptor instead.
+func (*MoveLinksRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MoveLinksRequest) GetSourcePageId() string {
+	if x != nil {
+		return x.SourcePageId
+	}
+	return ""
+}
+
+func (x *MoveLinksRequest) GetTargetPageId() string {
+	if x != nil {
+		return x.TargetPageId
+	}
+	return ""
+}
+
+func (x *MoveLinksRequest) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
+	}
+	return nil
+}
+
+func (x *MoveLinksRequest) GetCopy() bool {
+	if x != nil {
+		return x.Copy
+	}
+	return false
+}
+
+type JoinPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinPageRequest) Reset() {
+	*x = JoinPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPageRequest) ProtoMessage() {}
+
+func (x *JoinPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinPageRequest.ProtoReflect.Descriptor instead.
+func (*JoinPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JoinPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *JoinPageRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type GetPageInviteQRCodeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// size is the width and height of the image in pixels, from 64 to 1024. It defaults to 256.
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// error_correction is the error correction level "L", "M", "Q" or "H", each restoring more of a damaged or covered code
+	// at the cost of a denser code. It defaults to "M".
+	ErrorCorrection string `protobuf:"bytes,3,opt,name=error_correction,json=errorCorrection,proto3" json:"error_correction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPageInviteQRCodeRequest) Reset() {
+	*x = GetPageInviteQRCodeRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageInviteQRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageInviteQRCodeRequest) ProtoMessage() {}
+
+func (x *GetPageInviteQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageInviteQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetPageInviteQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPageInviteQRCodeRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *GetPageInviteQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetPageInviteQRCodeRequest) GetErrorCorrection() string {
+	if x != nil {
+		return x.ErrorCorrection
+	}
+	return ""
+}
+
+type DuplicatePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicatePageRequest) Reset() {
+	*x = DuplicatePageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicatePageRequest) ProtoMessage() {}
+
+func (x *DuplicatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicatePageRequest.ProtoReflect.Descriptor instead.
+func (*DuplicatePageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DuplicatePageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type ExportPageRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// format is one of "markdown", "html", "json" or "opml". Defaults to "markdown".
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPageRequest) Reset() {
+	*x = ExportPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPageRequest) ProtoMessage() {}
+
+func (x *ExportPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPageRequest.ProtoReflect.Descriptor instead.
+func (*ExportPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ExportPageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type CreateFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedTokenRequest) Reset() {
+	*x = CreateFeedTokenRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedTokenRequest) ProtoMessage() {}
+
+func (x *CreateFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateFeedTokenRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type CreateFeedTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is only returned once. Issuing a new token invalidates the previous one.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AtomPath      string `protobuf:"bytes,2,opt,name=atom_path,json=atomPath,proto3" json:"atom_path,omitempty"`
+	RssPath       string `protobuf:"bytes,3,opt,name=rss_path,json=rssPath,proto3" json:"rss_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedTokenResponse) Reset() {
+	*x = CreateFeedTokenResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedTokenResponse) ProtoMessage() {}
+
+func (x *CreateFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateFeedTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateFeedTokenResponse) GetAtomPath() string {
+	if x != nil {
+		return x.AtomPath
+	}
+	return ""
+}
+
+func (x *CreateFeedTokenResponse) GetRssPath() string {
+	if x != nil {
+		return x.RssPath
+	}
+	return ""
+}
+
+type RevokeFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeFeedTokenRequest) Reset() {
+	*x = RevokeFeedTokenRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeFeedTokenRequest) ProtoMessage() {}
+
+func (x *RevokeFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeFeedTokenRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type CreateSectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSectionRequest) Reset() {
+	*x = CreateSectionRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSectionRequest) ProtoMessage() {}
+
+func (x *CreateSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSectionRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateSectionRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *CreateSectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameSectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	SectionId     string                 `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameSectionRequest) Reset() {
+	*x = RenameSectionRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSectionRequest) ProtoMessage() {}
+
+func (x *RenameSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameSectionRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameSectionRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *RenameSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *RenameSectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReorderSectionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// section_ids must list every section of the page exactly once.
+	SectionIds    []string `protobuf:"bytes,2,rep,name=section_ids,json=sectionIds,proto3" json:"section_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSectionsRequest) Reset() {
+	*x = ReorderSectionsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSectionsRequest) ProtoMessage() {}
+
+func (x *ReorderSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSectionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReorderSectionsRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ReorderSectionsRequest) GetSectionIds() []string {
+	if x != nil {
+		return x.SectionIds
+	}
+	return nil
+}
+
+type DeleteSectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	SectionId     string                 `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSectionRequest) Reset() {
+	*x = DeleteSectionRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSectionRequest) ProtoMessage() {}
+
+func (x *DeleteSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSectionRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteSectionRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *DeleteSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type MoveLinksToSectionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PageId  string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkIds []string               `protobuf:"bytes,2,rep,name=link_ids,json=linkIds,proto3" json:"link_ids,omitempty"`
+	// section_id is the destination section. An empty value moves the links out of any section.
+	SectionId     string `protobuf:"bytes,3,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveLinksToSectionRequest) Reset() {
+	*x = MoveLinksToSectionRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLinksToSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLinksToSectionRequest) ProtoMessage() {}
+
+func (x *MoveLinksToSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLinksToSectionRequest.ProtoReflect.Descriptor instead.
+func (*MoveLinksToSectionRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MoveLinksToSectionRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *MoveLinksToSectionRequest) GetLinkIds() []string {
+	if x != nil {
+		return x.LinkIds
+	}
+	return nil
+}
+
+func (x *MoveLinksToSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type ReactToLinkRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// emoji is the reaction. An empty value is a plain upvote.
+	Emoji         string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactToLinkRequest) Reset() {
+	*x = ReactToLinkRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactToLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactToLinkRequest) ProtoMessage() {}
+
+func (x *ReactToLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactToLinkRequest.ProtoReflect.Descriptor instead.
+func (*ReactToLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReactToLinkRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ReactToLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *ReactToLinkRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveLinkReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLinkReactionRequest) Reset() {
+	*x = RemoveLinkReactionRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLinkReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLinkReactionRequest) ProtoMessage() {}
+
+func (x *RemoveLinkReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLinkReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLinkReactionRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveLinkReactionRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *RemoveLinkReactionRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type MarkLinkRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// state is "unread", "read" or "done".
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkLinkRequest) Reset() {
+	*x = MarkLinkRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkLinkRequest) ProtoMessage() {}
+
+func (x *MarkLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkLinkRequest.ProtoReflect.Descriptor instead.
+func (*MarkLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MarkLinkRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *MarkLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *MarkLinkRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type MarkAllLinksReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllLinksReadRequest) Reset() {
+	*x = MarkAllLinksReadRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllLinksReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllLinksReadRequest) ProtoMessage() {}
+
+func (x *MarkAllLinksReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllLinksReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllLinksReadRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MarkAllLinksReadRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type SetChecklistModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChecklistModeRequest) Reset() {
+	*x = SetChecklistModeRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChecklistModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChecklistModeRequest) ProtoMessage() {}
+
+func (x *SetChecklistModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChecklistModeRequest.ProtoReflect.Descriptor instead.
+func (*SetChecklistModeRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetChecklistModeRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *SetChecklistModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ToggleLinkDoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLinkDoneRequest) Reset() {
+	*x = ToggleLinkDoneRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLinkDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLinkDoneRequest) ProtoMessage() {}
+
+func (x *ToggleLinkDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLinkDoneRequest.ProtoReflect.Descriptor instead.
+func (*ToggleLinkDoneRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ToggleLinkDoneRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ToggleLinkDoneRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type AcceptLinkRedirectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptLinkRedirectRequest) Reset() {
+	*x = AcceptLinkRedirectRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptLinkRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptLinkRedirectRequest) ProtoMessage() {}
+
+func (x *AcceptLinkRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptLinkRedirectRequest.ProtoReflect.Descriptor instead.
+func (*AcceptLinkRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AcceptLinkRedirectRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *AcceptLinkRedirectRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type ArchivePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePageRequest) Reset() {
+	*x = ArchivePageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePageRequest) ProtoMessage() {}
+
+func (x *ArchivePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePageRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ArchivePageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type UnarchivePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchivePageRequest) Reset() {
+	*x = UnarchivePageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchivePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchivePageRequest) ProtoMessage() {}
+
+func (x *UnarchivePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchivePageRequest.ProtoReflect.Descriptor instead.
+func (*UnarchivePageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnarchivePageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type PinPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPageRequest) Reset() {
+	*x = PinPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPageRequest) ProtoMessage() {}
+
+func (x *PinPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPageRequest.ProtoReflect.Descriptor instead.
+func (*PinPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PinPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type UnpinPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinPageRequest) Reset() {
+	*x = UnpinPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPageRequest) ProtoMessage() {}
+
+func (x *UnpinPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnpinPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type ReorderMyPagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_ids is the caller's own order of pages. Pages not listed follow in the default order.
+	PageIds       []string `protobuf:"bytes,1,rep,name=page_ids,json=pageIds,proto3" json:"page_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderMyPagesRequest) Reset() {
+	*x = ReorderMyPagesRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderMyPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderMyPagesRequest) ProtoMessage() {}
+
+func (x *ReorderMyPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderMyPagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderMyPagesRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReorderMyPagesRequest) GetPageIds() []string {
+	if x != nil {
+		return x.PageIds
+	}
+	return nil
+}
+
+type SetInboxPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInboxPageRequest) Reset() {
+	*x = SetInboxPageRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInboxPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInboxPageRequest) ProtoMessage() {}
+
+func (x *SetInboxPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInboxPageRequest.ProtoReflect.Descriptor instead.
+func (*SetInboxPageRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetInboxPageRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type CaptureLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// title is the title of the captured web page. It becomes the memo together with text.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the text selected when capturing.
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureLinkRequest) Reset() {
+	*x = CaptureLinkRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureLinkRequest) ProtoMessage() {}
+
+func (x *CaptureLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureLinkRequest.ProtoReflect.Descriptor instead.
+func (*CaptureLinkRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CaptureLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CaptureLinkRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CaptureLinkRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CaptureLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_id is the ID of the inbox page.
+	PageId string `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// added is false when the URL was captured recently and nothing was added.
+	Added         bool `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureLinkResponse) Reset() {
+	*x = CaptureLinkResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureLinkResponse) ProtoMessage() {}
+
+func (x *CaptureLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureLinkResponse.ProtoReflect.Descriptor instead.
+func (*CaptureLinkResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CaptureLinkResponse) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *CaptureLinkResponse) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+type SetPageSlugRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// slug is 3 to 30 lowercase letters and digits, in words joined by single hyphens. It is matched without case.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPageSlugRequest) Reset() {
+	*x = SetPageSlugRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPageSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPageSlugRequest) ProtoMessage() {}
+
+func (x *SetPageSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPageSlugRequest.ProtoReflect.Descriptor instead.
+func (*SetPageSlugRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetPageSlugRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *SetPageSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ResolveSlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSlugRequest) Reset() {
+	*x = ResolveSlugRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSlugRequest) ProtoMessage() {}
+
+func (x *ResolveSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSlugRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSlugRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResolveSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ResolveSlugResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// slug is the current slug of the page, which differs from the requested one after a rename.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSlugResponse) Reset() {
+	*x = ResolveSlugResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSlugResponse) ProtoMessage() {}
+
+func (x *ResolveSlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSlugResponse.ProtoReflect.Descriptor instead.
+func (*ResolveSlugResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResolveSlugResponse) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ResolveSlugResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type Template struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// visibility is either "private" or "shared".
+	Visibility string  `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Links      []*Link `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	// owned reports whether the caller saved the template.
+	Owned         bool `protobuf:"varint,5,opt,name=owned,proto3" json:"owned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Template) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Template) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Template) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+type SaveTemplateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// title defaults to the page title.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// visibility is either "private" (only the caller) or "shared" (also the current members of the page).
+	Visibility    string `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTemplateRequest) Reset() {
+	*x = SaveTemplateRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTemplateRequest) ProtoMessage() {}
+
+func (x *SaveTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SaveTemplateRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *SaveTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SaveTemplateRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{50}
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type Comment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkId string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// parent_id is the first comment of the thread. It is empty for the first comment itself.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorId string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// body is empty once the comment is deleted.
+	Body      string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Deleted   bool                   `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// owned reports whether the caller wrote the comment and can edit or delete it.
+	Owned bool `protobuf:"varint,9,opt,name=owned,proto3" json:"owned,omitempty"`
+	// replies are the rest of the thread, oldest first. Only set on the first comment of a thread.
+	Replies       []*Comment `protobuf:"bytes,10,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type AddCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// parent_id replies in the thread of the given comment.
+	ParentId      string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddCommentRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCommentsRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// comments are the threads on the link, oldest first.
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EditCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type LinkSnapshot struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkId string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	// url is the url of the link when the snapshot was taken.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// size is the size of the archived HTML in bytes.
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// created_by_user_id is the member who requested the snapshot. It is empty if that member was deleted.
+	CreatedByUserId string                 `protobuf:"bytes,5,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LinkSnapshot) Reset() {
+	*x = LinkSnapshot{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSnapshot) ProtoMessage() {}
+
+func (x *LinkSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSnapshot.ProtoReflect.Descriptor instead.
+func (*LinkSnapshot) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LinkSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LinkSnapshot) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *LinkSnapshot) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkSnapshot) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *LinkSnapshot) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *LinkSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateLinkSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLinkSnapshotRequest) Reset() {
+	*x = CreateLinkSnapshotRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLinkSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateLinkSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateLinkSnapshotRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *CreateLinkSnapshotRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type ListLinkSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkSnapshotsRequest) Reset() {
+	*x = ListLinkSnapshotsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListLinkSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListLinkSnapshotsRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *ListLinkSnapshotsRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type ListLinkSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshots are the snapshots of the link, newest first.
+	Snapshots     []*LinkSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkSnapshotsResponse) Reset() {
+	*x = ListLinkSnapshotsResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListLinkSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLinkSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListLinkSnapshotsResponse) GetSnapshots() []*LinkSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type GetLinkSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	SnapshotId    string                 `protobuf:"bytes,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkSnapshotRequest) Reset() {
+	*x = GetLinkSnapshotRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkSnapshotRequest) ProtoMessage() {}
+
+func (x *GetLinkSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetLinkSnapshotRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *GetLinkSnapshotRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *GetLinkSnapshotRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type GetLinkSnapshotResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot *LinkSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// html is the archived page as UTF-8 HTML without scripts. Relative URLs resolve against the original page.
+	Html          string `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkSnapshotResponse) Reset() {
+	*x = GetLinkSnapshotResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkSnapshotResponse) ProtoMessage() {}
+
+func (x *GetLinkSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetLinkSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLinkSnapshotResponse) GetSnapshot() *LinkSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GetLinkSnapshotResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+type GetPageStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPageStatsRequest) Reset() {
+	*x = GetPageStatsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageStatsRequest) ProtoMessage() {}
+
+func (x *GetPageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetPageStatsRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type LinkStats struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LinkId string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Url    string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// clicks_last_day is the number of clicks in the 24 hours before generated_at.
+	ClicksLastDay int64 `protobuf:"varint,3,opt,name=clicks_last_day,json=clicksLastDay,proto3" json:"clicks_last_day,omitempty"`
+	// clicks_last_week is the number of clicks in the 7 days before generated_at.
+	ClicksLastWeek int64 `protobuf:"varint,4,opt,name=clicks_last_week,json=clicksLastWeek,proto3" json:"clicks_last_week,omitempty"`
+	// clicks_last_month is the number of clicks in the 30 days before generated_at.
+	ClicksLastMonth int64 `protobuf:"varint,5,opt,name=clicks_last_month,json=clicksLastMonth,proto3" json:"clicks_last_month,omitempty"`
+	ClicksTotal     int64 `protobuf:"varint,6,opt,name=clicks_total,json=clicksTotal,proto3" json:"clicks_total,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LinkStats) Reset() {
+	*x = LinkStats{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkStats) ProtoMessage() {}
+
+func (x *LinkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkStats.ProtoReflect.Descriptor instead.
+func (*LinkStats) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LinkStats) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *LinkStats) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkStats) GetClicksLastDay() int64 {
+	if x != nil {
+		return x.ClicksLastDay
+	}
+	return 0
+}
+
+func (x *LinkStats) GetClicksLastWeek() int64 {
+	if x != nil {
+		return x.ClicksLastWeek
+	}
+	return 0
+}
+
+func (x *LinkStats) GetClicksLastMonth() int64 {
+	if x != nil {
+		return x.ClicksLastMonth
+	}
+	return 0
+}
+
+func (x *LinkStats) GetClicksTotal() int64 {
+	if x != nil {
+		return x.ClicksTotal
+	}
+	return 0
+}
+
+type PageStats struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PageId string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// links are the stats of every link of the page in page order, including links without clicks.
+	Links         []*LinkStats           `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageStats) Reset() {
+	*x = PageStats{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageStats) ProtoMessage() {}
+
+func (x *PageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageStats.ProtoReflect.Descriptor instead.
+func (*PageStats) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PageStats) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *PageStats) GetLinks() []*LinkStats {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *PageStats) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type Invitation struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageId string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Email  string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// status is one of "pending", "accepted", "revoked" or "expired".
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// accepted_by_id is the user who accepted the invitation. It is empty until then.
+	AcceptedById  string `protobuf:"bytes,7,opt,name=accepted_by_id,json=acceptedById,proto3" json:"accepted_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetAcceptedById() string {
+	if x != nil {
+		return x.AcceptedById
+	}
+	return ""
+}
+
+type CreateInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateInvitationRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListInvitationsRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// invitations are the invitations of the page, newest first.
+	Invitations   []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	InvitationId  string                 `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RevokeInvitationRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type AcceptInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the signed token from the invite link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_id is the page the caller joined.
+	PageId        string `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AcceptInvitationResponse) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kind is one of "member_joined", "links_added" or "page_archived".
+	Kind   string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	PageId string `protobuf:"bytes,3,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// actor_id is the user whose action caused the notification.
+	ActorId string `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// count is the number of links added for "links_added" and 1 otherwise.
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Read          bool                   `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Notification) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *Notification) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Notification) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadOnly    bool                   `protobuf:"varint,1,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notifications are the notifications of the user, newest first.
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int32           `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ids are the notifications to mark as read. An empty list marks all of them.
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type UnreadNotificationCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadNotificationCount) Reset() {
+	*x = UnreadNotificationCount{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadNotificationCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadNotificationCount) ProtoMessage() {}
+
+func (x *UnreadNotificationCount) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadNotificationCount.ProtoReflect.Descriptor instead.
+func (*UnreadNotificationCount) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UnreadNotificationCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *NotificationPreference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// preferences hold one entry per notification kind.
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NotificationPreferences) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// preferences are the kinds to change. Kinds that are not listed keep their setting.
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type Device struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// platform is one of "ios", "android" or "web".
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Device) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RegisterDeviceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the registration token FCM issued to the app on the device.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// platform is one of "ios", "android" or "web".
+	Platform      string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RegisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+type UnregisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceRequest) Reset() {
+	*x = UnregisterDeviceRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceRequest) ProtoMessage() {}
+
+func (x *UnregisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UnregisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Webhook struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageId string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Url    string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// events are the event types the webhook is called for: "link.added", "link.removed", "link.edited",
+	// "member.joined" or "page.deleted". An empty list means all of them.
+	Events []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// active is false once the webhook was disabled by hand or after repeated failed deliveries.
+	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// failure_count is the number of deliveries that failed in a row.
+	FailureCount  int32                  `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	DisabledAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *Webhook) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateWebhookRequest) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Webhook *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// secret signs the payloads in the X-Tsudzuri-Signature header. It is only returned here.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	InviteCode    string                 `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
-	Links         []*Link                `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Page) Reset() {
-	*x = Page{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[0]
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Page) String() string {
+func (x *ListWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Page) ProtoMessage() {}
+func (*ListWebhooksRequest) ProtoMessage() {}
 
-func (x *Page) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[0]
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,63 +5241,90 @@ func (x *Page) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Page.ProtoReflect.Descriptor instead.
-func (*Page) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *Page) GetId() string {
+func (x *ListWebhooksRequest) GetPageId() string {
 	if x != nil {
-		return x.Id
+		return x.PageId
 	}
 	return ""
 }
 
-func (x *Page) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type ListWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhooks are the webhooks of the page, oldest first.
+	Webhooks      []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Page) GetInviteCode() string {
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[89]
 	if x != nil {
-		return x.InviteCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Page) GetLinks() []*Link {
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.Links
+		return x.Webhooks
 	}
 	return nil
 }
 
-type Link struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
-	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+type UpdateWebhookRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageId    string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WebhookId string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url       string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Events    []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// active enables or disables the webhook. Enabling it clears its failures.
+	Active        bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Link) Reset() {
-	*x = Link{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[1]
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Link) String() string {
+func (x *UpdateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Link) ProtoMessage() {}
+func (*UpdateWebhookRequest) ProtoMessage() {}
 
-func (x *Link) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[1]
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,54 +5335,69 @@ func (x *Link) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Link.ProtoReflect.Descriptor instead.
-func (*Link) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Link) GetUrl() string {
+func (x *UpdateWebhookRequest) GetPageId() string {
 	if x != nil {
-		return x.Url
+		return x.PageId
 	}
 	return ""
 }
 
-func (x *Link) GetMemo() string {
+func (x *UpdateWebhookRequest) GetWebhookId() string {
 	if x != nil {
-		return x.Memo
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *Link) GetPriority() int32 {
+func (x *UpdateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Priority
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type CreatePageRequest struct {
+func (x *UpdateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePageRequest) Reset() {
-	*x = CreatePageRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[2]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePageRequest) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePageRequest) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[2]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,40 +5408,48 @@ func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePageRequest.ProtoReflect.Descriptor instead.
-func (*CreatePageRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CreatePageRequest) GetTitle() string {
+func (x *DeleteWebhookRequest) GetPageId() string {
 	if x != nil {
-		return x.Title
+		return x.PageId
 	}
 	return ""
 }
 
-type GetPageRequest struct {
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPageRequest) Reset() {
-	*x = GetPageRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[3]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPageRequest) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPageRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[3]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,39 +5460,57 @@ func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPageRequest.ProtoReflect.Descriptor instead.
-func (*GetPageRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetPageRequest) GetPageId() string {
+func (x *ListWebhookDeliveriesRequest) GetPageId() string {
 	if x != nil {
 		return x.PageId
 	}
 	return ""
 }
 
-type ListPagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// event_id is shared by the retries of the same event.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event   string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Attempt int32  `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// status_code is the HTTP status of the response, or 0 if none was received.
+	StatusCode    int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Succeeded     bool                   `protobuf:"varint,7,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPagesRequest) Reset() {
-	*x = ListPagesRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[4]
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPagesRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPagesRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[4]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,33 +5521,97 @@ func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPagesRequest.ProtoReflect.Descriptor instead.
-func (*ListPagesRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{93}
 }
 
-type ListPagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pages         []*Page                `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deliveries are the recent delivery attempts, newest first.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPagesResponse) Reset() {
-	*x = ListPagesResponse{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[5]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPagesResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPagesResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[5]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,42 +5622,53 @@ func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPagesResponse.ProtoReflect.Descriptor instead.
-func (*ListPagesResponse) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ListPagesResponse) GetPages() []*Page {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Pages
+		return x.Deliveries
 	}
 	return nil
 }
 
-type EditPageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Links         []*LinkInput           `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+type AccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// hint is the start of the token, which helps recognize it.
+	Hint string `protobuf:"bytes,3,opt,name=hint,proto3" json:"hint,omitempty"`
+	// scopes are "read" and "write". Write implies read.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// page_ids restrict the token to the pages. Empty means every page of the user.
+	PageIds []string `protobuf:"bytes,5,rep,name=page_ids,json=pageIds,proto3" json:"page_ids,omitempty"`
+	// active is false once the token is revoked or expired.
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditPageRequest) Reset() {
-	*x = EditPageRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[6]
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditPageRequest) String() string {
+func (x *AccessToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditPageRequest) ProtoMessage() {}
+func (*AccessToken) ProtoMessage() {}
 
-func (x *EditPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[6]
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,114 +5679,109 @@ func (x *EditPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditPageRequest.ProtoReflect.Descriptor instead.
-func (*EditPageRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *EditPageRequest) GetPageId() string {
+func (x *AccessToken) GetId() string {
 	if x != nil {
-		return x.PageId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *EditPageRequest) GetTitle() string {
+func (x *AccessToken) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EditPageRequest) GetLinks() []*LinkInput {
+func (x *AccessToken) GetHint() string {
 	if x != nil {
-		return x.Links
+		return x.Hint
 	}
-	return nil
-}
-
-type LinkInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
-	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *LinkInput) Reset() {
-	*x = LinkInput{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-func (x *LinkInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessToken) GetPageIds() []string {
+	if x != nil {
+		return x.PageIds
+	}
+	return nil
 }
 
-func (*LinkInput) ProtoMessage() {}
-
-func (x *LinkInput) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[7]
+func (x *AccessToken) GetActive() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Active
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use LinkInput.ProtoReflect.Descriptor instead.
-func (*LinkInput) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{7}
+func (x *AccessToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *LinkInput) GetUrl() string {
+func (x *AccessToken) GetLastUsedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Url
+		return x.LastUsedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *LinkInput) GetMemo() string {
+func (x *AccessToken) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Memo
+		return x.RevokedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *LinkInput) GetPriority() int32 {
+func (x *AccessToken) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Priority
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type DeletePageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+type CreateAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// scopes are "read" and "write". Empty scopes allow both.
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// page_ids restrict the token to the pages. Empty allows every page of the user.
+	PageIds []string `protobuf:"bytes,3,rep,name=page_ids,json=pageIds,proto3" json:"page_ids,omitempty"`
+	// expires_at is when the token stops working. Unset keeps it working until it is revoked.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePageRequest) Reset() {
-	*x = DeletePageRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[8]
+func (x *CreateAccessTokenRequest) Reset() {
+	*x = CreateAccessTokenRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePageRequest) String() string {
+func (x *CreateAccessTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePageRequest) ProtoMessage() {}
+func (*CreateAccessTokenRequest) ProtoMessage() {}
 
-func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[8]
+func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,42 +5792,63 @@ func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePageRequest.ProtoReflect.Descriptor instead.
-func (*DeletePageRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *DeletePageRequest) GetPageId() string {
+func (x *CreateAccessTokenRequest) GetName() string {
 	if x != nil {
-		return x.PageId
+		return x.Name
 	}
 	return ""
 }
 
-type AddLinkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Memo          string                 `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+func (x *CreateAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) GetPageIds() []string {
+	if x != nil {
+		return x.PageIds
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAccessTokenResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken *AccessToken           `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// token is sent as "Authorization: Bearer <token>". It is not shown again.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddLinkRequest) Reset() {
-	*x = AddLinkRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[9]
+func (x *CreateAccessTokenResponse) Reset() {
+	*x = CreateAccessTokenResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddLinkRequest) String() string {
+func (x *CreateAccessTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLinkRequest) ProtoMessage() {}
+func (*CreateAccessTokenResponse) ProtoMessage() {}
 
-func (x *AddLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[9]
+func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,55 +5859,48 @@ func (x *AddLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLinkRequest.ProtoReflect.Descriptor instead.
-func (*AddLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *AddLinkRequest) GetPageId() string {
-	if x != nil {
-		return x.PageId
-	}
-	return ""
+// Deprecated: Use CreateAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *AddLinkRequest) GetUrl() string {
+func (x *CreateAccessTokenResponse) GetAccessToken() *AccessToken {
 	if x != nil {
-		return x.Url
+		return x.AccessToken
 	}
-	return ""
+	return nil
 }
 
-func (x *AddLinkRequest) GetMemo() string {
+func (x *CreateAccessTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Memo
+		return x.Token
 	}
 	return ""
 }
 
-type RemoveLinkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+type ListAccessTokensResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// access_tokens are newest first, including revoked and expired ones.
+	AccessTokens  []*AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveLinkRequest) Reset() {
-	*x = RemoveLinkRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[10]
+func (x *ListAccessTokensResponse) Reset() {
+	*x = ListAccessTokensResponse{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveLinkRequest) String() string {
+func (x *ListAccessTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLinkRequest) ProtoMessage() {}
+func (*ListAccessTokensResponse) ProtoMessage() {}
 
-func (x *RemoveLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[10]
+func (x *ListAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,48 +5911,40 @@ func (x *RemoveLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLinkRequest.ProtoReflect.Descriptor instead.
-func (*RemoveLinkRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *RemoveLinkRequest) GetPageId() string {
-	if x != nil {
-		return x.PageId
-	}
-	return ""
+// Deprecated: Use ListAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RemoveLinkRequest) GetUrl() string {
+func (x *ListAccessTokensResponse) GetAccessTokens() []*AccessToken {
 	if x != nil {
-		return x.Url
+		return x.AccessTokens
 	}
-	return ""
+	return nil
 }
 
-type JoinPageRequest struct {
+type RevokeAccessTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
-	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	AccessTokenId string                 `protobuf:"bytes,1,opt,name=access_token_id,json=accessTokenId,proto3" json:"access_token_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinPageRequest) Reset() {
-	*x = JoinPageRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[11]
+func (x *RevokeAccessTokenRequest) Reset() {
+	*x = RevokeAccessTokenRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinPageRequest) String() string {
+func (x *RevokeAccessTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinPageRequest) ProtoMessage() {}
+func (*RevokeAccessTokenRequest) ProtoMessage() {}
 
-func (x *JoinPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[11]
+func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,21 +5955,14 @@ func (x *JoinPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinPageRequest.ProtoReflect.Descriptor instead.
-func (*JoinPageRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *JoinPageRequest) GetPageId() string {
-	if x != nil {
-		return x.PageId
-	}
-	return ""
+// Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *JoinPageRequest) GetInviteCode() string {
+func (x *RevokeAccessTokenRequest) GetAccessTokenId() string {
 	if x != nil {
-		return x.InviteCode
+		return x.AccessTokenId
 	}
 	return ""
 }
@@ -655,13 +5974,17 @@ type User struct {
 	Provider      string                  `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
 	Email         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	JoinedPageIds []string                `protobuf:"bytes,5,rep,name=joined_page_ids,json=joinedPageIds,proto3" json:"joined_page_ids,omitempty"`
+	DisplayName   string                  `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                  `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// locale is the preferred locale as a BCP 47 tag, e.g. "ja-JP".
+	Locale        string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[12]
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +5996,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[12]
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +6009,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{12}
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *User) GetId() string {
@@ -724,6 +6047,87 @@ func (x *User) GetJoinedPageIds() []string {
 	return nil
 }
 
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type LoginRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Provider      string                  `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
@@ -734,7 +6138,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[13]
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -746,7 +6150,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[13]
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +6163,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{13}
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *LoginRequest) GetProvider() string {
@@ -776,32 +6180,146 @@ func (x *LoginRequest) GetEmail() *wrapperspb.StringValue {
 	return nil
 }
 
+type BatchAddLinksRequest_Link struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddLinksRequest_Link) Reset() {
+	*x = BatchAddLinksRequest_Link{}
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddLinksRequest_Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddLinksRequest_Link) ProtoMessage() {}
+
+func (x *BatchAddLinksRequest_Link) ProtoReflect() protoreflect.Message {
+	mi := &file_tsudzuri_v1_tsudzuri_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddLinksRequest_Link.ProtoReflect.Descriptor instead.
+func (*BatchAddLinksRequest_Link) Descriptor() ([]byte, []int) {
+	return file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *BatchAddLinksRequest_Link) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BatchAddLinksRequest_Link) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
 var File_tsudzuri_v1_tsudzuri_proto protoreflect.FileDescriptor
 
 const file_tsudzuri_v1_tsudzuri_proto_rawDesc = "" +
 	"\n" +
-	"\x1atsudzuri/v1/tsudzuri.proto\x12\vtsudzuri.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"v\n" +
+	"\x1atsudzuri/v1/tsudzuri.proto\x12\vtsudzuri.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf8\x05\n" +
 	"\x04Page\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
 	"\vinvite_code\x18\x03 \x01(\tR\n" +
 	"inviteCode\x12'\n" +
-	"\x05links\x18\x04 \x03(\v2\x11.tsudzuri.v1.LinkR\x05links\"H\n" +
+	"\x05links\x18\x04 \x03(\v2\x11.tsudzuri.v1.LinkR\x05links\x12$\n" +
+	"\x0esource_page_id\x18\x05 \x01(\tR\fsourcePageId\x120\n" +
+	"\bsections\x18\x06 \x03(\v2\x14.tsudzuri.v1.SectionR\bsections\x12!\n" +
+	"\funread_count\x18\a \x01(\x05R\vunreadCount\x12\x1c\n" +
+	"\tchecklist\x18\b \x01(\bR\tchecklist\x12:\n" +
+	"\bprogress\x18\t \x01(\v2\x1e.tsudzuri.v1.ChecklistProgressR\bprogress\x12\x1a\n" +
+	"\barchived\x18\n" +
+	" \x01(\bR\barchived\x12\x16\n" +
+	"\x06pinned\x18\v \x01(\bR\x06pinned\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12)\n" +
+	"\x10description_html\x18\r \x01(\tR\x0fdescriptionHtml\x12\x12\n" +
+	"\x04icon\x18\x0e \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x0f \x01(\tR\x05color\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\acreator\x18\x12 \x01(\v2\x15.tsudzuri.v1.PageUserR\acreator\x12/\n" +
+	"\amembers\x18\x13 \x03(\v2\x15.tsudzuri.v1.PageUserR\amembers\x12\x14\n" +
+	"\x05inbox\x18\x14 \x01(\bR\x05inbox\x12\x12\n" +
+	"\x04slug\x18\x15 \x01(\tR\x04slug\"x\n" +
+	"\bPageUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"=\n" +
+	"\x11ChecklistProgress\x12\x12\n" +
+	"\x04done\x18\x01 \x01(\x05R\x04done\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"r\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12'\n" +
+	"\x05links\x18\x04 \x03(\v2\x11.tsudzuri.v1.LinkR\x05links\"\x92\x04\n" +
 	"\x04Link\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
 	"\x04memo\x18\x02 \x01(\tR\x04memo\x12\x1a\n" +
-	"\bpriority\x18\x03 \x01(\x05R\bpriority\")\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x05 \x01(\tR\tsectionId\x128\n" +
+	"\treactions\x18\x06 \x03(\v2\x1a.tsudzuri.v1.ReactionCountR\treactions\x12\x1f\n" +
+	"\vmy_reaction\x18\a \x01(\tR\n" +
+	"myReaction\x12\x14\n" +
+	"\x05votes\x18\b \x01(\x05R\x05votes\x12\x14\n" +
+	"\x05state\x18\t \x01(\tR\x05state\x12\x12\n" +
+	"\x04done\x18\n" +
+	" \x01(\bR\x04done\x12%\n" +
+	"\x0fdone_by_user_id\x18\v \x01(\tR\fdoneByUserId\x123\n" +
+	"\adone_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06doneAt\x12\x16\n" +
+	"\x06health\x18\r \x01(\tR\x06health\x12,\n" +
+	"\x12health_status_code\x18\x0e \x01(\x05R\x10healthStatusCode\x12!\n" +
+	"\fredirect_url\x18\x0f \x01(\tR\vredirectUrl\x129\n" +
+	"\n" +
+	"checked_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\";\n" +
+	"\rReactionCount\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"J\n" +
 	"\x11CreatePageRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\")\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"H\n" +
 	"\x0eGetPageRequest\x12\x17\n" +
-	"\apage_id\x18\x01 \x01(\tR\x06pageId\"\x12\n" +
-	"\x10ListPagesRequest\"<\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"link_order\x18\x02 \x01(\tR\tlinkOrder\"=\n" +
+	"\x10ListPagesRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"<\n" +
 	"\x11ListPagesResponse\x12'\n" +
-	"\x05pages\x18\x01 \x03(\v2\x11.tsudzuri.v1.PageR\x05pages\"n\n" +
+	"\x05pages\x18\x01 \x03(\v2\x11.tsudzuri.v1.PageR\x05pages\"\x94\x02\n" +
 	"\x0fEditPageRequest\x12\x17\n" +
 	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12,\n" +
-	"\x05links\x18\x03 \x03(\v2\x16.tsudzuri.v1.LinkInputR\x05links\"M\n" +
+	"\x05links\x18\x03 \x03(\v2\x16.tsudzuri.v1.LinkInputR\x05links\x12>\n" +
+	"\vdescription\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x120\n" +
+	"\x04icon\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x04icon\x122\n" +
+	"\x05color\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\"M\n" +
 	"\tLinkInput\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
 	"\x04memo\x18\x02 \x01(\tR\x04memo\x12\x1a\n" +
@@ -814,20 +6332,364 @@ const file_tsudzuri_v1_tsudzuri_proto_rawDesc = "" +
 	"\x04memo\x18\x03 \x01(\tR\x04memo\">\n" +
 	"\x11RemoveLinkRequest\x12\x17\n" +
 	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"K\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x9b\x01\n" +
+	"\x14BatchAddLinksRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12<\n" +
+	"\x05links\x18\x02 \x03(\v2&.tsudzuri.v1.BatchAddLinksRequest.LinkR\x05links\x1a,\n" +
+	"\x04Link\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04memo\x18\x02 \x01(\tR\x04memo\"M\n" +
+	"\x17BatchRemoveLinksRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x19\n" +
+	"\blink_ids\x18\x02 \x03(\tR\alinkIds\"\x8d\x01\n" +
+	"\x10MoveLinksRequest\x12$\n" +
+	"\x0esource_page_id\x18\x01 \x01(\tR\fsourcePageId\x12$\n" +
+	"\x0etarget_page_id\x18\x02 \x01(\tR\ftargetPageId\x12\x19\n" +
+	"\blink_ids\x18\x03 \x03(\tR\alinkIds\x12\x12\n" +
+	"\x04copy\x18\x04 \x01(\bR\x04copy\"K\n" +
 	"\x0fJoinPageRequest\x12\x17\n" +
 	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1f\n" +
 	"\vinvite_code\x18\x02 \x01(\tR\n" +
-	"inviteCode\"\xa0\x01\n" +
+	"inviteCode\"t\n" +
+	"\x1aGetPageInviteQRCodeRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12)\n" +
+	"\x10error_correction\x18\x03 \x01(\tR\x0ferrorCorrection\"/\n" +
+	"\x14DuplicatePageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"D\n" +
+	"\x11ExportPageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"1\n" +
+	"\x16CreateFeedTokenRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"g\n" +
+	"\x17CreateFeedTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tatom_path\x18\x02 \x01(\tR\batomPath\x12\x19\n" +
+	"\brss_path\x18\x03 \x01(\tR\arssPath\"1\n" +
+	"\x16RevokeFeedTokenRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"C\n" +
+	"\x14CreateSectionRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"b\n" +
+	"\x14RenameSectionRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"R\n" +
+	"\x16ReorderSectionsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1f\n" +
+	"\vsection_ids\x18\x02 \x03(\tR\n" +
+	"sectionIds\"N\n" +
+	"\x14DeleteSectionRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\"n\n" +
+	"\x19MoveLinksToSectionRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x19\n" +
+	"\blink_ids\x18\x02 \x03(\tR\alinkIds\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x03 \x01(\tR\tsectionId\"\\\n" +
+	"\x12ReactToLinkRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\"M\n" +
+	"\x19RemoveLinkReactionRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"Y\n" +
+	"\x0fMarkLinkRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"2\n" +
+	"\x17MarkAllLinksReadRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"L\n" +
+	"\x17SetChecklistModeRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"I\n" +
+	"\x15ToggleLinkDoneRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"M\n" +
+	"\x19AcceptLinkRedirectRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"-\n" +
+	"\x12ArchivePageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"/\n" +
+	"\x14UnarchivePageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\")\n" +
+	"\x0ePinPageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"+\n" +
+	"\x10UnpinPageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"2\n" +
+	"\x15ReorderMyPagesRequest\x12\x19\n" +
+	"\bpage_ids\x18\x01 \x03(\tR\apageIds\".\n" +
+	"\x13SetInboxPageRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"P\n" +
+	"\x12CaptureLinkRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"D\n" +
+	"\x13CaptureLinkResponse\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\bR\x05added\"A\n" +
+	"\x12SetPageSlugRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"(\n" +
+	"\x12ResolveSlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"B\n" +
+	"\x13ResolveSlugResponse\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"\x8f\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tR\n" +
+	"visibility\x12'\n" +
+	"\x05links\x18\x04 \x03(\v2\x11.tsudzuri.v1.LinkR\x05links\x12\x14\n" +
+	"\x05owned\x18\x05 \x01(\bR\x05owned\"d\n" +
+	"\x13SaveTemplateRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tR\n" +
+	"visibility\"\x16\n" +
+	"\x14ListTemplatesRequest\"L\n" +
+	"\x15ListTemplatesResponse\x123\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x15.tsudzuri.v1.TemplateR\ttemplates\"\xd6\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x18\n" +
+	"\adeleted\x18\x06 \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05owned\x18\t \x01(\bR\x05owned\x12.\n" +
+	"\areplies\x18\n" +
+	" \x03(\v2\x14.tsudzuri.v1.CommentR\areplies\"v\n" +
+	"\x11AddCommentRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"G\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"H\n" +
+	"\x14ListCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.tsudzuri.v1.CommentR\bcomments\"G\n" +
+	"\x12EditCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"5\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\"\xc5\x01\n" +
+	"\fLinkSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12+\n" +
+	"\x12created_by_user_id\x18\x05 \x01(\tR\x0fcreatedByUserId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\x19CreateLinkSnapshotRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"L\n" +
+	"\x18ListLinkSnapshotsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"T\n" +
+	"\x19ListLinkSnapshotsResponse\x127\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x19.tsudzuri.v1.LinkSnapshotR\tsnapshots\"k\n" +
+	"\x16GetLinkSnapshotRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x1f\n" +
+	"\vsnapshot_id\x18\x03 \x01(\tR\n" +
+	"snapshotId\"d\n" +
+	"\x17GetLinkSnapshotResponse\x125\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x19.tsudzuri.v1.LinkSnapshotR\bsnapshot\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\".\n" +
+	"\x13GetPageStatsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"\xd7\x01\n" +
+	"\tLinkStats\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12&\n" +
+	"\x0fclicks_last_day\x18\x03 \x01(\x03R\rclicksLastDay\x12(\n" +
+	"\x10clicks_last_week\x18\x04 \x01(\x03R\x0eclicksLastWeek\x12*\n" +
+	"\x11clicks_last_month\x18\x05 \x01(\x03R\x0fclicksLastMonth\x12!\n" +
+	"\fclicks_total\x18\x06 \x01(\x03R\vclicksTotal\"\x91\x01\n" +
+	"\tPageStats\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12,\n" +
+	"\x05links\x18\x02 \x03(\v2\x16.tsudzuri.v1.LinkStatsR\x05links\x12=\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xff\x01\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12$\n" +
+	"\x0eaccepted_by_id\x18\a \x01(\tR\facceptedById\"H\n" +
+	"\x17CreateInvitationRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"1\n" +
+	"\x16ListInvitationsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"T\n" +
+	"\x17ListInvitationsResponse\x129\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x17.tsudzuri.v1.InvitationR\vinvitations\"W\n" +
+	"\x17RevokeInvitationRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\tR\finvitationId\"/\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"3\n" +
+	"\x18AcceptInvitationResponse\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"\xcb\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\apage_id\x18\x03 \x01(\tR\x06pageId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\x18ListNotificationsRequest\x12\x1f\n" +
+	"\vunread_only\x18\x01 \x01(\bR\n" +
+	"unreadOnly\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\x7f\n" +
+	"\x19ListNotificationsResponse\x12?\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x19.tsudzuri.v1.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"0\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"/\n" +
+	"\x17UnreadNotificationCount\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"F\n" +
+	"\x16NotificationPreference\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"`\n" +
+	"\x17NotificationPreferences\x12E\n" +
+	"\vpreferences\x18\x01 \x03(\v2#.tsudzuri.v1.NotificationPreferenceR\vpreferences\"m\n" +
+	"$UpdateNotificationPreferencesRequest\x12E\n" +
+	"\vpreferences\x18\x01 \x03(\v2#.tsudzuri.v1.NotificationPreferenceR\vpreferences\"o\n" +
+	"\x06Device\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x15RegisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\"/\n" +
+	"\x17UnregisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x91\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12#\n" +
+	"\rfailure_count\x18\x06 \x01(\x05R\ffailureCount\x12;\n" +
+	"\vdisabled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"disabledAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"Y\n" +
+	"\x14CreateWebhookRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\"_\n" +
+	"\x15CreateWebhookResponse\x12.\n" +
+	"\awebhook\x18\x01 \x01(\v2\x14.tsudzuri.v1.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\".\n" +
+	"\x13ListWebhooksRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\"H\n" +
+	"\x14ListWebhooksResponse\x120\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x14.tsudzuri.v1.WebhookR\bwebhooks\"\x90\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"N\n" +
+	"\x14DeleteWebhookRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\"V\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\"\x9d\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1c\n" +
+	"\tsucceeded\x18\a \x01(\bR\tsucceeded\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"\x1dListWebhookDeliveriesResponse\x12<\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1c.tsudzuri.v1.WebhookDeliveryR\n" +
+	"deliveries\"\xff\x02\n" +
+	"\vAccessToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hint\x18\x03 \x01(\tR\x04hint\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x19\n" +
+	"\bpage_ids\x18\x05 \x03(\tR\apageIds\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9c\x01\n" +
+	"\x18CreateAccessTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x19\n" +
+	"\bpage_ids\x18\x03 \x03(\tR\apageIds\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"n\n" +
+	"\x19CreateAccessTokenResponse\x12;\n" +
+	"\faccess_token\x18\x01 \x01(\v2\x18.tsudzuri.v1.AccessTokenR\vaccessToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"Y\n" +
+	"\x18ListAccessTokensResponse\x12=\n" +
+	"\raccess_tokens\x18\x01 \x03(\v2\x18.tsudzuri.v1.AccessTokenR\faccessTokens\"B\n" +
+	"\x18RevokeAccessTokenRequest\x12&\n" +
+	"\x0faccess_token_id\x18\x01 \x01(\tR\raccessTokenId\"\xfa\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1a\n" +
 	"\bprovider\x18\x03 \x01(\tR\bprovider\x122\n" +
 	"\x05email\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x12&\n" +
-	"\x0fjoined_page_ids\x18\x05 \x03(\tR\rjoinedPageIds\"^\n" +
+	"\x0fjoined_page_ids\x18\x05 \x03(\tR\rjoinedPageIds\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\a \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\"p\n" +
+	"\x14UpdateProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"^\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x122\n" +
-	"\x05email\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x05email2\xb7\b\n" +
+	"\x05email\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x05email2\xf7D\n" +
 	"\x0fTsudzuriService\x12^\n" +
 	"\n" +
 	"CreatePage\x12\x1e.tsudzuri.v1.CreatePageRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/pages\x12Z\n" +
@@ -838,12 +6700,74 @@ const file_tsudzuri_v1_tsudzuri_proto_rawDesc = "" +
 	"DeletePage\x12\x1e.tsudzuri.v1.DeletePageRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/pages/{page_id}\x12h\n" +
 	"\aAddLink\x12\x1b.tsudzuri.v1.AddLinkRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/pages/{page_id}/links\x12k\n" +
 	"\n" +
-	"RemoveLink\x12\x1e.tsudzuri.v1.RemoveLinkRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/pages/{page_id}/links\x12i\n" +
-	"\bJoinPage\x12\x1c.tsudzuri.v1.JoinPageRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/pages/{page_id}/join\x12N\n" +
+	"RemoveLink\x12\x1e.tsudzuri.v1.RemoveLinkRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/pages/{page_id}/links\x12}\n" +
+	"\rBatchAddLinks\x12!.tsudzuri.v1.BatchAddLinksRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/pages/{page_id}/links:batchAdd\x12\x86\x01\n" +
+	"\x10BatchRemoveLinks\x12$.tsudzuri.v1.BatchRemoveLinksRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/pages/{page_id}/links:batchRemove\x12x\n" +
+	"\tMoveLinks\x12\x1d.tsudzuri.v1.MoveLinksRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/pages/{source_page_id}/links:move\x12p\n" +
+	"\rDuplicatePage\x12!.tsudzuri.v1.DuplicatePageRequest\x1a\x11.tsudzuri.v1.Page\")\x82\xd3\xe4\x93\x02#\"!/api/v1/pages/{page_id}/duplicate\x12i\n" +
+	"\bJoinPage\x12\x1c.tsudzuri.v1.JoinPageRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/pages/{page_id}/join\x12\x83\x01\n" +
+	"\x13GetPageInviteQRCode\x12'.tsudzuri.v1.GetPageInviteQRCodeRequest\x1a\x14.google.api.HttpBody\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/pages/{page_id}/invite/qr.png\x12\x86\x01\n" +
+	"\x16GetPageInviteQRCodeSVG\x12'.tsudzuri.v1.GetPageInviteQRCodeRequest\x1a\x14.google.api.HttpBody\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/pages/{page_id}/invite/qr.svg\x12j\n" +
+	"\n" +
+	"ExportPage\x12\x1e.tsudzuri.v1.ExportPageRequest\x1a\x14.google.api.HttpBody\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/pages/{page_id}/export\x12\x88\x01\n" +
+	"\x0fCreateFeedToken\x12#.tsudzuri.v1.CreateFeedTokenRequest\x1a$.tsudzuri.v1.CreateFeedTokenResponse\"*\x82\xd3\xe4\x93\x02$\"\"/api/v1/pages/{page_id}/feed-token\x12z\n" +
+	"\x0fRevokeFeedToken\x12#.tsudzuri.v1.RevokeFeedTokenRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/pages/{page_id}/feed-token\x12u\n" +
+	"\rCreateSection\x12!.tsudzuri.v1.CreateSectionRequest\x1a\x14.tsudzuri.v1.Section\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/pages/{page_id}/sections\x12\x84\x01\n" +
+	"\rRenameSection\x12!.tsudzuri.v1.RenameSectionRequest\x1a\x16.google.protobuf.Empty\"8\x82\xd3\xe4\x93\x022:\x01*2-/api/v1/pages/{page_id}/sections/{section_id}\x12\x83\x01\n" +
+	"\x0fReorderSections\x12#.tsudzuri.v1.ReorderSectionsRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/pages/{page_id}/sections:reorder\x12\x81\x01\n" +
+	"\rDeleteSection\x12!.tsudzuri.v1.DeleteSectionRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/*-/api/v1/pages/{page_id}/sections/{section_id}\x12\x8c\x01\n" +
+	"\x12MoveLinksToSection\x12&.tsudzuri.v1.MoveLinksToSectionRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/pages/{page_id}/links:moveToSection\x12~\n" +
+	"\vReactToLink\x12\x1f.tsudzuri.v1.ReactToLinkRequest\x1a\x11.tsudzuri.v1.Link\";\x82\xd3\xe4\x93\x025:\x01*\x1a0/api/v1/pages/{page_id}/links/{link_id}/reaction\x12\x89\x01\n" +
+	"\x12RemoveLinkReaction\x12&.tsudzuri.v1.RemoveLinkReactionRequest\x1a\x11.tsudzuri.v1.Link\"8\x82\xd3\xe4\x93\x022*0/api/v1/pages/{page_id}/links/{link_id}/reaction\x12u\n" +
+	"\bMarkLink\x12\x1c.tsudzuri.v1.MarkLinkRequest\x1a\x11.tsudzuri.v1.Link\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/pages/{page_id}/links/{link_id}/state\x12\x86\x01\n" +
+	"\x10MarkAllLinksRead\x12$.tsudzuri.v1.MarkAllLinksReadRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/pages/{page_id}/links:markAllRead\x12y\n" +
+	"\x10SetChecklistMode\x12$.tsudzuri.v1.SetChecklistModeRequest\x1a\x11.tsudzuri.v1.Page\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/pages/{page_id}/checklist\x12\x86\x01\n" +
+	"\x0eToggleLinkDone\x12\".tsudzuri.v1.ToggleLinkDoneRequest\x1a\x11.tsudzuri.v1.Link\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/pages/{page_id}/links/{link_id}:toggleDone\x12\x92\x01\n" +
+	"\x12AcceptLinkRedirect\x12&.tsudzuri.v1.AcceptLinkRedirectRequest\x1a\x11.tsudzuri.v1.Link\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/v1/pages/{page_id}/links/{link_id}:acceptRedirect\x12m\n" +
+	"\vArchivePage\x12\x1f.tsudzuri.v1.ArchivePageRequest\x1a\x11.tsudzuri.v1.Page\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/pages/{page_id}:archive\x12s\n" +
+	"\rUnarchivePage\x12!.tsudzuri.v1.UnarchivePageRequest\x1a\x11.tsudzuri.v1.Page\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/pages/{page_id}:unarchive\x12c\n" +
+	"\aPinPage\x12\x1b.tsudzuri.v1.PinPageRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d\x1a\x1b/api/v1/pages/{page_id}/pin\x12g\n" +
+	"\tUnpinPage\x12\x1d.tsudzuri.v1.UnpinPageRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/v1/pages/{page_id}/pin\x12o\n" +
+	"\x0eReorderMyPages\x12\".tsudzuri.v1.ReorderMyPagesRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/me/pages/order\x12o\n" +
+	"\fSetInboxPage\x12 .tsudzuri.v1.SetInboxPageRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f\x1a\x1d/api/v1/pages/{page_id}/inbox\x12l\n" +
+	"\vCaptureLink\x12\x1f.tsudzuri.v1.CaptureLinkRequest\x1a .tsudzuri.v1.CaptureLinkResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/capture\x12j\n" +
+	"\vSetPageSlug\x12\x1f.tsudzuri.v1.SetPageSlugRequest\x1a\x11.tsudzuri.v1.Page\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/pages/{page_id}/slug\x12n\n" +
+	"\vResolveSlug\x12\x1f.tsudzuri.v1.ResolveSlugRequest\x1a .tsudzuri.v1.ResolveSlugResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/slugs/{slug}\x12e\n" +
+	"\fSaveTemplate\x12 .tsudzuri.v1.SaveTemplateRequest\x1a\x15.tsudzuri.v1.Template\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/templates\x12q\n" +
+	"\rListTemplates\x12!.tsudzuri.v1.ListTemplatesRequest\x1a\".tsudzuri.v1.ListTemplatesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/templates\x12\x7f\n" +
+	"\n" +
+	"AddComment\x12\x1e.tsudzuri.v1.AddCommentRequest\x1a\x14.tsudzuri.v1.Comment\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/pages/{page_id}/links/{link_id}/comments\x12\x8d\x01\n" +
+	"\fListComments\x12 .tsudzuri.v1.ListCommentsRequest\x1a!.tsudzuri.v1.ListCommentsResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/pages/{page_id}/links/{link_id}/comments\x12n\n" +
+	"\vEditComment\x12\x1f.tsudzuri.v1.EditCommentRequest\x1a\x14.tsudzuri.v1.Comment\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/api/v1/comments/{comment_id}\x12q\n" +
+	"\rDeleteComment\x12!.tsudzuri.v1.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/comments/{comment_id}\x12\x95\x01\n" +
+	"\x12CreateLinkSnapshot\x12&.tsudzuri.v1.CreateLinkSnapshotRequest\x1a\x19.tsudzuri.v1.LinkSnapshot\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/pages/{page_id}/links/{link_id}/snapshots\x12\x9d\x01\n" +
+	"\x11ListLinkSnapshots\x12%.tsudzuri.v1.ListLinkSnapshotsRequest\x1a&.tsudzuri.v1.ListLinkSnapshotsResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/pages/{page_id}/links/{link_id}/snapshots\x12\xa5\x01\n" +
+	"\x0fGetLinkSnapshot\x12#.tsudzuri.v1.GetLinkSnapshotRequest\x1a$.tsudzuri.v1.GetLinkSnapshotResponse\"G\x82\xd3\xe4\x93\x02A\x12?/api/v1/pages/{page_id}/links/{link_id}/snapshots/{snapshot_id}\x12o\n" +
+	"\fGetPageStats\x12 .tsudzuri.v1.GetPageStatsRequest\x1a\x16.tsudzuri.v1.PageStats\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/pages/{page_id}/stats\x12\x81\x01\n" +
+	"\x10CreateInvitation\x12$.tsudzuri.v1.CreateInvitationRequest\x1a\x17.tsudzuri.v1.Invitation\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/pages/{page_id}/invitations\x12\x89\x01\n" +
+	"\x0fListInvitations\x12#.tsudzuri.v1.ListInvitationsRequest\x1a$.tsudzuri.v1.ListInvitationsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/pages/{page_id}/invitations\x12\x8d\x01\n" +
+	"\x10RevokeInvitation\x12$.tsudzuri.v1.RevokeInvitationRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/api/v1/pages/{page_id}/invitations/{invitation_id}\x12\x86\x01\n" +
+	"\x10AcceptInvitation\x12$.tsudzuri.v1.AcceptInvitationRequest\x1a%.tsudzuri.v1.AcceptInvitationResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/invitations/accept\x12\x84\x01\n" +
+	"\x11ListNotifications\x12%.tsudzuri.v1.ListNotificationsRequest\x1a&.tsudzuri.v1.ListNotificationsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/notifications\x12\x88\x01\n" +
+	"\x15MarkNotificationsRead\x12).tsudzuri.v1.MarkNotificationsReadRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/me/notifications:markRead\x12\x89\x01\n" +
+	"\x1aGetUnreadNotificationCount\x12\x16.google.protobuf.Empty\x1a$.tsudzuri.v1.UnreadNotificationCount\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/me/notifications/unread-count\x12\x87\x01\n" +
+	"\x1aGetNotificationPreferences\x12\x16.google.protobuf.Empty\x1a$.tsudzuri.v1.NotificationPreferences\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/me/notification-preferences\x12\xa8\x01\n" +
+	"\x1dUpdateNotificationPreferences\x121.tsudzuri.v1.UpdateNotificationPreferencesRequest\x1a$.tsudzuri.v1.NotificationPreferences\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/me/notification-preferences\x12h\n" +
+	"\x0eRegisterDevice\x12\".tsudzuri.v1.RegisterDeviceRequest\x1a\x13.tsudzuri.v1.Device\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/me/devices\x12z\n" +
+	"\x10UnregisterDevice\x12$.tsudzuri.v1.UnregisterDeviceRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/me/devices:unregister\x12\x83\x01\n" +
+	"\rCreateWebhook\x12!.tsudzuri.v1.CreateWebhookRequest\x1a\".tsudzuri.v1.CreateWebhookResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/pages/{page_id}/webhooks\x12}\n" +
+	"\fListWebhooks\x12 .tsudzuri.v1.ListWebhooksRequest\x1a!.tsudzuri.v1.ListWebhooksResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/pages/{page_id}/webhooks\x12\x82\x01\n" +
+	"\rUpdateWebhook\x12!.tsudzuri.v1.UpdateWebhookRequest\x1a\x14.tsudzuri.v1.Webhook\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/pages/{page_id}/webhooks/{webhook_id}\x12\x81\x01\n" +
+	"\rDeleteWebhook\x12!.tsudzuri.v1.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/*-/api/v1/pages/{page_id}/webhooks/{webhook_id}\x12\xb0\x01\n" +
+	"\x15ListWebhookDeliveries\x12).tsudzuri.v1.ListWebhookDeliveriesRequest\x1a*.tsudzuri.v1.ListWebhookDeliveriesResponse\"@\x82\xd3\xe4\x93\x02:\x128/api/v1/pages/{page_id}/webhooks/{webhook_id}/deliveries\x12\x87\x01\n" +
+	"\x11CreateAccessToken\x12%.tsudzuri.v1.CreateAccessTokenRequest\x1a&.tsudzuri.v1.CreateAccessTokenResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/me/access-tokens\x12s\n" +
+	"\x10ListAccessTokens\x12\x16.google.protobuf.Empty\x1a%.tsudzuri.v1.ListAccessTokensResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/access-tokens\x12\x86\x01\n" +
+	"\x11RevokeAccessToken\x12%.tsudzuri.v1.RevokeAccessTokenRequest\x1a\x16.google.protobuf.Empty\"2\x82\xd3\xe4\x93\x02,**/api/v1/me/access-tokens/{access_token_id}\x12N\n" +
 	"\n" +
 	"CreateUser\x12\x16.google.protobuf.Empty\x1a\x11.tsudzuri.v1.User\"\x15\x82\xd3\xe4\x93\x02\x0f\"\r/api/v1/users\x12Z\n" +
 	"\x05Login\x12\x19.tsudzuri.v1.LoginRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/users/login\x12J\n" +
-	"\x03Get\x12\x16.google.protobuf.Empty\x1a\x11.tsudzuri.v1.User\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/users/meB\xaf\x01\n" +
+	"\x03Get\x12\x16.google.protobuf.Empty\x1a\x11.tsudzuri.v1.User\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/users/me\x12d\n" +
+	"\rUpdateProfile\x12!.tsudzuri.v1.UpdateProfileRequest\x1a\x11.tsudzuri.v1.User\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/me/profileB\xaf\x01\n" +
 	"\x0fcom.tsudzuri.v1B\rTsudzuriProtoP\x01Z@github.com/naka-sei/tsudzuri/api/protobuf/tsudzuri/v1;tsudzuriv1\xa2\x02\x03TXX\xaa\x02\vTsudzuri.V1\xca\x02\vTsudzuri\\V1\xe2\x02\x17Tsudzuri\\V1\\GPBMetadata\xea\x02\fTsudzuri::V1b\x06proto3"
 
 var (
@@ -858,58 +6782,316 @@ func file_tsudzuri_v1_tsudzuri_proto_rawDescGZIP() []byte {
 	return file_tsudzuri_v1_tsudzuri_proto_rawDescData
 }
 
-var file_tsudzuri_v1_tsudzuri_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_tsudzuri_v1_tsudzuri_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
 var file_tsudzuri_v1_tsudzuri_proto_goTypes = []any{
-	(*Page)(nil),                   // 0: tsudzuri.v1.Page
-	(*Link)(nil),                   // 1: tsudzuri.v1.Link
-	(*CreatePageRequest)(nil),      // 2: tsudzuri.v1.CreatePageRequest
-	(*GetPageRequest)(nil),         // 3: tsudzuri.v1.GetPageRequest
-	(*ListPagesRequest)(nil),       // 4: tsudzuri.v1.ListPagesRequest
-	(*ListPagesResponse)(nil),      // 5: tsudzuri.v1.ListPagesResponse
-	(*EditPageRequest)(nil),        // 6: tsudzuri.v1.EditPageRequest
-	(*LinkInput)(nil),              // 7: tsudzuri.v1.LinkInput
-	(*DeletePageRequest)(nil),      // 8: tsudzuri.v1.DeletePageRequest
-	(*AddLinkRequest)(nil),         // 9: tsudzuri.v1.AddLinkRequest
-	(*RemoveLinkRequest)(nil),      // 10: tsudzuri.v1.RemoveLinkRequest
-	(*JoinPageRequest)(nil),        // 11: tsudzuri.v1.JoinPageRequest
-	(*User)(nil),                   // 12: tsudzuri.v1.User
-	(*LoginRequest)(nil),           // 13: tsudzuri.v1.LoginRequest
-	(*wrapperspb.StringValue)(nil), // 14: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),          // 15: google.protobuf.Empty
+	(*Page)(nil),                                 // 0: tsudzuri.v1.Page
+	(*PageUser)(nil),                             // 1: tsudzuri.v1.PageUser
+	(*ChecklistProgress)(nil),                    // 2: tsudzuri.v1.ChecklistProgress
+	(*Section)(nil),                              // 3: tsudzuri.v1.Section
+	(*Link)(nil),                                 // 4: tsudzuri.v1.Link
+	(*ReactionCount)(nil),                        // 5: tsudzuri.v1.ReactionCount
+	(*CreatePageRequest)(nil),                    // 6: tsudzuri.v1.CreatePageRequest
+	(*GetPageRequest)(nil),                       // 7: tsudzuri.v1.GetPageRequest
+	(*ListPagesRequest)(nil),                     // 8: tsudzuri.v1.ListPagesRequest
+	(*ListPagesResponse)(nil),                    // 9: tsudzuri.v1.ListPagesResponse
+	(*EditPageRequest)(nil),                      // 10: tsudzuri.v1.EditPageRequest
+	(*LinkInput)(nil),                            // 11: tsudzuri.v1.LinkInput
+	(*DeletePageRequest)(nil),                    // 12: tsudzuri.v1.DeletePageRequest
+	(*AddLinkRequest)(nil),                       // 13: tsudzuri.v1.AddLinkRequest
+	(*RemoveLinkRequest)(nil),                    // 14: tsudzuri.v1.RemoveLinkRequest
+	(*BatchAddLinksRequest)(nil),                 // 15: tsudzuri.v1.BatchAddLinksRequest
+	(*BatchRemoveLinksRequest)(nil),              // 16: tsudzuri.v1.BatchRemoveLinksRequest
+	(*MoveLinksRequest)(nil),                     // 17: tsudzuri.v1.MoveLinksRequest
+	(*JoinPageRequest)(nil),                      // 18: tsudzuri.v1.JoinPageRequest
+	(*GetPageInviteQRCodeRequest)(nil),           // 19: tsudzuri.v1.GetPageInviteQRCodeRequest
+	(*DuplicatePageRequest)(nil),                 // 20: tsudzuri.v1.DuplicatePageRequest
+	(*ExportPageRequest)(nil),                    // 21: tsudzuri.v1.ExportPageRequest
+	(*CreateFeedTokenRequest)(nil),               // 22: tsudzuri.v1.CreateFeedTokenRequest
+	(*CreateFeedTokenResponse)(nil),              // 23: tsudzuri.v1.CreateFeedTokenResponse
+	(*RevokeFeedTokenRequest)(nil),               // 24: tsudzuri.v1.RevokeFeedTokenRequest
+	(*CreateSectionRequest)(nil),                 // 25: tsudzuri.v1.CreateSectionRequest
+	(*RenameSectionRequest)(nil),                 // 26: tsudzuri.v1.RenameSectionRequest
+	(*ReorderSectionsRequest)(nil),               // 27: tsudzuri.v1.ReorderSectionsRequest
+	(*DeleteSectionRequest)(nil),                 // 28: tsudzuri.v1.DeleteSectionRequest
+	(*MoveLinksToSectionRequest)(nil),            // 29: tsudzuri.v1.MoveLinksToSectionRequest
+	(*ReactToLinkRequest)(nil),                   // 30: tsudzuri.v1.ReactToLinkRequest
+	(*RemoveLinkReactionRequest)(nil),            // 31: tsudzuri.v1.RemoveLinkReactionRequest
+	(*MarkLinkRequest)(nil),                      // 32: tsudzuri.v1.MarkLinkRequest
+	(*MarkAllLinksReadRequest)(nil),              // 33: tsudzuri.v1.MarkAllLinksReadRequest
+	(*SetChecklistModeRequest)(nil),              // 34: tsudzuri.v1.SetChecklistModeRequest
+	(*ToggleLinkDoneRequest)(nil),                // 35: tsudzuri.v1.ToggleLinkDoneRequest
+	(*AcceptLinkRedirectRequest)(nil),            // 36: tsudzuri.v1.AcceptLinkRedirectRequest
+	(*ArchivePageRequest)(nil),                   // 37: tsudzuri.v1.ArchivePageRequest
+	(*UnarchivePageRequest)(nil),                 // 38: tsudzuri.v1.UnarchivePageRequest
+	(*PinPageRequest)(nil),                       // 39: tsudzuri.v1.PinPageRequest
+	(*UnpinPageRequest)(nil),                     // 40: tsudzuri.v1.UnpinPageRequest
+	(*ReorderMyPagesRequest)(nil),                // 41: tsudzuri.v1.ReorderMyPagesRequest
+	(*SetInboxPageRequest)(nil),                  // 42: tsudzuri.v1.SetInboxPageRequest
+	(*CaptureLinkRequest)(nil),                   // 43: tsudzuri.v1.CaptureLinkRequest
+	(*CaptureLinkResponse)(nil),                  // 44: tsudzuri.v1.CaptureLinkResponse
+	(*SetPageSlugRequest)(nil),                   // 45: tsudzuri.v1.SetPageSlugRequest
+	(*ResolveSlugRequest)(nil),                   // 46: tsudzuri.v1.ResolveSlugRequest
+	(*ResolveSlugResponse)(nil),                  // 47: tsudzuri.v1.ResolveSlugResponse
+	(*Template)(nil),                             // 48: tsudzuri.v1.Template
+	(*SaveTemplateRequest)(nil),                  // 49: tsudzuri.v1.SaveTemplateRequest
+	(*ListTemplatesRequest)(nil),                 // 50: tsudzuri.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),                // 51: tsudzuri.v1.ListTemplatesResponse
+	(*Comment)(nil),                              // 52: tsudzuri.v1.Comment
+	(*AddCommentRequest)(nil),                    // 53: tsudzuri.v1.AddCommentRequest
+	(*ListCommentsRequest)(nil),                  // 54: tsudzuri.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),                 // 55: tsudzuri.v1.ListCommentsResponse
+	(*EditCommentRequest)(nil),                   // 56: tsudzuri.v1.EditCommentRequest
+	(*DeleteCommentRequest)(nil),                 // 57: tsudzuri.v1.DeleteCommentRequest
+	(*LinkSnapshot)(nil),                         // 58: tsudzuri.v1.LinkSnapshot
+	(*CreateLinkSnapshotRequest)(nil),            // 59: tsudzuri.v1.CreateLinkSnapshotRequest
+	(*ListLinkSnapshotsRequest)(nil),             // 60: tsudzuri.v1.ListLinkSnapshotsRequest
+	(*ListLinkSnapshotsResponse)(nil),            // 61: tsudzuri.v1.ListLinkSnapshotsResponse
+	(*GetLinkSnapshotRequest)(nil),               // 62: tsudzuri.v1.GetLinkSnapshotRequest
+	(*GetLinkSnapshotResponse)(nil),              // 63: tsudzuri.v1.GetLinkSnapshotResponse
+	(*GetPageStatsRequest)(nil),                  // 64: tsudzuri.v1.GetPageStatsRequest
+	(*LinkStats)(nil),                            // 65: tsudzuri.v1.LinkStats
+	(*PageStats)(nil),                            // 66: tsudzuri.v1.PageStats
+	(*Invitation)(nil),                           // 67: tsudzuri.v1.Invitation
+	(*CreateInvitationRequest)(nil),              // 68: tsudzuri.v1.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),               // 69: tsudzuri.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),              // 70: tsudzuri.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),              // 71: tsudzuri.v1.RevokeInvitationRequest
+	(*AcceptInvitationRequest)(nil),              // 72: tsudzuri.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),             // 73: tsudzuri.v1.AcceptInvitationResponse
+	(*Notification)(nil),                         // 74: tsudzuri.v1.Notification
+	(*ListNotificationsRequest)(nil),             // 75: tsudzuri.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 76: tsudzuri.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),         // 77: tsudzuri.v1.MarkNotificationsReadRequest
+	(*UnreadNotificationCount)(nil),              // 78: tsudzuri.v1.UnreadNotificationCount
+	(*NotificationPreference)(nil),               // 79: tsudzuri.v1.NotificationPreference
+	(*NotificationPreferences)(nil),              // 80: tsudzuri.v1.NotificationPreferences
+	(*UpdateNotificationPreferencesRequest)(nil), // 81: tsudzuri.v1.UpdateNotificationPreferencesRequest
+	(*Device)(nil),                               // 82: tsudzuri.v1.Device
+	(*RegisterDeviceRequest)(nil),                // 83: tsudzuri.v1.RegisterDeviceRequest
+	(*UnregisterDeviceRequest)(nil),              // 84: tsudzuri.v1.UnregisterDeviceRequest
+	(*Webhook)(nil),                              // 85: tsudzuri.v1.Webhook
+	(*CreateWebhookRequest)(nil),                 // 86: tsudzuri.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                // 87: tsudzuri.v1.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),                  // 88: tsudzuri.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                 // 89: tsudzuri.v1.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),                 // 90: tsudzuri.v1.UpdateWebhookRequest
+	(*DeleteWebhookRequest)(nil),                 // 91: tsudzuri.v1.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),         // 92: tsudzuri.v1.ListWebhookDeliveriesRequest
+	(*WebhookDelivery)(nil),                      // 93: tsudzuri.v1.WebhookDelivery
+	(*ListWebhookDeliveriesResponse)(nil),        // 94: tsudzuri.v1.ListWebhookDeliveriesResponse
+	(*AccessToken)(nil),                          // 95: tsudzuri.v1.AccessToken
+	(*CreateAccessTokenRequest)(nil),             // 96: tsudzuri.v1.CreateAccessTokenRequest
+	(*CreateAccessTokenResponse)(nil),            // 97: tsudzuri.v1.CreateAccessTokenResponse
+	(*ListAccessTokensResponse)(nil),             // 98: tsudzuri.v1.ListAccessTokensResponse
+	(*RevokeAccessTokenRequest)(nil),             // 99: tsudzuri.v1.RevokeAccessTokenRequest
+	(*User)(nil),                                 // 100: tsudzuri.v1.User
+	(*UpdateProfileRequest)(nil),                 // 101: tsudzuri.v1.UpdateProfileRequest
+	(*LoginRequest)(nil),                         // 102: tsudzuri.v1.LoginRequest
+	(*BatchAddLinksRequest_Link)(nil),            // 103: tsudzuri.v1.BatchAddLinksRequest.Link
+	(*timestamppb.Timestamp)(nil),                // 104: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),               // 105: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),                        // 106: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),                    // 107: google.api.HttpBody
 }
 var file_tsudzuri_v1_tsudzuri_proto_depIdxs = []int32{
-	1,  // 0: tsudzuri.v1.Page.links:type_name -> tsudzuri.v1.Link
-	0,  // 1: tsudzuri.v1.ListPagesResponse.pages:type_name -> tsudzuri.v1.Page
-	7,  // 2: tsudzuri.v1.EditPageRequest.links:type_name -> tsudzuri.v1.LinkInput
-	14, // 3: tsudzuri.v1.User.email:type_name -> google.protobuf.StringValue
-	14, // 4: tsudzuri.v1.LoginRequest.email:type_name -> google.protobuf.StringValue
-	2,  // 5: tsudzuri.v1.TsudzuriService.CreatePage:input_type -> tsudzuri.v1.CreatePageRequest
-	3,  // 6: tsudzuri.v1.TsudzuriService.GetPage:input_type -> tsudzuri.v1.GetPageRequest
-	4,  // 7: tsudzuri.v1.TsudzuriService.ListPages:input_type -> tsudzuri.v1.ListPagesRequest
-	6,  // 8: tsudzuri.v1.TsudzuriService.EditPage:input_type -> tsudzuri.v1.EditPageRequest
-	8,  // 9: tsudzuri.v1.TsudzuriService.DeletePage:input_type -> tsudzuri.v1.DeletePageRequest
-	9,  // 10: tsudzuri.v1.TsudzuriService.AddLink:input_type -> tsudzuri.v1.AddLinkRequest
-	10, // 11: tsudzuri.v1.TsudzuriService.RemoveLink:input_type -> tsudzuri.v1.RemoveLinkRequest
-	11, // 12: tsudzuri.v1.TsudzuriService.JoinPage:input_type -> tsudzuri.v1.JoinPageRequest
-	15, // 13: tsudzuri.v1.TsudzuriService.CreateUser:input_type -> google.protobuf.Empty
-	13, // 14: tsudzuri.v1.TsudzuriService.Login:input_type -> tsudzuri.v1.LoginRequest
-	15, // 15: tsudzuri.v1.TsudzuriService.Get:input_type -> google.protobuf.Empty
-	15, // 16: tsudzuri.v1.TsudzuriService.CreatePage:output_type -> google.protobuf.Empty
-	0,  // 17: tsudzuri.v1.TsudzuriService.GetPage:output_type -> tsudzuri.v1.Page
-	5,  // 18: tsudzuri.v1.TsudzuriService.ListPages:output_type -> tsudzuri.v1.ListPagesResponse
-	15, // 19: tsudzuri.v1.TsudzuriService.EditPage:output_type -> google.protobuf.Empty
-	15, // 20: tsudzuri.v1.TsudzuriService.DeletePage:output_type -> google.protobuf.Empty
-	15, // 21: tsudzuri.v1.TsudzuriService.AddLink:output_type -> google.protobuf.Empty
-	15, // 22: tsudzuri.v1.TsudzuriService.RemoveLink:output_type -> google.protobuf.Empty
-	15, // 23: tsudzuri.v1.TsudzuriService.JoinPage:output_type -> google.protobuf.Empty
-	12, // 24: tsudzuri.v1.TsudzuriService.CreateUser:output_type -> tsudzuri.v1.User
-	15, // 25: tsudzuri.v1.TsudzuriService.Login:output_type -> google.protobuf.Empty
-	12, // 26: tsudzuri.v1.TsudzuriService.Get:output_type -> tsudzuri.v1.User
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,   // 0: tsudzuri.v1.Page.links:type_name -> tsudzuri.v1.Link
+	3,   // 1: tsudzuri.v1.Page.sections:type_name -> tsudzuri.v1.Section
+	2,   // 2: tsudzuri.v1.Page.progress:type_name -> tsudzuri.v1.ChecklistProgress
+	104, // 3: tsudzuri.v1.Page.created_at:type_name -> google.protobuf.Timestamp
+	104, // 4: tsudzuri.v1.Page.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 5: tsudzuri.v1.Page.creator:type_name -> tsudzuri.v1.PageUser
+	1,   // 6: tsudzuri.v1.Page.members:type_name -> tsudzuri.v1.PageUser
+	4,   // 7: tsudzuri.v1.Section.links:type_name -> tsudzuri.v1.Link
+	5,   // 8: tsudzuri.v1.Link.reactions:type_name -> tsudzuri.v1.ReactionCount
+	104, // 9: tsudzuri.v1.Link.done_at:type_name -> google.protobuf.Timestamp
+	104, // 10: tsudzuri.v1.Link.checked_at:type_name -> google.protobuf.Timestamp
+	0,   // 11: tsudzuri.v1.ListPagesResponse.pages:type_name -> tsudzuri.v1.Page
+	11,  // 12: tsudzuri.v1.EditPageRequest.links:type_name -> tsudzuri.v1.LinkInput
+	105, // 13: tsudzuri.v1.EditPageRequest.description:type_name -> google.protobuf.StringValue
+	105, // 14: tsudzuri.v1.EditPageRequest.icon:type_name -> google.protobuf.StringValue
+	105, // 15: tsudzuri.v1.EditPageRequest.color:type_name -> google.protobuf.StringValue
+	103, // 16: tsudzuri.v1.BatchAddLinksRequest.links:type_name -> tsudzuri.v1.BatchAddLinksRequest.Link
+	4,   // 17: tsudzuri.v1.Template.links:type_name -> tsudzuri.v1.Link
+	48,  // 18: tsudzuri.v1.ListTemplatesResponse.templates:type_name -> tsudzuri.v1.Template
+	104, // 19: tsudzuri.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	104, // 20: tsudzuri.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	52,  // 21: tsudzuri.v1.Comment.replies:type_name -> tsudzuri.v1.Comment
+	52,  // 22: tsudzuri.v1.ListCommentsResponse.comments:type_name -> tsudzuri.v1.Comment
+	104, // 23: tsudzuri.v1.LinkSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 24: tsudzuri.v1.ListLinkSnapshotsResponse.snapshots:type_name -> tsudzuri.v1.LinkSnapshot
+	58,  // 25: tsudzuri.v1.GetLinkSnapshotResponse.snapshot:type_name -> tsudzuri.v1.LinkSnapshot
+	65,  // 26: tsudzuri.v1.PageStats.links:type_name -> tsudzuri.v1.LinkStats
+	104, // 27: tsudzuri.v1.PageStats.generated_at:type_name -> google.protobuf.Timestamp
+	104, // 28: tsudzuri.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	104, // 29: tsudzuri.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 30: tsudzuri.v1.ListInvitationsResponse.invitations:type_name -> tsudzuri.v1.Invitation
+	104, // 31: tsudzuri.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 32: tsudzuri.v1.ListNotificationsResponse.notifications:type_name -> tsudzuri.v1.Notification
+	79,  // 33: tsudzuri.v1.NotificationPreferences.preferences:type_name -> tsudzuri.v1.NotificationPreference
+	79,  // 34: tsudzuri.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> tsudzuri.v1.NotificationPreference
+	104, // 35: tsudzuri.v1.Device.created_at:type_name -> google.protobuf.Timestamp
+	104, // 36: tsudzuri.v1.Webhook.disabled_at:type_name -> google.protobuf.Timestamp
+	104, // 37: tsudzuri.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 38: tsudzuri.v1.CreateWebhookResponse.webhook:type_name -> tsudzuri.v1.Webhook
+	85,  // 39: tsudzuri.v1.ListWebhooksResponse.webhooks:type_name -> tsudzuri.v1.Webhook
+	104, // 40: tsudzuri.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 41: tsudzuri.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> tsudzuri.v1.WebhookDelivery
+	104, // 42: tsudzuri.v1.AccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	104, // 43: tsudzuri.v1.AccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	104, // 44: tsudzuri.v1.AccessToken.revoked_at:type_name -> google.protobuf.Timestamp
+	104, // 45: tsudzuri.v1.AccessToken.created_at:type_name -> google.protobuf.Timestamp
+	104, // 46: tsudzuri.v1.CreateAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	95,  // 47: tsudzuri.v1.CreateAccessTokenResponse.access_token:type_name -> tsudzuri.v1.AccessToken
+	95,  // 48: tsudzuri.v1.ListAccessTokensResponse.access_tokens:type_name -> tsudzuri.v1.AccessToken
+	105, // 49: tsudzuri.v1.User.email:type_name -> google.protobuf.StringValue
+	105, // 50: tsudzuri.v1.LoginRequest.email:type_name -> google.protobuf.StringValue
+	6,   // 51: tsudzuri.v1.TsudzuriService.CreatePage:input_type -> tsudzuri.v1.CreatePageRequest
+	7,   // 52: tsudzuri.v1.TsudzuriService.GetPage:input_type -> tsudzuri.v1.GetPageRequest
+	8,   // 53: tsudzuri.v1.TsudzuriService.ListPages:input_type -> tsudzuri.v1.ListPagesRequest
+	10,  // 54: tsudzuri.v1.TsudzuriService.EditPage:input_type -> tsudzuri.v1.EditPageRequest
+	12,  // 55: tsudzuri.v1.TsudzuriService.DeletePage:input_type -> tsudzuri.v1.DeletePageRequest
+	13,  // 56: tsudzuri.v1.TsudzuriService.AddLink:input_type -> tsudzuri.v1.AddLinkRequest
+	14,  // 57: tsudzuri.v1.TsudzuriService.RemoveLink:input_type -> tsudzuri.v1.RemoveLinkRequest
+	15,  // 58: tsudzuri.v1.TsudzuriService.BatchAddLinks:input_type -> tsudzuri.v1.BatchAddLinksRequest
+	16,  // 59: tsudzuri.v1.TsudzuriService.BatchRemoveLinks:input_type -> tsudzuri.v1.BatchRemoveLinksRequest
+	17,  // 60: tsudzuri.v1.TsudzuriService.MoveLinks:input_type -> tsudzuri.v1.MoveLinksRequest
+	20,  // 61: tsudzuri.v1.TsudzuriService.DuplicatePage:input_type -> tsudzuri.v1.DuplicatePageRequest
+	18,  // 62: tsudzuri.v1.TsudzuriService.JoinPage:input_type -> tsudzuri.v1.JoinPageRequest
+	19,  // 63: tsudzuri.v1.TsudzuriService.GetPageInviteQRCode:input_type -> tsudzuri.v1.GetPageInviteQRCodeRequest
+	19,  // 64: tsudzuri.v1.TsudzuriService.GetPageInviteQRCodeSVG:input_type -> tsudzuri.v1.GetPageInviteQRCodeRequest
+	21,  // 65: tsudzuri.v1.TsudzuriService.ExportPage:input_type -> tsudzuri.v1.ExportPageRequest
+	22,  // 66: tsudzuri.v1.TsudzuriService.CreateFeedToken:input_type -> tsudzuri.v1.CreateFeedTokenRequest
+	24,  // 67: tsudzuri.v1.TsudzuriService.RevokeFeedToken:input_type -> tsudzuri.v1.RevokeFeedTokenRequest
+	25,  // 68: tsudzuri.v1.TsudzuriService.CreateSection:input_type -> tsudzuri.v1.CreateSectionRequest
+	26,  // 69: tsudzuri.v1.TsudzuriService.RenameSection:input_type -> tsudzuri.v1.RenameSectionRequest
+	27,  // 70: tsudzuri.v1.TsudzuriService.ReorderSections:input_type -> tsudzuri.v1.ReorderSectionsRequest
+	28,  // 71: tsudzuri.v1.TsudzuriService.DeleteSection:input_type -> tsudzuri.v1.DeleteSectionRequest
+	29,  // 72: tsudzuri.v1.TsudzuriService.MoveLinksToSection:input_type -> tsudzuri.v1.MoveLinksToSectionRequest
+	30,  // 73: tsudzuri.v1.TsudzuriService.ReactToLink:input_type -> tsudzuri.v1.ReactToLinkRequest
+	31,  // 74: tsudzuri.v1.TsudzuriService.RemoveLinkReaction:input_type -> tsudzuri.v1.RemoveLinkReactionRequest
+	32,  // 75: tsudzuri.v1.TsudzuriService.MarkLink:input_type -> tsudzuri.v1.MarkLinkRequest
+	33,  // 76: tsudzuri.v1.TsudzuriService.MarkAllLinksRead:input_type -> tsudzuri.v1.MarkAllLinksReadRequest
+	34,  // 77: tsudzuri.v1.TsudzuriService.SetChecklistMode:input_type -> tsudzuri.v1.SetChecklistModeRequest
+	35,  // 78: tsudzuri.v1.TsudzuriService.ToggleLinkDone:input_type -> tsudzuri.v1.ToggleLinkDoneRequest
+	36,  // 79: tsudzuri.v1.TsudzuriService.AcceptLinkRedirect:input_type -> tsudzuri.v1.AcceptLinkRedirectRequest
+	37,  // 80: tsudzuri.v1.TsudzuriService.ArchivePage:input_type -> tsudzuri.v1.ArchivePageRequest
+	38,  // 81: tsudzuri.v1.TsudzuriService.UnarchivePage:input_type -> tsudzuri.v1.UnarchivePageRequest
+	39,  // 82: tsudzuri.v1.TsudzuriService.PinPage:input_type -> tsudzuri.v1.PinPageRequest
+	40,  // 83: tsudzuri.v1.TsudzuriService.UnpinPage:input_type -> tsudzuri.v1.UnpinPageRequest
+	41,  // 84: tsudzuri.v1.TsudzuriService.ReorderMyPages:input_type -> tsudzuri.v1.ReorderMyPagesRequest
+	42,  // 85: tsudzuri.v1.TsudzuriService.SetInboxPage:input_type -> tsudzuri.v1.SetInboxPageRequest
+	43,  // 86: tsudzuri.v1.TsudzuriService.CaptureLink:input_type -> tsudzuri.v1.CaptureLinkRequest
+	45,  // 87: tsudzuri.v1.TsudzuriService.SetPageSlug:input_type -> tsudzuri.v1.SetPageSlugRequest
+	46,  // 88: tsudzuri.v1.TsudzuriService.ResolveSlug:input_type -> tsudzuri.v1.ResolveSlugRequest
+	49,  // 89: tsudzuri.v1.TsudzuriService.SaveTemplate:input_type -> tsudzuri.v1.SaveTemplateRequest
+	50,  // 90: tsudzuri.v1.TsudzuriService.ListTemplates:input_type -> tsudzuri.v1.ListTemplatesRequest
+	53,  // 91: tsudzuri.v1.TsudzuriService.AddComment:input_type -> tsudzuri.v1.AddCommentRequest
+	54,  // 92: tsudzuri.v1.TsudzuriService.ListComments:input_type -> tsudzuri.v1.ListCommentsRequest
+	56,  // 93: tsudzuri.v1.TsudzuriService.EditComment:input_type -> tsudzuri.v1.EditCommentRequest
+	57,  // 94: tsudzuri.v1.TsudzuriService.DeleteComment:input_type -> tsudzuri.v1.DeleteCommentRequest
+	59,  // 95: tsudzuri.v1.TsudzuriService.CreateLinkSnapshot:input_type -> tsudzuri.v1.CreateLinkSnapshotRequest
+	60,  // 96: tsudzuri.v1.TsudzuriService.ListLinkSnapshots:input_type -> tsudzuri.v1.ListLinkSnapshotsRequest
+	62,  // 97: tsudzuri.v1.TsudzuriService.GetLinkSnapshot:input_type -> tsudzuri.v1.GetLinkSnapshotRequest
+	64,  // 98: tsudzuri.v1.TsudzuriService.GetPageStats:input_type -> tsudzuri.v1.GetPageStatsRequest
+	68,  // 99: tsudzuri.v1.TsudzuriService.CreateInvitation:input_type -> tsudzuri.v1.CreateInvitationRequest
+	69,  // 100: tsudzuri.v1.TsudzuriService.ListInvitations:input_type -> tsudzuri.v1.ListInvitationsRequest
+	71,  // 101: tsudzuri.v1.TsudzuriService.RevokeInvitation:input_type -> tsudzuri.v1.RevokeInvitationRequest
+	72,  // 102: tsudzuri.v1.TsudzuriService.AcceptInvitation:input_type -> tsudzuri.v1.AcceptInvitationRequest
+	75,  // 103: tsudzuri.v1.TsudzuriService.ListNotifications:input_type -> tsudzuri.v1.ListNotificationsRequest
+	77,  // 104: tsudzuri.v1.TsudzuriService.MarkNotificationsRead:input_type -> tsudzuri.v1.MarkNotificationsReadRequest
+	106, // 105: tsudzuri.v1.TsudzuriService.GetUnreadNotificationCount:input_type -> google.protobuf.Empty
+	106, // 106: tsudzuri.v1.TsudzuriService.GetNotificationPreferences:input_type -> google.protobuf.Empty
+	81,  // 107: tsudzuri.v1.TsudzuriService.UpdateNotificationPreferences:input_type -> tsudzuri.v1.UpdateNotificationPreferencesRequest
+	83,  // 108: tsudzuri.v1.TsudzuriService.RegisterDevice:input_type -> tsudzuri.v1.RegisterDeviceRequest
+	84,  // 109: tsudzuri.v1.TsudzuriService.UnregisterDevice:input_type -> tsudzuri.v1.UnregisterDeviceRequest
+	86,  // 110: tsudzuri.v1.TsudzuriService.CreateWebhook:input_type -> tsudzuri.v1.CreateWebhookRequest
+	88,  // 111: tsudzuri.v1.TsudzuriService.ListWebhooks:input_type -> tsudzuri.v1.ListWebhooksRequest
+	90,  // 112: tsudzuri.v1.TsudzuriService.UpdateWebhook:input_type -> tsudzuri.v1.UpdateWebhookRequest
+	91,  // 113: tsudzuri.v1.TsudzuriService.DeleteWebhook:input_type -> tsudzuri.v1.DeleteWebhookRequest
+	92,  // 114: tsudzuri.v1.TsudzuriService.ListWebhookDeliveries:input_type -> tsudzuri.v1.ListWebhookDeliveriesRequest
+	96,  // 115: tsudzuri.v1.TsudzuriService.CreateAccessToken:input_type -> tsudzuri.v1.CreateAccessTokenRequest
+	106, // 116: tsudzuri.v1.TsudzuriService.ListAccessTokens:input_type -> google.protobuf.Empty
+	99,  // 117: tsudzuri.v1.TsudzuriService.RevokeAccessToken:input_type -> tsudzuri.v1.RevokeAccessTokenRequest
+	106, // 118: tsudzuri.v1.TsudzuriService.CreateUser:input_type -> google.protobuf.Empty
+	102, // 119: tsudzuri.v1.TsudzuriService.Login:input_type -> tsudzuri.v1.LoginRequest
+	106, // 120: tsudzuri.v1.TsudzuriService.Get:input_type -> google.protobuf.Empty
+	101, // 121: tsudzuri.v1.TsudzuriService.UpdateProfile:input_type -> tsudzuri.v1.UpdateProfileRequest
+	106, // 122: tsudzuri.v1.TsudzuriService.CreatePage:output_type -> google.protobuf.Empty
+	0,   // 123: tsudzuri.v1.TsudzuriService.GetPage:output_type -> tsudzuri.v1.Page
+	9,   // 124: tsudzuri.v1.TsudzuriService.ListPages:output_type -> tsudzuri.v1.ListPagesResponse
+	106, // 125: tsudzuri.v1.TsudzuriService.EditPage:output_type -> google.protobuf.Empty
+	106, // 126: tsudzuri.v1.TsudzuriService.DeletePage:output_type -> google.protobuf.Empty
+	106, // 127: tsudzuri.v1.TsudzuriService.AddLink:output_type -> google.protobuf.Empty
+	106, // 128: tsudzuri.v1.TsudzuriService.RemoveLink:output_type -> google.protobuf.Empty
+	106, // 129: tsudzuri.v1.TsudzuriService.BatchAddLinks:output_type -> google.protobuf.Empty
+	106, // 130: tsudzuri.v1.TsudzuriService.BatchRemoveLinks:output_type -> google.protobuf.Empty
+	106, // 131: tsudzuri.v1.TsudzuriService.MoveLinks:output_type -> google.protobuf.Empty
+	0,   // 132: tsudzuri.v1.TsudzuriService.DuplicatePage:output_type -> tsudzuri.v1.Page
+	106, // 133: tsudzuri.v1.TsudzuriService.JoinPage:output_type -> google.protobuf.Empty
+	107, // 134: tsudzuri.v1.TsudzuriService.GetPageInviteQRCode:output_type -> google.api.HttpBody
+	107, // 135: tsudzuri.v1.TsudzuriService.GetPageInviteQRCodeSVG:output_type -> google.api.HttpBody
+	107, // 136: tsudzuri.v1.TsudzuriService.ExportPage:output_type -> google.api.HttpBody
+	23,  // 137: tsudzuri.v1.TsudzuriService.CreateFeedToken:output_type -> tsudzuri.v1.CreateFeedTokenResponse
+	106, // 138: tsudzuri.v1.TsudzuriService.RevokeFeedToken:output_type -> google.protobuf.Empty
+	3,   // 139: tsudzuri.v1.TsudzuriService.CreateSection:output_type -> tsudzuri.v1.Section
+	106, // 140: tsudzuri.v1.TsudzuriService.RenameSection:output_type -> google.protobuf.Empty
+	106, // 141: tsudzuri.v1.TsudzuriService.ReorderSections:output_type -> google.protobuf.Empty
+	106, // 142: tsudzuri.v1.TsudzuriService.DeleteSection:output_type -> google.protobuf.Empty
+	106, // 143: tsudzuri.v1.TsudzuriService.MoveLinksToSection:output_type -> google.protobuf.Empty
+	4,   // 144: tsudzuri.v1.TsudzuriService.ReactToLink:output_type -> tsudzuri.v1.Link
+	4,   // 145: tsudzuri.v1.TsudzuriService.RemoveLinkReaction:output_type -> tsudzuri.v1.Link
+	4,   // 146: tsudzuri.v1.TsudzuriService.MarkLink:output_type -> tsudzuri.v1.Link
+	106, // 147: tsudzuri.v1.TsudzuriService.MarkAllLinksRead:output_type -> google.protobuf.Empty
+	0,   // 148: tsudzuri.v1.TsudzuriService.SetChecklistMode:output_type -> tsudzuri.v1.Page
+	4,   // 149: tsudzuri.v1.TsudzuriService.ToggleLinkDone:output_type -> tsudzuri.v1.Link
+	4,   // 150: tsudzuri.v1.TsudzuriService.AcceptLinkRedirect:output_type -> tsudzuri.v1.Link
+	0,   // 151: tsudzuri.v1.TsudzuriService.ArchivePage:output_type -> tsudzuri.v1.Page
+	0,   // 152: tsudzuri.v1.TsudzuriService.UnarchivePage:output_type -> tsudzuri.v1.Page
+	106, // 153: tsudzuri.v1.TsudzuriService.PinPage:output_type -> google.protobuf.Empty
+	106, // 154: tsudzuri.v1.TsudzuriService.UnpinPage:output_type -> google.protobuf.Empty
+	106, // 155: tsudzuri.v1.TsudzuriService.ReorderMyPages:output_type -> google.protobuf.Empty
+	106, // 156: tsudzuri.v1.TsudzuriService.SetInboxPage:output_type -> google.protobuf.Empty
+	44,  // 157: tsudzuri.v1.TsudzuriService.CaptureLink:output_type -> tsudzuri.v1.CaptureLinkResponse
+	0,   // 158: tsudzuri.v1.TsudzuriService.SetPageSlug:output_type -> tsudzuri.v1.Page
+	47,  // 159: tsudzuri.v1.TsudzuriService.ResolveSlug:output_type -> tsudzuri.v1.ResolveSlugResponse
+	48,  // 160: tsudzuri.v1.TsudzuriService.SaveTemplate:output_type -> tsudzuri.v1.Template
+	51,  // 161: tsudzuri.v1.TsudzuriService.ListTemplates:output_type -> tsudzuri.v1.ListTemplatesResponse
+	52,  // 162: tsudzuri.v1.TsudzuriService.AddComment:output_type -> tsudzuri.v1.Comment
+	55,  // 163: tsudzuri.v1.TsudzuriService.ListComments:output_type -> tsudzuri.v1.ListCommentsResponse
+	52,  // 164: tsudzuri.v1.TsudzuriService.EditComment:output_type -> tsudzuri.v1.Comment
+	106, // 165: tsudzuri.v1.TsudzuriService.DeleteComment:output_type -> google.protobuf.Empty
+	58,  // 166: tsudzuri.v1.TsudzuriService.CreateLinkSnapshot:output_type -> tsudzuri.v1.LinkSnapshot
+	61,  // 167: tsudzuri.v1.TsudzuriService.ListLinkSnapshots:output_type -> tsudzuri.v1.ListLinkSnapshotsResponse
+	63,  // 168: tsudzuri.v1.TsudzuriService.GetLinkSnapshot:output_type -> tsudzuri.v1.GetLinkSnapshotResponse
+	66,  // 169: tsudzuri.v1.TsudzuriService.GetPageStats:output_type -> tsudzuri.v1.PageStats
+	67,  // 170: tsudzuri.v1.TsudzuriService.CreateInvitation:output_type -> tsudzuri.v1.Invitation
+	70,  // 171: tsudzuri.v1.TsudzuriService.ListInvitations:output_type -> tsudzuri.v1.ListInvitationsResponse
+	106, // 172: tsudzuri.v1.TsudzuriService.RevokeInvitation:output_type -> google.protobuf.Empty
+	73,  // 173: tsudzuri.v1.TsudzuriService.AcceptInvitation:output_type -> tsudzuri.v1.AcceptInvitationResponse
+	76,  // 174: tsudzuri.v1.TsudzuriService.ListNotifications:output_type -> tsudzuri.v1.ListNotificationsResponse
+	106, // 175: tsudzuri.v1.TsudzuriService.MarkNotificationsRead:output_type -> google.protobuf.Empty
+	78,  // 176: tsudzuri.v1.TsudzuriService.GetUnreadNotificationCount:output_type -> tsudzuri.v1.UnreadNotificationCount
+	80,  // 177: tsudzuri.v1.TsudzuriService.GetNotificationPreferences:output_type -> tsudzuri.v1.NotificationPreferences
+	80,  // 178: tsudzuri.v1.TsudzuriService.UpdateNotificationPreferences:output_type -> tsudzuri.v1.NotificationPreferences
+	82,  // 179: tsudzuri.v1.TsudzuriService.RegisterDevice:output_type -> tsudzuri.v1.Device
+	106, // 180: tsudzuri.v1.TsudzuriService.UnregisterDevice:output_type -> google.protobuf.Empty
+	87,  // 181: tsudzuri.v1.TsudzuriService.CreateWebhook:output_type -> tsudzuri.v1.CreateWebhookResponse
+	89,  // 182: tsudzuri.v1.TsudzuriService.ListWebhooks:output_type -> tsudzuri.v1.ListWebhooksResponse
+	85,  // 183: tsudzuri.v1.TsudzuriService.UpdateWebhook:output_type -> tsudzuri.v1.Webhook
+	106, // 184: tsudzuri.v1.TsudzuriService.DeleteWebhook:output_type -> google.protobuf.Empty
+	94,  // 185: tsudzuri.v1.TsudzuriService.ListWebhookDeliveries:output_type -> tsudzuri.v1.ListWebhookDeliveriesResponse
+	97,  // 186: tsudzuri.v1.TsudzuriService.CreateAccessToken:output_type -> tsudzuri.v1.CreateAccessTokenResponse
+	98,  // 187: tsudzuri.v1.TsudzuriService.ListAccessTokens:output_type -> tsudzuri.v1.ListAccessTokensResponse
+	106, // 188: tsudzuri.v1.TsudzuriService.RevokeAccessToken:output_type -> google.protobuf.Empty
+	100, // 189: tsudzuri.v1.TsudzuriService.CreateUser:output_type -> tsudzuri.v1.User
+	106, // 190: tsudzuri.v1.TsudzuriService.Login:output_type -> google.protobuf.Empty
+	100, // 191: tsudzuri.v1.TsudzuriService.Get:output_type -> tsudzuri.v1.User
+	100, // 192: tsudzuri.v1.TsudzuriService.UpdateProfile:output_type -> tsudzuri.v1.User
+	122, // [122:193] is the sub-list for method output_type
+	51,  // [51:122] is the sub-list for method input_type
+	51,  // [51:51] is the sub-list for extension type_name
+	51,  // [51:51] is the sub-list for extension extendee
+	0,   // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_tsudzuri_v1_tsudzuri_proto_init() }
@@ -917,13 +7099,14 @@ func file_tsudzuri_v1_tsudzuri_proto_init() {
 	if File_tsudzuri_v1_tsudzuri_proto != nil {
 		return
 	}
+	file_tsudzuri_v1_tsudzuri_proto_msgTypes[75].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tsudzuri_v1_tsudzuri_proto_rawDesc), len(file_tsudzuri_v1_tsudzuri_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   104,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
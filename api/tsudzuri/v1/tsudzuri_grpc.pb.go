@@ -8,6 +8,7 @@ package tsudzuriv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,17 +21,77 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	TsudzuriService_CreatePage_FullMethodName = "/tsudzuri.v1.TsudzuriService/CreatePage"
-	TsudzuriService_GetPage_FullMethodName    = "/tsudzuri.v1.TsudzuriService/GetPage"
-	TsudzuriService_ListPages_FullMethodName  = "/tsudzuri.v1.TsudzuriService/ListPages"
-	TsudzuriService_EditPage_FullMethodName   = "/tsudzuri.v1.TsudzuriService/EditPage"
-	TsudzuriService_DeletePage_FullMethodName = "/tsudzuri.v1.TsudzuriService/DeletePage"
-	TsudzuriService_AddLink_FullMethodName    = "/tsudzuri.v1.TsudzuriService/AddLink"
-	TsudzuriService_RemoveLink_FullMethodName = "/tsudzuri.v1.TsudzuriService/RemoveLink"
-	TsudzuriService_JoinPage_FullMethodName   = "/tsudzuri.v1.TsudzuriService/JoinPage"
-	TsudzuriService_CreateUser_FullMethodName = "/tsudzuri.v1.TsudzuriService/CreateUser"
-	TsudzuriService_Login_FullMethodName      = "/tsudzuri.v1.TsudzuriService/Login"
-	TsudzuriService_Get_FullMethodName        = "/tsudzuri.v1.TsudzuriService/Get"
+	TsudzuriService_CreatePage_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/CreatePage"
+	TsudzuriService_GetPage_FullMethodName                       = "/tsudzuri.v1.TsudzuriService/GetPage"
+	TsudzuriService_ListPages_FullMethodName                     = "/tsudzuri.v1.TsudzuriService/ListPages"
+	TsudzuriService_EditPage_FullMethodName                      = "/tsudzuri.v1.TsudzuriService/EditPage"
+	TsudzuriService_DeletePage_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/DeletePage"
+	TsudzuriService_AddLink_FullMethodName                       = "/tsudzuri.v1.TsudzuriService/AddLink"
+	TsudzuriService_RemoveLink_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/RemoveLink"
+	TsudzuriService_BatchAddLinks_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/BatchAddLinks"
+	TsudzuriService_BatchRemoveLinks_FullMethodName              = "/tsudzuri.v1.TsudzuriService/BatchRemoveLinks"
+	TsudzuriService_MoveLinks_FullMethodName                     = "/tsudzuri.v1.TsudzuriService/MoveLinks"
+	TsudzuriService_DuplicatePage_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/DuplicatePage"
+	TsudzuriService_JoinPage_FullMethodName                      = "/tsudzuri.v1.TsudzuriService/JoinPage"
+	TsudzuriService_GetPageInviteQRCode_FullMethodName           = "/tsudzuri.v1.TsudzuriService/GetPageInviteQRCode"
+	TsudzuriService_GetPageInviteQRCodeSVG_FullMethodName        = "/tsudzuri.v1.TsudzuriService/GetPageInviteQRCodeSVG"
+	TsudzuriService_ExportPage_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/ExportPage"
+	TsudzuriService_CreateFeedToken_FullMethodName               = "/tsudzuri.v1.TsudzuriService/CreateFeedToken"
+	TsudzuriService_RevokeFeedToken_FullMethodName               = "/tsudzuri.v1.TsudzuriService/RevokeFeedToken"
+	TsudzuriService_CreateSection_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/CreateSection"
+	TsudzuriService_RenameSection_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/RenameSection"
+	TsudzuriService_ReorderSections_FullMethodName               = "/tsudzuri.v1.TsudzuriService/ReorderSections"
+	TsudzuriService_DeleteSection_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/DeleteSection"
+	TsudzuriService_MoveLinksToSection_FullMethodName            = "/tsudzuri.v1.TsudzuriService/MoveLinksToSection"
+	TsudzuriService_ReactToLink_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/ReactToLink"
+	TsudzuriService_RemoveLinkReaction_FullMethodName            = "/tsudzuri.v1.TsudzuriService/RemoveLinkReaction"
+	TsudzuriService_MarkLink_FullMethodName                      = "/tsudzuri.v1.TsudzuriService/MarkLink"
+	TsudzuriService_MarkAllLinksRead_FullMethodName              = "/tsudzuri.v1.TsudzuriService/MarkAllLinksRead"
+	TsudzuriService_SetChecklistMode_FullMethodName              = "/tsudzuri.v1.TsudzuriService/SetChecklistMode"
+	TsudzuriService_ToggleLinkDone_FullMethodName                = "/tsudzuri.v1.TsudzuriService/ToggleLinkDone"
+	TsudzuriService_AcceptLinkRedirect_FullMethodName            = "/tsudzuri.v1.TsudzuriService/AcceptLinkRedirect"
+	TsudzuriService_ArchivePage_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/ArchivePage"
+	TsudzuriService_UnarchivePage_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/UnarchivePage"
+	TsudzuriService_PinPage_FullMethodName                       = "/tsudzuri.v1.TsudzuriService/PinPage"
+	TsudzuriService_UnpinPage_FullMethodName                     = "/tsudzuri.v1.TsudzuriService/UnpinPage"
+	TsudzuriService_ReorderMyPages_FullMethodName                = "/tsudzuri.v1.TsudzuriService/ReorderMyPages"
+	TsudzuriService_SetInboxPage_FullMethodName                  = "/tsudzuri.v1.TsudzuriService/SetInboxPage"
+	TsudzuriService_CaptureLink_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/CaptureLink"
+	TsudzuriService_SetPageSlug_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/SetPageSlug"
+	TsudzuriService_ResolveSlug_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/ResolveSlug"
+	TsudzuriService_SaveTemplate_FullMethodName                  = "/tsudzuri.v1.TsudzuriService/SaveTemplate"
+	TsudzuriService_ListTemplates_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/ListTemplates"
+	TsudzuriService_AddComment_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/AddComment"
+	TsudzuriService_ListComments_FullMethodName                  = "/tsudzuri.v1.TsudzuriService/ListComments"
+	TsudzuriService_EditComment_FullMethodName                   = "/tsudzuri.v1.TsudzuriService/EditComment"
+	TsudzuriService_DeleteComment_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/DeleteComment"
+	TsudzuriService_CreateLinkSnapshot_FullMethodName            = "/tsudzuri.v1.TsudzuriService/CreateLinkSnapshot"
+	TsudzuriService_ListLinkSnapshots_FullMethodName             = "/tsudzuri.v1.TsudzuriService/ListLinkSnapshots"
+	TsudzuriService_GetLinkSnapshot_FullMethodName               = "/tsudzuri.v1.TsudzuriService/GetLinkSnapshot"
+	TsudzuriService_GetPageStats_FullMethodName                  = "/tsudzuri.v1.TsudzuriService/GetPageStats"
+	TsudzuriService_CreateInvitation_FullMethodName              = "/tsudzuri.v1.TsudzuriService/CreateInvitation"
+	TsudzuriService_ListInvitations_FullMethodName               = "/tsudzuri.v1.TsudzuriService/ListInvitations"
+	TsudzuriService_RevokeInvitation_FullMethodName              = "/tsudzuri.v1.TsudzuriService/RevokeInvitation"
+	TsudzuriService_AcceptInvitation_FullMethodName              = "/tsudzuri.v1.TsudzuriService/AcceptInvitation"
+	TsudzuriService_ListNotifications_FullMethodName             = "/tsudzuri.v1.TsudzuriService/ListNotifications"
+	TsudzuriService_MarkNotificationsRead_FullMethodName         = "/tsudzuri.v1.TsudzuriService/MarkNotificationsRead"
+	TsudzuriService_GetUnreadNotificationCount_FullMethodName    = "/tsudzuri.v1.TsudzuriService/GetUnreadNotificationCount"
+	TsudzuriService_GetNotificationPreferences_FullMethodName    = "/tsudzuri.v1.TsudzuriService/GetNotificationPreferences"
+	TsudzuriService_UpdateNotificationPreferences_FullMethodName = "/tsudzuri.v1.TsudzuriService/UpdateNotificationPreferences"
+	TsudzuriService_RegisterDevice_FullMethodName                = "/tsudzuri.v1.TsudzuriService/RegisterDevice"
+	TsudzuriService_UnregisterDevice_FullMethodName              = "/tsudzuri.v1.TsudzuriService/UnregisterDevice"
+	TsudzuriService_CreateWebhook_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/CreateWebhook"
+	TsudzuriService_ListWebhooks_FullMethodName                  = "/tsudzuri.v1.TsudzuriService/ListWebhooks"
+	TsudzuriService_UpdateWebhook_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/UpdateWebhook"
+	TsudzuriService_DeleteWebhook_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/DeleteWebhook"
+	TsudzuriService_ListWebhookDeliveries_FullMethodName         = "/tsudzuri.v1.TsudzuriService/ListWebhookDeliveries"
+	TsudzuriService_CreateAccessToken_FullMethodName             = "/tsudzuri.v1.TsudzuriService/CreateAccessToken"
+	TsudzuriService_ListAccessTokens_FullMethodName              = "/tsudzuri.v1.TsudzuriService/ListAccessTokens"
+	TsudzuriService_RevokeAccessToken_FullMethodName             = "/tsudzuri.v1.TsudzuriService/RevokeAccessToken"
+	TsudzuriService_CreateUser_FullMethodName                    = "/tsudzuri.v1.TsudzuriService/CreateUser"
+	TsudzuriService_Login_FullMethodName                         = "/tsudzuri.v1.TsudzuriService/Login"
+	TsudzuriService_Get_FullMethodName                           = "/tsudzuri.v1.TsudzuriService/Get"
+	TsudzuriService_UpdateProfile_FullMethodName                 = "/tsudzuri.v1.TsudzuriService/UpdateProfile"
 )
 
 // TsudzuriServiceClient is the client API for TsudzuriService service.
@@ -38,6 +99,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TsudzuriServiceClient interface {
 	// Page management
+	// Every page_id, source_page_id and target_page_id field also accepts a slug of the page, including replaced slugs.
 	CreatePage(ctx context.Context, in *CreatePageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetPage(ctx context.Context, in *GetPageRequest, opts ...grpc.CallOption) (*Page, error)
 	ListPages(ctx context.Context, in *ListPagesRequest, opts ...grpc.CallOption) (*ListPagesResponse, error)
@@ -45,11 +107,96 @@ type TsudzuriServiceClient interface {
 	DeletePage(ctx context.Context, in *DeletePageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddLink(ctx context.Context, in *AddLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveLink(ctx context.Context, in *RemoveLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchAddLinks(ctx context.Context, in *BatchAddLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchRemoveLinks(ctx context.Context, in *BatchRemoveLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveLinks(ctx context.Context, in *MoveLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DuplicatePage(ctx context.Context, in *DuplicatePageRequest, opts ...grpc.CallOption) (*Page, error)
 	JoinPage(ctx context.Context, in *JoinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetPageInviteQRCode renders the join link of the page, carrying the page ID and the current invite code, as a PNG QR code
+	// for people meeting in person to scan. Only the page creator can fetch it, as only the creator sees the invite code.
+	GetPageInviteQRCode(ctx context.Context, in *GetPageInviteQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// GetPageInviteQRCodeSVG renders the same QR code as GetPageInviteQRCode as an SVG image.
+	GetPageInviteQRCodeSVG(ctx context.Context, in *GetPageInviteQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	ExportPage(ctx context.Context, in *ExportPageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreateFeedToken(ctx context.Context, in *CreateFeedTokenRequest, opts ...grpc.CallOption) (*CreateFeedTokenResponse, error)
+	RevokeFeedToken(ctx context.Context, in *RevokeFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateSection(ctx context.Context, in *CreateSectionRequest, opts ...grpc.CallOption) (*Section, error)
+	RenameSection(ctx context.Context, in *RenameSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderSections(ctx context.Context, in *ReorderSectionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteSection(ctx context.Context, in *DeleteSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveLinksToSection(ctx context.Context, in *MoveLinksToSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReactToLink(ctx context.Context, in *ReactToLinkRequest, opts ...grpc.CallOption) (*Link, error)
+	RemoveLinkReaction(ctx context.Context, in *RemoveLinkReactionRequest, opts ...grpc.CallOption) (*Link, error)
+	MarkLink(ctx context.Context, in *MarkLinkRequest, opts ...grpc.CallOption) (*Link, error)
+	MarkAllLinksRead(ctx context.Context, in *MarkAllLinksReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetChecklistMode(ctx context.Context, in *SetChecklistModeRequest, opts ...grpc.CallOption) (*Page, error)
+	ToggleLinkDone(ctx context.Context, in *ToggleLinkDoneRequest, opts ...grpc.CallOption) (*Link, error)
+	// AcceptLinkRedirect replaces the url of a redirected link with its redirect target. Only the page creator can accept it.
+	AcceptLinkRedirect(ctx context.Context, in *AcceptLinkRedirectRequest, opts ...grpc.CallOption) (*Link, error)
+	ArchivePage(ctx context.Context, in *ArchivePageRequest, opts ...grpc.CallOption) (*Page, error)
+	UnarchivePage(ctx context.Context, in *UnarchivePageRequest, opts ...grpc.CallOption) (*Page, error)
+	PinPage(ctx context.Context, in *PinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnpinPage(ctx context.Context, in *UnpinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderMyPages(ctx context.Context, in *ReorderMyPagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetInboxPage makes the page the caller's inbox, the page CaptureLink adds links to.
+	SetInboxPage(ctx context.Context, in *SetInboxPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CaptureLink adds a link to the caller's inbox page in one request, for browser extensions and share sheets.
+	// The inbox page is created on the first capture. A URL captured again within a day is not added twice.
+	// The HTTP endpoint also accepts a form body with the same field names.
+	CaptureLink(ctx context.Context, in *CaptureLinkRequest, opts ...grpc.CallOption) (*CaptureLinkResponse, error)
+	// SetPageSlug replaces the slug of the page with a vanity slug. Only the page creator can set it.
+	// The replaced slug keeps addressing the page, and a slug another page has ever used is rejected.
+	SetPageSlug(ctx context.Context, in *SetPageSlugRequest, opts ...grpc.CallOption) (*Page, error)
+	// ResolveSlug returns the page a current or replaced slug addresses. Only members of the page can resolve it.
+	// Browsers open the vanity URL /p/{slug} instead, which redirects a signed in member to the page.
+	ResolveSlug(ctx context.Context, in *ResolveSlugRequest, opts ...grpc.CallOption) (*ResolveSlugResponse, error)
+	// Template management
+	SaveTemplate(ctx context.Context, in *SaveTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// Comment management
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Link snapshots
+	// CreateLinkSnapshot downloads the web page of the link and stores a copy without scripts. Any page member can request one.
+	CreateLinkSnapshot(ctx context.Context, in *CreateLinkSnapshotRequest, opts ...grpc.CallOption) (*LinkSnapshot, error)
+	ListLinkSnapshots(ctx context.Context, in *ListLinkSnapshotsRequest, opts ...grpc.CallOption) (*ListLinkSnapshotsResponse, error)
+	GetLinkSnapshot(ctx context.Context, in *GetLinkSnapshotRequest, opts ...grpc.CallOption) (*GetLinkSnapshotResponse, error)
+	// Click stats
+	// GetPageStats returns the clicks through /r/{link_id} on each link of the page. Only the page creator can see them.
+	GetPageStats(ctx context.Context, in *GetPageStatsRequest, opts ...grpc.CallOption) (*PageStats, error)
+	// Email invitations
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	// Notification inbox
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUnreadNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UnreadNotificationCount, error)
+	GetNotificationPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// Push notification devices
+	RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Outgoing webhooks
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Personal access tokens
+	// CreateAccessToken issues a token for scripts and integrations. The token is only returned in this response.
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error)
+	ListAccessTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// User management
 	CreateUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error)
+	// UpdateProfile replaces the profile of the caller. Empty fields clear the values.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*User, error)
 }
 
 type tsudzuriServiceClient struct {
@@ -123,6 +270,42 @@ func (c *tsudzuriServiceClient) RemoveLink(ctx context.Context, in *RemoveLinkRe
 	return out, nil
 }
 
+func (c *tsudzuriServiceClient) BatchAddLinks(ctx context.Context, in *BatchAddLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_BatchAddLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) BatchRemoveLinks(ctx context.Context, in *BatchRemoveLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_BatchRemoveLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) MoveLinks(ctx context.Context, in *MoveLinksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_MoveLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) DuplicatePage(ctx context.Context, in *DuplicatePageRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := c.cc.Invoke(ctx, TsudzuriService_DuplicatePage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tsudzuriServiceClient) JoinPage(ctx context.Context, in *JoinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, TsudzuriService_JoinPage_FullMethodName, in, out, opts...)
@@ -132,243 +315,2075 @@ func (c *tsudzuriServiceClient) JoinPage(ctx context.Context, in *JoinPageReques
 	return out, nil
 }
 
-func (c *tsudzuriServiceClient) CreateUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, TsudzuriService_CreateUser_FullMethodName, in, out, opts...)
+func (c *tsudzuriServiceClient) GetPageInviteQRCode(ctx context.Context, in *GetPageInviteQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetPageInviteQRCode_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tsudzuriServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *tsudzuriServiceClient) GetPageInviteQRCodeSVG(ctx context.Context, in *GetPageInviteQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetPageInviteQRCodeSVG_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ExportPage(ctx context.Context, in *ExportPageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, TsudzuriService_ExportPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateFeedToken(ctx context.Context, in *CreateFeedTokenRequest, opts ...grpc.CallOption) (*CreateFeedTokenResponse, error) {
+	out := new(CreateFeedTokenResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) RevokeFeedToken(ctx context.Context, in *RevokeFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, TsudzuriService_Login_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, TsudzuriService_RevokeFeedToken_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tsudzuriServiceClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, TsudzuriService_Get_FullMethodName, in, out, opts...)
+func (c *tsudzuriServiceClient) CreateSection(ctx context.Context, in *CreateSectionRequest, opts ...grpc.CallOption) (*Section, error) {
+	out := new(Section)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateSection_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TsudzuriServiceServer is the server API for TsudzuriService service.
-// All implementations must embed UnimplementedTsudzuriServiceServer
-// for forward compatibility
-type TsudzuriServiceServer interface {
-	// Page management
-	CreatePage(context.Context, *CreatePageRequest) (*emptypb.Empty, error)
-	GetPage(context.Context, *GetPageRequest) (*Page, error)
-	ListPages(context.Context, *ListPagesRequest) (*ListPagesResponse, error)
-	EditPage(context.Context, *EditPageRequest) (*emptypb.Empty, error)
-	DeletePage(context.Context, *DeletePageRequest) (*emptypb.Empty, error)
-	AddLink(context.Context, *AddLinkRequest) (*emptypb.Empty, error)
-	RemoveLink(context.Context, *RemoveLinkRequest) (*emptypb.Empty, error)
-	JoinPage(context.Context, *JoinPageRequest) (*emptypb.Empty, error)
-	// User management
-	CreateUser(context.Context, *emptypb.Empty) (*User, error)
-	Login(context.Context, *LoginRequest) (*emptypb.Empty, error)
-	Get(context.Context, *emptypb.Empty) (*User, error)
-	mustEmbedUnimplementedTsudzuriServiceServer()
+func (c *tsudzuriServiceClient) RenameSection(ctx context.Context, in *RenameSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_RenameSection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedTsudzuriServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedTsudzuriServiceServer struct {
+func (c *tsudzuriServiceClient) ReorderSections(ctx context.Context, in *ReorderSectionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_ReorderSections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedTsudzuriServiceServer) CreatePage(context.Context, *CreatePageRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePage not implemented")
+func (c *tsudzuriServiceClient) DeleteSection(ctx context.Context, in *DeleteSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_DeleteSection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) GetPage(context.Context, *GetPageRequest) (*Page, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
+
+func (c *tsudzuriServiceClient) MoveLinksToSection(ctx context.Context, in *MoveLinksToSectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_MoveLinksToSection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) ListPages(context.Context, *ListPagesRequest) (*ListPagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPages not implemented")
+
+func (c *tsudzuriServiceClient) ReactToLink(ctx context.Context, in *ReactToLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, TsudzuriService_ReactToLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) EditPage(context.Context, *EditPageRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditPage not implemented")
+
+func (c *tsudzuriServiceClient) RemoveLinkReaction(ctx context.Context, in *RemoveLinkReactionRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, TsudzuriService_RemoveLinkReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) DeletePage(context.Context, *DeletePageRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
+
+func (c *tsudzuriServiceClient) MarkLink(ctx context.Context, in *MarkLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, TsudzuriService_MarkLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) AddLink(context.Context, *AddLinkRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLink not implemented")
+
+func (c *tsudzuriServiceClient) MarkAllLinksRead(ctx context.Context, in *MarkAllLinksReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_MarkAllLinksRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) RemoveLink(context.Context, *RemoveLinkRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLink not implemented")
+
+func (c *tsudzuriServiceClient) SetChecklistMode(ctx context.Context, in *SetChecklistModeRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := c.cc.Invoke(ctx, TsudzuriService_SetChecklistMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTsudzuriServiceServer) JoinPage(context.Context, *JoinPageRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinPage not implemented")
+
+func (c *tsudzuriServiceClient) ToggleLinkDone(ctx context.Context, in *ToggleLinkDoneRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, TsudzuriService_ToggleLinkDone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) AcceptLinkRedirect(ctx context.Context, in *AcceptLinkRedirectRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, TsudzuriService_AcceptLinkRedirect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ArchivePage(ctx context.Context, in *ArchivePageRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := c.cc.Invoke(ctx, TsudzuriService_ArchivePage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UnarchivePage(ctx context.Context, in *UnarchivePageRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := c.cc.Invoke(ctx, TsudzuriService_UnarchivePage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) PinPage(ctx context.Context, in *PinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_PinPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UnpinPage(ctx context.Context, in *UnpinPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_UnpinPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ReorderMyPages(ctx context.Context, in *ReorderMyPagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_ReorderMyPages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) SetInboxPage(ctx context.Context, in *SetInboxPageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_SetInboxPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CaptureLink(ctx context.Context, in *CaptureLinkRequest, opts ...grpc.CallOption) (*CaptureLinkResponse, error) {
+	out := new(CaptureLinkResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_CaptureLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) SetPageSlug(ctx context.Context, in *SetPageSlugRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := c.cc.Invoke(ctx, TsudzuriService_SetPageSlug_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ResolveSlug(ctx context.Context, in *ResolveSlugRequest, opts ...grpc.CallOption) (*ResolveSlugResponse, error) {
+	out := new(ResolveSlugResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ResolveSlug_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) SaveTemplate(ctx context.Context, in *SaveTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, TsudzuriService_SaveTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, TsudzuriService_AddComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, TsudzuriService_EditComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_DeleteComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateLinkSnapshot(ctx context.Context, in *CreateLinkSnapshotRequest, opts ...grpc.CallOption) (*LinkSnapshot, error) {
+	out := new(LinkSnapshot)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateLinkSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListLinkSnapshots(ctx context.Context, in *ListLinkSnapshotsRequest, opts ...grpc.CallOption) (*ListLinkSnapshotsResponse, error) {
+	out := new(ListLinkSnapshotsResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListLinkSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) GetLinkSnapshot(ctx context.Context, in *GetLinkSnapshotRequest, opts ...grpc.CallOption) (*GetLinkSnapshotResponse, error) {
+	out := new(GetLinkSnapshotResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetLinkSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) GetPageStats(ctx context.Context, in *GetPageStatsRequest, opts ...grpc.CallOption) (*PageStats, error) {
+	out := new(PageStats)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetPageStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_RevokeInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_AcceptInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_MarkNotificationsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) GetUnreadNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UnreadNotificationCount, error) {
+	out := new(UnreadNotificationCount)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetUnreadNotificationCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) GetNotificationPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, TsudzuriService_GetNotificationPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, TsudzuriService_UpdateNotificationPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, TsudzuriService_RegisterDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_UnregisterDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, TsudzuriService_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	out := new(CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateAccessToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) ListAccessTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, TsudzuriService_ListAccessTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_RevokeAccessToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) CreateUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, TsudzuriService_CreateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TsudzuriService_Login_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, TsudzuriService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tsudzuriServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, TsudzuriService_UpdateProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TsudzuriServiceServer is the server API for TsudzuriService service.
+// All implementations must embed UnimplementedTsudzuriServiceServer
+// for forward compatibility
+type TsudzuriServiceServer interface {
+	// Page management
+	// Every page_id, source_page_id and target_page_id field also accepts a slug of the page, including replaced slugs.
+	CreatePage(context.Context, *CreatePageRequest) (*emptypb.Empty, error)
+	GetPage(context.Context, *GetPageRequest) (*Page, error)
+	ListPages(context.Context, *ListPagesRequest) (*ListPagesResponse, error)
+	EditPage(context.Context, *EditPageRequest) (*emptypb.Empty, error)
+	DeletePage(context.Context, *DeletePageRequest) (*emptypb.Empty, error)
+	AddLink(context.Context, *AddLinkRequest) (*emptypb.Empty, error)
+	RemoveLink(context.Context, *RemoveLinkRequest) (*emptypb.Empty, error)
+	BatchAddLinks(context.Context, *BatchAddLinksRequest) (*emptypb.Empty, error)
+	BatchRemoveLinks(context.Context, *BatchRemoveLinksRequest) (*emptypb.Empty, error)
+	MoveLinks(context.Context, *MoveLinksRequest) (*emptypb.Empty, error)
+	DuplicatePage(context.Context, *DuplicatePageRequest) (*Page, error)
+	JoinPage(context.Context, *JoinPageRequest) (*emptypb.Empty, error)
+	// GetPageInviteQRCode renders the join link of the page, carrying the page ID and the current invite code, as a PNG QR code
+	// for people meeting in person to scan. Only the page creator can fetch it, as only the creator sees the invite code.
+	GetPageInviteQRCode(context.Context, *GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error)
+	// GetPageInviteQRCodeSVG renders the same QR code as GetPageInviteQRCode as an SVG image.
+	GetPageInviteQRCodeSVG(context.Context, *GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error)
+	ExportPage(context.Context, *ExportPageRequest) (*httpbody.HttpBody, error)
+	CreateFeedToken(context.Context, *CreateFeedTokenRequest) (*CreateFeedTokenResponse, error)
+	RevokeFeedToken(context.Context, *RevokeFeedTokenRequest) (*emptypb.Empty, error)
+	CreateSection(context.Context, *CreateSectionRequest) (*Section, error)
+	RenameSection(context.Context, *RenameSectionRequest) (*emptypb.Empty, error)
+	ReorderSections(context.Context, *ReorderSectionsRequest) (*emptypb.Empty, error)
+	DeleteSection(context.Context, *DeleteSectionRequest) (*emptypb.Empty, error)
+	MoveLinksToSection(context.Context, *MoveLinksToSectionRequest) (*emptypb.Empty, error)
+	ReactToLink(context.Context, *ReactToLinkRequest) (*Link, error)
+	RemoveLinkReaction(context.Context, *RemoveLinkReactionRequest) (*Link, error)
+	MarkLink(context.Context, *MarkLinkRequest) (*Link, error)
+	MarkAllLinksRead(context.Context, *MarkAllLinksReadRequest) (*emptypb.Empty, error)
+	SetChecklistMode(context.Context, *SetChecklistModeRequest) (*Page, error)
+	ToggleLinkDone(context.Context, *ToggleLinkDoneRequest) (*Link, error)
+	// AcceptLinkRedirect replaces the url of a redirected link with its redirect target. Only the page creator can accept it.
+	AcceptLinkRedirect(context.Context, *AcceptLinkRedirectRequest) (*Link, error)
+	ArchivePage(context.Context, *ArchivePageRequest) (*Page, error)
+	UnarchivePage(context.Context, *UnarchivePageRequest) (*Page, error)
+	PinPage(context.Context, *PinPageRequest) (*emptypb.Empty, error)
+	UnpinPage(context.Context, *UnpinPageRequest) (*emptypb.Empty, error)
+	ReorderMyPages(context.Context, *ReorderMyPagesRequest) (*emptypb.Empty, error)
+	// SetInboxPage makes the page the caller's inbox, the page CaptureLink adds links to.
+	SetInboxPage(context.Context, *SetInboxPageRequest) (*emptypb.Empty, error)
+	// CaptureLink adds a link to the caller's inbox page in one request, for browser extensions and share sheets.
+	// The inbox page is created on the first capture. A URL captured again within a day is not added twice.
+	// The HTTP endpoint also accepts a form body with the same field names.
+	CaptureLink(context.Context, *CaptureLinkRequest) (*CaptureLinkResponse, error)
+	// SetPageSlug replaces the slug of the page with a vanity slug. Only the page creator can set it.
+	// The replaced slug keeps addressing the page, and a slug another page has ever used is rejected.
+	SetPageSlug(context.Context, *SetPageSlugRequest) (*Page, error)
+	// ResolveSlug returns the page a current or replaced slug addresses. Only members of the page can resolve it.
+	// Browsers open the vanity URL /p/{slug} instead, which redirects a signed in member to the page.
+	ResolveSlug(context.Context, *ResolveSlugRequest) (*ResolveSlugResponse, error)
+	// Template management
+	SaveTemplate(context.Context, *SaveTemplateRequest) (*Template, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// Comment management
+	AddComment(context.Context, *AddCommentRequest) (*Comment, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	// Link snapshots
+	// CreateLinkSnapshot downloads the web page of the link and stores a copy without scripts. Any page member can request one.
+	CreateLinkSnapshot(context.Context, *CreateLinkSnapshotRequest) (*LinkSnapshot, error)
+	ListLinkSnapshots(context.Context, *ListLinkSnapshotsRequest) (*ListLinkSnapshotsResponse, error)
+	GetLinkSnapshot(context.Context, *GetLinkSnapshotRequest) (*GetLinkSnapshotResponse, error)
+	// Click stats
+	// GetPageStats returns the clicks through /r/{link_id} on each link of the page. Only the page creator can see them.
+	GetPageStats(context.Context, *GetPageStatsRequest) (*PageStats, error)
+	// Email invitations
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	// Notification inbox
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*emptypb.Empty, error)
+	GetUnreadNotificationCount(context.Context, *emptypb.Empty) (*UnreadNotificationCount, error)
+	GetNotificationPreferences(context.Context, *emptypb.Empty) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error)
+	// Push notification devices
+	RegisterDevice(context.Context, *RegisterDeviceRequest) (*Device, error)
+	UnregisterDevice(context.Context, *UnregisterDeviceRequest) (*emptypb.Empty, error)
+	// Outgoing webhooks
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Personal access tokens
+	// CreateAccessToken issues a token for scripts and integrations. The token is only returned in this response.
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error)
+	ListAccessTokens(context.Context, *emptypb.Empty) (*ListAccessTokensResponse, error)
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*emptypb.Empty, error)
+	// User management
+	CreateUser(context.Context, *emptypb.Empty) (*User, error)
+	Login(context.Context, *LoginRequest) (*emptypb.Empty, error)
+	Get(context.Context, *emptypb.Empty) (*User, error)
+	// UpdateProfile replaces the profile of the caller. Empty fields clear the values.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*User, error)
+	mustEmbedUnimplementedTsudzuriServiceServer()
+}
+
+// UnimplementedTsudzuriServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTsudzuriServiceServer struct {
+}
+
+func (UnimplementedTsudzuriServiceServer) CreatePage(context.Context, *CreatePageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetPage(context.Context, *GetPageRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListPages(context.Context, *ListPagesRequest) (*ListPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPages not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) EditPage(context.Context, *EditPageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) DeletePage(context.Context, *DeletePageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) AddLink(context.Context, *AddLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLink not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RemoveLink(context.Context, *RemoveLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLink not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) BatchAddLinks(context.Context, *BatchAddLinksRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAddLinks not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) BatchRemoveLinks(context.Context, *BatchRemoveLinksRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRemoveLinks not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) MoveLinks(context.Context, *MoveLinksRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLinks not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) DuplicatePage(context.Context, *DuplicatePageRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicatePage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) JoinPage(context.Context, *JoinPageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetPageInviteQRCode(context.Context, *GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPageInviteQRCode not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetPageInviteQRCodeSVG(context.Context, *GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPageInviteQRCodeSVG not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ExportPage(context.Context, *ExportPageRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateFeedToken(context.Context, *CreateFeedTokenRequest) (*CreateFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedToken not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RevokeFeedToken(context.Context, *RevokeFeedTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedToken not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateSection(context.Context, *CreateSectionRequest) (*Section, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSection not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RenameSection(context.Context, *RenameSectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSection not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ReorderSections(context.Context, *ReorderSectionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSections not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) DeleteSection(context.Context, *DeleteSectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSection not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) MoveLinksToSection(context.Context, *MoveLinksToSectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLinksToSection not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ReactToLink(context.Context, *ReactToLinkRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToLink not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RemoveLinkReaction(context.Context, *RemoveLinkReactionRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLinkReaction not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) MarkLink(context.Context, *MarkLinkRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkLink not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) MarkAllLinksRead(context.Context, *MarkAllLinksReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllLinksRead not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) SetChecklistMode(context.Context, *SetChecklistModeRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChecklistMode not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ToggleLinkDone(context.Context, *ToggleLinkDoneRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleLinkDone not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) AcceptLinkRedirect(context.Context, *AcceptLinkRedirectRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptLinkRedirect not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ArchivePage(context.Context, *ArchivePageRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UnarchivePage(context.Context, *UnarchivePageRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchivePage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) PinPage(context.Context, *PinPageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UnpinPage(context.Context, *UnpinPageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ReorderMyPages(context.Context, *ReorderMyPagesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderMyPages not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) SetInboxPage(context.Context, *SetInboxPageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInboxPage not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CaptureLink(context.Context, *CaptureLinkRequest) (*CaptureLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureLink not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) SetPageSlug(context.Context, *SetPageSlugRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPageSlug not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ResolveSlug(context.Context, *ResolveSlugRequest) (*ResolveSlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlug not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) SaveTemplate(context.Context, *SaveTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTemplate not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) AddComment(context.Context, *AddCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateLinkSnapshot(context.Context, *CreateLinkSnapshotRequest) (*LinkSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLinkSnapshot not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListLinkSnapshots(context.Context, *ListLinkSnapshotsRequest) (*ListLinkSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkSnapshots not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetLinkSnapshot(context.Context, *GetLinkSnapshotRequest) (*GetLinkSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkSnapshot not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetPageStats(context.Context, *GetPageStatsRequest) (*PageStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPageStats not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetUnreadNotificationCount(context.Context, *emptypb.Empty) (*UnreadNotificationCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) GetNotificationPreferences(context.Context, *emptypb.Empty) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RegisterDevice(context.Context, *RegisterDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevice not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UnregisterDevice(context.Context, *UnregisterDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDevice not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) ListAccessTokens(context.Context, *emptypb.Empty) (*ListAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) CreateUser(context.Context, *emptypb.Empty) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) Login(context.Context, *LoginRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) Get(context.Context, *emptypb.Empty) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedTsudzuriServiceServer) mustEmbedUnimplementedTsudzuriServiceServer() {}
+
+// UnsafeTsudzuriServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TsudzuriServiceServer will
+// result in compilation errors.
+type UnsafeTsudzuriServiceServer interface {
+	mustEmbedUnimplementedTsudzuriServiceServer()
+}
+
+func RegisterTsudzuriServiceServer(s grpc.ServiceRegistrar, srv TsudzuriServiceServer) {
+	s.RegisterService(&TsudzuriService_ServiceDesc, srv)
+}
+
+func _TsudzuriService_CreatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CreatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CreatePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CreatePage(ctx, req.(*CreatePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_GetPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetPage(ctx, req.(*GetPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListPages(ctx, req.(*ListPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_EditPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).EditPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_EditPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).EditPage(ctx, req.(*EditPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_DeletePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).DeletePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_DeletePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).DeletePage(ctx, req.(*DeletePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_AddLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).AddLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_AddLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).AddLink(ctx, req.(*AddLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_RemoveLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RemoveLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RemoveLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RemoveLink(ctx, req.(*RemoveLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_BatchAddLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).BatchAddLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_BatchAddLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).BatchAddLinks(ctx, req.(*BatchAddLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_BatchRemoveLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRemoveLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).BatchRemoveLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_BatchRemoveLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).BatchRemoveLinks(ctx, req.(*BatchRemoveLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_MoveLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).MoveLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_MoveLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).MoveLinks(ctx, req.(*MoveLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_DuplicatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicatePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).DuplicatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_DuplicatePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).DuplicatePage(ctx, req.(*DuplicatePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_JoinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).JoinPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_JoinPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).JoinPage(ctx, req.(*JoinPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_GetPageInviteQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageInviteQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetPageInviteQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetPageInviteQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetPageInviteQRCode(ctx, req.(*GetPageInviteQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_GetPageInviteQRCodeSVG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageInviteQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetPageInviteQRCodeSVG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetPageInviteQRCodeSVG_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetPageInviteQRCodeSVG(ctx, req.(*GetPageInviteQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ExportPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ExportPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ExportPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ExportPage(ctx, req.(*ExportPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_CreateFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CreateFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CreateFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CreateFeedToken(ctx, req.(*CreateFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_RevokeFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RevokeFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RevokeFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RevokeFeedToken(ctx, req.(*RevokeFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CreateSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CreateSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CreateSection(ctx, req.(*CreateSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_RenameSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RenameSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RenameSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RenameSection(ctx, req.(*RenameSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ReorderSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ReorderSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ReorderSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ReorderSections(ctx, req.(*ReorderSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).DeleteSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_DeleteSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).DeleteSection(ctx, req.(*DeleteSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_MoveLinksToSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLinksToSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).MoveLinksToSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_MoveLinksToSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).MoveLinksToSection(ctx, req.(*MoveLinksToSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ReactToLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ReactToLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ReactToLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ReactToLink(ctx, req.(*ReactToLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_RemoveLinkReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLinkReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RemoveLinkReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RemoveLinkReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RemoveLinkReaction(ctx, req.(*RemoveLinkReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_MarkLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).MarkLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_MarkLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).MarkLink(ctx, req.(*MarkLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_MarkAllLinksRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllLinksReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).MarkAllLinksRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_MarkAllLinksRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).MarkAllLinksRead(ctx, req.(*MarkAllLinksReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_SetChecklistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChecklistModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).SetChecklistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_SetChecklistMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).SetChecklistMode(ctx, req.(*SetChecklistModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ToggleLinkDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleLinkDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ToggleLinkDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ToggleLinkDone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ToggleLinkDone(ctx, req.(*ToggleLinkDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_AcceptLinkRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptLinkRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).AcceptLinkRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_AcceptLinkRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).AcceptLinkRedirect(ctx, req.(*AcceptLinkRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ArchivePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ArchivePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ArchivePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ArchivePage(ctx, req.(*ArchivePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_UnarchivePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchivePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).UnarchivePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_UnarchivePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).UnarchivePage(ctx, req.(*UnarchivePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_PinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).PinPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_PinPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).PinPage(ctx, req.(*PinPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_UnpinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).UnpinPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_UnpinPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).UnpinPage(ctx, req.(*UnpinPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ReorderMyPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderMyPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ReorderMyPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ReorderMyPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ReorderMyPages(ctx, req.(*ReorderMyPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_SetInboxPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInboxPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).SetInboxPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_SetInboxPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).SetInboxPage(ctx, req.(*SetInboxPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_CaptureLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CaptureLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CaptureLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CaptureLink(ctx, req.(*CaptureLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_SetPageSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPageSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).SetPageSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_SetPageSlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).SetPageSlug(ctx, req.(*SetPageSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ResolveSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ResolveSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ResolveSlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ResolveSlug(ctx, req.(*ResolveSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_SaveTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).SaveTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_SaveTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).SaveTemplate(ctx, req.(*SaveTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_CreateLinkSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinkSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CreateLinkSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CreateLinkSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CreateLinkSnapshot(ctx, req.(*CreateLinkSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListLinkSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListLinkSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListLinkSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListLinkSnapshots(ctx, req.(*ListLinkSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_GetLinkSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetLinkSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetLinkSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetLinkSnapshot(ctx, req.(*GetLinkSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_GetPageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetPageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetPageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetPageStats(ctx, req.(*GetPageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TsudzuriService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTsudzuriServiceServer) CreateUser(context.Context, *emptypb.Empty) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func _TsudzuriService_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetUnreadNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetUnreadNotificationCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTsudzuriServiceServer) Login(context.Context, *LoginRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _TsudzuriService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).GetNotificationPreferences(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTsudzuriServiceServer) Get(context.Context, *emptypb.Empty) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func _TsudzuriService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTsudzuriServiceServer) mustEmbedUnimplementedTsudzuriServiceServer() {}
 
-// UnsafeTsudzuriServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TsudzuriServiceServer will
-// result in compilation errors.
-type UnsafeTsudzuriServiceServer interface {
-	mustEmbedUnimplementedTsudzuriServiceServer()
+func _TsudzuriService_RegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).RegisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_RegisterDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).RegisterDevice(ctx, req.(*RegisterDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTsudzuriServiceServer(s grpc.ServiceRegistrar, srv TsudzuriServiceServer) {
-	s.RegisterService(&TsudzuriService_ServiceDesc, srv)
+func _TsudzuriService_UnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).UnregisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_UnregisterDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).UnregisterDevice(ctx, req.(*UnregisterDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_CreatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePageRequest)
+func _TsudzuriService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).CreatePage(ctx, in)
+		return srv.(TsudzuriServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_CreatePage_FullMethodName,
+		FullMethod: TsudzuriService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).CreatePage(ctx, req.(*CreatePageRequest))
+		return srv.(TsudzuriServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_GetPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPageRequest)
+func _TsudzuriService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).GetPage(ctx, in)
+		return srv.(TsudzuriServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_GetPage_FullMethodName,
+		FullMethod: TsudzuriService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).GetPage(ctx, req.(*GetPageRequest))
+		return srv.(TsudzuriServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_ListPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPagesRequest)
+func _TsudzuriService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).ListPages(ctx, in)
+		return srv.(TsudzuriServiceServer).UpdateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_ListPages_FullMethodName,
+		FullMethod: TsudzuriService_UpdateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).ListPages(ctx, req.(*ListPagesRequest))
+		return srv.(TsudzuriServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_EditPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditPageRequest)
+func _TsudzuriService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).EditPage(ctx, in)
+		return srv.(TsudzuriServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_EditPage_FullMethodName,
+		FullMethod: TsudzuriService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).EditPage(ctx, req.(*EditPageRequest))
+		return srv.(TsudzuriServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_DeletePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePageRequest)
+func _TsudzuriService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).DeletePage(ctx, in)
+		return srv.(TsudzuriServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_DeletePage_FullMethodName,
+		FullMethod: TsudzuriService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).DeletePage(ctx, req.(*DeletePageRequest))
+		return srv.(TsudzuriServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_AddLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLinkRequest)
+func _TsudzuriService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).AddLink(ctx, in)
+		return srv.(TsudzuriServiceServer).CreateAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_AddLink_FullMethodName,
+		FullMethod: TsudzuriService_CreateAccessToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).AddLink(ctx, req.(*AddLinkRequest))
+		return srv.(TsudzuriServiceServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_RemoveLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLinkRequest)
+func _TsudzuriService_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).RemoveLink(ctx, in)
+		return srv.(TsudzuriServiceServer).ListAccessTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_RemoveLink_FullMethodName,
+		FullMethod: TsudzuriService_ListAccessTokens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).RemoveLink(ctx, req.(*RemoveLinkRequest))
+		return srv.(TsudzuriServiceServer).ListAccessTokens(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TsudzuriService_JoinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinPageRequest)
+func _TsudzuriService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TsudzuriServiceServer).JoinPage(ctx, in)
+		return srv.(TsudzuriServiceServer).RevokeAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TsudzuriService_JoinPage_FullMethodName,
+		FullMethod: TsudzuriService_RevokeAccessToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TsudzuriServiceServer).JoinPage(ctx, req.(*JoinPageRequest))
+		return srv.(TsudzuriServiceServer).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -427,6 +2442,24 @@ func _TsudzuriService_Get_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TsudzuriService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TsudzuriServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TsudzuriService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TsudzuriServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TsudzuriService_ServiceDesc is the grpc.ServiceDesc for TsudzuriService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -462,10 +2495,246 @@ var TsudzuriService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLink",
 			Handler:    _TsudzuriService_RemoveLink_Handler,
 		},
+		{
+			MethodName: "BatchAddLinks",
+			Handler:    _TsudzuriService_BatchAddLinks_Handler,
+		},
+		{
+			MethodName: "BatchRemoveLinks",
+			Handler:    _TsudzuriService_BatchRemoveLinks_Handler,
+		},
+		{
+			MethodName: "MoveLinks",
+			Handler:    _TsudzuriService_MoveLinks_Handler,
+		},
+		{
+			MethodName: "DuplicatePage",
+			Handler:    _TsudzuriService_DuplicatePage_Handler,
+		},
 		{
 			MethodName: "JoinPage",
 			Handler:    _TsudzuriService_JoinPage_Handler,
 		},
+		{
+			MethodName: "GetPageInviteQRCode",
+			Handler:    _TsudzuriService_GetPageInviteQRCode_Handler,
+		},
+		{
+			MethodName: "GetPageInviteQRCodeSVG",
+			Handler:    _TsudzuriService_GetPageInviteQRCodeSVG_Handler,
+		},
+		{
+			MethodName: "ExportPage",
+			Handler:    _TsudzuriService_ExportPage_Handler,
+		},
+		{
+			MethodName: "CreateFeedToken",
+			Handler:    _TsudzuriService_CreateFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeFeedToken",
+			Handler:    _TsudzuriService_RevokeFeedToken_Handler,
+		},
+		{
+			MethodName: "CreateSection",
+			Handler:    _TsudzuriService_CreateSection_Handler,
+		},
+		{
+			MethodName: "RenameSection",
+			Handler:    _TsudzuriService_RenameSection_Handler,
+		},
+		{
+			MethodName: "ReorderSections",
+			Handler:    _TsudzuriService_ReorderSections_Handler,
+		},
+		{
+			MethodName: "DeleteSection",
+			Handler:    _TsudzuriService_DeleteSection_Handler,
+		},
+		{
+			MethodName: "MoveLinksToSection",
+			Handler:    _TsudzuriService_MoveLinksToSection_Handler,
+		},
+		{
+			MethodName: "ReactToLink",
+			Handler:    _TsudzuriService_ReactToLink_Handler,
+		},
+		{
+			MethodName: "RemoveLinkReaction",
+			Handler:    _TsudzuriService_RemoveLinkReaction_Handler,
+		},
+		{
+			MethodName: "MarkLink",
+			Handler:    _TsudzuriService_MarkLink_Handler,
+		},
+		{
+			MethodName: "MarkAllLinksRead",
+			Handler:    _TsudzuriService_MarkAllLinksRead_Handler,
+		},
+		{
+			MethodName: "SetChecklistMode",
+			Handler:    _TsudzuriService_SetChecklistMode_Handler,
+		},
+		{
+			MethodName: "ToggleLinkDone",
+			Handler:    _TsudzuriService_ToggleLinkDone_Handler,
+		},
+		{
+			MethodName: "AcceptLinkRedirect",
+			Handler:    _TsudzuriService_AcceptLinkRedirect_Handler,
+		},
+		{
+			MethodName: "ArchivePage",
+			Handler:    _TsudzuriService_ArchivePage_Handler,
+		},
+		{
+			MethodName: "UnarchivePage",
+			Handler:    _TsudzuriService_UnarchivePage_Handler,
+		},
+		{
+			MethodName: "PinPage",
+			Handler:    _TsudzuriService_PinPage_Handler,
+		},
+		{
+			MethodName: "UnpinPage",
+			Handler:    _TsudzuriService_UnpinPage_Handler,
+		},
+		{
+			MethodName: "ReorderMyPages",
+			Handler:    _TsudzuriService_ReorderMyPages_Handler,
+		},
+		{
+			MethodName: "SetInboxPage",
+			Handler:    _TsudzuriService_SetInboxPage_Handler,
+		},
+		{
+			MethodName: "CaptureLink",
+			Handler:    _TsudzuriService_CaptureLink_Handler,
+		},
+		{
+			MethodName: "SetPageSlug",
+			Handler:    _TsudzuriService_SetPageSlug_Handler,
+		},
+		{
+			MethodName: "ResolveSlug",
+			Handler:    _TsudzuriService_ResolveSlug_Handler,
+		},
+		{
+			MethodName: "SaveTemplate",
+			Handler:    _TsudzuriService_SaveTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _TsudzuriService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _TsudzuriService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _TsudzuriService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _TsudzuriService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _TsudzuriService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "CreateLinkSnapshot",
+			Handler:    _TsudzuriService_CreateLinkSnapshot_Handler,
+		},
+		{
+			MethodName: "ListLinkSnapshots",
+			Handler:    _TsudzuriService_ListLinkSnapshots_Handler,
+		},
+		{
+			MethodName: "GetLinkSnapshot",
+			Handler:    _TsudzuriService_GetLinkSnapshot_Handler,
+		},
+		{
+			MethodName: "GetPageStats",
+			Handler:    _TsudzuriService_GetPageStats_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _TsudzuriService_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _TsudzuriService_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _TsudzuriService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _TsudzuriService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _TsudzuriService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _TsudzuriService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _TsudzuriService_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _TsudzuriService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _TsudzuriService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "RegisterDevice",
+			Handler:    _TsudzuriService_RegisterDevice_Handler,
+		},
+		{
+			MethodName: "UnregisterDevice",
+			Handler:    _TsudzuriService_UnregisterDevice_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TsudzuriService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TsudzuriService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _TsudzuriService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TsudzuriService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _TsudzuriService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _TsudzuriService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _TsudzuriService_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _TsudzuriService_RevokeAccessToken_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _TsudzuriService_CreateUser_Handler,
@@ -478,6 +2747,10 @@ var TsudzuriService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _TsudzuriService_Get_Handler,
 		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _TsudzuriService_UpdateProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tsudzuri/v1/tsudzuri.proto",
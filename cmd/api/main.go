@@ -22,16 +22,33 @@ import (
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
 	"github.com/naka-sei/tsudzuri/config"
+	domainaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	domainpage "github.com/naka-sei/tsudzuri/domain/page"
 	domainuser "github.com/naka-sei/tsudzuri/domain/user"
 	"github.com/naka-sei/tsudzuri/infrastructure/api/firebase"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/linkcheck"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/push"
+	apiwebhook "github.com/naka-sei/tsudzuri/infrastructure/api/webhook"
+	accesstokenrepo "github.com/naka-sei/tsudzuri/infrastructure/db/accesstoken"
+	clickrepo "github.com/naka-sei/tsudzuri/infrastructure/db/click"
+	devicerepo "github.com/naka-sei/tsudzuri/infrastructure/db/device"
+	pagerepo "github.com/naka-sei/tsudzuri/infrastructure/db/page"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
 	userrepo "github.com/naka-sei/tsudzuri/infrastructure/db/user"
+	webhookrepo "github.com/naka-sei/tsudzuri/infrastructure/db/webhook"
 	"github.com/naka-sei/tsudzuri/pkg/cache"
 	authinterceptor "github.com/naka-sei/tsudzuri/pkg/grpc/interceptor/auth"
 	loggerinterceptor "github.com/naka-sei/tsudzuri/pkg/grpc/interceptor/logger"
+	sluginterceptor "github.com/naka-sei/tsudzuri/pkg/grpc/interceptor/slug"
 	gmiddleware "github.com/naka-sei/tsudzuri/pkg/grpc/middleware"
+	httpmiddleware "github.com/naka-sei/tsudzuri/pkg/http/middleware"
 	applog "github.com/naka-sei/tsudzuri/pkg/log"
 	presentationgrpc "github.com/naka-sei/tsudzuri/presentation/grpc"
+	presentationhttp "github.com/naka-sei/tsudzuri/presentation/http"
+	clickusecase "github.com/naka-sei/tsudzuri/usecase/click"
+	notificationusecase "github.com/naka-sei/tsudzuri/usecase/notification"
+	pageusecase "github.com/naka-sei/tsudzuri/usecase/page"
+	webhookusecase "github.com/naka-sei/tsudzuri/usecase/webhook"
 )
 
 const (
@@ -86,11 +103,32 @@ func main() {
 		}
 	}()
 
-	server, err := InitializePresentationServer(conn)
+	pushSender, err := push.NewSender(conf)
+	if err != nil {
+		sugar.Fatalf("failed to create push sender: %v", err)
+	}
+	pushWorker := notificationusecase.NewPushWorker(devicerepo.NewDeviceRepository(conn), pushSender)
+
+	webhookDispatcher := webhookusecase.NewDispatcher(
+		webhookrepo.NewWebhookRepository(conn),
+		webhookrepo.NewDeliveryRepository(conn),
+		apiwebhook.NewClient(),
+	)
+
+	linkChecker := pageusecase.NewLinkChecker(pagerepo.NewLinkHealthRepository(conn), linkcheck.NewClient())
+
+	clickWriter := clickusecase.NewClickWriter(clickrepo.NewClickRepository(conn))
+
+	server, err := InitializePresentationServer(conf, conn, pushWorker, webhookDispatcher)
 	if err != nil {
 		sugar.Fatalf("failed to initialize presentation server: %v", err)
 	}
 
+	httpHandlers, err := InitializeHTTPServer(conn, clickWriter)
+	if err != nil {
+		sugar.Fatalf("failed to initialize HTTP handlers: %v", err)
+	}
+
 	authenticator, err := firebase.NewClient(conf)
 	if err != nil {
 		sugar.Fatalf("failed to create firebase client: %v", err)
@@ -99,9 +137,12 @@ func main() {
 	userCache := cache.NewMemoryCache[*domainuser.User](userCacheTTL)
 
 	server.WithUserCache(userCache)
+	httpHandlers.WithAuthentication(httpmiddleware.NewOptionalAuthenticationMiddleware(authenticator, userRepo, userCache))
 
 	grpcAddr := fmt.Sprintf(":%d", conf.GRPCPort)
-	grpcServer, grpcListener, err := buildGRPCServer(grpcAddr, logger, conf, server, authenticator, userRepo, userCache)
+	accessTokenRepo := accesstokenrepo.NewAccessTokenRepository(conn)
+	slugRepo := pagerepo.NewSlugRepository(conn)
+	grpcServer, grpcListener, err := buildGRPCServer(grpcAddr, logger, conf, server, authenticator, userRepo, accessTokenRepo, slugRepo, userCache)
 	if err != nil {
 		sugar.Fatalf("failed to set up gRPC server: %v", err)
 	}
@@ -119,12 +160,15 @@ func main() {
 		sugar.Fatalf("failed to build HTTP gateway: %v", err)
 	}
 
-	httpServer := buildHTTPServer(conf, mux)
+	httpServer := buildHTTPServer(conf, logger, httpHandlers, mux)
 
 	signalCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	if err := runServers(signalCtx, sugar, grpcAddr, grpcServer, grpcListener, httpServer, gatewayCancel); err != nil {
+	// The background workers log through the context like request handlers do.
+	runCtx := applog.NewLoggerContext(signalCtx, logger, conf.GoogleCloudProject)
+
+	if err := runServers(runCtx, sugar, grpcAddr, grpcServer, grpcListener, httpServer, gatewayCancel, pushWorker, webhookDispatcher, linkChecker, clickWriter); err != nil {
 		sugar.Fatalf("server error: %v", err)
 	}
 
@@ -138,6 +182,8 @@ func buildGRPCServer(
 	server *presentationgrpc.Server,
 	authenticator firebase.Authenticator,
 	userRepo domainuser.UserRepository,
+	accessTokenRepo domainaccesstoken.AccessTokenRepository,
+	slugRepo domainpage.SlugRepository,
 	userCache cache.Cache[*domainuser.User],
 ) (*grpc.Server, net.Listener, error) {
 	listener, err := net.Listen("tcp", addr)
@@ -147,16 +193,33 @@ func buildGRPCServer(
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.ChainUnaryInterceptor(
-			loggerinterceptor.NewLoggerUnaryServerInterceptor(logger, conf.GoogleCloudProject),
-			authinterceptor.NewAuthenticationUnaryServerInterceptor(authenticator, userRepo, userCache),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors(logger, conf, authenticator, userRepo, accessTokenRepo, slugRepo, userCache)...),
 	)
 	tsudzuriv1.RegisterTsudzuriServiceServer(grpcServer, server)
 
 	return grpcServer, listener, nil
 }
 
+// unaryInterceptors returns the interceptors of the gRPC server in the order they run. Requests are authenticated
+// before slugs are resolved, so that unauthenticated callers cannot look slugs up, and the page scope of access
+// tokens is checked after, so that it applies to the resolved page IDs.
+func unaryInterceptors(
+	logger *zap.Logger,
+	conf *config.Config,
+	authenticator firebase.Authenticator,
+	userRepo domainuser.UserRepository,
+	accessTokenRepo domainaccesstoken.AccessTokenRepository,
+	slugRepo domainpage.SlugRepository,
+	userCache cache.Cache[*domainuser.User],
+) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		loggerinterceptor.NewLoggerUnaryServerInterceptor(logger, conf.GoogleCloudProject),
+		authinterceptor.NewAuthenticationUnaryServerInterceptor(authenticator, userRepo, accessTokenRepo, userCache),
+		sluginterceptor.NewSlugUnaryServerInterceptor(slugRepo),
+		authinterceptor.NewPageScopeUnaryServerInterceptor(),
+	}
+}
+
 func buildGatewayMux(ctx context.Context, conf *config.Config) (*runtime.ServeMux, error) {
 	opts := []runtime.ServeMuxOption{
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
@@ -188,7 +251,10 @@ func buildGatewayMux(ctx context.Context, conf *config.Config) (*runtime.ServeMu
 	return mux, nil
 }
 
-func buildHTTPServer(conf *config.Config, handler http.Handler) *http.Server {
+func buildHTTPServer(conf *config.Config, logger *zap.Logger, server *presentationhttp.Server, gateway http.Handler) *http.Server {
+	// Serve plain HTTP endpoints (e.g. feeds) next to the gateway routes.
+	handler := server.Handler(gateway)
+	handler = httpmiddleware.NewLoggerMiddleware(logger, conf.GoogleCloudProject)(handler)
 	// Wrap handler with a very simple, hard-coded CORS middleware.
 	handler = corsMiddleware(handler)
 
@@ -231,11 +297,19 @@ func runServers(
 	grpcListener net.Listener,
 	httpServer *http.Server,
 	gatewayCancel context.CancelFunc,
+	pushWorker *notificationusecase.PushWorker,
+	webhookDispatcher *webhookusecase.Dispatcher,
+	linkChecker *pageusecase.LinkChecker,
+	clickWriter *clickusecase.ClickWriter,
 ) error {
 	group, groupCtx := errgroup.WithContext(ctx)
 
 	runGRPCServer(group, sugar, grpcAddr, grpcServer, grpcListener)
 	runHTTPServer(group, sugar, httpServer)
+	runPushWorker(groupCtx, group, sugar, pushWorker)
+	runWebhookDispatcher(groupCtx, group, sugar, webhookDispatcher)
+	runLinkChecker(groupCtx, group, sugar, linkChecker)
+	runClickWriter(groupCtx, group, sugar, clickWriter)
 	group.Go(func() error {
 		<-groupCtx.Done()
 		shutdownServers(sugar, grpcServer, httpServer, gatewayCancel)
@@ -265,6 +339,38 @@ func runHTTPServer(group *errgroup.Group, sugar *zap.SugaredLogger, server *http
 	})
 }
 
+func runPushWorker(ctx context.Context, group *errgroup.Group, sugar *zap.SugaredLogger, worker *notificationusecase.PushWorker) {
+	group.Go(func() error {
+		sugar.Info("push worker starting")
+		worker.Run(ctx)
+		return nil
+	})
+}
+
+func runWebhookDispatcher(ctx context.Context, group *errgroup.Group, sugar *zap.SugaredLogger, dispatcher *webhookusecase.Dispatcher) {
+	group.Go(func() error {
+		sugar.Info("webhook dispatcher starting")
+		dispatcher.Run(ctx)
+		return nil
+	})
+}
+
+func runLinkChecker(ctx context.Context, group *errgroup.Group, sugar *zap.SugaredLogger, checker *pageusecase.LinkChecker) {
+	group.Go(func() error {
+		sugar.Info("link checker starting")
+		checker.Run(ctx)
+		return nil
+	})
+}
+
+func runClickWriter(ctx context.Context, group *errgroup.Group, sugar *zap.SugaredLogger, writer *clickusecase.ClickWriter) {
+	group.Go(func() error {
+		sugar.Info("click writer starting")
+		writer.Run(ctx)
+		return nil
+	})
+}
+
 func shutdownServers(
 	sugar *zap.SugaredLogger,
 	grpcServer *grpc.Server,
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	"github.com/naka-sei/tsudzuri/config"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	mockaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken/mock/mock_accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	mockuser "github.com/naka-sei/tsudzuri/domain/user/mock/mock_user"
+	mockauthenticator "github.com/naka-sei/tsudzuri/infrastructure/api/firebase/mock/mock_authenticator"
+)
+
+func TestUnaryInterceptors(t *testing.T) {
+	const (
+		pageID  = "0190c7e0-0000-7000-8000-000000000001"
+		otherID = "0190c7e0-0000-7000-8000-000000000002"
+	)
+	rawAccessToken := daccesstoken.TokenPrefix + "secret"
+	accessToken := daccesstoken.ReconstructAccessToken("token-1", "test-id", "bot", daccesstoken.HashToken(rawAccessToken), "hint",
+		daccesstoken.Scopes(), []string{pageID})
+	testUser := duser.ReconstructUser("test-id", "test-uid", "anonymous", nil)
+
+	slugs := map[string]string{"trip-2026": pageID, "other-trip": otherID}
+
+	type fields struct {
+		userRepo        *mockuser.MockUserRepository
+		accessTokenRepo *mockaccesstoken.MockAccessTokenRepository
+	}
+
+	tests := []struct {
+		name          string
+		setup         func(f *fields)
+		authorization string
+		pageID        string
+		want          codes.Code
+		wantLookups   []string
+	}{
+		{
+			name:   "no_token_with_existing_slug",
+			pageID: "trip-2026",
+			want:   codes.Unauthenticated,
+		},
+		{
+			name:   "no_token_with_unknown_slug",
+			pageID: "no-such-page",
+			want:   codes.Unauthenticated,
+		},
+		{
+			name: "page_scoped_access_token_with_slug_of_its_page",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), accessToken.TokenHash()).Return(accessToken, nil)
+				f.userRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(testUser, nil)
+				f.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(accessToken, nil).AnyTimes()
+			},
+			authorization: "Bearer " + rawAccessToken,
+			pageID:        "trip-2026",
+			// The request reaches the server, which implements nothing here.
+			want:        codes.Unimplemented,
+			wantLookups: []string{"trip-2026"},
+		},
+		{
+			name: "page_scoped_access_token_with_slug_of_other_page",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), accessToken.TokenHash()).Return(accessToken, nil)
+				f.userRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(testUser, nil)
+				f.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(accessToken, nil).AnyTimes()
+			},
+			authorization: "Bearer " + rawAccessToken,
+			pageID:        "other-trip",
+			want:          codes.PermissionDenied,
+			wantLookups:   []string{"other-trip"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			f := &fields{
+				userRepo:        mockuser.NewMockUserRepository(ctrl),
+				accessTokenRepo: mockaccesstoken.NewMockAccessTokenRepository(ctrl),
+			}
+			slugRepo := &fakeSlugRepository{slugs: slugs}
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			client := newTestClient(t, grpc.ChainUnaryInterceptor(unaryInterceptors(
+				zap.NewNop(), &config.Config{}, mockauthenticator.NewMockAuthenticator(ctrl), f.userRepo, f.accessTokenRepo, slugRepo, nil,
+			)...))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if tt.authorization != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tt.authorization)
+			}
+			_, err := client.GetPage(ctx, &tsudzuriv1.GetPageRequest{PageId: tt.pageID})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("expected code %v, got %v (%v)", tt.want, got, err)
+			}
+			if diff := cmp.Diff(tt.wantLookups, slugRepo.lookedUp()); diff != "" {
+				t.Fatalf("slug lookups mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// newTestClient serves an unimplemented service with the option over an in-memory connection and returns a client of it.
+func newTestClient(t *testing.T, opt grpc.ServerOption) tsudzuriv1.TsudzuriServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(opt)
+	tsudzuriv1.RegisterTsudzuriServiceServer(server, tsudzuriv1.UnimplementedTsudzuriServiceServer{})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial test server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return tsudzuriv1.NewTsudzuriServiceClient(conn)
+}
+
+// fakeSlugRepository resolves a fixed set of slugs and records the lookups.
+type fakeSlugRepository struct {
+	slugs   map[string]string
+	mu      sync.Mutex
+	lookups []string
+}
+
+func (r *fakeSlugRepository) Resolve(_ context.Context, slug string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lookups = append(r.lookups, slug)
+	return r.slugs[slug], nil
+}
+
+func (r *fakeSlugRepository) lookedUp() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lookups
+}
@@ -5,19 +5,59 @@ package main
 import (
 	"github.com/google/wire"
 
+	"github.com/naka-sei/tsudzuri/config"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/mail"
+	apisnapshot "github.com/naka-sei/tsudzuri/infrastructure/api/snapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/storage"
+	accesstokenrepo "github.com/naka-sei/tsudzuri/infrastructure/db/accesstoken"
+	clickrepo "github.com/naka-sei/tsudzuri/infrastructure/db/click"
+	commentrepo "github.com/naka-sei/tsudzuri/infrastructure/db/comment"
+	devicerepo "github.com/naka-sei/tsudzuri/infrastructure/db/device"
+	invitationrepo "github.com/naka-sei/tsudzuri/infrastructure/db/invitation"
+	notificationrepo "github.com/naka-sei/tsudzuri/infrastructure/db/notification"
 	pagerepo "github.com/naka-sei/tsudzuri/infrastructure/db/page"
 	ipostgres "github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	snapshotrepo "github.com/naka-sei/tsudzuri/infrastructure/db/snapshot"
+	templaterepo "github.com/naka-sei/tsudzuri/infrastructure/db/template"
 	userrepo "github.com/naka-sei/tsudzuri/infrastructure/db/user"
+	webhookrepo "github.com/naka-sei/tsudzuri/infrastructure/db/webhook"
+	"github.com/naka-sei/tsudzuri/pkg/signature"
 	presentationgrpc "github.com/naka-sei/tsudzuri/presentation/grpc"
+	grpcaccesstoken "github.com/naka-sei/tsudzuri/presentation/grpc/accesstoken"
+	grpcclick "github.com/naka-sei/tsudzuri/presentation/grpc/click"
+	grpccomment "github.com/naka-sei/tsudzuri/presentation/grpc/comment"
+	grpcdevice "github.com/naka-sei/tsudzuri/presentation/grpc/device"
+	grpcinvitation "github.com/naka-sei/tsudzuri/presentation/grpc/invitation"
+	grpcnotification "github.com/naka-sei/tsudzuri/presentation/grpc/notification"
 	grpcpage "github.com/naka-sei/tsudzuri/presentation/grpc/page"
+	grpcsnapshot "github.com/naka-sei/tsudzuri/presentation/grpc/snapshot"
+	grpctemplate "github.com/naka-sei/tsudzuri/presentation/grpc/template"
 	grpcuser "github.com/naka-sei/tsudzuri/presentation/grpc/user"
+	grpcwebhook "github.com/naka-sei/tsudzuri/presentation/grpc/webhook"
+	presentationhttp "github.com/naka-sei/tsudzuri/presentation/http"
+	httpfeed "github.com/naka-sei/tsudzuri/presentation/http/feed"
+	httpredirect "github.com/naka-sei/tsudzuri/presentation/http/redirect"
+	httpslug "github.com/naka-sei/tsudzuri/presentation/http/slug"
+	accesstokenusecase "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	clickusecase "github.com/naka-sei/tsudzuri/usecase/click"
+	commentusecase "github.com/naka-sei/tsudzuri/usecase/comment"
+	deviceusecase "github.com/naka-sei/tsudzuri/usecase/device"
+	invitationusecase "github.com/naka-sei/tsudzuri/usecase/invitation"
+	notificationusecase "github.com/naka-sei/tsudzuri/usecase/notification"
 	pageusecase "github.com/naka-sei/tsudzuri/usecase/page"
+	pageexport "github.com/naka-sei/tsudzuri/usecase/page/export"
 	useservice "github.com/naka-sei/tsudzuri/usecase/service"
+	snapshotusecase "github.com/naka-sei/tsudzuri/usecase/snapshot"
+	templateusecase "github.com/naka-sei/tsudzuri/usecase/template"
 	userusecase "github.com/naka-sei/tsudzuri/usecase/user"
+	webhookusecase "github.com/naka-sei/tsudzuri/usecase/webhook"
 )
 
 func InitializePresentationServer(
+	conf *config.Config,
 	dbConn *ipostgres.Connection,
+	pushService useservice.PushService,
+	webhookService useservice.WebhookService,
 ) (*presentationgrpc.Server, error) {
 	wire.Build(
 		presentationSet,
@@ -28,10 +68,38 @@ func InitializePresentationServer(
 	return nil, nil
 }
 
+func InitializeHTTPServer(
+	dbConn *ipostgres.Connection,
+	clickService useservice.ClickService,
+) (*presentationhttp.Server, error) {
+	wire.Build(
+		httpSet,
+		pageusecase.NewFeedUsecase,
+		clickusecase.NewRedirectUsecase,
+		pageusecase.NewSlugResolveUsecase,
+		pagerepo.NewPageRepository,
+		pagerepo.NewSlugRepository,
+		clickrepo.NewTargetRepository,
+	)
+	return nil, nil
+}
+
 func transactionServiceProvider(dbc *ipostgres.Connection) useservice.TransactionService {
 	return dbc
 }
 
+func tokenSignerProvider(conf *config.Config) (useservice.TokenSigner, error) {
+	return signature.NewSigner([]byte(conf.InvitationSigningKey))
+}
+
+func invitationAcceptURLProvider(conf *config.Config) invitationusecase.AcceptURL {
+	return invitationusecase.AcceptURL(conf.InvitationAcceptURL)
+}
+
+func joinURLProvider(conf *config.Config) pageusecase.JoinURL {
+	return pageusecase.JoinURL(conf.JoinURL)
+}
+
 var (
 	presentationSet = wire.NewSet(
 		grpcpage.NewCreateService,
@@ -42,11 +110,76 @@ var (
 		grpcpage.NewLinkAddService,
 		grpcpage.NewLinkRemoveService,
 		grpcpage.NewJoinService,
+		grpcpage.NewExportService,
+		grpcpage.NewInviteQRCodeService,
+		grpcpage.NewFeedTokenCreateService,
+		grpcpage.NewFeedTokenRevokeService,
+		grpcpage.NewLinkBatchAddService,
+		grpcpage.NewLinkBatchRemoveService,
+		grpcpage.NewLinkMoveService,
+		grpcpage.NewDuplicateService,
+		grpcpage.NewSectionCreateService,
+		grpcpage.NewSectionRenameService,
+		grpcpage.NewSectionReorderService,
+		grpcpage.NewSectionDeleteService,
+		grpcpage.NewLinkMoveSectionService,
+		grpcpage.NewLinkReactService,
+		grpcpage.NewLinkUnreactService,
+		grpcpage.NewLinkMarkService,
+		grpcpage.NewLinkMarkAllReadService,
+		grpcpage.NewChecklistSetService,
+		grpcpage.NewLinkToggleDoneService,
+		grpcpage.NewLinkRedirectAcceptService,
+		grpcpage.NewArchiveService,
+		grpcpage.NewUnarchiveService,
+		grpcpage.NewPinService,
+		grpcpage.NewInboxSetService,
+		grpcpage.NewCaptureService,
+		grpcpage.NewSlugSetService,
+		grpcpage.NewSlugResolveService,
+		grpcpage.NewUnpinService,
+		grpcpage.NewMyPagesReorderService,
+		grpctemplate.NewSaveService,
+		grpctemplate.NewListService,
+		grpccomment.NewAddService,
+		grpccomment.NewListService,
+		grpccomment.NewEditService,
+		grpccomment.NewDeleteService,
+		grpcsnapshot.NewCreateService,
+		grpcsnapshot.NewListService,
+		grpcsnapshot.NewGetService,
+		grpcclick.NewStatsService,
+		grpcinvitation.NewCreateService,
+		grpcinvitation.NewListService,
+		grpcinvitation.NewRevokeService,
+		grpcinvitation.NewAcceptService,
+		grpcnotification.NewListService,
+		grpcnotification.NewMarkReadService,
+		grpcnotification.NewUnreadCountService,
+		grpcnotification.NewPreferenceGetService,
+		grpcnotification.NewPreferenceUpdateService,
+		grpcdevice.NewRegisterService,
+		grpcdevice.NewUnregisterService,
+		grpcwebhook.NewCreateService,
+		grpcwebhook.NewListService,
+		grpcwebhook.NewUpdateService,
+		grpcwebhook.NewDeleteService,
+		grpcwebhook.NewDeliveryListService,
+		grpcaccesstoken.NewCreateService,
+		grpcaccesstoken.NewListService,
+		grpcaccesstoken.NewRevokeService,
 		grpcuser.NewCreateService,
 		grpcuser.NewLoginService,
 		grpcuser.NewGetService,
+		grpcuser.NewProfileUpdateService,
 		presentationgrpc.NewServer,
 	)
+	httpSet = wire.NewSet(
+		httpfeed.NewHandler,
+		httpredirect.NewHandler,
+		httpslug.NewHandler,
+		presentationhttp.NewServer,
+	)
 	usecaseSet = wire.NewSet(
 		pageusecase.NewCreateUsecase,
 		pageusecase.NewGetUsecase,
@@ -56,15 +189,97 @@ var (
 		pageusecase.NewLinkAddUsecase,
 		pageusecase.NewLinkRemoveUsecase,
 		pageusecase.NewJoinUsecase,
+		pageusecase.NewExportUsecase,
+		pageusecase.NewInviteQRCodeUsecase,
+		pageusecase.NewFeedTokenCreateUsecase,
+		pageusecase.NewFeedTokenRevokeUsecase,
+		pageusecase.NewLinkBatchAddUsecase,
+		pageusecase.NewLinkBatchRemoveUsecase,
+		pageusecase.NewLinkMoveUsecase,
+		pageusecase.NewDuplicateUsecase,
+		pageusecase.NewSectionCreateUsecase,
+		pageusecase.NewSectionRenameUsecase,
+		pageusecase.NewSectionReorderUsecase,
+		pageusecase.NewSectionDeleteUsecase,
+		pageusecase.NewLinkMoveSectionUsecase,
+		pageusecase.NewLinkReactUsecase,
+		pageusecase.NewLinkUnreactUsecase,
+		pageusecase.NewLinkMarkUsecase,
+		pageusecase.NewLinkMarkAllReadUsecase,
+		pageusecase.NewChecklistSetUsecase,
+		pageusecase.NewLinkToggleDoneUsecase,
+		pageusecase.NewLinkRedirectAcceptUsecase,
+		pageusecase.NewArchiveUsecase,
+		pageusecase.NewUnarchiveUsecase,
+		pageusecase.NewPinUsecase,
+		pageusecase.NewInboxSetUsecase,
+		pageusecase.NewCaptureUsecase,
+		pageusecase.NewSlugSetUsecase,
+		pageusecase.NewSlugResolveUsecase,
+		pageusecase.NewUnpinUsecase,
+		pageusecase.NewMyPagesReorderUsecase,
+		templateusecase.NewSaveUsecase,
+		templateusecase.NewListUsecase,
+		commentusecase.NewAddUsecase,
+		commentusecase.NewListUsecase,
+		commentusecase.NewEditUsecase,
+		commentusecase.NewDeleteUsecase,
+		snapshotusecase.NewCreateUsecase,
+		snapshotusecase.NewListUsecase,
+		snapshotusecase.NewGetUsecase,
+		clickusecase.NewStatsUsecase,
+		invitationusecase.NewCreateUsecase,
+		invitationusecase.NewListUsecase,
+		invitationusecase.NewRevokeUsecase,
+		invitationusecase.NewAcceptUsecase,
+		notificationusecase.NewListUsecase,
+		notificationusecase.NewMarkReadUsecase,
+		notificationusecase.NewUnreadCountUsecase,
+		notificationusecase.NewPreferenceGetUsecase,
+		notificationusecase.NewPreferenceUpdateUsecase,
+		deviceusecase.NewRegisterUsecase,
+		deviceusecase.NewUnregisterUsecase,
+		webhookusecase.NewCreateUsecase,
+		webhookusecase.NewListUsecase,
+		webhookusecase.NewUpdateUsecase,
+		webhookusecase.NewDeleteUsecase,
+		webhookusecase.NewDeliveryListUsecase,
+		accesstokenusecase.NewCreateUsecase,
+		accesstokenusecase.NewListUsecase,
+		accesstokenusecase.NewRevokeUsecase,
 		userusecase.NewCreateUsecase,
 		userusecase.NewLoginUsecase,
 		userusecase.NewGetUsecase,
+		userusecase.NewProfileUpdateUsecase,
 	)
 	repoSet = wire.NewSet(
 		pagerepo.NewPageRepository,
+		pagerepo.NewReactionRepository,
+		pagerepo.NewLinkStateRepository,
+		pagerepo.NewPreferenceRepository,
+		pagerepo.NewSlugRepository,
+		templaterepo.NewTemplateRepository,
+		commentrepo.NewCommentRepository,
+		snapshotrepo.NewSnapshotRepository,
+		clickrepo.NewClickRepository,
+		invitationrepo.NewInvitationRepository,
+		notificationrepo.NewNotificationRepository,
+		notificationrepo.NewPreferenceRepository,
+		devicerepo.NewDeviceRepository,
+		webhookrepo.NewWebhookRepository,
+		webhookrepo.NewDeliveryRepository,
+		accesstokenrepo.NewAccessTokenRepository,
 		userrepo.NewUserRepository,
 	)
 	serviceSet = wire.NewSet(
 		transactionServiceProvider,
+		tokenSignerProvider,
+		invitationAcceptURLProvider,
+		joinURLProvider,
+		mail.NewMailer,
+		apisnapshot.NewFetcher,
+		storage.NewBlobStorage,
+		notificationusecase.NewNotifier,
+		pageexport.NewDefaultRegistry,
 	)
 )
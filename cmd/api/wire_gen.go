@@ -8,38 +8,220 @@ package main
 
 import (
 	"github.com/google/wire"
+	"github.com/naka-sei/tsudzuri/config"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/mail"
+	snapshot2 "github.com/naka-sei/tsudzuri/infrastructure/api/snapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/storage"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/accesstoken"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/click"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/comment"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/device"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/invitation"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/notification"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/page"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/snapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/template"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/webhook"
+	"github.com/naka-sei/tsudzuri/pkg/signature"
 	presentationgrpc "github.com/naka-sei/tsudzuri/presentation/grpc"
+	accesstoken3 "github.com/naka-sei/tsudzuri/presentation/grpc/accesstoken"
+	click3 "github.com/naka-sei/tsudzuri/presentation/grpc/click"
+	comment3 "github.com/naka-sei/tsudzuri/presentation/grpc/comment"
+	device3 "github.com/naka-sei/tsudzuri/presentation/grpc/device"
+	invitation3 "github.com/naka-sei/tsudzuri/presentation/grpc/invitation"
+	notification3 "github.com/naka-sei/tsudzuri/presentation/grpc/notification"
 	page3 "github.com/naka-sei/tsudzuri/presentation/grpc/page"
+	snapshot4 "github.com/naka-sei/tsudzuri/presentation/grpc/snapshot"
+	template3 "github.com/naka-sei/tsudzuri/presentation/grpc/template"
 	user3 "github.com/naka-sei/tsudzuri/presentation/grpc/user"
+	webhook3 "github.com/naka-sei/tsudzuri/presentation/grpc/webhook"
+	presentationhttp "github.com/naka-sei/tsudzuri/presentation/http"
+	"github.com/naka-sei/tsudzuri/presentation/http/feed"
+	"github.com/naka-sei/tsudzuri/presentation/http/redirect"
+	"github.com/naka-sei/tsudzuri/presentation/http/slug"
+	accesstoken2 "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	click2 "github.com/naka-sei/tsudzuri/usecase/click"
+	comment2 "github.com/naka-sei/tsudzuri/usecase/comment"
+	device2 "github.com/naka-sei/tsudzuri/usecase/device"
+	invitation2 "github.com/naka-sei/tsudzuri/usecase/invitation"
+	notification2 "github.com/naka-sei/tsudzuri/usecase/notification"
 	page2 "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/page/export"
 	"github.com/naka-sei/tsudzuri/usecase/service"
+	snapshot3 "github.com/naka-sei/tsudzuri/usecase/snapshot"
+	template2 "github.com/naka-sei/tsudzuri/usecase/template"
 	user2 "github.com/naka-sei/tsudzuri/usecase/user"
+	webhook2 "github.com/naka-sei/tsudzuri/usecase/webhook"
 )
 
 // Injectors from wire.go:
 
-func InitializePresentationServer(dbConn *postgres.Connection) (*presentationgrpc.Server, error) {
+func InitializePresentationServer(conf *config.Config, dbConn *postgres.Connection, pushService service.PushService, webhookService service.WebhookService) (*presentationgrpc.Server, error) {
 	pageRepository := page.NewPageRepository(dbConn)
+	templateRepository := template.NewTemplateRepository(dbConn)
 	transactionService := transactionServiceProvider(dbConn)
-	createUsecase := page2.NewCreateUsecase(pageRepository, transactionService)
+	createUsecase := page2.NewCreateUsecase(pageRepository, templateRepository, transactionService)
 	createService := page3.NewCreateService(createUsecase)
 	getUsecase := page2.NewGetUsecase(pageRepository)
 	getService := page3.NewGetService(getUsecase)
-	listUsecase := page2.NewListUsecase(pageRepository)
+	preferenceRepository := page.NewPreferenceRepository(dbConn)
+	listUsecase := page2.NewListUsecase(pageRepository, preferenceRepository)
 	listService := page3.NewListService(listUsecase)
-	editUsecase := page2.NewEditUsecase(pageRepository, transactionService)
+	editUsecase := page2.NewEditUsecase(pageRepository, transactionService, webhookService)
 	editService := page3.NewEditService(editUsecase)
-	deleteUsecase := page2.NewDeleteUsecase(pageRepository, transactionService)
+	deleteUsecase := page2.NewDeleteUsecase(pageRepository, transactionService, webhookService)
 	deleteService := page3.NewDeleteService(deleteUsecase)
-	linkAddUseCase := page2.NewLinkAddUsecase(pageRepository, transactionService)
+	notificationRepository := notification.NewNotificationRepository(dbConn)
+	notificationPreferenceRepository := notification.NewPreferenceRepository(dbConn)
+	notificationService := notification2.NewNotifier(notificationRepository, notificationPreferenceRepository, pushService)
+	linkAddUseCase := page2.NewLinkAddUsecase(pageRepository, transactionService, notificationService, webhookService)
 	linkAddService := page3.NewLinkAddService(linkAddUseCase)
-	linkRemoveUseCase := page2.NewLinkRemoveUsecase(pageRepository, transactionService)
+	linkRemoveUseCase := page2.NewLinkRemoveUsecase(pageRepository, transactionService, webhookService)
 	linkRemoveService := page3.NewLinkRemoveService(linkRemoveUseCase)
-	joinUsecase := page2.NewJoinUsecase(pageRepository, transactionService)
+	joinUsecase := page2.NewJoinUsecase(pageRepository, transactionService, notificationService, webhookService)
 	joinService := page3.NewJoinService(joinUsecase)
+	registry := export.NewDefaultRegistry()
+	exportUsecase := page2.NewExportUsecase(pageRepository, registry)
+	exportService := page3.NewExportService(exportUsecase)
+	joinURL := joinURLProvider(conf)
+	inviteQRCodeUsecase := page2.NewInviteQRCodeUsecase(pageRepository, joinURL)
+	inviteQRCodeService := page3.NewInviteQRCodeService(inviteQRCodeUsecase)
+	feedTokenCreateUsecase := page2.NewFeedTokenCreateUsecase(pageRepository, transactionService)
+	feedTokenCreateService := page3.NewFeedTokenCreateService(feedTokenCreateUsecase)
+	feedTokenRevokeUsecase := page2.NewFeedTokenRevokeUsecase(pageRepository, transactionService)
+	feedTokenRevokeService := page3.NewFeedTokenRevokeService(feedTokenRevokeUsecase)
+	linkBatchAddUsecase := page2.NewLinkBatchAddUsecase(pageRepository, transactionService, notificationService, webhookService)
+	linkBatchAddService := page3.NewLinkBatchAddService(linkBatchAddUsecase)
+	linkBatchRemoveUsecase := page2.NewLinkBatchRemoveUsecase(pageRepository, transactionService, webhookService)
+	linkBatchRemoveService := page3.NewLinkBatchRemoveService(linkBatchRemoveUsecase)
+	linkMoveUsecase := page2.NewLinkMoveUsecase(pageRepository, transactionService)
+	linkMoveService := page3.NewLinkMoveService(linkMoveUsecase)
+	duplicateUsecase := page2.NewDuplicateUsecase(pageRepository, transactionService)
+	duplicateService := page3.NewDuplicateService(duplicateUsecase)
+	sectionCreateUsecase := page2.NewSectionCreateUsecase(pageRepository, transactionService)
+	sectionCreateService := page3.NewSectionCreateService(sectionCreateUsecase)
+	sectionRenameUsecase := page2.NewSectionRenameUsecase(pageRepository, transactionService)
+	sectionRenameService := page3.NewSectionRenameService(sectionRenameUsecase)
+	sectionReorderUsecase := page2.NewSectionReorderUsecase(pageRepository, transactionService)
+	sectionReorderService := page3.NewSectionReorderService(sectionReorderUsecase)
+	sectionDeleteUsecase := page2.NewSectionDeleteUsecase(pageRepository, transactionService)
+	sectionDeleteService := page3.NewSectionDeleteService(sectionDeleteUsecase)
+	linkMoveSectionUsecase := page2.NewLinkMoveSectionUsecase(pageRepository, transactionService)
+	linkMoveSectionService := page3.NewLinkMoveSectionService(linkMoveSectionUsecase)
+	reactionRepository := page.NewReactionRepository(dbConn)
+	linkReactUsecase := page2.NewLinkReactUsecase(pageRepository, reactionRepository, transactionService)
+	linkReactService := page3.NewLinkReactService(linkReactUsecase)
+	linkUnreactUsecase := page2.NewLinkUnreactUsecase(pageRepository, reactionRepository, transactionService)
+	linkUnreactService := page3.NewLinkUnreactService(linkUnreactUsecase)
+	linkStateRepository := page.NewLinkStateRepository(dbConn)
+	linkMarkUsecase := page2.NewLinkMarkUsecase(pageRepository, linkStateRepository, transactionService)
+	linkMarkService := page3.NewLinkMarkService(linkMarkUsecase)
+	linkMarkAllReadUsecase := page2.NewLinkMarkAllReadUsecase(pageRepository, linkStateRepository, transactionService)
+	linkMarkAllReadService := page3.NewLinkMarkAllReadService(linkMarkAllReadUsecase)
+	checklistSetUsecase := page2.NewChecklistSetUsecase(pageRepository, transactionService)
+	checklistSetService := page3.NewChecklistSetService(checklistSetUsecase)
+	linkToggleDoneUsecase := page2.NewLinkToggleDoneUsecase(pageRepository, transactionService)
+	linkToggleDoneService := page3.NewLinkToggleDoneService(linkToggleDoneUsecase)
+	linkRedirectAcceptUsecase := page2.NewLinkRedirectAcceptUsecase(pageRepository, transactionService)
+	linkRedirectAcceptService := page3.NewLinkRedirectAcceptService(linkRedirectAcceptUsecase)
+	archiveUsecase := page2.NewArchiveUsecase(pageRepository, transactionService, notificationService)
+	archiveService := page3.NewArchiveService(archiveUsecase)
+	unarchiveUsecase := page2.NewUnarchiveUsecase(pageRepository, transactionService)
+	unarchiveService := page3.NewUnarchiveService(unarchiveUsecase)
+	pinUsecase := page2.NewPinUsecase(pageRepository, preferenceRepository, transactionService)
+	pinService := page3.NewPinService(pinUsecase)
+	unpinUsecase := page2.NewUnpinUsecase(preferenceRepository, transactionService)
+	unpinService := page3.NewUnpinService(unpinUsecase)
+	myPagesReorderUsecase := page2.NewMyPagesReorderUsecase(pageRepository, preferenceRepository, transactionService)
+	myPagesReorderService := page3.NewMyPagesReorderService(myPagesReorderUsecase)
+	inboxSetUsecase := page2.NewInboxSetUsecase(pageRepository, preferenceRepository, transactionService)
+	inboxSetService := page3.NewInboxSetService(inboxSetUsecase)
+	captureUsecase := page2.NewCaptureUsecase(pageRepository, preferenceRepository, transactionService, notificationService, webhookService)
+	captureService := page3.NewCaptureService(captureUsecase)
+	slugRepository := page.NewSlugRepository(dbConn)
+	slugSetUsecase := page2.NewSlugSetUsecase(pageRepository, slugRepository, transactionService)
+	slugSetService := page3.NewSlugSetService(slugSetUsecase)
+	slugResolveUsecase := page2.NewSlugResolveUsecase(pageRepository, slugRepository)
+	slugResolveService := page3.NewSlugResolveService(slugResolveUsecase)
+	saveUsecase := template2.NewSaveUsecase(pageRepository, templateRepository, transactionService)
+	saveService := template3.NewSaveService(saveUsecase)
+	templateListUsecase := template2.NewListUsecase(templateRepository)
+	templateListService := template3.NewListService(templateListUsecase)
+	commentRepository := comment.NewCommentRepository(dbConn)
+	addUsecase := comment2.NewAddUsecase(pageRepository, commentRepository, transactionService)
+	addService := comment3.NewAddService(addUsecase)
+	commentListUsecase := comment2.NewListUsecase(pageRepository, commentRepository)
+	commentListService := comment3.NewListService(commentListUsecase)
+	commentEditUsecase := comment2.NewEditUsecase(pageRepository, commentRepository, transactionService)
+	commentEditService := comment3.NewEditService(commentEditUsecase)
+	commentDeleteUsecase := comment2.NewDeleteUsecase(pageRepository, commentRepository, transactionService)
+	commentDeleteService := comment3.NewDeleteService(commentDeleteUsecase)
+	snapshotRepository := snapshot.NewSnapshotRepository(dbConn)
+	snapshotFetcher := snapshot2.NewFetcher()
+	blobStorage, err := storage.NewBlobStorage(conf)
+	if err != nil {
+		return nil, err
+	}
+	snapshotCreateUsecase := snapshot3.NewCreateUsecase(pageRepository, snapshotRepository, transactionService, snapshotFetcher, blobStorage)
+	snapshotCreateService := snapshot4.NewCreateService(snapshotCreateUsecase)
+	snapshotListUsecase := snapshot3.NewListUsecase(pageRepository, snapshotRepository)
+	snapshotListService := snapshot4.NewListService(snapshotListUsecase)
+	snapshotGetUsecase := snapshot3.NewGetUsecase(pageRepository, snapshotRepository, blobStorage)
+	snapshotGetService := snapshot4.NewGetService(snapshotGetUsecase)
+	clickRepository := click.NewClickRepository(dbConn)
+	statsUsecase := click2.NewStatsUsecase(pageRepository, clickRepository)
+	statsService := click3.NewStatsService(statsUsecase)
+	invitationRepository := invitation.NewInvitationRepository(dbConn)
+	mailService := mail.NewMailer(conf)
+	tokenSigner, err := tokenSignerProvider(conf)
+	if err != nil {
+		return nil, err
+	}
+	acceptURL := invitationAcceptURLProvider(conf)
+	invitationCreateUsecase := invitation2.NewCreateUsecase(pageRepository, invitationRepository, transactionService, mailService, tokenSigner, acceptURL)
+	invitationCreateService := invitation3.NewCreateService(invitationCreateUsecase)
+	invitationListUsecase := invitation2.NewListUsecase(pageRepository, invitationRepository)
+	invitationListService := invitation3.NewListService(invitationListUsecase)
+	revokeUsecase := invitation2.NewRevokeUsecase(pageRepository, invitationRepository, transactionService)
+	revokeService := invitation3.NewRevokeService(revokeUsecase)
+	acceptUsecase := invitation2.NewAcceptUsecase(pageRepository, invitationRepository, transactionService, tokenSigner, notificationService, webhookService)
+	acceptService := invitation3.NewAcceptService(acceptUsecase)
+	notificationListUsecase := notification2.NewListUsecase(notificationRepository)
+	notificationListService := notification3.NewListService(notificationListUsecase)
+	markReadUsecase := notification2.NewMarkReadUsecase(notificationRepository, transactionService)
+	markReadService := notification3.NewMarkReadService(markReadUsecase)
+	unreadCountUsecase := notification2.NewUnreadCountUsecase(notificationRepository)
+	unreadCountService := notification3.NewUnreadCountService(unreadCountUsecase)
+	preferenceGetUsecase := notification2.NewPreferenceGetUsecase(notificationPreferenceRepository)
+	preferenceGetService := notification3.NewPreferenceGetService(preferenceGetUsecase)
+	preferenceUpdateUsecase := notification2.NewPreferenceUpdateUsecase(notificationPreferenceRepository, transactionService)
+	preferenceUpdateService := notification3.NewPreferenceUpdateService(preferenceUpdateUsecase)
+	deviceRepository := device.NewDeviceRepository(dbConn)
+	registerUsecase := device2.NewRegisterUsecase(deviceRepository, transactionService)
+	registerService := device3.NewRegisterService(registerUsecase)
+	unregisterUsecase := device2.NewUnregisterUsecase(deviceRepository)
+	unregisterService := device3.NewUnregisterService(unregisterUsecase)
+	webhookRepository := webhook.NewWebhookRepository(dbConn)
+	webhookCreateUsecase := webhook2.NewCreateUsecase(pageRepository, webhookRepository, transactionService)
+	webhookCreateService := webhook3.NewCreateService(webhookCreateUsecase)
+	webhookListUsecase := webhook2.NewListUsecase(pageRepository, webhookRepository)
+	webhookListService := webhook3.NewListService(webhookListUsecase)
+	updateUsecase := webhook2.NewUpdateUsecase(pageRepository, webhookRepository, transactionService)
+	updateService := webhook3.NewUpdateService(updateUsecase)
+	webhookDeleteUsecase := webhook2.NewDeleteUsecase(pageRepository, webhookRepository, transactionService)
+	webhookDeleteService := webhook3.NewDeleteService(webhookDeleteUsecase)
+	deliveryRepository := webhook.NewDeliveryRepository(dbConn)
+	deliveryListUsecase := webhook2.NewDeliveryListUsecase(pageRepository, webhookRepository, deliveryRepository)
+	deliveryListService := webhook3.NewDeliveryListService(deliveryListUsecase)
+	accessTokenRepository := accesstoken.NewAccessTokenRepository(dbConn)
+	accesstokenCreateUsecase := accesstoken2.NewCreateUsecase(pageRepository, accessTokenRepository, transactionService)
+	accesstokenCreateService := accesstoken3.NewCreateService(accesstokenCreateUsecase)
+	accesstokenListUsecase := accesstoken2.NewListUsecase(accessTokenRepository)
+	accesstokenListService := accesstoken3.NewListService(accesstokenListUsecase)
+	accesstokenRevokeUsecase := accesstoken2.NewRevokeUsecase(accessTokenRepository, transactionService)
+	accesstokenRevokeService := accesstoken3.NewRevokeService(accesstokenRevokeUsecase)
 	userRepository := user.NewUserRepository(dbConn)
 	userCreateUsecase := user2.NewCreateUsecase(userRepository, transactionService)
 	userCreateService := user3.NewCreateService(userCreateUsecase)
@@ -47,7 +229,23 @@ func InitializePresentationServer(dbConn *postgres.Connection) (*presentationgrp
 	loginService := user3.NewLoginService(loginUsecase)
 	userGetUsecase := user2.NewGetUsecase(userRepository)
 	userGetService := user3.NewGetService(userGetUsecase)
-	server := presentationgrpc.NewServer(createService, getService, listService, editService, deleteService, linkAddService, linkRemoveService, joinService, userCreateService, loginService, userGetService)
+	profileUpdateUsecase := user2.NewProfileUpdateUsecase(userRepository, transactionService)
+	profileUpdateService := user3.NewProfileUpdateService(profileUpdateUsecase)
+	server := presentationgrpc.NewServer(createService, getService, listService, editService, deleteService, linkAddService, linkRemoveService, joinService, exportService, inviteQRCodeService, feedTokenCreateService, feedTokenRevokeService, linkBatchAddService, linkBatchRemoveService, linkMoveService, duplicateService, sectionCreateService, sectionRenameService, sectionReorderService, sectionDeleteService, linkMoveSectionService, linkReactService, linkUnreactService, linkMarkService, linkMarkAllReadService, checklistSetService, linkToggleDoneService, linkRedirectAcceptService, archiveService, unarchiveService, pinService, unpinService, myPagesReorderService, inboxSetService, captureService, slugSetService, slugResolveService, saveService, templateListService, addService, commentListService, commentEditService, commentDeleteService, snapshotCreateService, snapshotListService, snapshotGetService, statsService, invitationCreateService, invitationListService, revokeService, acceptService, notificationListService, markReadService, unreadCountService, preferenceGetService, preferenceUpdateService, registerService, unregisterService, webhookCreateService, webhookListService, updateService, webhookDeleteService, deliveryListService, accesstokenCreateService, accesstokenListService, accesstokenRevokeService, userCreateService, loginService, userGetService, profileUpdateService)
+	return server, nil
+}
+
+func InitializeHTTPServer(dbConn *postgres.Connection, clickService service.ClickService) (*presentationhttp.Server, error) {
+	pageRepository := page.NewPageRepository(dbConn)
+	feedUsecase := page2.NewFeedUsecase(pageRepository)
+	handler := feed.NewHandler(feedUsecase)
+	targetRepository := click.NewTargetRepository(dbConn)
+	redirectUsecase := click2.NewRedirectUsecase(targetRepository, clickService)
+	redirectHandler := redirect.NewHandler(redirectUsecase)
+	slugRepository := page.NewSlugRepository(dbConn)
+	slugResolveUsecase := page2.NewSlugResolveUsecase(pageRepository, slugRepository)
+	slugHandler := slug.NewHandler(slugResolveUsecase)
+	server := presentationhttp.NewServer(handler, redirectHandler, slugHandler)
 	return server, nil
 }
 
@@ -57,11 +255,27 @@ func transactionServiceProvider(dbc *postgres.Connection) service.TransactionSer
 	return dbc
 }
 
+func tokenSignerProvider(conf *config.Config) (service.TokenSigner, error) {
+	return signature.NewSigner([]byte(conf.InvitationSigningKey))
+}
+
+func invitationAcceptURLProvider(conf *config.Config) invitation2.AcceptURL {
+	return invitation2.AcceptURL(conf.InvitationAcceptURL)
+}
+
+func joinURLProvider(conf *config.Config) page2.JoinURL {
+	return page2.JoinURL(conf.JoinURL)
+}
+
 var (
-	presentationSet = wire.NewSet(page3.NewCreateService, page3.NewGetService, page3.NewListService, page3.NewEditService, page3.NewDeleteService, page3.NewLinkAddService, page3.NewLinkRemoveService, page3.NewJoinService, user3.NewCreateService, user3.NewLoginService, user3.NewGetService, presentationgrpc.NewServer)
-	usecaseSet      = wire.NewSet(page2.NewCreateUsecase, page2.NewGetUsecase, page2.NewListUsecase, page2.NewEditUsecase, page2.NewDeleteUsecase, page2.NewLinkAddUsecase, page2.NewLinkRemoveUsecase, page2.NewJoinUsecase, user2.NewCreateUsecase, user2.NewLoginUsecase, user2.NewGetUsecase)
-	repoSet         = wire.NewSet(page.NewPageRepository, user.NewUserRepository)
+	presentationSet = wire.NewSet(page3.NewCreateService, page3.NewGetService, page3.NewListService, page3.NewEditService, page3.NewDeleteService, page3.NewLinkAddService, page3.NewLinkRemoveService, page3.NewJoinService, page3.NewExportService, page3.NewInviteQRCodeService, page3.NewFeedTokenCreateService, page3.NewFeedTokenRevokeService, page3.NewLinkBatchAddService, page3.NewLinkBatchRemoveService, page3.NewLinkMoveService, page3.NewDuplicateService, page3.NewSectionCreateService, page3.NewSectionRenameService, page3.NewSectionReorderService, page3.NewSectionDeleteService, page3.NewLinkMoveSectionService, page3.NewLinkReactService, page3.NewLinkUnreactService, page3.NewLinkMarkService, page3.NewLinkMarkAllReadService, page3.NewChecklistSetService, page3.NewLinkToggleDoneService, page3.NewLinkRedirectAcceptService, page3.NewArchiveService, page3.NewUnarchiveService, page3.NewPinService, page3.NewInboxSetService, page3.NewCaptureService, page3.NewSlugSetService, page3.NewSlugResolveService, page3.NewUnpinService, page3.NewMyPagesReorderService, template3.NewSaveService, template3.NewListService, comment3.NewAddService, comment3.NewListService, comment3.NewEditService, comment3.NewDeleteService, snapshot4.NewCreateService, snapshot4.NewListService, snapshot4.NewGetService, click3.NewStatsService, invitation3.NewCreateService, invitation3.NewListService, invitation3.NewRevokeService, invitation3.NewAcceptService, notification3.NewListService, notification3.NewMarkReadService, notification3.NewUnreadCountService, notification3.NewPreferenceGetService, notification3.NewPreferenceUpdateService, device3.NewRegisterService, device3.NewUnregisterService, webhook3.NewCreateService, webhook3.NewListService, webhook3.NewUpdateService, webhook3.NewDeleteService, webhook3.NewDeliveryListService, accesstoken3.NewCreateService, accesstoken3.NewListService, accesstoken3.NewRevokeService, user3.NewCreateService, user3.NewLoginService, user3.NewGetService, user3.NewProfileUpdateService, presentationgrpc.NewServer)
+	httpSet         = wire.NewSet(feed.NewHandler, redirect.NewHandler, slug.NewHandler, presentationhttp.NewServer)
+	usecaseSet      = wire.NewSet(page2.NewCreateUsecase, page2.NewGetUsecase, page2.NewListUsecase, page2.NewEditUsecase, page2.NewDeleteUsecase, page2.NewLinkAddUsecase, page2.NewLinkRemoveUsecase, page2.NewJoinUsecase, page2.NewExportUsecase, page2.NewInviteQRCodeUsecase, page2.NewFeedTokenCreateUsecase, page2.NewFeedTokenRevokeUsecase, page2.NewLinkBatchAddUsecase, page2.NewLinkBatchRemoveUsecase, page2.NewLinkMoveUsecase, page2.NewDuplicateUsecase, page2.NewSectionCreateUsecase, page2.NewSectionRenameUsecase, page2.NewSectionReorderUsecase, page2.NewSectionDeleteUsecase, page2.NewLinkMoveSectionUsecase, page2.NewLinkReactUsecase, page2.NewLinkUnreactUsecase, page2.NewLinkMarkUsecase, page2.NewLinkMarkAllReadUsecase, page2.NewChecklistSetUsecase, page2.NewLinkToggleDoneUsecase, page2.NewLinkRedirectAcceptUsecase, page2.NewArchiveUsecase, page2.NewUnarchiveUsecase, page2.NewPinUsecase, page2.NewInboxSetUsecase, page2.NewCaptureUsecase, page2.NewSlugSetUsecase, page2.NewSlugResolveUsecase, page2.NewUnpinUsecase, page2.NewMyPagesReorderUsecase, template2.NewSaveUsecase, template2.NewListUsecase, comment2.NewAddUsecase, comment2.NewListUsecase, comment2.NewEditUsecase, comment2.NewDeleteUsecase, snapshot3.NewCreateUsecase, snapshot3.NewListUsecase, snapshot3.NewGetUsecase, click2.NewStatsUsecase, invitation2.NewCreateUsecase, invitation2.NewListUsecase, invitation2.NewRevokeUsecase, invitation2.NewAcceptUsecase, notification2.NewListUsecase, notification2.NewMarkReadUsecase, notification2.NewUnreadCountUsecase, notification2.NewPreferenceGetUsecase, notification2.NewPreferenceUpdateUsecase, device2.NewRegisterUsecase, device2.NewUnregisterUsecase, webhook2.NewCreateUsecase, webhook2.NewListUsecase, webhook2.NewUpdateUsecase, webhook2.NewDeleteUsecase, webhook2.NewDeliveryListUsecase, accesstoken2.NewCreateUsecase, accesstoken2.NewListUsecase, accesstoken2.NewRevokeUsecase, user2.NewCreateUsecase, user2.NewLoginUsecase, user2.NewGetUsecase, user2.NewProfileUpdateUsecase)
+	repoSet         = wire.NewSet(page.NewPageRepository, page.NewReactionRepository, page.NewLinkStateRepository, page.NewPreferenceRepository, page.NewSlugRepository, template.NewTemplateRepository, comment.NewCommentRepository, snapshot.NewSnapshotRepository, click.NewClickRepository, invitation.NewInvitationRepository, notification.NewNotificationRepository, notification.NewPreferenceRepository, device.NewDeviceRepository, webhook.NewWebhookRepository, webhook.NewDeliveryRepository, accesstoken.NewAccessTokenRepository, user.NewUserRepository)
 	serviceSet      = wire.NewSet(
 		transactionServiceProvider,
+		tokenSignerProvider,
+		invitationAcceptURLProvider,
+		joinURLProvider, mail.NewMailer, snapshot2.NewFetcher, storage.NewBlobStorage, notification2.NewNotifier, export.NewDefaultRegistry,
 	)
 )
@@ -35,6 +35,38 @@ type Config struct {
 
 	// TsudzuriDatabaseDSN is the Tsudzuri database DSN.
 	TsudzuriDatabaseDSN string `envconfig:"TSUDZURI_DATABASE_DSN"`
+
+	// SMTPHost is the host of the SMTP server mails are sent through. Mails are only logged when it is empty.
+	SMTPHost string `envconfig:"SMTP_HOST"`
+
+	// SMTPPort is the port of the SMTP server.
+	SMTPPort uint `envconfig:"SMTP_PORT" default:"587"`
+
+	// SMTPUsername is the username for SMTP authentication. Authentication is skipped when it is empty.
+	SMTPUsername string `envconfig:"SMTP_USERNAME"`
+
+	// SMTPPassword is the password for SMTP authentication.
+	SMTPPassword string `envconfig:"SMTP_PASSWORD"`
+
+	// MailFrom is the sender address of the mails.
+	MailFrom string `envconfig:"MAIL_FROM" default:"no-reply@tsudzuri.app"`
+
+	// InvitationSigningKey is the key invitation links are signed with. A random key is used when it is empty,
+	// which makes links sent before a restart invalid.
+	InvitationSigningKey string `envconfig:"INVITATION_SIGNING_KEY"`
+
+	// InvitationAcceptURL is the page of the web app that accepts invitations. The token is added as the "token" query parameter.
+	InvitationAcceptURL string `envconfig:"INVITATION_ACCEPT_URL" default:"http://localhost:3000/invitations/accept"`
+
+	// JoinURL is the page of the web app that joins a page, encoded in invite QR codes.
+	// The page ID and the invite code are added as the "page_id" and "invite_code" query parameters.
+	JoinURL string `envconfig:"JOIN_URL" default:"http://localhost:3000/join"`
+
+	// SnapshotBucket is the Cloud Storage bucket link snapshots are stored in. They are stored under SnapshotDir when it is empty.
+	SnapshotBucket string `envconfig:"SNAPSHOT_BUCKET"`
+
+	// SnapshotDir is the local directory link snapshots are stored in when no bucket is configured, meant for local development.
+	SnapshotDir string `envconfig:"SNAPSHOT_DIR" default:"data/snapshots"`
 }
 
 // Load loads the configuration.
@@ -0,0 +1,320 @@
+package accesstoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+const (
+	// TokenPrefix starts every personal access token, which tells them apart from Firebase ID tokens.
+	TokenPrefix = "tsz_pat_"
+	// MaxNameLength is the maximum number of characters in the name of an access token.
+	MaxNameLength = 100
+	// MaxAccessTokensPerUser is the maximum number of usable access tokens of a user.
+	MaxAccessTokensPerUser = 20
+	// MaxPagesPerAccessToken is the maximum number of pages an access token can be restricted to.
+	MaxPagesPerAccessToken = 20
+	// LastUsedInterval is how often the last use of an access token is recorded.
+	LastUsedInterval = time.Minute
+
+	hintLength = 4
+	tokenBytes = 32
+)
+
+// Scope is a kind of request an access token allows.
+type Scope string
+
+const (
+	// ScopeRead allows requests that only read, such as GetPage and ListPages.
+	ScopeRead Scope = "read"
+	// ScopeWrite allows requests that change data, such as AddLink. It implies ScopeRead.
+	ScopeWrite Scope = "write"
+)
+
+// Scopes returns every scope in a fixed order.
+func Scopes() []Scope {
+	return []Scope{ScopeRead, ScopeWrite}
+}
+
+// Valid reports whether the scope is known.
+func (s Scope) Valid() bool {
+	return slices.Contains(Scopes(), s)
+}
+
+// AccessToken is a personal access token a user creates for scripts and integrations. It authenticates
+// requests as the user like an ID token does, within its scopes and pages. Only the hash of the token is kept.
+type AccessToken struct {
+	id         string
+	userID     string
+	name       string
+	tokenHash  string
+	hint       string
+	scopes     []Scope
+	pageIDs    []string
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+	createdAt  time.Time
+}
+
+// NewAccessToken creates an access token of the user and returns it with the plain token, which is not kept.
+// Empty scopes allow reading and writing. When pages are given, the token only allows requests to those pages,
+// each of which the user must have access to. A nil expiry makes the token valid until it is revoked.
+func NewAccessToken(
+	user *duser.User,
+	name string,
+	scopes []Scope,
+	pages []*dpage.Page,
+	expiresAt *time.Time,
+	now time.Time,
+) (*AccessToken, string, error) {
+	if user == nil {
+		return nil, "", duser.ErrUserNotFound
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return nil, "", ErrInvalidName
+	}
+	normalizedScopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrInvalidExpiry
+	}
+	if len(pages) > MaxPagesPerAccessToken {
+		return nil, "", ErrInvalidScope
+	}
+	pageIDs := make([]string, 0, len(pages))
+	for _, page := range pages {
+		if page == nil {
+			return nil, "", dpage.ErrNoPageProvided
+		}
+		if err := page.Authorize(user); err != nil {
+			return nil, "", err
+		}
+		if !slices.Contains(pageIDs, page.ID()) {
+			pageIDs = append(pageIDs, page.ID())
+		}
+	}
+
+	token, err := tokenGenerator()
+	if err != nil {
+		return nil, "", fmt.Errorf("generate access token: %w", err)
+	}
+
+	return &AccessToken{
+		userID:    user.ID(),
+		name:      name,
+		tokenHash: HashToken(token),
+		hint:      token[:len(TokenPrefix)+hintLength],
+		scopes:    normalizedScopes,
+		pageIDs:   pageIDs,
+		expiresAt: expiresAt,
+		createdAt: now,
+	}, token, nil
+}
+
+// ReconstructAccessToken reconstructs an AccessToken from stored data.
+func ReconstructAccessToken(
+	id string,
+	userID string,
+	name string,
+	tokenHash string,
+	hint string,
+	scopes []Scope,
+	pageIDs []string,
+	options ...ReconstructOption,
+) *AccessToken {
+	t := &AccessToken{
+		id:        id,
+		userID:    userID,
+		name:      name,
+		tokenHash: tokenHash,
+		hint:      hint,
+		scopes:    scopes,
+		pageIDs:   pageIDs,
+	}
+	for _, opt := range options {
+		opt(t)
+	}
+	return t
+}
+
+// ReconstructOption sets optional fields when reconstructing an AccessToken.
+type ReconstructOption func(*AccessToken)
+
+// WithExpiresAt sets when the access token expires.
+func WithExpiresAt(expiresAt *time.Time) ReconstructOption {
+	return func(t *AccessToken) {
+		t.expiresAt = expiresAt
+	}
+}
+
+// WithUsage sets when the access token was last used and when it was revoked.
+func WithUsage(lastUsedAt *time.Time, revokedAt *time.Time) ReconstructOption {
+	return func(t *AccessToken) {
+		t.lastUsedAt = lastUsedAt
+		t.revokedAt = revokedAt
+	}
+}
+
+// WithCreatedAt sets when the access token was created.
+func WithCreatedAt(createdAt time.Time) ReconstructOption {
+	return func(t *AccessToken) {
+		t.createdAt = createdAt
+	}
+}
+
+// ID returns the ID of the access token.
+func (t *AccessToken) ID() string { return t.id }
+
+// UserID returns the ID of the user the access token authenticates as.
+func (t *AccessToken) UserID() string { return t.userID }
+
+// Name returns the name the user gave the access token.
+func (t *AccessToken) Name() string { return t.name }
+
+// TokenHash returns the hash of the plain token.
+func (t *AccessToken) TokenHash() string { return t.tokenHash }
+
+// Hint returns the start of the plain token, which helps the user recognize it.
+func (t *AccessToken) Hint() string { return t.hint }
+
+// Scopes returns the scopes of the access token.
+func (t *AccessToken) Scopes() []Scope { return t.scopes }
+
+// PageIDs returns the pages the access token is restricted to. Empty means every page of the user.
+func (t *AccessToken) PageIDs() []string { return t.pageIDs }
+
+// ExpiresAt returns when the access token expires, or nil if it does not.
+func (t *AccessToken) ExpiresAt() *time.Time { return t.expiresAt }
+
+// LastUsedAt returns when the access token was last used, or nil if it was never used.
+func (t *AccessToken) LastUsedAt() *time.Time { return t.lastUsedAt }
+
+// RevokedAt returns when the access token was revoked, or nil if it was not.
+func (t *AccessToken) RevokedAt() *time.Time { return t.revokedAt }
+
+// CreatedAt returns when the access token was created.
+func (t *AccessToken) CreatedAt() time.Time { return t.createdAt }
+
+// Active reports whether the access token can be used at the given time.
+func (t *AccessToken) Active(now time.Time) bool {
+	return t.Authenticate(now) == nil
+}
+
+// Authenticate checks that the access token can be used at the given time.
+func (t *AccessToken) Authenticate(now time.Time) error {
+	if t.revokedAt != nil {
+		return ErrAccessTokenRevoked
+	}
+	if t.expiresAt != nil && !now.Before(*t.expiresAt) {
+		return ErrAccessTokenExpired
+	}
+	return nil
+}
+
+// Permits checks that the access token allows a request of the scope to the pages. A token restricted to
+// pages only allows requests that name at least one page, all of which must be among its pages.
+func (t *AccessToken) Permits(scope Scope, pageIDs []string) error {
+	if err := t.PermitsScope(scope); err != nil {
+		return err
+	}
+	return t.PermitsPages(pageIDs)
+}
+
+// PermitsScope checks that the access token has the scope.
+func (t *AccessToken) PermitsScope(scope Scope) error {
+	if !slices.Contains(t.scopes, scope) {
+		return ErrInsufficientScope
+	}
+	return nil
+}
+
+// PermitsPages checks that the access token allows a request to the pages, as described on Permits.
+func (t *AccessToken) PermitsPages(pageIDs []string) error {
+	if len(t.pageIDs) == 0 {
+		return nil
+	}
+	if len(pageIDs) == 0 {
+		return ErrInsufficientScope
+	}
+	for _, id := range pageIDs {
+		if !slices.Contains(t.pageIDs, id) {
+			return ErrInsufficientScope
+		}
+	}
+	return nil
+}
+
+// MarkUsed records that the access token was used at the given time. It reports whether the last use changed,
+// which happens at most once per LastUsedInterval so that every request does not write it.
+func (t *AccessToken) MarkUsed(now time.Time) bool {
+	if t.lastUsedAt != nil && now.Sub(*t.lastUsedAt) < LastUsedInterval {
+		return false
+	}
+	t.lastUsedAt = &now
+	return true
+}
+
+// Revoke stops the access token from being used. Only its owner can revoke it, and revoking it again does nothing.
+func (t *AccessToken) Revoke(user *duser.User, now time.Time) error {
+	if user == nil {
+		return duser.ErrUserNotFound
+	}
+	if t.userID != user.ID() {
+		return ErrNotAccessTokenOwner
+	}
+	if t.revokedAt == nil {
+		t.revokedAt = &now
+	}
+	return nil
+}
+
+// IsAccessToken reports whether the bearer token is a personal access token rather than an ID token.
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+// HashToken returns the hash under which an access token is stored.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeScopes validates the scopes and returns them in a fixed order. Writing implies reading,
+// and empty scopes allow both.
+func normalizeScopes(scopes []Scope) ([]Scope, error) {
+	if len(scopes) == 0 {
+		return Scopes(), nil
+	}
+	for _, s := range scopes {
+		if !s.Valid() {
+			return nil, ErrInvalidScope
+		}
+	}
+	if slices.Contains(scopes, ScopeWrite) {
+		return Scopes(), nil
+	}
+	return []Scope{ScopeRead}, nil
+}
+
+var tokenGenerator = defaultTokenGenerator
+
+func defaultTokenGenerator() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
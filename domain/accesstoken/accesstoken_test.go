@@ -0,0 +1,227 @@
+package accesstoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNewAccessToken(t *testing.T) {
+	originalGenerator := tokenGenerator
+	t.Cleanup(func() { tokenGenerator = originalGenerator })
+	tokenGenerator = func() (string, error) { return TokenPrefix + "abcdefgh", nil }
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	other := duser.ReconstructUser("other-id", "uid-2", "google", nil)
+	ownPage := dpage.ReconstructPage("page-1", "title", *user, "code", nil, nil)
+	invitedPage := dpage.ReconstructPage("page-2", "title", *other, "code", nil, duser.Users{user})
+	foreignPage := dpage.ReconstructPage("page-3", "title", *other, "code", nil, nil)
+	expiresAt := fixedNow.Add(24 * time.Hour)
+	past := fixedNow.Add(-time.Second)
+
+	tests := []struct {
+		name      string
+		user      *duser.User
+		tokenName string
+		scopes    []Scope
+		pages     []*dpage.Page
+		expiresAt *time.Time
+		want      *AccessToken
+		wantToken string
+		err       error
+	}{
+		{
+			name:      "success_full_access",
+			user:      user,
+			tokenName: " ci bot ",
+			want: &AccessToken{
+				userID:    "user-id",
+				name:      "ci bot",
+				tokenHash: HashToken(TokenPrefix + "abcdefgh"),
+				hint:      TokenPrefix + "abcd",
+				scopes:    []Scope{ScopeRead, ScopeWrite},
+				pageIDs:   []string{},
+				createdAt: fixedNow,
+			},
+			wantToken: TokenPrefix + "abcdefgh",
+		},
+		{
+			name:      "success_read_only_pages_with_expiry",
+			user:      user,
+			tokenName: "reader",
+			scopes:    []Scope{ScopeRead, ScopeRead},
+			pages:     []*dpage.Page{ownPage, invitedPage, ownPage},
+			expiresAt: &expiresAt,
+			want: &AccessToken{
+				userID:    "user-id",
+				name:      "reader",
+				tokenHash: HashToken(TokenPrefix + "abcdefgh"),
+				hint:      TokenPrefix + "abcd",
+				scopes:    []Scope{ScopeRead},
+				pageIDs:   []string{"page-1", "page-2"},
+				expiresAt: &expiresAt,
+				createdAt: fixedNow,
+			},
+			wantToken: TokenPrefix + "abcdefgh",
+		},
+		{
+			name:      "write_implies_read",
+			user:      user,
+			tokenName: "writer",
+			scopes:    []Scope{ScopeWrite},
+			want: &AccessToken{
+				userID:    "user-id",
+				name:      "writer",
+				tokenHash: HashToken(TokenPrefix + "abcdefgh"),
+				hint:      TokenPrefix + "abcd",
+				scopes:    []Scope{ScopeRead, ScopeWrite},
+				pageIDs:   []string{},
+				createdAt: fixedNow,
+			},
+			wantToken: TokenPrefix + "abcdefgh",
+		},
+		{name: "empty_name", user: user, tokenName: " ", err: ErrInvalidName},
+		{name: "name_too_long", user: user, tokenName: strings.Repeat("あ", MaxNameLength+1), err: ErrInvalidName},
+		{name: "invalid_scope", user: user, tokenName: "bot", scopes: []Scope{"admin"}, err: ErrInvalidScope},
+		{name: "expiry_in_past", user: user, tokenName: "bot", expiresAt: &past, err: ErrInvalidExpiry},
+		{name: "inaccessible_page", user: user, tokenName: "bot", pages: []*dpage.Page{foreignPage}, err: dpage.ErrNotCreatedByUser},
+		{name: "nil_page", user: user, tokenName: "bot", pages: []*dpage.Page{nil}, err: dpage.ErrNoPageProvided},
+		{name: "nil_user", tokenName: "bot", err: duser.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, token, err := NewAccessToken(tt.user, tt.tokenName, tt.scopes, tt.pages, tt.expiresAt, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(AccessToken{})); diff != "" {
+				t.Fatalf("access token mismatch (-want +got):\n%s", diff)
+			}
+			if token != tt.wantToken {
+				t.Fatalf("token = %q, want %q", token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestAccessToken_Authenticate(t *testing.T) {
+	expiresAt := fixedNow.Add(time.Hour)
+	revokedAt := fixedNow.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		token *AccessToken
+		now   time.Time
+		err   error
+	}{
+		{name: "no_expiry", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil), now: fixedNow},
+		{name: "before_expiry", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil, WithExpiresAt(&expiresAt)), now: fixedNow},
+		{name: "expired", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil, WithExpiresAt(&expiresAt)), now: expiresAt, err: ErrAccessTokenExpired},
+		{name: "revoked", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil, WithUsage(nil, &revokedAt)), now: fixedNow, err: ErrAccessTokenRevoked},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.err, tt.token.Authenticate(tt.now))
+			if got := tt.token.Active(tt.now); got != (tt.err == nil) {
+				t.Fatalf("Active() = %v, want %v", got, tt.err == nil)
+			}
+		})
+	}
+}
+
+func TestAccessToken_Permits(t *testing.T) {
+	readOnly := ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", []Scope{ScopeRead}, nil)
+	pageScoped := ReconstructAccessToken("t-2", "user-id", "bot", "hash", "hint", Scopes(), []string{"page-1", "page-2"})
+
+	tests := []struct {
+		name    string
+		token   *AccessToken
+		scope   Scope
+		pageIDs []string
+		err     error
+	}{
+		{name: "read_allowed", token: readOnly, scope: ScopeRead},
+		{name: "write_denied", token: readOnly, scope: ScopeWrite, pageIDs: []string{"page-1"}, err: ErrInsufficientScope},
+		{name: "page_allowed", token: pageScoped, scope: ScopeWrite, pageIDs: []string{"page-1"}},
+		{name: "pages_allowed", token: pageScoped, scope: ScopeWrite, pageIDs: []string{"page-1", "page-2"}},
+		{name: "other_page_denied", token: pageScoped, scope: ScopeRead, pageIDs: []string{"page-1", "page-3"}, err: ErrInsufficientScope},
+		{name: "no_page_denied", token: pageScoped, scope: ScopeRead, err: ErrInsufficientScope},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.err, tt.token.Permits(tt.scope, tt.pageIDs))
+		})
+	}
+}
+
+func TestAccessToken_MarkUsed(t *testing.T) {
+	token := ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil)
+
+	if !token.MarkUsed(fixedNow) {
+		t.Fatal("first use should be recorded")
+	}
+	if token.MarkUsed(fixedNow.Add(LastUsedInterval - time.Second)) {
+		t.Fatal("use within the interval should not be recorded")
+	}
+	if !token.MarkUsed(fixedNow.Add(LastUsedInterval)) {
+		t.Fatal("use after the interval should be recorded")
+	}
+	if diff := cmp.Diff(fixedNow.Add(LastUsedInterval), *token.LastUsedAt()); diff != "" {
+		t.Fatalf("last used mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccessToken_Revoke(t *testing.T) {
+	owner := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	other := duser.ReconstructUser("other-id", "uid-2", "google", nil)
+	earlier := fixedNow.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		token *AccessToken
+		user  *duser.User
+		want  *time.Time
+		err   error
+	}{
+		{name: "success", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil), user: owner, want: &fixedNow},
+		{name: "already_revoked", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil, WithUsage(nil, &earlier)), user: owner, want: &earlier},
+		{name: "not_owner", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil), user: other, err: ErrNotAccessTokenOwner},
+		{name: "nil_user", token: ReconstructAccessToken("t-1", "user-id", "bot", "hash", "hint", Scopes(), nil), err: duser.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.token.Revoke(tt.user, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, tt.token.RevokedAt()); diff != "" {
+				t.Fatalf("revoked at mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessToken(t *testing.T) {
+	if !IsAccessToken(TokenPrefix + "abc") {
+		t.Fatal("token with the prefix should be an access token")
+	}
+	if IsAccessToken("eyJhbGciOiJSUzI1NiJ9.payload.signature") {
+		t.Fatal("ID token should not be an access token")
+	}
+}
@@ -0,0 +1,15 @@
+package accesstoken
+
+import "errors"
+
+var (
+	ErrNoAccessTokenProvided = errors.New("no access token provided")
+	ErrInvalidName           = errors.New("invalid access token name")
+	ErrInvalidScope          = errors.New("invalid access token scope")
+	ErrInvalidExpiry         = errors.New("invalid access token expiry")
+	ErrTooManyAccessTokens   = errors.New("too many access tokens")
+	ErrNotAccessTokenOwner   = errors.New("access token not owned by the user")
+	ErrAccessTokenExpired    = errors.New("access token expired")
+	ErrAccessTokenRevoked    = errors.New("access token revoked")
+	ErrInsufficientScope     = errors.New("access token scope does not allow the request")
+)
@@ -0,0 +1,14 @@
+package accesstoken
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_accesstoken/accesstoken.go -source=./repository.go -package=mockaccesstoken
+
+type AccessTokenRepository interface {
+	Get(ctx context.Context, id string) (*AccessToken, error)
+	// GetByTokenHash returns the access token with the given hash, or nil if there is none.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*AccessToken, error)
+	// ListByUserID returns the access tokens of the user, newest first.
+	ListByUserID(ctx context.Context, userID string) ([]*AccessToken, error)
+	Save(ctx context.Context, token *AccessToken) (*AccessToken, error)
+}
@@ -0,0 +1,86 @@
+package click
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// MaxReferrerLength is the maximum number of bytes of a referrer kept with a click.
+const MaxReferrerLength = 2048
+
+// Click is one visit of a link through the redirect endpoint.
+type Click struct {
+	pageID string
+	linkID string
+	// userID is the ID of the signed in user who clicked. Empty means the click is anonymous.
+	userID    string
+	referrer  string
+	clickedAt time.Time
+}
+
+// NewClick records a click on the link the target leads to. The user is nil for anonymous clicks.
+// Referrers longer than MaxReferrerLength are cut off.
+func NewClick(target *Target, user *duser.User, referrer string, now time.Time) *Click {
+	c := &Click{
+		pageID:    target.PageID(),
+		linkID:    target.LinkID(),
+		referrer:  truncateReferrer(referrer),
+		clickedAt: now,
+	}
+	if user != nil {
+		c.userID = user.ID()
+	}
+	return c
+}
+
+// PageID returns the ID of the page of the clicked link.
+func (c *Click) PageID() string { return c.pageID }
+
+// LinkID returns the ID of the clicked link.
+func (c *Click) LinkID() string { return c.linkID }
+
+// UserID returns the ID of the user who clicked, or an empty string for anonymous clicks.
+func (c *Click) UserID() string { return c.userID }
+
+// Referrer returns the page the click came from, or an empty string.
+func (c *Click) Referrer() string { return c.referrer }
+
+// ClickedAt returns the time of the click.
+func (c *Click) ClickedAt() time.Time { return c.clickedAt }
+
+// truncateReferrer cuts the referrer to MaxReferrerLength bytes without splitting a character.
+func truncateReferrer(referrer string) string {
+	referrer = strings.ToValidUTF8(strings.TrimSpace(referrer), "")
+	if len(referrer) <= MaxReferrerLength {
+		return referrer
+	}
+	cut := MaxReferrerLength
+	for cut > 0 && !utf8.RuneStart(referrer[cut]) {
+		cut--
+	}
+	return referrer[:cut]
+}
+
+// Target is where a link leads, as looked up by the redirect endpoint without loading the page of the link.
+type Target struct {
+	pageID string
+	linkID string
+	url    string
+}
+
+// ReconstructTarget reconstructs a Target from stored data.
+func ReconstructTarget(pageID string, linkID string, url string) *Target {
+	return &Target{pageID: pageID, linkID: linkID, url: url}
+}
+
+// PageID returns the ID of the page of the link.
+func (t *Target) PageID() string { return t.pageID }
+
+// LinkID returns the ID of the link.
+func (t *Target) LinkID() string { return t.linkID }
+
+// URL returns the URL the link leads to.
+func (t *Target) URL() string { return t.url }
@@ -0,0 +1,129 @@
+package click
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestNewClick(t *testing.T) {
+	type args struct {
+		user     *duser.User
+		referrer string
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	target := ReconstructTarget("page-id", "link-id", "https://example.com")
+	user := duser.ReconstructUser("user-id", "uid", "anonymous", nil)
+
+	tests := []struct {
+		name string
+		args args
+		want *Click
+	}{
+		{
+			name: "signed_in",
+			args: args{user: user, referrer: "https://tsudzuri.example.com/pages/page-id"},
+			want: &Click{pageID: "page-id", linkID: "link-id", userID: "user-id", referrer: "https://tsudzuri.example.com/pages/page-id", clickedAt: now},
+		},
+		{
+			name: "anonymous_without_referrer",
+			args: args{},
+			want: &Click{pageID: "page-id", linkID: "link-id", clickedAt: now},
+		},
+		{
+			name: "long_referrer_is_cut_between_characters",
+			args: args{referrer: strings.Repeat("a", MaxReferrerLength-1) + "あ"},
+			want: &Click{pageID: "page-id", linkID: "link-id", referrer: strings.Repeat("a", MaxReferrerLength-1), clickedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewClick(target, tt.args.user, tt.args.referrer, now)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Click{})); diff != "" {
+				t.Errorf("NewClick() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPageStats(t *testing.T) {
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+	}, nil)
+
+	counts := map[Window]map[string]int64{
+		WindowDay:   {"link-a": 1},
+		WindowWeek:  {"link-a": 3},
+		WindowMonth: {"link-a": 3, "removed-link": 2},
+		WindowAll:   {"link-a": 10, "link-b": 1, "removed-link": 4},
+	}
+
+	want := &PageStats{
+		pageID: "page-id",
+		links: []LinkStats{
+			{linkID: "link-a", url: "https://a.com", clicks: map[Window]int64{WindowDay: 1, WindowWeek: 3, WindowMonth: 3, WindowAll: 10}},
+			{linkID: "link-b", url: "https://b.com", clicks: map[Window]int64{WindowDay: 0, WindowWeek: 0, WindowMonth: 0, WindowAll: 1}},
+		},
+		generatedAt: now,
+	}
+	got := NewPageStats(page, counts, now)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(PageStats{}, LinkStats{})); diff != "" {
+		t.Errorf("NewPageStats() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWindow_Since(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		window Window
+		want   time.Time
+	}{
+		{window: WindowDay, want: time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)},
+		{window: WindowWeek, want: time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)},
+		{window: WindowMonth, want: time.Date(2025, 2, 8, 12, 0, 0, 0, time.UTC)},
+		{window: WindowAll, want: time.Time{}},
+	}
+	for _, tt := range tests {
+		if got := tt.window.Since(now); !got.Equal(tt.want) {
+			t.Errorf("Window(%d).Since() = %v, want %v", tt.window, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizeStats(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, duser.Users{invited})
+
+	tests := []struct {
+		name string
+		page *dpage.Page
+		user *duser.User
+		want error
+	}{
+		{name: "creator", page: page, user: creator},
+		{name: "invited_member", page: page, user: invited, want: dpage.ErrNotCreatedByUser},
+		{name: "nil_page", user: creator, want: dpage.ErrNoPageProvided},
+		{name: "nil_user", page: page, want: dpage.ErrNoUserProvided},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.want, AuthorizeStats(tt.page, tt.user))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_click/click.go -source=./repository.go -package=mockclick
+//
+
+// Package mockclick is a generated GoMock package.
+package mockclick
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	click "github.com/naka-sei/tsudzuri/domain/click"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClickRepository is a mock of ClickRepository interface.
+type MockClickRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockClickRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockClickRepositoryMockRecorder is the mock recorder for MockClickRepository.
+type MockClickRepositoryMockRecorder struct {
+	mock *MockClickRepository
+}
+
+// NewMockClickRepository creates a new mock instance.
+func NewMockClickRepository(ctrl *gomock.Controller) *MockClickRepository {
+	mock := &MockClickRepository{ctrl: ctrl}
+	mock.recorder = &MockClickRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClickRepository) EXPECT() *MockClickRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByLink mocks base method.
+func (m *MockClickRepository) CountByLink(ctx context.Context, pageID string, since time.Time) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByLink", ctx, pageID, since)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByLink indicates an expected call of CountByLink.
+func (mr *MockClickRepositoryMockRecorder) CountByLink(ctx, pageID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByLink", reflect.TypeOf((*MockClickRepository)(nil).CountByLink), ctx, pageID, since)
+}
+
+// CreateBulk mocks base method.
+func (m *MockClickRepository) CreateBulk(ctx context.Context, clicks []*click.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulk", ctx, clicks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBulk indicates an expected call of CreateBulk.
+func (mr *MockClickRepositoryMockRecorder) CreateBulk(ctx, clicks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulk", reflect.TypeOf((*MockClickRepository)(nil).CreateBulk), ctx, clicks)
+}
+
+// MockTargetRepository is a mock of TargetRepository interface.
+type MockTargetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTargetRepositoryMockRecorder is the mock recorder for MockTargetRepository.
+type MockTargetRepositoryMockRecorder struct {
+	mock *MockTargetRepository
+}
+
+// NewMockTargetRepository creates a new mock instance.
+func NewMockTargetRepository(ctrl *gomock.Controller) *MockTargetRepository {
+	mock := &MockTargetRepository{ctrl: ctrl}
+	mock.recorder = &MockTargetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTargetRepository) EXPECT() *MockTargetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTargetRepository) Get(ctx context.Context, linkID string) (*click.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, linkID)
+	ret0, _ := ret[0].(*click.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTargetRepositoryMockRecorder) Get(ctx, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTargetRepository)(nil).Get), ctx, linkID)
+}
@@ -0,0 +1,21 @@
+package click
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_click/click.go -source=./repository.go -package=mockclick
+
+type ClickRepository interface {
+	// CreateBulk stores the clicks at once.
+	CreateBulk(ctx context.Context, clicks []*Click) error
+	// CountByLink returns the number of clicks on each link of the page at or after since. The zero time counts
+	// every click. Links without clicks are left out.
+	CountByLink(ctx context.Context, pageID string, since time.Time) (map[string]int64, error)
+}
+
+type TargetRepository interface {
+	// Get returns where the link leads, or nil if there is no such link.
+	Get(ctx context.Context, linkID string) (*Target, error)
+}
@@ -0,0 +1,99 @@
+package click
+
+import (
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// Window is a period clicks are counted over, ending now.
+type Window int
+
+const (
+	// WindowDay counts the clicks of the last 24 hours.
+	WindowDay Window = iota
+	// WindowWeek counts the clicks of the last 7 days.
+	WindowWeek
+	// WindowMonth counts the clicks of the last 30 days.
+	WindowMonth
+	// WindowAll counts every click.
+	WindowAll
+)
+
+// Windows are the periods the stats of a page are counted over.
+var Windows = []Window{WindowDay, WindowWeek, WindowMonth, WindowAll}
+
+// Since returns the start of the window ending at now. It is the zero time for WindowAll.
+func (w Window) Since(now time.Time) time.Time {
+	switch w {
+	case WindowDay:
+		return now.Add(-24 * time.Hour)
+	case WindowWeek:
+		return now.AddDate(0, 0, -7)
+	case WindowMonth:
+		return now.AddDate(0, 0, -30)
+	default:
+		return time.Time{}
+	}
+}
+
+// LinkStats are the clicks on one link of a page per window.
+type LinkStats struct {
+	linkID string
+	url    string
+	clicks map[Window]int64
+}
+
+// LinkID returns the ID of the link.
+func (s LinkStats) LinkID() string { return s.linkID }
+
+// URL returns the URL of the link.
+func (s LinkStats) URL() string { return s.url }
+
+// Clicks returns the number of clicks on the link in the window.
+func (s LinkStats) Clicks(w Window) int64 { return s.clicks[w] }
+
+// PageStats are the clicks on the links of a page.
+type PageStats struct {
+	pageID      string
+	links       []LinkStats
+	generatedAt time.Time
+}
+
+// NewPageStats builds the stats of the links of the page in page order from the number of clicks per window
+// and link. Links without clicks are listed with zero clicks; clicks of links no longer on the page are left out.
+func NewPageStats(page *dpage.Page, counts map[Window]map[string]int64, now time.Time) *PageStats {
+	links := make([]LinkStats, 0, len(page.Links()))
+	for _, l := range page.Links() {
+		clicks := make(map[Window]int64, len(Windows))
+		for _, w := range Windows {
+			clicks[w] = counts[w][l.ID()]
+		}
+		links = append(links, LinkStats{linkID: l.ID(), url: l.URL(), clicks: clicks})
+	}
+	return &PageStats{pageID: page.ID(), links: links, generatedAt: now}
+}
+
+// PageID returns the ID of the page.
+func (s *PageStats) PageID() string { return s.pageID }
+
+// Links returns the stats of the links of the page in page order.
+func (s *PageStats) Links() []LinkStats { return s.links }
+
+// GeneratedAt returns the time the windows end at.
+func (s *PageStats) GeneratedAt() time.Time { return s.generatedAt }
+
+// AuthorizeStats checks that the user can see the click stats of the page, which only the creator of the page can do.
+func AuthorizeStats(page *dpage.Page, user *duser.User) error {
+	if page == nil {
+		return dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return dpage.ErrNoUserProvided
+	}
+	if page.CreatedBy().ID() != user.ID() {
+		return dpage.ErrNotCreatedByUser
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package comment
+
+import (
+	"time"
+	"unicode/utf8"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// MaxBodyLength is the maximum number of characters in a comment body.
+const MaxBodyLength = 1000
+
+// Comment is a message attached to a link of a page. Comments form threads: a reply points to
+// the first comment of its thread, so threads are never nested more than one level deep.
+type Comment struct {
+	id        string
+	pageID    string
+	linkID    string
+	parentID  string
+	author    duser.User
+	body      string
+	deleted   bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewComment creates a comment by the user on a link of the page.
+// A non-nil parent makes the comment a reply in the parent's thread.
+func NewComment(page *dpage.Page, user *duser.User, linkID string, parent *Comment, body string) (*Comment, error) {
+	if page == nil {
+		return nil, dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return nil, dpage.ErrNoUserProvided
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	if !page.HasLink(linkID) {
+		return nil, dpage.ErrNotFoundLinkByID(linkID)
+	}
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+
+	var parentID string
+	if parent != nil {
+		if parent.pageID != page.ID() || parent.linkID != linkID {
+			return nil, ErrInvalidParentComment
+		}
+		if parent.deleted {
+			return nil, ErrCommentDeleted
+		}
+		parentID = parent.ThreadID()
+	}
+
+	return &Comment{
+		pageID:   page.ID(),
+		linkID:   linkID,
+		parentID: parentID,
+		author:   *user,
+		body:     body,
+	}, nil
+}
+
+// ID returns the comment ID.
+func (c *Comment) ID() string {
+	return c.id
+}
+
+// PageID returns the ID of the page the commented link belongs to.
+func (c *Comment) PageID() string {
+	return c.pageID
+}
+
+// LinkID returns the ID of the commented link.
+func (c *Comment) LinkID() string {
+	return c.linkID
+}
+
+// ParentID returns the ID of the first comment of the thread, or an empty string for a thread's first comment.
+func (c *Comment) ParentID() string {
+	return c.parentID
+}
+
+// ThreadID returns the ID of the first comment of the thread the comment belongs to.
+func (c *Comment) ThreadID() string {
+	if c.parentID != "" {
+		return c.parentID
+	}
+	return c.id
+}
+
+// Author returns the user who wrote the comment.
+func (c *Comment) Author() *duser.User {
+	return &c.author
+}
+
+// Body returns the comment body. It is empty once the comment is deleted.
+func (c *Comment) Body() string {
+	return c.body
+}
+
+// Deleted reports whether the comment has been deleted.
+// Deleted comments are kept so the replies in their thread stay in place.
+func (c *Comment) Deleted() bool {
+	return c.deleted
+}
+
+// CreatedAt returns when the comment was written.
+func (c *Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns when the comment was last changed.
+func (c *Comment) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// IsWrittenBy reports whether the user wrote the comment.
+func (c *Comment) IsWrittenBy(user *duser.User) bool {
+	return user != nil && c.author.ID() == user.ID()
+}
+
+// Edit replaces the body of the comment. Only the author can edit the comment, and only while
+// they can still access the page.
+func (c *Comment) Edit(page *dpage.Page, user *duser.User, body string) error {
+	if err := c.authorizeAuthor(page, user); err != nil {
+		return err
+	}
+	if err := validateBody(body); err != nil {
+		return err
+	}
+
+	c.body = body
+	return nil
+}
+
+// Delete marks the comment as deleted and clears its body. Only the author can delete the comment,
+// and only while they can still access the page.
+func (c *Comment) Delete(page *dpage.Page, user *duser.User) error {
+	if err := c.authorizeAuthor(page, user); err != nil {
+		return err
+	}
+
+	c.deleted = true
+	c.body = ""
+	return nil
+}
+
+// Authorize checks that the user can read the comment, which is the case for every member of its page.
+func (c *Comment) Authorize(page *dpage.Page, user *duser.User) error {
+	if page == nil {
+		return dpage.ErrNoPageProvided
+	}
+	if page.ID() != c.pageID {
+		return ErrPageMismatch
+	}
+	return page.Authorize(user)
+}
+
+func (c *Comment) authorizeAuthor(page *dpage.Page, user *duser.User) error {
+	if err := c.Authorize(page, user); err != nil {
+		return err
+	}
+	if !c.IsWrittenBy(user) {
+		return ErrNotCommentAuthor
+	}
+	if c.deleted {
+		return ErrCommentDeleted
+	}
+	return nil
+}
+
+func validateBody(body string) error {
+	if body == "" {
+		return ErrNoCommentBodyProvided
+	}
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return ErrCommentBodyTooLong
+	}
+	return nil
+}
+
+// ReconstructOption sets optional fields when reconstructing a Comment.
+type ReconstructOption func(*Comment)
+
+// WithParentID sets the ID of the first comment of the thread.
+func WithParentID(parentID string) ReconstructOption {
+	return func(c *Comment) {
+		c.parentID = parentID
+	}
+}
+
+// WithDeleted marks the comment as deleted.
+func WithDeleted() ReconstructOption {
+	return func(c *Comment) {
+		c.deleted = true
+		c.body = ""
+	}
+}
+
+// WithTimestamps sets when the comment was written and last changed.
+func WithTimestamps(createdAt time.Time, updatedAt time.Time) ReconstructOption {
+	return func(c *Comment) {
+		c.createdAt = createdAt
+		c.updatedAt = updatedAt
+	}
+}
+
+// ReconstructComment reconstructs a Comment instance from existing data.
+func ReconstructComment(id string, pageID string, linkID string, author duser.User, body string, options ...ReconstructOption) *Comment {
+	c := &Comment{
+		id:     id,
+		pageID: pageID,
+		linkID: linkID,
+		author: author,
+		body:   body,
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	return c
+}
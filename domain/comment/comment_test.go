@@ -0,0 +1,262 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestNewComment(t *testing.T) {
+	type args struct {
+		page   *dpage.Page
+		user   *duser.User
+		linkID string
+		parent *Comment
+		body   string
+	}
+	type want struct {
+		comment *Comment
+		err     error
+	}
+
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+	}, duser.Users{invited})
+
+	root := ReconstructComment("comment-1", "page-id", "link-a", *creator, "root")
+	reply := ReconstructComment("comment-2", "page-id", "link-a", *invited, "reply", WithParentID("comment-1"))
+	deleted := ReconstructComment("comment-3", "page-id", "link-a", *creator, "", WithDeleted())
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "thread_start",
+			args: args{page: page, user: invited, linkID: "link-a", body: "hello"},
+			want: want{
+				comment: &Comment{pageID: "page-id", linkID: "link-a", author: *invited, body: "hello"},
+			},
+		},
+		{
+			name: "reply_to_thread_start",
+			args: args{page: page, user: creator, linkID: "link-a", parent: root, body: "hi"},
+			want: want{
+				comment: &Comment{pageID: "page-id", linkID: "link-a", parentID: "comment-1", author: *creator, body: "hi"},
+			},
+		},
+		{
+			name: "reply_to_reply_joins_thread",
+			args: args{page: page, user: creator, linkID: "link-a", parent: reply, body: "hi"},
+			want: want{
+				comment: &Comment{pageID: "page-id", linkID: "link-a", parentID: "comment-1", author: *creator, body: "hi"},
+			},
+		},
+		{
+			name: "nil_page",
+			args: args{user: creator, linkID: "link-a", body: "hello"},
+			want: want{err: dpage.ErrNoPageProvided},
+		},
+		{
+			name: "nil_user",
+			args: args{page: page, linkID: "link-a", body: "hello"},
+			want: want{err: dpage.ErrNoUserProvided},
+		},
+		{
+			name: "not_member",
+			args: args{page: page, user: other, linkID: "link-a", body: "hello"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "link_not_on_page",
+			args: args{page: page, user: creator, linkID: "link-x", body: "hello"},
+			want: want{err: dpage.ErrNotFoundLinkByID("link-x")},
+		},
+		{
+			name: "empty_body",
+			args: args{page: page, user: creator, linkID: "link-a"},
+			want: want{err: ErrNoCommentBodyProvided},
+		},
+		{
+			name: "body_too_long",
+			args: args{page: page, user: creator, linkID: "link-a", body: strings.Repeat("あ", MaxBodyLength+1)},
+			want: want{err: ErrCommentBodyTooLong},
+		},
+		{
+			name: "parent_on_another_link",
+			args: args{page: page, user: creator, linkID: "link-b", parent: root, body: "hi"},
+			want: want{err: ErrInvalidParentComment},
+		},
+		{
+			name: "parent_deleted",
+			args: args{page: page, user: creator, linkID: "link-a", parent: deleted, body: "hi"},
+			want: want{err: ErrCommentDeleted},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewComment(tt.args.page, tt.args.user, tt.args.linkID, tt.args.parent, tt.args.body)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.comment, got, cmp.AllowUnexported(Comment{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("NewComment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComment_Edit(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, duser.Users{invited})
+	otherPage := dpage.ReconstructPage("other-page-id", "Other", *invited, "INVITE02", nil, nil)
+
+	tests := []struct {
+		name     string
+		comment  *Comment
+		page     *dpage.Page
+		user     *duser.User
+		body     string
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name:     "author",
+			comment:  ReconstructComment("comment-1", "page-id", "link-a", *invited, "before"),
+			page:     page,
+			user:     invited,
+			body:     "after",
+			wantBody: "after",
+		},
+		{
+			name:     "not_author",
+			comment:  ReconstructComment("comment-1", "page-id", "link-a", *invited, "before"),
+			page:     page,
+			user:     creator,
+			body:     "after",
+			wantBody: "before",
+			wantErr:  ErrNotCommentAuthor,
+		},
+		{
+			name:     "author_left_page",
+			comment:  ReconstructComment("comment-1", "page-id", "link-a", *invited, "before"),
+			page:     dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, nil),
+			user:     invited,
+			body:     "after",
+			wantBody: "before",
+			wantErr:  dpage.ErrNotCreatedByUser,
+		},
+		{
+			name:     "page_mismatch",
+			comment:  ReconstructComment("comment-1", "page-id", "link-a", *invited, "before"),
+			page:     otherPage,
+			user:     invited,
+			body:     "after",
+			wantBody: "before",
+			wantErr:  ErrPageMismatch,
+		},
+		{
+			name:    "deleted",
+			comment: ReconstructComment("comment-1", "page-id", "link-a", *invited, "", WithDeleted()),
+			page:    page,
+			user:    invited,
+			body:    "after",
+			wantErr: ErrCommentDeleted,
+		},
+		{
+			name:     "empty_body",
+			comment:  ReconstructComment("comment-1", "page-id", "link-a", *invited, "before"),
+			page:     page,
+			user:     invited,
+			wantBody: "before",
+			wantErr:  ErrNoCommentBodyProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.comment.Edit(tt.page, tt.user, tt.body)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if tt.comment.Body() != tt.wantBody {
+				t.Fatalf("body mismatch: want %q, got %q", tt.wantBody, tt.comment.Body())
+			}
+		})
+	}
+}
+
+func TestComment_Delete(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, duser.Users{invited})
+
+	tests := []struct {
+		name        string
+		comment     *Comment
+		user        *duser.User
+		wantDeleted bool
+		wantErr     error
+	}{
+		{
+			name:        "author",
+			comment:     ReconstructComment("comment-1", "page-id", "link-a", *invited, "body"),
+			user:        invited,
+			wantDeleted: true,
+		},
+		{
+			name:    "page_creator_is_not_author",
+			comment: ReconstructComment("comment-1", "page-id", "link-a", *invited, "body"),
+			user:    creator,
+			wantErr: ErrNotCommentAuthor,
+		},
+		{
+			name:        "already_deleted",
+			comment:     ReconstructComment("comment-1", "page-id", "link-a", *invited, "", WithDeleted()),
+			user:        invited,
+			wantDeleted: true,
+			wantErr:     ErrCommentDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.comment.Delete(page, tt.user)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if tt.comment.Deleted() != tt.wantDeleted {
+				t.Fatalf("deleted mismatch: want %v, got %v", tt.wantDeleted, tt.comment.Deleted())
+			}
+			if tt.wantDeleted && tt.comment.Body() != "" {
+				t.Fatalf("body should be cleared, got %q", tt.comment.Body())
+			}
+		})
+	}
+}
+
+func TestComment_ThreadID(t *testing.T) {
+	author := duser.ReconstructUser("user-id", "uid", "anonymous", nil)
+	root := ReconstructComment("comment-1", "page-id", "link-a", *author, "root")
+	reply := ReconstructComment("comment-2", "page-id", "link-a", *author, "reply", WithParentID("comment-1"))
+
+	if got := root.ThreadID(); got != "comment-1" {
+		t.Fatalf("root ThreadID() = %q, want %q", got, "comment-1")
+	}
+	if got := reply.ThreadID(); got != "comment-1" {
+		t.Fatalf("reply ThreadID() = %q, want %q", got, "comment-1")
+	}
+}
@@ -0,0 +1,12 @@
+package comment
+
+import "errors"
+
+var (
+	ErrNoCommentBodyProvided = errors.New("no comment body provided")
+	ErrCommentBodyTooLong    = errors.New("comment body too long")
+	ErrInvalidParentComment  = errors.New("parent comment belongs to another link")
+	ErrNotCommentAuthor      = errors.New("comment not written by the user")
+	ErrCommentDeleted        = errors.New("comment already deleted")
+	ErrPageMismatch          = errors.New("comment does not belong to the page")
+)
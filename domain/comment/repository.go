@@ -0,0 +1,12 @@
+package comment
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_comment/comment.go -source=./repository.go -package=mockcomment
+
+type CommentRepository interface {
+	Get(ctx context.Context, id string) (*Comment, error)
+	// ListByLink returns the comments on the link, oldest first.
+	ListByLink(ctx context.Context, linkID string) ([]*Comment, error)
+	Save(ctx context.Context, comment *Comment) (*Comment, error)
+}
@@ -0,0 +1,88 @@
+package device
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// MaxTokenLength is the maximum number of bytes in a device token.
+const MaxTokenLength = 4096
+
+// Platform is the kind of client a device token was issued to.
+type Platform string
+
+const (
+	PlatformIOS     Platform = "ios"
+	PlatformAndroid Platform = "android"
+	PlatformWeb     Platform = "web"
+)
+
+// Platforms returns every platform in a fixed order.
+func Platforms() []Platform {
+	return []Platform{PlatformIOS, PlatformAndroid, PlatformWeb}
+}
+
+// Valid reports whether the platform is known.
+func (p Platform) Valid() bool {
+	return slices.Contains(Platforms(), p)
+}
+
+// Device is a client of a user that receives push notifications. It is identified by the token the push
+// service issued to it, so a token belongs to at most one user; registering it again moves it to the new user.
+type Device struct {
+	id        string
+	userID    string
+	token     string
+	platform  Platform
+	createdAt time.Time
+}
+
+// NewDevice creates a device of the user with the push token.
+func NewDevice(user *duser.User, token string, platform Platform, now time.Time) (*Device, error) {
+	if user == nil {
+		return nil, duser.ErrUserNotFound
+	}
+	token = strings.TrimSpace(token)
+	if token == "" || len(token) > MaxTokenLength {
+		return nil, ErrInvalidToken
+	}
+	if !platform.Valid() {
+		return nil, ErrInvalidPlatform
+	}
+
+	return &Device{
+		userID:    user.ID(),
+		token:     token,
+		platform:  platform,
+		createdAt: now,
+	}, nil
+}
+
+// ReconstructDevice reconstructs a Device from its components.
+func ReconstructDevice(id string, userID string, token string, platform Platform, createdAt time.Time) *Device {
+	return &Device{
+		id:        id,
+		userID:    userID,
+		token:     token,
+		platform:  platform,
+		createdAt: createdAt,
+	}
+}
+
+// ID returns the ID of the device.
+func (d *Device) ID() string { return d.id }
+
+// UserID returns the ID of the user the device belongs to.
+func (d *Device) UserID() string { return d.userID }
+
+// Token returns the token the push service addresses the device with.
+func (d *Device) Token() string { return d.token }
+
+// Platform returns the kind of client of the device.
+func (d *Device) Platform() Platform { return d.platform }
+
+// CreatedAt returns when the device was registered.
+func (d *Device) CreatedAt() time.Time { return d.createdAt }
@@ -0,0 +1,51 @@
+package device
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNewDevice(t *testing.T) {
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+
+	tests := []struct {
+		name     string
+		user     *duser.User
+		token    string
+		platform Platform
+		want     *Device
+		err      error
+	}{
+		{
+			name:     "success",
+			user:     user,
+			token:    " token-1 ",
+			platform: PlatformIOS,
+			want:     &Device{userID: "user-id", token: "token-1", platform: PlatformIOS, createdAt: fixedNow},
+		},
+		{name: "empty_token", user: user, token: " ", platform: PlatformWeb, err: ErrInvalidToken},
+		{name: "token_too_long", user: user, token: strings.Repeat("a", MaxTokenLength+1), platform: PlatformWeb, err: ErrInvalidToken},
+		{name: "invalid_platform", user: user, token: "token-1", platform: "windows", err: ErrInvalidPlatform},
+		{name: "nil_user", token: "token-1", platform: PlatformAndroid, err: duser.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewDevice(tt.user, tt.token, tt.platform, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Device{})); diff != "" {
+				t.Fatalf("device mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package device
+
+import "errors"
+
+var (
+	ErrInvalidToken    = errors.New("invalid device token")
+	ErrInvalidPlatform = errors.New("invalid device platform")
+)
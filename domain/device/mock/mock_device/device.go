@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_device/device.go -source=./repository.go -package=mockdevice
+//
+
+// Package mockdevice is a generated GoMock package.
+package mockdevice
+
+import (
+	context "context"
+	reflect "reflect"
+
+	device "github.com/naka-sei/tsudzuri/domain/device"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeviceRepository is a mock of DeviceRepository interface.
+type MockDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDeviceRepositoryMockRecorder is the mock recorder for MockDeviceRepository.
+type MockDeviceRepositoryMockRecorder struct {
+	mock *MockDeviceRepository
+}
+
+// NewMockDeviceRepository creates a new mock instance.
+func NewMockDeviceRepository(ctrl *gomock.Controller) *MockDeviceRepository {
+	mock := &MockDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceRepository) EXPECT() *MockDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeviceRepository) Delete(ctx context.Context, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeviceRepositoryMockRecorder) Delete(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeviceRepository)(nil).Delete), ctx, userID, token)
+}
+
+// DeleteByTokens mocks base method.
+func (m *MockDeviceRepository) DeleteByTokens(ctx context.Context, tokens []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTokens", ctx, tokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByTokens indicates an expected call of DeleteByTokens.
+func (mr *MockDeviceRepositoryMockRecorder) DeleteByTokens(ctx, tokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTokens", reflect.TypeOf((*MockDeviceRepository)(nil).DeleteByTokens), ctx, tokens)
+}
+
+// ListByUserIDs mocks base method.
+func (m *MockDeviceRepository) ListByUserIDs(ctx context.Context, userIDs []string) ([]*device.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]*device.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserIDs indicates an expected call of ListByUserIDs.
+func (mr *MockDeviceRepositoryMockRecorder) ListByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserIDs", reflect.TypeOf((*MockDeviceRepository)(nil).ListByUserIDs), ctx, userIDs)
+}
+
+// Save mocks base method.
+func (m *MockDeviceRepository) Save(ctx context.Context, arg1 *device.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDeviceRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDeviceRepository)(nil).Save), ctx, arg1)
+}
@@ -0,0 +1,16 @@
+package device
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_device/device.go -source=./repository.go -package=mockdevice
+
+type DeviceRepository interface {
+	// ListByUserIDs returns the devices of the users.
+	ListByUserIDs(ctx context.Context, userIDs []string) ([]*Device, error)
+	// Save stores the device. A device with the same token is replaced, even if it belongs to another user.
+	Save(ctx context.Context, device *Device) error
+	// Delete removes the device of the user with the token. Unknown tokens are ignored.
+	Delete(ctx context.Context, userID string, token string) error
+	// DeleteByTokens removes the devices with the tokens regardless of their user.
+	DeleteByTokens(ctx context.Context, tokens []string) error
+}
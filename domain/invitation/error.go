@@ -0,0 +1,14 @@
+package invitation
+
+import "errors"
+
+var (
+	ErrNoInvitationProvided      = errors.New("no invitation provided")
+	ErrInvalidEmail              = errors.New("invalid email")
+	ErrInvalidInvitationToken    = errors.New("invalid invitation token")
+	ErrInvitationAlreadyAccepted = errors.New("invitation already accepted")
+	ErrInvitationRevoked         = errors.New("invitation revoked")
+	ErrInvitationExpired         = errors.New("invitation expired")
+	ErrInvitationPageMismatch    = errors.New("invitation does not belong to the page")
+	ErrInvitationEmailMismatch   = errors.New("invitation is for another email address")
+)
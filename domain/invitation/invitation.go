@@ -0,0 +1,287 @@
+package invitation
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+const (
+	// ValidFor is how long an invitation can be accepted after it was sent.
+	ValidFor = 7 * 24 * time.Hour
+	// MaxEmailLength is the maximum number of bytes in an invited email address.
+	MaxEmailLength = 254
+)
+
+// Status is the state of an invitation in its lifecycle.
+type Status string
+
+const (
+	// StatusPending is an invitation waiting to be accepted.
+	StatusPending Status = "pending"
+	// StatusAccepted is an invitation that was used to join the page. It cannot be used again.
+	StatusAccepted Status = "accepted"
+	// StatusRevoked is an invitation cancelled by the page creator.
+	StatusRevoked Status = "revoked"
+	// StatusExpired is a pending invitation that was not accepted in time. It is never stored;
+	// a pending invitation past its expiry reports this status.
+	StatusExpired Status = "expired"
+)
+
+// Invitation invites an email address to join a page. The invitee proves they received it by presenting
+// the secret token sent by email. Only the hash of the token is kept, and the token can be used once.
+type Invitation struct {
+	id          string
+	pageID      string
+	email       string
+	invitedByID string
+	tokenHash   string
+	status      Status
+	acceptedBy  string
+	expiresAt   time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewInvitation creates an invitation of the email address to the page and returns it with its secret token.
+// Only the creator of the page can invite, and not to an archived page.
+// The plain token is returned once; only its hash is kept.
+func NewInvitation(page *dpage.Page, user *duser.User, email string, now time.Time) (*Invitation, string, error) {
+	if err := AuthorizeManage(page, user); err != nil {
+		return nil, "", err
+	}
+	if page.IsArchived() {
+		return nil, "", dpage.ErrPageArchived
+	}
+	normalized, err := normalizeEmail(email)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := tokenGenerator()
+	if err != nil {
+		return nil, "", fmt.Errorf("generate invitation token: %w", err)
+	}
+
+	return &Invitation{
+		pageID:      page.ID(),
+		email:       normalized,
+		invitedByID: user.ID(),
+		tokenHash:   HashToken(token),
+		status:      StatusPending,
+		expiresAt:   now.Add(ValidFor),
+	}, token, nil
+}
+
+// ID returns the invitation ID.
+func (i *Invitation) ID() string { return i.id }
+
+// PageID returns the ID of the page the invitation is for.
+func (i *Invitation) PageID() string { return i.pageID }
+
+// Email returns the invited email address, normalized to lower case.
+func (i *Invitation) Email() string { return i.email }
+
+// InvitedByID returns the ID of the user who sent the invitation.
+func (i *Invitation) InvitedByID() string { return i.invitedByID }
+
+// TokenHash returns the hash of the secret token.
+func (i *Invitation) TokenHash() string { return i.tokenHash }
+
+// AcceptedByID returns the ID of the user who accepted the invitation, or an empty string.
+func (i *Invitation) AcceptedByID() string { return i.acceptedBy }
+
+// ExpiresAt returns when the invitation stops being accepted.
+func (i *Invitation) ExpiresAt() time.Time { return i.expiresAt }
+
+// CreatedAt returns when the invitation was sent.
+func (i *Invitation) CreatedAt() time.Time { return i.createdAt }
+
+// UpdatedAt returns when the invitation last changed state.
+func (i *Invitation) UpdatedAt() time.Time { return i.updatedAt }
+
+// Status returns the state of the invitation at the given time. A pending invitation past its expiry is expired.
+func (i *Invitation) Status(now time.Time) Status {
+	if i.status == StatusPending && !now.Before(i.expiresAt) {
+		return StatusExpired
+	}
+	return i.status
+}
+
+// StoredStatus returns the state to store. Expiry is derived from the expiry time and never stored.
+func (i *Invitation) StoredStatus() Status { return i.status }
+
+// Accept uses the invitation to add the user to the page. The token must match, the invitation must be pending and
+// the user must be signed in with the invited email address, so that a forwarded link cannot be used by someone else.
+func (i *Invitation) Accept(page *dpage.Page, user *duser.User, token string, now time.Time) error {
+	if page == nil {
+		return dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return dpage.ErrNoUserProvided
+	}
+	if page.ID() != i.pageID {
+		return ErrInvitationPageMismatch
+	}
+	if err := i.VerifyToken(token); err != nil {
+		return err
+	}
+	if err := i.validatePending(now); err != nil {
+		return err
+	}
+	if !i.isAddressedTo(user) {
+		return ErrInvitationEmailMismatch
+	}
+	if err := page.JoinByInvitation(user); err != nil {
+		return err
+	}
+
+	i.status = StatusAccepted
+	i.acceptedBy = user.ID()
+	return nil
+}
+
+// Revoke cancels the invitation. Only the creator of the page can revoke it, and only while it is pending.
+func (i *Invitation) Revoke(page *dpage.Page, user *duser.User, now time.Time) error {
+	if err := AuthorizeManage(page, user); err != nil {
+		return err
+	}
+	if page.ID() != i.pageID {
+		return ErrInvitationPageMismatch
+	}
+	if err := i.validatePending(now); err != nil {
+		return err
+	}
+
+	i.status = StatusRevoked
+	return nil
+}
+
+// VerifyToken verifies that the token is the one issued with the invitation.
+func (i *Invitation) VerifyToken(token string) error {
+	if token == "" || subtle.ConstantTimeCompare([]byte(HashToken(token)), []byte(i.tokenHash)) != 1 {
+		return ErrInvalidInvitationToken
+	}
+	return nil
+}
+
+// isAddressedTo reports whether the user's email address is the invited one. Addresses are compared without case.
+func (i *Invitation) isAddressedTo(user *duser.User) bool {
+	email := user.Email()
+	if email == nil {
+		return false
+	}
+	normalized, err := normalizeEmail(*email)
+	return err == nil && normalized == i.email
+}
+
+func (i *Invitation) validatePending(now time.Time) error {
+	switch i.Status(now) {
+	case StatusAccepted:
+		return ErrInvitationAlreadyAccepted
+	case StatusRevoked:
+		return ErrInvitationRevoked
+	case StatusExpired:
+		return ErrInvitationExpired
+	}
+	return nil
+}
+
+// AuthorizeManage checks that the user can send, list and revoke invitations of the page,
+// which only the creator of the page can do.
+func AuthorizeManage(page *dpage.Page, user *duser.User) error {
+	if page == nil {
+		return dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return dpage.ErrNoUserProvided
+	}
+	if page.CreatedBy().ID() != user.ID() {
+		return dpage.ErrNotCreatedByUser
+	}
+	return nil
+}
+
+// HashToken returns the hash under which an invitation token is stored.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeEmail validates a bare email address and returns it in lower case.
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" || len(email) > MaxEmailLength {
+		return "", ErrInvalidEmail
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || addr.Name != "" {
+		return "", ErrInvalidEmail
+	}
+	return strings.ToLower(email), nil
+}
+
+// ReconstructInvitation reconstructs an Invitation from stored data.
+func ReconstructInvitation(
+	id string,
+	pageID string,
+	email string,
+	invitedByID string,
+	tokenHash string,
+	status Status,
+	expiresAt time.Time,
+	options ...ReconstructOption,
+) *Invitation {
+	i := &Invitation{
+		id:          id,
+		pageID:      pageID,
+		email:       email,
+		invitedByID: invitedByID,
+		tokenHash:   tokenHash,
+		status:      status,
+		expiresAt:   expiresAt,
+	}
+	for _, opt := range options {
+		opt(i)
+	}
+	return i
+}
+
+// ReconstructOption sets optional fields when reconstructing an Invitation.
+type ReconstructOption func(*Invitation)
+
+// WithAcceptedByID sets the ID of the user who accepted the invitation.
+func WithAcceptedByID(userID string) ReconstructOption {
+	return func(i *Invitation) {
+		i.acceptedBy = userID
+	}
+}
+
+// WithTimestamps sets when the invitation was created and last updated.
+func WithTimestamps(createdAt time.Time, updatedAt time.Time) ReconstructOption {
+	return func(i *Invitation) {
+		i.createdAt = createdAt
+		i.updatedAt = updatedAt
+	}
+}
+
+var tokenGenerator = defaultTokenGenerator
+
+const tokenBytes = 32
+
+func defaultTokenGenerator() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
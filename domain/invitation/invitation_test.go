@@ -0,0 +1,160 @@
+package invitation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNewInvitation(t *testing.T) {
+	originalGenerator := tokenGenerator
+	t.Cleanup(func() { tokenGenerator = originalGenerator })
+	tokenGenerator = func() (string, error) { return "token", nil }
+
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	member := duser.ReconstructUser("member-id", "uid-m", "google", nil)
+	page := dpage.ReconstructPage("page-1", "title", *creator, "code", nil, duser.Users{member})
+	archived := dpage.ReconstructPage("page-2", "title", *creator, "code", nil, nil, dpage.WithArchived(true))
+
+	tests := []struct {
+		name  string
+		page  *dpage.Page
+		user  *duser.User
+		email string
+		want  *Invitation
+		err   error
+	}{
+		{
+			name:  "success",
+			page:  page,
+			user:  creator,
+			email: " Friend@Example.com ",
+			want: &Invitation{
+				pageID:      "page-1",
+				email:       "friend@example.com",
+				invitedByID: "creator-id",
+				tokenHash:   HashToken("token"),
+				status:      StatusPending,
+				expiresAt:   fixedNow.Add(ValidFor),
+			},
+		},
+		{name: "not_creator", page: page, user: member, email: "friend@example.com", err: dpage.ErrNotCreatedByUser},
+		{name: "archived", page: archived, user: creator, email: "friend@example.com", err: dpage.ErrPageArchived},
+		{name: "invalid_email", page: page, user: creator, email: "not an email", err: ErrInvalidEmail},
+		{name: "email_with_name", page: page, user: creator, email: "Friend <friend@example.com>", err: ErrInvalidEmail},
+		{name: "empty_email", page: page, user: creator, email: "", err: ErrInvalidEmail},
+		{name: "nil_page", user: creator, email: "friend@example.com", err: dpage.ErrNoPageProvided},
+		{name: "nil_user", page: page, email: "friend@example.com", err: dpage.ErrNoUserProvided},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, token, err := NewInvitation(tt.page, tt.user, tt.email, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Invitation{})); diff != "" {
+				t.Fatalf("invitation mismatch (-want +got):\n%s", diff)
+			}
+			if tt.err == nil && token != "token" {
+				t.Fatalf("token = %q, want %q", token, "token")
+			}
+		})
+	}
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	invitedEmail := "Friend@Example.com"
+	otherEmail := "other@example.com"
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	member := duser.ReconstructUser("member-id", "uid-m", "google", &invitedEmail)
+	invitee := duser.ReconstructUser("invitee-id", "uid-i", "google", &invitedEmail)
+	other := duser.ReconstructUser("other-id", "uid-o", "google", &otherEmail)
+	anonymous := duser.ReconstructUser("anonymous-id", "uid-a", "anonymous", nil)
+
+	newInvitation := func(status Status, expiresAt time.Time) *Invitation {
+		return ReconstructInvitation("inv-1", "page-1", "friend@example.com", "creator-id", HashToken("token"), status, expiresAt)
+	}
+	valid := fixedNow.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		invitation *Invitation
+		pageID     string
+		user       *duser.User
+		token      string
+		wantStatus Status
+		wantMember bool
+		err        error
+	}{
+		{name: "success", invitation: newInvitation(StatusPending, valid), pageID: "page-1", user: invitee, token: "token", wantStatus: StatusAccepted, wantMember: true},
+		{name: "wrong_token", invitation: newInvitation(StatusPending, valid), pageID: "page-1", user: invitee, token: "other", wantStatus: StatusPending, err: ErrInvalidInvitationToken},
+		{name: "already_accepted", invitation: newInvitation(StatusAccepted, valid), pageID: "page-1", user: invitee, token: "token", wantStatus: StatusAccepted, err: ErrInvitationAlreadyAccepted},
+		{name: "revoked", invitation: newInvitation(StatusRevoked, valid), pageID: "page-1", user: invitee, token: "token", wantStatus: StatusRevoked, err: ErrInvitationRevoked},
+		{name: "expired", invitation: newInvitation(StatusPending, fixedNow), pageID: "page-1", user: invitee, token: "token", wantStatus: StatusExpired, err: ErrInvitationExpired},
+		{name: "already_member", invitation: newInvitation(StatusPending, valid), pageID: "page-1", user: member, token: "token", wantStatus: StatusPending, err: dpage.ErrAlreadyJoined},
+		{name: "other_page", invitation: newInvitation(StatusPending, valid), pageID: "page-2", user: invitee, token: "token", wantStatus: StatusPending, err: ErrInvitationPageMismatch},
+		{name: "other_email", invitation: newInvitation(StatusPending, valid), pageID: "page-1", user: other, token: "token", wantStatus: StatusPending, err: ErrInvitationEmailMismatch},
+		{name: "without_email", invitation: newInvitation(StatusPending, valid), pageID: "page-1", user: anonymous, token: "token", wantStatus: StatusPending, err: ErrInvitationEmailMismatch},
+		{name: "nil_user", invitation: newInvitation(StatusPending, valid), pageID: "page-1", token: "token", wantStatus: StatusPending, err: dpage.ErrNoUserProvided},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			page := dpage.ReconstructPage(tt.pageID, "title", *creator, "code", nil, duser.Users{member})
+			err := tt.invitation.Accept(page, tt.user, tt.token, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if got := tt.invitation.Status(fixedNow); got != tt.wantStatus {
+				t.Fatalf("status = %q, want %q", got, tt.wantStatus)
+			}
+			if got := page.Authorize(invitee) == nil; got != tt.wantMember {
+				t.Fatalf("invitee member = %v, want %v", got, tt.wantMember)
+			}
+			if tt.wantMember && tt.invitation.AcceptedByID() != invitee.ID() {
+				t.Fatalf("accepted by = %q, want %q", tt.invitation.AcceptedByID(), invitee.ID())
+			}
+		})
+	}
+}
+
+func TestInvitation_Revoke(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	member := duser.ReconstructUser("member-id", "uid-m", "google", nil)
+	page := dpage.ReconstructPage("page-1", "title", *creator, "code", nil, duser.Users{member})
+	valid := fixedNow.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		status     Status
+		expiresAt  time.Time
+		user       *duser.User
+		wantStatus Status
+		err        error
+	}{
+		{name: "success", status: StatusPending, expiresAt: valid, user: creator, wantStatus: StatusRevoked},
+		{name: "not_creator", status: StatusPending, expiresAt: valid, user: member, wantStatus: StatusPending, err: dpage.ErrNotCreatedByUser},
+		{name: "accepted", status: StatusAccepted, expiresAt: valid, user: creator, wantStatus: StatusAccepted, err: ErrInvitationAlreadyAccepted},
+		{name: "expired", status: StatusPending, expiresAt: fixedNow.Add(-time.Hour), user: creator, wantStatus: StatusExpired, err: ErrInvitationExpired},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			i := ReconstructInvitation("inv-1", "page-1", "friend@example.com", "creator-id", HashToken("token"), tt.status, tt.expiresAt)
+			err := i.Revoke(page, tt.user, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if got := i.Status(fixedNow); got != tt.wantStatus {
+				t.Fatalf("status = %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package invitation
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_invitation/invitation.go -source=./repository.go -package=mockinvitation
+
+type InvitationRepository interface {
+	Get(ctx context.Context, id string) (*Invitation, error)
+	// GetByTokenHash returns the invitation issued with the token of the given hash, or nil if there is none.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+	// ListByPage returns the invitations of the page, newest first.
+	ListByPage(ctx context.Context, pageID string) ([]*Invitation, error)
+	Save(ctx context.Context, invitation *Invitation) (*Invitation, error)
+}
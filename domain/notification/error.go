@@ -0,0 +1,10 @@
+package notification
+
+import "errors"
+
+var (
+	ErrNoEventProvided     = errors.New("no notification event provided")
+	ErrInvalidKind         = errors.New("invalid notification kind")
+	ErrInvalidCount        = errors.New("invalid notification count")
+	ErrNotPreferencesOwner = errors.New("preferences belong to another user")
+)
@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"slices"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// Kind is the kind of page activity a notification reports.
+type Kind string
+
+const (
+	// KindMemberJoined reports that a user joined the page.
+	KindMemberJoined Kind = "member_joined"
+	// KindLinksAdded reports that a member added links to the page. The count is the number of links.
+	KindLinksAdded Kind = "links_added"
+	// KindPageArchived reports that the creator archived the page.
+	KindPageArchived Kind = "page_archived"
+)
+
+// Kinds returns every kind of notification in a fixed order.
+func Kinds() []Kind {
+	return []Kind{KindMemberJoined, KindLinksAdded, KindPageArchived}
+}
+
+// Valid reports whether the kind is known.
+func (k Kind) Valid() bool {
+	return slices.Contains(Kinds(), k)
+}
+
+// Event is activity on a page that the other members are told about.
+type Event struct {
+	Kind  Kind
+	Page  *dpage.Page
+	Actor *duser.User
+	// Count is the number of items the event is about, e.g. the number of added links. It is at least 1.
+	Count int
+}
+
+// Notification is an entry in the inbox of a user.
+type Notification struct {
+	id          string
+	recipientID string
+	actorID     string
+	pageID      string
+	kind        Kind
+	count       int
+	readAt      *time.Time
+	createdAt   time.Time
+}
+
+// NewNotifications creates a notification of the event for every member of the page but the actor.
+// The creator of the page counts as a member.
+func NewNotifications(event *Event, now time.Time) ([]*Notification, error) {
+	if event == nil || event.Page == nil || event.Actor == nil {
+		return nil, ErrNoEventProvided
+	}
+	if !event.Kind.Valid() {
+		return nil, ErrInvalidKind
+	}
+	if event.Count < 1 {
+		return nil, ErrInvalidCount
+	}
+
+	members := make(duser.Users, 0, len(event.Page.InvitedUsers())+1)
+	if creator := event.Page.CreatedBy(); creator != nil {
+		members = append(members, creator)
+	}
+	members = append(members, event.Page.InvitedUsers()...)
+
+	notifications := make([]*Notification, 0, len(members))
+	for _, m := range members {
+		if m == nil || m.ID() == event.Actor.ID() {
+			continue
+		}
+		notifications = append(notifications, &Notification{
+			recipientID: m.ID(),
+			actorID:     event.Actor.ID(),
+			pageID:      event.Page.ID(),
+			kind:        event.Kind,
+			count:       event.Count,
+			createdAt:   now,
+		})
+	}
+	return notifications, nil
+}
+
+// ID returns the ID of the notification.
+func (n *Notification) ID() string { return n.id }
+
+// RecipientID returns the ID of the user the notification is addressed to.
+func (n *Notification) RecipientID() string { return n.recipientID }
+
+// ActorID returns the ID of the user who caused the activity.
+func (n *Notification) ActorID() string { return n.actorID }
+
+// PageID returns the ID of the page the activity happened on.
+func (n *Notification) PageID() string { return n.pageID }
+
+// Kind returns the kind of the activity.
+func (n *Notification) Kind() Kind { return n.kind }
+
+// Count returns the number of items the activity is about.
+func (n *Notification) Count() int { return n.count }
+
+// ReadAt returns when the recipient read the notification, or nil if it is unread.
+func (n *Notification) ReadAt() *time.Time { return n.readAt }
+
+// IsRead reports whether the recipient read the notification.
+func (n *Notification) IsRead() bool { return n.readAt != nil }
+
+// CreatedAt returns when the activity happened.
+func (n *Notification) CreatedAt() time.Time { return n.createdAt }
+
+// ReconstructNotification reconstructs a Notification from its components.
+func ReconstructNotification(
+	id string,
+	recipientID string,
+	actorID string,
+	pageID string,
+	kind Kind,
+	count int,
+	readAt *time.Time,
+	createdAt time.Time,
+) *Notification {
+	return &Notification{
+		id:          id,
+		recipientID: recipientID,
+		actorID:     actorID,
+		pageID:      pageID,
+		kind:        kind,
+		count:       count,
+		readAt:      readAt,
+		createdAt:   createdAt,
+	}
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNewNotifications(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	member1 := duser.ReconstructUser("member-1", "uid-1", "anonymous", nil)
+	member2 := duser.ReconstructUser("member-2", "uid-2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "title", *creator, "code", nil, duser.Users{member1, member2})
+
+	tests := []struct {
+		name  string
+		event *Event
+		want  []*Notification
+		err   error
+	}{
+		{
+			name:  "member_action_notifies_creator_and_other_members",
+			event: &Event{Kind: KindLinksAdded, Page: page, Actor: member1, Count: 3},
+			want: []*Notification{
+				{recipientID: "creator-id", actorID: "member-1", pageID: "page-1", kind: KindLinksAdded, count: 3, createdAt: fixedNow},
+				{recipientID: "member-2", actorID: "member-1", pageID: "page-1", kind: KindLinksAdded, count: 3, createdAt: fixedNow},
+			},
+		},
+		{
+			name:  "creator_action_notifies_members",
+			event: &Event{Kind: KindPageArchived, Page: page, Actor: creator, Count: 1},
+			want: []*Notification{
+				{recipientID: "member-1", actorID: "creator-id", pageID: "page-1", kind: KindPageArchived, count: 1, createdAt: fixedNow},
+				{recipientID: "member-2", actorID: "creator-id", pageID: "page-1", kind: KindPageArchived, count: 1, createdAt: fixedNow},
+			},
+		},
+		{
+			name:  "page_without_other_members",
+			event: &Event{Kind: KindLinksAdded, Page: dpage.ReconstructPage("page-2", "title", *creator, "code", nil, nil), Actor: creator, Count: 1},
+			want:  []*Notification{},
+		},
+		{name: "nil_event", err: ErrNoEventProvided},
+		{name: "nil_page", event: &Event{Kind: KindMemberJoined, Actor: member1, Count: 1}, err: ErrNoEventProvided},
+		{name: "nil_actor", event: &Event{Kind: KindMemberJoined, Page: page, Count: 1}, err: ErrNoEventProvided},
+		{name: "invalid_kind", event: &Event{Kind: "role_changed", Page: page, Actor: member1, Count: 1}, err: ErrInvalidKind},
+		{name: "zero_count", event: &Event{Kind: KindLinksAdded, Page: page, Actor: member1}, err: ErrInvalidCount},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewNotifications(tt.event, fixedNow)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Notification{})); diff != "" {
+				t.Errorf("NewNotifications() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
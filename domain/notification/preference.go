@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"slices"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// Preferences are the notification settings of a user. Every kind is enabled by default, so only the muted
+// kinds are kept.
+type Preferences struct {
+	userID string
+	muted  []Kind
+}
+
+// ReconstructPreferences reconstructs the Preferences of a user. Pass nil muted kinds for a user without settings.
+func ReconstructPreferences(userID string, muted []Kind) *Preferences {
+	return &Preferences{
+		userID: userID,
+		muted:  muted,
+	}
+}
+
+// UserID returns the ID of the user the preferences belong to.
+func (ps *Preferences) UserID() string { return ps.userID }
+
+// Muted returns the kinds the user does not want to be notified about, in the order of Kinds.
+func (ps *Preferences) Muted() []Kind {
+	muted := make([]Kind, 0, len(ps.muted))
+	for _, k := range Kinds() {
+		if slices.Contains(ps.muted, k) {
+			muted = append(muted, k)
+		}
+	}
+	return muted
+}
+
+// Enabled reports whether the user wants to be notified about the kind.
+func (ps *Preferences) Enabled(kind Kind) bool {
+	return !slices.Contains(ps.muted, kind)
+}
+
+// Set enables or mutes the kind. Only the user can change their own preferences.
+func (ps *Preferences) Set(user *duser.User, kind Kind, enabled bool) error {
+	if user == nil {
+		return duser.ErrUserNotFound
+	}
+	if user.ID() != ps.userID {
+		return ErrNotPreferencesOwner
+	}
+	if !kind.Valid() {
+		return ErrInvalidKind
+	}
+
+	ps.muted = slices.DeleteFunc(ps.muted, func(k Kind) bool { return k == kind })
+	if !enabled {
+		ps.muted = append(ps.muted, kind)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestPreferences_Set(t *testing.T) {
+	owner := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	other := duser.ReconstructUser("user-2", "uid-2", "google", nil)
+
+	tests := []struct {
+		name    string
+		muted   []Kind
+		user    *duser.User
+		kind    Kind
+		enabled bool
+		want    []Kind
+		err     error
+	}{
+		{
+			name: "mute",
+			user: owner,
+			kind: KindLinksAdded,
+			want: []Kind{KindLinksAdded},
+		},
+		{
+			name:    "enable",
+			muted:   []Kind{KindPageArchived, KindLinksAdded},
+			user:    owner,
+			kind:    KindLinksAdded,
+			enabled: true,
+			want:    []Kind{KindPageArchived},
+		},
+		{
+			name:  "mute_twice_keeps_one_entry",
+			muted: []Kind{KindMemberJoined},
+			user:  owner,
+			kind:  KindMemberJoined,
+			want:  []Kind{KindMemberJoined},
+		},
+		{
+			name:  "muted_in_kinds_order",
+			muted: []Kind{KindPageArchived},
+			user:  owner,
+			kind:  KindMemberJoined,
+			want:  []Kind{KindMemberJoined, KindPageArchived},
+		},
+		{name: "other_user", user: other, kind: KindLinksAdded, want: []Kind{}, err: ErrNotPreferencesOwner},
+		{name: "nil_user", kind: KindLinksAdded, want: []Kind{}, err: duser.ErrUserNotFound},
+		{name: "invalid_kind", user: owner, kind: "unknown", want: []Kind{}, err: ErrInvalidKind},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences("user-1", tt.muted)
+			err := ps.Set(tt.user, tt.kind, tt.enabled)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, ps.Muted()); diff != "" {
+				t.Errorf("Muted() mismatch (-want +got):\n%s", diff)
+			}
+			for _, k := range Kinds() {
+				if got, want := ps.Enabled(k), !slices.Contains(tt.want, k); got != want {
+					t.Errorf("Enabled(%s) = %v, want %v", k, got, want)
+				}
+			}
+		})
+	}
+}
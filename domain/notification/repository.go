@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_notification/notification.go -source=./repository.go -package=mocknotification
+
+type NotificationRepository interface {
+	// List returns the notifications of the recipient, newest first.
+	List(ctx context.Context, recipientID string, options ...SearchOption) ([]*Notification, error)
+	// CountUnread returns the number of unread notifications of the recipient.
+	CountUnread(ctx context.Context, recipientID string) (int, error)
+	// Create stores new notifications in one batch.
+	Create(ctx context.Context, notifications []*Notification) error
+	// MarkRead marks the unread notifications of the recipient as read at readAt. An empty ids marks all of them.
+	// IDs of notifications addressed to other users are ignored.
+	MarkRead(ctx context.Context, recipientID string, ids []string, readAt time.Time) error
+}
+
+// PreferenceRepository stores the notification settings of users.
+type PreferenceRepository interface {
+	// Get returns the preferences of the user. A user without settings gets empty preferences.
+	Get(ctx context.Context, userID string) (*Preferences, error)
+	// ListByUserIDs returns the preferences of the users in the given order, including empty ones.
+	ListByUserIDs(ctx context.Context, userIDs []string) ([]*Preferences, error)
+	Save(ctx context.Context, preferences *Preferences) error
+}
+
+type SearchParams struct {
+	UnreadOnly bool
+	Page       *int32
+	PageSize   *int32
+}
+
+type SearchOption interface {
+	Apply(*SearchParams)
+}
+
+type optionFunc func(*SearchParams)
+
+func (f optionFunc) Apply(p *SearchParams) {
+	f(p)
+}
+
+// WithUnreadOnly leaves read notifications out.
+func WithUnreadOnly(unreadOnly bool) SearchOption {
+	return optionFunc(func(p *SearchParams) {
+		p.UnreadOnly = unreadOnly
+	})
+}
+
+func WithPageSearchOption(page int32) SearchOption {
+	return optionFunc(func(p *SearchParams) {
+		p.Page = &page
+	})
+}
+
+func WithPageSizeSearchOption(pageSize int32) SearchOption {
+	return optionFunc(func(p *SearchParams) {
+		p.PageSize = &pageSize
+	})
+}
@@ -0,0 +1,51 @@
+package page
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxDescriptionLength is the maximum number of characters in a page description.
+const MaxDescriptionLength = 2000
+
+// ValidateTitle validates that the title is non-empty and at most MaxTitleLength characters.
+func ValidateTitle(title string) error {
+	if title == "" {
+		return ErrNoTitleProvided
+	}
+	if utf8.RuneCountInString(title) > MaxTitleLength {
+		return ErrTitleTooLong
+	}
+	return nil
+}
+
+func validateDescription(description string) error {
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return ErrDescriptionTooLong
+	}
+	return nil
+}
+
+// validateIcon validates that the icon is empty or a single emoji.
+func validateIcon(icon string) error {
+	if icon != "" && !isEmoji(icon) {
+		return ErrInvalidIcon
+	}
+	return nil
+}
+
+// normalizeColor validates that the color is empty or a "#rrggbb" hex color and returns it in lower case.
+func normalizeColor(color string) (string, error) {
+	if color == "" {
+		return "", nil
+	}
+	if len(color) != 7 || color[0] != '#' {
+		return "", ErrInvalidColor
+	}
+	for _, c := range color[1:] {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return "", ErrInvalidColor
+		}
+	}
+	return strings.ToLower(color), nil
+}
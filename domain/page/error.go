@@ -10,16 +10,56 @@ var (
 	ErrInvalidInviteCode  = errors.New("invalid invite code")
 	ErrAlreadyJoined      = errors.New("user already joined the page")
 	ErrCreatorCannotJoin  = errors.New("page creator cannot join the page")
+	ErrInvalidFeedToken   = errors.New("invalid feed token")
+	ErrNoLinksProvided    = errors.New("no links provided")
+	ErrNoPageProvided     = errors.New("no page provided")
+	ErrSamePage           = errors.New("source and target pages are the same")
+
+	ErrNoSectionNameProvided = errors.New("no section name provided")
+	ErrSectionNameTooLong    = errors.New("section name too long")
+	ErrInvalidSectionsLength = errors.New("invalid sections length")
+	ErrNotFoundSection       = errors.New("section not found")
+
+	ErrInvalidReaction  = errors.New("invalid reaction")
+	ErrInvalidLinkOrder = errors.New("invalid link order")
+
+	ErrInvalidLinkStatus = errors.New("invalid link status")
+	ErrNotChecklist      = errors.New("page is not in checklist mode")
+
+	ErrPageArchived = errors.New("page is archived")
+
+	ErrInvalidPageOrder   = errors.New("invalid page order")
+	ErrNotPreferenceOwner = errors.New("preferences belong to another user")
+
+	ErrTitleTooLong       = errors.New("title too long")
+	ErrDescriptionTooLong = errors.New("description too long")
+	ErrInvalidIcon        = errors.New("invalid icon")
+	ErrInvalidColor       = errors.New("invalid color")
+
+	ErrInvalidCaptureURL = errors.New("invalid capture url")
+
+	ErrNoLinkRedirect = errors.New("link has no redirect to accept")
+
+	ErrInvalidSlug = errors.New("invalid slug")
+	ErrSlugTaken   = errors.New("slug already used by another page")
 )
 
 type NotFoundLinkError struct {
 	URL string
+	ID  string
 }
 
 func (e *NotFoundLinkError) Error() string {
+	if e.URL == "" && e.ID != "" {
+		return "link not found: id=" + e.ID
+	}
 	return "link not found: " + e.URL
 }
 
 func ErrNotFoundLink(url string) *NotFoundLinkError {
 	return &NotFoundLinkError{URL: url}
 }
+
+func ErrNotFoundLinkByID(id string) *NotFoundLinkError {
+	return &NotFoundLinkError{ID: id}
+}
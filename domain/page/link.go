@@ -1,13 +1,38 @@
 package page
 
-import "slices"
+import (
+	"net/url"
+	"slices"
+	"time"
+)
+
+// MaxCaptureURLLength is the maximum number of bytes in a captured URL.
+const MaxCaptureURLLength = 2048
 
 type Link struct {
-	url      string
-	memo     string
-	priority int
+	id        string
+	url       string
+	memo      string
+	priority  int
+	createdAt time.Time
+	// sectionID is the ID of the section the link belongs to. Empty means the link is not in a section.
+	sectionID string
+	// reactions are the reactions of the page members to the link.
+	reactions Reactions
+	// states are the statuses of the page members on the link.
+	states LinkStates
+	// doneBy is the ID of the member who marked the link done in checklist mode. It may be empty for a done
+	// link if that member has been deleted.
+	doneBy string
+	// doneAt is the time the link was marked done. Zero means the link is not done.
+	doneAt time.Time
+	// health is the outcome of the last check by the dead link checker. Nil means the link has not been checked.
+	health *LinkHealth
 }
 
+// ID returns the link ID. It is empty until the link has been persisted.
+func (l Link) ID() string { return l.id }
+
 // URL returns the link URL.
 func (l Link) URL() string { return l.url }
 
@@ -17,18 +42,91 @@ func (l Link) Memo() string { return l.memo }
 // Priority returns the link priority.
 func (l Link) Priority() int { return l.priority }
 
+// CreatedAt returns the time the link was added to the page.
+func (l Link) CreatedAt() time.Time { return l.createdAt }
+
+// SectionID returns the ID of the section the link belongs to, or an empty string.
+func (l Link) SectionID() string { return l.sectionID }
+
+// Reactions returns the reactions of the page members to the link.
+func (l Link) Reactions() Reactions { return l.reactions }
+
+// States returns the statuses of the page members on the link.
+func (l Link) States() LinkStates { return l.states }
+
+// Done reports whether the link is marked done in checklist mode.
+func (l Link) Done() bool { return !l.doneAt.IsZero() }
+
+// DoneBy returns the ID of the member who marked the link done.
+func (l Link) DoneBy() string { return l.doneBy }
+
+// DoneAt returns the time the link was marked done, or the zero time.
+func (l Link) DoneAt() time.Time { return l.doneAt }
+
+// Health returns the outcome of the last check of the link. It is the zero LinkHealth if the link has not been checked.
+func (l Link) Health() LinkHealth {
+	if l.health == nil {
+		return LinkHealth{}
+	}
+	return *l.health
+}
+
+// toggleDone marks the link done by the user at the given time, or clears the flag if it is already done.
+func (l *Link) toggleDone(userID string, now time.Time) {
+	if l.Done() {
+		l.doneBy = ""
+		l.doneAt = time.Time{}
+		return
+	}
+	l.doneBy = userID
+	l.doneAt = now
+}
+
+// NewLink creates a link that has not been added to a page yet.
+func NewLink(url string, memo string) Link {
+	return Link{
+		url:  url,
+		memo: memo,
+	}
+}
+
+// validateCaptureURL checks that a captured URL is an absolute http or https URL.
+func validateCaptureURL(rawURL string) error {
+	if len(rawURL) > MaxCaptureURLLength || !IsWebURL(rawURL) {
+		return ErrInvalidCaptureURL
+	}
+	return nil
+}
+
+// IsWebURL reports whether the URL is an absolute http or https URL.
+func IsWebURL(rawURL string) bool {
+	if rawURL == "" {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 type Links []Link
 
-// addLink adds a new link to the Links slice.
+// addLink adds a new link to the end of the Links slice.
 func (ls *Links) addLink(url string, memo string) {
 	newLink := Link{
 		url:      url,
 		memo:     memo,
-		priority: len(*ls),
+		priority: len(*ls) + 1,
 	}
 	*ls = append(*ls, newLink)
 }
 
+// addLinks adds the links to the end of the Links slice in the given order.
+// The links are added as new links, so any ID, creation time or section they carry is dropped.
+func (ls *Links) addLinks(links Links) {
+	for _, l := range links {
+		ls.addLink(l.url, l.memo)
+	}
+}
+
 // removeLink removes a link by its URL.
 func (ls *Links) removeLink(url string) error {
 	deletedIdx, err := ls.getIndexByURL(url)
@@ -39,12 +137,56 @@ func (ls *Links) removeLink(url string) error {
 	// Remove the link
 	*ls = slices.Delete(*ls, deletedIdx, deletedIdx+1)
 
-	// Update priorities
+	ls.renumber()
+
+	return nil
+}
+
+// removeLinksByID removes the links with the given IDs and returns them in priority order.
+// If any of the IDs is not found nothing is removed.
+func (ls *Links) removeLinksByID(ids []string) (Links, error) {
+	removed, err := ls.getLinksByID(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	*ls = slices.DeleteFunc(*ls, func(l Link) bool {
+		return slices.ContainsFunc(removed, func(r Link) bool { return r.id == l.id })
+	})
+
+	ls.renumber()
+
+	return removed, nil
+}
+
+// getLinksByID returns the links with the given IDs in priority order. Duplicate IDs are ignored.
+func (ls Links) getLinksByID(ids []string) (Links, error) {
+	found := make(Links, 0, len(ids))
+	for _, id := range ids {
+		if slices.ContainsFunc(found, func(l Link) bool { return l.id == id }) {
+			continue
+		}
+		idx := slices.IndexFunc(ls, func(l Link) bool { return id != "" && l.id == id })
+		if idx == -1 {
+			return nil, ErrNotFoundLinkByID(id)
+		}
+		found = append(found, ls[idx])
+	}
+
+	slices.SortStableFunc(found, func(a, b Link) int {
+		return a.priority - b.priority
+	})
+	return found, nil
+}
+
+// renumber sorts the links by priority and reassigns priorities starting from 1.
+func (ls *Links) renumber() {
+	slices.SortStableFunc(*ls, func(a, b Link) int {
+		return a.priority - b.priority
+	})
 	for i := range *ls {
 		(*ls)[i].priority = i + 1
 	}
-
-	return nil
 }
 
 // editLink edits a link's order and memo.
@@ -58,10 +200,19 @@ func (ls *Links) editLinks(links Links) error {
 	})
 
 	for i, link := range links {
-		_, err := ls.getIndexByURL(link.url)
+		idx, err := ls.getIndexByURL(link.url)
 		if err != nil {
 			return err
 		}
+		// Keep the identity, section, reactions, member states and done flag of the existing link so that they survive reordering.
+		links[i].id = (*ls)[idx].id
+		links[i].createdAt = (*ls)[idx].createdAt
+		links[i].sectionID = (*ls)[idx].sectionID
+		links[i].reactions = (*ls)[idx].reactions
+		links[i].states = (*ls)[idx].states
+		links[i].doneBy = (*ls)[idx].doneBy
+		links[i].doneAt = (*ls)[idx].doneAt
+		links[i].health = (*ls)[idx].health
 		links[i].priority = i + 1
 	}
 
@@ -70,6 +221,34 @@ func (ls *Links) editLinks(links Links) error {
 	return nil
 }
 
+// moveToSection moves the links with the given IDs to the end of the section, keeping their relative order.
+// An empty sectionID moves the links out of any section. If any of the IDs is not found nothing is moved.
+func (ls *Links) moveToSection(ids []string, sectionID string) error {
+	moved, err := ls.getLinksByID(ids)
+	if err != nil {
+		return err
+	}
+
+	last := len(*ls)
+	for i, m := range moved {
+		idx := slices.IndexFunc(*ls, func(l Link) bool { return l.id == m.id })
+		(*ls)[idx].sectionID = sectionID
+		(*ls)[idx].priority = last + i + 1
+	}
+
+	ls.renumber()
+	return nil
+}
+
+// clearSection moves the links of the section out of any section.
+func (ls Links) clearSection(sectionID string) {
+	for i := range ls {
+		if ls[i].sectionID == sectionID {
+			ls[i].sectionID = ""
+		}
+	}
+}
+
 // getIndexByURL returns the index of the link with the given URL.
 func (ls Links) getIndexByURL(url string) (int, error) {
 	idx := slices.IndexFunc(ls, func(l Link) bool {
@@ -82,10 +261,70 @@ func (ls Links) getIndexByURL(url string) (int, error) {
 }
 
 // ReconstructLink reconstructs a Link from its components.
-func ReconstructLink(url string, memo string, priority int) Link {
-	return Link{
+func ReconstructLink(url string, memo string, priority int, options ...LinkReconstructOption) Link {
+	l := Link{
 		url:      url,
 		memo:     memo,
 		priority: priority,
 	}
+	for _, opt := range options {
+		opt(&l)
+	}
+	return l
+}
+
+type LinkReconstructOption func(*Link)
+
+// WithLinkID sets the persisted ID of the link.
+func WithLinkID(id string) LinkReconstructOption {
+	return func(l *Link) {
+		l.id = id
+	}
+}
+
+// WithLinkSectionID sets the ID of the section the link belongs to.
+func WithLinkSectionID(sectionID string) LinkReconstructOption {
+	return func(l *Link) {
+		l.sectionID = sectionID
+	}
+}
+
+// WithLinkReactions sets the reactions of the page members to the link.
+func WithLinkReactions(reactions Reactions) LinkReconstructOption {
+	return func(l *Link) {
+		l.reactions = reactions
+	}
+}
+
+// WithLinkStates sets the statuses of the page members on the link.
+func WithLinkStates(states LinkStates) LinkReconstructOption {
+	return func(l *Link) {
+		l.states = states
+	}
+}
+
+// WithLinkDone marks the link done by the member at the given time.
+func WithLinkDone(userID string, doneAt time.Time) LinkReconstructOption {
+	return func(l *Link) {
+		l.doneBy = userID
+		l.doneAt = doneAt
+	}
+}
+
+// WithLinkHealth sets the outcome of the last check of the link. A zero LinkHealth leaves the link unchecked.
+func WithLinkHealth(health LinkHealth) LinkReconstructOption {
+	return func(l *Link) {
+		if health.checkedAt.IsZero() {
+			l.health = nil
+			return
+		}
+		l.health = &health
+	}
+}
+
+// WithLinkCreatedAt sets the time the link was added to the page.
+func WithLinkCreatedAt(createdAt time.Time) LinkReconstructOption {
+	return func(l *Link) {
+		l.createdAt = createdAt
+	}
 }
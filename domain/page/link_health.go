@@ -0,0 +1,66 @@
+package page
+
+import "time"
+
+// LinkHealthStatus summarizes the last check of a link by the dead link checker.
+type LinkHealthStatus string
+
+const (
+	// LinkHealthUnchecked links have not been checked yet.
+	LinkHealthUnchecked LinkHealthStatus = ""
+	// LinkHealthOK links answered, possibly with a status like 401 or 429 that says nothing about the link itself.
+	LinkHealthOK LinkHealthStatus = "ok"
+	// LinkHealthRedirected links answered with a redirect. The new URL can be accepted by the page creator.
+	LinkHealthRedirected LinkHealthStatus = "redirected"
+	// LinkHealthBroken links could not be reached, are gone or failed on the server.
+	LinkHealthBroken LinkHealthStatus = "broken"
+)
+
+// LinkHealth is the outcome of the last check of a link.
+type LinkHealth struct {
+	// statusCode is the HTTP status of the response. Zero means no response was received.
+	statusCode int
+	// redirectURL is the absolute target of a redirect response, or empty.
+	redirectURL string
+	// checkedAt is the time of the check. Zero means the link has not been checked.
+	checkedAt time.Time
+}
+
+// NewLinkHealth creates the outcome of a check at the given time. The redirect URL is only kept for redirect responses.
+func NewLinkHealth(statusCode int, redirectURL string, checkedAt time.Time) LinkHealth {
+	if !isRedirect(statusCode) {
+		redirectURL = ""
+	}
+	return LinkHealth{
+		statusCode:  statusCode,
+		redirectURL: redirectURL,
+		checkedAt:   checkedAt,
+	}
+}
+
+// StatusCode returns the HTTP status of the response, or zero if the link could not be reached.
+func (h LinkHealth) StatusCode() int { return h.statusCode }
+
+// RedirectURL returns the target of the redirect, or an empty string.
+func (h LinkHealth) RedirectURL() string { return h.redirectURL }
+
+// CheckedAt returns the time of the check, or the zero time if the link has not been checked.
+func (h LinkHealth) CheckedAt() time.Time { return h.checkedAt }
+
+// Status summarizes the check. Only unreachable links, 404, 410 and server errors count as broken,
+// since other client errors usually mean the checker was turned away rather than that the link is dead.
+func (h LinkHealth) Status() LinkHealthStatus {
+	switch {
+	case h.checkedAt.IsZero():
+		return LinkHealthUnchecked
+	case h.redirectURL != "":
+		return LinkHealthRedirected
+	case h.statusCode == 0, h.statusCode == 404, h.statusCode == 410, h.statusCode >= 500:
+		return LinkHealthBroken
+	}
+	return LinkHealthOK
+}
+
+func isRedirect(statusCode int) bool {
+	return statusCode >= 300 && statusCode < 400
+}
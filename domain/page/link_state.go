@@ -0,0 +1,71 @@
+package page
+
+import "slices"
+
+// LinkStatus is a member's own progress on a link.
+type LinkStatus string
+
+const (
+	// LinkStatusUnread links have not been looked at by the member. Links without a stored state are unread.
+	LinkStatusUnread LinkStatus = "unread"
+	// LinkStatusRead links have been looked at by the member.
+	LinkStatusRead LinkStatus = "read"
+	// LinkStatusDone links have been finished by the member.
+	LinkStatusDone LinkStatus = "done"
+)
+
+var validLinkStatuses = []LinkStatus{
+	LinkStatusUnread,
+	LinkStatusRead,
+	LinkStatusDone,
+}
+
+// isValid checks if the given status is valid.
+func (s LinkStatus) isValid() error {
+	if !slices.Contains(validLinkStatuses, s) {
+		return ErrInvalidLinkStatus
+	}
+	return nil
+}
+
+// LinkState is a member's status on a link.
+type LinkState struct {
+	userID string
+	status LinkStatus
+}
+
+// UserID returns the ID of the member the state belongs to.
+func (s LinkState) UserID() string { return s.userID }
+
+// Status returns the member's status on the link.
+func (s LinkState) Status() LinkStatus { return s.status }
+
+// ReconstructLinkState reconstructs a LinkState from its components.
+func ReconstructLinkState(userID string, status LinkStatus) LinkState {
+	return LinkState{
+		userID: userID,
+		status: status,
+	}
+}
+
+type LinkStates []LinkState
+
+// Of returns the status of the user, LinkStatusUnread if the user has no state.
+func (ss LinkStates) Of(userID string) LinkStatus {
+	idx := slices.IndexFunc(ss, func(s LinkState) bool { return s.userID == userID })
+	if idx < 0 {
+		return LinkStatusUnread
+	}
+	return ss[idx].status
+}
+
+// set sets the status of the user, replacing any previous one.
+func (ss *LinkStates) set(userID string, status LinkStatus) LinkState {
+	state := LinkState{userID: userID, status: status}
+	if idx := slices.IndexFunc(*ss, func(s LinkState) bool { return s.userID == userID }); idx >= 0 {
+		(*ss)[idx] = state
+		return state
+	}
+	*ss = append(*ss, state)
+	return state
+}
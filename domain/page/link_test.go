@@ -2,6 +2,7 @@ package page
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
@@ -36,7 +37,7 @@ func TestLinks_addLink(t *testing.T) {
 			},
 			want: want{
 				links: Links{
-					{url: "https://x", memo: "m", priority: 0},
+					{url: "https://x", memo: "m", priority: 1},
 				},
 			},
 		},
@@ -44,7 +45,7 @@ func TestLinks_addLink(t *testing.T) {
 			name: "add_to_existing",
 			fields: fields{
 				Links{
-					{url: "a", memo: "A", priority: 0},
+					{url: "a", memo: "A", priority: 1},
 				},
 			},
 			args: args{
@@ -53,8 +54,8 @@ func TestLinks_addLink(t *testing.T) {
 			},
 			want: want{
 				links: Links{
-					{url: "a", memo: "A", priority: 0},
-					{url: "https://b", memo: "B", priority: 1},
+					{url: "a", memo: "A", priority: 1},
+					{url: "https://b", memo: "B", priority: 2},
 				},
 			},
 		},
@@ -143,6 +144,145 @@ func TestLinks_removeLink(t *testing.T) {
 	}
 }
 
+func TestLinks_removeLinksByID(t *testing.T) {
+	type fields struct {
+		links Links
+	}
+	type args struct {
+		ids []string
+	}
+	type want struct {
+		links   Links
+		removed Links
+		err     error
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "remove_success_renumbers",
+			fields: fields{
+				links: Links{
+					{id: "1", url: "a", priority: 1},
+					{id: "2", url: "b", priority: 2},
+					{id: "3", url: "c", priority: 3},
+					{id: "4", url: "d", priority: 4},
+				},
+			},
+			args: args{ids: []string{"3", "1", "3"}},
+			want: want{
+				links: Links{
+					{id: "2", url: "b", priority: 1},
+					{id: "4", url: "d", priority: 2},
+				},
+				removed: Links{
+					{id: "1", url: "a", priority: 1},
+					{id: "3", url: "c", priority: 3},
+				},
+			},
+		},
+		{
+			name: "remove_not_found_keeps_links",
+			fields: fields{
+				links: Links{
+					{id: "1", url: "a", priority: 1},
+					{id: "2", url: "b", priority: 2},
+				},
+			},
+			args: args{ids: []string{"1", "9"}},
+			want: want{
+				links: Links{
+					{id: "1", url: "a", priority: 1},
+					{id: "2", url: "b", priority: 2},
+				},
+				err: ErrNotFoundLinkByID("9"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			removed, err := tt.fields.links.removeLinksByID(tt.args.ids)
+			testutil.EqualErr(t, tt.want.err, err)
+
+			if diff := cmp.Diff(tt.want.removed, removed, cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("removed links mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want.links, tt.fields.links, cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLinks_moveToSection(t *testing.T) {
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1, sectionID: "s1"},
+			{id: "2", url: "b", priority: 2},
+			{id: "3", url: "c", priority: 3},
+			{id: "4", url: "d", priority: 4, sectionID: "s1"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ids       []string
+		sectionID string
+		want      Links
+		err       error
+	}{
+		{
+			name:      "move_into_section_appends",
+			ids:       []string{"3", "2"},
+			sectionID: "s1",
+			want: Links{
+				{id: "1", url: "a", priority: 1, sectionID: "s1"},
+				{id: "4", url: "d", priority: 2, sectionID: "s1"},
+				{id: "2", url: "b", priority: 3, sectionID: "s1"},
+				{id: "3", url: "c", priority: 4, sectionID: "s1"},
+			},
+		},
+		{
+			name: "move_out_of_section",
+			ids:  []string{"1"},
+			want: Links{
+				{id: "2", url: "b", priority: 1},
+				{id: "3", url: "c", priority: 2},
+				{id: "4", url: "d", priority: 3, sectionID: "s1"},
+				{id: "1", url: "a", priority: 4},
+			},
+		},
+		{
+			name:      "not_found_keeps_links",
+			ids:       []string{"2", "9"},
+			sectionID: "s1",
+			want:      original(),
+			err:       ErrNotFoundLinkByID("9"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			links := original()
+			err := links.moveToSection(tt.ids, tt.sectionID)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, links, cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLinks_editLinks(t *testing.T) {
 	type fields struct {
 		links Links
@@ -186,6 +326,48 @@ func TestLinks_editLinks(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "edit_keeps_link_identity",
+			fields: fields{
+				links: Links{
+					{id: "id-a", url: "a", memo: "A", priority: 1, createdAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{id: "id-b", url: "b", memo: "B", priority: 2, createdAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			args: args{
+				links: Links{
+					{url: "b", memo: "B-mod", priority: 1},
+					{url: "a", memo: "A", priority: 2},
+				},
+			},
+			want: want{
+				links: Links{
+					{id: "id-b", url: "b", memo: "B-mod", priority: 1, createdAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+					{id: "id-a", url: "a", memo: "A", priority: 2, createdAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+		{
+			name: "edit_keeps_section",
+			fields: fields{
+				links: Links{
+					{id: "id-a", url: "a", memo: "A", priority: 1, sectionID: "s1"},
+					{id: "id-b", url: "b", memo: "B", priority: 2},
+				},
+			},
+			args: args{
+				links: Links{
+					{url: "b", memo: "B", priority: 1},
+					{url: "a", memo: "A", priority: 2},
+				},
+			},
+			want: want{
+				links: Links{
+					{id: "id-b", url: "b", memo: "B", priority: 1},
+					{id: "id-a", url: "a", memo: "A", priority: 2, sectionID: "s1"},
+				},
+			},
+		},
 		{
 			name: "edit_not_found",
 			fields: fields{
@@ -247,6 +429,7 @@ func TestReconstructLink(t *testing.T) {
 		url      string
 		memo     string
 		priority int
+		options  []LinkReconstructOption
 	}
 	tests := []struct {
 		name string
@@ -258,6 +441,19 @@ func TestReconstructLink(t *testing.T) {
 			args: args{url: "https://x", memo: "memo", priority: 3},
 			want: Link{url: "https://x", memo: "memo", priority: 3},
 		},
+		{
+			name: "with_options",
+			args: args{
+				url:      "https://x",
+				memo:     "memo",
+				priority: 1,
+				options: []LinkReconstructOption{
+					WithLinkID("link-id"),
+					WithLinkCreatedAt(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			want: Link{id: "link-id", url: "https://x", memo: "memo", priority: 1, createdAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
 		{
 			name: "empty_fields",
 			args: args{url: "", memo: "", priority: 0},
@@ -269,10 +465,77 @@ func TestReconstructLink(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := ReconstructLink(tt.args.url, tt.args.memo, tt.args.priority)
+			got := ReconstructLink(tt.args.url, tt.args.memo, tt.args.priority, tt.args.options...)
 			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Link{})); diff != "" {
 				t.Fatalf("link mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestLinkHealth_Status(t *testing.T) {
+	checkedAt := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		health       LinkHealth
+		want         LinkHealthStatus
+		wantRedirect string
+	}{
+		{name: "unchecked", health: LinkHealth{}, want: LinkHealthUnchecked},
+		{name: "ok", health: NewLinkHealth(200, "", checkedAt), want: LinkHealthOK},
+		{name: "turned_away", health: NewLinkHealth(403, "", checkedAt), want: LinkHealthOK},
+		{name: "not_found", health: NewLinkHealth(404, "", checkedAt), want: LinkHealthBroken},
+		{name: "gone", health: NewLinkHealth(410, "", checkedAt), want: LinkHealthBroken},
+		{name: "server_error", health: NewLinkHealth(503, "", checkedAt), want: LinkHealthBroken},
+		{name: "unreachable", health: NewLinkHealth(0, "", checkedAt), want: LinkHealthBroken},
+		{
+			name:         "redirect",
+			health:       NewLinkHealth(301, "https://example.com/new", checkedAt),
+			want:         LinkHealthRedirected,
+			wantRedirect: "https://example.com/new",
+		},
+		{name: "redirect_url_of_other_status_is_dropped", health: NewLinkHealth(200, "https://example.com/new", checkedAt), want: LinkHealthOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.health.Status(); got != tt.want {
+				t.Fatalf("Status() = %q, want %q", got, tt.want)
+			}
+			if got := tt.health.RedirectURL(); got != tt.wantRedirect {
+				t.Fatalf("RedirectURL() = %q, want %q", got, tt.wantRedirect)
+			}
+		})
+	}
+}
+
+func TestIsWebURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "https", url: "https://example.com/a?b=c", want: true},
+		{name: "http", url: "http://example.com", want: true},
+		{name: "empty", url: ""},
+		{name: "javascript", url: "javascript:alert(1)"},
+		{name: "data", url: "data:text/html,<script>alert(1)</script>"},
+		{name: "scheme_relative", url: "//example.com"},
+		{name: "relative", url: "/pages/1"},
+		{name: "no_host", url: "https:///path"},
+		{name: "other_scheme", url: "ftp://example.com"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsWebURL(tt.url); got != tt.want {
+				t.Fatalf("IsWebURL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
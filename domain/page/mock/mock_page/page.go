@@ -12,6 +12,7 @@ package mockpage
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	page "github.com/naka-sei/tsudzuri/domain/page"
 	gomock "go.uber.org/mock/gomock"
@@ -105,6 +106,241 @@ func (mr *MockPageRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPageRepository)(nil).Save), ctx, arg1)
 }
 
+// MockSlugRepository is a mock of SlugRepository interface.
+type MockSlugRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlugRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSlugRepositoryMockRecorder is the mock recorder for MockSlugRepository.
+type MockSlugRepositoryMockRecorder struct {
+	mock *MockSlugRepository
+}
+
+// NewMockSlugRepository creates a new mock instance.
+func NewMockSlugRepository(ctrl *gomock.Controller) *MockSlugRepository {
+	mock := &MockSlugRepository{ctrl: ctrl}
+	mock.recorder = &MockSlugRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlugRepository) EXPECT() *MockSlugRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockSlugRepository) Resolve(ctx context.Context, slug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, slug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockSlugRepositoryMockRecorder) Resolve(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockSlugRepository)(nil).Resolve), ctx, slug)
+}
+
+// MockReactionRepository is a mock of ReactionRepository interface.
+type MockReactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReactionRepositoryMockRecorder is the mock recorder for MockReactionRepository.
+type MockReactionRepositoryMockRecorder struct {
+	mock *MockReactionRepository
+}
+
+// NewMockReactionRepository creates a new mock instance.
+func NewMockReactionRepository(ctrl *gomock.Controller) *MockReactionRepository {
+	mock := &MockReactionRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionRepository) EXPECT() *MockReactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockReactionRepository) Delete(ctx context.Context, linkID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, linkID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReactionRepositoryMockRecorder) Delete(ctx, linkID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReactionRepository)(nil).Delete), ctx, linkID, userID)
+}
+
+// Save mocks base method.
+func (m *MockReactionRepository) Save(ctx context.Context, linkID string, reaction page.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, linkID, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReactionRepositoryMockRecorder) Save(ctx, linkID, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReactionRepository)(nil).Save), ctx, linkID, reaction)
+}
+
+// MockLinkStateRepository is a mock of LinkStateRepository interface.
+type MockLinkStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkStateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkStateRepositoryMockRecorder is the mock recorder for MockLinkStateRepository.
+type MockLinkStateRepositoryMockRecorder struct {
+	mock *MockLinkStateRepository
+}
+
+// NewMockLinkStateRepository creates a new mock instance.
+func NewMockLinkStateRepository(ctrl *gomock.Controller) *MockLinkStateRepository {
+	mock := &MockLinkStateRepository{ctrl: ctrl}
+	mock.recorder = &MockLinkStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkStateRepository) EXPECT() *MockLinkStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Save mocks base method.
+func (m *MockLinkStateRepository) Save(ctx context.Context, linkIDs []string, state page.LinkState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, linkIDs, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLinkStateRepositoryMockRecorder) Save(ctx, linkIDs, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLinkStateRepository)(nil).Save), ctx, linkIDs, state)
+}
+
+// MockLinkHealthRepository is a mock of LinkHealthRepository interface.
+type MockLinkHealthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkHealthRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkHealthRepositoryMockRecorder is the mock recorder for MockLinkHealthRepository.
+type MockLinkHealthRepositoryMockRecorder struct {
+	mock *MockLinkHealthRepository
+}
+
+// NewMockLinkHealthRepository creates a new mock instance.
+func NewMockLinkHealthRepository(ctrl *gomock.Controller) *MockLinkHealthRepository {
+	mock := &MockLinkHealthRepository{ctrl: ctrl}
+	mock.recorder = &MockLinkHealthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkHealthRepository) EXPECT() *MockLinkHealthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListDue mocks base method.
+func (m *MockLinkHealthRepository) ListDue(ctx context.Context, checkedBefore time.Time, limit int) (page.Links, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, checkedBefore, limit)
+	ret0, _ := ret[0].(page.Links)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockLinkHealthRepositoryMockRecorder) ListDue(ctx, checkedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockLinkHealthRepository)(nil).ListDue), ctx, checkedBefore, limit)
+}
+
+// Save mocks base method.
+func (m *MockLinkHealthRepository) Save(ctx context.Context, link page.Link, health page.LinkHealth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, link, health)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLinkHealthRepositoryMockRecorder) Save(ctx, link, health any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLinkHealthRepository)(nil).Save), ctx, link, health)
+}
+
+// MockPreferenceRepository is a mock of PreferenceRepository interface.
+type MockPreferenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPreferenceRepositoryMockRecorder is the mock recorder for MockPreferenceRepository.
+type MockPreferenceRepositoryMockRecorder struct {
+	mock *MockPreferenceRepository
+}
+
+// NewMockPreferenceRepository creates a new mock instance.
+func NewMockPreferenceRepository(ctrl *gomock.Controller) *MockPreferenceRepository {
+	mock := &MockPreferenceRepository{ctrl: ctrl}
+	mock.recorder = &MockPreferenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceRepository) EXPECT() *MockPreferenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPreferenceRepository) Get(ctx context.Context, userID string) (*page.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*page.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPreferenceRepositoryMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPreferenceRepository)(nil).Get), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockPreferenceRepository) Save(ctx context.Context, preferences *page.Preferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPreferenceRepositoryMockRecorder) Save(ctx, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPreferenceRepository)(nil).Save), ctx, preferences)
+}
+
 // MockSearchOption is a mock of SearchOption interface.
 type MockSearchOption struct {
 	ctrl     *gomock.Controller
@@ -2,8 +2,13 @@ package page
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"slices"
+	"time"
 
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 )
@@ -14,13 +19,43 @@ type Page struct {
 	createdBy    duser.User
 	inviteCode   string
 	links        Links
+	sections     Sections
 	invitedUsers duser.Users
+	// feedTokenHash is the SHA-256 hex digest of the secret feed token. Empty means feeds are disabled.
+	feedTokenHash string
+	// sourcePageID is the ID of the page this page was duplicated from, if any.
+	sourcePageID string
+	// checklist reports whether the links of the page have a shared done flag.
+	checklist bool
+	// archived reports whether the page is frozen as read-only.
+	archived bool
+	// description is free text in Markdown. It is stored as written and sanitized when rendered.
+	description string
+	// icon is a single emoji shown with the title, or empty.
+	icon string
+	// color is the accent color in "#rrggbb" form, or empty.
+	color string
+	// slug is the current short name the page can be addressed by instead of its ID.
+	slug      string
+	createdAt time.Time
+	updatedAt time.Time
 }
 
+const (
+	// MaxTitleLength is the maximum number of characters in a page title.
+	MaxTitleLength = 50
+
+	// CaptureDedupeWindow is how long a captured URL is remembered. Capturing a URL that was added to the page
+	// within the window adds nothing, so a share sheet tapped twice does not leave two links.
+	CaptureDedupeWindow = 24 * time.Hour
+
+	duplicateTitleSuffix = " のコピー"
+)
+
 // NewPage creates a new Page instance.
 func NewPage(title string, createdBy *duser.User) (*Page, error) {
-	if title == "" {
-		return nil, ErrNoTitleProvided
+	if err := ValidateTitle(title); err != nil {
+		return nil, err
 	}
 
 	if createdBy == nil {
@@ -32,10 +67,16 @@ func NewPage(title string, createdBy *duser.User) (*Page, error) {
 		return nil, fmt.Errorf("generate invite code: %w", err)
 	}
 
+	slug, err := slugGenerator()
+	if err != nil {
+		return nil, fmt.Errorf("generate slug: %w", err)
+	}
+
 	return &Page{
 		title:      title,
 		createdBy:  *createdBy,
 		inviteCode: code,
+		slug:       slug,
 		links:      Links{},
 	}, nil
 }
@@ -50,6 +91,31 @@ func (p *Page) Title() string {
 	return p.title
 }
 
+// Description returns the page's description in Markdown.
+func (p *Page) Description() string {
+	return p.description
+}
+
+// Icon returns the page's emoji icon, or an empty string.
+func (p *Page) Icon() string {
+	return p.icon
+}
+
+// Color returns the page's accent color in "#rrggbb" form, or an empty string.
+func (p *Page) Color() string {
+	return p.color
+}
+
+// CreatedAt returns when the page was created. It is zero until the page has been persisted.
+func (p *Page) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt returns when the page was last updated. It is zero until the page has been persisted.
+func (p *Page) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
 // InviteCode returns the page's invite code if the given user is the creator of the page.
 // If the user is nil or not the creator, it returns an empty string.
 func (p *Page) InviteCode(user *duser.User) string {
@@ -67,16 +133,95 @@ func (p *Page) Links() Links {
 	return p.links
 }
 
+// Sections returns the sections of the page in priority order.
+func (p *Page) Sections() Sections {
+	return p.sections
+}
+
+// HasLink reports whether the page contains a link with the given ID.
+func (p *Page) HasLink(linkID string) bool {
+	return linkID != "" && slices.ContainsFunc(p.links, func(l Link) bool {
+		return l.id == linkID
+	})
+}
+
+// IsChecklist reports whether the page is in checklist mode.
+func (p *Page) IsChecklist() bool {
+	return p.checklist
+}
+
+// IsArchived reports whether the page is archived and therefore read-only.
+func (p *Page) IsArchived() bool {
+	return p.archived
+}
+
+// Progress returns the number of links marked done and the number of links on the page.
+func (p *Page) Progress() (done int, total int) {
+	for _, l := range p.links {
+		if l.Done() {
+			done++
+		}
+	}
+	return done, len(p.links)
+}
+
 // CreatedBy returns the creator user of the page.
 func (p *Page) CreatedBy() *duser.User {
 	return &p.createdBy
 }
 
+// SourcePageID returns the ID of the page this page was duplicated from, or an empty string.
+func (p *Page) SourcePageID() string {
+	return p.sourcePageID
+}
+
 // InvitedUsers returns the invited users for this page.
 func (p *Page) InvitedUsers() duser.Users {
 	return p.invitedUsers
 }
 
+// FeedTokenHash returns the hash of the page's feed token, or an empty string if no token has been issued.
+func (p *Page) FeedTokenHash() string {
+	return p.feedTokenHash
+}
+
+// IssueFeedToken issues a new secret feed token, replacing any previously issued one.
+// Only the creator can issue a token. The plain token is returned once; only its hash is kept.
+func (p *Page) IssueFeedToken(user *duser.User) (string, error) {
+	if err := p.validateCreatedBy(user); err != nil {
+		return "", err
+	}
+
+	token, err := feedTokenGenerator()
+	if err != nil {
+		return "", fmt.Errorf("generate feed token: %w", err)
+	}
+
+	p.feedTokenHash = hashFeedToken(token)
+	return token, nil
+}
+
+// RevokeFeedToken revokes the page's feed token. Only the creator can revoke it.
+func (p *Page) RevokeFeedToken(user *duser.User) error {
+	if err := p.validateCreatedBy(user); err != nil {
+		return err
+	}
+
+	p.feedTokenHash = ""
+	return nil
+}
+
+// VerifyFeedToken verifies that the given token matches the page's feed token.
+func (p *Page) VerifyFeedToken(token string) error {
+	if token == "" || p.feedTokenHash == "" {
+		return ErrInvalidFeedToken
+	}
+	if subtle.ConstantTimeCompare([]byte(hashFeedToken(token)), []byte(p.feedTokenHash)) != 1 {
+		return ErrInvalidFeedToken
+	}
+	return nil
+}
+
 // Join adds the user to the invited users if the invite code matches.
 func (p *Page) Join(user *duser.User, inviteCode string) error {
 	if user == nil {
@@ -87,10 +232,28 @@ func (p *Page) Join(user *duser.User, inviteCode string) error {
 		return ErrInvalidInviteCode
 	}
 
+	return p.addMember(user)
+}
+
+// JoinByInvitation adds the user to the invited users. The caller must have checked the invitation the user presented.
+func (p *Page) JoinByInvitation(user *duser.User) error {
+	if user == nil {
+		return ErrNoUserProvided
+	}
+
+	return p.addMember(user)
+}
+
+// addMember adds the user to the invited users unless they are already a member or the page is archived.
+func (p *Page) addMember(user *duser.User) error {
 	if p.createdBy.ID() == user.ID() {
 		return ErrCreatorCannotJoin
 	}
 
+	if p.archived {
+		return ErrPageArchived
+	}
+
 	if slices.ContainsFunc(p.invitedUsers, func(u *duser.User) bool {
 		return u.ID() == user.ID()
 	}) {
@@ -103,7 +266,11 @@ func (p *Page) Join(user *duser.User, inviteCode string) error {
 
 // Edit edits the page.
 func (p *Page) Edit(user *duser.User, title string, links Links) error {
-	if err := p.Authorize(user); err != nil {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if err := ValidateTitle(title); err != nil {
 		return err
 	}
 
@@ -116,19 +283,66 @@ func (p *Page) Edit(user *duser.User, title string, links Links) error {
 	return nil
 }
 
+// EditDetails replaces the description, icon and accent color of the page. Empty values clear them.
+func (p *Page) EditDetails(user *duser.User, description string, icon string, color string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if err := validateDescription(description); err != nil {
+		return err
+	}
+	if err := validateIcon(icon); err != nil {
+		return err
+	}
+	color, err := normalizeColor(color)
+	if err != nil {
+		return err
+	}
+
+	p.description = description
+	p.icon = icon
+	p.color = color
+	return nil
+}
+
 // AddLink adds a new link to the page.
 func (p *Page) AddLink(user *duser.User, url string, memo string) error {
-	if err := p.Authorize(user); err != nil {
+	if err := p.authorizeChange(user); err != nil {
 		return err
 	}
 
 	p.links.addLink(url, memo)
+	p.markAddedLinksRead(user, len(p.links)-1)
 	return nil
 }
 
+// Capture adds a captured link to the end of the page unless a link with the same URL was added within
+// CaptureDedupeWindow before now. It reports whether the link was added. Only absolute http(s) URLs can be captured.
+func (p *Page) Capture(user *duser.User, rawURL string, memo string, now time.Time) (bool, error) {
+	if err := p.authorizeChange(user); err != nil {
+		return false, err
+	}
+
+	if err := validateCaptureURL(rawURL); err != nil {
+		return false, err
+	}
+
+	for _, l := range p.links {
+		// Links not saved yet have no creation time and count as just added.
+		if l.url == rawURL && (l.createdAt.IsZero() || now.Sub(l.createdAt) < CaptureDedupeWindow) {
+			return false, nil
+		}
+	}
+
+	p.links.addLink(rawURL, memo)
+	p.markAddedLinksRead(user, len(p.links)-1)
+	return true, nil
+}
+
 // RemoveLink removes a link from the page.
 func (p *Page) RemoveLink(user *duser.User, url string) error {
-	if err := p.Authorize(user); err != nil {
+	if err := p.authorizeChange(user); err != nil {
 		return err
 	}
 
@@ -139,6 +353,397 @@ func (p *Page) RemoveLink(user *duser.User, url string) error {
 	return nil
 }
 
+// AddLinks adds the links to the end of the page in the given order.
+func (p *Page) AddLinks(user *duser.User, links Links) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if len(links) == 0 {
+		return ErrNoLinksProvided
+	}
+
+	added := len(p.links)
+	p.links.addLinks(links)
+	p.markAddedLinksRead(user, added)
+	return nil
+}
+
+// RemoveLinks removes the links with the given IDs from the page.
+// If any of the links is not on the page nothing is removed.
+func (p *Page) RemoveLinks(user *duser.User, linkIDs []string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if len(linkIDs) == 0 {
+		return ErrNoLinksProvided
+	}
+
+	if _, err := p.links.removeLinksByID(linkIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MoveLinks moves the links with the given IDs from the page to the end of the target page,
+// keeping their relative order. When copy is true the links are kept on this page as well.
+// The user must be authorized on both pages, and only links copied out of an archived page are allowed.
+func (p *Page) MoveLinks(user *duser.User, target *Page, linkIDs []string, copy bool) error {
+	if target == nil {
+		return ErrNoPageProvided
+	}
+
+	if copy {
+		if err := p.Authorize(user); err != nil {
+			return err
+		}
+	} else if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+	if err := target.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if p.id == target.id {
+		return ErrSamePage
+	}
+
+	if len(linkIDs) == 0 {
+		return ErrNoLinksProvided
+	}
+
+	var (
+		moved Links
+		err   error
+	)
+	if copy {
+		moved, err = p.links.getLinksByID(linkIDs)
+	} else {
+		moved, err = p.links.removeLinksByID(linkIDs)
+	}
+	if err != nil {
+		return err
+	}
+
+	target.links.addLinks(moved)
+	return nil
+}
+
+// AddSection adds a new section to the end of the page.
+func (p *Page) AddSection(user *duser.User, name string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+	if err := validateSectionName(name); err != nil {
+		return err
+	}
+
+	p.sections.addSection(name)
+	return nil
+}
+
+// RenameSection renames a section of the page.
+func (p *Page) RenameSection(user *duser.User, sectionID string, name string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+	if err := validateSectionName(name); err != nil {
+		return err
+	}
+
+	return p.sections.renameSection(sectionID, name)
+}
+
+// ReorderSections orders the sections of the page as given. The IDs must list every section exactly once.
+func (p *Page) ReorderSections(user *duser.User, sectionIDs []string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	return p.sections.reorderSections(sectionIDs)
+}
+
+// RemoveSection removes a section from the page. Its links stay on the page outside of any section.
+func (p *Page) RemoveSection(user *duser.User, sectionID string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if err := p.sections.removeSection(sectionID); err != nil {
+		return err
+	}
+
+	p.links.clearSection(sectionID)
+	return nil
+}
+
+// MoveLinksToSection moves the links with the given IDs to the end of the section.
+// An empty sectionID moves the links out of any section.
+func (p *Page) MoveLinksToSection(user *duser.User, linkIDs []string, sectionID string) error {
+	if err := p.authorizeChange(user); err != nil {
+		return err
+	}
+
+	if len(linkIDs) == 0 {
+		return ErrNoLinksProvided
+	}
+
+	if sectionID != "" {
+		if _, err := p.sections.getIndexByID(sectionID); err != nil {
+			return err
+		}
+	}
+
+	return p.links.moveToSection(linkIDs, sectionID)
+}
+
+// React sets the user's reaction to the link, replacing any previous one. An empty emoji is an upvote.
+func (p *Page) React(user *duser.User, linkID string, emoji string) (Reaction, error) {
+	if err := p.Authorize(user); err != nil {
+		return Reaction{}, err
+	}
+
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return Reaction{}, ErrNotFoundLinkByID(linkID)
+	}
+
+	if emoji == "" {
+		emoji = UpvoteReaction
+	}
+	if err := validateReaction(emoji); err != nil {
+		return Reaction{}, err
+	}
+
+	return p.links[idx].reactions.set(user.ID(), emoji), nil
+}
+
+// Unreact removes the user's reaction to the link. It does nothing if the user has not reacted.
+func (p *Page) Unreact(user *duser.User, linkID string) error {
+	if err := p.Authorize(user); err != nil {
+		return err
+	}
+
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return ErrNotFoundLinkByID(linkID)
+	}
+
+	p.links[idx].reactions.remove(user.ID())
+	return nil
+}
+
+// MarkLink sets the user's status on the link.
+func (p *Page) MarkLink(user *duser.User, linkID string, status LinkStatus) (LinkState, error) {
+	if err := p.Authorize(user); err != nil {
+		return LinkState{}, err
+	}
+
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return LinkState{}, ErrNotFoundLinkByID(linkID)
+	}
+
+	if err := status.isValid(); err != nil {
+		return LinkState{}, err
+	}
+
+	return p.links[idx].states.set(user.ID(), status), nil
+}
+
+// MarkAllRead marks every link the user has not read yet as read and returns the IDs of those links.
+// Links the user has marked done stay done.
+func (p *Page) MarkAllRead(user *duser.User) ([]string, error) {
+	if err := p.Authorize(user); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for i := range p.links {
+		if p.links[i].states.Of(user.ID()) != LinkStatusUnread {
+			continue
+		}
+		p.links[i].states.set(user.ID(), LinkStatusRead)
+		ids = append(ids, p.links[i].id)
+	}
+	return ids, nil
+}
+
+// UnreadCount returns the number of links the user has not read yet.
+func (p *Page) UnreadCount(user *duser.User) int {
+	if user == nil {
+		return 0
+	}
+	count := 0
+	for _, l := range p.links {
+		if l.states.Of(user.ID()) == LinkStatusUnread {
+			count++
+		}
+	}
+	return count
+}
+
+// markAddedLinksRead marks the links from index from onwards as read by the user who added them,
+// so that they are only new to the other members.
+func (p *Page) markAddedLinksRead(user *duser.User, from int) {
+	for i := from; i < len(p.links); i++ {
+		p.links[i].states.set(user.ID(), LinkStatusRead)
+	}
+}
+
+// Archive freezes the page so that its title, links and members can no longer change.
+// Only the creator can archive the page. Archiving an archived page does nothing.
+func (p *Page) Archive(user *duser.User) error {
+	if err := p.validateCreatedBy(user); err != nil {
+		return err
+	}
+
+	p.archived = true
+	return nil
+}
+
+// Unarchive makes an archived page editable again. Only the creator can unarchive the page.
+func (p *Page) Unarchive(user *duser.User) error {
+	if err := p.validateCreatedBy(user); err != nil {
+		return err
+	}
+
+	p.archived = false
+	return nil
+}
+
+// SetChecklist turns checklist mode on or off. Only the creator can change the mode.
+// Done flags are kept while the mode is off so that turning it back on restores them.
+func (p *Page) SetChecklist(user *duser.User, enabled bool) error {
+	if err := p.validateCreatedBy(user); err != nil {
+		return err
+	}
+	if p.archived {
+		return ErrPageArchived
+	}
+
+	p.checklist = enabled
+	return nil
+}
+
+// ToggleLinkDone marks the link done by the user at the given time, or clears the flag if the link is already done.
+func (p *Page) ToggleLinkDone(user *duser.User, linkID string, now time.Time) (Link, error) {
+	if err := p.authorizeChange(user); err != nil {
+		return Link{}, err
+	}
+
+	if !p.checklist {
+		return Link{}, ErrNotChecklist
+	}
+
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return Link{}, ErrNotFoundLinkByID(linkID)
+	}
+
+	p.links[idx].toggleDone(user.ID(), now)
+	return p.links[idx], nil
+}
+
+// AcceptLinkRedirect replaces the URL of a redirected link with the target of the redirect. The outcome of
+// the last check is cleared, so the new URL is checked again. Only the creator can accept redirects.
+func (p *Page) AcceptLinkRedirect(user *duser.User, linkID string) (Link, error) {
+	if err := p.validateCreatedBy(user); err != nil {
+		return Link{}, err
+	}
+	if p.archived {
+		return Link{}, ErrPageArchived
+	}
+
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return Link{}, ErrNotFoundLinkByID(linkID)
+	}
+
+	l := &p.links[idx]
+	health := l.Health()
+	if health.Status() != LinkHealthRedirected {
+		return Link{}, ErrNoLinkRedirect
+	}
+	l.url = health.redirectURL
+	l.health = nil
+	return *l, nil
+}
+
+// SortLinks orders the links of the page for listing. Priorities are left as they are.
+func (p *Page) SortLinks(order LinkOrder) error {
+	switch order {
+	case "", LinkOrderPriority:
+		return nil
+	case LinkOrderVotes:
+	default:
+		return ErrInvalidLinkOrder
+	}
+	slices.SortStableFunc(p.links, func(a, b Link) int {
+		if a.reactions.Votes() != b.reactions.Votes() {
+			return b.reactions.Votes() - a.reactions.Votes()
+		}
+		return a.priority - b.priority
+	})
+	return nil
+}
+
+// Link returns the link with the given ID.
+func (p *Page) Link(linkID string) (Link, error) {
+	idx := p.linkIndexByID(linkID)
+	if idx < 0 {
+		return Link{}, ErrNotFoundLinkByID(linkID)
+	}
+	return p.links[idx], nil
+}
+
+func (p *Page) linkIndexByID(linkID string) int {
+	if linkID == "" {
+		return -1
+	}
+	return slices.IndexFunc(p.links, func(l Link) bool { return l.id == linkID })
+}
+
+// Duplicate creates a new page owned by the user with the title, details, sections, links, memos, order and mode of this page.
+// The copy gets a fresh invite code, has no invited users, starts with no links done and remembers this page as its source.
+func (p *Page) Duplicate(user *duser.User) (*Page, error) {
+	if err := p.Authorize(user); err != nil {
+		return nil, err
+	}
+
+	dup, err := NewPage(duplicateTitle(p.title), user)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, sectionIDs := p.sections.duplicate()
+	links := slices.Clone(p.links)
+	links.renumber()
+	dup.links.addLinks(links)
+	for i, l := range links {
+		dup.links[i].sectionID = sectionIDs[l.sectionID]
+	}
+	dup.sections = sections
+	dup.sourcePageID = p.id
+	dup.checklist = p.checklist
+	dup.description = p.description
+	dup.icon = p.icon
+	dup.color = p.color
+
+	return dup, nil
+}
+
+// duplicateTitle appends the copy suffix to the title, shortening the title so the result fits MaxTitleLength.
+func duplicateTitle(title string) string {
+	suffix := []rune(duplicateTitleSuffix)
+	runes := []rune(title)
+	if max := MaxTitleLength - len(suffix); len(runes) > max {
+		runes = runes[:max]
+	}
+	return string(runes) + duplicateTitleSuffix
+}
+
 // Authorize authorizes the user to access the page.
 func (p *Page) Authorize(user *duser.User) error {
 	if user == nil {
@@ -160,6 +765,18 @@ func (p *Page) Authorize(user *duser.User) error {
 	return nil
 }
 
+// authorizeChange authorizes the user to change the title, links or sections of the page.
+// Archived pages are read-only.
+func (p *Page) authorizeChange(user *duser.User) error {
+	if err := p.Authorize(user); err != nil {
+		return err
+	}
+	if p.archived {
+		return ErrPageArchived
+	}
+	return nil
+}
+
 // validateCreatedBy validates if the given user is the creator of the page.
 func (p *Page) validateCreatedBy(user *duser.User) error {
 	if user == nil {
@@ -172,8 +789,8 @@ func (p *Page) validateCreatedBy(user *duser.User) error {
 }
 
 // ReconstructPage reconstructs a Page instance from existing data.
-func ReconstructPage(id string, title string, createdBy duser.User, inviteCode string, links Links, invitedUsers duser.Users) *Page {
-	return &Page{
+func ReconstructPage(id string, title string, createdBy duser.User, inviteCode string, links Links, invitedUsers duser.Users, options ...ReconstructOption) *Page {
+	p := &Page{
 		id:           id,
 		title:        title,
 		createdBy:    createdBy,
@@ -181,6 +798,64 @@ func ReconstructPage(id string, title string, createdBy duser.User, inviteCode s
 		links:        links,
 		invitedUsers: invitedUsers,
 	}
+	for _, opt := range options {
+		opt(p)
+	}
+	return p
+}
+
+type ReconstructOption func(*Page)
+
+// WithSourcePageID sets the ID of the page this page was duplicated from.
+func WithSourcePageID(id string) ReconstructOption {
+	return func(p *Page) {
+		p.sourcePageID = id
+	}
+}
+
+// WithSections sets the sections of the page.
+func WithSections(sections Sections) ReconstructOption {
+	return func(p *Page) {
+		p.sections = sections
+	}
+}
+
+// WithChecklist sets whether the page is in checklist mode.
+func WithChecklist(enabled bool) ReconstructOption {
+	return func(p *Page) {
+		p.checklist = enabled
+	}
+}
+
+// WithArchived sets whether the page is archived.
+func WithArchived(archived bool) ReconstructOption {
+	return func(p *Page) {
+		p.archived = archived
+	}
+}
+
+// WithDetails sets the description, icon and accent color of the page.
+func WithDetails(description string, icon string, color string) ReconstructOption {
+	return func(p *Page) {
+		p.description = description
+		p.icon = icon
+		p.color = color
+	}
+}
+
+// WithTimestamps sets when the page was created and last updated.
+func WithTimestamps(createdAt time.Time, updatedAt time.Time) ReconstructOption {
+	return func(p *Page) {
+		p.createdAt = createdAt
+		p.updatedAt = updatedAt
+	}
+}
+
+// WithFeedTokenHash sets the stored hash of the page's feed token.
+func WithFeedTokenHash(hash string) ReconstructOption {
+	return func(p *Page) {
+		p.feedTokenHash = hash
+	}
 }
 
 var inviteCodeGenerator = defaultInviteCodeGenerator
@@ -201,3 +876,20 @@ func defaultInviteCodeGenerator() (string, error) {
 	}
 	return string(buf), nil
 }
+
+var feedTokenGenerator = defaultFeedTokenGenerator
+
+const feedTokenBytes = 32
+
+func defaultFeedTokenGenerator() (string, error) {
+	buf := make([]byte, feedTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
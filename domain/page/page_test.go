@@ -2,7 +2,9 @@ package page
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	di "github.com/naka-sei/tsudzuri/domain/user"
@@ -20,7 +22,12 @@ func TestPage_NewPage(t *testing.T) {
 	}
 
 	originalGenerator := inviteCodeGenerator
-	t.Cleanup(func() { inviteCodeGenerator = originalGenerator })
+	originalSlugGenerator := slugGenerator
+	t.Cleanup(func() {
+		inviteCodeGenerator = originalGenerator
+		slugGenerator = originalSlugGenerator
+	})
+	slugGenerator = func() (string, error) { return "slug000001", nil }
 
 	errBoom := errors.New("boom")
 
@@ -41,6 +48,7 @@ func TestPage_NewPage(t *testing.T) {
 					title:      "Title",
 					createdBy:  di.User{},
 					inviteCode: "INVITE01",
+					slug:       "slug000001",
 					links:      Links{},
 				},
 			},
@@ -60,6 +68,16 @@ func TestPage_NewPage(t *testing.T) {
 				err: ErrNoTitleProvided,
 			},
 		},
+		{
+			name: "title_too_long",
+			args: args{
+				title:     strings.Repeat("あ", MaxTitleLength+1),
+				createdBy: &di.User{},
+			},
+			want: want{
+				err: ErrTitleTooLong,
+			},
+		},
 		{
 			name: "no_user_provided",
 			args: args{
@@ -104,7 +122,7 @@ func TestPage_NewPage(t *testing.T) {
 
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(Link{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -130,6 +148,8 @@ func TestDefaultInviteCodeGenerator(t *testing.T) {
 }
 
 func TestPage_Edit(t *testing.T) {
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	type fields struct {
 		page *Page
 	}
@@ -184,6 +204,41 @@ func TestPage_Edit(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "edit_keeps_done_flags",
+			fields: fields{
+				page: &Page{
+					title:      "Old",
+					createdBy:  di.User{},
+					inviteCode: "code",
+					links: Links{
+						{url: "a", memo: "A", priority: 1, doneBy: "member-id", doneAt: doneAt},
+						{url: "b", memo: "B", priority: 2},
+					},
+					checklist: true,
+				},
+			},
+			args: args{
+				user:  &di.User{},
+				title: "Old",
+				links: Links{
+					{url: "b", memo: "B", priority: 1},
+					{url: "a", memo: "A-new", priority: 2},
+				},
+			},
+			want: want{
+				page: &Page{
+					title:      "Old",
+					createdBy:  di.User{},
+					inviteCode: "code",
+					links: Links{
+						{url: "b", memo: "B", priority: 1},
+						{url: "a", memo: "A-new", priority: 2, doneBy: "member-id", doneAt: doneAt},
+					},
+					checklist: true,
+				},
+			},
+		},
 		{
 			name: "invalid_links_length",
 			fields: fields{
@@ -329,7 +384,7 @@ func TestPage_Edit(t *testing.T) {
 			err := tt.fields.page.Edit(tt.args.user, tt.args.title, tt.args.links)
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -480,7 +535,7 @@ func TestPage_Join(t *testing.T) {
 			err := tt.fields.page.Join(tt.args.user, tt.args.inviteCode)
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -660,7 +715,7 @@ func TestPage_AddLink(t *testing.T) {
 					createdBy:  *creator,
 					inviteCode: "code",
 					links: Links{
-						{url: "https://example.com", memo: "Example", priority: 0},
+						{url: "https://example.com", memo: "Example", priority: 1, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
 					},
 				},
 			},
@@ -687,7 +742,7 @@ func TestPage_AddLink(t *testing.T) {
 					createdBy:  *creator,
 					inviteCode: "code",
 					links: Links{
-						{url: "https://example.com", memo: "Example", priority: 0},
+						{url: "https://example.com", memo: "Example", priority: 1, states: LinkStates{{userID: "invited-id", status: LinkStatusRead}}},
 					},
 					invitedUsers: di.Users{invited},
 				},
@@ -753,13 +808,335 @@ func TestPage_AddLink(t *testing.T) {
 			err := tt.fields.page.AddLink(tt.args.user, tt.args.url, tt.args.memo)
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, LinkState{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
+func TestPage_Capture(t *testing.T) {
+	type args struct {
+		user *di.User
+		url  string
+	}
+	type want struct {
+		added bool
+		links Links
+		err   error
+	}
+
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	existing := func() Links {
+		return Links{
+			{id: "l1", url: "https://example.com/old", priority: 1, createdAt: now.Add(-CaptureDedupeWindow)},
+			{id: "l2", url: "https://example.com/recent", priority: 2, createdAt: now.Add(-time.Hour)},
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "new_url",
+			args: args{user: creator, url: "https://example.com/new"},
+			want: want{
+				added: true,
+				links: append(existing(), Link{url: "https://example.com/new", memo: "memo", priority: 3, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}}),
+			},
+		},
+		{
+			name: "url_captured_before_the_window",
+			args: args{user: creator, url: "https://example.com/old"},
+			want: want{
+				added: true,
+				links: append(existing(), Link{url: "https://example.com/old", memo: "memo", priority: 3, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}}),
+			},
+		},
+		{
+			name: "recently_captured_url",
+			args: args{user: creator, url: "https://example.com/recent"},
+			want: want{added: false, links: existing()},
+		},
+		{
+			name: "invalid_url",
+			args: args{user: creator, url: "javascript:alert(1)"},
+			want: want{links: existing(), err: ErrInvalidCaptureURL},
+		},
+		{
+			name: "not_creator",
+			args: args{user: other, url: "https://example.com/new"},
+			want: want{links: existing(), err: ErrNotCreatedByUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			page := ReconstructPage("page-1", "Title", *creator, "code", existing(), nil)
+			added, err := page.Capture(tt.args.user, tt.args.url, "memo", now)
+			testutil.EqualErr(t, tt.want.err, err)
+			if added != tt.want.added {
+				t.Fatalf("Capture() added = %v, want %v", added, tt.want.added)
+			}
+			if diff := cmp.Diff(tt.want.links, page.Links(), cmp.AllowUnexported(Link{}, LinkState{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_AddLinks(t *testing.T) {
+	type args struct {
+		user  *di.User
+		links Links
+	}
+	type want struct {
+		links Links
+		err   error
+	}
+
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success_appends_in_order",
+			args: args{
+				user:  creator,
+				links: Links{NewLink("https://b.com", "B"), NewLink("https://c.com", "")},
+			},
+			want: want{
+				links: Links{
+					{id: "1", url: "https://a.com", memo: "A", priority: 1},
+					{url: "https://b.com", memo: "B", priority: 2, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+					{url: "https://c.com", priority: 3, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+				},
+			},
+		},
+		{
+			name: "no_links",
+			args: args{user: creator},
+			want: want{
+				links: Links{{id: "1", url: "https://a.com", memo: "A", priority: 1}},
+				err:   ErrNoLinksProvided,
+			},
+		},
+		{
+			name: "unauthorized",
+			args: args{
+				user:  other,
+				links: Links{NewLink("https://b.com", "B")},
+			},
+			want: want{
+				links: Links{{id: "1", url: "https://a.com", memo: "A", priority: 1}},
+				err:   ErrNotCreatedByUser,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := ReconstructPage("page-id", "Title", *creator, "code", Links{{id: "1", url: "https://a.com", memo: "A", priority: 1}}, nil)
+			err := p.AddLinks(tt.args.user, tt.args.links)
+			testutil.EqualErr(t, tt.want.err, err)
+
+			if diff := cmp.Diff(tt.want.links, p.Links(), cmp.AllowUnexported(Link{}, LinkState{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_RemoveLinks(t *testing.T) {
+	type args struct {
+		user *di.User
+		ids  []string
+	}
+	type want struct {
+		links Links
+		err   error
+	}
+
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "https://a.com", priority: 1},
+			{id: "2", url: "https://b.com", priority: 2},
+			{id: "3", url: "https://c.com", priority: 3},
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success_by_invited_user",
+			args: args{user: invited, ids: []string{"1", "3"}},
+			want: want{
+				links: Links{{id: "2", url: "https://b.com", priority: 1}},
+			},
+		},
+		{
+			name: "not_found",
+			args: args{user: creator, ids: []string{"1", "x"}},
+			want: want{links: original(), err: ErrNotFoundLinkByID("x")},
+		},
+		{
+			name: "no_ids",
+			args: args{user: creator},
+			want: want{links: original(), err: ErrNoLinksProvided},
+		},
+		{
+			name: "unauthorized",
+			args: args{user: other, ids: []string{"1"}},
+			want: want{links: original(), err: ErrNotCreatedByUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{invited})
+			err := p.RemoveLinks(tt.args.user, tt.args.ids)
+			testutil.EqualErr(t, tt.want.err, err)
+
+			if diff := cmp.Diff(tt.want.links, p.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_MoveLinks(t *testing.T) {
+	type args struct {
+		user     *di.User
+		targetID string
+		ids      []string
+		copy     bool
+		noTarget bool
+	}
+	type want struct {
+		source Links
+		target Links
+		err    error
+	}
+
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+
+	sourceLinks := func() Links {
+		return Links{
+			{id: "1", url: "https://a.com", memo: "A", priority: 1},
+			{id: "2", url: "https://b.com", memo: "B", priority: 2},
+			{id: "3", url: "https://c.com", memo: "C", priority: 3},
+		}
+	}
+	targetLinks := func() Links {
+		return Links{{id: "9", url: "https://z.com", priority: 1}}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "move",
+			args: args{user: creator, targetID: "target", ids: []string{"3", "1"}},
+			want: want{
+				source: Links{{id: "2", url: "https://b.com", memo: "B", priority: 1}},
+				target: Links{
+					{id: "9", url: "https://z.com", priority: 1},
+					{url: "https://a.com", memo: "A", priority: 2},
+					{url: "https://c.com", memo: "C", priority: 3},
+				},
+			},
+		},
+		{
+			name: "copy",
+			args: args{user: creator, targetID: "target", ids: []string{"2"}, copy: true},
+			want: want{
+				source: sourceLinks(),
+				target: Links{
+					{id: "9", url: "https://z.com", priority: 1},
+					{url: "https://b.com", memo: "B", priority: 2},
+				},
+			},
+		},
+		{
+			name: "not_authorized_on_target",
+			args: args{user: member, targetID: "target", ids: []string{"1"}},
+			want: want{source: sourceLinks(), target: targetLinks(), err: ErrNotCreatedByUser},
+		},
+		{
+			name: "same_page",
+			args: args{user: creator, targetID: "source", ids: []string{"1"}},
+			want: want{source: sourceLinks(), target: targetLinks(), err: ErrSamePage},
+		},
+		{
+			name: "link_not_found",
+			args: args{user: creator, targetID: "target", ids: []string{"1", "x"}},
+			want: want{source: sourceLinks(), target: targetLinks(), err: ErrNotFoundLinkByID("x")},
+		},
+		{
+			name: "no_ids",
+			args: args{user: creator, targetID: "target"},
+			want: want{source: sourceLinks(), target: targetLinks(), err: ErrNoLinksProvided},
+		},
+		{
+			name: "no_target",
+			args: args{user: creator, noTarget: true, ids: []string{"1"}},
+			want: want{source: sourceLinks(), target: targetLinks(), err: ErrNoPageProvided},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := ReconstructPage("source", "Source", *creator, "code1", sourceLinks(), di.Users{member})
+			target := ReconstructPage(tt.args.targetID, "Target", *creator, "code2", targetLinks(), nil)
+			var err error
+			if tt.args.noTarget {
+				err = source.MoveLinks(tt.args.user, nil, tt.args.ids, tt.args.copy)
+			} else {
+				err = source.MoveLinks(tt.args.user, target, tt.args.ids, tt.args.copy)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+
+			if diff := cmp.Diff(tt.want.source, source.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("source links mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want.target, target.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("target links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestPage_RemoveLink(t *testing.T) {
 	type fields struct {
 		page *Page
@@ -932,7 +1309,7 @@ func TestPage_RemoveLink(t *testing.T) {
 			err := tt.fields.page.RemoveLink(tt.args.user, tt.args.url)
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.page, tt.fields.page, cmp.AllowUnexported(Link{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -947,6 +1324,7 @@ func TestReconstructPage(t *testing.T) {
 		inviteCode   string
 		links        Links
 		invitedUsers di.Users
+		options      []ReconstructOption
 	}
 	tests := []struct {
 		name string
@@ -976,6 +1354,23 @@ func TestReconstructPage(t *testing.T) {
 				invitedUsers: di.Users{&di.User{}},
 			},
 		},
+		{
+			name: "with_feed_token_hash",
+			args: args{
+				id:         "page-id",
+				title:      "Title",
+				createdBy:  di.User{},
+				inviteCode: "code",
+				options:    []ReconstructOption{WithFeedTokenHash("hash")},
+			},
+			want: &Page{
+				id:            "page-id",
+				title:         "Title",
+				createdBy:     di.User{},
+				inviteCode:    "code",
+				feedTokenHash: "hash",
+			},
+		},
 		{
 			name: "empty",
 			args: args{
@@ -1001,10 +1396,1390 @@ func TestReconstructPage(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := ReconstructPage(tt.args.id, tt.args.title, tt.args.createdBy, tt.args.inviteCode, tt.args.links, tt.args.invitedUsers)
-			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Link{}, Page{}, di.User{})); diff != "" {
+			got := ReconstructPage(tt.args.id, tt.args.title, tt.args.createdBy, tt.args.inviteCode, tt.args.links, tt.args.invitedUsers, tt.args.options...)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(Link{}, Page{}, di.User{}, di.Profile{})); diff != "" {
 				t.Fatalf("page mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestPage_IssueFeedToken(t *testing.T) {
+	type args struct {
+		user *di.User
+	}
+	type want struct {
+		token string
+		hash  string
+		err   error
+	}
+
+	originalGenerator := feedTokenGenerator
+	t.Cleanup(func() { feedTokenGenerator = originalGenerator })
+	feedTokenGenerator = func() (string, error) { return "secret-token", nil }
+
+	creator := di.ReconstructUser("creator-id", "creator-uid", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "invited-uid", "anonymous", nil)
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success",
+			args: args{user: creator},
+			want: want{token: "secret-token", hash: hashFeedToken("secret-token")},
+		},
+		{
+			name: "not_creator",
+			args: args{user: invited},
+			want: want{hash: "old-hash", err: ErrNotCreatedByUser},
+		},
+		{
+			name: "nil_user",
+			args: args{user: nil},
+			want: want{hash: "old-hash", err: ErrNoUserProvided},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{invited}, WithFeedTokenHash("old-hash"))
+			got, err := p.IssueFeedToken(tt.args.user)
+			testutil.EqualErr(t, tt.want.err, err)
+			if got != tt.want.token {
+				t.Fatalf("token mismatch: want %q, got %q", tt.want.token, got)
+			}
+			if p.FeedTokenHash() != tt.want.hash {
+				t.Fatalf("hash mismatch: want %q, got %q", tt.want.hash, p.FeedTokenHash())
+			}
+		})
+	}
+}
+
+func TestPage_RevokeFeedToken(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "creator-uid", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "invited-uid", "anonymous", nil)
+
+	tests := []struct {
+		name     string
+		user     *di.User
+		wantHash string
+		wantErr  error
+	}{
+		{name: "success", user: creator, wantHash: ""},
+		{name: "not_creator", user: invited, wantHash: "hash", wantErr: ErrNotCreatedByUser},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{invited}, WithFeedTokenHash("hash"))
+			err := p.RevokeFeedToken(tt.user)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if p.FeedTokenHash() != tt.wantHash {
+				t.Fatalf("hash mismatch: want %q, got %q", tt.wantHash, p.FeedTokenHash())
+			}
+		})
+	}
+}
+
+func TestPage_VerifyFeedToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		token   string
+		wantErr error
+	}{
+		{name: "match", hash: hashFeedToken("token"), token: "token"},
+		{name: "mismatch", hash: hashFeedToken("token"), token: "other", wantErr: ErrInvalidFeedToken},
+		{name: "empty_token", hash: hashFeedToken(""), token: "", wantErr: ErrInvalidFeedToken},
+		{name: "not_issued", hash: "", token: "token", wantErr: ErrInvalidFeedToken},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", di.User{}, "code", nil, nil, WithFeedTokenHash(tt.hash))
+			testutil.EqualErr(t, tt.wantErr, p.VerifyFeedToken(tt.token))
+		})
+	}
+}
+
+func TestDefaultFeedTokenGenerator(t *testing.T) {
+	a, err := defaultFeedTokenGenerator()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := defaultFeedTokenGenerator()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a) != 43 {
+		t.Fatalf("unexpected length: got %d", len(a))
+	}
+	if a == b {
+		t.Fatalf("tokens should differ: %q", a)
+	}
+}
+
+func TestPage_Duplicate(t *testing.T) {
+	type args struct {
+		user *di.User
+	}
+	type want struct {
+		page *Page
+		err  error
+	}
+
+	originalGenerator := inviteCodeGenerator
+	originalSlugGenerator := slugGenerator
+	originalSectionIDGenerator := sectionIDGenerator
+	t.Cleanup(func() {
+		inviteCodeGenerator = originalGenerator
+		slugGenerator = originalSlugGenerator
+		sectionIDGenerator = originalSectionIDGenerator
+	})
+	inviteCodeGenerator = func() (string, error) { return "NEWCODE1", nil }
+	slugGenerator = func() (string, error) { return "newslug001", nil }
+	sectionIDs := []string{"new-section-1", "new-section-2"}
+	sectionIDGenerator = func() string {
+		id := sectionIDs[0]
+		sectionIDs = sectionIDs[1:]
+		return id
+	}
+
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	source := ReconstructPage("source-id", "Onboarding", *creator, "OLDCODE1", Links{
+		{id: "3", url: "https://c.com", memo: "C", priority: 3, sectionID: "section-b"},
+		{id: "2", url: "https://b.com", memo: "B", priority: 2, sectionID: "section-a"},
+		{id: "1", url: "https://a.com", memo: "A", priority: 1},
+	}, di.Users{invited}, WithFeedTokenHash("hash"), WithSections(Sections{
+		{id: "section-a", name: "Day 2", priority: 2},
+		{id: "section-b", name: "Day 1", priority: 1},
+	}))
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success_by_invited_user",
+			args: args{user: invited},
+			want: want{
+				page: &Page{
+					title:      "Onboarding のコピー",
+					createdBy:  *invited,
+					inviteCode: "NEWCODE1",
+					slug:       "newslug001",
+					links: Links{
+						{url: "https://a.com", memo: "A", priority: 1},
+						{url: "https://b.com", memo: "B", priority: 2, sectionID: "new-section-2"},
+						{url: "https://c.com", memo: "C", priority: 3, sectionID: "new-section-1"},
+					},
+					sections: Sections{
+						{id: "new-section-1", name: "Day 1", priority: 1},
+						{id: "new-section-2", name: "Day 2", priority: 2},
+					},
+					sourcePageID: "source-id",
+				},
+			},
+		},
+		{
+			name: "unauthorized",
+			args: args{user: other},
+			want: want{err: ErrNotCreatedByUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := source.Duplicate(tt.args.user)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(Link{}, Page{}, Section{}, di.User{}, di.Profile{})); diff != "" {
+				t.Fatalf("page mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDuplicateTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{name: "short", title: "旅行", want: "旅行 のコピー"},
+		{
+			name:  "truncated_to_max_length",
+			title: "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをんアイウエオ",
+			want:  "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわを のコピー",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := duplicateTitle(tt.title)
+			if got != tt.want {
+				t.Fatalf("duplicateTitle() = %q, want %q", got, tt.want)
+			}
+			if n := len([]rune(got)); n > MaxTitleLength {
+				t.Fatalf("title too long: %d", n)
+			}
+		})
+	}
+}
+
+func TestPage_AddSection(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	tests := []struct {
+		name        string
+		user        *di.User
+		sectionName string
+		want        Sections
+		err         error
+	}{
+		{
+			name:        "success_by_invited_user",
+			user:        invited,
+			sectionName: "Day 2",
+			want: Sections{
+				{id: "s1", name: "Day 1", priority: 1},
+				{name: "Day 2", priority: 2},
+			},
+		},
+		{
+			name: "empty_name",
+			user: creator,
+			want: Sections{{id: "s1", name: "Day 1", priority: 1}},
+			err:  ErrNoSectionNameProvided,
+		},
+		{
+			name:        "unauthorized",
+			user:        other,
+			sectionName: "Day 2",
+			want:        Sections{{id: "s1", name: "Day 1", priority: 1}},
+			err:         ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{invited},
+				WithSections(Sections{{id: "s1", name: "Day 1", priority: 1}}))
+			err := p.AddSection(tt.user, tt.sectionName)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Sections(), cmp.AllowUnexported(Section{})); diff != "" {
+				t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_RenameSection(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	tests := []struct {
+		name      string
+		user      *di.User
+		sectionID string
+		newName   string
+		want      Sections
+		err       error
+	}{
+		{
+			name:      "success",
+			user:      creator,
+			sectionID: "s1",
+			newName:   "Keynotes",
+			want:      Sections{{id: "s1", name: "Keynotes", priority: 1}},
+		},
+		{
+			name:      "not_found",
+			user:      creator,
+			sectionID: "x",
+			newName:   "Keynotes",
+			want:      Sections{{id: "s1", name: "Day 1", priority: 1}},
+			err:       ErrNotFoundSection,
+		},
+		{
+			name:      "empty_name",
+			user:      creator,
+			sectionID: "s1",
+			want:      Sections{{id: "s1", name: "Day 1", priority: 1}},
+			err:       ErrNoSectionNameProvided,
+		},
+		{
+			name:      "unauthorized",
+			user:      other,
+			sectionID: "s1",
+			newName:   "Keynotes",
+			want:      Sections{{id: "s1", name: "Day 1", priority: 1}},
+			err:       ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, nil,
+				WithSections(Sections{{id: "s1", name: "Day 1", priority: 1}}))
+			err := p.RenameSection(tt.user, tt.sectionID, tt.newName)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Sections(), cmp.AllowUnexported(Section{})); diff != "" {
+				t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_ReorderSections(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Sections {
+		return Sections{
+			{id: "s1", name: "A", priority: 1},
+			{id: "s2", name: "B", priority: 2},
+		}
+	}
+
+	tests := []struct {
+		name string
+		user *di.User
+		ids  []string
+		want Sections
+		err  error
+	}{
+		{
+			name: "success",
+			user: creator,
+			ids:  []string{"s2", "s1"},
+			want: Sections{
+				{id: "s2", name: "B", priority: 1},
+				{id: "s1", name: "A", priority: 2},
+			},
+		},
+		{
+			name: "unauthorized",
+			user: other,
+			ids:  []string{"s2", "s1"},
+			want: original(),
+			err:  ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, nil, WithSections(original()))
+			err := p.ReorderSections(tt.user, tt.ids)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Sections(), cmp.AllowUnexported(Section{})); diff != "" {
+				t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_RemoveSection(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	type want struct {
+		sections Sections
+		links    Links
+		err      error
+	}
+
+	originalSections := func() Sections {
+		return Sections{
+			{id: "s1", name: "A", priority: 1},
+			{id: "s2", name: "B", priority: 2},
+		}
+	}
+	originalLinks := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1, sectionID: "s1"},
+			{id: "2", url: "b", priority: 2, sectionID: "s2"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		user      *di.User
+		sectionID string
+		want      want
+	}{
+		{
+			name:      "success_keeps_links",
+			user:      creator,
+			sectionID: "s1",
+			want: want{
+				sections: Sections{{id: "s2", name: "B", priority: 1}},
+				links: Links{
+					{id: "1", url: "a", priority: 1},
+					{id: "2", url: "b", priority: 2, sectionID: "s2"},
+				},
+			},
+		},
+		{
+			name:      "not_found",
+			user:      creator,
+			sectionID: "x",
+			want:      want{sections: originalSections(), links: originalLinks(), err: ErrNotFoundSection},
+		},
+		{
+			name:      "unauthorized",
+			user:      other,
+			sectionID: "s1",
+			want:      want{sections: originalSections(), links: originalLinks(), err: ErrNotCreatedByUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", originalLinks(), nil, WithSections(originalSections()))
+			err := p.RemoveSection(tt.user, tt.sectionID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.sections, p.Sections(), cmp.AllowUnexported(Section{})); diff != "" {
+				t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want.links, p.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_MoveLinksToSection(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1},
+			{id: "2", url: "b", priority: 2},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		user      *di.User
+		ids       []string
+		sectionID string
+		want      Links
+		err       error
+	}{
+		{
+			name:      "success",
+			user:      creator,
+			ids:       []string{"1"},
+			sectionID: "s1",
+			want: Links{
+				{id: "2", url: "b", priority: 1},
+				{id: "1", url: "a", priority: 2, sectionID: "s1"},
+			},
+		},
+		{
+			name:      "section_not_found",
+			user:      creator,
+			ids:       []string{"1"},
+			sectionID: "x",
+			want:      original(),
+			err:       ErrNotFoundSection,
+		},
+		{
+			name:      "no_ids",
+			user:      creator,
+			sectionID: "s1",
+			want:      original(),
+			err:       ErrNoLinksProvided,
+		},
+		{
+			name:      "unauthorized",
+			user:      other,
+			ids:       []string{"1"},
+			sectionID: "s1",
+			want:      original(),
+			err:       ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), nil,
+				WithSections(Sections{{id: "s1", name: "A", priority: 1}}))
+			err := p.MoveLinksToSection(tt.user, tt.ids, tt.sectionID)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_HasLink(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "creator-uid", "anonymous", nil)
+	p := ReconstructPage("page-id", "Title", *creator, "code", Links{
+		ReconstructLink("https://example.com", "", 1, WithLinkID("link-1")),
+	}, nil)
+
+	tests := []struct {
+		name   string
+		linkID string
+		want   bool
+	}{
+		{name: "found", linkID: "link-1", want: true},
+		{name: "not_found", linkID: "link-2", want: false},
+		{name: "empty_id", linkID: "", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := p.HasLink(tt.linkID); got != tt.want {
+				t.Fatalf("HasLink(%q) = %v, want %v", tt.linkID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_React(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1, reactions: Reactions{{userID: "member-id", emoji: "🎉"}}},
+			{id: "2", url: "b", priority: 2},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		user   *di.User
+		linkID string
+		emoji  string
+		want   Links
+		err    error
+	}{
+		{
+			name:   "upvote",
+			user:   creator,
+			linkID: "1",
+			want: Links{
+				{id: "1", url: "a", priority: 1, reactions: Reactions{{userID: "member-id", emoji: "🎉"}, {userID: "creator-id", emoji: UpvoteReaction}}},
+				{id: "2", url: "b", priority: 2},
+			},
+		},
+		{
+			name:   "replace_reaction",
+			user:   member,
+			linkID: "1",
+			emoji:  "👀",
+			want: Links{
+				{id: "1", url: "a", priority: 1, reactions: Reactions{{userID: "member-id", emoji: "👀"}}},
+				{id: "2", url: "b", priority: 2},
+			},
+		},
+		{
+			name:   "invalid_reaction",
+			user:   member,
+			linkID: "2",
+			emoji:  "nice",
+			want:   original(),
+			err:    ErrInvalidReaction,
+		},
+		{
+			name:   "link_not_found",
+			user:   member,
+			linkID: "3",
+			want:   original(),
+			err:    ErrNotFoundLinkByID("3"),
+		},
+		{
+			name:   "unauthorized",
+			user:   other,
+			linkID: "1",
+			want:   original(),
+			err:    ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{member})
+			_, err := p.React(tt.user, tt.linkID, tt.emoji)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{}, Reaction{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_Unreact(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1, reactions: Reactions{{userID: "creator-id", emoji: UpvoteReaction}}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		user   *di.User
+		linkID string
+		want   Links
+		err    error
+	}{
+		{
+			name:   "success",
+			user:   creator,
+			linkID: "1",
+			want:   Links{{id: "1", url: "a", priority: 1, reactions: Reactions{}}},
+		},
+		{
+			name:   "link_not_found",
+			user:   creator,
+			linkID: "2",
+			want:   original(),
+			err:    ErrNotFoundLinkByID("2"),
+		},
+		{
+			name:   "unauthorized",
+			user:   other,
+			linkID: "1",
+			want:   original(),
+			err:    ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), nil)
+			err := p.Unreact(tt.user, tt.linkID)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{}, Reaction{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_SortLinks(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	up := func(userIDs ...string) Reactions {
+		rs := make(Reactions, 0, len(userIDs))
+		for _, id := range userIDs {
+			rs = append(rs, Reaction{userID: id, emoji: UpvoteReaction})
+		}
+		return rs
+	}
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1},
+			{id: "2", url: "b", priority: 2, reactions: up("u1")},
+			{id: "3", url: "c", priority: 3, reactions: up("u1", "u2")},
+			{id: "4", url: "d", priority: 4, reactions: up("u2")},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		order LinkOrder
+		want  []string
+		err   error
+	}{
+		{name: "default", order: "", want: []string{"1", "2", "3", "4"}},
+		{name: "priority", order: LinkOrderPriority, want: []string{"1", "2", "3", "4"}},
+		{name: "votes", order: LinkOrderVotes, want: []string{"3", "2", "4", "1"}},
+		{name: "invalid", order: "random", want: []string{"1", "2", "3", "4"}, err: ErrInvalidLinkOrder},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), nil)
+			testutil.EqualErr(t, tt.err, p.SortLinks(tt.order))
+			got := make([]string, 0, len(p.Links()))
+			for _, l := range p.Links() {
+				got = append(got, l.ID())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("order mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_MarkLink(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		user   *di.User
+		linkID string
+		status LinkStatus
+		want   Links
+		err    error
+	}{
+		{
+			name:   "success_new_state",
+			user:   member,
+			linkID: "1",
+			status: LinkStatusDone,
+			want: Links{
+				{id: "1", url: "a", priority: 1, states: LinkStates{
+					{userID: "creator-id", status: LinkStatusRead},
+					{userID: "member-id", status: LinkStatusDone},
+				}},
+			},
+		},
+		{
+			name:   "success_mark_unread",
+			user:   creator,
+			linkID: "1",
+			status: LinkStatusUnread,
+			want: Links{
+				{id: "1", url: "a", priority: 1, states: LinkStates{{userID: "creator-id", status: LinkStatusUnread}}},
+			},
+		},
+		{
+			name:   "invalid_status",
+			user:   creator,
+			linkID: "1",
+			status: "archived",
+			want:   original(),
+			err:    ErrInvalidLinkStatus,
+		},
+		{
+			name:   "link_not_found",
+			user:   creator,
+			linkID: "2",
+			status: LinkStatusRead,
+			want:   original(),
+			err:    ErrNotFoundLinkByID("2"),
+		},
+		{
+			name:   "unauthorized",
+			user:   other,
+			linkID: "1",
+			status: LinkStatusRead,
+			want:   original(),
+			err:    ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{member})
+			_, err := p.MarkLink(tt.user, tt.linkID, tt.status)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{}, LinkState{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_MarkAllRead(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1},
+			{id: "2", url: "b", priority: 2, states: LinkStates{{userID: "creator-id", status: LinkStatusDone}}},
+			{id: "3", url: "c", priority: 3, states: LinkStates{{userID: "creator-id", status: LinkStatusUnread}}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		user    *di.User
+		wantIDs []string
+		want    Links
+		err     error
+	}{
+		{
+			name:    "success",
+			user:    creator,
+			wantIDs: []string{"1", "3"},
+			want: Links{
+				{id: "1", url: "a", priority: 1, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+				{id: "2", url: "b", priority: 2, states: LinkStates{{userID: "creator-id", status: LinkStatusDone}}},
+				{id: "3", url: "c", priority: 3, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+			},
+		},
+		{
+			name: "unauthorized",
+			user: other,
+			want: original(),
+			err:  ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), nil)
+			ids, err := p.MarkAllRead(tt.user)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.wantIDs, ids); diff != "" {
+				t.Fatalf("ids mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{}, LinkState{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_UnreadCount(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	p := ReconstructPage("page-id", "Title", *creator, "code", Links{
+		{id: "1", url: "a", priority: 1, states: LinkStates{{userID: "creator-id", status: LinkStatusRead}}},
+		{id: "2", url: "b", priority: 2, states: LinkStates{{userID: "creator-id", status: LinkStatusDone}, {userID: "member-id", status: LinkStatusRead}}},
+		{id: "3", url: "c", priority: 3},
+	}, di.Users{member})
+
+	tests := []struct {
+		name string
+		user *di.User
+		want int
+	}{
+		{name: "creator", user: creator, want: 1},
+		{name: "member", user: member, want: 2},
+		{name: "nil_user", user: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := p.UnreadCount(tt.user); got != tt.want {
+				t.Fatalf("UnreadCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_SetChecklist(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+
+	tests := []struct {
+		name    string
+		user    *di.User
+		enabled bool
+		want    bool
+		err     error
+	}{
+		{name: "enable", user: creator, enabled: true, want: true},
+		{name: "disable", user: creator, enabled: false, want: false},
+		{name: "not_creator", user: member, enabled: true, want: false, err: ErrNotCreatedByUser},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{member})
+			err := p.SetChecklist(tt.user, tt.enabled)
+			testutil.EqualErr(t, tt.err, err)
+			if got := p.IsChecklist(); got != tt.want {
+				t.Fatalf("IsChecklist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_ToggleLinkDone(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	original := func() Links {
+		return Links{
+			{id: "1", url: "a", priority: 1},
+			{id: "2", url: "b", priority: 2, doneBy: "creator-id", doneAt: doneAt},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		checklist bool
+		user      *di.User
+		linkID    string
+		want      Links
+		err       error
+	}{
+		{
+			name:      "mark_done",
+			checklist: true,
+			user:      member,
+			linkID:    "1",
+			want: Links{
+				{id: "1", url: "a", priority: 1, doneBy: "member-id", doneAt: now},
+				{id: "2", url: "b", priority: 2, doneBy: "creator-id", doneAt: doneAt},
+			},
+		},
+		{
+			name:      "clear_done_by_other_member",
+			checklist: true,
+			user:      member,
+			linkID:    "2",
+			want: Links{
+				{id: "1", url: "a", priority: 1},
+				{id: "2", url: "b", priority: 2},
+			},
+		},
+		{
+			name:      "not_checklist",
+			checklist: false,
+			user:      creator,
+			linkID:    "1",
+			want:      original(),
+			err:       ErrNotChecklist,
+		},
+		{
+			name:      "link_not_found",
+			checklist: true,
+			user:      creator,
+			linkID:    "3",
+			want:      original(),
+			err:       ErrNotFoundLinkByID("3"),
+		},
+		{
+			name:      "unauthorized",
+			checklist: true,
+			user:      other,
+			linkID:    "1",
+			want:      original(),
+			err:       ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{member}, WithChecklist(tt.checklist))
+			_, err := p.ToggleLinkDone(tt.user, tt.linkID, now)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_AcceptLinkRedirect(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	checkedAt := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	original := func() Links {
+		return Links{
+			ReconstructLink("https://example.com/old", "memo", 1, WithLinkID("1"),
+				WithLinkHealth(NewLinkHealth(301, "https://example.com/new", checkedAt))),
+			ReconstructLink("https://example.com/ok", "", 2, WithLinkID("2"),
+				WithLinkHealth(NewLinkHealth(200, "", checkedAt))),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		user     *di.User
+		archived bool
+		linkID   string
+		want     Links
+		err      error
+	}{
+		{
+			name:   "accept",
+			user:   creator,
+			linkID: "1",
+			want: Links{
+				ReconstructLink("https://example.com/new", "memo", 1, WithLinkID("1")),
+				original()[1],
+			},
+		},
+		{
+			name:   "not_redirected",
+			user:   creator,
+			linkID: "2",
+			want:   original(),
+			err:    ErrNoLinkRedirect,
+		},
+		{
+			name:   "link_not_found",
+			user:   creator,
+			linkID: "3",
+			want:   original(),
+			err:    ErrNotFoundLinkByID("3"),
+		},
+		{
+			name:   "member_is_not_creator",
+			user:   member,
+			linkID: "1",
+			want:   original(),
+			err:    ErrNotCreatedByUser,
+		},
+		{
+			name:     "archived",
+			user:     creator,
+			archived: true,
+			linkID:   "1",
+			want:     original(),
+			err:      ErrPageArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{member}, WithArchived(tt.archived))
+			_, err := p.AcceptLinkRedirect(tt.user, tt.linkID)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, p.Links(), cmp.AllowUnexported(Link{}, LinkHealth{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_Progress(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		links     Links
+		wantDone  int
+		wantTotal int
+	}{
+		{name: "empty", links: nil, wantDone: 0, wantTotal: 0},
+		{
+			name: "partially_done",
+			links: Links{
+				{id: "1", url: "a", priority: 1, doneBy: "creator-id", doneAt: doneAt},
+				{id: "2", url: "b", priority: 2},
+				{id: "3", url: "c", priority: 3, doneAt: doneAt},
+			},
+			wantDone:  2,
+			wantTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", tt.links, nil, WithChecklist(true))
+			done, total := p.Progress()
+			if done != tt.wantDone || total != tt.wantTotal {
+				t.Fatalf("Progress() = (%d, %d), want (%d, %d)", done, total, tt.wantDone, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestPage_EditDetails(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	type args struct {
+		user        *di.User
+		description string
+		icon        string
+		color       string
+	}
+	type want struct {
+		description string
+		icon        string
+		color       string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+		err  error
+	}{
+		{
+			name: "member_sets_details",
+			args: args{user: member, description: "# Trip\n\n**must** read", icon: "🏝️", color: "#FFAA00"},
+			want: want{description: "# Trip\n\n**must** read", icon: "🏝️", color: "#ffaa00"},
+		},
+		{
+			name: "clear_details",
+			args: args{user: creator},
+			want: want{},
+		},
+		{
+			name: "description_at_limit",
+			args: args{user: creator, description: strings.Repeat("あ", MaxDescriptionLength)},
+			want: want{description: strings.Repeat("あ", MaxDescriptionLength)},
+		},
+		{
+			name: "description_too_long",
+			args: args{user: creator, description: strings.Repeat("あ", MaxDescriptionLength+1)},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrDescriptionTooLong,
+		},
+		{
+			name: "icon_not_emoji",
+			args: args{user: creator, icon: "A"},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrInvalidIcon,
+		},
+		{
+			name: "icon_too_long",
+			args: args{user: creator, icon: "📚📚📚📚📚📚📚📚📚📚📚📚📚📚📚📚📚"},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrInvalidIcon,
+		},
+		{
+			name: "color_without_hash",
+			args: args{user: creator, color: "ffaa00"},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrInvalidColor,
+		},
+		{
+			name: "color_not_hex",
+			args: args{user: creator, color: "#ggaa00"},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrInvalidColor,
+		},
+		{
+			name: "not_member",
+			args: args{user: other, description: "new"},
+			want: want{description: "old", icon: "📚", color: "#123456"}, err: ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{member}, WithDetails("old", "📚", "#123456"))
+			err := p.EditDetails(tt.args.user, tt.args.description, tt.args.icon, tt.args.color)
+			testutil.EqualErr(t, tt.err, err)
+			got := want{description: p.Description(), icon: p.Icon(), color: p.Color()}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("details mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage_Archive(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+
+	tests := []struct {
+		name     string
+		archived bool
+		user     *di.User
+		want     bool
+		err      error
+	}{
+		{name: "archive", archived: false, user: creator, want: true},
+		{name: "already_archived", archived: true, user: creator, want: true},
+		{name: "not_creator", archived: false, user: member, want: false, err: ErrNotCreatedByUser},
+		{name: "nil_user", archived: false, user: nil, want: false, err: ErrNoUserProvided},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{member}, WithArchived(tt.archived))
+			err := p.Archive(tt.user)
+			testutil.EqualErr(t, tt.err, err)
+			if got := p.IsArchived(); got != tt.want {
+				t.Fatalf("IsArchived() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_Unarchive(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+
+	tests := []struct {
+		name string
+		user *di.User
+		want bool
+		err  error
+	}{
+		{name: "unarchive", user: creator, want: false},
+		{name: "not_creator", user: member, want: true, err: ErrNotCreatedByUser},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Title", *creator, "code", nil, di.Users{member}, WithArchived(true))
+			err := p.Unarchive(tt.user)
+			testutil.EqualErr(t, tt.err, err)
+			if got := p.IsArchived(); got != tt.want {
+				t.Fatalf("IsArchived() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_archivedIsReadOnly(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	joiner := di.ReconstructUser("joiner-id", "uid-j", "anonymous", nil)
+
+	original := func() Links {
+		return Links{{id: "1", url: "a", memo: "A", priority: 1}}
+	}
+	newArchived := func() *Page {
+		return ReconstructPage("page-id", "Title", *creator, "code", original(), di.Users{member}, WithArchived(true), WithChecklist(true))
+	}
+	newTarget := func() *Page {
+		return ReconstructPage("target-id", "Target", *creator, "code2", nil, nil)
+	}
+
+	tests := []struct {
+		name      string
+		run       func(p *Page) error
+		err       error
+		wantLinks Links
+	}{
+		{
+			name:      "edit",
+			run:       func(p *Page) error { return p.Edit(member, "New", Links{{url: "a", memo: "B", priority: 1}}) },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name:      "edit_details",
+			run:       func(p *Page) error { return p.EditDetails(member, "desc", "", "") },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name:      "add_link",
+			run:       func(p *Page) error { return p.AddLink(member, "b", "") },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name:      "remove_link",
+			run:       func(p *Page) error { return p.RemoveLink(creator, "a") },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name:      "join",
+			run:       func(p *Page) error { return p.Join(joiner, "code") },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name: "toggle_link_done",
+			run: func(p *Page) error {
+				_, err := p.ToggleLinkDone(member, "1", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+				return err
+			},
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name:      "move_links",
+			run:       func(p *Page) error { return p.MoveLinks(creator, newTarget(), []string{"1"}, false) },
+			err:       ErrPageArchived,
+			wantLinks: original(),
+		},
+		{
+			name: "copy_links",
+			run: func(p *Page) error {
+				target := newTarget()
+				if err := p.MoveLinks(creator, target, []string{"1"}, true); err != nil {
+					return err
+				}
+				if len(target.Links()) != 1 {
+					t.Errorf("target links = %d, want 1", len(target.Links()))
+				}
+				return nil
+			},
+			wantLinks: original(),
+		},
+		{
+			name:      "not_member_before_archived",
+			run:       func(p *Page) error { return p.AddLink(joiner, "b", "") },
+			err:       ErrNotCreatedByUser,
+			wantLinks: original(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := newArchived()
+			err := tt.run(p)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.wantLinks, p.Links(), cmp.AllowUnexported(Link{})); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+			if p.Title() != "Title" || len(p.InvitedUsers()) != 1 {
+				t.Fatalf("archived page changed: title=%q members=%d", p.Title(), len(p.InvitedUsers()))
+			}
+		})
+	}
+}
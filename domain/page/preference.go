@@ -0,0 +1,221 @@
+package page
+
+import (
+	"cmp"
+	"slices"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// Preference is a user's personal setting for a page.
+type Preference struct {
+	pageID string
+	pinned bool
+	// position is the place of the page in the user's own order, starting at 1. Zero means the page has no place.
+	position int
+	// inbox reports whether captured links go to the page.
+	inbox bool
+}
+
+// PageID returns the ID of the page the preference is for.
+func (p Preference) PageID() string { return p.pageID }
+
+// Pinned reports whether the user pinned the page.
+func (p Preference) Pinned() bool { return p.pinned }
+
+// Position returns the place of the page in the user's own order, or zero if it has none.
+func (p Preference) Position() int { return p.position }
+
+// Inbox reports whether the page is the user's inbox for captured links.
+func (p Preference) Inbox() bool { return p.inbox }
+
+// ReconstructPreference reconstructs a Preference from its components.
+func ReconstructPreference(pageID string, pinned bool, position int, inbox bool) Preference {
+	return Preference{
+		pageID:   pageID,
+		pinned:   pinned,
+		position: position,
+		inbox:    inbox,
+	}
+}
+
+// Preferences are the personal page settings of a user. They are kept apart from the pages so that
+// pinning or ordering pages never touches data shared with the other members.
+type Preferences struct {
+	userID string
+	items  []Preference
+}
+
+// ReconstructPreferences reconstructs the Preferences of a user. Pass nil items for a user without settings.
+func ReconstructPreferences(userID string, items []Preference) *Preferences {
+	return &Preferences{
+		userID: userID,
+		items:  items,
+	}
+}
+
+// UserID returns the ID of the user the preferences belong to.
+func (ps *Preferences) UserID() string { return ps.userID }
+
+// Items returns the settings that differ from the default, that is pinned, positioned or inbox pages.
+func (ps *Preferences) Items() []Preference {
+	return slices.DeleteFunc(slices.Clone(ps.items), func(p Preference) bool {
+		return !p.pinned && p.position == 0 && !p.inbox
+	})
+}
+
+// IsPinned reports whether the user pinned the page.
+func (ps *Preferences) IsPinned(pageID string) bool {
+	return ps.of(pageID).pinned
+}
+
+// InboxPageID returns the ID of the page captured links go to, or an empty string if the user has no inbox.
+func (ps *Preferences) InboxPageID() string {
+	if idx := slices.IndexFunc(ps.items, func(p Preference) bool { return p.inbox }); idx >= 0 {
+		return ps.items[idx].pageID
+	}
+	return ""
+}
+
+// IsInbox reports whether the page is the user's inbox.
+func (ps *Preferences) IsInbox(pageID string) bool {
+	return ps.of(pageID).inbox
+}
+
+// SetInbox makes the page the user's inbox in place of the current one. The user must be able to add links
+// to the page, so archived pages cannot be the inbox.
+func (ps *Preferences) SetInbox(user *duser.User, page *Page) error {
+	if err := ps.authorize(user, page); err != nil {
+		return err
+	}
+	if page.IsArchived() {
+		return ErrPageArchived
+	}
+
+	for i := range ps.items {
+		ps.items[i].inbox = false
+	}
+	ps.item(page.ID()).inbox = true
+	return nil
+}
+
+// Pin pins the page for the user. The user must be able to access the page.
+func (ps *Preferences) Pin(user *duser.User, page *Page) error {
+	if err := ps.authorize(user, page); err != nil {
+		return err
+	}
+
+	ps.item(page.ID()).pinned = true
+	return nil
+}
+
+// Unpin unpins the page. It does nothing if the page is not pinned, so pages the user has left can still be unpinned.
+func (ps *Preferences) Unpin(user *duser.User, pageID string) error {
+	if err := ps.validateUser(user); err != nil {
+		return err
+	}
+
+	if idx := ps.indexOf(pageID); idx >= 0 {
+		ps.items[idx].pinned = false
+	}
+	return nil
+}
+
+// Reorder sets the user's own order of pages. The pages listed in pageIDs come first in the given order and
+// the remaining pages follow in the default order. Every listed page must be in pages and accessible by the user.
+func (ps *Preferences) Reorder(user *duser.User, pages []*Page, pageIDs []string) error {
+	if err := ps.validateUser(user); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(pageIDs))
+	for _, id := range pageIDs {
+		if _, ok := seen[id]; ok {
+			return ErrInvalidPageOrder
+		}
+		seen[id] = struct{}{}
+
+		idx := slices.IndexFunc(pages, func(p *Page) bool { return p != nil && p.ID() == id })
+		if idx < 0 {
+			return ErrInvalidPageOrder
+		}
+		if err := pages[idx].Authorize(user); err != nil {
+			return err
+		}
+	}
+
+	for i := range ps.items {
+		ps.items[i].position = 0
+	}
+	for i, id := range pageIDs {
+		ps.item(id).position = i + 1
+	}
+	return nil
+}
+
+// Sort orders the pages for the user: pinned pages first, then within pinned and unpinned pages those with a
+// place in the user's own order by that place, then the rest in their current order.
+func (ps *Preferences) Sort(pages []*Page) {
+	slices.SortStableFunc(pages, func(a, b *Page) int {
+		pa, pb := ps.of(a.ID()), ps.of(b.ID())
+		if pa.pinned != pb.pinned {
+			if pa.pinned {
+				return -1
+			}
+			return 1
+		}
+		switch {
+		case pa.position == pb.position:
+			return 0
+		case pa.position == 0:
+			return 1
+		case pb.position == 0:
+			return -1
+		}
+		return cmp.Compare(pa.position, pb.position)
+	})
+}
+
+// authorize checks that the preferences belong to the user and that the user can access the page.
+func (ps *Preferences) authorize(user *duser.User, page *Page) error {
+	if page == nil {
+		return ErrNoPageProvided
+	}
+	if err := ps.validateUser(user); err != nil {
+		return err
+	}
+	return page.Authorize(user)
+}
+
+// validateUser checks that the preferences belong to the user.
+func (ps *Preferences) validateUser(user *duser.User) error {
+	if user == nil {
+		return ErrNoUserProvided
+	}
+	if user.ID() != ps.userID {
+		return ErrNotPreferenceOwner
+	}
+	return nil
+}
+
+// of returns the setting for the page, or the default setting if there is none.
+func (ps *Preferences) of(pageID string) Preference {
+	if idx := ps.indexOf(pageID); idx >= 0 {
+		return ps.items[idx]
+	}
+	return Preference{pageID: pageID}
+}
+
+func (ps *Preferences) indexOf(pageID string) int {
+	return slices.IndexFunc(ps.items, func(p Preference) bool { return p.pageID == pageID })
+}
+
+// item returns the setting for the page, adding a default one if there is none.
+func (ps *Preferences) item(pageID string) *Preference {
+	idx := ps.indexOf(pageID)
+	if idx < 0 {
+		ps.items = append(ps.items, Preference{pageID: pageID})
+		idx = len(ps.items) - 1
+	}
+	return &ps.items[idx]
+}
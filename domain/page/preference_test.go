@@ -0,0 +1,319 @@
+package page
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	di "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestPreferences_Pin(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+	page := ReconstructPage("page-1", "Title", *creator, "code", nil, di.Users{member})
+
+	tests := []struct {
+		name  string
+		owner string
+		user  *di.User
+		page  *Page
+		want  []Preference
+		err   error
+	}{
+		{
+			name:  "member",
+			owner: "member-id",
+			user:  member,
+			page:  page,
+			want:  []Preference{{pageID: "page-1", pinned: true}},
+		},
+		{
+			name:  "not_member",
+			owner: "other-id",
+			user:  other,
+			page:  page,
+			want:  nil,
+			err:   ErrNotCreatedByUser,
+		},
+		{
+			name:  "not_owner",
+			owner: "member-id",
+			user:  creator,
+			page:  page,
+			want:  nil,
+			err:   ErrNotPreferenceOwner,
+		},
+		{
+			name:  "nil_page",
+			owner: "member-id",
+			user:  member,
+			want:  nil,
+			err:   ErrNoPageProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences(tt.owner, nil)
+			err := ps.Pin(tt.user, tt.page)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, ps.Items(), cmp.AllowUnexported(Preference{})); diff != "" {
+				t.Fatalf("items mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreferences_Unpin(t *testing.T) {
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+
+	tests := []struct {
+		name   string
+		pageID string
+		want   []Preference
+	}{
+		{
+			name:   "pinned_and_positioned",
+			pageID: "page-1",
+			want: []Preference{
+				{pageID: "page-1", position: 1},
+				{pageID: "page-2", pinned: true},
+			},
+		},
+		{
+			name:   "pinned_only",
+			pageID: "page-2",
+			want:   []Preference{{pageID: "page-1", pinned: true, position: 1}},
+		},
+		{
+			name:   "not_pinned",
+			pageID: "page-3",
+			want: []Preference{
+				{pageID: "page-1", pinned: true, position: 1},
+				{pageID: "page-2", pinned: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences("member-id", []Preference{
+				ReconstructPreference("page-1", true, 1, false),
+				ReconstructPreference("page-2", true, 0, false),
+			})
+			if err := ps.Unpin(member, tt.pageID); err != nil {
+				t.Fatalf("Unpin() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, ps.Items(), cmp.AllowUnexported(Preference{})); diff != "" {
+				t.Fatalf("items mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreferences_SetInbox(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := di.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+	page := ReconstructPage("page-3", "Title", *creator, "code", nil, di.Users{member})
+	archived := ReconstructPage("page-3", "Title", *creator, "code", nil, di.Users{member}, WithArchived(true))
+
+	tests := []struct {
+		name  string
+		owner string
+		user  *di.User
+		page  *Page
+		want  []Preference
+		err   error
+	}{
+		{
+			name:  "replaces_current_inbox",
+			owner: "member-id",
+			user:  member,
+			page:  page,
+			want: []Preference{
+				{pageID: "page-1", pinned: true},
+				{pageID: "page-3", inbox: true},
+			},
+		},
+		{
+			name:  "archived_page",
+			owner: "member-id",
+			user:  member,
+			page:  archived,
+			want: []Preference{
+				{pageID: "page-1", pinned: true},
+				{pageID: "page-2", inbox: true},
+			},
+			err: ErrPageArchived,
+		},
+		{
+			name:  "not_member",
+			owner: "other-id",
+			user:  other,
+			page:  page,
+			want: []Preference{
+				{pageID: "page-1", pinned: true},
+				{pageID: "page-2", inbox: true},
+			},
+			err: ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences(tt.owner, []Preference{
+				ReconstructPreference("page-1", true, 0, false),
+				ReconstructPreference("page-2", false, 0, true),
+			})
+			err := ps.SetInbox(tt.user, tt.page)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, ps.Items(), cmp.AllowUnexported(Preference{})); diff != "" {
+				t.Fatalf("items mismatch (-want +got):\n%s", diff)
+			}
+			if got, want := ps.InboxPageID(), tt.want[len(tt.want)-1].pageID; got != want {
+				t.Fatalf("InboxPageID() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPreferences_Reorder(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := di.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	pages := []*Page{
+		ReconstructPage("page-1", "One", *creator, "code1", nil, di.Users{member}),
+		ReconstructPage("page-2", "Two", *creator, "code2", nil, di.Users{member}),
+		ReconstructPage("page-3", "Three", *creator, "code3", nil, nil),
+	}
+	original := func() []Preference {
+		return []Preference{
+			{pageID: "page-1", pinned: true, position: 2},
+			{pageID: "page-2", position: 1},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		user    *di.User
+		pageIDs []string
+		want    []Preference
+		err     error
+	}{
+		{
+			name:    "success",
+			user:    member,
+			pageIDs: []string{"page-2", "page-1"},
+			want: []Preference{
+				{pageID: "page-1", pinned: true, position: 2},
+				{pageID: "page-2", position: 1},
+			},
+		},
+		{
+			name:    "clear_unlisted",
+			user:    member,
+			pageIDs: []string{"page-1"},
+			want:    []Preference{{pageID: "page-1", pinned: true, position: 1}},
+		},
+		{
+			name:    "duplicate",
+			user:    member,
+			pageIDs: []string{"page-1", "page-1"},
+			want:    original(),
+			err:     ErrInvalidPageOrder,
+		},
+		{
+			name:    "unknown_page",
+			user:    member,
+			pageIDs: []string{"page-9"},
+			want:    original(),
+			err:     ErrInvalidPageOrder,
+		},
+		{
+			name:    "not_member",
+			user:    member,
+			pageIDs: []string{"page-3"},
+			want:    original(),
+			err:     ErrNotCreatedByUser,
+		},
+		{
+			name:    "not_owner",
+			user:    creator,
+			pageIDs: []string{"page-1"},
+			want:    original(),
+			err:     ErrNotPreferenceOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences("member-id", original())
+			err := ps.Reorder(tt.user, pages, tt.pageIDs)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, ps.Items(), cmp.AllowUnexported(Preference{})); diff != "" {
+				t.Fatalf("items mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreferences_Sort(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	newPages := func(ids ...string) []*Page {
+		pages := make([]*Page, 0, len(ids))
+		for _, id := range ids {
+			pages = append(pages, ReconstructPage(id, id, *creator, "code", nil, nil))
+		}
+		return pages
+	}
+
+	tests := []struct {
+		name  string
+		items []Preference
+		pages []*Page
+		want  []string
+	}{
+		{
+			name:  "no_preferences",
+			pages: newPages("a", "b", "c"),
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name: "pinned_then_ordered_then_rest",
+			items: []Preference{
+				{pageID: "e", pinned: true},
+				{pageID: "d", pinned: true, position: 3},
+				{pageID: "c", position: 2},
+				{pageID: "b", position: 1},
+			},
+			pages: newPages("a", "b", "c", "d", "e", "f"),
+			want:  []string{"d", "e", "b", "c", "a", "f"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ps := ReconstructPreferences("creator-id", tt.items)
+			ps.Sort(tt.pages)
+			got := make([]string, 0, len(tt.pages))
+			for _, p := range tt.pages {
+				got = append(got, p.ID())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("order mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
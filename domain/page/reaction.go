@@ -0,0 +1,133 @@
+package page
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// UpvoteReaction is the reaction stored for a plain upvote.
+	UpvoteReaction = "+1"
+	// maxReactionLength is the maximum number of runes in a reaction emoji, enough for ZWJ sequences and flags.
+	maxReactionLength = 16
+)
+
+// Reaction is a member's reaction to a link. A member has at most one reaction per link.
+type Reaction struct {
+	userID string
+	emoji  string
+}
+
+// UserID returns the ID of the user who reacted.
+func (r Reaction) UserID() string { return r.userID }
+
+// Emoji returns the reaction emoji, or UpvoteReaction for a plain upvote.
+func (r Reaction) Emoji() string { return r.emoji }
+
+// ReconstructReaction reconstructs a Reaction from its components.
+func ReconstructReaction(userID string, emoji string) Reaction {
+	return Reaction{
+		userID: userID,
+		emoji:  emoji,
+	}
+}
+
+// ReactionCount is the number of members who reacted to a link with the emoji.
+type ReactionCount struct {
+	Emoji string
+	Count int
+}
+
+type Reactions []Reaction
+
+// Votes returns the number of members who reacted to the link. Every reaction counts as a vote.
+func (rs Reactions) Votes() int { return len(rs) }
+
+// Of returns the reaction of the user, if any.
+func (rs Reactions) Of(userID string) (Reaction, bool) {
+	idx := slices.IndexFunc(rs, func(r Reaction) bool { return r.userID == userID })
+	if idx < 0 {
+		return Reaction{}, false
+	}
+	return rs[idx], true
+}
+
+// Counts returns the number of reactions per emoji, most used first and then by emoji.
+func (rs Reactions) Counts() []ReactionCount {
+	counts := make([]ReactionCount, 0, len(rs))
+	for _, r := range rs {
+		idx := slices.IndexFunc(counts, func(c ReactionCount) bool { return c.Emoji == r.emoji })
+		if idx < 0 {
+			counts = append(counts, ReactionCount{Emoji: r.emoji, Count: 1})
+			continue
+		}
+		counts[idx].Count++
+	}
+	slices.SortFunc(counts, func(a, b ReactionCount) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.Emoji, b.Emoji)
+	})
+	return counts
+}
+
+// set sets the reaction of the user, replacing any previous one.
+func (rs *Reactions) set(userID string, emoji string) Reaction {
+	reaction := Reaction{userID: userID, emoji: emoji}
+	if idx := slices.IndexFunc(*rs, func(r Reaction) bool { return r.userID == userID }); idx >= 0 {
+		(*rs)[idx] = reaction
+		return reaction
+	}
+	*rs = append(*rs, reaction)
+	return reaction
+}
+
+// remove removes the reaction of the user. It does nothing if the user has not reacted.
+func (rs *Reactions) remove(userID string) {
+	*rs = slices.DeleteFunc(*rs, func(r Reaction) bool { return r.userID == userID })
+}
+
+// validateReaction validates that the reaction is UpvoteReaction or a single emoji.
+func validateReaction(emoji string) error {
+	if emoji == UpvoteReaction {
+		return nil
+	}
+	if !isEmoji(emoji) {
+		return ErrInvalidReaction
+	}
+	return nil
+}
+
+// isEmoji reports whether s is a single emoji, including ZWJ sequences, flags and keycaps.
+func isEmoji(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > maxReactionLength {
+		return false
+	}
+	hasSymbol := false
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.So, unicode.Me):
+			hasSymbol = true
+		case unicode.In(r, unicode.Sk, unicode.Mn, unicode.Cf):
+			// Skin tone modifiers, variation selectors, zero width joiners and tag characters.
+		case r == '#' || r == '*' || ('0' <= r && r <= '9'):
+			// Keycap bases.
+		default:
+			return false
+		}
+	}
+	return hasSymbol
+}
+
+// LinkOrder is the order in which the links of a page are listed.
+type LinkOrder string
+
+const (
+	// LinkOrderPriority lists the links in their manual order. It is the default when no order is given.
+	LinkOrderPriority LinkOrder = "priority"
+	// LinkOrderVotes lists the links with the most votes first, keeping the manual order for ties.
+	LinkOrderVotes LinkOrder = "votes"
+)
@@ -0,0 +1,63 @@
+package page
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestReactions_Counts(t *testing.T) {
+	rs := Reactions{
+		{userID: "u1", emoji: "🎉"},
+		{userID: "u2", emoji: UpvoteReaction},
+		{userID: "u3", emoji: "👀"},
+		{userID: "u4", emoji: UpvoteReaction},
+	}
+
+	want := []ReactionCount{
+		{Emoji: UpvoteReaction, Count: 2},
+		{Emoji: "🎉", Count: 1},
+		{Emoji: "👀", Count: 1},
+	}
+	if diff := cmp.Diff(want, rs.Counts()); diff != "" {
+		t.Fatalf("counts mismatch (-want +got):\n%s", diff)
+	}
+	if got := rs.Votes(); got != 4 {
+		t.Fatalf("Votes() = %d, want 4", got)
+	}
+	if r, ok := rs.Of("u3"); !ok || r.Emoji() != "👀" {
+		t.Fatalf("Of(u3) = %v, %v", r, ok)
+	}
+	if _, ok := rs.Of("u5"); ok {
+		t.Fatal("Of(u5) found a reaction")
+	}
+}
+
+func Test_validateReaction(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		err   error
+	}{
+		{name: "upvote", emoji: UpvoteReaction},
+		{name: "emoji", emoji: "👍"},
+		{name: "skin_tone", emoji: "👍🏽"},
+		{name: "zwj_sequence", emoji: "👨‍👩‍👧‍👦"},
+		{name: "flag", emoji: "🇯🇵"},
+		{name: "keycap", emoji: "1️⃣"},
+		{name: "empty", emoji: "", err: ErrInvalidReaction},
+		{name: "text", emoji: "nice", err: ErrInvalidReaction},
+		{name: "emoji_with_text", emoji: "👍ok", err: ErrInvalidReaction},
+		{name: "digit", emoji: "1", err: ErrInvalidReaction},
+		{name: "too_long", emoji: "👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍", err: ErrInvalidReaction},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.err, validateReaction(tt.emoji))
+		})
+	}
+}
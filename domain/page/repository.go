@@ -1,6 +1,9 @@
 package page
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_page/page.go -source=./repository.go -package=mockpage
 
@@ -11,9 +14,44 @@ type PageRepository interface {
 	DeleteByID(ctx context.Context, id string) error
 }
 
+// SlugRepository looks pages up by any slug they ever had. Slugs are recorded when the page is saved.
+type SlugRepository interface {
+	// Resolve returns the ID of the page that has or had the slug, or an empty string if no page did.
+	Resolve(ctx context.Context, slug string) (string, error)
+}
+
+// ReactionRepository stores reactions one at a time so that members reacting at the same time do not overwrite each other.
+type ReactionRepository interface {
+	Save(ctx context.Context, linkID string, reaction Reaction) error
+	Delete(ctx context.Context, linkID string, userID string) error
+}
+
+// LinkStateRepository stores the statuses of a member on links without touching the statuses of other members.
+type LinkStateRepository interface {
+	Save(ctx context.Context, linkIDs []string, state LinkState) error
+}
+
+// LinkHealthRepository stores the outcomes of the dead link checker without touching the rest of the links.
+type LinkHealthRepository interface {
+	// ListDue returns up to limit links that were never checked or were last checked before the given time,
+	// the least recently checked first. Only the ID and the URL of the links are set.
+	ListDue(ctx context.Context, checkedBefore time.Time, limit int) (Links, error)
+	// Save stores the outcome of the check of the link. Nothing is stored if the URL of the link changed
+	// after it was listed, since the outcome is for the old URL.
+	Save(ctx context.Context, link Link, health LinkHealth) error
+}
+
+// PreferenceRepository stores the personal page settings of a user.
+type PreferenceRepository interface {
+	// Get returns the preferences of the user. A user without settings gets empty preferences.
+	Get(ctx context.Context, userID string) (*Preferences, error)
+	Save(ctx context.Context, preferences *Preferences) error
+}
+
 type SearchParams struct {
 	IDs             []string
 	CreatedByUserID string
+	IncludeArchived bool
 	Page            *int32
 	PageSize        *int32
 }
@@ -40,6 +78,13 @@ func WithCreatedByUserID(userID string) SearchOption {
 	})
 }
 
+// WithIncludeArchived lists archived pages as well. They are left out by default.
+func WithIncludeArchived(include bool) SearchOption {
+	return optionFunc(func(p *SearchParams) {
+		p.IncludeArchived = include
+	})
+}
+
 func WithPageSearchOption(page int32) SearchOption {
 	return optionFunc(func(p *SearchParams) {
 		p.Page = &page
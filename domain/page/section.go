@@ -0,0 +1,145 @@
+package page
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// MaxSectionNameLength is the maximum number of characters in a section name.
+const MaxSectionNameLength = 50
+
+// Section is a named group of links inside a page.
+type Section struct {
+	id       string
+	name     string
+	priority int
+}
+
+// ID returns the section ID. It is empty until the section has been persisted.
+func (s Section) ID() string { return s.id }
+
+// Name returns the section name.
+func (s Section) Name() string { return s.name }
+
+// Priority returns the display order of the section within the page.
+func (s Section) Priority() int { return s.priority }
+
+type Sections []Section
+
+// addSection adds a new section to the end of the Sections slice.
+func (ss *Sections) addSection(name string) {
+	*ss = append(*ss, Section{
+		name:     name,
+		priority: len(*ss) + 1,
+	})
+}
+
+// duplicate returns copies of the sections with new IDs in priority order, and the new ID of each copied section by
+// the ID of its original.
+func (ss Sections) duplicate() (Sections, map[string]string) {
+	dup := slices.Clone(ss)
+	dup.renumber()
+	newIDs := make(map[string]string, len(dup))
+	for i := range dup {
+		id := sectionIDGenerator()
+		newIDs[dup[i].id] = id
+		dup[i].id = id
+	}
+	return dup, newIDs
+}
+
+// renameSection renames the section with the given ID.
+func (ss Sections) renameSection(id string, name string) error {
+	idx, err := ss.getIndexByID(id)
+	if err != nil {
+		return err
+	}
+	ss[idx].name = name
+	return nil
+}
+
+// removeSection removes the section with the given ID.
+func (ss *Sections) removeSection(id string) error {
+	idx, err := ss.getIndexByID(id)
+	if err != nil {
+		return err
+	}
+
+	*ss = slices.Delete(*ss, idx, idx+1)
+	ss.renumber()
+
+	return nil
+}
+
+// reorderSections orders the sections as given. The IDs must list every section exactly once.
+func (ss *Sections) reorderSections(ids []string) error {
+	if len(ids) != len(*ss) {
+		return ErrInvalidSectionsLength
+	}
+
+	reordered := make(Sections, 0, len(ids))
+	for i, id := range ids {
+		if slices.ContainsFunc(reordered, func(s Section) bool { return s.id == id }) {
+			return ErrInvalidSectionsLength
+		}
+		idx, err := ss.getIndexByID(id)
+		if err != nil {
+			return err
+		}
+		section := (*ss)[idx]
+		section.priority = i + 1
+		reordered = append(reordered, section)
+	}
+
+	*ss = reordered
+	return nil
+}
+
+// renumber sorts the sections by priority and reassigns priorities starting from 1.
+func (ss *Sections) renumber() {
+	slices.SortStableFunc(*ss, func(a, b Section) int {
+		return a.priority - b.priority
+	})
+	for i := range *ss {
+		(*ss)[i].priority = i + 1
+	}
+}
+
+// getIndexByID returns the index of the section with the given ID.
+func (ss Sections) getIndexByID(id string) (int, error) {
+	idx := slices.IndexFunc(ss, func(s Section) bool {
+		return id != "" && s.id == id
+	})
+	if idx == -1 {
+		return -1, ErrNotFoundSection
+	}
+	return idx, nil
+}
+
+var sectionIDGenerator = defaultSectionIDGenerator
+
+// defaultSectionIDGenerator returns a new UUIDv7, the same kind of ID the repository gives stored sections.
+func defaultSectionIDGenerator() string {
+	return tsuuid.NewV7().String()
+}
+
+func validateSectionName(name string) error {
+	if name == "" {
+		return ErrNoSectionNameProvided
+	}
+	if utf8.RuneCountInString(name) > MaxSectionNameLength {
+		return ErrSectionNameTooLong
+	}
+	return nil
+}
+
+// ReconstructSection reconstructs a Section from its components.
+func ReconstructSection(id string, name string, priority int) Section {
+	return Section{
+		id:       id,
+		name:     name,
+		priority: priority,
+	}
+}
@@ -0,0 +1,108 @@
+package page
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestSections_reorderSections(t *testing.T) {
+	original := func() Sections {
+		return Sections{
+			{id: "s1", name: "A", priority: 1},
+			{id: "s2", name: "B", priority: 2},
+			{id: "s3", name: "C", priority: 3},
+		}
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want Sections
+		err  error
+	}{
+		{
+			name: "success",
+			ids:  []string{"s3", "s1", "s2"},
+			want: Sections{
+				{id: "s3", name: "C", priority: 1},
+				{id: "s1", name: "A", priority: 2},
+				{id: "s2", name: "B", priority: 3},
+			},
+		},
+		{
+			name: "missing_section",
+			ids:  []string{"s3", "s1"},
+			want: original(),
+			err:  ErrInvalidSectionsLength,
+		},
+		{
+			name: "duplicate_section",
+			ids:  []string{"s3", "s3", "s1"},
+			want: original(),
+			err:  ErrInvalidSectionsLength,
+		},
+		{
+			name: "unknown_section",
+			ids:  []string{"s3", "s1", "x"},
+			want: original(),
+			err:  ErrNotFoundSection,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ss := original()
+			err := ss.reorderSections(tt.ids)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, ss, cmp.AllowUnexported(Section{})); diff != "" {
+				t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSections_removeSection(t *testing.T) {
+	ss := Sections{
+		{id: "s1", name: "A", priority: 1},
+		{id: "s2", name: "B", priority: 2},
+		{id: "s3", name: "C", priority: 3},
+	}
+
+	if err := ss.removeSection("s2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Sections{
+		{id: "s1", name: "A", priority: 1},
+		{id: "s3", name: "C", priority: 2},
+	}
+	if diff := cmp.Diff(want, ss, cmp.AllowUnexported(Section{})); diff != "" {
+		t.Fatalf("sections mismatch (-want +got):\n%s", diff)
+	}
+
+	testutil.EqualErr(t, ErrNotFoundSection, ss.removeSection("s2"))
+}
+
+func TestValidateSectionName(t *testing.T) {
+	tests := []struct {
+		name        string
+		sectionName string
+		want        error
+	}{
+		{name: "valid", sectionName: "Day 1", want: nil},
+		{name: "max_length", sectionName: "あいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこ", want: nil},
+		{name: "empty", sectionName: "", want: ErrNoSectionNameProvided},
+		{name: "too_long", sectionName: "あいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこあいうえおかきくけこさ", want: ErrSectionNameTooLong},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.want, validateSectionName(tt.sectionName))
+		})
+	}
+}
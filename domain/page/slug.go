@@ -0,0 +1,78 @@
+package page
+
+import (
+	"crypto/rand"
+	"regexp"
+	"strings"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+const (
+	// MinSlugLength is the minimum number of characters in a slug.
+	MinSlugLength = 3
+	// MaxSlugLength is the maximum number of characters in a slug. It is kept below the length of a page ID
+	// in any form so that a slug is never mistaken for an ID.
+	MaxSlugLength = 30
+
+	generatedSlugLength   = 10
+	generatedSlugAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+)
+
+// slugPattern matches lowercase letters and digits in words joined by single hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NormalizeSlug lowercases the slug and trims surrounding spaces, so that slugs are matched without case.
+func NormalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// ValidateSlug checks that the normalized slug can address a page.
+func ValidateSlug(slug string) error {
+	if len(slug) < MinSlugLength || len(slug) > MaxSlugLength || !slugPattern.MatchString(slug) {
+		return ErrInvalidSlug
+	}
+	return nil
+}
+
+// Slug returns the current slug of the page. Earlier slugs keep addressing the page, so links shared
+// before a rename still work. It is empty only for pages that were never given one.
+func (p *Page) Slug() string {
+	return p.slug
+}
+
+// SetSlug makes the vanity slug the current slug of the page. Only the creator can choose it.
+// Whether another page has already used the slug is checked against the slug history by the caller.
+func (p *Page) SetSlug(user *duser.User, slug string) error {
+	if err := p.validateCreatedBy(user); err != nil {
+		return err
+	}
+	slug = NormalizeSlug(slug)
+	if err := ValidateSlug(slug); err != nil {
+		return err
+	}
+	p.slug = slug
+	return nil
+}
+
+var slugGenerator = defaultSlugGenerator
+
+// defaultSlugGenerator returns a short random slug without the letters and digits that are easily confused.
+func defaultSlugGenerator() (string, error) {
+	buf := make([]byte, generatedSlugLength)
+	max := byte(len(generatedSlugAlphabet))
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = generatedSlugAlphabet[b%max]
+	}
+	return string(buf), nil
+}
+
+// WithSlug sets the current slug of the page.
+func WithSlug(slug string) ReconstructOption {
+	return func(p *Page) {
+		p.slug = slug
+	}
+}
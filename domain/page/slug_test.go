@@ -0,0 +1,81 @@
+package page
+
+import (
+	"strings"
+	"testing"
+
+	di "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestValidateSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		want error
+	}{
+		{name: "words", slug: "trip-2026"},
+		{name: "shortest", slug: "abc"},
+		{name: "longest", slug: strings.Repeat("a", MaxSlugLength)},
+		{name: "too_short", slug: "ab", want: ErrInvalidSlug},
+		{name: "too_long", slug: strings.Repeat("a", MaxSlugLength+1), want: ErrInvalidSlug},
+		{name: "uppercase", slug: "Trip", want: ErrInvalidSlug},
+		{name: "leading_hyphen", slug: "-trip", want: ErrInvalidSlug},
+		{name: "trailing_hyphen", slug: "trip-", want: ErrInvalidSlug},
+		{name: "double_hyphen", slug: "trip--2026", want: ErrInvalidSlug},
+		{name: "other_characters", slug: "trip_2026", want: ErrInvalidSlug},
+		{name: "page_id", slug: "0190c7e0-0000-7000-8000-000000000001", want: ErrInvalidSlug},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.want, ValidateSlug(tt.slug))
+		})
+	}
+}
+
+func TestPage_SetSlug(t *testing.T) {
+	creator := di.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := di.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+
+	tests := []struct {
+		name     string
+		user     *di.User
+		slug     string
+		wantSlug string
+		wantErr  error
+	}{
+		{name: "normalized", user: creator, slug: "  Trip-2026 ", wantSlug: "trip-2026"},
+		{name: "invalid", user: creator, slug: "trip 2026", wantSlug: "oldslug001", wantErr: ErrInvalidSlug},
+		{name: "not_creator", user: invited, slug: "trip-2026", wantSlug: "oldslug001", wantErr: ErrNotCreatedByUser},
+		{name: "no_user", slug: "trip-2026", wantSlug: "oldslug001", wantErr: ErrNoUserProvided},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, di.Users{invited}, WithSlug("oldslug001"))
+			testutil.EqualErr(t, tt.wantErr, p.SetSlug(tt.user, tt.slug))
+			if got := p.Slug(); got != tt.wantSlug {
+				t.Fatalf("Slug() = %q, want %q", got, tt.wantSlug)
+			}
+		})
+	}
+}
+
+func TestDefaultSlugGenerator(t *testing.T) {
+	const iterations = 32
+	for i := 0; i < iterations; i++ {
+		slug, err := defaultSlugGenerator()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(slug) != generatedSlugLength {
+			t.Fatalf("unexpected length: got %d", len(slug))
+		}
+		if err := ValidateSlug(slug); err != nil {
+			t.Fatalf("generated slug %q is invalid: %v", slug, err)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package snapshot
+
+import "errors"
+
+var ErrPageMismatch = errors.New("snapshot does not belong to the page")
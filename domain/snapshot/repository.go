@@ -0,0 +1,13 @@
+package snapshot
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_snapshot/snapshot.go -source=./repository.go -package=mocksnapshot
+
+type SnapshotRepository interface {
+	Get(ctx context.Context, id string) (*Snapshot, error)
+	// ListByLink returns the snapshots of the link, newest first.
+	ListByLink(ctx context.Context, linkID string) ([]*Snapshot, error)
+	// Create inserts the snapshot. Snapshots are never changed once created.
+	Create(ctx context.Context, snapshot *Snapshot) (*Snapshot, error)
+}
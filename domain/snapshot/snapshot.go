@@ -0,0 +1,140 @@
+package snapshot
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// ContentType is the media type of stored snapshots.
+const ContentType = "text/html; charset=utf-8"
+
+// Snapshot is an archived copy of the web page a link points to, taken at the request of a page member.
+// The HTML itself lives in blob storage under the storage key; scripts are removed before it is stored.
+type Snapshot struct {
+	id         string
+	pageID     string
+	linkID     string
+	url        string
+	storageKey string
+	size       int64
+	createdBy  string
+	createdAt  time.Time
+}
+
+// NewSnapshot creates a snapshot of a link of the page requested by the user. The URL of the link at this
+// moment is archived, and the content is stored under a new storage key.
+func NewSnapshot(page *dpage.Page, user *duser.User, linkID string, now time.Time) (*Snapshot, error) {
+	if page == nil {
+		return nil, dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return nil, dpage.ErrNoUserProvided
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	link, err := page.Link(linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		pageID:     page.ID(),
+		linkID:     linkID,
+		url:        link.URL(),
+		storageKey: newStorageKey(page.ID(), linkID),
+		createdBy:  user.ID(),
+		createdAt:  now,
+	}, nil
+}
+
+// ID returns the snapshot ID.
+func (s *Snapshot) ID() string {
+	return s.id
+}
+
+// PageID returns the ID of the page the archived link belongs to.
+func (s *Snapshot) PageID() string {
+	return s.pageID
+}
+
+// LinkID returns the ID of the archived link.
+func (s *Snapshot) LinkID() string {
+	return s.linkID
+}
+
+// URL returns the URL the snapshot was taken of.
+func (s *Snapshot) URL() string {
+	return s.url
+}
+
+// StorageKey returns the key of the content in blob storage.
+func (s *Snapshot) StorageKey() string {
+	return s.storageKey
+}
+
+// Size returns the size of the stored content in bytes.
+func (s *Snapshot) Size() int64 {
+	return s.size
+}
+
+// CreatedBy returns the ID of the user who requested the snapshot. It is empty if that user was deleted.
+func (s *Snapshot) CreatedBy() string {
+	return s.createdBy
+}
+
+// CreatedAt returns when the snapshot was taken.
+func (s *Snapshot) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// SetSize records the size of the content stored for the snapshot.
+func (s *Snapshot) SetSize(size int64) {
+	s.size = size
+}
+
+// Authorize checks that the user can read the snapshot, which is the case for every member of its page.
+func (s *Snapshot) Authorize(page *dpage.Page, user *duser.User) error {
+	if page == nil {
+		return dpage.ErrNoPageProvided
+	}
+	if page.ID() != s.pageID {
+		return ErrPageMismatch
+	}
+	return page.Authorize(user)
+}
+
+// newStorageKey returns a key that is unique for every snapshot and groups the snapshots of a link.
+func newStorageKey(pageID string, linkID string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("snapshots/%s/%s/%s.html", pageID, linkID, hex.EncodeToString(b))
+}
+
+// ReconstructSnapshot reconstructs a Snapshot instance from existing data.
+func ReconstructSnapshot(
+	id string,
+	pageID string,
+	linkID string,
+	url string,
+	storageKey string,
+	size int64,
+	createdBy string,
+	createdAt time.Time,
+) *Snapshot {
+	return &Snapshot{
+		id:         id,
+		pageID:     pageID,
+		linkID:     linkID,
+		url:        url,
+		storageKey: storageKey,
+		size:       size,
+		createdBy:  createdBy,
+		createdAt:  createdAt,
+	}
+}
@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	type args struct {
+		page   *dpage.Page
+		user   *duser.User
+		linkID string
+	}
+	type want struct {
+		snapshot *Snapshot
+		err      error
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+	}, duser.Users{invited})
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "member",
+			args: args{page: page, user: invited, linkID: "link-a"},
+			want: want{
+				snapshot: &Snapshot{pageID: "page-id", linkID: "link-a", url: "https://a.com", createdBy: "invited-id", createdAt: now},
+			},
+		},
+		{
+			name: "nil_page",
+			args: args{user: creator, linkID: "link-a"},
+			want: want{err: dpage.ErrNoPageProvided},
+		},
+		{
+			name: "nil_user",
+			args: args{page: page, linkID: "link-a"},
+			want: want{err: dpage.ErrNoUserProvided},
+		},
+		{
+			name: "not_member",
+			args: args{page: page, user: other, linkID: "link-a"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "link_not_on_page",
+			args: args{page: page, user: creator, linkID: "link-x"},
+			want: want{err: dpage.ErrNotFoundLinkByID("link-x")},
+		},
+	}
+
+	keyPattern := regexp.MustCompile(`^snapshots/page-id/link-a/[0-9a-f]{32}\.html$`)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewSnapshot(tt.args.page, tt.args.user, tt.args.linkID, now)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.snapshot, got, cmp.AllowUnexported(Snapshot{}), cmpopts.IgnoreFields(Snapshot{}, "storageKey")); diff != "" {
+				t.Fatalf("NewSnapshot() mismatch (-want +got):\n%s", diff)
+			}
+			if got != nil && !keyPattern.MatchString(got.StorageKey()) {
+				t.Fatalf("StorageKey() = %q, want match of %s", got.StorageKey(), keyPattern)
+			}
+		})
+	}
+}
+
+func TestSnapshot_Authorize(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", nil, nil)
+	otherPage := dpage.ReconstructPage("other-page-id", "Other", *other, "INVITE02", nil, nil)
+	snapshot := ReconstructSnapshot("snapshot-1", "page-id", "link-a", "https://a.com", "key", 10, "creator-id", time.Time{})
+
+	tests := []struct {
+		name    string
+		page    *dpage.Page
+		user    *duser.User
+		wantErr error
+	}{
+		{name: "member", page: page, user: creator},
+		{name: "not_member", page: page, user: other, wantErr: dpage.ErrNotCreatedByUser},
+		{name: "other_page", page: otherPage, user: other, wantErr: ErrPageMismatch},
+		{name: "nil_page", user: creator, wantErr: dpage.ErrNoPageProvided},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.wantErr, snapshot.Authorize(tt.page, tt.user))
+		})
+	}
+}
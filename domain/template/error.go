@@ -0,0 +1,20 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrTemplateNotAccessible = errors.New("template not accessible by the user")
+
+type InvalidVisibilityError struct {
+	visibility Visibility
+}
+
+func (e *InvalidVisibilityError) Error() string {
+	return fmt.Sprintf("invalid visibility: %s", e.visibility)
+}
+
+func ErrInvalidVisibility(visibility Visibility) *InvalidVisibilityError {
+	return &InvalidVisibilityError{visibility: visibility}
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_template/template.go -source=./repository.go -package=mocktemplate
+//
+
+// Package mocktemplate is a generated GoMock package.
+package mocktemplate
+
+import (
+	context "context"
+	reflect "reflect"
+
+	template "github.com/naka-sei/tsudzuri/domain/template"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTemplateRepository is a mock of TemplateRepository interface.
+type MockTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTemplateRepositoryMockRecorder is the mock recorder for MockTemplateRepository.
+type MockTemplateRepositoryMockRecorder struct {
+	mock *MockTemplateRepository
+}
+
+// NewMockTemplateRepository creates a new mock instance.
+func NewMockTemplateRepository(ctrl *gomock.Controller) *MockTemplateRepository {
+	mock := &MockTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateRepository) EXPECT() *MockTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTemplateRepository) Get(ctx context.Context, id string) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTemplateRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTemplateRepository)(nil).Get), ctx, id)
+}
+
+// ListAvailable mocks base method.
+func (m *MockTemplateRepository) ListAvailable(ctx context.Context, userID string) ([]*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailable", ctx, userID)
+	ret0, _ := ret[0].([]*template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailable indicates an expected call of ListAvailable.
+func (mr *MockTemplateRepositoryMockRecorder) ListAvailable(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailable", reflect.TypeOf((*MockTemplateRepository)(nil).ListAvailable), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockTemplateRepository) Save(ctx context.Context, arg1 *template.Template) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
+	ret0, _ := ret[0].(*template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTemplateRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTemplateRepository)(nil).Save), ctx, arg1)
+}
@@ -0,0 +1,12 @@
+package template
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_template/template.go -source=./repository.go -package=mocktemplate
+
+type TemplateRepository interface {
+	Get(ctx context.Context, id string) (*Template, error)
+	// ListAvailable returns the templates created by the user and the shared templates available to the user.
+	ListAvailable(ctx context.Context, userID string) ([]*Template, error)
+	Save(ctx context.Context, template *Template) (*Template, error)
+}
@@ -0,0 +1,156 @@
+package template
+
+import (
+	"slices"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// Template is a reusable skeleton of a page. It is stored apart from live pages, so it never
+// appears in page listings and cannot be joined.
+type Template struct {
+	id         string
+	title      string
+	createdBy  duser.User
+	visibility Visibility
+	links      dpage.Links
+	// sharedUsers are the members of the source page who can use a shared template.
+	sharedUsers duser.Users
+}
+
+// NewTemplate saves the page as a template owned by the user.
+// An empty title falls back to the page title. A shared template is available to the members of the page at the time it is saved.
+func NewTemplate(page *dpage.Page, user *duser.User, title string, visibility Visibility) (*Template, error) {
+	if page == nil {
+		return nil, dpage.ErrNoPageProvided
+	}
+	if user == nil {
+		return nil, dpage.ErrNoUserProvided
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	if err := visibility.isValid(); err != nil {
+		return nil, err
+	}
+
+	if title == "" {
+		title = page.Title()
+	}
+	if err := dpage.ValidateTitle(title); err != nil {
+		return nil, err
+	}
+
+	links := make(dpage.Links, 0, len(page.Links()))
+	for _, l := range page.Links() {
+		links = append(links, dpage.ReconstructLink(l.URL(), l.Memo(), l.Priority()))
+	}
+
+	var sharedUsers duser.Users
+	if visibility == VisibilityShared {
+		members := append(duser.Users{page.CreatedBy()}, page.InvitedUsers()...)
+		for _, m := range members {
+			if m == nil || m.ID() == user.ID() {
+				continue
+			}
+			sharedUsers = append(sharedUsers, m)
+		}
+	}
+
+	return &Template{
+		title:       title,
+		createdBy:   *user,
+		visibility:  visibility,
+		links:       links,
+		sharedUsers: sharedUsers,
+	}, nil
+}
+
+// ID returns the template ID.
+func (t *Template) ID() string {
+	return t.id
+}
+
+// Title returns the template title.
+func (t *Template) Title() string {
+	return t.title
+}
+
+// CreatedBy returns the user who saved the template.
+func (t *Template) CreatedBy() *duser.User {
+	return &t.createdBy
+}
+
+// Visibility returns who can use the template.
+func (t *Template) Visibility() Visibility {
+	return t.visibility
+}
+
+// Links returns the links of the template in priority order.
+func (t *Template) Links() dpage.Links {
+	return t.links
+}
+
+// SharedUsers returns the users a shared template is available to, besides its creator.
+func (t *Template) SharedUsers() duser.Users {
+	return t.sharedUsers
+}
+
+// IsOwnedBy reports whether the user saved the template.
+func (t *Template) IsOwnedBy(user *duser.User) bool {
+	return user != nil && t.createdBy.ID() == user.ID()
+}
+
+// Authorize checks that the user can use the template.
+func (t *Template) Authorize(user *duser.User) error {
+	if user == nil {
+		return dpage.ErrNoUserProvided
+	}
+	if t.IsOwnedBy(user) {
+		return nil
+	}
+	if t.visibility == VisibilityShared && slices.ContainsFunc(t.sharedUsers, func(u *duser.User) bool {
+		return u.ID() == user.ID()
+	}) {
+		return nil
+	}
+	return ErrTemplateNotAccessible
+}
+
+// NewPage creates a new page owned by the user from the template.
+// An empty title falls back to the template title.
+func (t *Template) NewPage(user *duser.User, title string) (*dpage.Page, error) {
+	if err := t.Authorize(user); err != nil {
+		return nil, err
+	}
+
+	if title == "" {
+		title = t.title
+	}
+
+	page, err := dpage.NewPage(title, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(t.links) > 0 {
+		if err := page.AddLinks(user, t.links); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// ReconstructTemplate reconstructs a Template instance from existing data.
+func ReconstructTemplate(id string, title string, createdBy duser.User, visibility Visibility, links dpage.Links, sharedUsers duser.Users) *Template {
+	return &Template{
+		id:          id,
+		title:       title,
+		createdBy:   createdBy,
+		visibility:  visibility,
+		links:       links,
+		sharedUsers: sharedUsers,
+	}
+}
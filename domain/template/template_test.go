@@ -0,0 +1,237 @@
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestNewTemplate(t *testing.T) {
+	type args struct {
+		page       *dpage.Page
+		user       *duser.User
+		title      string
+		visibility Visibility
+	}
+	type want struct {
+		template *Template
+		err      error
+	}
+
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-i", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	page := dpage.ReconstructPage("page-id", "Trip", *creator, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+	}, duser.Users{invited})
+
+	templateLinks := dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1),
+		dpage.ReconstructLink("https://b.com", "B", 2),
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "private_with_page_title",
+			args: args{page: page, user: creator, visibility: VisibilityPrivate},
+			want: want{
+				template: &Template{
+					title:      "Trip",
+					createdBy:  *creator,
+					visibility: VisibilityPrivate,
+					links:      templateLinks,
+				},
+			},
+		},
+		{
+			name: "shared_by_invited_user",
+			args: args{page: page, user: invited, title: "Trip skeleton", visibility: VisibilityShared},
+			want: want{
+				template: &Template{
+					title:       "Trip skeleton",
+					createdBy:   *invited,
+					visibility:  VisibilityShared,
+					links:       templateLinks,
+					sharedUsers: duser.Users{creator},
+				},
+			},
+		},
+		{
+			name: "title_too_long",
+			args: args{page: page, user: creator, title: strings.Repeat("旅", dpage.MaxTitleLength+1), visibility: VisibilityPrivate},
+			want: want{err: dpage.ErrTitleTooLong},
+		},
+		{
+			name: "unauthorized",
+			args: args{page: page, user: other, visibility: VisibilityPrivate},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "invalid_visibility",
+			args: args{page: page, user: creator, visibility: Visibility("public")},
+			want: want{err: ErrInvalidVisibility(Visibility("public"))},
+		},
+		{
+			name: "nil_page",
+			args: args{user: creator, visibility: VisibilityPrivate},
+			want: want{err: dpage.ErrNoPageProvided},
+		},
+		{
+			name: "nil_user",
+			args: args{page: page, visibility: VisibilityPrivate},
+			want: want{err: dpage.ErrNoUserProvided},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewTemplate(tt.args.page, tt.args.user, tt.args.title, tt.args.visibility)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.template, got, cmp.AllowUnexported(Template{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("template mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTemplate_Authorize(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := duser.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	tests := []struct {
+		name     string
+		template *Template
+		user     *duser.User
+		want     error
+	}{
+		{
+			name:     "creator_private",
+			template: ReconstructTemplate("t1", "title", *creator, VisibilityPrivate, nil, nil),
+			user:     creator,
+		},
+		{
+			name:     "member_shared",
+			template: ReconstructTemplate("t1", "title", *creator, VisibilityShared, nil, duser.Users{member}),
+			user:     member,
+		},
+		{
+			name:     "member_private",
+			template: ReconstructTemplate("t1", "title", *creator, VisibilityPrivate, nil, duser.Users{member}),
+			user:     member,
+			want:     ErrTemplateNotAccessible,
+		},
+		{
+			name:     "other_shared",
+			template: ReconstructTemplate("t1", "title", *creator, VisibilityShared, nil, duser.Users{member}),
+			user:     other,
+			want:     ErrTemplateNotAccessible,
+		},
+		{
+			name:     "nil_user",
+			template: ReconstructTemplate("t1", "title", *creator, VisibilityShared, nil, nil),
+			want:     dpage.ErrNoUserProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.want, tt.template.Authorize(tt.user))
+		})
+	}
+}
+
+func TestTemplate_NewPage(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "anonymous", nil)
+	member := duser.ReconstructUser("member-id", "uid-m", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-o", "anonymous", nil)
+
+	tmpl := ReconstructTemplate("t1", "Sprint", *creator, VisibilityShared, dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+	}, duser.Users{member})
+	empty := ReconstructTemplate("t2", "Empty", *creator, VisibilityPrivate, nil, nil)
+
+	type want struct {
+		title string
+		links []string
+		err   error
+	}
+
+	tests := []struct {
+		name     string
+		template *Template
+		user     *duser.User
+		title    string
+		want     want
+	}{
+		{
+			name:     "template_title",
+			template: tmpl,
+			user:     member,
+			want:     want{title: "Sprint", links: []string{"https://a.com", "https://b.com"}},
+		},
+		{
+			name:     "override_title",
+			template: tmpl,
+			user:     creator,
+			title:    "Sprint 42",
+			want:     want{title: "Sprint 42", links: []string{"https://a.com", "https://b.com"}},
+		},
+		{
+			name:     "without_links",
+			template: empty,
+			user:     creator,
+			want:     want{title: "Empty", links: []string{}},
+		},
+		{
+			name:     "not_accessible",
+			template: tmpl,
+			user:     other,
+			want:     want{err: ErrTemplateNotAccessible},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.template.NewPage(tt.user, tt.title)
+			testutil.EqualErr(t, tt.want.err, err)
+			if tt.want.err != nil {
+				return
+			}
+			if got.ID() != "" || got.CreatedBy().ID() != tt.user.ID() || got.InviteCode(tt.user) == "" {
+				t.Fatalf("unexpected page: id=%q creator=%q", got.ID(), got.CreatedBy().ID())
+			}
+			if got.Title() != tt.want.title {
+				t.Fatalf("title = %q, want %q", got.Title(), tt.want.title)
+			}
+			urls := make([]string, 0, len(got.Links()))
+			for _, l := range got.Links() {
+				if l.ID() != "" {
+					t.Fatalf("link should be new, got id %q", l.ID())
+				}
+				urls = append(urls, l.URL())
+			}
+			if diff := cmp.Diff(tt.want.links, urls); diff != "" {
+				t.Fatalf("links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
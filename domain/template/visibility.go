@@ -0,0 +1,25 @@
+package template
+
+import "slices"
+
+type Visibility string
+
+const (
+	// VisibilityPrivate templates can only be used by their creator.
+	VisibilityPrivate Visibility = "private"
+	// VisibilityShared templates can also be used by the members of the page they were saved from.
+	VisibilityShared Visibility = "shared"
+)
+
+var validVisibilities = []Visibility{
+	VisibilityPrivate,
+	VisibilityShared,
+}
+
+// isValid checks if the given visibility is valid.
+func (v Visibility) isValid() error {
+	if !slices.Contains(validVisibilities, v) {
+		return ErrInvalidVisibility(v)
+	}
+	return nil
+}
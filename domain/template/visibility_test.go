@@ -0,0 +1,28 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestVisibility_isValid(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Visibility
+		want error
+	}{
+		{name: "private", v: VisibilityPrivate, want: nil},
+		{name: "shared", v: VisibilityShared, want: nil},
+		{name: "invalid", v: Visibility("public"), want: ErrInvalidVisibility(Visibility("public"))},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.v.isValid()
+			testutil.EqualErr(t, tt.want, err)
+		})
+	}
+}
@@ -5,6 +5,10 @@ import "fmt"
 var (
 	ErrNoSpecifiedEmail = fmt.Errorf("no specified email")
 	ErrUserNotFound     = fmt.Errorf("user not found")
+
+	ErrDisplayNameTooLong = fmt.Errorf("display name too long")
+	ErrInvalidAvatarURL   = fmt.Errorf("invalid avatar url")
+	ErrInvalidLocale      = fmt.Errorf("invalid locale")
 )
 
 type InvalidProviderError struct {
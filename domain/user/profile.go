@@ -0,0 +1,88 @@
+package user
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxDisplayNameLength is the maximum number of characters in a display name.
+	MaxDisplayNameLength = 50
+	// MaxAvatarURLLength is the maximum number of bytes in an avatar URL.
+	MaxAvatarURLLength = 2048
+)
+
+// localePattern accepts BCP 47 style tags such as "ja", "en-US" or "zh-Hant-TW".
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8}){0,3}$`)
+
+// Profile is the public information of a user shown to the other members of a page.
+// Contact details such as the email are not part of the profile.
+type Profile struct {
+	displayName string
+	avatarURL   string
+	locale      string
+}
+
+// DisplayName returns the name shown to other users, or an empty string.
+func (p Profile) DisplayName() string { return p.displayName }
+
+// AvatarURL returns the URL of the avatar image, or an empty string.
+func (p Profile) AvatarURL() string { return p.avatarURL }
+
+// Locale returns the preferred locale as a BCP 47 tag, or an empty string.
+func (p Profile) Locale() string { return p.locale }
+
+// NewProfile creates a validated Profile. Empty values are allowed and mean the field is not set.
+func NewProfile(displayName string, avatarURL string, locale string) (Profile, error) {
+	p := ReconstructProfile(strings.TrimSpace(displayName), strings.TrimSpace(avatarURL), strings.TrimSpace(locale))
+	if err := validateDisplayName(p.displayName); err != nil {
+		return Profile{}, err
+	}
+	if err := validateAvatarURL(p.avatarURL); err != nil {
+		return Profile{}, err
+	}
+	if err := validateLocale(p.locale); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
+
+// ReconstructProfile reconstructs a Profile from stored or otherwise trusted data without validation.
+func ReconstructProfile(displayName string, avatarURL string, locale string) Profile {
+	return Profile{
+		displayName: displayName,
+		avatarURL:   avatarURL,
+		locale:      locale,
+	}
+}
+
+func validateDisplayName(name string) error {
+	if utf8.RuneCountInString(name) > MaxDisplayNameLength {
+		return ErrDisplayNameTooLong
+	}
+	return nil
+}
+
+// validateAvatarURL validates that the URL is empty or an absolute http(s) URL.
+func validateAvatarURL(rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	if len(rawURL) > MaxAvatarURLLength {
+		return ErrInvalidAvatarURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return ErrInvalidAvatarURL
+	}
+	return nil
+}
+
+func validateLocale(locale string) error {
+	if locale != "" && !localePattern.MatchString(locale) {
+		return ErrInvalidLocale
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package user
 
+import "strings"
+
 type User struct {
 	id            string
 	uid           string
 	provider      Provider
 	email         *string
+	profile       Profile
 	joinedPageIDs []string
 }
 
@@ -36,6 +39,42 @@ func (u *User) Email() *string {
 	return u.email
 }
 
+// Profile returns the user's public profile.
+func (u *User) Profile() Profile {
+	return u.profile
+}
+
+// UpdateProfile replaces the user's profile with the validated values. Empty values clear the fields.
+func (u *User) UpdateProfile(displayName string, avatarURL string, locale string) error {
+	profile, err := NewProfile(displayName, avatarURL, locale)
+	if err != nil {
+		return err
+	}
+	u.profile = profile
+	return nil
+}
+
+// SeedProfile fills the fields of the profile that are not set yet with the claimed values,
+// typically taken from the identity provider. Invalid claimed values are ignored so a bad claim never blocks a login,
+// and fields the user already set are never overwritten.
+func (u *User) SeedProfile(claimed Profile) {
+	if u.profile.displayName == "" {
+		if name := strings.TrimSpace(claimed.displayName); validateDisplayName(name) == nil {
+			u.profile.displayName = name
+		}
+	}
+	if u.profile.avatarURL == "" {
+		if avatarURL := strings.TrimSpace(claimed.avatarURL); validateAvatarURL(avatarURL) == nil {
+			u.profile.avatarURL = avatarURL
+		}
+	}
+	if u.profile.locale == "" {
+		if locale := strings.TrimSpace(claimed.locale); validateLocale(locale) == nil {
+			u.profile.locale = locale
+		}
+	}
+}
+
 // JoinedPageIDs returns the identifiers of pages the user has joined.
 func (u *User) JoinedPageIDs() []string {
 	ids := make([]string, len(u.joinedPageIDs))
@@ -82,6 +121,13 @@ func ReconstructUser(id string, uid string, provider string, email *string, opti
 
 type ReconstructOption func(*User)
 
+// WithProfile sets the user's profile.
+func WithProfile(profile Profile) ReconstructOption {
+	return func(u *User) {
+		u.profile = profile
+	}
+}
+
 func WithJoinedPageIDs(pageIDs []string) ReconstructOption {
 	return func(u *User) {
 		if len(pageIDs) == 0 {
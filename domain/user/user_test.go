@@ -1,6 +1,7 @@
 package user
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -63,7 +64,7 @@ func TestUser_Login(t *testing.T) {
 			err := u.Login(tt.args.provider, tt.args.email)
 			testutil.EqualErr(t, tt.want.err, err)
 
-			if diff := cmp.Diff(tt.want.user, u, cmp.AllowUnexported(User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.user, u, cmp.AllowUnexported(User{}, Profile{})); diff != "" {
 				t.Fatalf("user mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -73,7 +74,7 @@ func TestUser_Login(t *testing.T) {
 func TestUser_NewUser(t *testing.T) {
 	u := NewUser("uid123")
 	want := &User{uid: "uid123", provider: ProviderAnonymous}
-	if diff := cmp.Diff(want, u, cmp.AllowUnexported(User{})); diff != "" {
+	if diff := cmp.Diff(want, u, cmp.AllowUnexported(User{}, Profile{})); diff != "" {
 		t.Fatalf("NewUser mismatch (-want +got):\n%s", diff)
 	}
 }
@@ -117,7 +118,7 @@ func TestUser_ReconstructUser(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			u := ReconstructUser(tc.args.id, tc.args.uid, tc.args.provider, tc.args.email, tc.args.options...)
-			if diff := cmp.Diff(tc.want.user, u, cmp.AllowUnexported(User{})); diff != "" {
+			if diff := cmp.Diff(tc.want.user, u, cmp.AllowUnexported(User{}, Profile{})); diff != "" {
 				t.Fatalf("ReconstructUser mismatch (-want +got):\n%s", diff)
 			}
 		})
@@ -192,3 +193,104 @@ func TestUser_JoinedPageIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_UpdateProfile(t *testing.T) {
+	type args struct {
+		displayName string
+		avatarURL   string
+		locale      string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want Profile
+		err  error
+	}{
+		{
+			name: "success",
+			args: args{displayName: "  Naka  ", avatarURL: "https://example.com/a.png", locale: "ja-JP"},
+			want: Profile{displayName: "Naka", avatarURL: "https://example.com/a.png", locale: "ja-JP"},
+		},
+		{
+			name: "clear",
+			args: args{},
+			want: Profile{},
+		},
+		{
+			name: "display_name_too_long",
+			args: args{displayName: strings.Repeat("あ", MaxDisplayNameLength+1)},
+			want: Profile{displayName: "old"},
+			err:  ErrDisplayNameTooLong,
+		},
+		{
+			name: "invalid_avatar_scheme",
+			args: args{avatarURL: "javascript:alert(1)"},
+			want: Profile{displayName: "old"},
+			err:  ErrInvalidAvatarURL,
+		},
+		{
+			name: "relative_avatar",
+			args: args{avatarURL: "/a.png"},
+			want: Profile{displayName: "old"},
+			err:  ErrInvalidAvatarURL,
+		},
+		{
+			name: "invalid_locale",
+			args: args{locale: "japanese!"},
+			want: Profile{displayName: "old"},
+			err:  ErrInvalidLocale,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			u := ReconstructUser("1", "u1", "google", nil, WithProfile(ReconstructProfile("old", "", "")))
+			err := u.UpdateProfile(tt.args.displayName, tt.args.avatarURL, tt.args.locale)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, u.Profile(), cmp.AllowUnexported(Profile{})); diff != "" {
+				t.Fatalf("profile mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUser_SeedProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		current Profile
+		claimed Profile
+		want    Profile
+	}{
+		{
+			name:    "empty_profile",
+			claimed: ReconstructProfile("Naka", "https://example.com/a.png", "ja"),
+			want:    Profile{displayName: "Naka", avatarURL: "https://example.com/a.png", locale: "ja"},
+		},
+		{
+			name:    "keeps_set_fields",
+			current: ReconstructProfile("Mine", "", ""),
+			claimed: ReconstructProfile("Naka", "https://example.com/a.png", "ja"),
+			want:    Profile{displayName: "Mine", avatarURL: "https://example.com/a.png", locale: "ja"},
+		},
+		{
+			name:    "ignores_invalid_claims",
+			claimed: ReconstructProfile("Naka", "ftp://example.com/a.png", "??"),
+			want:    Profile{displayName: "Naka"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			u := ReconstructUser("1", "u1", "google", nil, WithProfile(tt.current))
+			u.SeedProfile(tt.claimed)
+			if diff := cmp.Diff(tt.want, u.Profile(), cmp.AllowUnexported(Profile{})); diff != "" {
+				t.Fatalf("profile mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
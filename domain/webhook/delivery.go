@@ -0,0 +1,111 @@
+package webhook
+
+import "time"
+
+const (
+	// MaxDeliveries is the number of recent deliveries kept per webhook.
+	MaxDeliveries = 50
+	// MaxDeliveryErrorLength is the maximum number of bytes of an error message kept in a delivery.
+	MaxDeliveryErrorLength = 1024
+)
+
+// Delivery is one attempt to post a payload to a webhook.
+type Delivery struct {
+	id         string
+	webhookID  string
+	eventID    string
+	eventType  EventType
+	attempt    int
+	statusCode int
+	errMessage string
+	duration   time.Duration
+	createdAt  time.Time
+}
+
+// NewDelivery records an attempt to post the event to the webhook. The status code is 0 when no response
+// was received, and the error describes why the attempt failed.
+func NewDelivery(
+	webhook *Webhook,
+	eventID string,
+	eventType EventType,
+	attempt int,
+	statusCode int,
+	err error,
+	duration time.Duration,
+	now time.Time,
+) *Delivery {
+	var errMessage string
+	if err != nil {
+		errMessage = err.Error()
+		if len(errMessage) > MaxDeliveryErrorLength {
+			errMessage = errMessage[:MaxDeliveryErrorLength]
+		}
+	}
+	return &Delivery{
+		webhookID:  webhook.ID(),
+		eventID:    eventID,
+		eventType:  eventType,
+		attempt:    attempt,
+		statusCode: statusCode,
+		errMessage: errMessage,
+		duration:   duration,
+		createdAt:  now,
+	}
+}
+
+// ReconstructDelivery reconstructs a Delivery from stored data.
+func ReconstructDelivery(
+	id string,
+	webhookID string,
+	eventID string,
+	eventType EventType,
+	attempt int,
+	statusCode int,
+	errMessage string,
+	duration time.Duration,
+	createdAt time.Time,
+) *Delivery {
+	return &Delivery{
+		id:         id,
+		webhookID:  webhookID,
+		eventID:    eventID,
+		eventType:  eventType,
+		attempt:    attempt,
+		statusCode: statusCode,
+		errMessage: errMessage,
+		duration:   duration,
+		createdAt:  createdAt,
+	}
+}
+
+// ID returns the ID of the delivery.
+func (d *Delivery) ID() string { return d.id }
+
+// WebhookID returns the ID of the webhook the payload was posted to.
+func (d *Delivery) WebhookID() string { return d.webhookID }
+
+// EventID returns the ID of the event. Retries of the same event share it.
+func (d *Delivery) EventID() string { return d.eventID }
+
+// EventType returns the type of the event.
+func (d *Delivery) EventType() EventType { return d.eventType }
+
+// Attempt returns the number of the attempt, starting at 1.
+func (d *Delivery) Attempt() int { return d.attempt }
+
+// StatusCode returns the HTTP status of the response, or 0 if none was received.
+func (d *Delivery) StatusCode() int { return d.statusCode }
+
+// Error returns why the attempt failed, or an empty string.
+func (d *Delivery) Error() string { return d.errMessage }
+
+// Duration returns how long the attempt took.
+func (d *Delivery) Duration() time.Duration { return d.duration }
+
+// CreatedAt returns when the attempt was made.
+func (d *Delivery) CreatedAt() time.Time { return d.createdAt }
+
+// Succeeded reports whether the receiver answered with a 2xx status.
+func (d *Delivery) Succeeded() bool {
+	return d.errMessage == "" && d.statusCode >= 200 && d.statusCode < 300
+}
@@ -0,0 +1,12 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrNoWebhookProvided   = errors.New("no webhook provided")
+	ErrNoEventProvided     = errors.New("no webhook event provided")
+	ErrInvalidURL          = errors.New("invalid webhook url")
+	ErrInvalidEventType    = errors.New("invalid webhook event type")
+	ErrWebhookPageMismatch = errors.New("webhook does not belong to the page")
+	ErrTooManyWebhooks     = errors.New("too many webhooks on the page")
+)
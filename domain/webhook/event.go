@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"slices"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// EventType is the kind of page activity a webhook is called for.
+type EventType string
+
+const (
+	// EventLinkAdded reports links added to the page.
+	EventLinkAdded EventType = "link.added"
+	// EventLinkRemoved reports links removed from the page.
+	EventLinkRemoved EventType = "link.removed"
+	// EventLinkEdited reports links whose memo or position changed.
+	EventLinkEdited EventType = "link.edited"
+	// EventMemberJoined reports a user who joined the page.
+	EventMemberJoined EventType = "member.joined"
+	// EventPageDeleted reports that the creator deleted the page. The webhooks of the page are deleted with it,
+	// so this is the last event they receive.
+	EventPageDeleted EventType = "page.deleted"
+)
+
+// EventTypes returns every event type in a fixed order.
+func EventTypes() []EventType {
+	return []EventType{EventLinkAdded, EventLinkRemoved, EventLinkEdited, EventMemberJoined, EventPageDeleted}
+}
+
+// Valid reports whether the event type is known.
+func (t EventType) Valid() bool {
+	return slices.Contains(EventTypes(), t)
+}
+
+// Event is activity on a page that is sent to the webhooks of the page.
+type Event struct {
+	Type  EventType
+	Page  *dpage.Page
+	Actor *duser.User
+	// Links are the links the event is about. They are empty for member and page events.
+	Links dpage.Links
+}
+
+// Validate checks that the event can be sent.
+func (e *Event) Validate() error {
+	if e == nil || e.Page == nil || e.Actor == nil {
+		return ErrNoEventProvided
+	}
+	if !e.Type.Valid() {
+		return ErrInvalidEventType
+	}
+	return nil
+}
+
+// RemovedLinks returns the links of before that are not in after, in the order of before.
+// Links are matched by URL, which is unique within a page.
+func RemovedLinks(before dpage.Links, after dpage.Links) dpage.Links {
+	var removed dpage.Links
+	for _, b := range before {
+		if !slices.ContainsFunc(after, func(a dpage.Link) bool { return a.URL() == b.URL() }) {
+			removed = append(removed, b)
+		}
+	}
+	return removed
+}
+
+// EditedLinks returns the links of after whose memo or position differs from the same link in before,
+// in the order of after. Links are matched by URL, which is unique within a page.
+func EditedLinks(before dpage.Links, after dpage.Links) dpage.Links {
+	var edited dpage.Links
+	for _, a := range after {
+		idx := slices.IndexFunc(before, func(b dpage.Link) bool { return b.URL() == a.URL() })
+		if idx == -1 {
+			continue
+		}
+		if b := before[idx]; b.Memo() != a.Memo() || b.Priority() != a.Priority() {
+			edited = append(edited, a)
+		}
+	}
+	return edited
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestEvent_Validate(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	page := dpage.ReconstructPage("page-1", "title", *creator, "code", nil, nil)
+
+	tests := []struct {
+		name  string
+		event *Event
+		err   error
+	}{
+		{name: "success", event: &Event{Type: EventMemberJoined, Page: page, Actor: creator}},
+		{name: "nil_event", err: ErrNoEventProvided},
+		{name: "nil_page", event: &Event{Type: EventMemberJoined, Actor: creator}, err: ErrNoEventProvided},
+		{name: "nil_actor", event: &Event{Type: EventMemberJoined, Page: page}, err: ErrNoEventProvided},
+		{name: "invalid_type", event: &Event{Type: "page.renamed", Page: page, Actor: creator}, err: ErrInvalidEventType},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.EqualErr(t, tt.err, tt.event.Validate())
+		})
+	}
+}
+
+func TestEditedLinks(t *testing.T) {
+	before := dpage.Links{
+		dpage.ReconstructLink("https://a.example.com", "a", 1),
+		dpage.ReconstructLink("https://b.example.com", "b", 2),
+		dpage.ReconstructLink("https://c.example.com", "c", 3),
+	}
+	after := dpage.Links{
+		dpage.ReconstructLink("https://b.example.com", "b", 1),
+		dpage.ReconstructLink("https://a.example.com", "a", 2),
+		dpage.ReconstructLink("https://c.example.com", "changed", 3),
+		dpage.ReconstructLink("https://d.example.com", "new", 4),
+	}
+	want := dpage.Links{after[0], after[1], after[2]}
+
+	got := EditedLinks(before, after)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+		t.Fatalf("EditedLinks mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRemovedLinks(t *testing.T) {
+	before := dpage.Links{
+		dpage.ReconstructLink("https://a.example.com", "a", 1),
+		dpage.ReconstructLink("https://b.example.com", "b", 2),
+		dpage.ReconstructLink("https://c.example.com", "c", 3),
+	}
+	after := dpage.Links{
+		dpage.ReconstructLink("https://b.example.com", "b", 1),
+	}
+	want := dpage.Links{before[0], before[2]}
+
+	got := RemovedLinks(before, after)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+		t.Fatalf("RemovedLinks mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_webhook/webhook.go -source=./repository.go -package=mockwebhook
+//
+
+// Package mockwebhook is a generated GoMock package.
+package mockwebhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockWebhookRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWebhookRepository) Get(ctx context.Context, id string) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookRepository)(nil).Get), ctx, id)
+}
+
+// ListByPageID mocks base method.
+func (m *MockWebhookRepository) ListByPageID(ctx context.Context, pageID string) ([]*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPageID", ctx, pageID)
+	ret0, _ := ret[0].([]*webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPageID indicates an expected call of ListByPageID.
+func (mr *MockWebhookRepositoryMockRecorder) ListByPageID(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPageID", reflect.TypeOf((*MockWebhookRepository)(nil).ListByPageID), ctx, pageID)
+}
+
+// Save mocks base method.
+func (m *MockWebhookRepository) Save(ctx context.Context, arg1 *webhook.Webhook) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, arg1)
+	ret0, _ := ret[0].(*webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockWebhookRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookRepository)(nil).Save), ctx, arg1)
+}
+
+// MockDeliveryRepository is a mock of DeliveryRepository interface.
+type MockDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDeliveryRepositoryMockRecorder is the mock recorder for MockDeliveryRepository.
+type MockDeliveryRepositoryMockRecorder struct {
+	mock *MockDeliveryRepository
+}
+
+// NewMockDeliveryRepository creates a new mock instance.
+func NewMockDeliveryRepository(ctrl *gomock.Controller) *MockDeliveryRepository {
+	mock := &MockDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryRepository) EXPECT() *MockDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockDeliveryRepository) Add(ctx context.Context, delivery *webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockDeliveryRepositoryMockRecorder) Add(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockDeliveryRepository)(nil).Add), ctx, delivery)
+}
+
+// ListByWebhookID mocks base method.
+func (m *MockDeliveryRepository) ListByWebhookID(ctx context.Context, webhookID string, limit int) ([]*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWebhookID", ctx, webhookID, limit)
+	ret0, _ := ret[0].([]*webhook.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWebhookID indicates an expected call of ListByWebhookID.
+func (mr *MockDeliveryRepositoryMockRecorder) ListByWebhookID(ctx, webhookID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWebhookID", reflect.TypeOf((*MockDeliveryRepository)(nil).ListByWebhookID), ctx, webhookID, limit)
+}
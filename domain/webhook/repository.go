@@ -0,0 +1,23 @@
+package webhook
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_webhook/webhook.go -source=./repository.go -package=mockwebhook
+
+type WebhookRepository interface {
+	// Get returns the webhook with the ID, or nil if there is none.
+	Get(ctx context.Context, id string) (*Webhook, error)
+	// ListByPageID returns the webhooks of the page, oldest first.
+	ListByPageID(ctx context.Context, pageID string) ([]*Webhook, error)
+	Save(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	// Delete removes the webhook and its deliveries. Unknown IDs are ignored.
+	Delete(ctx context.Context, id string) error
+}
+
+// DeliveryRepository stores the recent delivery attempts of webhooks.
+type DeliveryRepository interface {
+	// Add stores the delivery and drops the oldest deliveries of its webhook beyond MaxDeliveries.
+	Add(ctx context.Context, delivery *Delivery) error
+	// ListByWebhookID returns the deliveries of the webhook, newest first, at most limit of them.
+	ListByWebhookID(ctx context.Context, webhookID string, limit int) ([]*Delivery, error)
+}
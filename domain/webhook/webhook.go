@@ -0,0 +1,265 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+const (
+	// MaxURLLength is the maximum number of bytes in a webhook URL.
+	MaxURLLength = 2048
+	// MaxWebhooksPerPage is the maximum number of webhooks a page can have.
+	MaxWebhooksPerPage = 10
+	// MaxConsecutiveFailures is the number of deliveries in a row that can fail before the webhook is disabled.
+	MaxConsecutiveFailures = 5
+	// SignaturePrefix prefixes the hex encoded signature of a payload.
+	SignaturePrefix = "sha256="
+)
+
+// Webhook calls a URL with a signed JSON payload when something happens on a page.
+// Only the creator of the page can manage its webhooks. A webhook whose deliveries keep failing is disabled
+// until the creator updates it.
+type Webhook struct {
+	id           string
+	pageID       string
+	url          string
+	secret       string
+	events       []EventType
+	failureCount int
+	disabledAt   *time.Time
+	createdByID  string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewWebhook creates a webhook of the page that is called with the events. Empty events subscribe to all of them.
+func NewWebhook(page *dpage.Page, user *duser.User, rawURL string, events []EventType, now time.Time) (*Webhook, error) {
+	if err := dinvitation.AuthorizeManage(page, user); err != nil {
+		return nil, err
+	}
+	normalizedURL, err := normalizeURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	normalizedEvents, err := normalizeEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := secretGenerator()
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	return &Webhook{
+		pageID:      page.ID(),
+		url:         normalizedURL,
+		secret:      secret,
+		events:      normalizedEvents,
+		createdByID: user.ID(),
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ID returns the ID of the webhook.
+func (w *Webhook) ID() string { return w.id }
+
+// PageID returns the ID of the page the webhook belongs to.
+func (w *Webhook) PageID() string { return w.pageID }
+
+// URL returns the URL the payloads are posted to.
+func (w *Webhook) URL() string { return w.url }
+
+// Secret returns the key the payloads are signed with.
+func (w *Webhook) Secret() string { return w.secret }
+
+// Events returns the event types the webhook is called for. Empty events mean all of them.
+func (w *Webhook) Events() []EventType { return w.events }
+
+// FailureCount returns the number of deliveries that failed in a row.
+func (w *Webhook) FailureCount() int { return w.failureCount }
+
+// DisabledAt returns when the webhook was disabled, or nil if it is active.
+func (w *Webhook) DisabledAt() *time.Time { return w.disabledAt }
+
+// Active reports whether the webhook is called.
+func (w *Webhook) Active() bool { return w.disabledAt == nil }
+
+// CreatedByID returns the ID of the user who created the webhook.
+func (w *Webhook) CreatedByID() string { return w.createdByID }
+
+// CreatedAt returns when the webhook was created.
+func (w *Webhook) CreatedAt() time.Time { return w.createdAt }
+
+// UpdatedAt returns when the webhook last changed.
+func (w *Webhook) UpdatedAt() time.Time { return w.updatedAt }
+
+// Subscribes reports whether the webhook is called for the event type.
+func (w *Webhook) Subscribes(eventType EventType) bool {
+	return len(w.events) == 0 || slices.Contains(w.events, eventType)
+}
+
+// Update replaces the URL and the event types of the webhook and enables or disables it.
+// Enabling clears the failures so that a disabled webhook gets a fresh start.
+func (w *Webhook) Update(page *dpage.Page, user *duser.User, rawURL string, events []EventType, active bool, now time.Time) error {
+	if err := w.Authorize(page, user); err != nil {
+		return err
+	}
+	normalizedURL, err := normalizeURL(rawURL)
+	if err != nil {
+		return err
+	}
+	normalizedEvents, err := normalizeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	w.url = normalizedURL
+	w.events = normalizedEvents
+	switch {
+	case active:
+		w.failureCount = 0
+		w.disabledAt = nil
+	case w.disabledAt == nil:
+		w.disabledAt = &now
+	}
+	w.updatedAt = now
+	return nil
+}
+
+// Authorize checks that the user can manage the webhook as the creator of its page.
+func (w *Webhook) Authorize(page *dpage.Page, user *duser.User) error {
+	if err := dinvitation.AuthorizeManage(page, user); err != nil {
+		return err
+	}
+	if page.ID() != w.pageID {
+		return ErrWebhookPageMismatch
+	}
+	return nil
+}
+
+// RecordDelivery records the outcome of a delivery. A success clears the failures; after
+// MaxConsecutiveFailures failures in a row the webhook is disabled.
+func (w *Webhook) RecordDelivery(succeeded bool, now time.Time) {
+	if succeeded {
+		w.failureCount = 0
+		return
+	}
+	w.failureCount++
+	if w.failureCount >= MaxConsecutiveFailures && w.disabledAt == nil {
+		w.disabledAt = &now
+		w.updatedAt = now
+	}
+}
+
+// Sign returns the signature of a payload sent at the timestamp, the hex encoded HMAC-SHA256 of
+// "<unix seconds>.<body>" keyed with the secret and prefixed with SignaturePrefix.
+// Receivers recompute it to check that the payload came from the service and was not replayed later.
+func (w *Webhook) Sign(timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// normalizeURL validates an absolute http or https URL.
+func normalizeURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" || len(rawURL) > MaxURLLength {
+		return "", ErrInvalidURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return "", ErrInvalidURL
+	}
+	return u.String(), nil
+}
+
+// normalizeEvents validates the event types and returns them without duplicates in the order of EventTypes.
+func normalizeEvents(events []EventType) ([]EventType, error) {
+	for _, e := range events {
+		if !e.Valid() {
+			return nil, ErrInvalidEventType
+		}
+	}
+	normalized := make([]EventType, 0, len(events))
+	for _, e := range EventTypes() {
+		if slices.Contains(events, e) {
+			normalized = append(normalized, e)
+		}
+	}
+	return normalized, nil
+}
+
+// ReconstructWebhook reconstructs a Webhook from stored data.
+func ReconstructWebhook(
+	id string,
+	pageID string,
+	url string,
+	secret string,
+	events []EventType,
+	createdByID string,
+	options ...ReconstructOption,
+) *Webhook {
+	w := &Webhook{
+		id:          id,
+		pageID:      pageID,
+		url:         url,
+		secret:      secret,
+		events:      events,
+		createdByID: createdByID,
+	}
+	for _, opt := range options {
+		opt(w)
+	}
+	return w
+}
+
+// ReconstructOption sets optional fields when reconstructing a Webhook.
+type ReconstructOption func(*Webhook)
+
+// WithFailures sets the number of failed deliveries in a row and when the webhook was disabled.
+func WithFailures(failureCount int, disabledAt *time.Time) ReconstructOption {
+	return func(w *Webhook) {
+		w.failureCount = failureCount
+		w.disabledAt = disabledAt
+	}
+}
+
+// WithTimestamps sets when the webhook was created and last updated.
+func WithTimestamps(createdAt time.Time, updatedAt time.Time) ReconstructOption {
+	return func(w *Webhook) {
+		w.createdAt = createdAt
+		w.updatedAt = updatedAt
+	}
+}
+
+var secretGenerator = defaultSecretGenerator
+
+const (
+	secretPrefix = "whsec_"
+	secretBytes  = 32
+)
+
+func defaultSecretGenerator() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,20 @@
+package firebase
+
+import (
+	"firebase.google.com/go/v4/auth"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+// ProfileClaims returns the profile found in the standard "name", "picture" and "locale" claims of the token.
+// Missing or non-string claims are left empty. The values are not validated.
+func ProfileClaims(token *auth.Token) duser.Profile {
+	if token == nil {
+		return duser.Profile{}
+	}
+	claim := func(key string) string {
+		v, _ := token.Claims[key].(string)
+		return v
+	}
+	return duser.ReconstructProfile(claim("name"), claim("picture"), claim("locale"))
+}
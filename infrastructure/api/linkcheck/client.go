@@ -0,0 +1,77 @@
+package linkcheck
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// requestTimeout bounds a single check so that a slow host cannot hold up the others.
+	requestTimeout = 15 * time.Second
+	// maxResponseBytes is how much of a response body is read before the connection is released.
+	maxResponseBytes = 64 << 10
+	// userAgent identifies the checker to the hosts of the links.
+	userAgent = "tsudzuri-link-checker/1.0 (+https://github.com/naka-sei/tsudzuri)"
+)
+
+// Client checks links over HTTP. A link is requested with HEAD first and with GET when the host does not
+// support HEAD. Redirects are not followed, so that a moved link is reported with its new location.
+type Client struct {
+	client *http.Client
+}
+
+// NewClient creates a Client with the default timeout. It only connects to public addresses, so that links cannot
+// be used to probe services inside our own network. A refused link fails like an unreachable one.
+func NewClient() service.LinkChecker {
+	return NewClientWithHTTPClient(&http.Client{Timeout: requestTimeout, Transport: publicnet.NewTransport()})
+}
+
+// NewClientWithHTTPClient creates a Client that sends requests through the given http.Client. Its redirect policy
+// is replaced so that redirects are returned as responses.
+func NewClientWithHTTPClient(client *http.Client) *Client {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	return &Client{client: &c}
+}
+
+// Check requests the URL and returns the status of the response and, for a redirect, its absolute target.
+func (c *Client) Check(ctx context.Context, url string) (service.LinkCheckResult, error) {
+	resp, err := c.do(ctx, http.MethodHead, url)
+	if err != nil {
+		return service.LinkCheckResult{}, err
+	}
+	if resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented {
+		resp, err = c.do(ctx, http.MethodGet, url)
+		if err != nil {
+			return service.LinkCheckResult{}, err
+		}
+	}
+
+	result := service.LinkCheckResult{StatusCode: resp.StatusCode}
+	if location, err := resp.Location(); err == nil {
+		result.Location = location.String()
+	}
+	return result, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestClient_Check(t *testing.T) {
+	var methods []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Header.Get("User-Agent") != userAgent {
+			t.Errorf("User-Agent = %q, want %q", r.Header.Get("User-Agent"), userAgent)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		http.Error(w, "gone", http.StatusGone)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		http.Redirect(w, r, "/ok?from=moved", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = w.Write([]byte("<html></html>"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client())
+
+	tests := []struct {
+		name        string
+		path        string
+		want        service.LinkCheckResult
+		wantMethods []string
+	}{
+		{
+			name:        "ok",
+			path:        "/ok",
+			want:        service.LinkCheckResult{StatusCode: http.StatusOK},
+			wantMethods: []string{http.MethodHead},
+		},
+		{
+			name:        "broken",
+			path:        "/gone",
+			want:        service.LinkCheckResult{StatusCode: http.StatusGone},
+			wantMethods: []string{http.MethodHead},
+		},
+		{
+			name:        "redirect_not_followed",
+			path:        "/moved",
+			want:        service.LinkCheckResult{StatusCode: http.StatusMovedPermanently, Location: server.URL + "/ok?from=moved"},
+			wantMethods: []string{http.MethodHead},
+		},
+		{
+			name:        "falls_back_to_get",
+			path:        "/get-only",
+			want:        service.LinkCheckResult{StatusCode: http.StatusOK},
+			wantMethods: []string{http.MethodHead, http.MethodGet},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			methods = nil
+			got, err := client.Check(context.Background(), server.URL+tt.path)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Check() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantMethods, methods); diff != "" {
+				t.Errorf("methods mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_Check_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := NewClientWithHTTPClient(http.DefaultClient)
+	if _, err := client.Check(context.Background(), url); err == nil {
+		t.Fatal("Check() error = nil, want error for a closed server")
+	}
+}
+
+func TestNewClient_refusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	t.Cleanup(server.Close)
+
+	// The refusal is an error rather than a status, so the link is recorded as unreachable.
+	got, err := NewClient().Check(context.Background(), server.URL)
+	if !errors.Is(err, publicnet.ErrNotPublicAddress) {
+		t.Fatalf("Check() error = %v, want refusal of a private address", err)
+	}
+	if got.StatusCode != 0 {
+		t.Fatalf("Check() status = %d, want none", got.StatusCode)
+	}
+}
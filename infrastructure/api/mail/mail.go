@@ -0,0 +1,15 @@
+package mail
+
+import (
+	"github.com/naka-sei/tsudzuri/config"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// NewMailer creates the mailer for the configuration. Mails go out over SMTP when an SMTP host is configured;
+// otherwise they are kept in memory and logged, which is meant for local development.
+func NewMailer(conf *config.Config) service.MailService {
+	if conf == nil || conf.SMTPHost == "" {
+		return NewMemoryMailer()
+	}
+	return NewSMTPMailer(conf.SMTPHost, conf.SMTPPort, conf.SMTPUsername, conf.SMTPPassword, conf.MailFrom)
+}
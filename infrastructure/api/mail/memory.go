@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// MemoryMailer keeps sent mails in memory instead of delivering them. It is safe for concurrent use.
+type MemoryMailer struct {
+	mu   sync.Mutex
+	sent []service.Mail
+}
+
+// NewMemoryMailer creates an empty MemoryMailer.
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+// Send records the mail and logs it so the links in it can be followed during local development.
+func (m *MemoryMailer) Send(ctx context.Context, mail service.Mail) error {
+	if err := validateMail(mail); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.sent = append(m.sent, mail)
+	m.mu.Unlock()
+
+	log.LoggerFromContext(ctx).Sugar().Infof("Mail kept in memory: to=%s subject=%s\n%s", mail.To, mail.Subject, mail.Body)
+	return nil
+}
+
+// Sent returns the mails sent so far, oldest first.
+func (m *MemoryMailer) Sent() []service.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]service.Mail(nil), m.sent...)
+}
@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestMemoryMailer_Send(t *testing.T) {
+	m := NewMemoryMailer()
+	mails := []service.Mail{
+		{To: "a@example.com", Subject: "one", Body: "1"},
+		{To: "b@example.com", Subject: "two", Body: "2"},
+	}
+	for _, mail := range mails {
+		if err := m.Send(context.Background(), mail); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	testutil.EqualErr(t, ErrInvalidMail, m.Send(context.Background(), service.Mail{Subject: "no recipient"}))
+
+	if diff := cmp.Diff(mails, m.Sent()); diff != "" {
+		t.Fatalf("sent mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,84 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// ErrInvalidMail is returned for mails with an empty recipient or line breaks in a header.
+var ErrInvalidMail = errors.New("invalid mail")
+
+// SMTPMailer delivers mails through an SMTP server. STARTTLS is used when the server offers it.
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTPMailer creates an SMTPMailer. PLAIN authentication is used when a username is given.
+func NewSMTPMailer(host string, port uint, username string, password string, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{
+		addr: net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10)),
+		auth: auth,
+		from: from,
+	}
+}
+
+// Send delivers the mail as UTF-8 plain text.
+func (m *SMTPMailer) Send(ctx context.Context, mail service.Mail) error {
+	if err := validateMail(mail); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg := buildMessage(m.from, mail, time.Now())
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, msg); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+	return nil
+}
+
+// buildMessage renders the mail in RFC 5322 form with a base64 encoded body.
+func buildMessage(from string, mail service.Mail, date time.Time) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + mail.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", mail.Subject) + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(mail.Body))
+	for len(body) > 76 {
+		b.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	b.WriteString(body + "\r\n")
+	return b.Bytes()
+}
+
+func validateMail(mail service.Mail) error {
+	if strings.TrimSpace(mail.To) == "" || strings.ContainsAny(mail.To+mail.Subject, "\r\n") {
+		return ErrInvalidMail
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// received is what the fake SMTP server got in one session.
+type received struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer starts a minimal SMTP server that accepts one session and reports what it received.
+func startSMTPServer(t *testing.T) (host string, port uint, got <-chan received) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	ch := make(chan received, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+		var r received
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				_ = tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				r.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+				_ = tp.PrintfLine("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				r.to = append(r.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "DATA":
+				_ = tp.PrintfLine("354 Go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				r.data = string(data)
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				ch <- r
+				return
+			default:
+				_ = tp.PrintfLine("502 Not implemented")
+			}
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), uint(addr.Port), ch
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	host, port, got := startSMTPServer(t)
+	m := NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+
+	mail := service.Mail{To: "friend@example.com", Subject: "綴りへの招待", Body: "こんにちは\nhttps://example.com/accept?token=abc"}
+	if err := m.Send(context.Background(), mail); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	r := <-got
+	if r.from != "no-reply@example.com" {
+		t.Errorf("from = %q, want %q", r.from, "no-reply@example.com")
+	}
+	if diff := cmp.Diff([]string{"friend@example.com"}, r.to); diff != "" {
+		t.Errorf("recipients mismatch (-want +got):\n%s", diff)
+	}
+
+	header, body, ok := strings.Cut(r.data, "\n\n")
+	if !ok {
+		t.Fatalf("message has no body: %q", r.data)
+	}
+	for _, want := range []string{"To: friend@example.com", "Subject: =?UTF-8?b?", "Content-Type: text/plain; charset=UTF-8"} {
+		if !strings.Contains(header, want) {
+			t.Errorf("header %q does not contain %q", header, want)
+		}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\n", ""))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if string(decoded) != mail.Body {
+		t.Fatalf("body = %q, want %q", decoded, mail.Body)
+	}
+}
+
+func TestSMTPMailer_Send_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		mail service.Mail
+	}{
+		{name: "empty_to", mail: service.Mail{Subject: "subject"}},
+		{name: "header_injection", mail: service.Mail{To: "friend@example.com\r\nBcc: other@example.com", Subject: "subject"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Nothing listens on the port; an invalid mail must be rejected before connecting.
+			m := NewSMTPMailer("127.0.0.1", 1, "", "", "no-reply@example.com")
+			testutil.EqualErr(t, ErrInvalidMail, m.Send(context.Background(), tt.mail))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"firebase.google.com/go/v4/messaging"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// fcmMaxBatchSize is the number of tokens FCM accepts in one multicast message.
+const fcmMaxBatchSize = 500
+
+// FCMSender delivers pushes through Firebase Cloud Messaging.
+type FCMSender struct {
+	client *messaging.Client
+}
+
+// NewFCMSender creates an FCMSender sending through the client.
+func NewFCMSender(client *messaging.Client) *FCMSender {
+	return &FCMSender{client: client}
+}
+
+// Send sends the message to the tokens as one multicast message.
+func (s *FCMSender) Send(ctx context.Context, tokens []string, message service.PushMessage) ([]error, error) {
+	resp, err := s.client.SendEachForMulticast(ctx, &messaging.MulticastMessage{
+		Tokens: tokens,
+		Notification: &messaging.Notification{
+			Title: message.Title,
+			Body:  message.Body,
+		},
+		Data: message.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Responses) != len(tokens) {
+		return nil, fmt.Errorf("fcm returned %d responses for %d tokens", len(resp.Responses), len(tokens))
+	}
+
+	results := make([]error, len(tokens))
+	for i, r := range resp.Responses {
+		if r.Success {
+			continue
+		}
+		results[i] = toSendError(r.Error)
+	}
+	return results, nil
+}
+
+// MaxBatchSize returns the multicast limit of FCM.
+func (s *FCMSender) MaxBatchSize() int {
+	return fcmMaxBatchSize
+}
+
+// toSendError marks errors about the token itself as service.ErrInvalidPushToken. The message is always
+// well-formed, so an invalid argument points at a malformed token.
+func toSendError(err error) error {
+	if messaging.IsUnregistered(err) || messaging.IsSenderIDMismatch(err) || messaging.IsInvalidArgument(err) {
+		return fmt.Errorf("%w: %v", service.ErrInvalidPushToken, err)
+	}
+	return err
+}
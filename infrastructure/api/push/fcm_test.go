@@ -0,0 +1,78 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	firebasev4 "firebase.google.com/go/v4"
+	"google.golang.org/api/option"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestFCMSender_Send(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Message struct {
+				Token        string `json:"token"`
+				Notification struct {
+					Title string `json:"title"`
+				} `json:"notification"`
+			} `json:"message"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if req.Message.Notification.Title != "title" {
+			t.Errorf("title = %q, want %q", req.Message.Notification.Title, "title")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Message.Token {
+		case "unregistered":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND",` +
+				`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+		case "apns-rejected":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":401,"message":"Auth error from APNS or Web Push Service","status":"UNAUTHENTICATED",` +
+				`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"THIRD_PARTY_AUTH_ERROR"}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"name":"projects/test/messages/1"}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	app, err := firebasev4.NewApp(ctx, &firebasev4.Config{ProjectID: "test"},
+		option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create app: %v", err)
+	}
+	client, err := app.Messaging(ctx)
+	if err != nil {
+		t.Fatalf("failed to create messaging client: %v", err)
+	}
+
+	s := NewFCMSender(client)
+	results, err := s.Send(ctx, []string{"valid", "unregistered", "apns-rejected"}, service.PushMessage{Title: "title", Body: "body"})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("len(results) = %d, want 3", len(results))
+	}
+	if results[0] != nil {
+		t.Errorf("results[0] = %v, want nil", results[0])
+	}
+	if !errors.Is(results[1], service.ErrInvalidPushToken) {
+		t.Errorf("results[1] = %v, want ErrInvalidPushToken", results[1])
+	}
+	if results[2] == nil || errors.Is(results[2], service.ErrInvalidPushToken) {
+		t.Errorf("results[2] = %v, want an error other than ErrInvalidPushToken", results[2])
+	}
+}
@@ -0,0 +1,30 @@
+package push
+
+import (
+	"context"
+
+	firebasev4 "firebase.google.com/go/v4"
+
+	"github.com/naka-sei/tsudzuri/config"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// NewSender creates the push sender for the configuration. Pushes go out through Firebase Cloud Messaging on Google Cloud;
+// elsewhere they are recorded and logged, which is meant for local development.
+func NewSender(conf *config.Config) (service.PushSender, error) {
+	if conf == nil || !conf.OnGoogleCloud() {
+		return NewRecordingSender(), nil
+	}
+
+	ctx := context.Background()
+	app, err := firebasev4.NewApp(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := app.Messaging(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewFCMSender(client), nil
+}
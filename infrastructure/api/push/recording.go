@@ -0,0 +1,71 @@
+package push
+
+import (
+	"context"
+	"sync"
+
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// RecordedPush is a batch of pushes handed to a RecordingSender.
+type RecordedPush struct {
+	Tokens  []string
+	Message service.PushMessage
+}
+
+// RecordingSender keeps pushes in memory instead of delivering them. Tokens marked invalid are reported
+// as service.ErrInvalidPushToken, so that the handling of invalid tokens can be exercised without FCM.
+// It is safe for concurrent use.
+type RecordingSender struct {
+	mu      sync.Mutex
+	sent    []RecordedPush
+	invalid map[string]bool
+}
+
+// NewRecordingSender creates an empty RecordingSender.
+func NewRecordingSender() *RecordingSender {
+	return &RecordingSender{invalid: make(map[string]bool)}
+}
+
+// MarkInvalid makes later sends to the tokens fail with service.ErrInvalidPushToken.
+func (s *RecordingSender) MarkInvalid(tokens ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range tokens {
+		s.invalid[t] = true
+	}
+}
+
+// Send records the push for the valid tokens and logs it.
+func (s *RecordingSender) Send(ctx context.Context, tokens []string, message service.PushMessage) ([]error, error) {
+	s.mu.Lock()
+	results := make([]error, len(tokens))
+	delivered := make([]string, 0, len(tokens))
+	for i, t := range tokens {
+		if s.invalid[t] {
+			results[i] = service.ErrInvalidPushToken
+			continue
+		}
+		delivered = append(delivered, t)
+	}
+	if len(delivered) > 0 {
+		s.sent = append(s.sent, RecordedPush{Tokens: delivered, Message: message})
+	}
+	s.mu.Unlock()
+
+	log.LoggerFromContext(ctx).Sugar().Infof("Push kept in memory: devices=%d title=%s body=%s", len(delivered), message.Title, message.Body)
+	return results, nil
+}
+
+// MaxBatchSize returns the same limit as FCM.
+func (s *RecordingSender) MaxBatchSize() int {
+	return fcmMaxBatchSize
+}
+
+// Sent returns the pushes recorded so far, oldest first.
+func (s *RecordingSender) Sent() []RecordedPush {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedPush(nil), s.sent...)
+}
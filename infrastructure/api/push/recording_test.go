@@ -0,0 +1,28 @@
+package push
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestRecordingSender_Send(t *testing.T) {
+	s := NewRecordingSender()
+	s.MarkInvalid("token-2")
+	message := service.PushMessage{Title: "title", Body: "body"}
+
+	results, err := s.Send(context.Background(), []string{"token-1", "token-2", "token-3"}, message)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if diff := cmp.Diff([]error{nil, service.ErrInvalidPushToken, nil}, results, cmp.Comparer(func(a, b error) bool { return a == b })); diff != "" {
+		t.Fatalf("results mismatch (-want +got):\n%s", diff)
+	}
+
+	want := []RecordedPush{{Tokens: []string{"token-1", "token-3"}, Message: message}}
+	if diff := cmp.Diff(want, s.Sent()); diff != "" {
+		t.Fatalf("sent mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,94 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/html/charset"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// requestTimeout bounds the download of a page, including redirects.
+	requestTimeout = 30 * time.Second
+	// maxPageBytes is the largest page that is archived.
+	maxPageBytes = 5 << 20
+	// userAgent identifies the archiver to the hosts of the links.
+	userAgent = "tsudzuri-archiver/1.0 (+https://github.com/naka-sei/tsudzuri)"
+)
+
+// Fetcher downloads web pages over HTTP and removes scripts and other active content from them.
+type Fetcher struct {
+	client *http.Client
+}
+
+// NewFetcher creates a Fetcher with the default timeout. It only connects to public addresses, so that links cannot
+// be used to reach services inside our own network.
+func NewFetcher() service.SnapshotFetcher {
+	return NewFetcherWithHTTPClient(&http.Client{Timeout: requestTimeout, Transport: publicnet.NewTransport()})
+}
+
+// NewFetcherWithHTTPClient creates a Fetcher that sends requests through the given http.Client.
+func NewFetcherWithHTTPClient(client *http.Client) *Fetcher {
+	return &Fetcher{client: client}
+}
+
+// Fetch downloads the HTML page at the URL, following redirects, and returns it as UTF-8 without active content.
+// Pages that cannot be downloaded, answer with an error, are not HTML or are too large are reported as
+// service.ErrSnapshotUnavailable.
+func (f *Fetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrSnapshotUnavailable, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", service.ErrSnapshotUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%w: status %d", service.ErrSnapshotUnavailable, resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !isHTML(contentType) {
+		return nil, fmt.Errorf("%w: content type %q", service.ErrSnapshotUnavailable, contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrSnapshotUnavailable, err)
+	}
+	if len(body) > maxPageBytes {
+		return nil, fmt.Errorf("%w: page larger than %d bytes", service.ErrSnapshotUnavailable, maxPageBytes)
+	}
+
+	utf8Body, err := charset.NewReader(bytes.NewReader(body), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrSnapshotUnavailable, err)
+	}
+	// The base is the URL the page was finally served from, after redirects.
+	return sanitize(utf8Body, resp.Request.URL)
+}
+
+// isHTML reports whether the content type is an HTML page. Pages without a content type are assumed to be HTML.
+func isHTML(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
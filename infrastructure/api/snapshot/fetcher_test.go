@@ -0,0 +1,107 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestFetcher_Fetch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != userAgent {
+			t.Errorf("User-Agent = %q, want %q", r.Header.Get("User-Agent"), userAgent)
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><title>Article</title><script>alert(1)</script></head><body><p>Body</p></body></html>`))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/article", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/latin1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=iso-8859-1")
+		_, _ = w.Write([]byte("<html><body><p>caf\xe9</p></body></html>"))
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("\x89PNG"))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(strings.Repeat("a", maxPageBytes+1)))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusGone)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	fetcher := NewFetcherWithHTTPClient(server.Client())
+
+	tests := []struct {
+		name         string
+		path         string
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name:         "strips_scripts",
+			path:         "/article",
+			wantContains: []string{`<base href="` + server.URL + `/article"/>`, "<title>Article</title>", "<p>Body</p>"},
+		},
+		{
+			name:         "follows_redirects",
+			path:         "/moved",
+			wantContains: []string{`<base href="` + server.URL + `/article"/>`, "<p>Body</p>"},
+		},
+		{
+			name:         "converts_to_utf8",
+			path:         "/latin1",
+			wantContains: []string{`<meta charset="utf-8"/>`, "<p>café</p>"},
+		},
+		{name: "not_html", path: "/image", wantErr: true},
+		{name: "too_large", path: "/large", wantErr: true},
+		{name: "error_status", path: "/gone", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fetcher.Fetch(context.Background(), server.URL+tt.path)
+			if tt.wantErr {
+				if !errors.Is(err, service.ErrSnapshotUnavailable) {
+					t.Fatalf("Fetch() error = %v, want %v", err, service.ErrSnapshotUnavailable)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if strings.Contains(string(got), "<script") {
+				t.Errorf("Fetch() kept a script:\n%s", got)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("Fetch() = %s, want it to contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewFetcher_refusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewFetcher().Fetch(context.Background(), server.URL)
+	if !errors.Is(err, service.ErrSnapshotUnavailable) || !errors.Is(err, publicnet.ErrNotPublicAddress) {
+		t.Fatalf("Fetch() error = %v, want refusal of a private address", err)
+	}
+}
@@ -0,0 +1,156 @@
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// removedElements are dropped from archived pages with their content. They run code, embed other documents
+// or change where the page navigates to.
+var removedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Noscript: true,
+	atom.Iframe:   true,
+	atom.Frame:    true,
+	atom.Frameset: true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Applet:   true,
+	atom.Base:     true,
+}
+
+// urlAttributes are the attributes holding URLs that could use the javascript: scheme.
+var urlAttributes = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"action":     true,
+	"formaction": true,
+	"xlink:href": true,
+	"data":       true,
+	"poster":     true,
+}
+
+// sanitize parses the page and renders it again without scripts, event handlers, javascript: URLs, embedded
+// documents and refresh redirects. A base element pointing at the original URL is added, so that relative links,
+// images and style sheets still resolve.
+func sanitize(r io.Reader, base *url.URL) ([]byte, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	clean(doc)
+	if head := findHead(doc); head != nil {
+		head.InsertBefore(&html.Node{
+			Type:     html.ElementNode,
+			Data:     "base",
+			DataAtom: atom.Base,
+			Attr:     []html.Attribute{{Key: "href", Val: base.String()}},
+		}, head.FirstChild)
+		head.InsertBefore(&html.Node{
+			Type:     html.ElementNode,
+			Data:     "meta",
+			DataAtom: atom.Meta,
+			Attr:     []html.Attribute{{Key: "charset", Val: "utf-8"}},
+		}, head.FirstChild)
+	}
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func clean(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == html.ElementNode && isRemoved(c) {
+			n.RemoveChild(c)
+		} else {
+			if c.Type == html.ElementNode {
+				c.Attr = cleanAttributes(c.Attr)
+			}
+			clean(c)
+		}
+		c = next
+	}
+}
+
+func isRemoved(n *html.Node) bool {
+	if removedElements[n.DataAtom] {
+		return true
+	}
+	// Charsets are dropped because the page is stored as UTF-8, refreshes because they navigate away.
+	if n.DataAtom == atom.Meta {
+		for _, a := range n.Attr {
+			switch strings.ToLower(a.Key) {
+			case "charset":
+				return true
+			case "http-equiv":
+				v := strings.ToLower(a.Val)
+				return v == "refresh" || v == "content-type" || v == "set-cookie"
+			}
+		}
+	}
+	// Imported documents and preloaded scripts.
+	if n.DataAtom == atom.Link {
+		for _, a := range n.Attr {
+			if strings.ToLower(a.Key) == "rel" {
+				for _, rel := range strings.Fields(strings.ToLower(a.Val)) {
+					if rel == "import" || rel == "modulepreload" || rel == "serviceworker" {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+func cleanAttributes(attrs []html.Attribute) []html.Attribute {
+	kept := attrs[:0]
+	for _, a := range attrs {
+		key := strings.ToLower(a.Key)
+		if a.Namespace != "" {
+			key = strings.ToLower(a.Namespace) + ":" + key
+		}
+		switch {
+		case strings.HasPrefix(key, "on"), key == "srcdoc":
+			continue
+		case urlAttributes[key] && isScriptURL(a.Val):
+			continue
+		}
+		kept = append(kept, a)
+	}
+	return kept
+}
+
+// isScriptURL reports whether the URL runs code when followed. Browsers ignore whitespace and control characters
+// inside the scheme, so they are ignored here as well.
+func isScriptURL(v string) bool {
+	v = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(v))
+	return strings.HasPrefix(v, "javascript:") || strings.HasPrefix(v, "vbscript:") || strings.HasPrefix(v, "data:text/html")
+}
+
+func findHead(n *html.Node) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == atom.Head {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if head := findHead(c); head != nil {
+			return head
+		}
+	}
+	return nil
+}
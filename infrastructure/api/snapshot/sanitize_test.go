@@ -0,0 +1,56 @@
+package snapshot
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	base, _ := url.Parse("https://example.com/articles/1")
+
+	tests := []struct {
+		name       string
+		input      string
+		want       []string
+		wantAbsent []string
+	}{
+		{
+			name:       "scripts_and_embedded_documents",
+			input:      `<html><head><script src="a.js"></script></head><body><noscript>x</noscript><iframe src="https://ads.example.com"></iframe><object data="a.swf"></object><embed src="b.swf"><p>text</p></body></html>`,
+			want:       []string{"<p>text</p>"},
+			wantAbsent: []string{"<script", "<noscript", "<iframe", "<object", "<embed"},
+		},
+		{
+			name:       "event_handlers_and_script_urls",
+			input:      `<body onload="x()"><a href=" Java&#x09;Script:alert(1)" onclick="y()">a</a><a href="/relative">b</a><img src="data:text/html,<b>" srcdoc="<p>"><form action="javascript:z()"></form></body>`,
+			want:       []string{`<body>`, `<a>a</a>`, `<a href="/relative">b</a>`, `<img/>`, `<form></form>`},
+			wantAbsent: []string{"onload", "onclick", "javascript", "Script:", "srcdoc"},
+		},
+		{
+			name:       "refresh_and_base_replaced",
+			input:      `<html><head><meta http-equiv="refresh" content="0;url=https://evil.example.com"><meta charset="shift_jis"><base href="https://evil.example.com/"><link rel="stylesheet" href="style.css"></head><body></body></html>`,
+			want:       []string{`<head><meta charset="utf-8"/><base href="https://example.com/articles/1"/><link rel="stylesheet" href="style.css"/></head>`},
+			wantAbsent: []string{"refresh", "shift_jis", "evil.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sanitize(strings.NewReader(tt.input), base)
+			if err != nil {
+				t.Fatalf("sanitize() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("sanitize() = %s, want it to contain %q", got, want)
+				}
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(string(got), absent) {
+					t.Errorf("sanitize() = %s, want it not to contain %q", got, absent)
+				}
+			}
+		})
+	}
+}
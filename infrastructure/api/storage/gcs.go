@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// GCSStorage stores blobs as objects of a Cloud Storage bucket. The key is the object name.
+type GCSStorage struct {
+	bucket *storage.BucketHandle
+}
+
+// NewGCSStorage creates a GCSStorage storing blobs in the bucket through the client.
+func NewGCSStorage(client *storage.Client, bucket string) *GCSStorage {
+	return &GCSStorage{bucket: client.Bucket(bucket)}
+}
+
+// Put uploads the data as the object of the key.
+func (s *GCSStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	w := s.bucket.Object(key).NewWriter(ctx)
+	w.ContentType = contentType
+	// Blobs are small enough to be uploaded in a single request.
+	w.ChunkSize = 0
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// Get downloads the object of the key.
+func (s *GCSStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	r, err := s.bucket.Object(key).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, service.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type fakeObject struct {
+	contentType string
+	data        []byte
+}
+
+// newFakeGCS serves the multipart uploads and media downloads the storage client sends for a single bucket.
+func newFakeGCS(t *testing.T, bucket string) (*httptest.Server, map[string]fakeObject) {
+	t.Helper()
+	var mu sync.Mutex
+	objects := make(map[string]fakeObject)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/"+bucket+"/o":
+			_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			mr := multipart.NewReader(r.Body, params["boundary"])
+			var meta struct {
+				Name        string `json:"name"`
+				ContentType string `json:"contentType"`
+			}
+			part, err := mr.NextPart()
+			if err != nil || json.NewDecoder(part).Decode(&meta) != nil {
+				http.Error(w, "bad metadata", http.StatusBadRequest)
+				return
+			}
+			part, err = mr.NextPart()
+			if err != nil {
+				http.Error(w, "no media", http.StatusBadRequest)
+				return
+			}
+			data, _ := io.ReadAll(part)
+			objects[meta.Name] = fakeObject{contentType: meta.ContentType, data: data}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"bucket": bucket, "name": meta.Name, "contentType": meta.ContentType})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/"+bucket+"/"):
+			object, ok := objects[strings.TrimPrefix(r.URL.Path, "/"+bucket+"/")]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", object.contentType)
+			_, _ = w.Write(object.data)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, objects
+}
+
+func TestGCSStorage(t *testing.T) {
+	ctx := context.Background()
+	server, objects := newFakeGCS(t, "snapshots-bucket")
+	client, err := storage.NewClient(ctx, option.WithEndpoint(server.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create storage client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	s := NewGCSStorage(client, "snapshots-bucket")
+
+	if err := s.Put(ctx, "snapshots/page/link/a.html", "text/html; charset=utf-8", []byte("<p>hi</p>")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if got := objects["snapshots/page/link/a.html"].contentType; got != "text/html; charset=utf-8" {
+		t.Errorf("stored content type = %q, want %q", got, "text/html; charset=utf-8")
+	}
+
+	got, err := s.Get(ctx, "snapshots/page/link/a.html")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(got) != "<p>hi</p>" {
+		t.Errorf("Get() = %q, want %q", got, "<p>hi</p>")
+	}
+
+	if _, err := s.Get(ctx, "snapshots/missing.html"); !errors.Is(err, service.ErrBlobNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, service.ErrBlobNotFound)
+	}
+}
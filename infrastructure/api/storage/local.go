@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// LocalStorage stores blobs as files under a directory. The key is the path of the file relative to the directory.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage creates a LocalStorage storing blobs under the directory, which is created on the first Put.
+func NewLocalStorage(dir string) *LocalStorage {
+	return &LocalStorage{dir: dir}
+}
+
+// Put writes the data to the file of the key. The file is written next to its final place and renamed,
+// so that readers never see a partly written blob. The content type is not kept.
+func (s *LocalStorage) Put(_ context.Context, key string, _ string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get reads the file of the key.
+func (s *LocalStorage) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) // #nosec G304 - the key is checked to stay inside the directory
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, service.ErrBlobNotFound
+	}
+	return data, err
+}
+
+// path returns the file of the key. Keys leaving the directory are rejected.
+func (s *LocalStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := NewLocalStorage(filepath.Join(dir, "snapshots"))
+
+	if err := s.Put(ctx, "page/link/a.html", "text/html", []byte("first")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	// A second Put replaces the content.
+	if err := s.Put(ctx, "page/link/a.html", "text/html", []byte("second")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, err := s.Get(ctx, "page/link/a.html")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(got) != "second" {
+		t.Errorf("Get() = %q, want %q", got, "second")
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "snapshots", "page", "link"))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("files after Put = %v, %v, want only the blob", entries, err)
+	}
+
+	if _, err := s.Get(ctx, "page/link/missing.html"); !errors.Is(err, service.ErrBlobNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, service.ErrBlobNotFound)
+	}
+
+	for _, key := range []string{"../outside.html", "/etc/passwd", ""} {
+		if err := s.Put(ctx, key, "text/html", []byte("x")); err == nil {
+			t.Errorf("Put(%q) error = nil, want invalid key", key)
+		}
+		if _, err := s.Get(ctx, key); err == nil {
+			t.Errorf("Get(%q) error = nil, want invalid key", key)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/naka-sei/tsudzuri/config"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// defaultLocalDir is the directory blobs are stored in without a configuration.
+const defaultLocalDir = "data/snapshots"
+
+// NewBlobStorage creates the blob storage for the configuration. Blobs are stored in Cloud Storage when a bucket is
+// configured; otherwise they are stored in a local directory, which is meant for local development.
+func NewBlobStorage(conf *config.Config) (service.BlobStorage, error) {
+	if conf == nil || conf.SnapshotBucket == "" {
+		dir := defaultLocalDir
+		if conf != nil && conf.SnapshotDir != "" {
+			dir = conf.SnapshotDir
+		}
+		return NewLocalStorage(dir), nil
+	}
+
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return NewGCSStorage(client, conf.SnapshotBucket), nil
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// requestTimeout bounds a single delivery so that a slow receiver cannot hold up the others.
+	requestTimeout = 10 * time.Second
+	// maxResponseBytes is how much of a response body is read before the connection is released.
+	maxResponseBytes = 64 << 10
+)
+
+// Client posts webhook payloads over HTTP. Redirects are not followed, so a receiver answering with one
+// counts as a failed delivery.
+type Client struct {
+	client *http.Client
+}
+
+// NewClient creates a Client with the default timeout. It only connects to public addresses, so that webhook URLs
+// cannot be used to reach services inside our own network.
+func NewClient() service.WebhookClient {
+	return NewClientWithHTTPClient(&http.Client{Timeout: requestTimeout, Transport: publicnet.NewTransport()})
+}
+
+// NewClientWithHTTPClient creates a Client that sends requests through the given http.Client. Its redirect policy
+// is replaced so that redirects are returned as responses.
+func NewClientWithHTTPClient(client *http.Client) *Client {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	return &Client{client: &c}
+}
+
+// Post posts the JSON body to the URL with the headers of the request and returns the status of the response.
+func (c *Client) Post(ctx context.Context, request service.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range request.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/pkg/http/publicnet"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+func TestClient_Post(t *testing.T) {
+	var (
+		gotMethod  string
+		gotHeaders http.Header
+		gotBody    string
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotHeaders = r.Header.Clone()
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/fail", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "broken", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client())
+
+	tests := []struct {
+		name    string
+		path    string
+		want    int
+		wantErr bool
+	}{
+		{name: "success", path: "/ok", want: http.StatusNoContent},
+		{name: "error_status", path: "/fail", want: http.StatusInternalServerError},
+		{name: "redirect_not_followed", path: "/redirect", want: http.StatusFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMethod, gotHeaders, gotBody = "", nil, ""
+			got, err := client.Post(context.Background(), service.WebhookRequest{
+				URL:     server.URL + tt.path,
+				Headers: map[string]string{"X-Tsudzuri-Event": "link.added"},
+				Body:    []byte(`{"event":"link.added"}`),
+			})
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Post() = %d, want %d", got, tt.want)
+			}
+			if tt.path != "/ok" {
+				if gotMethod != "" {
+					t.Fatalf("request reached /ok through %s", tt.path)
+				}
+				return
+			}
+			if gotMethod != http.MethodPost {
+				t.Fatalf("method = %s, want POST", gotMethod)
+			}
+			if ct := gotHeaders.Get("Content-Type"); ct != "application/json" {
+				t.Fatalf("Content-Type = %q, want application/json", ct)
+			}
+			if ev := gotHeaders.Get("X-Tsudzuri-Event"); ev != "link.added" {
+				t.Fatalf("X-Tsudzuri-Event = %q, want link.added", ev)
+			}
+			if gotBody != `{"event":"link.added"}` {
+				t.Fatalf("body = %q", gotBody)
+			}
+		})
+	}
+
+	t.Run("no_response", func(t *testing.T) {
+		closed := httptest.NewServer(http.NotFoundHandler())
+		closed.Close()
+		if _, err := client.Post(context.Background(), service.WebhookRequest{URL: closed.URL}); err == nil {
+			t.Fatalf("Post() to a closed server returned no error")
+		}
+	})
+}
+
+func TestNewClient_refusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewClient().Post(context.Background(), service.WebhookRequest{URL: server.URL, Body: []byte(`{}`)})
+	if !errors.Is(err, publicnet.ErrNotPublicAddress) {
+		t.Fatalf("Post() error = %v, want refusal of a private address", err)
+	}
+}
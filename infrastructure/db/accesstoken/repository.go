@@ -0,0 +1,154 @@
+package accesstoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entaccesstoken "github.com/naka-sei/tsudzuri/infrastructure/db/ent/accesstoken"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type accessTokenRepository struct {
+	conn *postgres.Connection
+}
+
+func NewAccessTokenRepository(conn *postgres.Connection) daccesstoken.AccessTokenRepository {
+	return &accessTokenRepository{conn: conn}
+}
+
+// Get fetches an access token by ID. Returns (nil, nil) if not found or id empty.
+func (r *accessTokenRepository) Get(ctx context.Context, id string) (*daccesstoken.AccessToken, error) {
+	if id == "" {
+		return nil, nil
+	}
+	tid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.AccessToken.Get(ctx, tid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// GetByTokenHash fetches an access token by the hash of its token. Returns (nil, nil) if not found.
+func (r *accessTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*daccesstoken.AccessToken, error) {
+	if tokenHash == "" {
+		return nil, nil
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.AccessToken.Query().
+		Where(entaccesstoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// ListByUserID returns the access tokens of the user, newest first.
+func (r *accessTokenRepository) ListByUserID(ctx context.Context, userID string) ([]*daccesstoken.AccessToken, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.AccessToken.Query().
+		Where(entaccesstoken.UserIDEQ(uid)).
+		Order(ent.Desc(entaccesstoken.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*daccesstoken.AccessToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, entToDomain(row))
+	}
+	return tokens, nil
+}
+
+// Save inserts a new access token or records the last use and the revocation of an existing one.
+func (r *accessTokenRepository) Save(ctx context.Context, t *daccesstoken.AccessToken) (*daccesstoken.AccessToken, error) {
+	if t == nil {
+		return nil, errors.New("nil access token")
+	}
+	client := r.conn.WriteDB(ctx)
+
+	if t.ID() == "" { // create
+		uid, err := uuid.Parse(t.UserID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %w", err)
+		}
+		scopes := make([]string, 0, len(t.Scopes()))
+		for _, s := range t.Scopes() {
+			scopes = append(scopes, string(s))
+		}
+		create := client.AccessToken.Create().
+			SetUserID(uid).
+			SetName(t.Name()).
+			SetTokenHash(t.TokenHash()).
+			SetHint(t.Hint()).
+			SetScopes(scopes).
+			SetPageIds(t.PageIDs()).
+			SetNillableExpiresAt(t.ExpiresAt()).
+			SetNillableLastUsedAt(t.LastUsedAt()).
+			SetNillableRevokedAt(t.RevokedAt())
+		if !t.CreatedAt().IsZero() {
+			create = create.SetCreatedAt(t.CreatedAt()).SetUpdatedAt(t.CreatedAt())
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return entToDomain(created), nil
+	}
+
+	tid, err := uuid.Parse(t.ID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token id: %w", err)
+	}
+	updated, err := client.AccessToken.UpdateOneID(tid).
+		SetNillableLastUsedAt(t.LastUsedAt()).
+		SetNillableRevokedAt(t.RevokedAt()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entToDomain(updated), nil
+}
+
+func entToDomain(t *ent.AccessToken) *daccesstoken.AccessToken {
+	scopes := make([]daccesstoken.Scope, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		scopes = append(scopes, daccesstoken.Scope(s))
+	}
+	return daccesstoken.ReconstructAccessToken(
+		t.ID.String(),
+		t.UserID.String(),
+		t.Name,
+		t.TokenHash,
+		t.Hint,
+		scopes,
+		t.PageIds,
+		daccesstoken.WithExpiresAt(t.ExpiresAt),
+		daccesstoken.WithUsage(t.LastUsedAt, t.RevokedAt),
+		daccesstoken.WithCreatedAt(t.CreatedAt),
+	)
+}
@@ -0,0 +1,102 @@
+package accesstoken
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+var createdAt = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func accessTokenCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(daccesstoken.AccessToken{}),
+		// IDs are generated by the repository.
+		cmpopts.IgnoreFields(daccesstoken.AccessToken{}, "id"),
+		cmpopts.EquateApproxTime(0),
+		cmpopts.EquateEmpty(),
+	}
+}
+
+func TestAccessTokenRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	fx.NewUser(duser.ReconstructUser("", "access-token-repo-user-1", string(duser.ProviderGoogle), nil))
+	fx.NewUser(duser.ReconstructUser("", "access-token-repo-user-2", string(duser.ProviderGoogle), nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	user1 := fx.ID("access-token-repo-user-1")
+	user2 := fx.ID("access-token-repo-user-2")
+	expiresAt := createdAt.Add(24 * time.Hour)
+	repo := NewAccessTokenRepository(conn)
+
+	first := daccesstoken.ReconstructAccessToken("", user1, "ci bot", "access-token-repo-hash-1", "tsz_pat_abcd",
+		daccesstoken.Scopes(), nil, daccesstoken.WithCreatedAt(createdAt))
+	second := daccesstoken.ReconstructAccessToken("", user1, "reader", "access-token-repo-hash-2", "tsz_pat_efgh",
+		[]daccesstoken.Scope{daccesstoken.ScopeRead}, []string{"page-1"},
+		daccesstoken.WithExpiresAt(&expiresAt), daccesstoken.WithCreatedAt(createdAt))
+	// The name limit counts characters, not bytes.
+	other := daccesstoken.ReconstructAccessToken("", user2, strings.Repeat("鍵", daccesstoken.MaxNameLength), "access-token-repo-hash-3", "tsz_pat_ijkl",
+		daccesstoken.Scopes(), nil, daccesstoken.WithCreatedAt(createdAt))
+
+	saved, err := repo.Save(ctx, first)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	for _, token := range []*daccesstoken.AccessToken{second, other} {
+		if _, err := repo.Save(ctx, token); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	got, err := repo.ListByUserID(ctx, user1)
+	if err != nil {
+		t.Fatalf("ListByUserID() error = %v", err)
+	}
+	if diff := cmp.Diff([]*daccesstoken.AccessToken{second, first}, got, accessTokenCmpOpts()...); diff != "" {
+		t.Fatalf("ListByUserID() mismatch (-want +got):\n%s", diff)
+	}
+
+	byHash, err := repo.GetByTokenHash(ctx, "access-token-repo-hash-2")
+	if err != nil {
+		t.Fatalf("GetByTokenHash() error = %v", err)
+	}
+	if diff := cmp.Diff(second, byHash, accessTokenCmpOpts()...); diff != "" {
+		t.Fatalf("GetByTokenHash() mismatch (-want +got):\n%s", diff)
+	}
+	missing, err := repo.GetByTokenHash(ctx, "access-token-repo-unknown")
+	if err != nil || missing != nil {
+		t.Fatalf("GetByTokenHash() = %v, %v, want nil, nil", missing, err)
+	}
+
+	// Recording the use and revoking the token are persisted.
+	usedAt := createdAt.Add(time.Hour)
+	saved.MarkUsed(usedAt)
+	if err := saved.Revoke(duser.ReconstructUser(user1, "access-token-repo-user-1", string(duser.ProviderGoogle), nil), usedAt); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := repo.Save(ctx, saved); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	reloaded, err := repo.Get(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	want := daccesstoken.ReconstructAccessToken(saved.ID(), user1, "ci bot", "access-token-repo-hash-1", "tsz_pat_abcd",
+		daccesstoken.Scopes(), nil, daccesstoken.WithUsage(&usedAt, &usedAt), daccesstoken.WithCreatedAt(createdAt))
+	if diff := cmp.Diff(want, reloaded, accessTokenCmpOpts()...); diff != "" {
+		t.Fatalf("Get() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,83 @@
+package click
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinkclick "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type clickRepository struct {
+	conn *postgres.Connection
+}
+
+func NewClickRepository(conn *postgres.Connection) dclick.ClickRepository {
+	return &clickRepository{conn: conn}
+}
+
+// CreateBulk inserts the clicks in one statement.
+func (r *clickRepository) CreateBulk(ctx context.Context, clicks []*dclick.Click) error {
+	if len(clicks) == 0 {
+		return nil
+	}
+
+	client := r.conn.WriteDB(ctx)
+	builders := make([]*ent.LinkClickCreate, 0, len(clicks))
+	for _, c := range clicks {
+		pageUUID, err := uuid.Parse(c.PageID())
+		if err != nil {
+			return fmt.Errorf("invalid page id: %w", err)
+		}
+		linkUUID, err := uuid.Parse(c.LinkID())
+		if err != nil {
+			return fmt.Errorf("invalid link id: %w", err)
+		}
+		create := client.LinkClick.Create().
+			SetPageID(pageUUID).
+			SetLinkItemID(linkUUID).
+			SetReferrer(c.Referrer()).
+			SetClickedAt(c.ClickedAt())
+		if c.UserID() != "" {
+			userUUID, err := uuid.Parse(c.UserID())
+			if err != nil {
+				return fmt.Errorf("invalid user id: %w", err)
+			}
+			create = create.SetUserID(userUUID)
+		}
+		builders = append(builders, create)
+	}
+	return client.LinkClick.CreateBulk(builders...).Exec(ctx)
+}
+
+// CountByLink counts the clicks on the links of the page at or after since, grouped by link.
+func (r *clickRepository) CountByLink(ctx context.Context, pageID string, since time.Time) (map[string]int64, error) {
+	pid, err := uuid.Parse(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page id: %w", err)
+	}
+
+	query := r.conn.ReadOnlyDB(ctx).LinkClick.Query().Where(entlinkclick.PageIDEQ(pid))
+	if !since.IsZero() {
+		query = query.Where(entlinkclick.ClickedAtGTE(since))
+	}
+
+	var rows []struct {
+		LinkItemID uuid.UUID `json:"link_item_id"`
+		Count      int64     `json:"count"`
+	}
+	if err := query.GroupBy(entlinkclick.FieldLinkItemID).Aggregate(ent.Count()).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.LinkItemID.String()] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,87 @@
+package click
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+// newLinkPage registers a user and a page holding links with the given IDs.
+func newLinkPage(fx *fixture.Fixture, uid string, title string, linkIDs ...string) {
+	user := duser.ReconstructUser("", uid, string(duser.ProviderAnonymous), nil)
+	fx.NewUser(user)
+	links := make(dpage.Links, 0, len(linkIDs))
+	for i, id := range linkIDs {
+		links = append(links, dpage.ReconstructLink("https://example.com/"+id, "", i+1, dpage.WithLinkID(id)))
+	}
+	fx.NewPage(dpage.ReconstructPage("", title, *user, "INVITE01", links, nil))
+}
+
+func TestClickRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+
+	linkA, linkB := uuid.NewString(), uuid.NewString()
+	fx := fixture.New()
+	newLinkPage(fx, "click-user", "click-page", linkA, linkB)
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	pageID := fx.ID("click-page")
+	user := duser.ReconstructUser(fx.ID("click-user"), "click-user", string(duser.ProviderAnonymous), nil)
+	targetA := dclick.ReconstructTarget(pageID, linkA, "https://example.com/a")
+	targetB := dclick.ReconstructTarget(pageID, linkB, "https://example.com/b")
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	repo := NewClickRepository(conn)
+	if err := repo.CreateBulk(ctx, []*dclick.Click{
+		dclick.NewClick(targetA, user, "https://referrer.example.com", now.Add(-2*time.Hour)),
+		dclick.NewClick(targetA, nil, "", now.Add(-48*time.Hour)),
+		dclick.NewClick(targetB, nil, "", now.Add(-time.Hour)),
+	}); err != nil {
+		t.Fatalf("CreateBulk() error = %v", err)
+	}
+
+	t.Run("count_all", func(t *testing.T) {
+		got, err := repo.CountByLink(ctx, pageID, time.Time{})
+		if err != nil {
+			t.Fatalf("CountByLink() error = %v", err)
+		}
+		if diff := cmp.Diff(map[string]int64{linkA: 2, linkB: 1}, got); diff != "" {
+			t.Fatalf("CountByLink() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("count_since", func(t *testing.T) {
+		got, err := repo.CountByLink(ctx, pageID, now.Add(-24*time.Hour))
+		if err != nil {
+			t.Fatalf("CountByLink() error = %v", err)
+		}
+		if diff := cmp.Diff(map[string]int64{linkA: 1, linkB: 1}, got); diff != "" {
+			t.Fatalf("CountByLink() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("count_other_page", func(t *testing.T) {
+		got, err := repo.CountByLink(ctx, uuid.NewString(), time.Time{})
+		if err != nil || len(got) != 0 {
+			t.Fatalf("CountByLink() = %v, %v, want empty", got, err)
+		}
+	})
+
+	t.Run("create_nothing", func(t *testing.T) {
+		if err := repo.CreateBulk(ctx, nil); err != nil {
+			t.Fatalf("CreateBulk() error = %v", err)
+		}
+	})
+}
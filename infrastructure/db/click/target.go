@@ -0,0 +1,41 @@
+package click
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinkitem "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type targetRepository struct {
+	conn *postgres.Connection
+}
+
+func NewTargetRepository(conn *postgres.Connection) dclick.TargetRepository {
+	return &targetRepository{conn: conn}
+}
+
+// Get looks up the page and URL of the link item. IDs that are not UUIDs cannot name a link item
+// and are reported as missing, since they come straight from the redirect path.
+func (r *targetRepository) Get(ctx context.Context, linkID string) (*dclick.Target, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, nil
+	}
+
+	item, err := r.conn.ReadOnlyDB(ctx).LinkItem.Query().
+		Where(entlinkitem.IDEQ(lid)).
+		Select(entlinkitem.FieldID, entlinkitem.FieldPageID, entlinkitem.FieldURL).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return dclick.ReconstructTarget(item.PageID.String(), item.ID.String(), item.URL), nil
+}
@@ -0,0 +1,59 @@
+package click
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+func TestTargetRepository_Get(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+
+	linkID := uuid.NewString()
+	fx := fixture.New()
+	newLinkPage(fx, "target-user", "target-page", linkID)
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewTargetRepository(conn)
+
+	tests := []struct {
+		name   string
+		linkID string
+		want   *dclick.Target
+	}{
+		{
+			name:   "found",
+			linkID: linkID,
+			want:   dclick.ReconstructTarget(fx.ID("target-page"), linkID, "https://example.com/"+linkID),
+		},
+		{
+			name:   "not_found",
+			linkID: uuid.NewString(),
+		},
+		{
+			name:   "not_uuid",
+			linkID: "not-a-uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.Get(ctx, tt.linkID)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dclick.Target{})); diff != "" {
+				t.Fatalf("Get() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entcomment "github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type commentRepository struct {
+	conn *postgres.Connection
+}
+
+func NewCommentRepository(conn *postgres.Connection) dcomment.CommentRepository {
+	return &commentRepository{conn: conn}
+}
+
+// Get fetches a comment by ID with its author.
+func (r *commentRepository) Get(ctx context.Context, id string) (*dcomment.Comment, error) {
+	if id == "" {
+		return nil, nil
+	}
+	cid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.Comment.Query().
+		Where(entcomment.IDEQ(cid)).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.entToDomain(found)
+}
+
+// ListByLink returns the comments on the link, oldest first.
+func (r *commentRepository) ListByLink(ctx context.Context, linkID string) ([]*dcomment.Comment, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	list, err := client.Comment.Query().
+		Where(entcomment.LinkItemIDEQ(lid)).
+		WithAuthor().
+		Order(ent.Asc(entcomment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*dcomment.Comment, 0, len(list))
+	for _, c := range list {
+		dc, err := r.entToDomain(c)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, dc)
+	}
+	return comments, nil
+}
+
+// Save inserts a new comment or updates the body and deletion state of an existing one.
+func (r *commentRepository) Save(ctx context.Context, c *dcomment.Comment) (*dcomment.Comment, error) {
+	if c == nil {
+		return nil, errors.New("nil comment")
+	}
+	client := r.conn.WriteDB(ctx)
+
+	if c.ID() == "" { // create
+		pageUUID, err := uuid.Parse(c.PageID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid page id: %w", err)
+		}
+		linkUUID, err := uuid.Parse(c.LinkID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid link id: %w", err)
+		}
+		authorUUID, err := uuid.Parse(c.Author().ID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid author id: %w", err)
+		}
+		create := client.Comment.Create().
+			SetPageID(pageUUID).
+			SetLinkItemID(linkUUID).
+			SetAuthorID(authorUUID).
+			SetBody(c.Body())
+		if c.ParentID() != "" {
+			parentUUID, err := uuid.Parse(c.ParentID())
+			if err != nil {
+				return nil, fmt.Errorf("invalid parent comment id: %w", err)
+			}
+			create = create.SetParentID(parentUUID)
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return r.reconstruct(created, c.Author()), nil
+	}
+
+	cid, err := uuid.Parse(c.ID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment id: %w", err)
+	}
+	found, err := client.Comment.Get(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	update := client.Comment.UpdateOneID(cid).SetBody(c.Body())
+	if c.Deleted() && found.DeletedAt == nil {
+		update = update.SetDeletedAt(ctxtime.Now(ctx))
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.reconstruct(updated, c.Author()), nil
+}
+
+// Helper: convert ent.Comment to domain.Comment.
+func (r *commentRepository) entToDomain(c *ent.Comment) (*dcomment.Comment, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Edges.Author == nil {
+		return nil, errors.New("comment author not loaded")
+	}
+	return r.reconstruct(c, entUserToDomain(c.Edges.Author)), nil
+}
+
+func (r *commentRepository) reconstruct(c *ent.Comment, author *duser.User) *dcomment.Comment {
+	opts := []dcomment.ReconstructOption{
+		dcomment.WithTimestamps(c.CreatedAt, c.UpdatedAt),
+	}
+	if c.ParentID != nil {
+		opts = append(opts, dcomment.WithParentID(c.ParentID.String()))
+	}
+	if c.DeletedAt != nil {
+		opts = append(opts, dcomment.WithDeleted())
+	}
+	return dcomment.ReconstructComment(c.ID.String(), c.PageID.String(), c.LinkItemID.String(), *author, c.Body, opts...)
+}
+
+func entUserToDomain(u *ent.User) *duser.User {
+	return duser.ReconstructUser(u.ID.String(), u.UID, string(u.Provider), u.Email, duser.WithProfile(duser.ReconstructProfile(u.DisplayName, u.AvatarURL, u.Locale)))
+}
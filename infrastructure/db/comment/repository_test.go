@@ -0,0 +1,286 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func commentCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(dcomment.Comment{}, duser.User{}, duser.Profile{}),
+		// Timestamps are generated by the database.
+		cmpopts.IgnoreFields(dcomment.Comment{}, "createdAt", "updatedAt"),
+	}
+}
+
+// newLinkPage registers a user and a page holding a single link with the given ID.
+func newLinkPage(fx *fixture.Fixture, uid string, title string, linkID string) {
+	user := duser.ReconstructUser("", uid, string(duser.ProviderAnonymous), nil)
+	fx.NewUser(user)
+	fx.NewPage(dpage.ReconstructPage("", title, *user, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID(linkID)),
+	}, nil))
+}
+
+func TestCommentRepository_Get(t *testing.T) {
+	type want struct {
+		comment *dcomment.Comment
+		err     error
+	}
+
+	linkID := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		id      func(*fixture.Fixture) string
+		want    func(*fixture.Fixture) want
+	}{
+		{
+			name: "success",
+			prepare: func(fx *fixture.Fixture) {
+				newLinkPage(fx, "comment-get-user", "comment-get-page", linkID)
+				author := duser.ReconstructUser("", "comment-get-user", string(duser.ProviderAnonymous), nil)
+				fx.NewComment(dcomment.ReconstructComment("", "comment-get-page", linkID, *author, "comment-get-root"))
+				fx.NewComment(dcomment.ReconstructComment("", "comment-get-page", linkID, *author, "comment-get-reply",
+					dcomment.WithParentID("comment-get-root")))
+			},
+			id: func(fx *fixture.Fixture) string { return fx.ID("comment-get-reply") },
+			want: func(fx *fixture.Fixture) want {
+				author := duser.ReconstructUser(fx.ID("comment-get-user"), "comment-get-user", string(duser.ProviderAnonymous), nil)
+				return want{comment: dcomment.ReconstructComment(fx.ID("comment-get-reply"), fx.ID("comment-get-page"), linkID, *author, "comment-get-reply",
+					dcomment.WithParentID(fx.ID("comment-get-root")))}
+			},
+		},
+		{
+			name: "empty_id",
+			id:   func(fx *fixture.Fixture) string { return "" },
+			want: func(fx *fixture.Fixture) want { return want{} },
+		},
+		{
+			name: "not_found",
+			id:   func(fx *fixture.Fixture) string { return uuid.NewString() },
+			want: func(fx *fixture.Fixture) want { return want{} },
+		},
+		{
+			name: "invalid_id",
+			id:   func(fx *fixture.Fixture) string { return "invalid" },
+			want: func(fx *fixture.Fixture) want {
+				_, invalidUUIDErr := uuid.Parse("invalid")
+				return want{err: invalidUUIDErr}
+			},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			w := tt.want(fx)
+			repo := NewCommentRepository(conn)
+			got, err := repo.Get(ctx, tt.id(fx))
+			testutil.EqualErr(t, w.err, err)
+			if diff := cmp.Diff(w.comment, got, commentCmpOpts()...); diff != "" {
+				t.Fatalf("comment mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_ListByLink(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+
+	linkID := uuid.NewString()
+	otherLinkID := uuid.NewString()
+	fx := fixture.New()
+	newLinkPage(fx, "comment-list-user", "comment-list-page", linkID)
+	newLinkPage(fx, "comment-list-other", "comment-list-other-page", otherLinkID)
+	author := duser.ReconstructUser("", "comment-list-user", string(duser.ProviderAnonymous), nil)
+	other := duser.ReconstructUser("", "comment-list-other", string(duser.ProviderAnonymous), nil)
+	fx.NewComment(dcomment.ReconstructComment("", "comment-list-page", linkID, *author, "comment-list-first"))
+	fx.NewComment(dcomment.ReconstructComment("", "comment-list-page", linkID, *author, "comment-list-second"))
+	fx.NewComment(dcomment.ReconstructComment("", "comment-list-other-page", otherLinkID, *other, "comment-list-elsewhere"))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		linkID string
+		want   []string
+		err    error
+	}{
+		{
+			name:   "oldest_first",
+			linkID: linkID,
+			want:   []string{"comment-list-first", "comment-list-second"},
+		},
+		{
+			name:   "no_comments",
+			linkID: uuid.NewString(),
+			want:   []string{},
+		},
+		{
+			name:   "invalid_link_id",
+			linkID: "invalid",
+			err: func() error {
+				_, invalidUUIDErr := uuid.Parse("invalid")
+				return invalidUUIDErr
+			}(),
+		},
+	}
+
+	repo := NewCommentRepository(conn)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.ListByLink(ctx, tt.linkID)
+			testutil.EqualErr(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			bodies := make([]string, 0, len(got))
+			for _, c := range got {
+				bodies = append(bodies, c.Body())
+			}
+			if diff := cmp.Diff(tt.want, bodies); diff != "" {
+				t.Fatalf("bodies mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_Save(t *testing.T) {
+	type want struct {
+		comment *dcomment.Comment
+		err     error
+	}
+
+	// Each case uses its own link so parallel test transactions never insert the same row.
+	createLinkID, editLinkID, deleteLinkID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		args    func(*fixture.Fixture) *dcomment.Comment
+		want    func(*fixture.Fixture) want
+	}{
+		{
+			name: "create_reply",
+			prepare: func(fx *fixture.Fixture) {
+				newLinkPage(fx, "comment-save-create-user", "comment-save-create-page", createLinkID)
+				author := duser.ReconstructUser("", "comment-save-create-user", string(duser.ProviderAnonymous), nil)
+				fx.NewComment(dcomment.ReconstructComment("", "comment-save-create-page", createLinkID, *author, "comment-save-create-root"))
+			},
+			args: func(fx *fixture.Fixture) *dcomment.Comment {
+				author := duser.ReconstructUser(fx.ID("comment-save-create-user"), "comment-save-create-user", string(duser.ProviderAnonymous), nil)
+				return dcomment.ReconstructComment("", fx.ID("comment-save-create-page"), createLinkID, *author, "reply",
+					dcomment.WithParentID(fx.ID("comment-save-create-root")))
+			},
+			want: func(fx *fixture.Fixture) want {
+				author := duser.ReconstructUser(fx.ID("comment-save-create-user"), "comment-save-create-user", string(duser.ProviderAnonymous), nil)
+				return want{comment: dcomment.ReconstructComment("", fx.ID("comment-save-create-page"), createLinkID, *author, "reply",
+					dcomment.WithParentID(fx.ID("comment-save-create-root")))}
+			},
+		},
+		{
+			name: "update_body",
+			prepare: func(fx *fixture.Fixture) {
+				newLinkPage(fx, "comment-save-edit-user", "comment-save-edit-page", editLinkID)
+				author := duser.ReconstructUser("", "comment-save-edit-user", string(duser.ProviderAnonymous), nil)
+				fx.NewComment(dcomment.ReconstructComment("", "comment-save-edit-page", editLinkID, *author, "comment-save-edit-before"))
+			},
+			args: func(fx *fixture.Fixture) *dcomment.Comment {
+				author := duser.ReconstructUser(fx.ID("comment-save-edit-user"), "comment-save-edit-user", string(duser.ProviderAnonymous), nil)
+				return dcomment.ReconstructComment(fx.ID("comment-save-edit-before"), fx.ID("comment-save-edit-page"), editLinkID, *author, "after")
+			},
+			want: func(fx *fixture.Fixture) want {
+				author := duser.ReconstructUser(fx.ID("comment-save-edit-user"), "comment-save-edit-user", string(duser.ProviderAnonymous), nil)
+				return want{comment: dcomment.ReconstructComment(fx.ID("comment-save-edit-before"), fx.ID("comment-save-edit-page"), editLinkID, *author, "after")}
+			},
+		},
+		{
+			name: "delete",
+			prepare: func(fx *fixture.Fixture) {
+				newLinkPage(fx, "comment-save-delete-user", "comment-save-delete-page", deleteLinkID)
+				author := duser.ReconstructUser("", "comment-save-delete-user", string(duser.ProviderAnonymous), nil)
+				fx.NewComment(dcomment.ReconstructComment("", "comment-save-delete-page", deleteLinkID, *author, "comment-save-delete-body"))
+			},
+			args: func(fx *fixture.Fixture) *dcomment.Comment {
+				author := duser.ReconstructUser(fx.ID("comment-save-delete-user"), "comment-save-delete-user", string(duser.ProviderAnonymous), nil)
+				return dcomment.ReconstructComment(fx.ID("comment-save-delete-body"), fx.ID("comment-save-delete-page"), deleteLinkID, *author, "",
+					dcomment.WithDeleted())
+			},
+			want: func(fx *fixture.Fixture) want {
+				author := duser.ReconstructUser(fx.ID("comment-save-delete-user"), "comment-save-delete-user", string(duser.ProviderAnonymous), nil)
+				return want{comment: dcomment.ReconstructComment(fx.ID("comment-save-delete-body"), fx.ID("comment-save-delete-page"), deleteLinkID, *author, "",
+					dcomment.WithDeleted())}
+			},
+		},
+		{
+			name: "nil_comment",
+			args: func(fx *fixture.Fixture) *dcomment.Comment { return nil },
+			want: func(fx *fixture.Fixture) want { return want{err: errors.New("nil comment")} },
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			repo := NewCommentRepository(conn)
+			w := tt.want(fx)
+
+			res, err := repo.Save(ctx, tt.args(fx))
+			testutil.EqualErr(t, w.err, err)
+			if w.err != nil {
+				return
+			}
+
+			got, err := repo.Get(ctx, res.ID())
+			if err != nil {
+				t.Fatalf("failed to get saved comment: %v", err)
+			}
+			cmpOpts := commentCmpOpts()
+			if w.comment.ID() == "" {
+				cmpOpts = append(cmpOpts, cmpopts.IgnoreFields(dcomment.Comment{}, "id"))
+			}
+			if diff := cmp.Diff(w.comment, got, cmpOpts...); diff != "" {
+				t.Fatalf("saved comment mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entdevice "github.com/naka-sei/tsudzuri/infrastructure/db/ent/device"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type deviceRepository struct {
+	conn *postgres.Connection
+}
+
+func NewDeviceRepository(conn *postgres.Connection) ddevice.DeviceRepository {
+	return &deviceRepository{conn: conn}
+}
+
+// ListByUserIDs returns the devices of the users, oldest first.
+func (r *deviceRepository) ListByUserIDs(ctx context.Context, userIDs []string) ([]*ddevice.Device, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	uids := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %w", err)
+		}
+		uids = append(uids, uid)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.Device.Query().
+		Where(entdevice.UserIDIn(uids...)).
+		Order(ent.Asc(entdevice.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*ddevice.Device, 0, len(rows))
+	for _, row := range rows {
+		devices = append(devices, entToDomain(row))
+	}
+	return devices, nil
+}
+
+// Save stores the device. A stored device with the same token is removed first so that the token moves to the user.
+func (r *deviceRepository) Save(ctx context.Context, device *ddevice.Device) error {
+	if device == nil {
+		return errors.New("nil device")
+	}
+	uid, err := uuid.Parse(device.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	if _, err := client.Device.Delete().
+		Where(entdevice.TokenEQ(device.Token())).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	create := client.Device.Create().
+		SetUserID(uid).
+		SetToken(device.Token()).
+		SetPlatform(string(device.Platform()))
+	if !device.CreatedAt().IsZero() {
+		create = create.SetCreatedAt(device.CreatedAt()).SetUpdatedAt(device.CreatedAt())
+	}
+	return create.Exec(ctx)
+}
+
+// Delete removes the device of the user with the token.
+func (r *deviceRepository) Delete(ctx context.Context, userID string, token string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	_, err = client.Device.Delete().
+		Where(
+			entdevice.UserIDEQ(uid),
+			entdevice.TokenEQ(token),
+		).
+		Exec(ctx)
+	return err
+}
+
+// DeleteByTokens removes the devices with the tokens.
+func (r *deviceRepository) DeleteByTokens(ctx context.Context, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	client := r.conn.WriteDB(ctx)
+	_, err := client.Device.Delete().
+		Where(entdevice.TokenIn(tokens...)).
+		Exec(ctx)
+	return err
+}
+
+func entToDomain(d *ent.Device) *ddevice.Device {
+	return ddevice.ReconstructDevice(
+		d.ID.String(),
+		d.UserID.String(),
+		d.Token,
+		ddevice.Platform(d.Platform),
+		d.CreatedAt,
+	)
+}
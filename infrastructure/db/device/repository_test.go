@@ -0,0 +1,88 @@
+package device
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+var createdAt = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func deviceCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(ddevice.Device{}),
+		// IDs are generated by the repository.
+		cmpopts.IgnoreFields(ddevice.Device{}, "id"),
+		cmpopts.EquateApproxTime(0),
+	}
+}
+
+func TestDeviceRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	fx.NewUser(duser.ReconstructUser("", "device-repo-user-1", string(duser.ProviderGoogle), nil))
+	fx.NewUser(duser.ReconstructUser("", "device-repo-user-2", string(duser.ProviderAnonymous), nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	user1 := fx.ID("device-repo-user-1")
+	user2 := fx.ID("device-repo-user-2")
+	newDevice := func(userID string, token string) *ddevice.Device {
+		return ddevice.ReconstructDevice("", userID, token, ddevice.PlatformIOS, createdAt)
+	}
+
+	repo := NewDeviceRepository(conn)
+	for _, d := range []*ddevice.Device{
+		newDevice(user1, "device-repo-token-1"),
+		newDevice(user1, "device-repo-token-2"),
+		newDevice(user2, "device-repo-token-3"),
+		// Registering a token again moves it to the new user.
+		newDevice(user2, "device-repo-token-1"),
+	} {
+		if err := repo.Save(ctx, d); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	got, err := repo.ListByUserIDs(ctx, []string{user1, user2})
+	if err != nil {
+		t.Fatalf("ListByUserIDs() error = %v", err)
+	}
+	want := []*ddevice.Device{
+		newDevice(user1, "device-repo-token-2"),
+		newDevice(user2, "device-repo-token-3"),
+		newDevice(user2, "device-repo-token-1"),
+	}
+	if diff := cmp.Diff(want, got, deviceCmpOpts()...); diff != "" {
+		t.Fatalf("ListByUserIDs() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Deleting with another user's ID must not remove the device.
+	if err := repo.Delete(ctx, user1, "device-repo-token-3"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := repo.Delete(ctx, user1, "device-repo-token-2"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := repo.DeleteByTokens(ctx, []string{"device-repo-token-1", "unknown-token"}); err != nil {
+		t.Fatalf("DeleteByTokens() error = %v", err)
+	}
+
+	got, err = repo.ListByUserIDs(ctx, []string{user1, user2})
+	if err != nil {
+		t.Fatalf("ListByUserIDs() error = %v", err)
+	}
+	if diff := cmp.Diff(want[1:2], got, deviceCmpOpts()...); diff != "" {
+		t.Fatalf("ListByUserIDs() after delete mismatch (-want +got):\n%s", diff)
+	}
+}
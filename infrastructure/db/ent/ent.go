@@ -12,9 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/accesstoken"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/device"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notificationpreference"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			linkitem.Table: linkitem.ValidColumn,
-			page.Table:     page.ValidColumn,
-			user.Table:     user.ValidColumn,
+			accesstoken.Table:            accesstoken.ValidColumn,
+			comment.Table:                comment.ValidColumn,
+			device.Table:                 device.ValidColumn,
+			invitation.Table:             invitation.ValidColumn,
+			linkclick.Table:              linkclick.ValidColumn,
+			linkitem.Table:               linkitem.ValidColumn,
+			linkreaction.Table:           linkreaction.ValidColumn,
+			linksnapshot.Table:           linksnapshot.ValidColumn,
+			linkstate.Table:              linkstate.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			page.Table:                   page.ValidColumn,
+			pagepreference.Table:         pagepreference.ValidColumn,
+			pageslug.Table:               pageslug.ValidColumn,
+			section.Table:                section.ValidColumn,
+			template.Table:               template.ValidColumn,
+			templatelink.Table:           templatelink.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -7,10 +7,28 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	LinkItem         string // LinkItem table.
-	Page             string // Page table.
-	PageInvitedUsers string // Page-invited_users->User table.
-	User             string // User table.
+	AccessToken            string // AccessToken table.
+	Comment                string // Comment table.
+	Device                 string // Device table.
+	Invitation             string // Invitation table.
+	LinkClick              string // LinkClick table.
+	LinkItem               string // LinkItem table.
+	LinkReaction           string // LinkReaction table.
+	LinkSnapshot           string // LinkSnapshot table.
+	LinkState              string // LinkState table.
+	Notification           string // Notification table.
+	NotificationPreference string // NotificationPreference table.
+	Page                   string // Page table.
+	PageInvitedUsers       string // Page-invited_users->User table.
+	PagePreference         string // PagePreference table.
+	PageSlug               string // PageSlug table.
+	Section                string // Section table.
+	Template               string // Template table.
+	TemplateSharedUsers    string // Template-shared_users->User table.
+	TemplateLink           string // TemplateLink table.
+	User                   string // User table.
+	Webhook                string // Webhook table.
+	WebhookDelivery        string // WebhookDelivery table.
 }
 
 type schemaCtxKey struct{}
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+)
+
+// LinkClick is the model entity for the LinkClick schema.
+type LinkClick struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PageID holds the value of the "page_id" field.
+	PageID uuid.UUID `json:"page_id,omitempty"`
+	// LinkItemID holds the value of the "link_item_id" field.
+	LinkItemID uuid.UUID `json:"link_item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// Referrer holds the value of the "referrer" field.
+	Referrer string `json:"referrer,omitempty"`
+	// ClickedAt holds the value of the "clicked_at" field.
+	ClickedAt time.Time `json:"clicked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkClickQuery when eager-loading is set.
+	Edges        LinkClickEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkClickEdges holds the relations/edges for other nodes in the graph.
+type LinkClickEdges struct {
+	// LinkItem holds the value of the link_item edge.
+	LinkItem *LinkItem `json:"link_item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinkItemOrErr returns the LinkItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkClickEdges) LinkItemOrErr() (*LinkItem, error) {
+	if e.LinkItem != nil {
+		return e.LinkItem, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: linkitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "link_item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkClick) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkclick.FieldUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case linkclick.FieldReferrer:
+			values[i] = new(sql.NullString)
+		case linkclick.FieldClickedAt:
+			values[i] = new(sql.NullTime)
+		case linkclick.FieldID, linkclick.FieldPageID, linkclick.FieldLinkItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkClick fields.
+func (_m *LinkClick) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkclick.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linkclick.FieldPageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field page_id", values[i])
+			} else if value != nil {
+				_m.PageID = *value
+			}
+		case linkclick.FieldLinkItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field link_item_id", values[i])
+			} else if value != nil {
+				_m.LinkItemID = *value
+			}
+		case linkclick.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case linkclick.FieldReferrer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer", values[i])
+			} else if value.Valid {
+				_m.Referrer = value.String
+			}
+		case linkclick.FieldClickedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field clicked_at", values[i])
+			} else if value.Valid {
+				_m.ClickedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkClick.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkClick) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLinkItem queries the "link_item" edge of the LinkClick entity.
+func (_m *LinkClick) QueryLinkItem() *LinkItemQuery {
+	return NewLinkClickClient(_m.config).QueryLinkItem(_m)
+}
+
+// Update returns a builder for updating this LinkClick.
+// Note that you need to call LinkClick.Unwrap() before calling this method if this LinkClick
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkClick) Update() *LinkClickUpdateOne {
+	return NewLinkClickClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkClick entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkClick) Unwrap() *LinkClick {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkClick is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkClick) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkClick(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("page_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageID))
+	builder.WriteString(", ")
+	builder.WriteString("link_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkItemID))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("referrer=")
+	builder.WriteString(_m.Referrer)
+	builder.WriteString(", ")
+	builder.WriteString("clicked_at=")
+	builder.WriteString(_m.ClickedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkClicks is a parsable slice of LinkClick.
+type LinkClicks []*LinkClick
@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkclick
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the linkclick type in the database.
+	Label = "link_click"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPageID holds the string denoting the page_id field in the database.
+	FieldPageID = "page_id"
+	// FieldLinkItemID holds the string denoting the link_item_id field in the database.
+	FieldLinkItemID = "link_item_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldReferrer holds the string denoting the referrer field in the database.
+	FieldReferrer = "referrer"
+	// FieldClickedAt holds the string denoting the clicked_at field in the database.
+	FieldClickedAt = "clicked_at"
+	// EdgeLinkItem holds the string denoting the link_item edge name in mutations.
+	EdgeLinkItem = "link_item"
+	// Table holds the table name of the linkclick in the database.
+	Table = "link_clicks"
+	// LinkItemTable is the table that holds the link_item relation/edge.
+	LinkItemTable = "link_clicks"
+	// LinkItemInverseTable is the table name for the LinkItem entity.
+	// It exists in this package in order to avoid circular dependency with the "linkitem" package.
+	LinkItemInverseTable = "link_items"
+	// LinkItemColumn is the table column denoting the link_item relation/edge.
+	LinkItemColumn = "link_item_id"
+)
+
+// Columns holds all SQL columns for linkclick fields.
+var Columns = []string{
+	FieldID,
+	FieldPageID,
+	FieldLinkItemID,
+	FieldUserID,
+	FieldReferrer,
+	FieldClickedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReferrer holds the default value on creation for the "referrer" field.
+	DefaultReferrer string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LinkClick queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPageID orders the results by the page_id field.
+func ByPageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageID, opts...).ToFunc()
+}
+
+// ByLinkItemID orders the results by the link_item_id field.
+func ByLinkItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkItemID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByReferrer orders the results by the referrer field.
+func ByReferrer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrer, opts...).ToFunc()
+}
+
+// ByClickedAt orders the results by the clicked_at field.
+func ByClickedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickedAt, opts...).ToFunc()
+}
+
+// ByLinkItemField orders the results by link_item field.
+func ByLinkItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLinkItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkItemTable, LinkItemColumn),
+	)
+}
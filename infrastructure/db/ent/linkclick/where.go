@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkclick
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLTE(FieldID, id))
+}
+
+// PageID applies equality check predicate on the "page_id" field. It's identical to PageIDEQ.
+func PageID(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldPageID, v))
+}
+
+// LinkItemID applies equality check predicate on the "link_item_id" field. It's identical to LinkItemIDEQ.
+func LinkItemID(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldLinkItemID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldUserID, v))
+}
+
+// Referrer applies equality check predicate on the "referrer" field. It's identical to ReferrerEQ.
+func Referrer(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldReferrer, v))
+}
+
+// ClickedAt applies equality check predicate on the "clicked_at" field. It's identical to ClickedAtEQ.
+func ClickedAt(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// PageIDEQ applies the EQ predicate on the "page_id" field.
+func PageIDEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldPageID, v))
+}
+
+// PageIDNEQ applies the NEQ predicate on the "page_id" field.
+func PageIDNEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldPageID, v))
+}
+
+// PageIDIn applies the In predicate on the "page_id" field.
+func PageIDIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldPageID, vs...))
+}
+
+// PageIDNotIn applies the NotIn predicate on the "page_id" field.
+func PageIDNotIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldPageID, vs...))
+}
+
+// PageIDGT applies the GT predicate on the "page_id" field.
+func PageIDGT(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGT(FieldPageID, v))
+}
+
+// PageIDGTE applies the GTE predicate on the "page_id" field.
+func PageIDGTE(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGTE(FieldPageID, v))
+}
+
+// PageIDLT applies the LT predicate on the "page_id" field.
+func PageIDLT(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLT(FieldPageID, v))
+}
+
+// PageIDLTE applies the LTE predicate on the "page_id" field.
+func PageIDLTE(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLTE(FieldPageID, v))
+}
+
+// LinkItemIDEQ applies the EQ predicate on the "link_item_id" field.
+func LinkItemIDEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldLinkItemID, v))
+}
+
+// LinkItemIDNEQ applies the NEQ predicate on the "link_item_id" field.
+func LinkItemIDNEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldLinkItemID, v))
+}
+
+// LinkItemIDIn applies the In predicate on the "link_item_id" field.
+func LinkItemIDIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldLinkItemID, vs...))
+}
+
+// LinkItemIDNotIn applies the NotIn predicate on the "link_item_id" field.
+func LinkItemIDNotIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldLinkItemID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotNull(FieldUserID))
+}
+
+// ReferrerEQ applies the EQ predicate on the "referrer" field.
+func ReferrerEQ(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldReferrer, v))
+}
+
+// ReferrerNEQ applies the NEQ predicate on the "referrer" field.
+func ReferrerNEQ(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldReferrer, v))
+}
+
+// ReferrerIn applies the In predicate on the "referrer" field.
+func ReferrerIn(vs ...string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldReferrer, vs...))
+}
+
+// ReferrerNotIn applies the NotIn predicate on the "referrer" field.
+func ReferrerNotIn(vs ...string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldReferrer, vs...))
+}
+
+// ReferrerGT applies the GT predicate on the "referrer" field.
+func ReferrerGT(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGT(FieldReferrer, v))
+}
+
+// ReferrerGTE applies the GTE predicate on the "referrer" field.
+func ReferrerGTE(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGTE(FieldReferrer, v))
+}
+
+// ReferrerLT applies the LT predicate on the "referrer" field.
+func ReferrerLT(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLT(FieldReferrer, v))
+}
+
+// ReferrerLTE applies the LTE predicate on the "referrer" field.
+func ReferrerLTE(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLTE(FieldReferrer, v))
+}
+
+// ReferrerContains applies the Contains predicate on the "referrer" field.
+func ReferrerContains(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldContains(FieldReferrer, v))
+}
+
+// ReferrerHasPrefix applies the HasPrefix predicate on the "referrer" field.
+func ReferrerHasPrefix(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldHasPrefix(FieldReferrer, v))
+}
+
+// ReferrerHasSuffix applies the HasSuffix predicate on the "referrer" field.
+func ReferrerHasSuffix(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldHasSuffix(FieldReferrer, v))
+}
+
+// ReferrerEqualFold applies the EqualFold predicate on the "referrer" field.
+func ReferrerEqualFold(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEqualFold(FieldReferrer, v))
+}
+
+// ReferrerContainsFold applies the ContainsFold predicate on the "referrer" field.
+func ReferrerContainsFold(v string) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldContainsFold(FieldReferrer, v))
+}
+
+// ClickedAtEQ applies the EQ predicate on the "clicked_at" field.
+func ClickedAtEQ(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// ClickedAtNEQ applies the NEQ predicate on the "clicked_at" field.
+func ClickedAtNEQ(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNEQ(FieldClickedAt, v))
+}
+
+// ClickedAtIn applies the In predicate on the "clicked_at" field.
+func ClickedAtIn(vs ...time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtNotIn applies the NotIn predicate on the "clicked_at" field.
+func ClickedAtNotIn(vs ...time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldNotIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtGT applies the GT predicate on the "clicked_at" field.
+func ClickedAtGT(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGT(FieldClickedAt, v))
+}
+
+// ClickedAtGTE applies the GTE predicate on the "clicked_at" field.
+func ClickedAtGTE(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldGTE(FieldClickedAt, v))
+}
+
+// ClickedAtLT applies the LT predicate on the "clicked_at" field.
+func ClickedAtLT(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLT(FieldClickedAt, v))
+}
+
+// ClickedAtLTE applies the LTE predicate on the "clicked_at" field.
+func ClickedAtLTE(v time.Time) predicate.LinkClick {
+	return predicate.LinkClick(sql.FieldLTE(FieldClickedAt, v))
+}
+
+// HasLinkItem applies the HasEdge predicate on the "link_item" edge.
+func HasLinkItem() predicate.LinkClick {
+	return predicate.LinkClick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkItemTable, LinkItemColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkItem
+		step.Edge.Schema = schemaConfig.LinkClick
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkItemWith applies the HasEdge predicate on the "link_item" edge with a given conditions (other predicates).
+func HasLinkItemWith(preds ...predicate.LinkItem) predicate.LinkClick {
+	return predicate.LinkClick(func(s *sql.Selector) {
+		step := newLinkItemStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkItem
+		step.Edge.Schema = schemaConfig.LinkClick
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkClick) predicate.LinkClick {
+	return predicate.LinkClick(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkClick) predicate.LinkClick {
+	return predicate.LinkClick(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkClick) predicate.LinkClick {
+	return predicate.LinkClick(sql.NotPredicates(p))
+}
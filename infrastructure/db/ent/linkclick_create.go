@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+)
+
+// LinkClickCreate is the builder for creating a LinkClick entity.
+type LinkClickCreate struct {
+	config
+	mutation *LinkClickMutation
+	hooks    []Hook
+}
+
+// SetPageID sets the "page_id" field.
+func (_c *LinkClickCreate) SetPageID(v uuid.UUID) *LinkClickCreate {
+	_c.mutation.SetPageID(v)
+	return _c
+}
+
+// SetLinkItemID sets the "link_item_id" field.
+func (_c *LinkClickCreate) SetLinkItemID(v uuid.UUID) *LinkClickCreate {
+	_c.mutation.SetLinkItemID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LinkClickCreate) SetUserID(v uuid.UUID) *LinkClickCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LinkClickCreate) SetNillableUserID(v *uuid.UUID) *LinkClickCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetReferrer sets the "referrer" field.
+func (_c *LinkClickCreate) SetReferrer(v string) *LinkClickCreate {
+	_c.mutation.SetReferrer(v)
+	return _c
+}
+
+// SetNillableReferrer sets the "referrer" field if the given value is not nil.
+func (_c *LinkClickCreate) SetNillableReferrer(v *string) *LinkClickCreate {
+	if v != nil {
+		_c.SetReferrer(*v)
+	}
+	return _c
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_c *LinkClickCreate) SetClickedAt(v time.Time) *LinkClickCreate {
+	_c.mutation.SetClickedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkClickCreate) SetID(v uuid.UUID) *LinkClickCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkClickCreate) SetNillableID(v *uuid.UUID) *LinkClickCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLinkItem sets the "link_item" edge to the LinkItem entity.
+func (_c *LinkClickCreate) SetLinkItem(v *LinkItem) *LinkClickCreate {
+	return _c.SetLinkItemID(v.ID)
+}
+
+// Mutation returns the LinkClickMutation object of the builder.
+func (_c *LinkClickCreate) Mutation() *LinkClickMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkClick in the database.
+func (_c *LinkClickCreate) Save(ctx context.Context) (*LinkClick, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkClickCreate) SaveX(ctx context.Context) *LinkClick {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkClickCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkClickCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkClickCreate) defaults() {
+	if _, ok := _c.mutation.Referrer(); !ok {
+		v := linkclick.DefaultReferrer
+		_c.mutation.SetReferrer(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkclick.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkClickCreate) check() error {
+	if _, ok := _c.mutation.PageID(); !ok {
+		return &ValidationError{Name: "page_id", err: errors.New(`ent: missing required field "LinkClick.page_id"`)}
+	}
+	if _, ok := _c.mutation.LinkItemID(); !ok {
+		return &ValidationError{Name: "link_item_id", err: errors.New(`ent: missing required field "LinkClick.link_item_id"`)}
+	}
+	if _, ok := _c.mutation.Referrer(); !ok {
+		return &ValidationError{Name: "referrer", err: errors.New(`ent: missing required field "LinkClick.referrer"`)}
+	}
+	if _, ok := _c.mutation.ClickedAt(); !ok {
+		return &ValidationError{Name: "clicked_at", err: errors.New(`ent: missing required field "LinkClick.clicked_at"`)}
+	}
+	if len(_c.mutation.LinkItemIDs()) == 0 {
+		return &ValidationError{Name: "link_item", err: errors.New(`ent: missing required edge "LinkClick.link_item"`)}
+	}
+	return nil
+}
+
+func (_c *LinkClickCreate) sqlSave(ctx context.Context) (*LinkClick, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkClickCreate) createSpec() (*LinkClick, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkClick{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkclick.Table, sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.LinkClick
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PageID(); ok {
+		_spec.SetField(linkclick.FieldPageID, field.TypeUUID, value)
+		_node.PageID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(linkclick.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Referrer(); ok {
+		_spec.SetField(linkclick.FieldReferrer, field.TypeString, value)
+		_node.Referrer = value
+	}
+	if value, ok := _c.mutation.ClickedAt(); ok {
+		_spec.SetField(linkclick.FieldClickedAt, field.TypeTime, value)
+		_node.ClickedAt = value
+	}
+	if nodes := _c.mutation.LinkItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkclick.LinkItemTable,
+			Columns: []string{linkclick.LinkItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LinkItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LinkClickCreateBulk is the builder for creating many LinkClick entities in bulk.
+type LinkClickCreateBulk struct {
+	config
+	err      error
+	builders []*LinkClickCreate
+}
+
+// Save creates the LinkClick entities in the database.
+func (_c *LinkClickCreateBulk) Save(ctx context.Context) ([]*LinkClick, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkClick, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkClickMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkClickCreateBulk) SaveX(ctx context.Context) []*LinkClick {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkClickCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkClickCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
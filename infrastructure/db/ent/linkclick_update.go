@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// LinkClickUpdate is the builder for updating LinkClick entities.
+type LinkClickUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkClickMutation
+}
+
+// Where appends a list predicates to the LinkClickUpdate builder.
+func (_u *LinkClickUpdate) Where(ps ...predicate.LinkClick) *LinkClickUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the LinkClickMutation object of the builder.
+func (_u *LinkClickUpdate) Mutation() *LinkClickMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkClickUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkClickUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkClickUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkClickUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkClickUpdate) check() error {
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkClick.link_item"`)
+	}
+	return nil
+}
+
+func (_u *LinkClickUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkclick.Table, linkclick.Columns, sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(linkclick.FieldUserID, field.TypeUUID)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkClick
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkclick.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkClickUpdateOne is the builder for updating a single LinkClick entity.
+type LinkClickUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkClickMutation
+}
+
+// Mutation returns the LinkClickMutation object of the builder.
+func (_u *LinkClickUpdateOne) Mutation() *LinkClickMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkClickUpdate builder.
+func (_u *LinkClickUpdateOne) Where(ps ...predicate.LinkClick) *LinkClickUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkClickUpdateOne) Select(field string, fields ...string) *LinkClickUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkClick entity.
+func (_u *LinkClickUpdateOne) Save(ctx context.Context) (*LinkClick, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkClickUpdateOne) SaveX(ctx context.Context) *LinkClick {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkClickUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkClickUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkClickUpdateOne) check() error {
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkClick.link_item"`)
+	}
+	return nil
+}
+
+func (_u *LinkClickUpdateOne) sqlSave(ctx context.Context) (_node *LinkClick, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkclick.Table, linkclick.Columns, sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkClick.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkclick.FieldID)
+		for _, f := range fields {
+			if !linkclick.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkclick.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(linkclick.FieldUserID, field.TypeUUID)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkClick
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &LinkClick{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkclick.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
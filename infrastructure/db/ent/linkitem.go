@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 )
 
 // LinkItem is the model entity for the LinkItem schema.
@@ -31,6 +32,18 @@ type LinkItem struct {
 	Memo *string `json:"memo,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority int `json:"priority,omitempty"`
+	// SectionID holds the value of the "section_id" field.
+	SectionID *uuid.UUID `json:"section_id,omitempty"`
+	// DoneByID holds the value of the "done_by_id" field.
+	DoneByID *uuid.UUID `json:"done_by_id,omitempty"`
+	// DoneAt holds the value of the "done_at" field.
+	DoneAt *time.Time `json:"done_at,omitempty"`
+	// CheckStatus holds the value of the "check_status" field.
+	CheckStatus *int `json:"check_status,omitempty"`
+	// RedirectURL holds the value of the "redirect_url" field.
+	RedirectURL *string `json:"redirect_url,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LinkItemQuery when eager-loading is set.
 	Edges        LinkItemEdges `json:"edges"`
@@ -41,9 +54,21 @@ type LinkItem struct {
 type LinkItemEdges struct {
 	// Page holds the value of the page edge.
 	Page *Page `json:"page,omitempty"`
+	// Section holds the value of the section edge.
+	Section *Section `json:"section,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*LinkReaction `json:"reactions,omitempty"`
+	// States holds the value of the states edge.
+	States []*LinkState `json:"states,omitempty"`
+	// Snapshots holds the value of the snapshots edge.
+	Snapshots []*LinkSnapshot `json:"snapshots,omitempty"`
+	// Clicks holds the value of the clicks edge.
+	Clicks []*LinkClick `json:"clicks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // PageOrErr returns the Page value or an error if the edge
@@ -57,16 +82,74 @@ func (e LinkItemEdges) PageOrErr() (*Page, error) {
 	return nil, &NotLoadedError{edge: "page"}
 }
 
+// SectionOrErr returns the Section value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkItemEdges) SectionOrErr() (*Section, error) {
+	if e.Section != nil {
+		return e.Section, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: section.Label}
+	}
+	return nil, &NotLoadedError{edge: "section"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkItemEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[2] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkItemEdges) ReactionsOrErr() ([]*LinkReaction, error) {
+	if e.loadedTypes[3] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// StatesOrErr returns the States value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkItemEdges) StatesOrErr() ([]*LinkState, error) {
+	if e.loadedTypes[4] {
+		return e.States, nil
+	}
+	return nil, &NotLoadedError{edge: "states"}
+}
+
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkItemEdges) SnapshotsOrErr() ([]*LinkSnapshot, error) {
+	if e.loadedTypes[5] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// ClicksOrErr returns the Clicks value or an error if the edge
+// was not loaded in eager-loading.
+func (e LinkItemEdges) ClicksOrErr() ([]*LinkClick, error) {
+	if e.loadedTypes[6] {
+		return e.Clicks, nil
+	}
+	return nil, &NotLoadedError{edge: "clicks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LinkItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case linkitem.FieldPriority:
+		case linkitem.FieldSectionID, linkitem.FieldDoneByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case linkitem.FieldPriority, linkitem.FieldCheckStatus:
 			values[i] = new(sql.NullInt64)
-		case linkitem.FieldURL, linkitem.FieldMemo:
+		case linkitem.FieldURL, linkitem.FieldMemo, linkitem.FieldRedirectURL:
 			values[i] = new(sql.NullString)
-		case linkitem.FieldCreatedAt, linkitem.FieldUpdatedAt:
+		case linkitem.FieldCreatedAt, linkitem.FieldUpdatedAt, linkitem.FieldDoneAt, linkitem.FieldCheckedAt:
 			values[i] = new(sql.NullTime)
 		case linkitem.FieldID, linkitem.FieldPageID:
 			values[i] = new(uuid.UUID)
@@ -128,6 +211,48 @@ func (_m *LinkItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Priority = int(value.Int64)
 			}
+		case linkitem.FieldSectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field section_id", values[i])
+			} else if value.Valid {
+				_m.SectionID = new(uuid.UUID)
+				*_m.SectionID = *value.S.(*uuid.UUID)
+			}
+		case linkitem.FieldDoneByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field done_by_id", values[i])
+			} else if value.Valid {
+				_m.DoneByID = new(uuid.UUID)
+				*_m.DoneByID = *value.S.(*uuid.UUID)
+			}
+		case linkitem.FieldDoneAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field done_at", values[i])
+			} else if value.Valid {
+				_m.DoneAt = new(time.Time)
+				*_m.DoneAt = value.Time
+			}
+		case linkitem.FieldCheckStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_status", values[i])
+			} else if value.Valid {
+				_m.CheckStatus = new(int)
+				*_m.CheckStatus = int(value.Int64)
+			}
+		case linkitem.FieldRedirectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_url", values[i])
+			} else if value.Valid {
+				_m.RedirectURL = new(string)
+				*_m.RedirectURL = value.String
+			}
+		case linkitem.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				_m.CheckedAt = new(time.Time)
+				*_m.CheckedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -146,6 +271,36 @@ func (_m *LinkItem) QueryPage() *PageQuery {
 	return NewLinkItemClient(_m.config).QueryPage(_m)
 }
 
+// QuerySection queries the "section" edge of the LinkItem entity.
+func (_m *LinkItem) QuerySection() *SectionQuery {
+	return NewLinkItemClient(_m.config).QuerySection(_m)
+}
+
+// QueryComments queries the "comments" edge of the LinkItem entity.
+func (_m *LinkItem) QueryComments() *CommentQuery {
+	return NewLinkItemClient(_m.config).QueryComments(_m)
+}
+
+// QueryReactions queries the "reactions" edge of the LinkItem entity.
+func (_m *LinkItem) QueryReactions() *LinkReactionQuery {
+	return NewLinkItemClient(_m.config).QueryReactions(_m)
+}
+
+// QueryStates queries the "states" edge of the LinkItem entity.
+func (_m *LinkItem) QueryStates() *LinkStateQuery {
+	return NewLinkItemClient(_m.config).QueryStates(_m)
+}
+
+// QuerySnapshots queries the "snapshots" edge of the LinkItem entity.
+func (_m *LinkItem) QuerySnapshots() *LinkSnapshotQuery {
+	return NewLinkItemClient(_m.config).QuerySnapshots(_m)
+}
+
+// QueryClicks queries the "clicks" edge of the LinkItem entity.
+func (_m *LinkItem) QueryClicks() *LinkClickQuery {
+	return NewLinkItemClient(_m.config).QueryClicks(_m)
+}
+
 // Update returns a builder for updating this LinkItem.
 // Note that you need to call LinkItem.Unwrap() before calling this method if this LinkItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -188,6 +343,36 @@ func (_m *LinkItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	if v := _m.SectionID; v != nil {
+		builder.WriteString("section_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DoneByID; v != nil {
+		builder.WriteString("done_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DoneAt; v != nil {
+		builder.WriteString("done_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CheckStatus; v != nil {
+		builder.WriteString("check_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RedirectURL; v != nil {
+		builder.WriteString("redirect_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CheckedAt; v != nil {
+		builder.WriteString("checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -27,8 +27,32 @@ const (
 	FieldMemo = "memo"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
+	// FieldSectionID holds the string denoting the section_id field in the database.
+	FieldSectionID = "section_id"
+	// FieldDoneByID holds the string denoting the done_by_id field in the database.
+	FieldDoneByID = "done_by_id"
+	// FieldDoneAt holds the string denoting the done_at field in the database.
+	FieldDoneAt = "done_at"
+	// FieldCheckStatus holds the string denoting the check_status field in the database.
+	FieldCheckStatus = "check_status"
+	// FieldRedirectURL holds the string denoting the redirect_url field in the database.
+	FieldRedirectURL = "redirect_url"
+	// FieldCheckedAt holds the string denoting the checked_at field in the database.
+	FieldCheckedAt = "checked_at"
 	// EdgePage holds the string denoting the page edge name in mutations.
 	EdgePage = "page"
+	// EdgeSection holds the string denoting the section edge name in mutations.
+	EdgeSection = "section"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
+	// EdgeStates holds the string denoting the states edge name in mutations.
+	EdgeStates = "states"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// EdgeClicks holds the string denoting the clicks edge name in mutations.
+	EdgeClicks = "clicks"
 	// Table holds the table name of the linkitem in the database.
 	Table = "link_items"
 	// PageTable is the table that holds the page relation/edge.
@@ -38,6 +62,48 @@ const (
 	PageInverseTable = "pages"
 	// PageColumn is the table column denoting the page relation/edge.
 	PageColumn = "page_id"
+	// SectionTable is the table that holds the section relation/edge.
+	SectionTable = "link_items"
+	// SectionInverseTable is the table name for the Section entity.
+	// It exists in this package in order to avoid circular dependency with the "section" package.
+	SectionInverseTable = "page_sections"
+	// SectionColumn is the table column denoting the section relation/edge.
+	SectionColumn = "section_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "link_comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "link_comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "link_item_id"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "link_reactions"
+	// ReactionsInverseTable is the table name for the LinkReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "linkreaction" package.
+	ReactionsInverseTable = "link_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "link_item_id"
+	// StatesTable is the table that holds the states relation/edge.
+	StatesTable = "link_states"
+	// StatesInverseTable is the table name for the LinkState entity.
+	// It exists in this package in order to avoid circular dependency with the "linkstate" package.
+	StatesInverseTable = "link_states"
+	// StatesColumn is the table column denoting the states relation/edge.
+	StatesColumn = "link_item_id"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "link_snapshots"
+	// SnapshotsInverseTable is the table name for the LinkSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "linksnapshot" package.
+	SnapshotsInverseTable = "link_snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "link_item_id"
+	// ClicksTable is the table that holds the clicks relation/edge.
+	ClicksTable = "link_clicks"
+	// ClicksInverseTable is the table name for the LinkClick entity.
+	// It exists in this package in order to avoid circular dependency with the "linkclick" package.
+	ClicksInverseTable = "link_clicks"
+	// ClicksColumn is the table column denoting the clicks relation/edge.
+	ClicksColumn = "link_item_id"
 )
 
 // Columns holds all SQL columns for linkitem fields.
@@ -49,6 +115,12 @@ var Columns = []string{
 	FieldURL,
 	FieldMemo,
 	FieldPriority,
+	FieldSectionID,
+	FieldDoneByID,
+	FieldDoneAt,
+	FieldCheckStatus,
+	FieldRedirectURL,
+	FieldCheckedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -114,12 +186,119 @@ func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
 }
 
+// BySectionID orders the results by the section_id field.
+func BySectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSectionID, opts...).ToFunc()
+}
+
+// ByDoneByID orders the results by the done_by_id field.
+func ByDoneByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDoneByID, opts...).ToFunc()
+}
+
+// ByDoneAt orders the results by the done_at field.
+func ByDoneAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDoneAt, opts...).ToFunc()
+}
+
+// ByCheckStatus orders the results by the check_status field.
+func ByCheckStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckStatus, opts...).ToFunc()
+}
+
+// ByRedirectURL orders the results by the redirect_url field.
+func ByRedirectURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedirectURL, opts...).ToFunc()
+}
+
+// ByCheckedAt orders the results by the checked_at field.
+func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
+}
+
 // ByPageField orders the results by page field.
 func ByPageField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newPageStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySectionField orders the results by section field.
+func BySectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatesCount orders the results by states count.
+func ByStatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatesStep(), opts...)
+	}
+}
+
+// ByStates orders the results by states terms.
+func ByStates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByClicksCount orders the results by clicks count.
+func ByClicksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClicksStep(), opts...)
+	}
+}
+
+// ByClicks orders the results by clicks terms.
+func ByClicks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClicksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPageStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -127,3 +306,45 @@ func newPageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, PageTable, PageColumn),
 	)
 }
+func newSectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+	)
+}
+func newStatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatesTable, StatesColumn),
+	)
+}
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
+func newClicksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClicksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClicksTable, ClicksColumn),
+	)
+}
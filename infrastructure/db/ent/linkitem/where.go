@@ -87,6 +87,36 @@ func Priority(v int) predicate.LinkItem {
 	return predicate.LinkItem(sql.FieldEQ(FieldPriority, v))
 }
 
+// SectionID applies equality check predicate on the "section_id" field. It's identical to SectionIDEQ.
+func SectionID(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldSectionID, v))
+}
+
+// DoneByID applies equality check predicate on the "done_by_id" field. It's identical to DoneByIDEQ.
+func DoneByID(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldDoneByID, v))
+}
+
+// DoneAt applies equality check predicate on the "done_at" field. It's identical to DoneAtEQ.
+func DoneAt(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// CheckStatus applies equality check predicate on the "check_status" field. It's identical to CheckStatusEQ.
+func CheckStatus(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldCheckStatus, v))
+}
+
+// RedirectURL applies equality check predicate on the "redirect_url" field. It's identical to RedirectURLEQ.
+func RedirectURL(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldCheckedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LinkItem {
 	return predicate.LinkItem(sql.FieldEQ(FieldCreatedAt, v))
@@ -367,6 +397,311 @@ func PriorityLTE(v int) predicate.LinkItem {
 	return predicate.LinkItem(sql.FieldLTE(FieldPriority, v))
 }
 
+// SectionIDEQ applies the EQ predicate on the "section_id" field.
+func SectionIDEQ(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldSectionID, v))
+}
+
+// SectionIDNEQ applies the NEQ predicate on the "section_id" field.
+func SectionIDNEQ(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldSectionID, v))
+}
+
+// SectionIDIn applies the In predicate on the "section_id" field.
+func SectionIDIn(vs ...uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldSectionID, vs...))
+}
+
+// SectionIDNotIn applies the NotIn predicate on the "section_id" field.
+func SectionIDNotIn(vs ...uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldSectionID, vs...))
+}
+
+// SectionIDIsNil applies the IsNil predicate on the "section_id" field.
+func SectionIDIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldSectionID))
+}
+
+// SectionIDNotNil applies the NotNil predicate on the "section_id" field.
+func SectionIDNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldSectionID))
+}
+
+// DoneByIDEQ applies the EQ predicate on the "done_by_id" field.
+func DoneByIDEQ(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldDoneByID, v))
+}
+
+// DoneByIDNEQ applies the NEQ predicate on the "done_by_id" field.
+func DoneByIDNEQ(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldDoneByID, v))
+}
+
+// DoneByIDIn applies the In predicate on the "done_by_id" field.
+func DoneByIDIn(vs ...uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldDoneByID, vs...))
+}
+
+// DoneByIDNotIn applies the NotIn predicate on the "done_by_id" field.
+func DoneByIDNotIn(vs ...uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldDoneByID, vs...))
+}
+
+// DoneByIDGT applies the GT predicate on the "done_by_id" field.
+func DoneByIDGT(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGT(FieldDoneByID, v))
+}
+
+// DoneByIDGTE applies the GTE predicate on the "done_by_id" field.
+func DoneByIDGTE(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGTE(FieldDoneByID, v))
+}
+
+// DoneByIDLT applies the LT predicate on the "done_by_id" field.
+func DoneByIDLT(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLT(FieldDoneByID, v))
+}
+
+// DoneByIDLTE applies the LTE predicate on the "done_by_id" field.
+func DoneByIDLTE(v uuid.UUID) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLTE(FieldDoneByID, v))
+}
+
+// DoneByIDIsNil applies the IsNil predicate on the "done_by_id" field.
+func DoneByIDIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldDoneByID))
+}
+
+// DoneByIDNotNil applies the NotNil predicate on the "done_by_id" field.
+func DoneByIDNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldDoneByID))
+}
+
+// DoneAtEQ applies the EQ predicate on the "done_at" field.
+func DoneAtEQ(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// DoneAtNEQ applies the NEQ predicate on the "done_at" field.
+func DoneAtNEQ(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldDoneAt, v))
+}
+
+// DoneAtIn applies the In predicate on the "done_at" field.
+func DoneAtIn(vs ...time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldDoneAt, vs...))
+}
+
+// DoneAtNotIn applies the NotIn predicate on the "done_at" field.
+func DoneAtNotIn(vs ...time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldDoneAt, vs...))
+}
+
+// DoneAtGT applies the GT predicate on the "done_at" field.
+func DoneAtGT(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGT(FieldDoneAt, v))
+}
+
+// DoneAtGTE applies the GTE predicate on the "done_at" field.
+func DoneAtGTE(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGTE(FieldDoneAt, v))
+}
+
+// DoneAtLT applies the LT predicate on the "done_at" field.
+func DoneAtLT(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLT(FieldDoneAt, v))
+}
+
+// DoneAtLTE applies the LTE predicate on the "done_at" field.
+func DoneAtLTE(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLTE(FieldDoneAt, v))
+}
+
+// DoneAtIsNil applies the IsNil predicate on the "done_at" field.
+func DoneAtIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldDoneAt))
+}
+
+// DoneAtNotNil applies the NotNil predicate on the "done_at" field.
+func DoneAtNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldDoneAt))
+}
+
+// CheckStatusEQ applies the EQ predicate on the "check_status" field.
+func CheckStatusEQ(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldCheckStatus, v))
+}
+
+// CheckStatusNEQ applies the NEQ predicate on the "check_status" field.
+func CheckStatusNEQ(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldCheckStatus, v))
+}
+
+// CheckStatusIn applies the In predicate on the "check_status" field.
+func CheckStatusIn(vs ...int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldCheckStatus, vs...))
+}
+
+// CheckStatusNotIn applies the NotIn predicate on the "check_status" field.
+func CheckStatusNotIn(vs ...int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldCheckStatus, vs...))
+}
+
+// CheckStatusGT applies the GT predicate on the "check_status" field.
+func CheckStatusGT(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGT(FieldCheckStatus, v))
+}
+
+// CheckStatusGTE applies the GTE predicate on the "check_status" field.
+func CheckStatusGTE(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGTE(FieldCheckStatus, v))
+}
+
+// CheckStatusLT applies the LT predicate on the "check_status" field.
+func CheckStatusLT(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLT(FieldCheckStatus, v))
+}
+
+// CheckStatusLTE applies the LTE predicate on the "check_status" field.
+func CheckStatusLTE(v int) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLTE(FieldCheckStatus, v))
+}
+
+// CheckStatusIsNil applies the IsNil predicate on the "check_status" field.
+func CheckStatusIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldCheckStatus))
+}
+
+// CheckStatusNotNil applies the NotNil predicate on the "check_status" field.
+func CheckStatusNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldCheckStatus))
+}
+
+// RedirectURLEQ applies the EQ predicate on the "redirect_url" field.
+func RedirectURLEQ(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLNEQ applies the NEQ predicate on the "redirect_url" field.
+func RedirectURLNEQ(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLIn applies the In predicate on the "redirect_url" field.
+func RedirectURLIn(vs ...string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLNotIn applies the NotIn predicate on the "redirect_url" field.
+func RedirectURLNotIn(vs ...string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLGT applies the GT predicate on the "redirect_url" field.
+func RedirectURLGT(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGT(FieldRedirectURL, v))
+}
+
+// RedirectURLGTE applies the GTE predicate on the "redirect_url" field.
+func RedirectURLGTE(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGTE(FieldRedirectURL, v))
+}
+
+// RedirectURLLT applies the LT predicate on the "redirect_url" field.
+func RedirectURLLT(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLT(FieldRedirectURL, v))
+}
+
+// RedirectURLLTE applies the LTE predicate on the "redirect_url" field.
+func RedirectURLLTE(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLTE(FieldRedirectURL, v))
+}
+
+// RedirectURLContains applies the Contains predicate on the "redirect_url" field.
+func RedirectURLContains(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldContains(FieldRedirectURL, v))
+}
+
+// RedirectURLHasPrefix applies the HasPrefix predicate on the "redirect_url" field.
+func RedirectURLHasPrefix(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldHasPrefix(FieldRedirectURL, v))
+}
+
+// RedirectURLHasSuffix applies the HasSuffix predicate on the "redirect_url" field.
+func RedirectURLHasSuffix(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldHasSuffix(FieldRedirectURL, v))
+}
+
+// RedirectURLIsNil applies the IsNil predicate on the "redirect_url" field.
+func RedirectURLIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldRedirectURL))
+}
+
+// RedirectURLNotNil applies the NotNil predicate on the "redirect_url" field.
+func RedirectURLNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldRedirectURL))
+}
+
+// RedirectURLEqualFold applies the EqualFold predicate on the "redirect_url" field.
+func RedirectURLEqualFold(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEqualFold(FieldRedirectURL, v))
+}
+
+// RedirectURLContainsFold applies the ContainsFold predicate on the "redirect_url" field.
+func RedirectURLContainsFold(v string) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldContainsFold(FieldRedirectURL, v))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// CheckedAtIsNil applies the IsNil predicate on the "checked_at" field.
+func CheckedAtIsNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldIsNull(FieldCheckedAt))
+}
+
+// CheckedAtNotNil applies the NotNil predicate on the "checked_at" field.
+func CheckedAtNotNil() predicate.LinkItem {
+	return predicate.LinkItem(sql.FieldNotNull(FieldCheckedAt))
+}
+
 // HasPage applies the HasEdge predicate on the "page" edge.
 func HasPage() predicate.LinkItem {
 	return predicate.LinkItem(func(s *sql.Selector) {
@@ -396,6 +731,180 @@ func HasPageWith(preds ...predicate.Page) predicate.LinkItem {
 	})
 }
 
+// HasSection applies the HasEdge predicate on the "section" edge.
+func HasSection() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.LinkItem
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionWith applies the HasEdge predicate on the "section" edge with a given conditions (other predicates).
+func HasSectionWith(preds ...predicate.Section) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newSectionStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.LinkItem
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Comment
+		step.Edge.Schema = schemaConfig.Comment
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newCommentsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Comment
+		step.Edge.Schema = schemaConfig.Comment
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkReaction
+		step.Edge.Schema = schemaConfig.LinkReaction
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.LinkReaction) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newReactionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkReaction
+		step.Edge.Schema = schemaConfig.LinkReaction
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStates applies the HasEdge predicate on the "states" edge.
+func HasStates() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatesTable, StatesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkState
+		step.Edge.Schema = schemaConfig.LinkState
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatesWith applies the HasEdge predicate on the "states" edge with a given conditions (other predicates).
+func HasStatesWith(preds ...predicate.LinkState) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newStatesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkState
+		step.Edge.Schema = schemaConfig.LinkState
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkSnapshot
+		step.Edge.Schema = schemaConfig.LinkSnapshot
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.LinkSnapshot) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkSnapshot
+		step.Edge.Schema = schemaConfig.LinkSnapshot
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasClicks applies the HasEdge predicate on the "clicks" edge.
+func HasClicks() predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClicksTable, ClicksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkClick
+		step.Edge.Schema = schemaConfig.LinkClick
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClicksWith applies the HasEdge predicate on the "clicks" edge with a given conditions (other predicates).
+func HasClicksWith(preds ...predicate.LinkClick) predicate.LinkItem {
+	return predicate.LinkItem(func(s *sql.Selector) {
+		step := newClicksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkClick
+		step.Edge.Schema = schemaConfig.LinkClick
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LinkItem) predicate.LinkItem {
 	return predicate.LinkItem(sql.AndPredicates(predicates...))
@@ -11,8 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 )
 
 // LinkItemCreate is the builder for creating a LinkItem entity.
@@ -90,6 +96,90 @@ func (_c *LinkItemCreate) SetNillablePriority(v *int) *LinkItemCreate {
 	return _c
 }
 
+// SetSectionID sets the "section_id" field.
+func (_c *LinkItemCreate) SetSectionID(v uuid.UUID) *LinkItemCreate {
+	_c.mutation.SetSectionID(v)
+	return _c
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableSectionID(v *uuid.UUID) *LinkItemCreate {
+	if v != nil {
+		_c.SetSectionID(*v)
+	}
+	return _c
+}
+
+// SetDoneByID sets the "done_by_id" field.
+func (_c *LinkItemCreate) SetDoneByID(v uuid.UUID) *LinkItemCreate {
+	_c.mutation.SetDoneByID(v)
+	return _c
+}
+
+// SetNillableDoneByID sets the "done_by_id" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableDoneByID(v *uuid.UUID) *LinkItemCreate {
+	if v != nil {
+		_c.SetDoneByID(*v)
+	}
+	return _c
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_c *LinkItemCreate) SetDoneAt(v time.Time) *LinkItemCreate {
+	_c.mutation.SetDoneAt(v)
+	return _c
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableDoneAt(v *time.Time) *LinkItemCreate {
+	if v != nil {
+		_c.SetDoneAt(*v)
+	}
+	return _c
+}
+
+// SetCheckStatus sets the "check_status" field.
+func (_c *LinkItemCreate) SetCheckStatus(v int) *LinkItemCreate {
+	_c.mutation.SetCheckStatus(v)
+	return _c
+}
+
+// SetNillableCheckStatus sets the "check_status" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableCheckStatus(v *int) *LinkItemCreate {
+	if v != nil {
+		_c.SetCheckStatus(*v)
+	}
+	return _c
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_c *LinkItemCreate) SetRedirectURL(v string) *LinkItemCreate {
+	_c.mutation.SetRedirectURL(v)
+	return _c
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableRedirectURL(v *string) *LinkItemCreate {
+	if v != nil {
+		_c.SetRedirectURL(*v)
+	}
+	return _c
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_c *LinkItemCreate) SetCheckedAt(v time.Time) *LinkItemCreate {
+	_c.mutation.SetCheckedAt(v)
+	return _c
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_c *LinkItemCreate) SetNillableCheckedAt(v *time.Time) *LinkItemCreate {
+	if v != nil {
+		_c.SetCheckedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LinkItemCreate) SetID(v uuid.UUID) *LinkItemCreate {
 	_c.mutation.SetID(v)
@@ -109,6 +199,86 @@ func (_c *LinkItemCreate) SetPage(v *Page) *LinkItemCreate {
 	return _c.SetPageID(v.ID)
 }
 
+// SetSection sets the "section" edge to the Section entity.
+func (_c *LinkItemCreate) SetSection(v *Section) *LinkItemCreate {
+	return _c.SetSectionID(v.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *LinkItemCreate) AddCommentIDs(ids ...uuid.UUID) *LinkItemCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *LinkItemCreate) AddComments(v ...*Comment) *LinkItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the LinkReaction entity by IDs.
+func (_c *LinkItemCreate) AddReactionIDs(ids ...uuid.UUID) *LinkItemCreate {
+	_c.mutation.AddReactionIDs(ids...)
+	return _c
+}
+
+// AddReactions adds the "reactions" edges to the LinkReaction entity.
+func (_c *LinkItemCreate) AddReactions(v ...*LinkReaction) *LinkItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReactionIDs(ids...)
+}
+
+// AddStateIDs adds the "states" edge to the LinkState entity by IDs.
+func (_c *LinkItemCreate) AddStateIDs(ids ...uuid.UUID) *LinkItemCreate {
+	_c.mutation.AddStateIDs(ids...)
+	return _c
+}
+
+// AddStates adds the "states" edges to the LinkState entity.
+func (_c *LinkItemCreate) AddStates(v ...*LinkState) *LinkItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStateIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the LinkSnapshot entity by IDs.
+func (_c *LinkItemCreate) AddSnapshotIDs(ids ...uuid.UUID) *LinkItemCreate {
+	_c.mutation.AddSnapshotIDs(ids...)
+	return _c
+}
+
+// AddSnapshots adds the "snapshots" edges to the LinkSnapshot entity.
+func (_c *LinkItemCreate) AddSnapshots(v ...*LinkSnapshot) *LinkItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSnapshotIDs(ids...)
+}
+
+// AddClickIDs adds the "clicks" edge to the LinkClick entity by IDs.
+func (_c *LinkItemCreate) AddClickIDs(ids ...uuid.UUID) *LinkItemCreate {
+	_c.mutation.AddClickIDs(ids...)
+	return _c
+}
+
+// AddClicks adds the "clicks" edges to the LinkClick entity.
+func (_c *LinkItemCreate) AddClicks(v ...*LinkClick) *LinkItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddClickIDs(ids...)
+}
+
 // Mutation returns the LinkItemMutation object of the builder.
 func (_c *LinkItemCreate) Mutation() *LinkItemMutation {
 	return _c.mutation
@@ -243,6 +413,26 @@ func (_c *LinkItemCreate) createSpec() (*LinkItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(linkitem.FieldPriority, field.TypeInt, value)
 		_node.Priority = value
 	}
+	if value, ok := _c.mutation.DoneByID(); ok {
+		_spec.SetField(linkitem.FieldDoneByID, field.TypeUUID, value)
+		_node.DoneByID = &value
+	}
+	if value, ok := _c.mutation.DoneAt(); ok {
+		_spec.SetField(linkitem.FieldDoneAt, field.TypeTime, value)
+		_node.DoneAt = &value
+	}
+	if value, ok := _c.mutation.CheckStatus(); ok {
+		_spec.SetField(linkitem.FieldCheckStatus, field.TypeInt, value)
+		_node.CheckStatus = &value
+	}
+	if value, ok := _c.mutation.RedirectURL(); ok {
+		_spec.SetField(linkitem.FieldRedirectURL, field.TypeString, value)
+		_node.RedirectURL = &value
+	}
+	if value, ok := _c.mutation.CheckedAt(); ok {
+		_spec.SetField(linkitem.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = &value
+	}
 	if nodes := _c.mutation.PageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -261,6 +451,109 @@ func (_c *LinkItemCreate) createSpec() (*LinkItem, *sqlgraph.CreateSpec) {
 		_node.PageID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkitem.SectionTable,
+			Columns: []string{linkitem.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Comment
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkState
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ClicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
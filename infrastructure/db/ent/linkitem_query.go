@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,20 +13,32 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 )
 
 // LinkItemQuery is the builder for querying LinkItem entities.
 type LinkItemQuery struct {
 	config
-	ctx        *QueryContext
-	order      []linkitem.OrderOption
-	inters     []Interceptor
-	predicates []predicate.LinkItem
-	withPage   *PageQuery
+	ctx           *QueryContext
+	order         []linkitem.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.LinkItem
+	withPage      *PageQuery
+	withSection   *SectionQuery
+	withComments  *CommentQuery
+	withReactions *LinkReactionQuery
+	withStates    *LinkStateQuery
+	withSnapshots *LinkSnapshotQuery
+	withClicks    *LinkClickQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +100,156 @@ func (_q *LinkItemQuery) QueryPage() *PageQuery {
 	return query
 }
 
+// QuerySection chains the current query on the "section" edge.
+func (_q *LinkItemQuery) QuerySection() *SectionQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(section.Table, section.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkitem.SectionTable, linkitem.SectionColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.LinkItem
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (_q *LinkItemQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkitem.CommentsTable, linkitem.CommentsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Comment
+		step.Edge.Schema = schemaConfig.Comment
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReactions chains the current query on the "reactions" edge.
+func (_q *LinkItemQuery) QueryReactions() *LinkReactionQuery {
+	query := (&LinkReactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(linkreaction.Table, linkreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkitem.ReactionsTable, linkitem.ReactionsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.LinkReaction
+		step.Edge.Schema = schemaConfig.LinkReaction
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStates chains the current query on the "states" edge.
+func (_q *LinkItemQuery) QueryStates() *LinkStateQuery {
+	query := (&LinkStateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(linkstate.Table, linkstate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkitem.StatesTable, linkitem.StatesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.LinkState
+		step.Edge.Schema = schemaConfig.LinkState
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySnapshots chains the current query on the "snapshots" edge.
+func (_q *LinkItemQuery) QuerySnapshots() *LinkSnapshotQuery {
+	query := (&LinkSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(linksnapshot.Table, linksnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkitem.SnapshotsTable, linkitem.SnapshotsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.LinkSnapshot
+		step.Edge.Schema = schemaConfig.LinkSnapshot
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClicks chains the current query on the "clicks" edge.
+func (_q *LinkItemQuery) QueryClicks() *LinkClickQuery {
+	query := (&LinkClickClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkitem.Table, linkitem.FieldID, selector),
+			sqlgraph.To(linkclick.Table, linkclick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkitem.ClicksTable, linkitem.ClicksColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.LinkClick
+		step.Edge.Schema = schemaConfig.LinkClick
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LinkItem entity from the query.
 // Returns a *NotFoundError when no LinkItem was found.
 func (_q *LinkItemQuery) First(ctx context.Context) (*LinkItem, error) {
@@ -274,12 +437,18 @@ func (_q *LinkItemQuery) Clone() *LinkItemQuery {
 		return nil
 	}
 	return &LinkItemQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]linkitem.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.LinkItem{}, _q.predicates...),
-		withPage:   _q.withPage.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]linkitem.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.LinkItem{}, _q.predicates...),
+		withPage:      _q.withPage.Clone(),
+		withSection:   _q.withSection.Clone(),
+		withComments:  _q.withComments.Clone(),
+		withReactions: _q.withReactions.Clone(),
+		withStates:    _q.withStates.Clone(),
+		withSnapshots: _q.withSnapshots.Clone(),
+		withClicks:    _q.withClicks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -297,6 +466,72 @@ func (_q *LinkItemQuery) WithPage(opts ...func(*PageQuery)) *LinkItemQuery {
 	return _q
 }
 
+// WithSection tells the query-builder to eager-load the nodes that are connected to
+// the "section" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithSection(opts ...func(*SectionQuery)) *LinkItemQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSection = query
+	return _q
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithComments(opts ...func(*CommentQuery)) *LinkItemQuery {
+	query := (&CommentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withComments = query
+	return _q
+}
+
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithReactions(opts ...func(*LinkReactionQuery)) *LinkItemQuery {
+	query := (&LinkReactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReactions = query
+	return _q
+}
+
+// WithStates tells the query-builder to eager-load the nodes that are connected to
+// the "states" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithStates(opts ...func(*LinkStateQuery)) *LinkItemQuery {
+	query := (&LinkStateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStates = query
+	return _q
+}
+
+// WithSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithSnapshots(opts ...func(*LinkSnapshotQuery)) *LinkItemQuery {
+	query := (&LinkSnapshotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSnapshots = query
+	return _q
+}
+
+// WithClicks tells the query-builder to eager-load the nodes that are connected to
+// the "clicks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LinkItemQuery) WithClicks(opts ...func(*LinkClickQuery)) *LinkItemQuery {
+	query := (&LinkClickClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withClicks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -375,8 +610,14 @@ func (_q *LinkItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lin
 	var (
 		nodes       = []*LinkItem{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [7]bool{
 			_q.withPage != nil,
+			_q.withSection != nil,
+			_q.withComments != nil,
+			_q.withReactions != nil,
+			_q.withStates != nil,
+			_q.withSnapshots != nil,
+			_q.withClicks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +646,47 @@ func (_q *LinkItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lin
 			return nil, err
 		}
 	}
+	if query := _q.withSection; query != nil {
+		if err := _q.loadSection(ctx, query, nodes, nil,
+			func(n *LinkItem, e *Section) { n.Edges.Section = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withComments; query != nil {
+		if err := _q.loadComments(ctx, query, nodes,
+			func(n *LinkItem) { n.Edges.Comments = []*Comment{} },
+			func(n *LinkItem, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReactions; query != nil {
+		if err := _q.loadReactions(ctx, query, nodes,
+			func(n *LinkItem) { n.Edges.Reactions = []*LinkReaction{} },
+			func(n *LinkItem, e *LinkReaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStates; query != nil {
+		if err := _q.loadStates(ctx, query, nodes,
+			func(n *LinkItem) { n.Edges.States = []*LinkState{} },
+			func(n *LinkItem, e *LinkState) { n.Edges.States = append(n.Edges.States, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSnapshots; query != nil {
+		if err := _q.loadSnapshots(ctx, query, nodes,
+			func(n *LinkItem) { n.Edges.Snapshots = []*LinkSnapshot{} },
+			func(n *LinkItem, e *LinkSnapshot) { n.Edges.Snapshots = append(n.Edges.Snapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withClicks; query != nil {
+		if err := _q.loadClicks(ctx, query, nodes,
+			func(n *LinkItem) { n.Edges.Clicks = []*LinkClick{} },
+			func(n *LinkItem, e *LinkClick) { n.Edges.Clicks = append(n.Edges.Clicks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -437,6 +719,188 @@ func (_q *LinkItemQuery) loadPage(ctx context.Context, query *PageQuery, nodes [
 	}
 	return nil
 }
+func (_q *LinkItemQuery) loadSection(ctx context.Context, query *SectionQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *Section)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LinkItem)
+	for i := range nodes {
+		if nodes[i].SectionID == nil {
+			continue
+		}
+		fk := *nodes[i].SectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(section.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "section_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *LinkItemQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LinkItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldLinkItemID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(linkitem.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LinkItemQuery) loadReactions(ctx context.Context, query *LinkReactionQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *LinkReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LinkItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkreaction.FieldLinkItemID)
+	}
+	query.Where(predicate.LinkReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(linkitem.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LinkItemQuery) loadStates(ctx context.Context, query *LinkStateQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *LinkState)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LinkItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkstate.FieldLinkItemID)
+	}
+	query.Where(predicate.LinkState(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(linkitem.StatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LinkItemQuery) loadSnapshots(ctx context.Context, query *LinkSnapshotQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *LinkSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LinkItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linksnapshot.FieldLinkItemID)
+	}
+	query.Where(predicate.LinkSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(linkitem.SnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LinkItemQuery) loadClicks(ctx context.Context, query *LinkClickQuery, nodes []*LinkItem, init func(*LinkItem), assign func(*LinkItem, *LinkClick)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LinkItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkclick.FieldLinkItemID)
+	}
+	query.Where(predicate.LinkClick(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(linkitem.ClicksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LinkItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "link_item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *LinkItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -468,6 +932,9 @@ func (_q *LinkItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withPage != nil {
 			_spec.Node.AddColumnOnce(linkitem.FieldPageID)
 		}
+		if _q.withSection != nil {
+			_spec.Node.AddColumnOnce(linkitem.FieldSectionID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,10 +12,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 )
 
 // LinkItemUpdate is the builder for updating LinkItem entities.
@@ -106,11 +112,218 @@ func (_u *LinkItemUpdate) AddPriority(v int) *LinkItemUpdate {
 	return _u
 }
 
+// SetSectionID sets the "section_id" field.
+func (_u *LinkItemUpdate) SetSectionID(v uuid.UUID) *LinkItemUpdate {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableSectionID(v *uuid.UUID) *LinkItemUpdate {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *LinkItemUpdate) ClearSectionID() *LinkItemUpdate {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
+// SetDoneByID sets the "done_by_id" field.
+func (_u *LinkItemUpdate) SetDoneByID(v uuid.UUID) *LinkItemUpdate {
+	_u.mutation.SetDoneByID(v)
+	return _u
+}
+
+// SetNillableDoneByID sets the "done_by_id" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableDoneByID(v *uuid.UUID) *LinkItemUpdate {
+	if v != nil {
+		_u.SetDoneByID(*v)
+	}
+	return _u
+}
+
+// ClearDoneByID clears the value of the "done_by_id" field.
+func (_u *LinkItemUpdate) ClearDoneByID() *LinkItemUpdate {
+	_u.mutation.ClearDoneByID()
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *LinkItemUpdate) SetDoneAt(v time.Time) *LinkItemUpdate {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableDoneAt(v *time.Time) *LinkItemUpdate {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *LinkItemUpdate) ClearDoneAt() *LinkItemUpdate {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetCheckStatus sets the "check_status" field.
+func (_u *LinkItemUpdate) SetCheckStatus(v int) *LinkItemUpdate {
+	_u.mutation.ResetCheckStatus()
+	_u.mutation.SetCheckStatus(v)
+	return _u
+}
+
+// SetNillableCheckStatus sets the "check_status" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableCheckStatus(v *int) *LinkItemUpdate {
+	if v != nil {
+		_u.SetCheckStatus(*v)
+	}
+	return _u
+}
+
+// AddCheckStatus adds value to the "check_status" field.
+func (_u *LinkItemUpdate) AddCheckStatus(v int) *LinkItemUpdate {
+	_u.mutation.AddCheckStatus(v)
+	return _u
+}
+
+// ClearCheckStatus clears the value of the "check_status" field.
+func (_u *LinkItemUpdate) ClearCheckStatus() *LinkItemUpdate {
+	_u.mutation.ClearCheckStatus()
+	return _u
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_u *LinkItemUpdate) SetRedirectURL(v string) *LinkItemUpdate {
+	_u.mutation.SetRedirectURL(v)
+	return _u
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableRedirectURL(v *string) *LinkItemUpdate {
+	if v != nil {
+		_u.SetRedirectURL(*v)
+	}
+	return _u
+}
+
+// ClearRedirectURL clears the value of the "redirect_url" field.
+func (_u *LinkItemUpdate) ClearRedirectURL() *LinkItemUpdate {
+	_u.mutation.ClearRedirectURL()
+	return _u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_u *LinkItemUpdate) SetCheckedAt(v time.Time) *LinkItemUpdate {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *LinkItemUpdate) SetNillableCheckedAt(v *time.Time) *LinkItemUpdate {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *LinkItemUpdate) ClearCheckedAt() *LinkItemUpdate {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
 // SetPage sets the "page" edge to the Page entity.
 func (_u *LinkItemUpdate) SetPage(v *Page) *LinkItemUpdate {
 	return _u.SetPageID(v.ID)
 }
 
+// SetSection sets the "section" edge to the Section entity.
+func (_u *LinkItemUpdate) SetSection(v *Section) *LinkItemUpdate {
+	return _u.SetSectionID(v.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *LinkItemUpdate) AddCommentIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *LinkItemUpdate) AddComments(v ...*Comment) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the LinkReaction entity by IDs.
+func (_u *LinkItemUpdate) AddReactionIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the LinkReaction entity.
+func (_u *LinkItemUpdate) AddReactions(v ...*LinkReaction) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// AddStateIDs adds the "states" edge to the LinkState entity by IDs.
+func (_u *LinkItemUpdate) AddStateIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.AddStateIDs(ids...)
+	return _u
+}
+
+// AddStates adds the "states" edges to the LinkState entity.
+func (_u *LinkItemUpdate) AddStates(v ...*LinkState) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStateIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the LinkSnapshot entity by IDs.
+func (_u *LinkItemUpdate) AddSnapshotIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.AddSnapshotIDs(ids...)
+	return _u
+}
+
+// AddSnapshots adds the "snapshots" edges to the LinkSnapshot entity.
+func (_u *LinkItemUpdate) AddSnapshots(v ...*LinkSnapshot) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSnapshotIDs(ids...)
+}
+
+// AddClickIDs adds the "clicks" edge to the LinkClick entity by IDs.
+func (_u *LinkItemUpdate) AddClickIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.AddClickIDs(ids...)
+	return _u
+}
+
+// AddClicks adds the "clicks" edges to the LinkClick entity.
+func (_u *LinkItemUpdate) AddClicks(v ...*LinkClick) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClickIDs(ids...)
+}
+
 // Mutation returns the LinkItemMutation object of the builder.
 func (_u *LinkItemUpdate) Mutation() *LinkItemMutation {
 	return _u.mutation
@@ -122,6 +335,117 @@ func (_u *LinkItemUpdate) ClearPage() *LinkItemUpdate {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the Section entity.
+func (_u *LinkItemUpdate) ClearSection() *LinkItemUpdate {
+	_u.mutation.ClearSection()
+	return _u
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *LinkItemUpdate) ClearComments() *LinkItemUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *LinkItemUpdate) RemoveCommentIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *LinkItemUpdate) RemoveComments(v ...*Comment) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the LinkReaction entity.
+func (_u *LinkItemUpdate) ClearReactions() *LinkItemUpdate {
+	_u.mutation.ClearReactions()
+	return _u
+}
+
+// RemoveReactionIDs removes the "reactions" edge to LinkReaction entities by IDs.
+func (_u *LinkItemUpdate) RemoveReactionIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.RemoveReactionIDs(ids...)
+	return _u
+}
+
+// RemoveReactions removes "reactions" edges to LinkReaction entities.
+func (_u *LinkItemUpdate) RemoveReactions(v ...*LinkReaction) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactionIDs(ids...)
+}
+
+// ClearStates clears all "states" edges to the LinkState entity.
+func (_u *LinkItemUpdate) ClearStates() *LinkItemUpdate {
+	_u.mutation.ClearStates()
+	return _u
+}
+
+// RemoveStateIDs removes the "states" edge to LinkState entities by IDs.
+func (_u *LinkItemUpdate) RemoveStateIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.RemoveStateIDs(ids...)
+	return _u
+}
+
+// RemoveStates removes "states" edges to LinkState entities.
+func (_u *LinkItemUpdate) RemoveStates(v ...*LinkState) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStateIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the LinkSnapshot entity.
+func (_u *LinkItemUpdate) ClearSnapshots() *LinkItemUpdate {
+	_u.mutation.ClearSnapshots()
+	return _u
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to LinkSnapshot entities by IDs.
+func (_u *LinkItemUpdate) RemoveSnapshotIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.RemoveSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveSnapshots removes "snapshots" edges to LinkSnapshot entities.
+func (_u *LinkItemUpdate) RemoveSnapshots(v ...*LinkSnapshot) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSnapshotIDs(ids...)
+}
+
+// ClearClicks clears all "clicks" edges to the LinkClick entity.
+func (_u *LinkItemUpdate) ClearClicks() *LinkItemUpdate {
+	_u.mutation.ClearClicks()
+	return _u
+}
+
+// RemoveClickIDs removes the "clicks" edge to LinkClick entities by IDs.
+func (_u *LinkItemUpdate) RemoveClickIDs(ids ...uuid.UUID) *LinkItemUpdate {
+	_u.mutation.RemoveClickIDs(ids...)
+	return _u
+}
+
+// RemoveClicks removes "clicks" edges to LinkClick entities.
+func (_u *LinkItemUpdate) RemoveClicks(v ...*LinkClick) *LinkItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClickIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LinkItemUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -201,6 +525,39 @@ func (_u *LinkItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(linkitem.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DoneByID(); ok {
+		_spec.SetField(linkitem.FieldDoneByID, field.TypeUUID, value)
+	}
+	if _u.mutation.DoneByIDCleared() {
+		_spec.ClearField(linkitem.FieldDoneByID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(linkitem.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(linkitem.FieldDoneAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckStatus(); ok {
+		_spec.SetField(linkitem.FieldCheckStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckStatus(); ok {
+		_spec.AddField(linkitem.FieldCheckStatus, field.TypeInt, value)
+	}
+	if _u.mutation.CheckStatusCleared() {
+		_spec.ClearField(linkitem.FieldCheckStatus, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedirectURL(); ok {
+		_spec.SetField(linkitem.FieldRedirectURL, field.TypeString, value)
+	}
+	if _u.mutation.RedirectURLCleared() {
+		_spec.ClearField(linkitem.FieldRedirectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(linkitem.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(linkitem.FieldCheckedAt, field.TypeTime)
+	}
 	if _u.mutation.PageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -232,119 +589,708 @@ func (_u *LinkItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.Schema = _u.schemaConfig.LinkItem
-	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{linkitem.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkitem.SectionTable,
+			Columns: []string{linkitem.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkitem.SectionTable,
+			Columns: []string{linkitem.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatesIDs(); len(nodes) > 0 && !_u.mutation.StatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ClicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClicksIDs(); len(nodes) > 0 && !_u.mutation.ClicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkItem
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkItemUpdateOne is the builder for updating a single LinkItem entity.
+type LinkItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkItemUpdateOne) SetUpdatedAt(v time.Time) *LinkItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPageID sets the "page_id" field.
+func (_u *LinkItemUpdateOne) SetPageID(v uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.SetPageID(v)
+	return _u
+}
+
+// SetNillablePageID sets the "page_id" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillablePageID(v *uuid.UUID) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetPageID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LinkItemUpdateOne) SetURL(v string) *LinkItemUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableURL(v *string) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *LinkItemUpdateOne) SetMemo(v string) *LinkItemUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableMemo(v *string) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *LinkItemUpdateOne) ClearMemo() *LinkItemUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *LinkItemUpdateOne) SetPriority(v int) *LinkItemUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillablePriority(v *int) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *LinkItemUpdateOne) AddPriority(v int) *LinkItemUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *LinkItemUpdateOne) SetSectionID(v uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableSectionID(v *uuid.UUID) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *LinkItemUpdateOne) ClearSectionID() *LinkItemUpdateOne {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
+// SetDoneByID sets the "done_by_id" field.
+func (_u *LinkItemUpdateOne) SetDoneByID(v uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.SetDoneByID(v)
+	return _u
+}
+
+// SetNillableDoneByID sets the "done_by_id" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableDoneByID(v *uuid.UUID) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetDoneByID(*v)
+	}
+	return _u
+}
+
+// ClearDoneByID clears the value of the "done_by_id" field.
+func (_u *LinkItemUpdateOne) ClearDoneByID() *LinkItemUpdateOne {
+	_u.mutation.ClearDoneByID()
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *LinkItemUpdateOne) SetDoneAt(v time.Time) *LinkItemUpdateOne {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableDoneAt(v *time.Time) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *LinkItemUpdateOne) ClearDoneAt() *LinkItemUpdateOne {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetCheckStatus sets the "check_status" field.
+func (_u *LinkItemUpdateOne) SetCheckStatus(v int) *LinkItemUpdateOne {
+	_u.mutation.ResetCheckStatus()
+	_u.mutation.SetCheckStatus(v)
+	return _u
+}
+
+// SetNillableCheckStatus sets the "check_status" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableCheckStatus(v *int) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetCheckStatus(*v)
+	}
+	return _u
+}
+
+// AddCheckStatus adds value to the "check_status" field.
+func (_u *LinkItemUpdateOne) AddCheckStatus(v int) *LinkItemUpdateOne {
+	_u.mutation.AddCheckStatus(v)
+	return _u
+}
+
+// ClearCheckStatus clears the value of the "check_status" field.
+func (_u *LinkItemUpdateOne) ClearCheckStatus() *LinkItemUpdateOne {
+	_u.mutation.ClearCheckStatus()
+	return _u
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_u *LinkItemUpdateOne) SetRedirectURL(v string) *LinkItemUpdateOne {
+	_u.mutation.SetRedirectURL(v)
+	return _u
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableRedirectURL(v *string) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetRedirectURL(*v)
+	}
+	return _u
+}
+
+// ClearRedirectURL clears the value of the "redirect_url" field.
+func (_u *LinkItemUpdateOne) ClearRedirectURL() *LinkItemUpdateOne {
+	_u.mutation.ClearRedirectURL()
+	return _u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (_u *LinkItemUpdateOne) SetCheckedAt(v time.Time) *LinkItemUpdateOne {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *LinkItemUpdateOne) SetNillableCheckedAt(v *time.Time) *LinkItemUpdateOne {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *LinkItemUpdateOne) ClearCheckedAt() *LinkItemUpdateOne {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
+// SetPage sets the "page" edge to the Page entity.
+func (_u *LinkItemUpdateOne) SetPage(v *Page) *LinkItemUpdateOne {
+	return _u.SetPageID(v.ID)
+}
+
+// SetSection sets the "section" edge to the Section entity.
+func (_u *LinkItemUpdateOne) SetSection(v *Section) *LinkItemUpdateOne {
+	return _u.SetSectionID(v.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *LinkItemUpdateOne) AddCommentIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *LinkItemUpdateOne) AddComments(v ...*Comment) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the LinkReaction entity by IDs.
+func (_u *LinkItemUpdateOne) AddReactionIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the LinkReaction entity.
+func (_u *LinkItemUpdateOne) AddReactions(v ...*LinkReaction) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// AddStateIDs adds the "states" edge to the LinkState entity by IDs.
+func (_u *LinkItemUpdateOne) AddStateIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.AddStateIDs(ids...)
+	return _u
+}
+
+// AddStates adds the "states" edges to the LinkState entity.
+func (_u *LinkItemUpdateOne) AddStates(v ...*LinkState) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStateIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the LinkSnapshot entity by IDs.
+func (_u *LinkItemUpdateOne) AddSnapshotIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.AddSnapshotIDs(ids...)
+	return _u
+}
+
+// AddSnapshots adds the "snapshots" edges to the LinkSnapshot entity.
+func (_u *LinkItemUpdateOne) AddSnapshots(v ...*LinkSnapshot) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSnapshotIDs(ids...)
+}
+
+// AddClickIDs adds the "clicks" edge to the LinkClick entity by IDs.
+func (_u *LinkItemUpdateOne) AddClickIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.AddClickIDs(ids...)
+	return _u
+}
+
+// AddClicks adds the "clicks" edges to the LinkClick entity.
+func (_u *LinkItemUpdateOne) AddClicks(v ...*LinkClick) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u.AddClickIDs(ids...)
 }
 
-// LinkItemUpdateOne is the builder for updating a single LinkItem entity.
-type LinkItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *LinkItemMutation
+// Mutation returns the LinkItemMutation object of the builder.
+func (_u *LinkItemUpdateOne) Mutation() *LinkItemMutation {
+	return _u.mutation
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *LinkItemUpdateOne) SetUpdatedAt(v time.Time) *LinkItemUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// ClearPage clears the "page" edge to the Page entity.
+func (_u *LinkItemUpdateOne) ClearPage() *LinkItemUpdateOne {
+	_u.mutation.ClearPage()
 	return _u
 }
 
-// SetPageID sets the "page_id" field.
-func (_u *LinkItemUpdateOne) SetPageID(v uuid.UUID) *LinkItemUpdateOne {
-	_u.mutation.SetPageID(v)
+// ClearSection clears the "section" edge to the Section entity.
+func (_u *LinkItemUpdateOne) ClearSection() *LinkItemUpdateOne {
+	_u.mutation.ClearSection()
 	return _u
 }
 
-// SetNillablePageID sets the "page_id" field if the given value is not nil.
-func (_u *LinkItemUpdateOne) SetNillablePageID(v *uuid.UUID) *LinkItemUpdateOne {
-	if v != nil {
-		_u.SetPageID(*v)
-	}
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *LinkItemUpdateOne) ClearComments() *LinkItemUpdateOne {
+	_u.mutation.ClearComments()
 	return _u
 }
 
-// SetURL sets the "url" field.
-func (_u *LinkItemUpdateOne) SetURL(v string) *LinkItemUpdateOne {
-	_u.mutation.SetURL(v)
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *LinkItemUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
 	return _u
 }
 
-// SetNillableURL sets the "url" field if the given value is not nil.
-func (_u *LinkItemUpdateOne) SetNillableURL(v *string) *LinkItemUpdateOne {
-	if v != nil {
-		_u.SetURL(*v)
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *LinkItemUpdateOne) RemoveComments(v ...*Comment) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the LinkReaction entity.
+func (_u *LinkItemUpdateOne) ClearReactions() *LinkItemUpdateOne {
+	_u.mutation.ClearReactions()
 	return _u
 }
 
-// SetMemo sets the "memo" field.
-func (_u *LinkItemUpdateOne) SetMemo(v string) *LinkItemUpdateOne {
-	_u.mutation.SetMemo(v)
+// RemoveReactionIDs removes the "reactions" edge to LinkReaction entities by IDs.
+func (_u *LinkItemUpdateOne) RemoveReactionIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.RemoveReactionIDs(ids...)
 	return _u
 }
 
-// SetNillableMemo sets the "memo" field if the given value is not nil.
-func (_u *LinkItemUpdateOne) SetNillableMemo(v *string) *LinkItemUpdateOne {
-	if v != nil {
-		_u.SetMemo(*v)
+// RemoveReactions removes "reactions" edges to LinkReaction entities.
+func (_u *LinkItemUpdateOne) RemoveReactions(v ...*LinkReaction) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveReactionIDs(ids...)
 }
 
-// ClearMemo clears the value of the "memo" field.
-func (_u *LinkItemUpdateOne) ClearMemo() *LinkItemUpdateOne {
-	_u.mutation.ClearMemo()
+// ClearStates clears all "states" edges to the LinkState entity.
+func (_u *LinkItemUpdateOne) ClearStates() *LinkItemUpdateOne {
+	_u.mutation.ClearStates()
 	return _u
 }
 
-// SetPriority sets the "priority" field.
-func (_u *LinkItemUpdateOne) SetPriority(v int) *LinkItemUpdateOne {
-	_u.mutation.ResetPriority()
-	_u.mutation.SetPriority(v)
+// RemoveStateIDs removes the "states" edge to LinkState entities by IDs.
+func (_u *LinkItemUpdateOne) RemoveStateIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.RemoveStateIDs(ids...)
 	return _u
 }
 
-// SetNillablePriority sets the "priority" field if the given value is not nil.
-func (_u *LinkItemUpdateOne) SetNillablePriority(v *int) *LinkItemUpdateOne {
-	if v != nil {
-		_u.SetPriority(*v)
+// RemoveStates removes "states" edges to LinkState entities.
+func (_u *LinkItemUpdateOne) RemoveStates(v ...*LinkState) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveStateIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the LinkSnapshot entity.
+func (_u *LinkItemUpdateOne) ClearSnapshots() *LinkItemUpdateOne {
+	_u.mutation.ClearSnapshots()
 	return _u
 }
 
-// AddPriority adds value to the "priority" field.
-func (_u *LinkItemUpdateOne) AddPriority(v int) *LinkItemUpdateOne {
-	_u.mutation.AddPriority(v)
+// RemoveSnapshotIDs removes the "snapshots" edge to LinkSnapshot entities by IDs.
+func (_u *LinkItemUpdateOne) RemoveSnapshotIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.RemoveSnapshotIDs(ids...)
 	return _u
 }
 
-// SetPage sets the "page" edge to the Page entity.
-func (_u *LinkItemUpdateOne) SetPage(v *Page) *LinkItemUpdateOne {
-	return _u.SetPageID(v.ID)
+// RemoveSnapshots removes "snapshots" edges to LinkSnapshot entities.
+func (_u *LinkItemUpdateOne) RemoveSnapshots(v ...*LinkSnapshot) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSnapshotIDs(ids...)
 }
 
-// Mutation returns the LinkItemMutation object of the builder.
-func (_u *LinkItemUpdateOne) Mutation() *LinkItemMutation {
-	return _u.mutation
+// ClearClicks clears all "clicks" edges to the LinkClick entity.
+func (_u *LinkItemUpdateOne) ClearClicks() *LinkItemUpdateOne {
+	_u.mutation.ClearClicks()
+	return _u
 }
 
-// ClearPage clears the "page" edge to the Page entity.
-func (_u *LinkItemUpdateOne) ClearPage() *LinkItemUpdateOne {
-	_u.mutation.ClearPage()
+// RemoveClickIDs removes the "clicks" edge to LinkClick entities by IDs.
+func (_u *LinkItemUpdateOne) RemoveClickIDs(ids ...uuid.UUID) *LinkItemUpdateOne {
+	_u.mutation.RemoveClickIDs(ids...)
 	return _u
 }
 
+// RemoveClicks removes "clicks" edges to LinkClick entities.
+func (_u *LinkItemUpdateOne) RemoveClicks(v ...*LinkClick) *LinkItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClickIDs(ids...)
+}
+
 // Where appends a list predicates to the LinkItemUpdate builder.
 func (_u *LinkItemUpdateOne) Where(ps ...predicate.LinkItem) *LinkItemUpdateOne {
 	_u.mutation.Where(ps...)
@@ -454,6 +1400,39 @@ func (_u *LinkItemUpdateOne) sqlSave(ctx context.Context) (_node *LinkItem, err
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(linkitem.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DoneByID(); ok {
+		_spec.SetField(linkitem.FieldDoneByID, field.TypeUUID, value)
+	}
+	if _u.mutation.DoneByIDCleared() {
+		_spec.ClearField(linkitem.FieldDoneByID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(linkitem.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(linkitem.FieldDoneAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckStatus(); ok {
+		_spec.SetField(linkitem.FieldCheckStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckStatus(); ok {
+		_spec.AddField(linkitem.FieldCheckStatus, field.TypeInt, value)
+	}
+	if _u.mutation.CheckStatusCleared() {
+		_spec.ClearField(linkitem.FieldCheckStatus, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedirectURL(); ok {
+		_spec.SetField(linkitem.FieldRedirectURL, field.TypeString, value)
+	}
+	if _u.mutation.RedirectURLCleared() {
+		_spec.ClearField(linkitem.FieldRedirectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(linkitem.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(linkitem.FieldCheckedAt, field.TypeTime)
+	}
 	if _u.mutation.PageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -485,6 +1464,277 @@ func (_u *LinkItemUpdateOne) sqlSave(ctx context.Context) (_node *LinkItem, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkitem.SectionTable,
+			Columns: []string{linkitem.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkitem.SectionTable,
+			Columns: []string{linkitem.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.CommentsTable,
+			Columns: []string{linkitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Comment
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ReactionsTable,
+			Columns: []string{linkitem.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatesIDs(); len(nodes) > 0 && !_u.mutation.StatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.StatesTable,
+			Columns: []string{linkitem.StatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkstate.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkState
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.SnapshotsTable,
+			Columns: []string{linkitem.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ClicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClicksIDs(); len(nodes) > 0 && !_u.mutation.ClicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   linkitem.ClicksTable,
+			Columns: []string{linkitem.ClicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkclick.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkClick
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = _u.schemaConfig.LinkItem
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_node = &LinkItem{config: _u.config}
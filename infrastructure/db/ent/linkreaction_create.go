@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// LinkReactionCreate is the builder for creating a LinkReaction entity.
+type LinkReactionCreate struct {
+	config
+	mutation *LinkReactionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkReactionCreate) SetCreatedAt(v time.Time) *LinkReactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkReactionCreate) SetNillableCreatedAt(v *time.Time) *LinkReactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LinkReactionCreate) SetUpdatedAt(v time.Time) *LinkReactionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LinkReactionCreate) SetNillableUpdatedAt(v *time.Time) *LinkReactionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLinkItemID sets the "link_item_id" field.
+func (_c *LinkReactionCreate) SetLinkItemID(v uuid.UUID) *LinkReactionCreate {
+	_c.mutation.SetLinkItemID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LinkReactionCreate) SetUserID(v uuid.UUID) *LinkReactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEmoji sets the "emoji" field.
+func (_c *LinkReactionCreate) SetEmoji(v string) *LinkReactionCreate {
+	_c.mutation.SetEmoji(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkReactionCreate) SetID(v uuid.UUID) *LinkReactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkReactionCreate) SetNillableID(v *uuid.UUID) *LinkReactionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLinkItem sets the "link_item" edge to the LinkItem entity.
+func (_c *LinkReactionCreate) SetLinkItem(v *LinkItem) *LinkReactionCreate {
+	return _c.SetLinkItemID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LinkReactionCreate) SetUser(v *User) *LinkReactionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LinkReactionMutation object of the builder.
+func (_c *LinkReactionCreate) Mutation() *LinkReactionMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkReaction in the database.
+func (_c *LinkReactionCreate) Save(ctx context.Context) (*LinkReaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkReactionCreate) SaveX(ctx context.Context) *LinkReaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkReactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkReactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkReactionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linkreaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := linkreaction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkreaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkReactionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkReaction.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LinkReaction.updated_at"`)}
+	}
+	if _, ok := _c.mutation.LinkItemID(); !ok {
+		return &ValidationError{Name: "link_item_id", err: errors.New(`ent: missing required field "LinkReaction.link_item_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LinkReaction.user_id"`)}
+	}
+	if _, ok := _c.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "LinkReaction.emoji"`)}
+	}
+	if v, ok := _c.mutation.Emoji(); ok {
+		if err := linkreaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "LinkReaction.emoji": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LinkItemIDs()) == 0 {
+		return &ValidationError{Name: "link_item", err: errors.New(`ent: missing required edge "LinkReaction.link_item"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LinkReaction.user"`)}
+	}
+	return nil
+}
+
+func (_c *LinkReactionCreate) sqlSave(ctx context.Context) (*LinkReaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkReactionCreate) createSpec() (*LinkReaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkReaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkreaction.Table, sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.LinkReaction
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linkreaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkreaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Emoji(); ok {
+		_spec.SetField(linkreaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if nodes := _c.mutation.LinkItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkreaction.LinkItemTable,
+			Columns: []string{linkreaction.LinkItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LinkItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkreaction.UserTable,
+			Columns: []string{linkreaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkReaction
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LinkReactionCreateBulk is the builder for creating many LinkReaction entities in bulk.
+type LinkReactionCreateBulk struct {
+	config
+	err      error
+	builders []*LinkReactionCreate
+}
+
+// Save creates the LinkReaction entities in the database.
+func (_c *LinkReactionCreateBulk) Save(ctx context.Context) ([]*LinkReaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkReaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkReactionCreateBulk) SaveX(ctx context.Context) []*LinkReaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// LinkReactionUpdate is the builder for updating LinkReaction entities.
+type LinkReactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkReactionMutation
+}
+
+// Where appends a list predicates to the LinkReactionUpdate builder.
+func (_u *LinkReactionUpdate) Where(ps ...predicate.LinkReaction) *LinkReactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkReactionUpdate) SetUpdatedAt(v time.Time) *LinkReactionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEmoji sets the "emoji" field.
+func (_u *LinkReactionUpdate) SetEmoji(v string) *LinkReactionUpdate {
+	_u.mutation.SetEmoji(v)
+	return _u
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (_u *LinkReactionUpdate) SetNillableEmoji(v *string) *LinkReactionUpdate {
+	if v != nil {
+		_u.SetEmoji(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkReactionMutation object of the builder.
+func (_u *LinkReactionUpdate) Mutation() *LinkReactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkReactionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkReactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkReactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkReactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkReactionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linkreaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkReactionUpdate) check() error {
+	if v, ok := _u.mutation.Emoji(); ok {
+		if err := linkreaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "LinkReaction.emoji": %w`, err)}
+		}
+	}
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkReaction.link_item"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkReaction.user"`)
+	}
+	return nil
+}
+
+func (_u *LinkReactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkreaction.Table, linkreaction.Columns, sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkreaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Emoji(); ok {
+		_spec.SetField(linkreaction.FieldEmoji, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkReaction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkreaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkReactionUpdateOne is the builder for updating a single LinkReaction entity.
+type LinkReactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkReactionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkReactionUpdateOne) SetUpdatedAt(v time.Time) *LinkReactionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEmoji sets the "emoji" field.
+func (_u *LinkReactionUpdateOne) SetEmoji(v string) *LinkReactionUpdateOne {
+	_u.mutation.SetEmoji(v)
+	return _u
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (_u *LinkReactionUpdateOne) SetNillableEmoji(v *string) *LinkReactionUpdateOne {
+	if v != nil {
+		_u.SetEmoji(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkReactionMutation object of the builder.
+func (_u *LinkReactionUpdateOne) Mutation() *LinkReactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkReactionUpdate builder.
+func (_u *LinkReactionUpdateOne) Where(ps ...predicate.LinkReaction) *LinkReactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkReactionUpdateOne) Select(field string, fields ...string) *LinkReactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkReaction entity.
+func (_u *LinkReactionUpdateOne) Save(ctx context.Context) (*LinkReaction, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkReactionUpdateOne) SaveX(ctx context.Context) *LinkReaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkReactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkReactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkReactionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linkreaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkReactionUpdateOne) check() error {
+	if v, ok := _u.mutation.Emoji(); ok {
+		if err := linkreaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "LinkReaction.emoji": %w`, err)}
+		}
+	}
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkReaction.link_item"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkReaction.user"`)
+	}
+	return nil
+}
+
+func (_u *LinkReactionUpdateOne) sqlSave(ctx context.Context) (_node *LinkReaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkreaction.Table, linkreaction.Columns, sqlgraph.NewFieldSpec(linkreaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkReaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkreaction.FieldID)
+		for _, f := range fields {
+			if !linkreaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkreaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkreaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Emoji(); ok {
+		_spec.SetField(linkreaction.FieldEmoji, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkReaction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &LinkReaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkreaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+)
+
+// LinkSnapshot is the model entity for the LinkSnapshot schema.
+type LinkSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PageID holds the value of the "page_id" field.
+	PageID uuid.UUID `json:"page_id,omitempty"`
+	// LinkItemID holds the value of the "link_item_id" field.
+	LinkItemID uuid.UUID `json:"link_item_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkSnapshotQuery when eager-loading is set.
+	Edges        LinkSnapshotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkSnapshotEdges holds the relations/edges for other nodes in the graph.
+type LinkSnapshotEdges struct {
+	// LinkItem holds the value of the link_item edge.
+	LinkItem *LinkItem `json:"link_item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinkItemOrErr returns the LinkItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkSnapshotEdges) LinkItemOrErr() (*LinkItem, error) {
+	if e.LinkItem != nil {
+		return e.LinkItem, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: linkitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "link_item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linksnapshot.FieldCreatedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case linksnapshot.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case linksnapshot.FieldURL, linksnapshot.FieldStorageKey:
+			values[i] = new(sql.NullString)
+		case linksnapshot.FieldCreatedAt, linksnapshot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case linksnapshot.FieldID, linksnapshot.FieldPageID, linksnapshot.FieldLinkItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkSnapshot fields.
+func (_m *LinkSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linksnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linksnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case linksnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case linksnapshot.FieldPageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field page_id", values[i])
+			} else if value != nil {
+				_m.PageID = *value
+			}
+		case linksnapshot.FieldLinkItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field link_item_id", values[i])
+			} else if value != nil {
+				_m.LinkItemID = *value
+			}
+		case linksnapshot.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case linksnapshot.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				_m.StorageKey = value.String
+			}
+		case linksnapshot.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case linksnapshot.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(uuid.UUID)
+				*_m.CreatedByID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLinkItem queries the "link_item" edge of the LinkSnapshot entity.
+func (_m *LinkSnapshot) QueryLinkItem() *LinkItemQuery {
+	return NewLinkSnapshotClient(_m.config).QueryLinkItem(_m)
+}
+
+// Update returns a builder for updating this LinkSnapshot.
+// Note that you need to call LinkSnapshot.Unwrap() before calling this method if this LinkSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkSnapshot) Update() *LinkSnapshotUpdateOne {
+	return NewLinkSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkSnapshot) Unwrap() *LinkSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("page_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageID))
+	builder.WriteString(", ")
+	builder.WriteString("link_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkItemID))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(_m.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkSnapshots is a parsable slice of LinkSnapshot.
+type LinkSnapshots []*LinkSnapshot
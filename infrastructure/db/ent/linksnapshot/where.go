@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linksnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PageID applies equality check predicate on the "page_id" field. It's identical to PageIDEQ.
+func PageID(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldPageID, v))
+}
+
+// LinkItemID applies equality check predicate on the "link_item_id" field. It's identical to LinkItemIDEQ.
+func LinkItemID(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldLinkItemID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldURL, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldSize, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PageIDEQ applies the EQ predicate on the "page_id" field.
+func PageIDEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldPageID, v))
+}
+
+// PageIDNEQ applies the NEQ predicate on the "page_id" field.
+func PageIDNEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldPageID, v))
+}
+
+// PageIDIn applies the In predicate on the "page_id" field.
+func PageIDIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldPageID, vs...))
+}
+
+// PageIDNotIn applies the NotIn predicate on the "page_id" field.
+func PageIDNotIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldPageID, vs...))
+}
+
+// PageIDGT applies the GT predicate on the "page_id" field.
+func PageIDGT(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldPageID, v))
+}
+
+// PageIDGTE applies the GTE predicate on the "page_id" field.
+func PageIDGTE(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldPageID, v))
+}
+
+// PageIDLT applies the LT predicate on the "page_id" field.
+func PageIDLT(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldPageID, v))
+}
+
+// PageIDLTE applies the LTE predicate on the "page_id" field.
+func PageIDLTE(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldPageID, v))
+}
+
+// LinkItemIDEQ applies the EQ predicate on the "link_item_id" field.
+func LinkItemIDEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldLinkItemID, v))
+}
+
+// LinkItemIDNEQ applies the NEQ predicate on the "link_item_id" field.
+func LinkItemIDNEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldLinkItemID, v))
+}
+
+// LinkItemIDIn applies the In predicate on the "link_item_id" field.
+func LinkItemIDIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldLinkItemID, vs...))
+}
+
+// LinkItemIDNotIn applies the NotIn predicate on the "link_item_id" field.
+func LinkItemIDNotIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldLinkItemID, vs...))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldSize, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDGT applies the GT predicate on the "created_by_id" field.
+func CreatedByIDGT(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGT(FieldCreatedByID, v))
+}
+
+// CreatedByIDGTE applies the GTE predicate on the "created_by_id" field.
+func CreatedByIDGTE(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldGTE(FieldCreatedByID, v))
+}
+
+// CreatedByIDLT applies the LT predicate on the "created_by_id" field.
+func CreatedByIDLT(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLT(FieldCreatedByID, v))
+}
+
+// CreatedByIDLTE applies the LTE predicate on the "created_by_id" field.
+func CreatedByIDLTE(v uuid.UUID) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldLTE(FieldCreatedByID, v))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// HasLinkItem applies the HasEdge predicate on the "link_item" edge.
+func HasLinkItem() predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkItemTable, LinkItemColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkItem
+		step.Edge.Schema = schemaConfig.LinkSnapshot
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkItemWith applies the HasEdge predicate on the "link_item" edge with a given conditions (other predicates).
+func HasLinkItemWith(preds ...predicate.LinkItem) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(func(s *sql.Selector) {
+		step := newLinkItemStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.LinkItem
+		step.Edge.Schema = schemaConfig.LinkSnapshot
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkSnapshot) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkSnapshot) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkSnapshot) predicate.LinkSnapshot {
+	return predicate.LinkSnapshot(sql.NotPredicates(p))
+}
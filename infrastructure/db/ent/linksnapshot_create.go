@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+)
+
+// LinkSnapshotCreate is the builder for creating a LinkSnapshot entity.
+type LinkSnapshotCreate struct {
+	config
+	mutation *LinkSnapshotMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkSnapshotCreate) SetCreatedAt(v time.Time) *LinkSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkSnapshotCreate) SetNillableCreatedAt(v *time.Time) *LinkSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LinkSnapshotCreate) SetUpdatedAt(v time.Time) *LinkSnapshotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LinkSnapshotCreate) SetNillableUpdatedAt(v *time.Time) *LinkSnapshotCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPageID sets the "page_id" field.
+func (_c *LinkSnapshotCreate) SetPageID(v uuid.UUID) *LinkSnapshotCreate {
+	_c.mutation.SetPageID(v)
+	return _c
+}
+
+// SetLinkItemID sets the "link_item_id" field.
+func (_c *LinkSnapshotCreate) SetLinkItemID(v uuid.UUID) *LinkSnapshotCreate {
+	_c.mutation.SetLinkItemID(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *LinkSnapshotCreate) SetURL(v string) *LinkSnapshotCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *LinkSnapshotCreate) SetStorageKey(v string) *LinkSnapshotCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *LinkSnapshotCreate) SetSize(v int64) *LinkSnapshotCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *LinkSnapshotCreate) SetNillableSize(v *int64) *LinkSnapshotCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *LinkSnapshotCreate) SetCreatedByID(v uuid.UUID) *LinkSnapshotCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *LinkSnapshotCreate) SetNillableCreatedByID(v *uuid.UUID) *LinkSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkSnapshotCreate) SetID(v uuid.UUID) *LinkSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkSnapshotCreate) SetNillableID(v *uuid.UUID) *LinkSnapshotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLinkItem sets the "link_item" edge to the LinkItem entity.
+func (_c *LinkSnapshotCreate) SetLinkItem(v *LinkItem) *LinkSnapshotCreate {
+	return _c.SetLinkItemID(v.ID)
+}
+
+// Mutation returns the LinkSnapshotMutation object of the builder.
+func (_c *LinkSnapshotCreate) Mutation() *LinkSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkSnapshot in the database.
+func (_c *LinkSnapshotCreate) Save(ctx context.Context) (*LinkSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkSnapshotCreate) SaveX(ctx context.Context) *LinkSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linksnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := linksnapshot.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		v := linksnapshot.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linksnapshot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkSnapshotCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkSnapshot.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LinkSnapshot.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PageID(); !ok {
+		return &ValidationError{Name: "page_id", err: errors.New(`ent: missing required field "LinkSnapshot.page_id"`)}
+	}
+	if _, ok := _c.mutation.LinkItemID(); !ok {
+		return &ValidationError{Name: "link_item_id", err: errors.New(`ent: missing required field "LinkSnapshot.link_item_id"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LinkSnapshot.url"`)}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "LinkSnapshot.storage_key"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "LinkSnapshot.size"`)}
+	}
+	if len(_c.mutation.LinkItemIDs()) == 0 {
+		return &ValidationError{Name: "link_item", err: errors.New(`ent: missing required edge "LinkSnapshot.link_item"`)}
+	}
+	return nil
+}
+
+func (_c *LinkSnapshotCreate) sqlSave(ctx context.Context) (*LinkSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkSnapshotCreate) createSpec() (*LinkSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linksnapshot.Table, sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.LinkSnapshot
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linksnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(linksnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.PageID(); ok {
+		_spec.SetField(linksnapshot.FieldPageID, field.TypeUUID, value)
+		_node.PageID = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(linksnapshot.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(linksnapshot.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(linksnapshot.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.CreatedByID(); ok {
+		_spec.SetField(linksnapshot.FieldCreatedByID, field.TypeUUID, value)
+		_node.CreatedByID = &value
+	}
+	if nodes := _c.mutation.LinkItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linksnapshot.LinkItemTable,
+			Columns: []string{linksnapshot.LinkItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.LinkSnapshot
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LinkItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LinkSnapshotCreateBulk is the builder for creating many LinkSnapshot entities in bulk.
+type LinkSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*LinkSnapshotCreate
+}
+
+// Save creates the LinkSnapshot entities in the database.
+func (_c *LinkSnapshotCreateBulk) Save(ctx context.Context) ([]*LinkSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkSnapshotCreateBulk) SaveX(ctx context.Context) []*LinkSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
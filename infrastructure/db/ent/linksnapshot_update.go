@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// LinkSnapshotUpdate is the builder for updating LinkSnapshot entities.
+type LinkSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkSnapshotMutation
+}
+
+// Where appends a list predicates to the LinkSnapshotUpdate builder.
+func (_u *LinkSnapshotUpdate) Where(ps ...predicate.LinkSnapshot) *LinkSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkSnapshotUpdate) SetUpdatedAt(v time.Time) *LinkSnapshotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *LinkSnapshotUpdate) SetSize(v int64) *LinkSnapshotUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *LinkSnapshotUpdate) SetNillableSize(v *int64) *LinkSnapshotUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *LinkSnapshotUpdate) AddSize(v int64) *LinkSnapshotUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *LinkSnapshotUpdate) SetCreatedByID(v uuid.UUID) *LinkSnapshotUpdate {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *LinkSnapshotUpdate) SetNillableCreatedByID(v *uuid.UUID) *LinkSnapshotUpdate {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *LinkSnapshotUpdate) ClearCreatedByID() *LinkSnapshotUpdate {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// Mutation returns the LinkSnapshotMutation object of the builder.
+func (_u *LinkSnapshotUpdate) Mutation() *LinkSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkSnapshotUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linksnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkSnapshotUpdate) check() error {
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkSnapshot.link_item"`)
+	}
+	return nil
+}
+
+func (_u *LinkSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linksnapshot.Table, linksnapshot.Columns, sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linksnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(linksnapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(linksnapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedByID(); ok {
+		_spec.SetField(linksnapshot.FieldCreatedByID, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByIDCleared() {
+		_spec.ClearField(linksnapshot.FieldCreatedByID, field.TypeUUID)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkSnapshot
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linksnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkSnapshotUpdateOne is the builder for updating a single LinkSnapshot entity.
+type LinkSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkSnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LinkSnapshotUpdateOne) SetUpdatedAt(v time.Time) *LinkSnapshotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *LinkSnapshotUpdateOne) SetSize(v int64) *LinkSnapshotUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *LinkSnapshotUpdateOne) SetNillableSize(v *int64) *LinkSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *LinkSnapshotUpdateOne) AddSize(v int64) *LinkSnapshotUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *LinkSnapshotUpdateOne) SetCreatedByID(v uuid.UUID) *LinkSnapshotUpdateOne {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *LinkSnapshotUpdateOne) SetNillableCreatedByID(v *uuid.UUID) *LinkSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *LinkSnapshotUpdateOne) ClearCreatedByID() *LinkSnapshotUpdateOne {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// Mutation returns the LinkSnapshotMutation object of the builder.
+func (_u *LinkSnapshotUpdateOne) Mutation() *LinkSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkSnapshotUpdate builder.
+func (_u *LinkSnapshotUpdateOne) Where(ps ...predicate.LinkSnapshot) *LinkSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkSnapshotUpdateOne) Select(field string, fields ...string) *LinkSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkSnapshot entity.
+func (_u *LinkSnapshotUpdateOne) Save(ctx context.Context) (*LinkSnapshot, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkSnapshotUpdateOne) SaveX(ctx context.Context) *LinkSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LinkSnapshotUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := linksnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkSnapshotUpdateOne) check() error {
+	if _u.mutation.LinkItemCleared() && len(_u.mutation.LinkItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkSnapshot.link_item"`)
+	}
+	return nil
+}
+
+func (_u *LinkSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *LinkSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linksnapshot.Table, linksnapshot.Columns, sqlgraph.NewFieldSpec(linksnapshot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linksnapshot.FieldID)
+		for _, f := range fields {
+			if !linksnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linksnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(linksnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(linksnapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(linksnapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedByID(); ok {
+		_spec.SetField(linksnapshot.FieldCreatedByID, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByIDCleared() {
+		_spec.ClearField(linksnapshot.FieldCreatedByID, field.TypeUUID)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LinkSnapshot
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &LinkSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linksnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
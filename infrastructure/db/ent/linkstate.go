@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// LinkState is the model entity for the LinkState schema.
+type LinkState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// LinkItemID holds the value of the "link_item_id" field.
+	LinkItemID uuid.UUID `json:"link_item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status linkstate.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkStateQuery when eager-loading is set.
+	Edges        LinkStateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkStateEdges holds the relations/edges for other nodes in the graph.
+type LinkStateEdges struct {
+	// LinkItem holds the value of the link_item edge.
+	LinkItem *LinkItem `json:"link_item,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LinkItemOrErr returns the LinkItem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkStateEdges) LinkItemOrErr() (*LinkItem, error) {
+	if e.LinkItem != nil {
+		return e.LinkItem, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: linkitem.Label}
+	}
+	return nil, &NotLoadedError{edge: "link_item"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkStateEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkstate.FieldStatus:
+			values[i] = new(sql.NullString)
+		case linkstate.FieldCreatedAt, linkstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case linkstate.FieldID, linkstate.FieldLinkItemID, linkstate.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkState fields.
+func (_m *LinkState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkstate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linkstate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case linkstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case linkstate.FieldLinkItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field link_item_id", values[i])
+			} else if value != nil {
+				_m.LinkItemID = *value
+			}
+		case linkstate.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case linkstate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = linkstate.Status(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkState.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLinkItem queries the "link_item" edge of the LinkState entity.
+func (_m *LinkState) QueryLinkItem() *LinkItemQuery {
+	return NewLinkStateClient(_m.config).QueryLinkItem(_m)
+}
+
+// QueryUser queries the "user" edge of the LinkState entity.
+func (_m *LinkState) QueryUser() *UserQuery {
+	return NewLinkStateClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LinkState.
+// Note that you need to call LinkState.Unwrap() before calling this method if this LinkState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkState) Update() *LinkStateUpdateOne {
+	return NewLinkStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkState) Unwrap() *LinkState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkState) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("link_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkItemID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkStates is a parsable slice of LinkState.
+type LinkStates []*LinkState
@@ -9,6 +9,192 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "hint", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "page_ids", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[11]},
+			},
+		},
+	}
+	// LinkCommentsColumns holds the columns for the "link_comments" table.
+	LinkCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "link_item_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID},
+	}
+	// LinkCommentsTable holds the schema information for the "link_comments" table.
+	LinkCommentsTable = &schema.Table{
+		Name:       "link_comments",
+		Columns:    LinkCommentsColumns,
+		PrimaryKey: []*schema.Column{LinkCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_comments_link_comments_replies",
+				Columns:    []*schema.Column{LinkCommentsColumns[6]},
+				RefColumns: []*schema.Column{LinkCommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "link_comments_link_items_comments",
+				Columns:    []*schema.Column{LinkCommentsColumns[7]},
+				RefColumns: []*schema.Column{LinkItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "link_comments_users_comments",
+				Columns:    []*schema.Column{LinkCommentsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_link_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{LinkCommentsColumns[7]},
+			},
+		},
+	}
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token", Type: field.TypeString, Size: 4096},
+		{Name: "platform", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:       "devices",
+		Columns:    DevicesColumns,
+		PrimaryKey: []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_users_devices",
+				Columns:    []*schema.Column{DevicesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "device_token",
+				Unique:  true,
+				Columns: []*schema.Column{DevicesColumns[3]},
+			},
+			{
+				Name:    "device_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[5]},
+			},
+		},
+	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString, Size: 254},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "revoked"}, Default: "pending"},
+		{Name: "accepted_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "invited_by_id", Type: field.TypeUUID},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_pages_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[8]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invitations_users_sent_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitation_page_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[8]},
+			},
+		},
+	}
+	// LinkClicksColumns holds the columns for the "link_clicks" table.
+	LinkClicksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "referrer", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "clicked_at", Type: field.TypeTime},
+		{Name: "link_item_id", Type: field.TypeUUID},
+	}
+	// LinkClicksTable holds the schema information for the "link_clicks" table.
+	LinkClicksTable = &schema.Table{
+		Name:       "link_clicks",
+		Columns:    LinkClicksColumns,
+		PrimaryKey: []*schema.Column{LinkClicksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_clicks_link_items_clicks",
+				Columns:    []*schema.Column{LinkClicksColumns[5]},
+				RefColumns: []*schema.Column{LinkItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkclick_page_id_clicked_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkClicksColumns[1], LinkClicksColumns[4]},
+			},
+		},
+	}
 	// LinkItemsColumns holds the columns for the "link_items" table.
 	LinkItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -17,7 +203,13 @@ var (
 		{Name: "url", Type: field.TypeString, Size: 2147483647},
 		{Name: "memo", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "done_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "done_at", Type: field.TypeTime, Nullable: true},
+		{Name: "check_status", Type: field.TypeInt, Nullable: true},
+		{Name: "redirect_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "section_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// LinkItemsTable holds the schema information for the "link_items" table.
 	LinkItemsTable = &schema.Table{
@@ -27,10 +219,196 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "link_items_pages_link_items",
-				Columns:    []*schema.Column{LinkItemsColumns[6]},
+				Columns:    []*schema.Column{LinkItemsColumns[11]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "link_items_page_sections_link_items",
+				Columns:    []*schema.Column{LinkItemsColumns[12]},
+				RefColumns: []*schema.Column{PageSectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkitem_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkItemsColumns[10]},
+			},
+		},
+	}
+	// LinkReactionsColumns holds the columns for the "link_reactions" table.
+	LinkReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "emoji", Type: field.TypeString},
+		{Name: "link_item_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// LinkReactionsTable holds the schema information for the "link_reactions" table.
+	LinkReactionsTable = &schema.Table{
+		Name:       "link_reactions",
+		Columns:    LinkReactionsColumns,
+		PrimaryKey: []*schema.Column{LinkReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_reactions_link_items_reactions",
+				Columns:    []*schema.Column{LinkReactionsColumns[4]},
+				RefColumns: []*schema.Column{LinkItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "link_reactions_users_link_reactions",
+				Columns:    []*schema.Column{LinkReactionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkreaction_link_item_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{LinkReactionsColumns[4], LinkReactionsColumns[5]},
+			},
+		},
+	}
+	// LinkSnapshotsColumns holds the columns for the "link_snapshots" table.
+	LinkSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "storage_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "link_item_id", Type: field.TypeUUID},
+	}
+	// LinkSnapshotsTable holds the schema information for the "link_snapshots" table.
+	LinkSnapshotsTable = &schema.Table{
+		Name:       "link_snapshots",
+		Columns:    LinkSnapshotsColumns,
+		PrimaryKey: []*schema.Column{LinkSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_snapshots_link_items_snapshots",
+				Columns:    []*schema.Column{LinkSnapshotsColumns[8]},
+				RefColumns: []*schema.Column{LinkItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linksnapshot_link_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{LinkSnapshotsColumns[8]},
+			},
+		},
+	}
+	// LinkStatesColumns holds the columns for the "link_states" table.
+	LinkStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"unread", "read", "done"}, Default: "unread"},
+		{Name: "link_item_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// LinkStatesTable holds the schema information for the "link_states" table.
+	LinkStatesTable = &schema.Table{
+		Name:       "link_states",
+		Columns:    LinkStatesColumns,
+		PrimaryKey: []*schema.Column{LinkStatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_states_link_items_states",
+				Columns:    []*schema.Column{LinkStatesColumns[4]},
+				RefColumns: []*schema.Column{LinkItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "link_states_users_link_states",
+				Columns:    []*schema.Column{LinkStatesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkstate_link_item_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{LinkStatesColumns[4], LinkStatesColumns[5]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "actor_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "count", Type: field.TypeInt, Default: 1},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "recipient_id", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_pages_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
 				RefColumns: []*schema.Column{PagesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_recipient_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[8], NotificationsColumns[6]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_notification_preferences",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[4], NotificationPreferencesColumns[3]},
+			},
 		},
 	}
 	// PagesColumns holds the columns for the "pages" table.
@@ -40,6 +418,14 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 50},
 		{Name: "invite_code", Type: field.TypeString, Unique: true, Size: 8},
+		{Name: "feed_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "source_page_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "checklist", Type: field.TypeBool, Default: false},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "icon", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "color", Type: field.TypeString, Size: 7, Default: ""},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true, Size: 30},
 		{Name: "creator_id", Type: field.TypeUUID},
 	}
 	// PagesTable holds the schema information for the "pages" table.
@@ -50,12 +436,148 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pages_users_created_pages",
-				Columns:    []*schema.Column{PagesColumns[5]},
+				Columns:    []*schema.Column{PagesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PagePreferencesColumns holds the columns for the "page_preferences" table.
+	PagePreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "inbox", Type: field.TypeBool, Default: false},
+		{Name: "page_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PagePreferencesTable holds the schema information for the "page_preferences" table.
+	PagePreferencesTable = &schema.Table{
+		Name:       "page_preferences",
+		Columns:    PagePreferencesColumns,
+		PrimaryKey: []*schema.Column{PagePreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "page_preferences_pages_preferences",
+				Columns:    []*schema.Column{PagePreferencesColumns[6]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "page_preferences_users_page_preferences",
+				Columns:    []*schema.Column{PagePreferencesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pagepreference_user_id_page_id",
+				Unique:  true,
+				Columns: []*schema.Column{PagePreferencesColumns[7], PagePreferencesColumns[6]},
+			},
+		},
+	}
+	// PageSlugsColumns holds the columns for the "page_slugs" table.
+	PageSlugsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 30},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "page_id", Type: field.TypeUUID},
+	}
+	// PageSlugsTable holds the schema information for the "page_slugs" table.
+	PageSlugsTable = &schema.Table{
+		Name:       "page_slugs",
+		Columns:    PageSlugsColumns,
+		PrimaryKey: []*schema.Column{PageSlugsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "page_slugs_pages_slugs",
+				Columns:    []*schema.Column{PageSlugsColumns[3]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pageslug_page_id",
+				Unique:  false,
+				Columns: []*schema.Column{PageSlugsColumns[3]},
+			},
+		},
+	}
+	// PageSectionsColumns holds the columns for the "page_sections" table.
+	PageSectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "page_id", Type: field.TypeUUID},
+	}
+	// PageSectionsTable holds the schema information for the "page_sections" table.
+	PageSectionsTable = &schema.Table{
+		Name:       "page_sections",
+		Columns:    PageSectionsColumns,
+		PrimaryKey: []*schema.Column{PageSectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "page_sections_pages_sections",
+				Columns:    []*schema.Column{PageSectionsColumns[5]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TemplatesColumns holds the columns for the "templates" table.
+	TemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Size: 50},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "shared"}, Default: "private"},
+		{Name: "creator_id", Type: field.TypeUUID},
+	}
+	// TemplatesTable holds the schema information for the "templates" table.
+	TemplatesTable = &schema.Table{
+		Name:       "templates",
+		Columns:    TemplatesColumns,
+		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "templates_users_created_templates",
+				Columns:    []*schema.Column{TemplatesColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// TemplateLinksColumns holds the columns for the "template_links" table.
+	TemplateLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "memo", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "template_id", Type: field.TypeUUID},
+	}
+	// TemplateLinksTable holds the schema information for the "template_links" table.
+	TemplateLinksTable = &schema.Table{
+		Name:       "template_links",
+		Columns:    TemplateLinksColumns,
+		PrimaryKey: []*schema.Column{TemplateLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_links_templates_template_links",
+				Columns:    []*schema.Column{TemplateLinksColumns[6]},
+				RefColumns: []*schema.Column{TemplatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -64,6 +586,9 @@ var (
 		{Name: "uid", Type: field.TypeString, Unique: true},
 		{Name: "provider", Type: field.TypeEnum, Enums: []string{"anonymous", "google", "facebook"}, Default: "anonymous"},
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Default: ""},
+		{Name: "avatar_url", Type: field.TypeString, Size: 2048, Default: ""},
+		{Name: "locale", Type: field.TypeString, Size: 35, Default: ""},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -71,6 +596,74 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by_id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "page_id", Type: field.TypeUUID},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_pages_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[9]},
+				RefColumns: []*schema.Column{PagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_page_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[9]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[9], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
 	// PageUsersColumns holds the columns for the "page_users" table.
 	PageUsersColumns = []*schema.Column{
 		{Name: "page_id", Type: field.TypeUUID},
@@ -96,30 +689,154 @@ var (
 			},
 		},
 	}
+	// TemplateUsersColumns holds the columns for the "template_users" table.
+	TemplateUsersColumns = []*schema.Column{
+		{Name: "template_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// TemplateUsersTable holds the schema information for the "template_users" table.
+	TemplateUsersTable = &schema.Table{
+		Name:       "template_users",
+		Columns:    TemplateUsersColumns,
+		PrimaryKey: []*schema.Column{TemplateUsersColumns[0], TemplateUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_users_template_id",
+				Columns:    []*schema.Column{TemplateUsersColumns[0]},
+				RefColumns: []*schema.Column{TemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "template_users_user_id",
+				Columns:    []*schema.Column{TemplateUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
+		LinkCommentsTable,
+		DevicesTable,
+		InvitationsTable,
+		LinkClicksTable,
 		LinkItemsTable,
+		LinkReactionsTable,
+		LinkSnapshotsTable,
+		LinkStatesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
 		PagesTable,
+		PagePreferencesTable,
+		PageSlugsTable,
+		PageSectionsTable,
+		TemplatesTable,
+		TemplateLinksTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		PageUsersTable,
+		TemplateUsersTable,
 	}
 )
 
 func init() {
+	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	AccessTokensTable.Annotation = &entsql.Annotation{
+		Table: "access_tokens",
+	}
+	LinkCommentsTable.ForeignKeys[0].RefTable = LinkCommentsTable
+	LinkCommentsTable.ForeignKeys[1].RefTable = LinkItemsTable
+	LinkCommentsTable.ForeignKeys[2].RefTable = UsersTable
+	LinkCommentsTable.Annotation = &entsql.Annotation{
+		Table: "link_comments",
+	}
+	DevicesTable.ForeignKeys[0].RefTable = UsersTable
+	DevicesTable.Annotation = &entsql.Annotation{
+		Table: "devices",
+	}
+	InvitationsTable.ForeignKeys[0].RefTable = PagesTable
+	InvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	InvitationsTable.Annotation = &entsql.Annotation{
+		Table: "invitations",
+	}
+	LinkClicksTable.ForeignKeys[0].RefTable = LinkItemsTable
+	LinkClicksTable.Annotation = &entsql.Annotation{
+		Table: "link_clicks",
+	}
 	LinkItemsTable.ForeignKeys[0].RefTable = PagesTable
+	LinkItemsTable.ForeignKeys[1].RefTable = PageSectionsTable
 	LinkItemsTable.Annotation = &entsql.Annotation{
 		Table: "link_items",
 	}
+	LinkReactionsTable.ForeignKeys[0].RefTable = LinkItemsTable
+	LinkReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	LinkReactionsTable.Annotation = &entsql.Annotation{
+		Table: "link_reactions",
+	}
+	LinkSnapshotsTable.ForeignKeys[0].RefTable = LinkItemsTable
+	LinkSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "link_snapshots",
+	}
+	LinkStatesTable.ForeignKeys[0].RefTable = LinkItemsTable
+	LinkStatesTable.ForeignKeys[1].RefTable = UsersTable
+	LinkStatesTable.Annotation = &entsql.Annotation{
+		Table: "link_states",
+	}
+	NotificationsTable.ForeignKeys[0].RefTable = PagesTable
+	NotificationsTable.ForeignKeys[1].RefTable = UsersTable
+	NotificationsTable.Annotation = &entsql.Annotation{
+		Table: "notifications",
+	}
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationPreferencesTable.Annotation = &entsql.Annotation{
+		Table: "notification_preferences",
+	}
 	PagesTable.ForeignKeys[0].RefTable = UsersTable
 	PagesTable.Annotation = &entsql.Annotation{
 		Table: "pages",
 	}
+	PagePreferencesTable.ForeignKeys[0].RefTable = PagesTable
+	PagePreferencesTable.ForeignKeys[1].RefTable = UsersTable
+	PagePreferencesTable.Annotation = &entsql.Annotation{
+		Table: "page_preferences",
+	}
+	PageSlugsTable.ForeignKeys[0].RefTable = PagesTable
+	PageSlugsTable.Annotation = &entsql.Annotation{
+		Table: "page_slugs",
+	}
+	PageSectionsTable.ForeignKeys[0].RefTable = PagesTable
+	PageSectionsTable.Annotation = &entsql.Annotation{
+		Table: "page_sections",
+	}
+	TemplatesTable.ForeignKeys[0].RefTable = UsersTable
+	TemplatesTable.Annotation = &entsql.Annotation{
+		Table: "templates",
+	}
+	TemplateLinksTable.ForeignKeys[0].RefTable = TemplatesTable
+	TemplateLinksTable.Annotation = &entsql.Annotation{
+		Table: "template_links",
+	}
 	UsersTable.Annotation = &entsql.Annotation{
 		Table: "users",
 	}
+	WebhooksTable.ForeignKeys[0].RefTable = PagesTable
+	WebhooksTable.Annotation = &entsql.Annotation{
+		Table: "webhooks",
+	}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "webhook_deliveries",
+	}
 	PageUsersTable.ForeignKeys[0].RefTable = PagesTable
 	PageUsersTable.ForeignKeys[1].RefTable = UsersTable
 	PageUsersTable.Annotation = &entsql.Annotation{
 		Table: "page_users",
 	}
+	TemplateUsersTable.ForeignKeys[0].RefTable = TemplatesTable
+	TemplateUsersTable.ForeignKeys[1].RefTable = UsersTable
+	TemplateUsersTable.Annotation = &entsql.Annotation{
+		Table: "template_users",
+	}
 }
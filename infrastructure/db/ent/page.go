@@ -29,6 +29,22 @@ type Page struct {
 	CreatorID uuid.UUID `json:"creator_id,omitempty"`
 	// InviteCode holds the value of the "invite_code" field.
 	InviteCode string `json:"invite_code,omitempty"`
+	// FeedTokenHash holds the value of the "feed_token_hash" field.
+	FeedTokenHash *string `json:"feed_token_hash,omitempty"`
+	// SourcePageID holds the value of the "source_page_id" field.
+	SourcePageID *uuid.UUID `json:"source_page_id,omitempty"`
+	// Checklist holds the value of the "checklist" field.
+	Checklist bool `json:"checklist,omitempty"`
+	// Archived holds the value of the "archived" field.
+	Archived bool `json:"archived,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Icon holds the value of the "icon" field.
+	Icon string `json:"icon,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug *string `json:"slug,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PageQuery when eager-loading is set.
 	Edges        PageEdges `json:"edges"`
@@ -41,11 +57,23 @@ type PageEdges struct {
 	Creator *User `json:"creator,omitempty"`
 	// LinkItems holds the value of the link_items edge.
 	LinkItems []*LinkItem `json:"link_items,omitempty"`
+	// Sections holds the value of the sections edge.
+	Sections []*Section `json:"sections,omitempty"`
+	// Preferences holds the value of the preferences edge.
+	Preferences []*PagePreference `json:"preferences,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Slugs holds the value of the slugs edge.
+	Slugs []*PageSlug `json:"slugs,omitempty"`
 	// InvitedUsers holds the value of the invited_users edge.
 	InvitedUsers []*User `json:"invited_users,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // CreatorOrErr returns the Creator value or an error if the edge
@@ -68,10 +96,64 @@ func (e PageEdges) LinkItemsOrErr() ([]*LinkItem, error) {
 	return nil, &NotLoadedError{edge: "link_items"}
 }
 
+// SectionsOrErr returns the Sections value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) SectionsOrErr() ([]*Section, error) {
+	if e.loadedTypes[2] {
+		return e.Sections, nil
+	}
+	return nil, &NotLoadedError{edge: "sections"}
+}
+
+// PreferencesOrErr returns the Preferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) PreferencesOrErr() ([]*PagePreference, error) {
+	if e.loadedTypes[3] {
+		return e.Preferences, nil
+	}
+	return nil, &NotLoadedError{edge: "preferences"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[4] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[5] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// SlugsOrErr returns the Slugs value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) SlugsOrErr() ([]*PageSlug, error) {
+	if e.loadedTypes[7] {
+		return e.Slugs, nil
+	}
+	return nil, &NotLoadedError{edge: "slugs"}
+}
+
 // InvitedUsersOrErr returns the InvitedUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e PageEdges) InvitedUsersOrErr() ([]*User, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[8] {
 		return e.InvitedUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "invited_users"}
@@ -82,7 +164,11 @@ func (*Page) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case page.FieldTitle, page.FieldInviteCode:
+		case page.FieldSourcePageID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case page.FieldChecklist, page.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case page.FieldTitle, page.FieldInviteCode, page.FieldFeedTokenHash, page.FieldDescription, page.FieldIcon, page.FieldColor, page.FieldSlug:
 			values[i] = new(sql.NullString)
 		case page.FieldCreatedAt, page.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -139,6 +225,57 @@ func (_m *Page) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.InviteCode = value.String
 			}
+		case page.FieldFeedTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feed_token_hash", values[i])
+			} else if value.Valid {
+				_m.FeedTokenHash = new(string)
+				*_m.FeedTokenHash = value.String
+			}
+		case page.FieldSourcePageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_page_id", values[i])
+			} else if value.Valid {
+				_m.SourcePageID = new(uuid.UUID)
+				*_m.SourcePageID = *value.S.(*uuid.UUID)
+			}
+		case page.FieldChecklist:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field checklist", values[i])
+			} else if value.Valid {
+				_m.Checklist = value.Bool
+			}
+		case page.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = value.Bool
+			}
+		case page.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case page.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
+		case page.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = value.String
+			}
+		case page.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = new(string)
+				*_m.Slug = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -162,6 +299,36 @@ func (_m *Page) QueryLinkItems() *LinkItemQuery {
 	return NewPageClient(_m.config).QueryLinkItems(_m)
 }
 
+// QuerySections queries the "sections" edge of the Page entity.
+func (_m *Page) QuerySections() *SectionQuery {
+	return NewPageClient(_m.config).QuerySections(_m)
+}
+
+// QueryPreferences queries the "preferences" edge of the Page entity.
+func (_m *Page) QueryPreferences() *PagePreferenceQuery {
+	return NewPageClient(_m.config).QueryPreferences(_m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Page entity.
+func (_m *Page) QueryInvitations() *InvitationQuery {
+	return NewPageClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Page entity.
+func (_m *Page) QueryNotifications() *NotificationQuery {
+	return NewPageClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Page entity.
+func (_m *Page) QueryWebhooks() *WebhookQuery {
+	return NewPageClient(_m.config).QueryWebhooks(_m)
+}
+
+// QuerySlugs queries the "slugs" edge of the Page entity.
+func (_m *Page) QuerySlugs() *PageSlugQuery {
+	return NewPageClient(_m.config).QuerySlugs(_m)
+}
+
 // QueryInvitedUsers queries the "invited_users" edge of the Page entity.
 func (_m *Page) QueryInvitedUsers() *UserQuery {
 	return NewPageClient(_m.config).QueryInvitedUsers(_m)
@@ -204,6 +371,36 @@ func (_m *Page) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("invite_code=")
 	builder.WriteString(_m.InviteCode)
+	builder.WriteString(", ")
+	if v := _m.FeedTokenHash; v != nil {
+		builder.WriteString("feed_token_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SourcePageID; v != nil {
+		builder.WriteString("source_page_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("checklist=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Checklist))
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	if v := _m.Slug; v != nil {
+		builder.WriteString("slug=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
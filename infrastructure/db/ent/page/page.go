@@ -25,10 +25,38 @@ const (
 	FieldCreatorID = "creator_id"
 	// FieldInviteCode holds the string denoting the invite_code field in the database.
 	FieldInviteCode = "invite_code"
+	// FieldFeedTokenHash holds the string denoting the feed_token_hash field in the database.
+	FieldFeedTokenHash = "feed_token_hash"
+	// FieldSourcePageID holds the string denoting the source_page_id field in the database.
+	FieldSourcePageID = "source_page_id"
+	// FieldChecklist holds the string denoting the checklist field in the database.
+	FieldChecklist = "checklist"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldIcon holds the string denoting the icon field in the database.
+	FieldIcon = "icon"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
 	EdgeCreator = "creator"
 	// EdgeLinkItems holds the string denoting the link_items edge name in mutations.
 	EdgeLinkItems = "link_items"
+	// EdgeSections holds the string denoting the sections edge name in mutations.
+	EdgeSections = "sections"
+	// EdgePreferences holds the string denoting the preferences edge name in mutations.
+	EdgePreferences = "preferences"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeSlugs holds the string denoting the slugs edge name in mutations.
+	EdgeSlugs = "slugs"
 	// EdgeInvitedUsers holds the string denoting the invited_users edge name in mutations.
 	EdgeInvitedUsers = "invited_users"
 	// Table holds the table name of the page in the database.
@@ -47,6 +75,48 @@ const (
 	LinkItemsInverseTable = "link_items"
 	// LinkItemsColumn is the table column denoting the link_items relation/edge.
 	LinkItemsColumn = "page_id"
+	// SectionsTable is the table that holds the sections relation/edge.
+	SectionsTable = "page_sections"
+	// SectionsInverseTable is the table name for the Section entity.
+	// It exists in this package in order to avoid circular dependency with the "section" package.
+	SectionsInverseTable = "page_sections"
+	// SectionsColumn is the table column denoting the sections relation/edge.
+	SectionsColumn = "page_id"
+	// PreferencesTable is the table that holds the preferences relation/edge.
+	PreferencesTable = "page_preferences"
+	// PreferencesInverseTable is the table name for the PagePreference entity.
+	// It exists in this package in order to avoid circular dependency with the "pagepreference" package.
+	PreferencesInverseTable = "page_preferences"
+	// PreferencesColumn is the table column denoting the preferences relation/edge.
+	PreferencesColumn = "page_id"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "invitations"
+	// InvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	InvitationsInverseTable = "invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "page_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "page_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "page_id"
+	// SlugsTable is the table that holds the slugs relation/edge.
+	SlugsTable = "page_slugs"
+	// SlugsInverseTable is the table name for the PageSlug entity.
+	// It exists in this package in order to avoid circular dependency with the "pageslug" package.
+	SlugsInverseTable = "page_slugs"
+	// SlugsColumn is the table column denoting the slugs relation/edge.
+	SlugsColumn = "page_id"
 	// InvitedUsersTable is the table that holds the invited_users relation/edge. The primary key declared below.
 	InvitedUsersTable = "page_users"
 	// InvitedUsersInverseTable is the table name for the User entity.
@@ -62,6 +132,14 @@ var Columns = []string{
 	FieldTitle,
 	FieldCreatorID,
 	FieldInviteCode,
+	FieldFeedTokenHash,
+	FieldSourcePageID,
+	FieldChecklist,
+	FieldArchived,
+	FieldDescription,
+	FieldIcon,
+	FieldColor,
+	FieldSlug,
 }
 
 var (
@@ -91,6 +169,24 @@ var (
 	TitleValidator func(string) error
 	// InviteCodeValidator is a validator for the "invite_code" field. It is called by the builders before save.
 	InviteCodeValidator func(string) error
+	// FeedTokenHashValidator is a validator for the "feed_token_hash" field. It is called by the builders before save.
+	FeedTokenHashValidator func(string) error
+	// DefaultChecklist holds the default value on creation for the "checklist" field.
+	DefaultChecklist bool
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultIcon holds the default value on creation for the "icon" field.
+	DefaultIcon string
+	// IconValidator is a validator for the "icon" field. It is called by the builders before save.
+	IconValidator func(string) error
+	// DefaultColor holds the default value on creation for the "color" field.
+	DefaultColor string
+	// ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	ColorValidator func(string) error
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -128,6 +224,46 @@ func ByInviteCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInviteCode, opts...).ToFunc()
 }
 
+// ByFeedTokenHash orders the results by the feed_token_hash field.
+func ByFeedTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedTokenHash, opts...).ToFunc()
+}
+
+// BySourcePageID orders the results by the source_page_id field.
+func BySourcePageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourcePageID, opts...).ToFunc()
+}
+
+// ByChecklist orders the results by the checklist field.
+func ByChecklist(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecklist, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByIcon orders the results by the icon field.
+func ByIcon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIcon, opts...).ToFunc()
+}
+
+// ByColor orders the results by the color field.
+func ByColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColor, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
 // ByCreatorField orders the results by creator field.
 func ByCreatorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -149,6 +285,90 @@ func ByLinkItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySectionsCount orders the results by sections count.
+func BySectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSectionsStep(), opts...)
+	}
+}
+
+// BySections orders the results by sections terms.
+func BySections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPreferencesCount orders the results by preferences count.
+func ByPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPreferencesStep(), opts...)
+	}
+}
+
+// ByPreferences orders the results by preferences terms.
+func ByPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlugsCount orders the results by slugs count.
+func BySlugsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlugsStep(), opts...)
+	}
+}
+
+// BySlugs orders the results by slugs terms.
+func BySlugs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlugsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByInvitedUsersCount orders the results by invited_users count.
 func ByInvitedUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -176,6 +396,48 @@ func newLinkItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LinkItemsTable, LinkItemsColumn),
 	)
 }
+func newSectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+	)
+}
+func newPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PreferencesTable, PreferencesColumn),
+	)
+}
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
+func newSlugsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlugsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlugsTable, SlugsColumn),
+	)
+}
 func newInvitedUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -82,6 +82,46 @@ func InviteCode(v string) predicate.Page {
 	return predicate.Page(sql.FieldEQ(FieldInviteCode, v))
 }
 
+// FeedTokenHash applies equality check predicate on the "feed_token_hash" field. It's identical to FeedTokenHashEQ.
+func FeedTokenHash(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldFeedTokenHash, v))
+}
+
+// SourcePageID applies equality check predicate on the "source_page_id" field. It's identical to SourcePageIDEQ.
+func SourcePageID(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldSourcePageID, v))
+}
+
+// Checklist applies equality check predicate on the "checklist" field. It's identical to ChecklistEQ.
+func Checklist(v bool) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldChecklist, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldArchived, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldDescription, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldIcon, v))
+}
+
+// Color applies equality check predicate on the "color" field. It's identical to ColorEQ.
+func Color(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldColor, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldSlug, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Page {
 	return predicate.Page(sql.FieldEQ(FieldCreatedAt, v))
@@ -312,6 +352,421 @@ func InviteCodeContainsFold(v string) predicate.Page {
 	return predicate.Page(sql.FieldContainsFold(FieldInviteCode, v))
 }
 
+// FeedTokenHashEQ applies the EQ predicate on the "feed_token_hash" field.
+func FeedTokenHashEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashNEQ applies the NEQ predicate on the "feed_token_hash" field.
+func FeedTokenHashNEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashIn applies the In predicate on the "feed_token_hash" field.
+func FeedTokenHashIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldFeedTokenHash, vs...))
+}
+
+// FeedTokenHashNotIn applies the NotIn predicate on the "feed_token_hash" field.
+func FeedTokenHashNotIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldFeedTokenHash, vs...))
+}
+
+// FeedTokenHashGT applies the GT predicate on the "feed_token_hash" field.
+func FeedTokenHashGT(v string) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashGTE applies the GTE predicate on the "feed_token_hash" field.
+func FeedTokenHashGTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashLT applies the LT predicate on the "feed_token_hash" field.
+func FeedTokenHashLT(v string) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashLTE applies the LTE predicate on the "feed_token_hash" field.
+func FeedTokenHashLTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashContains applies the Contains predicate on the "feed_token_hash" field.
+func FeedTokenHashContains(v string) predicate.Page {
+	return predicate.Page(sql.FieldContains(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashHasPrefix applies the HasPrefix predicate on the "feed_token_hash" field.
+func FeedTokenHashHasPrefix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasPrefix(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashHasSuffix applies the HasSuffix predicate on the "feed_token_hash" field.
+func FeedTokenHashHasSuffix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasSuffix(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashIsNil applies the IsNil predicate on the "feed_token_hash" field.
+func FeedTokenHashIsNil() predicate.Page {
+	return predicate.Page(sql.FieldIsNull(FieldFeedTokenHash))
+}
+
+// FeedTokenHashNotNil applies the NotNil predicate on the "feed_token_hash" field.
+func FeedTokenHashNotNil() predicate.Page {
+	return predicate.Page(sql.FieldNotNull(FieldFeedTokenHash))
+}
+
+// FeedTokenHashEqualFold applies the EqualFold predicate on the "feed_token_hash" field.
+func FeedTokenHashEqualFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldEqualFold(FieldFeedTokenHash, v))
+}
+
+// FeedTokenHashContainsFold applies the ContainsFold predicate on the "feed_token_hash" field.
+func FeedTokenHashContainsFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldContainsFold(FieldFeedTokenHash, v))
+}
+
+// SourcePageIDEQ applies the EQ predicate on the "source_page_id" field.
+func SourcePageIDEQ(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldSourcePageID, v))
+}
+
+// SourcePageIDNEQ applies the NEQ predicate on the "source_page_id" field.
+func SourcePageIDNEQ(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldSourcePageID, v))
+}
+
+// SourcePageIDIn applies the In predicate on the "source_page_id" field.
+func SourcePageIDIn(vs ...uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldSourcePageID, vs...))
+}
+
+// SourcePageIDNotIn applies the NotIn predicate on the "source_page_id" field.
+func SourcePageIDNotIn(vs ...uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldSourcePageID, vs...))
+}
+
+// SourcePageIDGT applies the GT predicate on the "source_page_id" field.
+func SourcePageIDGT(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldSourcePageID, v))
+}
+
+// SourcePageIDGTE applies the GTE predicate on the "source_page_id" field.
+func SourcePageIDGTE(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldSourcePageID, v))
+}
+
+// SourcePageIDLT applies the LT predicate on the "source_page_id" field.
+func SourcePageIDLT(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldSourcePageID, v))
+}
+
+// SourcePageIDLTE applies the LTE predicate on the "source_page_id" field.
+func SourcePageIDLTE(v uuid.UUID) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldSourcePageID, v))
+}
+
+// SourcePageIDIsNil applies the IsNil predicate on the "source_page_id" field.
+func SourcePageIDIsNil() predicate.Page {
+	return predicate.Page(sql.FieldIsNull(FieldSourcePageID))
+}
+
+// SourcePageIDNotNil applies the NotNil predicate on the "source_page_id" field.
+func SourcePageIDNotNil() predicate.Page {
+	return predicate.Page(sql.FieldNotNull(FieldSourcePageID))
+}
+
+// ChecklistEQ applies the EQ predicate on the "checklist" field.
+func ChecklistEQ(v bool) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldChecklist, v))
+}
+
+// ChecklistNEQ applies the NEQ predicate on the "checklist" field.
+func ChecklistNEQ(v bool) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldChecklist, v))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldArchived, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Page {
+	return predicate.Page(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.Page {
+	return predicate.Page(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldContainsFold(FieldIcon, v))
+}
+
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldColor, v))
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldColor, v))
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldColor, vs...))
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldColor, vs...))
+}
+
+// ColorGT applies the GT predicate on the "color" field.
+func ColorGT(v string) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldColor, v))
+}
+
+// ColorGTE applies the GTE predicate on the "color" field.
+func ColorGTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldColor, v))
+}
+
+// ColorLT applies the LT predicate on the "color" field.
+func ColorLT(v string) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldColor, v))
+}
+
+// ColorLTE applies the LTE predicate on the "color" field.
+func ColorLTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldColor, v))
+}
+
+// ColorContains applies the Contains predicate on the "color" field.
+func ColorContains(v string) predicate.Page {
+	return predicate.Page(sql.FieldContains(FieldColor, v))
+}
+
+// ColorHasPrefix applies the HasPrefix predicate on the "color" field.
+func ColorHasPrefix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasPrefix(FieldColor, v))
+}
+
+// ColorHasSuffix applies the HasSuffix predicate on the "color" field.
+func ColorHasSuffix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasSuffix(FieldColor, v))
+}
+
+// ColorEqualFold applies the EqualFold predicate on the "color" field.
+func ColorEqualFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldEqualFold(FieldColor, v))
+}
+
+// ColorContainsFold applies the ContainsFold predicate on the "color" field.
+func ColorContainsFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldContainsFold(FieldColor, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Page {
+	return predicate.Page(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Page {
+	return predicate.Page(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Page {
+	return predicate.Page(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Page {
+	return predicate.Page(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Page {
+	return predicate.Page(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Page {
+	return predicate.Page(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Page {
+	return predicate.Page(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Page {
+	return predicate.Page(sql.FieldIsNull(FieldSlug))
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Page {
+	return predicate.Page(sql.FieldNotNull(FieldSlug))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Page {
+	return predicate.Page(sql.FieldContainsFold(FieldSlug, v))
+}
+
 // HasCreator applies the HasEdge predicate on the "creator" edge.
 func HasCreator() predicate.Page {
 	return predicate.Page(func(s *sql.Selector) {
@@ -370,6 +825,180 @@ func HasLinkItemsWith(preds ...predicate.LinkItem) predicate.Page {
 	})
 }
 
+// HasSections applies the HasEdge predicate on the "sections" edge.
+func HasSections() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.Section
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionsWith applies the HasEdge predicate on the "sections" edge with a given conditions (other predicates).
+func HasSectionsWith(preds ...predicate.Section) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newSectionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.Section
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPreferences applies the HasEdge predicate on the "preferences" edge.
+func HasPreferences() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PreferencesTable, PreferencesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.PagePreference
+		step.Edge.Schema = schemaConfig.PagePreference
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPreferencesWith applies the HasEdge predicate on the "preferences" edge with a given conditions (other predicates).
+func HasPreferencesWith(preds ...predicate.PagePreference) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newPreferencesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.PagePreference
+		step.Edge.Schema = schemaConfig.PagePreference
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Invitation
+		step.Edge.Schema = schemaConfig.Invitation
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.Invitation) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Invitation
+		step.Edge.Schema = schemaConfig.Invitation
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Notification
+		step.Edge.Schema = schemaConfig.Notification
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Notification
+		step.Edge.Schema = schemaConfig.Notification
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSlugs applies the HasEdge predicate on the "slugs" edge.
+func HasSlugs() predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlugsTable, SlugsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.PageSlug
+		step.Edge.Schema = schemaConfig.PageSlug
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlugsWith applies the HasEdge predicate on the "slugs" edge with a given conditions (other predicates).
+func HasSlugsWith(preds ...predicate.PageSlug) predicate.Page {
+	return predicate.Page(func(s *sql.Selector) {
+		step := newSlugsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.PageSlug
+		step.Edge.Schema = schemaConfig.PageSlug
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasInvitedUsers applies the HasEdge predicate on the "invited_users" edge.
 func HasInvitedUsers() predicate.Page {
 	return predicate.Page(func(s *sql.Selector) {
@@ -11,9 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
 )
 
 // PageCreate is the builder for creating a Page entity.
@@ -69,6 +75,118 @@ func (_c *PageCreate) SetInviteCode(v string) *PageCreate {
 	return _c
 }
 
+// SetFeedTokenHash sets the "feed_token_hash" field.
+func (_c *PageCreate) SetFeedTokenHash(v string) *PageCreate {
+	_c.mutation.SetFeedTokenHash(v)
+	return _c
+}
+
+// SetNillableFeedTokenHash sets the "feed_token_hash" field if the given value is not nil.
+func (_c *PageCreate) SetNillableFeedTokenHash(v *string) *PageCreate {
+	if v != nil {
+		_c.SetFeedTokenHash(*v)
+	}
+	return _c
+}
+
+// SetSourcePageID sets the "source_page_id" field.
+func (_c *PageCreate) SetSourcePageID(v uuid.UUID) *PageCreate {
+	_c.mutation.SetSourcePageID(v)
+	return _c
+}
+
+// SetNillableSourcePageID sets the "source_page_id" field if the given value is not nil.
+func (_c *PageCreate) SetNillableSourcePageID(v *uuid.UUID) *PageCreate {
+	if v != nil {
+		_c.SetSourcePageID(*v)
+	}
+	return _c
+}
+
+// SetChecklist sets the "checklist" field.
+func (_c *PageCreate) SetChecklist(v bool) *PageCreate {
+	_c.mutation.SetChecklist(v)
+	return _c
+}
+
+// SetNillableChecklist sets the "checklist" field if the given value is not nil.
+func (_c *PageCreate) SetNillableChecklist(v *bool) *PageCreate {
+	if v != nil {
+		_c.SetChecklist(*v)
+	}
+	return _c
+}
+
+// SetArchived sets the "archived" field.
+func (_c *PageCreate) SetArchived(v bool) *PageCreate {
+	_c.mutation.SetArchived(v)
+	return _c
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_c *PageCreate) SetNillableArchived(v *bool) *PageCreate {
+	if v != nil {
+		_c.SetArchived(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PageCreate) SetDescription(v string) *PageCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PageCreate) SetNillableDescription(v *string) *PageCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *PageCreate) SetIcon(v string) *PageCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *PageCreate) SetNillableIcon(v *string) *PageCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetColor sets the "color" field.
+func (_c *PageCreate) SetColor(v string) *PageCreate {
+	_c.mutation.SetColor(v)
+	return _c
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_c *PageCreate) SetNillableColor(v *string) *PageCreate {
+	if v != nil {
+		_c.SetColor(*v)
+	}
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PageCreate) SetSlug(v string) *PageCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_c *PageCreate) SetNillableSlug(v *string) *PageCreate {
+	if v != nil {
+		_c.SetSlug(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PageCreate) SetID(v uuid.UUID) *PageCreate {
 	_c.mutation.SetID(v)
@@ -103,6 +221,96 @@ func (_c *PageCreate) AddLinkItems(v ...*LinkItem) *PageCreate {
 	return _c.AddLinkItemIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_c *PageCreate) AddSectionIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddSectionIDs(ids...)
+	return _c
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_c *PageCreate) AddSections(v ...*Section) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSectionIDs(ids...)
+}
+
+// AddPreferenceIDs adds the "preferences" edge to the PagePreference entity by IDs.
+func (_c *PageCreate) AddPreferenceIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddPreferenceIDs(ids...)
+	return _c
+}
+
+// AddPreferences adds the "preferences" edges to the PagePreference entity.
+func (_c *PageCreate) AddPreferences(v ...*PagePreference) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPreferenceIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (_c *PageCreate) AddInvitationIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddInvitationIDs(ids...)
+	return _c
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (_c *PageCreate) AddInvitations(v ...*Invitation) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvitationIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *PageCreate) AddNotificationIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *PageCreate) AddNotifications(v ...*Notification) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *PageCreate) AddWebhookIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *PageCreate) AddWebhooks(v ...*Webhook) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
+// AddSlugIDs adds the "slugs" edge to the PageSlug entity by IDs.
+func (_c *PageCreate) AddSlugIDs(ids ...uuid.UUID) *PageCreate {
+	_c.mutation.AddSlugIDs(ids...)
+	return _c
+}
+
+// AddSlugs adds the "slugs" edges to the PageSlug entity.
+func (_c *PageCreate) AddSlugs(v ...*PageSlug) *PageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSlugIDs(ids...)
+}
+
 // AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
 func (_c *PageCreate) AddInvitedUserIDs(ids ...uuid.UUID) *PageCreate {
 	_c.mutation.AddInvitedUserIDs(ids...)
@@ -161,6 +369,26 @@ func (_c *PageCreate) defaults() {
 		v := page.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Checklist(); !ok {
+		v := page.DefaultChecklist
+		_c.mutation.SetChecklist(v)
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		v := page.DefaultArchived
+		_c.mutation.SetArchived(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := page.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Icon(); !ok {
+		v := page.DefaultIcon
+		_c.mutation.SetIcon(v)
+	}
+	if _, ok := _c.mutation.Color(); !ok {
+		v := page.DefaultColor
+		_c.mutation.SetColor(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := page.DefaultID()
 		_c.mutation.SetID(v)
@@ -194,6 +422,41 @@ func (_c *PageCreate) check() error {
 			return &ValidationError{Name: "invite_code", err: fmt.Errorf(`ent: validator failed for field "Page.invite_code": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.FeedTokenHash(); ok {
+		if err := page.FeedTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "feed_token_hash", err: fmt.Errorf(`ent: validator failed for field "Page.feed_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Checklist(); !ok {
+		return &ValidationError{Name: "checklist", err: errors.New(`ent: missing required field "Page.checklist"`)}
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Page.archived"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Page.description"`)}
+	}
+	if _, ok := _c.mutation.Icon(); !ok {
+		return &ValidationError{Name: "icon", err: errors.New(`ent: missing required field "Page.icon"`)}
+	}
+	if v, ok := _c.mutation.Icon(); ok {
+		if err := page.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Page.icon": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "Page.color"`)}
+	}
+	if v, ok := _c.mutation.Color(); ok {
+		if err := page.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Page.color": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := page.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Page.slug": %w`, err)}
+		}
+	}
 	if len(_c.mutation.CreatorIDs()) == 0 {
 		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "Page.creator"`)}
 	}
@@ -249,6 +512,38 @@ func (_c *PageCreate) createSpec() (*Page, *sqlgraph.CreateSpec) {
 		_spec.SetField(page.FieldInviteCode, field.TypeString, value)
 		_node.InviteCode = value
 	}
+	if value, ok := _c.mutation.FeedTokenHash(); ok {
+		_spec.SetField(page.FieldFeedTokenHash, field.TypeString, value)
+		_node.FeedTokenHash = &value
+	}
+	if value, ok := _c.mutation.SourcePageID(); ok {
+		_spec.SetField(page.FieldSourcePageID, field.TypeUUID, value)
+		_node.SourcePageID = &value
+	}
+	if value, ok := _c.mutation.Checklist(); ok {
+		_spec.SetField(page.FieldChecklist, field.TypeBool, value)
+		_node.Checklist = value
+	}
+	if value, ok := _c.mutation.Archived(); ok {
+		_spec.SetField(page.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(page.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(page.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := _c.mutation.Color(); ok {
+		_spec.SetField(page.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(page.FieldSlug, field.TypeString, value)
+		_node.Slug = &value
+	}
 	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -284,6 +579,108 @@ func (_c *PageCreate) createSpec() (*Page, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Section
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Invitation
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Notification
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SlugsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.InvitedUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -14,22 +14,34 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
 )
 
 // PageQuery is the builder for querying Page entities.
 type PageQuery struct {
 	config
-	ctx              *QueryContext
-	order            []page.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Page
-	withCreator      *UserQuery
-	withLinkItems    *LinkItemQuery
-	withInvitedUsers *UserQuery
+	ctx               *QueryContext
+	order             []page.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Page
+	withCreator       *UserQuery
+	withLinkItems     *LinkItemQuery
+	withSections      *SectionQuery
+	withPreferences   *PagePreferenceQuery
+	withInvitations   *InvitationQuery
+	withNotifications *NotificationQuery
+	withWebhooks      *WebhookQuery
+	withSlugs         *PageSlugQuery
+	withInvitedUsers  *UserQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -116,6 +128,156 @@ func (_q *PageQuery) QueryLinkItems() *LinkItemQuery {
 	return query
 }
 
+// QuerySections chains the current query on the "sections" edge.
+func (_q *PageQuery) QuerySections() *SectionQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(section.Table, section.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.SectionsTable, page.SectionsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Section
+		step.Edge.Schema = schemaConfig.Section
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPreferences chains the current query on the "preferences" edge.
+func (_q *PageQuery) QueryPreferences() *PagePreferenceQuery {
+	query := (&PagePreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(pagepreference.Table, pagepreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.PreferencesTable, page.PreferencesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.PagePreference
+		step.Edge.Schema = schemaConfig.PagePreference
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitations chains the current query on the "invitations" edge.
+func (_q *PageQuery) QueryInvitations() *InvitationQuery {
+	query := (&InvitationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.InvitationsTable, page.InvitationsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Invitation
+		step.Edge.Schema = schemaConfig.Invitation
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (_q *PageQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.NotificationsTable, page.NotificationsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Notification
+		step.Edge.Schema = schemaConfig.Notification
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (_q *PageQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.WebhooksTable, page.WebhooksColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlugs chains the current query on the "slugs" edge.
+func (_q *PageQuery) QuerySlugs() *PageSlugQuery {
+	query := (&PageSlugClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(pageslug.Table, pageslug.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.SlugsTable, page.SlugsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.PageSlug
+		step.Edge.Schema = schemaConfig.PageSlug
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryInvitedUsers chains the current query on the "invited_users" edge.
 func (_q *PageQuery) QueryInvitedUsers() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
@@ -328,14 +490,20 @@ func (_q *PageQuery) Clone() *PageQuery {
 		return nil
 	}
 	return &PageQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]page.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Page{}, _q.predicates...),
-		withCreator:      _q.withCreator.Clone(),
-		withLinkItems:    _q.withLinkItems.Clone(),
-		withInvitedUsers: _q.withInvitedUsers.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]page.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Page{}, _q.predicates...),
+		withCreator:       _q.withCreator.Clone(),
+		withLinkItems:     _q.withLinkItems.Clone(),
+		withSections:      _q.withSections.Clone(),
+		withPreferences:   _q.withPreferences.Clone(),
+		withInvitations:   _q.withInvitations.Clone(),
+		withNotifications: _q.withNotifications.Clone(),
+		withWebhooks:      _q.withWebhooks.Clone(),
+		withSlugs:         _q.withSlugs.Clone(),
+		withInvitedUsers:  _q.withInvitedUsers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -364,6 +532,72 @@ func (_q *PageQuery) WithLinkItems(opts ...func(*LinkItemQuery)) *PageQuery {
 	return _q
 }
 
+// WithSections tells the query-builder to eager-load the nodes that are connected to
+// the "sections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithSections(opts ...func(*SectionQuery)) *PageQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSections = query
+	return _q
+}
+
+// WithPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithPreferences(opts ...func(*PagePreferenceQuery)) *PageQuery {
+	query := (&PagePreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPreferences = query
+	return _q
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithInvitations(opts ...func(*InvitationQuery)) *PageQuery {
+	query := (&InvitationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvitations = query
+	return _q
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithNotifications(opts ...func(*NotificationQuery)) *PageQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotifications = query
+	return _q
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithWebhooks(opts ...func(*WebhookQuery)) *PageQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhooks = query
+	return _q
+}
+
+// WithSlugs tells the query-builder to eager-load the nodes that are connected to
+// the "slugs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PageQuery) WithSlugs(opts ...func(*PageSlugQuery)) *PageQuery {
+	query := (&PageSlugClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSlugs = query
+	return _q
+}
+
 // WithInvitedUsers tells the query-builder to eager-load the nodes that are connected to
 // the "invited_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PageQuery) WithInvitedUsers(opts ...func(*UserQuery)) *PageQuery {
@@ -453,9 +687,15 @@ func (_q *PageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Page, e
 	var (
 		nodes       = []*Page{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			_q.withCreator != nil,
 			_q.withLinkItems != nil,
+			_q.withSections != nil,
+			_q.withPreferences != nil,
+			_q.withInvitations != nil,
+			_q.withNotifications != nil,
+			_q.withWebhooks != nil,
+			_q.withSlugs != nil,
 			_q.withInvitedUsers != nil,
 		}
 	)
@@ -492,6 +732,48 @@ func (_q *PageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Page, e
 			return nil, err
 		}
 	}
+	if query := _q.withSections; query != nil {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *Page) { n.Edges.Sections = []*Section{} },
+			func(n *Page, e *Section) { n.Edges.Sections = append(n.Edges.Sections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPreferences; query != nil {
+		if err := _q.loadPreferences(ctx, query, nodes,
+			func(n *Page) { n.Edges.Preferences = []*PagePreference{} },
+			func(n *Page, e *PagePreference) { n.Edges.Preferences = append(n.Edges.Preferences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitations; query != nil {
+		if err := _q.loadInvitations(ctx, query, nodes,
+			func(n *Page) { n.Edges.Invitations = []*Invitation{} },
+			func(n *Page, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotifications; query != nil {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *Page) { n.Edges.Notifications = []*Notification{} },
+			func(n *Page, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhooks; query != nil {
+		if err := _q.loadWebhooks(ctx, query, nodes,
+			func(n *Page) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Page, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSlugs; query != nil {
+		if err := _q.loadSlugs(ctx, query, nodes,
+			func(n *Page) { n.Edges.Slugs = []*PageSlug{} },
+			func(n *Page, e *PageSlug) { n.Edges.Slugs = append(n.Edges.Slugs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withInvitedUsers; query != nil {
 		if err := _q.loadInvitedUsers(ctx, query, nodes,
 			func(n *Page) { n.Edges.InvitedUsers = []*User{} },
@@ -561,6 +843,186 @@ func (_q *PageQuery) loadLinkItems(ctx context.Context, query *LinkItemQuery, no
 	}
 	return nil
 }
+func (_q *PageQuery) loadSections(ctx context.Context, query *SectionQuery, nodes []*Page, init func(*Page), assign func(*Page, *Section)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(section.FieldPageID)
+	}
+	query.Where(predicate.Section(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.SectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PageQuery) loadPreferences(ctx context.Context, query *PagePreferenceQuery, nodes []*Page, init func(*Page), assign func(*Page, *PagePreference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pagepreference.FieldPageID)
+	}
+	query.Where(predicate.PagePreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.PreferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PageQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*Page, init func(*Page), assign func(*Page, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invitation.FieldPageID)
+	}
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PageQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Page, init func(*Page), assign func(*Page, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldPageID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PageQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Page, init func(*Page), assign func(*Page, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldPageID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PageQuery) loadSlugs(ctx context.Context, query *PageSlugQuery, nodes []*Page, init func(*Page), assign func(*Page, *PageSlug)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Page)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pageslug.FieldPageID)
+	}
+	query.Where(predicate.PageSlug(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(page.SlugsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "page_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PageQuery) loadInvitedUsers(ctx context.Context, query *UserQuery, nodes []*Page, init func(*Page), assign func(*Page, *User)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Page)
@@ -13,10 +13,16 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
 )
 
 // PageUpdate is the builder for updating Page entities.
@@ -80,6 +86,116 @@ func (_u *PageUpdate) SetNillableInviteCode(v *string) *PageUpdate {
 	return _u
 }
 
+// SetFeedTokenHash sets the "feed_token_hash" field.
+func (_u *PageUpdate) SetFeedTokenHash(v string) *PageUpdate {
+	_u.mutation.SetFeedTokenHash(v)
+	return _u
+}
+
+// SetNillableFeedTokenHash sets the "feed_token_hash" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableFeedTokenHash(v *string) *PageUpdate {
+	if v != nil {
+		_u.SetFeedTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearFeedTokenHash clears the value of the "feed_token_hash" field.
+func (_u *PageUpdate) ClearFeedTokenHash() *PageUpdate {
+	_u.mutation.ClearFeedTokenHash()
+	return _u
+}
+
+// SetChecklist sets the "checklist" field.
+func (_u *PageUpdate) SetChecklist(v bool) *PageUpdate {
+	_u.mutation.SetChecklist(v)
+	return _u
+}
+
+// SetNillableChecklist sets the "checklist" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableChecklist(v *bool) *PageUpdate {
+	if v != nil {
+		_u.SetChecklist(*v)
+	}
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *PageUpdate) SetArchived(v bool) *PageUpdate {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableArchived(v *bool) *PageUpdate {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PageUpdate) SetDescription(v string) *PageUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableDescription(v *string) *PageUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *PageUpdate) SetIcon(v string) *PageUpdate {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableIcon(v *string) *PageUpdate {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *PageUpdate) SetColor(v string) *PageUpdate {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableColor(v *string) *PageUpdate {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PageUpdate) SetSlug(v string) *PageUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PageUpdate) SetNillableSlug(v *string) *PageUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *PageUpdate) ClearSlug() *PageUpdate {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetCreator sets the "creator" edge to the User entity.
 func (_u *PageUpdate) SetCreator(v *User) *PageUpdate {
 	return _u.SetCreatorID(v.ID)
@@ -100,6 +216,96 @@ func (_u *PageUpdate) AddLinkItems(v ...*LinkItem) *PageUpdate {
 	return _u.AddLinkItemIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_u *PageUpdate) AddSectionIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_u *PageUpdate) AddSections(v ...*Section) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddPreferenceIDs adds the "preferences" edge to the PagePreference entity by IDs.
+func (_u *PageUpdate) AddPreferenceIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddPreferenceIDs(ids...)
+	return _u
+}
+
+// AddPreferences adds the "preferences" edges to the PagePreference entity.
+func (_u *PageUpdate) AddPreferences(v ...*PagePreference) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPreferenceIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (_u *PageUpdate) AddInvitationIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddInvitationIDs(ids...)
+	return _u
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (_u *PageUpdate) AddInvitations(v ...*Invitation) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitationIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *PageUpdate) AddNotificationIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *PageUpdate) AddNotifications(v ...*Notification) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *PageUpdate) AddWebhookIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *PageUpdate) AddWebhooks(v ...*Webhook) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddSlugIDs adds the "slugs" edge to the PageSlug entity by IDs.
+func (_u *PageUpdate) AddSlugIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.AddSlugIDs(ids...)
+	return _u
+}
+
+// AddSlugs adds the "slugs" edges to the PageSlug entity.
+func (_u *PageUpdate) AddSlugs(v ...*PageSlug) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugIDs(ids...)
+}
+
 // AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
 func (_u *PageUpdate) AddInvitedUserIDs(ids ...uuid.UUID) *PageUpdate {
 	_u.mutation.AddInvitedUserIDs(ids...)
@@ -147,6 +353,132 @@ func (_u *PageUpdate) RemoveLinkItems(v ...*LinkItem) *PageUpdate {
 	return _u.RemoveLinkItemIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the Section entity.
+func (_u *PageUpdate) ClearSections() *PageUpdate {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to Section entities by IDs.
+func (_u *PageUpdate) RemoveSectionIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to Section entities.
+func (_u *PageUpdate) RemoveSections(v ...*Section) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearPreferences clears all "preferences" edges to the PagePreference entity.
+func (_u *PageUpdate) ClearPreferences() *PageUpdate {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
+// RemovePreferenceIDs removes the "preferences" edge to PagePreference entities by IDs.
+func (_u *PageUpdate) RemovePreferenceIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemovePreferenceIDs(ids...)
+	return _u
+}
+
+// RemovePreferences removes "preferences" edges to PagePreference entities.
+func (_u *PageUpdate) RemovePreferences(v ...*PagePreference) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePreferenceIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (_u *PageUpdate) ClearInvitations() *PageUpdate {
+	_u.mutation.ClearInvitations()
+	return _u
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (_u *PageUpdate) RemoveInvitationIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemoveInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (_u *PageUpdate) RemoveInvitations(v ...*Invitation) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvitationIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *PageUpdate) ClearNotifications() *PageUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *PageUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *PageUpdate) RemoveNotifications(v ...*Notification) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *PageUpdate) ClearWebhooks() *PageUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *PageUpdate) RemoveWebhookIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *PageUpdate) RemoveWebhooks(v ...*Webhook) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearSlugs clears all "slugs" edges to the PageSlug entity.
+func (_u *PageUpdate) ClearSlugs() *PageUpdate {
+	_u.mutation.ClearSlugs()
+	return _u
+}
+
+// RemoveSlugIDs removes the "slugs" edge to PageSlug entities by IDs.
+func (_u *PageUpdate) RemoveSlugIDs(ids ...uuid.UUID) *PageUpdate {
+	_u.mutation.RemoveSlugIDs(ids...)
+	return _u
+}
+
+// RemoveSlugs removes "slugs" edges to PageSlug entities.
+func (_u *PageUpdate) RemoveSlugs(v ...*PageSlug) *PageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSlugIDs(ids...)
+}
+
 // ClearInvitedUsers clears all "invited_users" edges to the User entity.
 func (_u *PageUpdate) ClearInvitedUsers() *PageUpdate {
 	_u.mutation.ClearInvitedUsers()
@@ -216,6 +548,26 @@ func (_u *PageUpdate) check() error {
 			return &ValidationError{Name: "invite_code", err: fmt.Errorf(`ent: validator failed for field "Page.invite_code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FeedTokenHash(); ok {
+		if err := page.FeedTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "feed_token_hash", err: fmt.Errorf(`ent: validator failed for field "Page.feed_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Icon(); ok {
+		if err := page.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Page.icon": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Color(); ok {
+		if err := page.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Page.color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := page.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Page.slug": %w`, err)}
+		}
+	}
 	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Page.creator"`)
 	}
@@ -243,6 +595,36 @@ func (_u *PageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.InviteCode(); ok {
 		_spec.SetField(page.FieldInviteCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.FeedTokenHash(); ok {
+		_spec.SetField(page.FieldFeedTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.FeedTokenHashCleared() {
+		_spec.ClearField(page.FieldFeedTokenHash, field.TypeString)
+	}
+	if _u.mutation.SourcePageIDCleared() {
+		_spec.ClearField(page.FieldSourcePageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Checklist(); ok {
+		_spec.SetField(page.FieldChecklist, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(page.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(page.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(page.FieldIcon, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(page.FieldColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(page.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(page.FieldSlug, field.TypeString)
+	}
 	if _u.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -322,78 +704,366 @@ func (_u *PageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitedUsersCleared() {
+	if _u.mutation.SectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   page.InvitedUsersTable,
-			Columns: page.InvitedUsersPrimaryKey,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
 			},
 		}
-		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		edge.Schema = _u.schemaConfig.Section
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvitedUsersIDs(); len(nodes) > 0 && !_u.mutation.InvitedUsersCleared() {
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   page.InvitedUsersTable,
-			Columns: page.InvitedUsersPrimaryKey,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
 			},
 		}
-		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		edge.Schema = _u.schemaConfig.Section
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitedUsersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   page.InvitedUsersTable,
-			Columns: page.InvitedUsersPrimaryKey,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
 			},
 		}
-		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		edge.Schema = _u.schemaConfig.Section
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.Schema = _u.schemaConfig.Page
-	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{page.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		edge.Schema = _u.schemaConfig.PagePreference
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// PageUpdateOne is the builder for updating a single Page entity.
-type PageUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *PageMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *PageUpdateOne) SetUpdatedAt(v time.Time) *PageUpdateOne {
+	if nodes := _u.mutation.RemovedPreferencesIDs(); len(nodes) > 0 && !_u.mutation.PreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugsIDs(); len(nodes) > 0 && !_u.mutation.SlugsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   page.InvitedUsersTable,
+			Columns: page.InvitedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitedUsersIDs(); len(nodes) > 0 && !_u.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   page.InvitedUsersTable,
+			Columns: page.InvitedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   page.InvitedUsersTable,
+			Columns: page.InvitedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageInvitedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Page
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{page.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PageUpdateOne is the builder for updating a single Page entity.
+type PageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PageUpdateOne) SetUpdatedAt(v time.Time) *PageUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
@@ -440,71 +1110,397 @@ func (_u *PageUpdateOne) SetNillableInviteCode(v *string) *PageUpdateOne {
 	return _u
 }
 
+// SetFeedTokenHash sets the "feed_token_hash" field.
+func (_u *PageUpdateOne) SetFeedTokenHash(v string) *PageUpdateOne {
+	_u.mutation.SetFeedTokenHash(v)
+	return _u
+}
+
+// SetNillableFeedTokenHash sets the "feed_token_hash" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableFeedTokenHash(v *string) *PageUpdateOne {
+	if v != nil {
+		_u.SetFeedTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearFeedTokenHash clears the value of the "feed_token_hash" field.
+func (_u *PageUpdateOne) ClearFeedTokenHash() *PageUpdateOne {
+	_u.mutation.ClearFeedTokenHash()
+	return _u
+}
+
+// SetChecklist sets the "checklist" field.
+func (_u *PageUpdateOne) SetChecklist(v bool) *PageUpdateOne {
+	_u.mutation.SetChecklist(v)
+	return _u
+}
+
+// SetNillableChecklist sets the "checklist" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableChecklist(v *bool) *PageUpdateOne {
+	if v != nil {
+		_u.SetChecklist(*v)
+	}
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *PageUpdateOne) SetArchived(v bool) *PageUpdateOne {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableArchived(v *bool) *PageUpdateOne {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PageUpdateOne) SetDescription(v string) *PageUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableDescription(v *string) *PageUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *PageUpdateOne) SetIcon(v string) *PageUpdateOne {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableIcon(v *string) *PageUpdateOne {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *PageUpdateOne) SetColor(v string) *PageUpdateOne {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableColor(v *string) *PageUpdateOne {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PageUpdateOne) SetSlug(v string) *PageUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PageUpdateOne) SetNillableSlug(v *string) *PageUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *PageUpdateOne) ClearSlug() *PageUpdateOne {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetCreator sets the "creator" edge to the User entity.
 func (_u *PageUpdateOne) SetCreator(v *User) *PageUpdateOne {
 	return _u.SetCreatorID(v.ID)
 }
 
-// AddLinkItemIDs adds the "link_items" edge to the LinkItem entity by IDs.
-func (_u *PageUpdateOne) AddLinkItemIDs(ids ...uuid.UUID) *PageUpdateOne {
-	_u.mutation.AddLinkItemIDs(ids...)
+// AddLinkItemIDs adds the "link_items" edge to the LinkItem entity by IDs.
+func (_u *PageUpdateOne) AddLinkItemIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddLinkItemIDs(ids...)
+	return _u
+}
+
+// AddLinkItems adds the "link_items" edges to the LinkItem entity.
+func (_u *PageUpdateOne) AddLinkItems(v ...*LinkItem) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkItemIDs(ids...)
+}
+
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_u *PageUpdateOne) AddSectionIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_u *PageUpdateOne) AddSections(v ...*Section) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddPreferenceIDs adds the "preferences" edge to the PagePreference entity by IDs.
+func (_u *PageUpdateOne) AddPreferenceIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddPreferenceIDs(ids...)
+	return _u
+}
+
+// AddPreferences adds the "preferences" edges to the PagePreference entity.
+func (_u *PageUpdateOne) AddPreferences(v ...*PagePreference) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPreferenceIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (_u *PageUpdateOne) AddInvitationIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddInvitationIDs(ids...)
+	return _u
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (_u *PageUpdateOne) AddInvitations(v ...*Invitation) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitationIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *PageUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *PageUpdateOne) AddNotifications(v ...*Notification) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *PageUpdateOne) AddWebhookIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *PageUpdateOne) AddWebhooks(v ...*Webhook) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddSlugIDs adds the "slugs" edge to the PageSlug entity by IDs.
+func (_u *PageUpdateOne) AddSlugIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddSlugIDs(ids...)
+	return _u
+}
+
+// AddSlugs adds the "slugs" edges to the PageSlug entity.
+func (_u *PageUpdateOne) AddSlugs(v ...*PageSlug) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugIDs(ids...)
+}
+
+// AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
+func (_u *PageUpdateOne) AddInvitedUserIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.AddInvitedUserIDs(ids...)
+	return _u
+}
+
+// AddInvitedUsers adds the "invited_users" edges to the User entity.
+func (_u *PageUpdateOne) AddInvitedUsers(v ...*User) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitedUserIDs(ids...)
+}
+
+// Mutation returns the PageMutation object of the builder.
+func (_u *PageUpdateOne) Mutation() *PageMutation {
+	return _u.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (_u *PageUpdateOne) ClearCreator() *PageUpdateOne {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// ClearLinkItems clears all "link_items" edges to the LinkItem entity.
+func (_u *PageUpdateOne) ClearLinkItems() *PageUpdateOne {
+	_u.mutation.ClearLinkItems()
+	return _u
+}
+
+// RemoveLinkItemIDs removes the "link_items" edge to LinkItem entities by IDs.
+func (_u *PageUpdateOne) RemoveLinkItemIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveLinkItemIDs(ids...)
+	return _u
+}
+
+// RemoveLinkItems removes "link_items" edges to LinkItem entities.
+func (_u *PageUpdateOne) RemoveLinkItems(v ...*LinkItem) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkItemIDs(ids...)
+}
+
+// ClearSections clears all "sections" edges to the Section entity.
+func (_u *PageUpdateOne) ClearSections() *PageUpdateOne {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to Section entities by IDs.
+func (_u *PageUpdateOne) RemoveSectionIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to Section entities.
+func (_u *PageUpdateOne) RemoveSections(v ...*Section) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearPreferences clears all "preferences" edges to the PagePreference entity.
+func (_u *PageUpdateOne) ClearPreferences() *PageUpdateOne {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
+// RemovePreferenceIDs removes the "preferences" edge to PagePreference entities by IDs.
+func (_u *PageUpdateOne) RemovePreferenceIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemovePreferenceIDs(ids...)
+	return _u
+}
+
+// RemovePreferences removes "preferences" edges to PagePreference entities.
+func (_u *PageUpdateOne) RemovePreferences(v ...*PagePreference) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePreferenceIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (_u *PageUpdateOne) ClearInvitations() *PageUpdateOne {
+	_u.mutation.ClearInvitations()
 	return _u
 }
 
-// AddLinkItems adds the "link_items" edges to the LinkItem entity.
-func (_u *PageUpdateOne) AddLinkItems(v ...*LinkItem) *PageUpdateOne {
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (_u *PageUpdateOne) RemoveInvitationIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (_u *PageUpdateOne) RemoveInvitations(v ...*Invitation) *PageUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddLinkItemIDs(ids...)
+	return _u.RemoveInvitationIDs(ids...)
 }
 
-// AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
-func (_u *PageUpdateOne) AddInvitedUserIDs(ids ...uuid.UUID) *PageUpdateOne {
-	_u.mutation.AddInvitedUserIDs(ids...)
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *PageUpdateOne) ClearNotifications() *PageUpdateOne {
+	_u.mutation.ClearNotifications()
 	return _u
 }
 
-// AddInvitedUsers adds the "invited_users" edges to the User entity.
-func (_u *PageUpdateOne) AddInvitedUsers(v ...*User) *PageUpdateOne {
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *PageUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *PageUpdateOne) RemoveNotifications(v ...*Notification) *PageUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInvitedUserIDs(ids...)
+	return _u.RemoveNotificationIDs(ids...)
 }
 
-// Mutation returns the PageMutation object of the builder.
-func (_u *PageUpdateOne) Mutation() *PageMutation {
-	return _u.mutation
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *PageUpdateOne) ClearWebhooks() *PageUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (_u *PageUpdateOne) ClearCreator() *PageUpdateOne {
-	_u.mutation.ClearCreator()
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *PageUpdateOne) RemoveWebhookIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
 	return _u
 }
 
-// ClearLinkItems clears all "link_items" edges to the LinkItem entity.
-func (_u *PageUpdateOne) ClearLinkItems() *PageUpdateOne {
-	_u.mutation.ClearLinkItems()
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *PageUpdateOne) RemoveWebhooks(v ...*Webhook) *PageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearSlugs clears all "slugs" edges to the PageSlug entity.
+func (_u *PageUpdateOne) ClearSlugs() *PageUpdateOne {
+	_u.mutation.ClearSlugs()
 	return _u
 }
 
-// RemoveLinkItemIDs removes the "link_items" edge to LinkItem entities by IDs.
-func (_u *PageUpdateOne) RemoveLinkItemIDs(ids ...uuid.UUID) *PageUpdateOne {
-	_u.mutation.RemoveLinkItemIDs(ids...)
+// RemoveSlugIDs removes the "slugs" edge to PageSlug entities by IDs.
+func (_u *PageUpdateOne) RemoveSlugIDs(ids ...uuid.UUID) *PageUpdateOne {
+	_u.mutation.RemoveSlugIDs(ids...)
 	return _u
 }
 
-// RemoveLinkItems removes "link_items" edges to LinkItem entities.
-func (_u *PageUpdateOne) RemoveLinkItems(v ...*LinkItem) *PageUpdateOne {
+// RemoveSlugs removes "slugs" edges to PageSlug entities.
+func (_u *PageUpdateOne) RemoveSlugs(v ...*PageSlug) *PageUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLinkItemIDs(ids...)
+	return _u.RemoveSlugIDs(ids...)
 }
 
 // ClearInvitedUsers clears all "invited_users" edges to the User entity.
@@ -589,6 +1585,26 @@ func (_u *PageUpdateOne) check() error {
 			return &ValidationError{Name: "invite_code", err: fmt.Errorf(`ent: validator failed for field "Page.invite_code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FeedTokenHash(); ok {
+		if err := page.FeedTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "feed_token_hash", err: fmt.Errorf(`ent: validator failed for field "Page.feed_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Icon(); ok {
+		if err := page.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Page.icon": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Color(); ok {
+		if err := page.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Page.color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := page.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Page.slug": %w`, err)}
+		}
+	}
 	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Page.creator"`)
 	}
@@ -633,6 +1649,36 @@ func (_u *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error) {
 	if value, ok := _u.mutation.InviteCode(); ok {
 		_spec.SetField(page.FieldInviteCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.FeedTokenHash(); ok {
+		_spec.SetField(page.FieldFeedTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.FeedTokenHashCleared() {
+		_spec.ClearField(page.FieldFeedTokenHash, field.TypeString)
+	}
+	if _u.mutation.SourcePageIDCleared() {
+		_spec.ClearField(page.FieldSourcePageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Checklist(); ok {
+		_spec.SetField(page.FieldChecklist, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(page.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(page.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(page.FieldIcon, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(page.FieldColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(page.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(page.FieldSlug, field.TypeString)
+	}
 	if _u.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -712,6 +1758,294 @@ func (_u *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SectionsTable,
+			Columns: []string{page.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PagePreference
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPreferencesIDs(); len(nodes) > 0 && !_u.mutation.PreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.PreferencesTable,
+			Columns: []string{page.PreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.InvitationsTable,
+			Columns: []string{page.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Invitation
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.NotificationsTable,
+			Columns: []string{page.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Notification
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.WebhooksTable,
+			Columns: []string{page.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugsIDs(); len(nodes) > 0 && !_u.mutation.SlugsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.SlugsTable,
+			Columns: []string{page.SlugsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.InvitedUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
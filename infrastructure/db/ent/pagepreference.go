@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// PagePreference is the model entity for the PagePreference schema.
+type PagePreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// PageID holds the value of the "page_id" field.
+	PageID uuid.UUID `json:"page_id,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Inbox holds the value of the "inbox" field.
+	Inbox bool `json:"inbox,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PagePreferenceQuery when eager-loading is set.
+	Edges        PagePreferenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PagePreferenceEdges holds the relations/edges for other nodes in the graph.
+type PagePreferenceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Page holds the value of the page edge.
+	Page *Page `json:"page,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PagePreferenceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PageOrErr returns the Page value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PagePreferenceEdges) PageOrErr() (*Page, error) {
+	if e.Page != nil {
+		return e.Page, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: page.Label}
+	}
+	return nil, &NotLoadedError{edge: "page"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PagePreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pagepreference.FieldPinned, pagepreference.FieldInbox:
+			values[i] = new(sql.NullBool)
+		case pagepreference.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case pagepreference.FieldCreatedAt, pagepreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pagepreference.FieldID, pagepreference.FieldUserID, pagepreference.FieldPageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PagePreference fields.
+func (_m *PagePreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pagepreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case pagepreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case pagepreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case pagepreference.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case pagepreference.FieldPageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field page_id", values[i])
+			} else if value != nil {
+				_m.PageID = *value
+			}
+		case pagepreference.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				_m.Pinned = value.Bool
+			}
+		case pagepreference.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case pagepreference.FieldInbox:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field inbox", values[i])
+			} else if value.Valid {
+				_m.Inbox = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PagePreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *PagePreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PagePreference entity.
+func (_m *PagePreference) QueryUser() *UserQuery {
+	return NewPagePreferenceClient(_m.config).QueryUser(_m)
+}
+
+// QueryPage queries the "page" edge of the PagePreference entity.
+func (_m *PagePreference) QueryPage() *PageQuery {
+	return NewPagePreferenceClient(_m.config).QueryPage(_m)
+}
+
+// Update returns a builder for updating this PagePreference.
+// Note that you need to call PagePreference.Unwrap() before calling this method if this PagePreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PagePreference) Update() *PagePreferenceUpdateOne {
+	return NewPagePreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PagePreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PagePreference) Unwrap() *PagePreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PagePreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PagePreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("PagePreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("page_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageID))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pinned))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("inbox=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Inbox))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PagePreferences is a parsable slice of PagePreference.
+type PagePreferences []*PagePreference
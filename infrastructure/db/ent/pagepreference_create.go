@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// PagePreferenceCreate is the builder for creating a PagePreference entity.
+type PagePreferenceCreate struct {
+	config
+	mutation *PagePreferenceMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PagePreferenceCreate) SetCreatedAt(v time.Time) *PagePreferenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillableCreatedAt(v *time.Time) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PagePreferenceCreate) SetUpdatedAt(v time.Time) *PagePreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillableUpdatedAt(v *time.Time) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PagePreferenceCreate) SetUserID(v uuid.UUID) *PagePreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPageID sets the "page_id" field.
+func (_c *PagePreferenceCreate) SetPageID(v uuid.UUID) *PagePreferenceCreate {
+	_c.mutation.SetPageID(v)
+	return _c
+}
+
+// SetPinned sets the "pinned" field.
+func (_c *PagePreferenceCreate) SetPinned(v bool) *PagePreferenceCreate {
+	_c.mutation.SetPinned(v)
+	return _c
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillablePinned(v *bool) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetPinned(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *PagePreferenceCreate) SetPosition(v int) *PagePreferenceCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillablePosition(v *int) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetInbox sets the "inbox" field.
+func (_c *PagePreferenceCreate) SetInbox(v bool) *PagePreferenceCreate {
+	_c.mutation.SetInbox(v)
+	return _c
+}
+
+// SetNillableInbox sets the "inbox" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillableInbox(v *bool) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetInbox(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PagePreferenceCreate) SetID(v uuid.UUID) *PagePreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PagePreferenceCreate) SetNillableID(v *uuid.UUID) *PagePreferenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PagePreferenceCreate) SetUser(v *User) *PagePreferenceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetPage sets the "page" edge to the Page entity.
+func (_c *PagePreferenceCreate) SetPage(v *Page) *PagePreferenceCreate {
+	return _c.SetPageID(v.ID)
+}
+
+// Mutation returns the PagePreferenceMutation object of the builder.
+func (_c *PagePreferenceCreate) Mutation() *PagePreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the PagePreference in the database.
+func (_c *PagePreferenceCreate) Save(ctx context.Context) (*PagePreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PagePreferenceCreate) SaveX(ctx context.Context) *PagePreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PagePreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PagePreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PagePreferenceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pagepreference.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := pagepreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		v := pagepreference.DefaultPinned
+		_c.mutation.SetPinned(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := pagepreference.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.Inbox(); !ok {
+		v := pagepreference.DefaultInbox
+		_c.mutation.SetInbox(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := pagepreference.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PagePreferenceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PagePreference.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PagePreference.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PagePreference.user_id"`)}
+	}
+	if _, ok := _c.mutation.PageID(); !ok {
+		return &ValidationError{Name: "page_id", err: errors.New(`ent: missing required field "PagePreference.page_id"`)}
+	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "PagePreference.pinned"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PagePreference.position"`)}
+	}
+	if _, ok := _c.mutation.Inbox(); !ok {
+		return &ValidationError{Name: "inbox", err: errors.New(`ent: missing required field "PagePreference.inbox"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PagePreference.user"`)}
+	}
+	if len(_c.mutation.PageIDs()) == 0 {
+		return &ValidationError{Name: "page", err: errors.New(`ent: missing required edge "PagePreference.page"`)}
+	}
+	return nil
+}
+
+func (_c *PagePreferenceCreate) sqlSave(ctx context.Context) (*PagePreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PagePreferenceCreate) createSpec() (*PagePreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PagePreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pagepreference.Table, sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.PagePreference
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pagepreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(pagepreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Pinned(); ok {
+		_spec.SetField(pagepreference.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(pagepreference.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Inbox(); ok {
+		_spec.SetField(pagepreference.FieldInbox, field.TypeBool, value)
+		_node.Inbox = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pagepreference.UserTable,
+			Columns: []string{pagepreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pagepreference.PageTable,
+			Columns: []string{pagepreference.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.PagePreference
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PagePreferenceCreateBulk is the builder for creating many PagePreference entities in bulk.
+type PagePreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*PagePreferenceCreate
+}
+
+// Save creates the PagePreference entities in the database.
+func (_c *PagePreferenceCreateBulk) Save(ctx context.Context) ([]*PagePreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PagePreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PagePreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PagePreferenceCreateBulk) SaveX(ctx context.Context) []*PagePreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PagePreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PagePreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// PagePreferenceUpdate is the builder for updating PagePreference entities.
+type PagePreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PagePreferenceMutation
+}
+
+// Where appends a list predicates to the PagePreferenceUpdate builder.
+func (_u *PagePreferenceUpdate) Where(ps ...predicate.PagePreference) *PagePreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PagePreferenceUpdate) SetUpdatedAt(v time.Time) *PagePreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *PagePreferenceUpdate) SetPinned(v bool) *PagePreferenceUpdate {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *PagePreferenceUpdate) SetNillablePinned(v *bool) *PagePreferenceUpdate {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PagePreferenceUpdate) SetPosition(v int) *PagePreferenceUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PagePreferenceUpdate) SetNillablePosition(v *int) *PagePreferenceUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PagePreferenceUpdate) AddPosition(v int) *PagePreferenceUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetInbox sets the "inbox" field.
+func (_u *PagePreferenceUpdate) SetInbox(v bool) *PagePreferenceUpdate {
+	_u.mutation.SetInbox(v)
+	return _u
+}
+
+// SetNillableInbox sets the "inbox" field if the given value is not nil.
+func (_u *PagePreferenceUpdate) SetNillableInbox(v *bool) *PagePreferenceUpdate {
+	if v != nil {
+		_u.SetInbox(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PagePreferenceMutation object of the builder.
+func (_u *PagePreferenceUpdate) Mutation() *PagePreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PagePreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PagePreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PagePreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PagePreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PagePreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pagepreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PagePreferenceUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PagePreference.user"`)
+	}
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PagePreference.page"`)
+	}
+	return nil
+}
+
+func (_u *PagePreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pagepreference.Table, pagepreference.Columns, sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pagepreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(pagepreference.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(pagepreference.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(pagepreference.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Inbox(); ok {
+		_spec.SetField(pagepreference.FieldInbox, field.TypeBool, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.PagePreference
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pagepreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PagePreferenceUpdateOne is the builder for updating a single PagePreference entity.
+type PagePreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PagePreferenceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PagePreferenceUpdateOne) SetUpdatedAt(v time.Time) *PagePreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *PagePreferenceUpdateOne) SetPinned(v bool) *PagePreferenceUpdateOne {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *PagePreferenceUpdateOne) SetNillablePinned(v *bool) *PagePreferenceUpdateOne {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PagePreferenceUpdateOne) SetPosition(v int) *PagePreferenceUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PagePreferenceUpdateOne) SetNillablePosition(v *int) *PagePreferenceUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PagePreferenceUpdateOne) AddPosition(v int) *PagePreferenceUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetInbox sets the "inbox" field.
+func (_u *PagePreferenceUpdateOne) SetInbox(v bool) *PagePreferenceUpdateOne {
+	_u.mutation.SetInbox(v)
+	return _u
+}
+
+// SetNillableInbox sets the "inbox" field if the given value is not nil.
+func (_u *PagePreferenceUpdateOne) SetNillableInbox(v *bool) *PagePreferenceUpdateOne {
+	if v != nil {
+		_u.SetInbox(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PagePreferenceMutation object of the builder.
+func (_u *PagePreferenceUpdateOne) Mutation() *PagePreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PagePreferenceUpdate builder.
+func (_u *PagePreferenceUpdateOne) Where(ps ...predicate.PagePreference) *PagePreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PagePreferenceUpdateOne) Select(field string, fields ...string) *PagePreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PagePreference entity.
+func (_u *PagePreferenceUpdateOne) Save(ctx context.Context) (*PagePreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PagePreferenceUpdateOne) SaveX(ctx context.Context) *PagePreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PagePreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PagePreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PagePreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pagepreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PagePreferenceUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PagePreference.user"`)
+	}
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PagePreference.page"`)
+	}
+	return nil
+}
+
+func (_u *PagePreferenceUpdateOne) sqlSave(ctx context.Context) (_node *PagePreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pagepreference.Table, pagepreference.Columns, sqlgraph.NewFieldSpec(pagepreference.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PagePreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pagepreference.FieldID)
+		for _, f := range fields {
+			if !pagepreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pagepreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pagepreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(pagepreference.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(pagepreference.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(pagepreference.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Inbox(); ok {
+		_spec.SetField(pagepreference.FieldInbox, field.TypeBool, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.PagePreference
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &PagePreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pagepreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+)
+
+// PageSlug is the model entity for the PageSlug schema.
+type PageSlug struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// PageID holds the value of the "page_id" field.
+	PageID uuid.UUID `json:"page_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PageSlugQuery when eager-loading is set.
+	Edges        PageSlugEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PageSlugEdges holds the relations/edges for other nodes in the graph.
+type PageSlugEdges struct {
+	// Page holds the value of the page edge.
+	Page *Page `json:"page,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PageOrErr returns the Page value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PageSlugEdges) PageOrErr() (*Page, error) {
+	if e.Page != nil {
+		return e.Page, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: page.Label}
+	}
+	return nil, &NotLoadedError{edge: "page"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PageSlug) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pageslug.FieldSlug:
+			values[i] = new(sql.NullString)
+		case pageslug.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pageslug.FieldID, pageslug.FieldPageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PageSlug fields.
+func (_m *PageSlug) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pageslug.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case pageslug.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case pageslug.FieldPageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field page_id", values[i])
+			} else if value != nil {
+				_m.PageID = *value
+			}
+		case pageslug.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PageSlug.
+// This includes values selected through modifiers, order, etc.
+func (_m *PageSlug) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPage queries the "page" edge of the PageSlug entity.
+func (_m *PageSlug) QueryPage() *PageQuery {
+	return NewPageSlugClient(_m.config).QueryPage(_m)
+}
+
+// Update returns a builder for updating this PageSlug.
+// Note that you need to call PageSlug.Unwrap() before calling this method if this PageSlug
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PageSlug) Update() *PageSlugUpdateOne {
+	return NewPageSlugClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PageSlug entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PageSlug) Unwrap() *PageSlug {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PageSlug is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PageSlug) String() string {
+	var builder strings.Builder
+	builder.WriteString("PageSlug(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("page_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PageSlugs is a parsable slice of PageSlug.
+type PageSlugs []*PageSlug
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+)
+
+// PageSlugCreate is the builder for creating a PageSlug entity.
+type PageSlugCreate struct {
+	config
+	mutation *PageSlugMutation
+	hooks    []Hook
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PageSlugCreate) SetSlug(v string) *PageSlugCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetPageID sets the "page_id" field.
+func (_c *PageSlugCreate) SetPageID(v uuid.UUID) *PageSlugCreate {
+	_c.mutation.SetPageID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PageSlugCreate) SetCreatedAt(v time.Time) *PageSlugCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PageSlugCreate) SetID(v uuid.UUID) *PageSlugCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PageSlugCreate) SetNillableID(v *uuid.UUID) *PageSlugCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPage sets the "page" edge to the Page entity.
+func (_c *PageSlugCreate) SetPage(v *Page) *PageSlugCreate {
+	return _c.SetPageID(v.ID)
+}
+
+// Mutation returns the PageSlugMutation object of the builder.
+func (_c *PageSlugCreate) Mutation() *PageSlugMutation {
+	return _c.mutation
+}
+
+// Save creates the PageSlug in the database.
+func (_c *PageSlugCreate) Save(ctx context.Context) (*PageSlug, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PageSlugCreate) SaveX(ctx context.Context) *PageSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PageSlugCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PageSlugCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PageSlugCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := pageslug.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PageSlugCreate) check() error {
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PageSlug.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := pageslug.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PageSlug.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PageID(); !ok {
+		return &ValidationError{Name: "page_id", err: errors.New(`ent: missing required field "PageSlug.page_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PageSlug.created_at"`)}
+	}
+	if len(_c.mutation.PageIDs()) == 0 {
+		return &ValidationError{Name: "page", err: errors.New(`ent: missing required edge "PageSlug.page"`)}
+	}
+	return nil
+}
+
+func (_c *PageSlugCreate) sqlSave(ctx context.Context) (*PageSlug, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PageSlugCreate) createSpec() (*PageSlug, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PageSlug{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pageslug.Table, sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.PageSlug
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(pageslug.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pageslug.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pageslug.PageTable,
+			Columns: []string{pageslug.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.PageSlug
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PageSlugCreateBulk is the builder for creating many PageSlug entities in bulk.
+type PageSlugCreateBulk struct {
+	config
+	err      error
+	builders []*PageSlugCreate
+}
+
+// Save creates the PageSlug entities in the database.
+func (_c *PageSlugCreateBulk) Save(ctx context.Context) ([]*PageSlug, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PageSlug, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PageSlugMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PageSlugCreateBulk) SaveX(ctx context.Context) []*PageSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PageSlugCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PageSlugCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+)
+
+// PageSlugUpdate is the builder for updating PageSlug entities.
+type PageSlugUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PageSlugMutation
+}
+
+// Where appends a list predicates to the PageSlugUpdate builder.
+func (_u *PageSlugUpdate) Where(ps ...predicate.PageSlug) *PageSlugUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PageSlugMutation object of the builder.
+func (_u *PageSlugUpdate) Mutation() *PageSlugMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PageSlugUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PageSlugUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PageSlugUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PageSlugUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PageSlugUpdate) check() error {
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PageSlug.page"`)
+	}
+	return nil
+}
+
+func (_u *PageSlugUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pageslug.Table, pageslug.Columns, sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = _u.schemaConfig.PageSlug
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pageslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PageSlugUpdateOne is the builder for updating a single PageSlug entity.
+type PageSlugUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PageSlugMutation
+}
+
+// Mutation returns the PageSlugMutation object of the builder.
+func (_u *PageSlugUpdateOne) Mutation() *PageSlugMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PageSlugUpdate builder.
+func (_u *PageSlugUpdateOne) Where(ps ...predicate.PageSlug) *PageSlugUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PageSlugUpdateOne) Select(field string, fields ...string) *PageSlugUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PageSlug entity.
+func (_u *PageSlugUpdateOne) Save(ctx context.Context) (*PageSlug, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PageSlugUpdateOne) SaveX(ctx context.Context) *PageSlug {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PageSlugUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PageSlugUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PageSlugUpdateOne) check() error {
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PageSlug.page"`)
+	}
+	return nil
+}
+
+func (_u *PageSlugUpdateOne) sqlSave(ctx context.Context) (_node *PageSlug, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pageslug.Table, pageslug.Columns, sqlgraph.NewFieldSpec(pageslug.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PageSlug.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pageslug.FieldID)
+		for _, f := range fields {
+			if !pageslug.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pageslug.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = _u.schemaConfig.PageSlug
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &PageSlug{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pageslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,11 +6,62 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// Device is the predicate function for device builders.
+type Device func(*sql.Selector)
+
+// Invitation is the predicate function for invitation builders.
+type Invitation func(*sql.Selector)
+
+// LinkClick is the predicate function for linkclick builders.
+type LinkClick func(*sql.Selector)
+
 // LinkItem is the predicate function for linkitem builders.
 type LinkItem func(*sql.Selector)
 
+// LinkReaction is the predicate function for linkreaction builders.
+type LinkReaction func(*sql.Selector)
+
+// LinkSnapshot is the predicate function for linksnapshot builders.
+type LinkSnapshot func(*sql.Selector)
+
+// LinkState is the predicate function for linkstate builders.
+type LinkState func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
 // Page is the predicate function for page builders.
 type Page func(*sql.Selector)
 
+// PagePreference is the predicate function for pagepreference builders.
+type PagePreference func(*sql.Selector)
+
+// PageSlug is the predicate function for pageslug builders.
+type PageSlug func(*sql.Selector)
+
+// Section is the predicate function for section builders.
+type Section func(*sql.Selector)
+
+// Template is the predicate function for template builders.
+type Template func(*sql.Selector)
+
+// TemplateLink is the predicate function for templatelink builders.
+type TemplateLink func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
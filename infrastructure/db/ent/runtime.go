@@ -6,16 +6,197 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/accesstoken"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/comment"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/device"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkclick"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/notificationpreference"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/schema"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenMixin := schema.AccessToken{}.Mixin()
+	accesstokenMixinFields0 := accesstokenMixin[0].Fields()
+	_ = accesstokenMixinFields0
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenMixinFields0[0].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	// accesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	accesstokenDescUpdatedAt := accesstokenMixinFields0[1].Descriptor()
+	// accesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesstoken.DefaultUpdatedAt = accesstokenDescUpdatedAt.Default.(func() time.Time)
+	// accesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesstoken.UpdateDefaultUpdatedAt = accesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[2].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = func() func(string) error {
+		validators := accesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesstokenDescTokenHash is the schema descriptor for token_hash field.
+	accesstokenDescTokenHash := accesstokenFields[3].Descriptor()
+	// accesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accesstoken.TokenHashValidator = accesstokenDescTokenHash.Validators[0].(func(string) error)
+	// accesstokenDescHint is the schema descriptor for hint field.
+	accesstokenDescHint := accesstokenFields[4].Descriptor()
+	// accesstoken.HintValidator is a validator for the "hint" field. It is called by the builders before save.
+	accesstoken.HintValidator = accesstokenDescHint.Validators[0].(func(string) error)
+	// accesstokenDescScopes is the schema descriptor for scopes field.
+	accesstokenDescScopes := accesstokenFields[5].Descriptor()
+	// accesstoken.DefaultScopes holds the default value on creation for the scopes field.
+	accesstoken.DefaultScopes = accesstokenDescScopes.Default.([]string)
+	// accesstokenDescPageIds is the schema descriptor for page_ids field.
+	accesstokenDescPageIds := accesstokenFields[6].Descriptor()
+	// accesstoken.DefaultPageIds holds the default value on creation for the page_ids field.
+	accesstoken.DefaultPageIds = accesstokenDescPageIds.Default.([]string)
+	// accesstokenDescID is the schema descriptor for id field.
+	accesstokenDescID := accesstokenFields[0].Descriptor()
+	// accesstoken.DefaultID holds the default value on creation for the id field.
+	accesstoken.DefaultID = accesstokenDescID.Default.(func() uuid.UUID)
+	commentMixin := schema.Comment{}.Mixin()
+	commentMixinFields0 := commentMixin[0].Fields()
+	_ = commentMixinFields0
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentMixinFields0[0].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentMixinFields0[1].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	deviceMixin := schema.Device{}.Mixin()
+	deviceMixinFields0 := deviceMixin[0].Fields()
+	_ = deviceMixinFields0
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescCreatedAt is the schema descriptor for created_at field.
+	deviceDescCreatedAt := deviceMixinFields0[0].Descriptor()
+	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
+	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
+	// deviceDescUpdatedAt is the schema descriptor for updated_at field.
+	deviceDescUpdatedAt := deviceMixinFields0[1].Descriptor()
+	// device.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	device.DefaultUpdatedAt = deviceDescUpdatedAt.Default.(func() time.Time)
+	// device.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	device.UpdateDefaultUpdatedAt = deviceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deviceDescToken is the schema descriptor for token field.
+	deviceDescToken := deviceFields[2].Descriptor()
+	// device.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	device.TokenValidator = func() func(string) error {
+		validators := deviceDescToken.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token string) error {
+			for _, fn := range fns {
+				if err := fn(token); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deviceDescPlatform is the schema descriptor for platform field.
+	deviceDescPlatform := deviceFields[3].Descriptor()
+	// device.PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	device.PlatformValidator = deviceDescPlatform.Validators[0].(func(string) error)
+	// deviceDescID is the schema descriptor for id field.
+	deviceDescID := deviceFields[0].Descriptor()
+	// device.DefaultID holds the default value on creation for the id field.
+	device.DefaultID = deviceDescID.Default.(func() uuid.UUID)
+	invitationMixin := schema.Invitation{}.Mixin()
+	invitationMixinFields0 := invitationMixin[0].Fields()
+	_ = invitationMixinFields0
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationMixinFields0[0].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	// invitationDescUpdatedAt is the schema descriptor for updated_at field.
+	invitationDescUpdatedAt := invitationMixinFields0[1].Descriptor()
+	// invitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitation.DefaultUpdatedAt = invitationDescUpdatedAt.Default.(func() time.Time)
+	// invitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitation.UpdateDefaultUpdatedAt = invitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitationDescEmail is the schema descriptor for email field.
+	invitationDescEmail := invitationFields[3].Descriptor()
+	// invitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitation.EmailValidator = func() func(string) error {
+		validators := invitationDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invitationDescTokenHash is the schema descriptor for token_hash field.
+	invitationDescTokenHash := invitationFields[4].Descriptor()
+	// invitation.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	invitation.TokenHashValidator = invitationDescTokenHash.Validators[0].(func(string) error)
+	// invitationDescID is the schema descriptor for id field.
+	invitationDescID := invitationFields[0].Descriptor()
+	// invitation.DefaultID holds the default value on creation for the id field.
+	invitation.DefaultID = invitationDescID.Default.(func() uuid.UUID)
+	linkclickFields := schema.LinkClick{}.Fields()
+	_ = linkclickFields
+	// linkclickDescReferrer is the schema descriptor for referrer field.
+	linkclickDescReferrer := linkclickFields[4].Descriptor()
+	// linkclick.DefaultReferrer holds the default value on creation for the referrer field.
+	linkclick.DefaultReferrer = linkclickDescReferrer.Default.(string)
+	// linkclickDescID is the schema descriptor for id field.
+	linkclickDescID := linkclickFields[0].Descriptor()
+	// linkclick.DefaultID holds the default value on creation for the id field.
+	linkclick.DefaultID = linkclickDescID.Default.(func() uuid.UUID)
 	linkitemMixin := schema.LinkItem{}.Mixin()
 	linkitemMixinFields0 := linkitemMixin[0].Fields()
 	_ = linkitemMixinFields0
@@ -43,6 +224,121 @@ func init() {
 	linkitemDescID := linkitemFields[0].Descriptor()
 	// linkitem.DefaultID holds the default value on creation for the id field.
 	linkitem.DefaultID = linkitemDescID.Default.(func() uuid.UUID)
+	linkreactionMixin := schema.LinkReaction{}.Mixin()
+	linkreactionMixinFields0 := linkreactionMixin[0].Fields()
+	_ = linkreactionMixinFields0
+	linkreactionFields := schema.LinkReaction{}.Fields()
+	_ = linkreactionFields
+	// linkreactionDescCreatedAt is the schema descriptor for created_at field.
+	linkreactionDescCreatedAt := linkreactionMixinFields0[0].Descriptor()
+	// linkreaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkreaction.DefaultCreatedAt = linkreactionDescCreatedAt.Default.(func() time.Time)
+	// linkreactionDescUpdatedAt is the schema descriptor for updated_at field.
+	linkreactionDescUpdatedAt := linkreactionMixinFields0[1].Descriptor()
+	// linkreaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	linkreaction.DefaultUpdatedAt = linkreactionDescUpdatedAt.Default.(func() time.Time)
+	// linkreaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	linkreaction.UpdateDefaultUpdatedAt = linkreactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// linkreactionDescEmoji is the schema descriptor for emoji field.
+	linkreactionDescEmoji := linkreactionFields[3].Descriptor()
+	// linkreaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	linkreaction.EmojiValidator = linkreactionDescEmoji.Validators[0].(func(string) error)
+	// linkreactionDescID is the schema descriptor for id field.
+	linkreactionDescID := linkreactionFields[0].Descriptor()
+	// linkreaction.DefaultID holds the default value on creation for the id field.
+	linkreaction.DefaultID = linkreactionDescID.Default.(func() uuid.UUID)
+	linksnapshotMixin := schema.LinkSnapshot{}.Mixin()
+	linksnapshotMixinFields0 := linksnapshotMixin[0].Fields()
+	_ = linksnapshotMixinFields0
+	linksnapshotFields := schema.LinkSnapshot{}.Fields()
+	_ = linksnapshotFields
+	// linksnapshotDescCreatedAt is the schema descriptor for created_at field.
+	linksnapshotDescCreatedAt := linksnapshotMixinFields0[0].Descriptor()
+	// linksnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linksnapshot.DefaultCreatedAt = linksnapshotDescCreatedAt.Default.(func() time.Time)
+	// linksnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	linksnapshotDescUpdatedAt := linksnapshotMixinFields0[1].Descriptor()
+	// linksnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	linksnapshot.DefaultUpdatedAt = linksnapshotDescUpdatedAt.Default.(func() time.Time)
+	// linksnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	linksnapshot.UpdateDefaultUpdatedAt = linksnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// linksnapshotDescSize is the schema descriptor for size field.
+	linksnapshotDescSize := linksnapshotFields[5].Descriptor()
+	// linksnapshot.DefaultSize holds the default value on creation for the size field.
+	linksnapshot.DefaultSize = linksnapshotDescSize.Default.(int64)
+	// linksnapshotDescID is the schema descriptor for id field.
+	linksnapshotDescID := linksnapshotFields[0].Descriptor()
+	// linksnapshot.DefaultID holds the default value on creation for the id field.
+	linksnapshot.DefaultID = linksnapshotDescID.Default.(func() uuid.UUID)
+	linkstateMixin := schema.LinkState{}.Mixin()
+	linkstateMixinFields0 := linkstateMixin[0].Fields()
+	_ = linkstateMixinFields0
+	linkstateFields := schema.LinkState{}.Fields()
+	_ = linkstateFields
+	// linkstateDescCreatedAt is the schema descriptor for created_at field.
+	linkstateDescCreatedAt := linkstateMixinFields0[0].Descriptor()
+	// linkstate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkstate.DefaultCreatedAt = linkstateDescCreatedAt.Default.(func() time.Time)
+	// linkstateDescUpdatedAt is the schema descriptor for updated_at field.
+	linkstateDescUpdatedAt := linkstateMixinFields0[1].Descriptor()
+	// linkstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	linkstate.DefaultUpdatedAt = linkstateDescUpdatedAt.Default.(func() time.Time)
+	// linkstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	linkstate.UpdateDefaultUpdatedAt = linkstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// linkstateDescID is the schema descriptor for id field.
+	linkstateDescID := linkstateFields[0].Descriptor()
+	// linkstate.DefaultID holds the default value on creation for the id field.
+	linkstate.DefaultID = linkstateDescID.Default.(func() uuid.UUID)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationDescKind is the schema descriptor for kind field.
+	notificationDescKind := notificationFields[4].Descriptor()
+	// notification.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notification.KindValidator = notificationDescKind.Validators[0].(func(string) error)
+	// notificationDescCount is the schema descriptor for count field.
+	notificationDescCount := notificationFields[5].Descriptor()
+	// notification.DefaultCount holds the default value on creation for the count field.
+	notification.DefaultCount = notificationDescCount.Default.(int)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescKind is the schema descriptor for kind field.
+	notificationpreferenceDescKind := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notificationpreference.KindValidator = notificationpreferenceDescKind.Validators[0].(func(string) error)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() uuid.UUID)
 	pageMixin := schema.Page{}.Mixin()
 	pageMixinFields0 := pageMixin[0].Fields()
 	_ = pageMixinFields0
@@ -94,10 +390,188 @@ func init() {
 			return nil
 		}
 	}()
+	// pageDescFeedTokenHash is the schema descriptor for feed_token_hash field.
+	pageDescFeedTokenHash := pageFields[4].Descriptor()
+	// page.FeedTokenHashValidator is a validator for the "feed_token_hash" field. It is called by the builders before save.
+	page.FeedTokenHashValidator = pageDescFeedTokenHash.Validators[0].(func(string) error)
+	// pageDescChecklist is the schema descriptor for checklist field.
+	pageDescChecklist := pageFields[6].Descriptor()
+	// page.DefaultChecklist holds the default value on creation for the checklist field.
+	page.DefaultChecklist = pageDescChecklist.Default.(bool)
+	// pageDescArchived is the schema descriptor for archived field.
+	pageDescArchived := pageFields[7].Descriptor()
+	// page.DefaultArchived holds the default value on creation for the archived field.
+	page.DefaultArchived = pageDescArchived.Default.(bool)
+	// pageDescDescription is the schema descriptor for description field.
+	pageDescDescription := pageFields[8].Descriptor()
+	// page.DefaultDescription holds the default value on creation for the description field.
+	page.DefaultDescription = pageDescDescription.Default.(string)
+	// pageDescIcon is the schema descriptor for icon field.
+	pageDescIcon := pageFields[9].Descriptor()
+	// page.DefaultIcon holds the default value on creation for the icon field.
+	page.DefaultIcon = pageDescIcon.Default.(string)
+	// page.IconValidator is a validator for the "icon" field. It is called by the builders before save.
+	page.IconValidator = pageDescIcon.Validators[0].(func(string) error)
+	// pageDescColor is the schema descriptor for color field.
+	pageDescColor := pageFields[10].Descriptor()
+	// page.DefaultColor holds the default value on creation for the color field.
+	page.DefaultColor = pageDescColor.Default.(string)
+	// page.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	page.ColorValidator = pageDescColor.Validators[0].(func(string) error)
+	// pageDescSlug is the schema descriptor for slug field.
+	pageDescSlug := pageFields[11].Descriptor()
+	// page.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	page.SlugValidator = pageDescSlug.Validators[0].(func(string) error)
 	// pageDescID is the schema descriptor for id field.
 	pageDescID := pageFields[0].Descriptor()
 	// page.DefaultID holds the default value on creation for the id field.
 	page.DefaultID = pageDescID.Default.(func() uuid.UUID)
+	pagepreferenceMixin := schema.PagePreference{}.Mixin()
+	pagepreferenceMixinFields0 := pagepreferenceMixin[0].Fields()
+	_ = pagepreferenceMixinFields0
+	pagepreferenceFields := schema.PagePreference{}.Fields()
+	_ = pagepreferenceFields
+	// pagepreferenceDescCreatedAt is the schema descriptor for created_at field.
+	pagepreferenceDescCreatedAt := pagepreferenceMixinFields0[0].Descriptor()
+	// pagepreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pagepreference.DefaultCreatedAt = pagepreferenceDescCreatedAt.Default.(func() time.Time)
+	// pagepreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	pagepreferenceDescUpdatedAt := pagepreferenceMixinFields0[1].Descriptor()
+	// pagepreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pagepreference.DefaultUpdatedAt = pagepreferenceDescUpdatedAt.Default.(func() time.Time)
+	// pagepreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pagepreference.UpdateDefaultUpdatedAt = pagepreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pagepreferenceDescPinned is the schema descriptor for pinned field.
+	pagepreferenceDescPinned := pagepreferenceFields[3].Descriptor()
+	// pagepreference.DefaultPinned holds the default value on creation for the pinned field.
+	pagepreference.DefaultPinned = pagepreferenceDescPinned.Default.(bool)
+	// pagepreferenceDescPosition is the schema descriptor for position field.
+	pagepreferenceDescPosition := pagepreferenceFields[4].Descriptor()
+	// pagepreference.DefaultPosition holds the default value on creation for the position field.
+	pagepreference.DefaultPosition = pagepreferenceDescPosition.Default.(int)
+	// pagepreferenceDescInbox is the schema descriptor for inbox field.
+	pagepreferenceDescInbox := pagepreferenceFields[5].Descriptor()
+	// pagepreference.DefaultInbox holds the default value on creation for the inbox field.
+	pagepreference.DefaultInbox = pagepreferenceDescInbox.Default.(bool)
+	// pagepreferenceDescID is the schema descriptor for id field.
+	pagepreferenceDescID := pagepreferenceFields[0].Descriptor()
+	// pagepreference.DefaultID holds the default value on creation for the id field.
+	pagepreference.DefaultID = pagepreferenceDescID.Default.(func() uuid.UUID)
+	pageslugFields := schema.PageSlug{}.Fields()
+	_ = pageslugFields
+	// pageslugDescSlug is the schema descriptor for slug field.
+	pageslugDescSlug := pageslugFields[1].Descriptor()
+	// pageslug.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	pageslug.SlugValidator = func() func(string) error {
+		validators := pageslugDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// pageslugDescID is the schema descriptor for id field.
+	pageslugDescID := pageslugFields[0].Descriptor()
+	// pageslug.DefaultID holds the default value on creation for the id field.
+	pageslug.DefaultID = pageslugDescID.Default.(func() uuid.UUID)
+	sectionMixin := schema.Section{}.Mixin()
+	sectionMixinFields0 := sectionMixin[0].Fields()
+	_ = sectionMixinFields0
+	sectionFields := schema.Section{}.Fields()
+	_ = sectionFields
+	// sectionDescCreatedAt is the schema descriptor for created_at field.
+	sectionDescCreatedAt := sectionMixinFields0[0].Descriptor()
+	// section.DefaultCreatedAt holds the default value on creation for the created_at field.
+	section.DefaultCreatedAt = sectionDescCreatedAt.Default.(func() time.Time)
+	// sectionDescUpdatedAt is the schema descriptor for updated_at field.
+	sectionDescUpdatedAt := sectionMixinFields0[1].Descriptor()
+	// section.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	section.DefaultUpdatedAt = sectionDescUpdatedAt.Default.(func() time.Time)
+	// section.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	section.UpdateDefaultUpdatedAt = sectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sectionDescName is the schema descriptor for name field.
+	sectionDescName := sectionFields[2].Descriptor()
+	// section.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	section.NameValidator = sectionDescName.Validators[0].(func(string) error)
+	// sectionDescPriority is the schema descriptor for priority field.
+	sectionDescPriority := sectionFields[3].Descriptor()
+	// section.DefaultPriority holds the default value on creation for the priority field.
+	section.DefaultPriority = sectionDescPriority.Default.(int)
+	// sectionDescID is the schema descriptor for id field.
+	sectionDescID := sectionFields[0].Descriptor()
+	// section.DefaultID holds the default value on creation for the id field.
+	section.DefaultID = sectionDescID.Default.(func() uuid.UUID)
+	templateMixin := schema.Template{}.Mixin()
+	templateMixinFields0 := templateMixin[0].Fields()
+	_ = templateMixinFields0
+	templateFields := schema.Template{}.Fields()
+	_ = templateFields
+	// templateDescCreatedAt is the schema descriptor for created_at field.
+	templateDescCreatedAt := templateMixinFields0[0].Descriptor()
+	// template.DefaultCreatedAt holds the default value on creation for the created_at field.
+	template.DefaultCreatedAt = templateDescCreatedAt.Default.(func() time.Time)
+	// templateDescUpdatedAt is the schema descriptor for updated_at field.
+	templateDescUpdatedAt := templateMixinFields0[1].Descriptor()
+	// template.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	template.DefaultUpdatedAt = templateDescUpdatedAt.Default.(func() time.Time)
+	// template.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	template.UpdateDefaultUpdatedAt = templateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// templateDescTitle is the schema descriptor for title field.
+	templateDescTitle := templateFields[1].Descriptor()
+	// template.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	template.TitleValidator = func() func(string) error {
+		validators := templateDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// templateDescID is the schema descriptor for id field.
+	templateDescID := templateFields[0].Descriptor()
+	// template.DefaultID holds the default value on creation for the id field.
+	template.DefaultID = templateDescID.Default.(func() uuid.UUID)
+	templatelinkMixin := schema.TemplateLink{}.Mixin()
+	templatelinkMixinFields0 := templatelinkMixin[0].Fields()
+	_ = templatelinkMixinFields0
+	templatelinkFields := schema.TemplateLink{}.Fields()
+	_ = templatelinkFields
+	// templatelinkDescCreatedAt is the schema descriptor for created_at field.
+	templatelinkDescCreatedAt := templatelinkMixinFields0[0].Descriptor()
+	// templatelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	templatelink.DefaultCreatedAt = templatelinkDescCreatedAt.Default.(func() time.Time)
+	// templatelinkDescUpdatedAt is the schema descriptor for updated_at field.
+	templatelinkDescUpdatedAt := templatelinkMixinFields0[1].Descriptor()
+	// templatelink.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	templatelink.DefaultUpdatedAt = templatelinkDescUpdatedAt.Default.(func() time.Time)
+	// templatelink.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	templatelink.UpdateDefaultUpdatedAt = templatelinkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// templatelinkDescURL is the schema descriptor for url field.
+	templatelinkDescURL := templatelinkFields[2].Descriptor()
+	// templatelink.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	templatelink.URLValidator = templatelinkDescURL.Validators[0].(func(string) error)
+	// templatelinkDescPriority is the schema descriptor for priority field.
+	templatelinkDescPriority := templatelinkFields[4].Descriptor()
+	// templatelink.DefaultPriority holds the default value on creation for the priority field.
+	templatelink.DefaultPriority = templatelinkDescPriority.Default.(int)
+	// templatelinkDescID is the schema descriptor for id field.
+	templatelinkDescID := templatelinkFields[0].Descriptor()
+	// templatelink.DefaultID holds the default value on creation for the id field.
+	templatelink.DefaultID = templatelinkDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -117,8 +591,112 @@ func init() {
 	userDescUID := userFields[1].Descriptor()
 	// user.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
 	user.UIDValidator = userDescUID.Validators[0].(func(string) error)
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[4].Descriptor()
+	// user.DefaultDisplayName holds the default value on creation for the display_name field.
+	user.DefaultDisplayName = userDescDisplayName.Default.(string)
+	// userDescAvatarURL is the schema descriptor for avatar_url field.
+	userDescAvatarURL := userFields[5].Descriptor()
+	// user.DefaultAvatarURL holds the default value on creation for the avatar_url field.
+	user.DefaultAvatarURL = userDescAvatarURL.Default.(string)
+	// user.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	user.AvatarURLValidator = userDescAvatarURL.Validators[0].(func(string) error)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[6].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// user.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	user.LocaleValidator = userDescLocale.Validators[0].(func(string) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[3].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[4].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescEvents is the schema descriptor for events field.
+	webhookDescEvents := webhookFields[5].Descriptor()
+	// webhook.DefaultEvents holds the default value on creation for the events field.
+	webhook.DefaultEvents = webhookDescEvents.Default.([]string)
+	// webhookDescFailureCount is the schema descriptor for failure_count field.
+	webhookDescFailureCount := webhookFields[6].Descriptor()
+	// webhook.DefaultFailureCount holds the default value on creation for the failure_count field.
+	webhook.DefaultFailureCount = webhookDescFailureCount.Default.(int)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescStatusCode is the schema descriptor for status_code field.
+	webhookdeliveryDescStatusCode := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultStatusCode holds the default value on creation for the status_code field.
+	webhookdelivery.DefaultStatusCode = webhookdeliveryDescStatusCode.Default.(int)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultError holds the default value on creation for the error field.
+	webhookdelivery.DefaultError = webhookdeliveryDescError.Default.(string)
+	// webhookdeliveryDescDurationMs is the schema descriptor for duration_ms field.
+	webhookdeliveryDescDurationMs := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookdelivery.DefaultDurationMs = webhookdeliveryDescDurationMs.Default.(int64)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// AccessToken represents a personal access token of a user. Only the hash of the token is stored.
+type AccessToken struct{ ent.Schema }
+
+func (AccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "access_tokens", Schema: "tsudzuri"},
+	}
+}
+
+func (AccessToken) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("user_id", guuid.UUID{}).Immutable(), // FK for user edge
+		field.String("name").NotEmpty().MaxRuneLen(100).Immutable(),
+		field.String("token_hash").NotEmpty().Unique().Immutable(),
+		field.String("hint").NotEmpty().Immutable(),
+		field.Strings("scopes").Default([]string{}).Immutable(),
+		// Empty page IDs mean every page of the user.
+		field.Strings("page_ids").Default([]string{}).Immutable(),
+		field.Time("expires_at").Optional().Nillable().Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("access_tokens").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Comment represents a comment on a link item. Replies point to the first comment of their thread.
+type Comment struct{ ent.Schema }
+
+func (Comment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "link_comments", Schema: "tsudzuri"},
+	}
+}
+
+func (Comment) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("page_id", guuid.UUID{}).Immutable(),
+		field.UUID("link_item_id", guuid.UUID{}).Immutable(),                    // FK for link_item edge
+		field.UUID("parent_id", guuid.UUID{}).Optional().Nillable().Immutable(), // FK for parent edge
+		field.UUID("author_id", guuid.UUID{}).Immutable(),                       // FK for author edge
+		field.Text("body"),
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link_item", LinkItem.Type).Ref("comments").Field("link_item_id").Unique().Required().Immutable(),
+		edge.From("author", User.Type).Ref("comments").Field("author_id").Unique().Required().Immutable(),
+		// Replies in the thread started by this comment.
+		edge.To("replies", Comment.Type).From("parent").Field("parent_id").Unique().Immutable(),
+	}
+}
+
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_item_id"),
+	}
+}
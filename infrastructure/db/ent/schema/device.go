@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Device represents a client of a user that receives push notifications.
+type Device struct{ ent.Schema }
+
+func (Device) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "devices", Schema: "tsudzuri"},
+	}
+}
+
+func (Device) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("user_id", guuid.UUID{}).Immutable(), // FK for user edge
+		field.String("token").NotEmpty().MaxLen(4096).Immutable(),
+		field.String("platform").NotEmpty(),
+	}
+}
+
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("devices").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (Device) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token").Unique(),
+		index.Fields("user_id"),
+	}
+}
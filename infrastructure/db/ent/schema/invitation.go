@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Invitation represents an email invitation to join a page. Only the hash of the secret token is stored.
+type Invitation struct{ ent.Schema }
+
+func (Invitation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invitations", Schema: "tsudzuri"},
+	}
+}
+
+func (Invitation) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("page_id", guuid.UUID{}).Immutable(),       // FK for page edge
+		field.UUID("invited_by_id", guuid.UUID{}).Immutable(), // FK for inviter edge
+		field.String("email").NotEmpty().MaxLen(254).Immutable(),
+		field.String("token_hash").NotEmpty().Unique().Immutable(),
+		// Expiry is derived from expires_at and never stored as a status.
+		field.Enum("status").Values("pending", "accepted", "revoked").Default("pending"),
+		field.UUID("accepted_by_id", guuid.UUID{}).Optional().Nillable(),
+		field.Time("expires_at"),
+	}
+}
+
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("page", Page.Type).Ref("invitations").Field("page_id").Unique().Required().Immutable(),
+		edge.From("inviter", User.Type).Ref("sent_invitations").Field("invited_by_id").Unique().Required().Immutable(),
+	}
+}
+
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("page_id"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// LinkClick represents one click through the redirect endpoint of a link item. Clicks are only ever inserted,
+// so the time of the click is kept instead of the usual timestamps.
+type LinkClick struct{ ent.Schema }
+
+func (LinkClick) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "link_clicks", Schema: "tsudzuri"},
+	}
+}
+
+func (LinkClick) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		// Page of the link item, kept on the click so the clicks of a page are counted without a join.
+		field.UUID("page_id", guuid.UUID{}).Immutable(),
+		field.UUID("link_item_id", guuid.UUID{}).Immutable(), // FK for link_item edge
+		// Signed in user who clicked. Kept as a plain column so deleting the user only clears it.
+		field.UUID("user_id", guuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Text("referrer").Default("").Immutable(),
+		field.Time("clicked_at").Immutable(),
+	}
+}
+
+func (LinkClick) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link_item", LinkItem.Type).Ref("clicks").Field("link_item_id").Unique().Required().Immutable(),
+	}
+}
+
+func (LinkClick) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("page_id", "clicked_at"),
+	}
+}
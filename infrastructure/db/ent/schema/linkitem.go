@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	guuid "github.com/google/uuid"
 	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
 )
@@ -28,13 +29,41 @@ func (LinkItem) Fields() []ent.Field {
 		field.Text("url").NotEmpty(),
 		field.Text("memo").Optional().Nillable(),
 		field.Int("priority").Default(0),
+		field.UUID("section_id", guuid.UUID{}).Optional().Nillable(), // FK for section edge
+		// Member who marked the item done in checklist mode. Kept as a plain column so deleting the user only clears it.
+		field.UUID("done_by_id", guuid.UUID{}).Optional().Nillable(),
+		// Time the item was marked done in checklist mode. NULL means the item is not done.
+		field.Time("done_at").Optional().Nillable(),
+		// HTTP status of the last check by the dead link checker. Zero means the URL could not be reached.
+		field.Int("check_status").Optional().Nillable(),
+		// Target of the redirect answered to the last check, if any.
+		field.Text("redirect_url").Optional().Nillable(),
+		// Time of the last check. NULL means the item has not been checked.
+		field.Time("checked_at").Optional().Nillable(),
 	}
 }
 
 func (LinkItem) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("page", Page.Type).Ref("link_items").Field("page_id").Unique().Required(),
+		// Section the item belongs to, if any.
+		edge.From("section", Section.Type).Ref("link_items").Field("section_id").Unique(),
+		// Comments on the item.
+		edge.To("comments", Comment.Type),
+		// Reactions of the page members to the item.
+		edge.To("reactions", LinkReaction.Type),
+		// Statuses of the page members on the item.
+		edge.To("states", LinkState.Type),
+		// Archived copies of the web page the item points to.
+		edge.To("snapshots", LinkSnapshot.Type),
+		// Clicks through the redirect endpoint of the item.
+		edge.To("clicks", LinkClick.Type),
 	}
 }
 
-// Indexes could be added here if needed (e.g., composite index page_id+priority).
+func (LinkItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// The dead link checker picks the least recently checked items.
+		index.Fields("checked_at"),
+	}
+}
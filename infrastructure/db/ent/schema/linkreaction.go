@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// LinkReaction represents a user's reaction (an upvote or an emoji) to a link item. A user has one reaction per item.
+type LinkReaction struct{ ent.Schema }
+
+func (LinkReaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "link_reactions", Schema: "tsudzuri"},
+	}
+}
+
+func (LinkReaction) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (LinkReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("link_item_id", guuid.UUID{}).Immutable(), // FK for link_item edge
+		field.UUID("user_id", guuid.UUID{}).Immutable(),      // FK for user edge
+		field.String("emoji").NotEmpty(),
+	}
+}
+
+func (LinkReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link_item", LinkItem.Type).Ref("reactions").Field("link_item_id").Unique().Required().Immutable(),
+		edge.From("user", User.Type).Ref("link_reactions").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (LinkReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_item_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// LinkSnapshot represents an archived copy of the web page a link item points to. The content is kept in blob storage.
+type LinkSnapshot struct{ ent.Schema }
+
+func (LinkSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "link_snapshots", Schema: "tsudzuri"},
+	}
+}
+
+func (LinkSnapshot) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (LinkSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("page_id", guuid.UUID{}).Immutable(),
+		field.UUID("link_item_id", guuid.UUID{}).Immutable(), // FK for link_item edge
+		// URL of the link item when the snapshot was taken.
+		field.Text("url").Immutable(),
+		field.Text("storage_key").Immutable(),
+		field.Int64("size").Default(0),
+		// Member who requested the snapshot. Kept as a plain column so deleting the user only clears it.
+		field.UUID("created_by_id", guuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (LinkSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link_item", LinkItem.Type).Ref("snapshots").Field("link_item_id").Unique().Required().Immutable(),
+	}
+}
+
+func (LinkSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_item_id"),
+	}
+}
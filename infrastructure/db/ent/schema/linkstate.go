@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// LinkState represents a user's own status (unread/read/done) on a link item. Items without a row are unread.
+type LinkState struct{ ent.Schema }
+
+func (LinkState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "link_states", Schema: "tsudzuri"},
+	}
+}
+
+func (LinkState) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (LinkState) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("link_item_id", guuid.UUID{}).Immutable(), // FK for link_item edge
+		field.UUID("user_id", guuid.UUID{}).Immutable(),      // FK for user edge
+		field.Enum("status").Values("unread", "read", "done").Default("unread"),
+	}
+}
+
+func (LinkState) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("link_item", LinkItem.Type).Ref("states").Field("link_item_id").Unique().Required().Immutable(),
+		edge.From("user", User.Type).Ref("link_states").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (LinkState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_item_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Notification represents an entry in the inbox of a user about activity on a page.
+type Notification struct{ ent.Schema }
+
+func (Notification) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "notifications", Schema: "tsudzuri"},
+	}
+}
+
+func (Notification) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("recipient_id", guuid.UUID{}).Immutable(), // FK for recipient edge
+		field.UUID("page_id", guuid.UUID{}).Immutable(),      // FK for page edge
+		// The actor is kept as a plain ID so that the notification outlives the account of the actor.
+		field.UUID("actor_id", guuid.UUID{}).Immutable(),
+		field.String("kind").NotEmpty().Immutable(),
+		field.Int("count").Default(1).Immutable(),
+		field.Time("read_at").Optional().Nillable(),
+	}
+}
+
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recipient", User.Type).Ref("notifications").Field("recipient_id").Unique().Required().Immutable(),
+		edge.From("page", Page.Type).Ref("notifications").Field("page_id").Unique().Required().Immutable(),
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_id", "read_at"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// NotificationPreference represents a notification kind a user muted. Kinds without a row are enabled.
+type NotificationPreference struct{ ent.Schema }
+
+func (NotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "notification_preferences", Schema: "tsudzuri"},
+	}
+}
+
+func (NotificationPreference) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("user_id", guuid.UUID{}).Immutable(), // FK for user edge
+		field.String("kind").NotEmpty().Immutable(),
+	}
+}
+
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("notification_preferences").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "kind").Unique(),
+	}
+}
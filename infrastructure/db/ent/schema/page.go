@@ -24,9 +24,25 @@ func (Page) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
 func (Page) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
-		field.String("title").NotEmpty().MaxLen(50),
+		field.String("title").NotEmpty().MaxRuneLen(50),
 		field.UUID("creator_id", guuid.UUID{}), // FK for creator edge
 		field.String("invite_code").NotEmpty().Unique().MaxLen(8),
+		// SHA-256 hex digest of the secret token used to read the page feeds.
+		field.String("feed_token_hash").Optional().Nillable().Unique().MaxLen(64),
+		// Page this page was duplicated from. Kept as a plain column so deleting the source does not touch forks.
+		field.UUID("source_page_id", guuid.UUID{}).Optional().Nillable().Immutable(),
+		// Whether the link items of the page have a shared done flag.
+		field.Bool("checklist").Default(false),
+		// Whether the page is frozen as read-only.
+		field.Bool("archived").Default(false),
+		// Markdown description, up to 2000 characters. Sanitized when rendered, never stored as HTML.
+		field.Text("description").Default(""),
+		// Single emoji shown with the title.
+		field.String("icon").Default("").MaxLen(64),
+		// Accent color in "#rrggbb" form.
+		field.String("color").Default("").MaxLen(7),
+		// Current short slug used in URLs instead of the ID. Every slug the page ever had is kept in page_slugs.
+		field.String("slug").Optional().Nillable().Unique().MaxLen(30),
 	}
 }
 
@@ -36,6 +52,18 @@ func (Page) Edges() []ent.Edge {
 		edge.From("creator", User.Type).Ref("created_pages").Field("creator_id").Unique().Required(),
 		// Link items contained in this page.
 		edge.To("link_items", LinkItem.Type),
+		// Sections grouping the link items of this page.
+		edge.To("sections", Section.Type),
+		// Personal settings of users for this page.
+		edge.To("preferences", PagePreference.Type),
+		// Email invitations to join this page.
+		edge.To("invitations", Invitation.Type),
+		// Notifications about activity on this page.
+		edge.To("notifications", Notification.Type),
+		// Webhooks called on activity on this page.
+		edge.To("webhooks", Webhook.Type),
+		// Current and previous slugs of this page.
+		edge.To("slugs", PageSlug.Type),
 		// M2M invited users via existing join table tsudzuri.page_users.
 		// Define the storage key here; the inverse side is User.invited_pages.
 		edge.To("invited_users", User.Type).
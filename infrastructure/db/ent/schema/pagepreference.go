@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// PagePreference represents a user's personal setting for a page (pinned, own order, capture inbox).
+// It is kept apart from page_users so that it also works for the creator and never touches membership.
+type PagePreference struct{ ent.Schema }
+
+func (PagePreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "page_preferences", Schema: "tsudzuri"},
+	}
+}
+
+func (PagePreference) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (PagePreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("user_id", guuid.UUID{}).Immutable(), // FK for user edge
+		field.UUID("page_id", guuid.UUID{}).Immutable(), // FK for page edge
+		field.Bool("pinned").Default(false),
+		// Place of the page in the user's own order, starting at 1. Zero means the page has no place.
+		field.Int("position").Default(0),
+		// Whether captured links go to the page. At most one page per user is the inbox.
+		field.Bool("inbox").Default(false),
+	}
+}
+
+func (PagePreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("page_preferences").Field("user_id").Unique().Required().Immutable(),
+		edge.From("page", Page.Type).Ref("preferences").Field("page_id").Unique().Required().Immutable(),
+	}
+}
+
+func (PagePreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "page_id").Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// PageSlug holds a slug a page has ever had, so renamed slugs keep resolving to the page.
+// Rows are only ever inserted, so the time of the insertion is kept instead of the usual timestamps.
+type PageSlug struct{ ent.Schema }
+
+func (PageSlug) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "page_slugs", Schema: "tsudzuri"},
+	}
+}
+
+func (PageSlug) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.String("slug").NotEmpty().Unique().MaxLen(30).Immutable(),
+		field.UUID("page_id", guuid.UUID{}).Immutable(), // FK for page edge
+		field.Time("created_at").Immutable(),
+	}
+}
+
+func (PageSlug) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("page", Page.Type).Ref("slugs").Field("page_id").Unique().Required().Immutable(),
+	}
+}
+
+func (PageSlug) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("page_id"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Section represents a named group of link items inside a Page.
+type Section struct{ ent.Schema }
+
+func (Section) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "page_sections", Schema: "tsudzuri"},
+	}
+}
+
+func (Section) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Section) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("page_id", guuid.UUID{}),
+		field.String("name").NotEmpty(),
+		field.Int("priority").Default(0),
+	}
+}
+
+func (Section) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("page", Page.Type).Ref("sections").Field("page_id").Unique().Required(),
+		// Link items grouped in this section.
+		edge.To("link_items", LinkItem.Type),
+	}
+}
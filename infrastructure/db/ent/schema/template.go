@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Template holds the schema definition for the Template entity.
+// Templates are kept apart from pages so they never appear in page listings.
+type Template struct{ ent.Schema }
+
+func (Template) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "templates", Schema: "tsudzuri"},
+	}
+}
+
+func (Template) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Template) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.String("title").NotEmpty().MaxRuneLen(50),
+		field.UUID("creator_id", guuid.UUID{}), // FK for creator edge
+		field.Enum("visibility").Values("private", "shared").Default("private"),
+	}
+}
+
+func (Template) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creator of the template.
+		edge.From("creator", User.Type).Ref("created_templates").Field("creator_id").Unique().Required(),
+		// Links contained in this template.
+		edge.To("template_links", TemplateLink.Type),
+		// M2M users a shared template is available to via join table tsudzuri.template_users.
+		edge.To("shared_users", User.Type).
+			Annotations(entsql.Annotation{Table: "template_users", Schema: "tsudzuri"}).
+			StorageKey(
+				edge.Table("template_users"),
+				edge.Columns("template_id", "user_id"),
+			),
+	}
+}
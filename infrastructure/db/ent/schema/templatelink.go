@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// TemplateLink represents an item (URL) inside a Template.
+type TemplateLink struct{ ent.Schema }
+
+func (TemplateLink) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "template_links", Schema: "tsudzuri"},
+	}
+}
+
+func (TemplateLink) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (TemplateLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("template_id", guuid.UUID{}),
+		field.Text("url").NotEmpty(),
+		field.Text("memo").Optional().Nillable(),
+		field.Int("priority").Default(0),
+	}
+}
+
+func (TemplateLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("template", Template.Type).Ref("template_links").Field("template_id").Unique().Required(),
+	}
+}
@@ -30,6 +30,10 @@ func (User) Fields() []ent.Field {
 		field.String("uid").NotEmpty().Unique(),
 		field.Enum("provider").Values("anonymous", "google", "facebook").Default("anonymous"),
 		field.String("email").Optional().Nillable().Unique(),
+		// Public profile shown to the other members of a page. Empty strings mean the field is not set.
+		field.String("display_name").Default(""),
+		field.String("avatar_url").MaxLen(2048).Default(""),
+		field.String("locale").MaxLen(35).Default(""),
 	}
 }
 
@@ -40,5 +44,23 @@ func (User) Edges() []ent.Edge {
 		// M2M invited pages via existing join table tsudzuri.page_users
 		// The join table definition is configured on Page.invited_users.
 		edge.From("invited_pages", Page.Type).Ref("invited_users"),
+		edge.To("created_templates", Template.Type),
+		// Shared templates available to the user; the join table is configured on Template.shared_users.
+		edge.From("shared_templates", Template.Type).Ref("shared_users"),
+		edge.To("comments", Comment.Type),
+		edge.To("link_reactions", LinkReaction.Type),
+		edge.To("link_states", LinkState.Type),
+		// Personal settings of the user for pages.
+		edge.To("page_preferences", PagePreference.Type),
+		// Email invitations sent by the user.
+		edge.To("sent_invitations", Invitation.Type),
+		// Inbox of the user.
+		edge.To("notifications", Notification.Type),
+		// Notification kinds the user muted.
+		edge.To("notification_preferences", NotificationPreference.Type),
+		// Devices of the user that receive push notifications.
+		edge.To("devices", Device.Type),
+		// Personal access tokens of the user.
+		edge.To("access_tokens", AccessToken.Type),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// Webhook represents a URL that is called with signed payloads when something happens on a page.
+type Webhook struct{ ent.Schema }
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhooks", Schema: "tsudzuri"},
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("page_id", guuid.UUID{}).Immutable(), // FK for page edge
+		// The creator is kept as a plain ID; only the creator of the page manages its webhooks.
+		field.UUID("created_by_id", guuid.UUID{}).Immutable(),
+		field.String("url").NotEmpty().MaxLen(2048),
+		field.String("secret").NotEmpty().Sensitive().Immutable(),
+		// Empty events subscribe to all of them.
+		field.Strings("events").Default([]string{}),
+		field.Int("failure_count").Default(0),
+		field.Time("disabled_at").Optional().Nillable(),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("page", Page.Type).Ref("webhooks").Field("page_id").Unique().Required().Immutable(),
+		// Recent delivery attempts of this webhook.
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("page_id"),
+	}
+}
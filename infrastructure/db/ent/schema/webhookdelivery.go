@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	guuid "github.com/google/uuid"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+// WebhookDelivery represents one attempt to post a payload to a webhook. Only the recent attempts are kept.
+type WebhookDelivery struct{ ent.Schema }
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_deliveries", Schema: "tsudzuri"},
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", guuid.UUID{}).Default(tsuuid.NewV7),
+		field.UUID("webhook_id", guuid.UUID{}).Immutable(), // FK for webhook edge
+		field.String("event_id").NotEmpty().Immutable(),
+		field.String("event_type").NotEmpty().Immutable(),
+		field.Int("attempt").Immutable(),
+		// 0 when no response was received.
+		field.Int("status_code").Default(0).Immutable(),
+		field.String("error").Default("").Immutable(),
+		field.Int64("duration_ms").Default(0).Immutable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Field("webhook_id").Unique().Required().Immutable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+	}
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
+)
+
+// Section is the model entity for the Section schema.
+type Section struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PageID holds the value of the "page_id" field.
+	PageID uuid.UUID `json:"page_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SectionQuery when eager-loading is set.
+	Edges        SectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SectionEdges holds the relations/edges for other nodes in the graph.
+type SectionEdges struct {
+	// Page holds the value of the page edge.
+	Page *Page `json:"page,omitempty"`
+	// LinkItems holds the value of the link_items edge.
+	LinkItems []*LinkItem `json:"link_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PageOrErr returns the Page value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SectionEdges) PageOrErr() (*Page, error) {
+	if e.Page != nil {
+		return e.Page, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: page.Label}
+	}
+	return nil, &NotLoadedError{edge: "page"}
+}
+
+// LinkItemsOrErr returns the LinkItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e SectionEdges) LinkItemsOrErr() ([]*LinkItem, error) {
+	if e.loadedTypes[1] {
+		return e.LinkItems, nil
+	}
+	return nil, &NotLoadedError{edge: "link_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Section) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case section.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case section.FieldName:
+			values[i] = new(sql.NullString)
+		case section.FieldCreatedAt, section.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case section.FieldID, section.FieldPageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Section fields.
+func (_m *Section) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case section.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case section.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case section.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case section.FieldPageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field page_id", values[i])
+			} else if value != nil {
+				_m.PageID = *value
+			}
+		case section.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case section.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Section.
+// This includes values selected through modifiers, order, etc.
+func (_m *Section) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPage queries the "page" edge of the Section entity.
+func (_m *Section) QueryPage() *PageQuery {
+	return NewSectionClient(_m.config).QueryPage(_m)
+}
+
+// QueryLinkItems queries the "link_items" edge of the Section entity.
+func (_m *Section) QueryLinkItems() *LinkItemQuery {
+	return NewSectionClient(_m.config).QueryLinkItems(_m)
+}
+
+// Update returns a builder for updating this Section.
+// Note that you need to call Section.Unwrap() before calling this method if this Section
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Section) Update() *SectionUpdateOne {
+	return NewSectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Section entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Section) Unwrap() *Section {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Section is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Section) String() string {
+	var builder strings.Builder
+	builder.WriteString("Section(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("page_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sections is a parsable slice of Section.
+type Sections []*Section
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
+)
+
+// SectionUpdate is the builder for updating Section entities.
+type SectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SectionMutation
+}
+
+// Where appends a list predicates to the SectionUpdate builder.
+func (_u *SectionUpdate) Where(ps ...predicate.Section) *SectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SectionUpdate) SetUpdatedAt(v time.Time) *SectionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPageID sets the "page_id" field.
+func (_u *SectionUpdate) SetPageID(v uuid.UUID) *SectionUpdate {
+	_u.mutation.SetPageID(v)
+	return _u
+}
+
+// SetNillablePageID sets the "page_id" field if the given value is not nil.
+func (_u *SectionUpdate) SetNillablePageID(v *uuid.UUID) *SectionUpdate {
+	if v != nil {
+		_u.SetPageID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SectionUpdate) SetName(v string) *SectionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SectionUpdate) SetNillableName(v *string) *SectionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *SectionUpdate) SetPriority(v int) *SectionUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *SectionUpdate) SetNillablePriority(v *int) *SectionUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *SectionUpdate) AddPriority(v int) *SectionUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPage sets the "page" edge to the Page entity.
+func (_u *SectionUpdate) SetPage(v *Page) *SectionUpdate {
+	return _u.SetPageID(v.ID)
+}
+
+// AddLinkItemIDs adds the "link_items" edge to the LinkItem entity by IDs.
+func (_u *SectionUpdate) AddLinkItemIDs(ids ...uuid.UUID) *SectionUpdate {
+	_u.mutation.AddLinkItemIDs(ids...)
+	return _u
+}
+
+// AddLinkItems adds the "link_items" edges to the LinkItem entity.
+func (_u *SectionUpdate) AddLinkItems(v ...*LinkItem) *SectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkItemIDs(ids...)
+}
+
+// Mutation returns the SectionMutation object of the builder.
+func (_u *SectionUpdate) Mutation() *SectionMutation {
+	return _u.mutation
+}
+
+// ClearPage clears the "page" edge to the Page entity.
+func (_u *SectionUpdate) ClearPage() *SectionUpdate {
+	_u.mutation.ClearPage()
+	return _u
+}
+
+// ClearLinkItems clears all "link_items" edges to the LinkItem entity.
+func (_u *SectionUpdate) ClearLinkItems() *SectionUpdate {
+	_u.mutation.ClearLinkItems()
+	return _u
+}
+
+// RemoveLinkItemIDs removes the "link_items" edge to LinkItem entities by IDs.
+func (_u *SectionUpdate) RemoveLinkItemIDs(ids ...uuid.UUID) *SectionUpdate {
+	_u.mutation.RemoveLinkItemIDs(ids...)
+	return _u
+}
+
+// RemoveLinkItems removes "link_items" edges to LinkItem entities.
+func (_u *SectionUpdate) RemoveLinkItems(v ...*LinkItem) *SectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SectionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SectionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := section.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SectionUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := section.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Section.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Section.page"`)
+	}
+	return nil
+}
+
+func (_u *SectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(section.Table, section.Columns, sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(section.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(section.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(section.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(section.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.PageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   section.PageTable,
+			Columns: []string{section.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   section.PageTable,
+			Columns: []string{section.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinkItemsIDs(); len(nodes) > 0 && !_u.mutation.LinkItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Section
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{section.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SectionUpdateOne is the builder for updating a single Section entity.
+type SectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SectionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SectionUpdateOne) SetUpdatedAt(v time.Time) *SectionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPageID sets the "page_id" field.
+func (_u *SectionUpdateOne) SetPageID(v uuid.UUID) *SectionUpdateOne {
+	_u.mutation.SetPageID(v)
+	return _u
+}
+
+// SetNillablePageID sets the "page_id" field if the given value is not nil.
+func (_u *SectionUpdateOne) SetNillablePageID(v *uuid.UUID) *SectionUpdateOne {
+	if v != nil {
+		_u.SetPageID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SectionUpdateOne) SetName(v string) *SectionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SectionUpdateOne) SetNillableName(v *string) *SectionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *SectionUpdateOne) SetPriority(v int) *SectionUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *SectionUpdateOne) SetNillablePriority(v *int) *SectionUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *SectionUpdateOne) AddPriority(v int) *SectionUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPage sets the "page" edge to the Page entity.
+func (_u *SectionUpdateOne) SetPage(v *Page) *SectionUpdateOne {
+	return _u.SetPageID(v.ID)
+}
+
+// AddLinkItemIDs adds the "link_items" edge to the LinkItem entity by IDs.
+func (_u *SectionUpdateOne) AddLinkItemIDs(ids ...uuid.UUID) *SectionUpdateOne {
+	_u.mutation.AddLinkItemIDs(ids...)
+	return _u
+}
+
+// AddLinkItems adds the "link_items" edges to the LinkItem entity.
+func (_u *SectionUpdateOne) AddLinkItems(v ...*LinkItem) *SectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkItemIDs(ids...)
+}
+
+// Mutation returns the SectionMutation object of the builder.
+func (_u *SectionUpdateOne) Mutation() *SectionMutation {
+	return _u.mutation
+}
+
+// ClearPage clears the "page" edge to the Page entity.
+func (_u *SectionUpdateOne) ClearPage() *SectionUpdateOne {
+	_u.mutation.ClearPage()
+	return _u
+}
+
+// ClearLinkItems clears all "link_items" edges to the LinkItem entity.
+func (_u *SectionUpdateOne) ClearLinkItems() *SectionUpdateOne {
+	_u.mutation.ClearLinkItems()
+	return _u
+}
+
+// RemoveLinkItemIDs removes the "link_items" edge to LinkItem entities by IDs.
+func (_u *SectionUpdateOne) RemoveLinkItemIDs(ids ...uuid.UUID) *SectionUpdateOne {
+	_u.mutation.RemoveLinkItemIDs(ids...)
+	return _u
+}
+
+// RemoveLinkItems removes "link_items" edges to LinkItem entities.
+func (_u *SectionUpdateOne) RemoveLinkItems(v ...*LinkItem) *SectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkItemIDs(ids...)
+}
+
+// Where appends a list predicates to the SectionUpdate builder.
+func (_u *SectionUpdateOne) Where(ps ...predicate.Section) *SectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SectionUpdateOne) Select(field string, fields ...string) *SectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Section entity.
+func (_u *SectionUpdateOne) Save(ctx context.Context) (*Section, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SectionUpdateOne) SaveX(ctx context.Context) *Section {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SectionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := section.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SectionUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := section.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Section.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PageCleared() && len(_u.mutation.PageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Section.page"`)
+	}
+	return nil
+}
+
+func (_u *SectionUpdateOne) sqlSave(ctx context.Context) (_node *Section, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(section.Table, section.Columns, sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Section.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, section.FieldID)
+		for _, f := range fields {
+			if !section.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != section.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(section.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(section.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(section.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(section.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.PageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   section.PageTable,
+			Columns: []string{section.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   section.PageTable,
+			Columns: []string{section.PageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(page.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Section
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinkItemsIDs(); len(nodes) > 0 && !_u.mutation.LinkItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   section.LinkItemsTable,
+			Columns: []string{section.LinkItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkitem.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.LinkItem
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Section
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Section{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{section.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// Template is the model entity for the Template schema.
+type Template struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID uuid.UUID `json:"creator_id,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility template.Visibility `json:"visibility,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateQuery when eager-loading is set.
+	Edges        TemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TemplateEdges holds the relations/edges for other nodes in the graph.
+type TemplateEdges struct {
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// TemplateLinks holds the value of the template_links edge.
+	TemplateLinks []*TemplateLink `json:"template_links,omitempty"`
+	// SharedUsers holds the value of the shared_users edge.
+	SharedUsers []*User `json:"shared_users,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// TemplateLinksOrErr returns the TemplateLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateEdges) TemplateLinksOrErr() ([]*TemplateLink, error) {
+	if e.loadedTypes[1] {
+		return e.TemplateLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "template_links"}
+}
+
+// SharedUsersOrErr returns the SharedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateEdges) SharedUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[2] {
+		return e.SharedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_users"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Template) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldTitle, template.FieldVisibility:
+			values[i] = new(sql.NullString)
+		case template.FieldCreatedAt, template.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case template.FieldID, template.FieldCreatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Template fields.
+func (_m *Template) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case template.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case template.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case template.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case template.FieldCreatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value != nil {
+				_m.CreatorID = *value
+			}
+		case template.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = template.Visibility(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Template.
+// This includes values selected through modifiers, order, etc.
+func (_m *Template) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCreator queries the "creator" edge of the Template entity.
+func (_m *Template) QueryCreator() *UserQuery {
+	return NewTemplateClient(_m.config).QueryCreator(_m)
+}
+
+// QueryTemplateLinks queries the "template_links" edge of the Template entity.
+func (_m *Template) QueryTemplateLinks() *TemplateLinkQuery {
+	return NewTemplateClient(_m.config).QueryTemplateLinks(_m)
+}
+
+// QuerySharedUsers queries the "shared_users" edge of the Template entity.
+func (_m *Template) QuerySharedUsers() *UserQuery {
+	return NewTemplateClient(_m.config).QuerySharedUsers(_m)
+}
+
+// Update returns a builder for updating this Template.
+// Note that you need to call Template.Unwrap() before calling this method if this Template
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Template) Update() *TemplateUpdateOne {
+	return NewTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Template entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Template) Unwrap() *Template {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Template is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Template) String() string {
+	var builder strings.Builder
+	builder.WriteString("Template(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Templates is a parsable slice of Template.
+type Templates []*Template
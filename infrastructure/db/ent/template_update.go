@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+)
+
+// TemplateUpdate is the builder for updating Template entities.
+type TemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TemplateMutation
+}
+
+// Where appends a list predicates to the TemplateUpdate builder.
+func (_u *TemplateUpdate) Where(ps ...predicate.Template) *TemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TemplateUpdate) SetUpdatedAt(v time.Time) *TemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TemplateUpdate) SetTitle(v string) *TemplateUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TemplateUpdate) SetNillableTitle(v *string) *TemplateUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (_u *TemplateUpdate) SetCreatorID(v uuid.UUID) *TemplateUpdate {
+	_u.mutation.SetCreatorID(v)
+	return _u
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (_u *TemplateUpdate) SetNillableCreatorID(v *uuid.UUID) *TemplateUpdate {
+	if v != nil {
+		_u.SetCreatorID(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *TemplateUpdate) SetVisibility(v template.Visibility) *TemplateUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *TemplateUpdate) SetNillableVisibility(v *template.Visibility) *TemplateUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_u *TemplateUpdate) SetCreator(v *User) *TemplateUpdate {
+	return _u.SetCreatorID(v.ID)
+}
+
+// AddTemplateLinkIDs adds the "template_links" edge to the TemplateLink entity by IDs.
+func (_u *TemplateUpdate) AddTemplateLinkIDs(ids ...uuid.UUID) *TemplateUpdate {
+	_u.mutation.AddTemplateLinkIDs(ids...)
+	return _u
+}
+
+// AddTemplateLinks adds the "template_links" edges to the TemplateLink entity.
+func (_u *TemplateUpdate) AddTemplateLinks(v ...*TemplateLink) *TemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateLinkIDs(ids...)
+}
+
+// AddSharedUserIDs adds the "shared_users" edge to the User entity by IDs.
+func (_u *TemplateUpdate) AddSharedUserIDs(ids ...uuid.UUID) *TemplateUpdate {
+	_u.mutation.AddSharedUserIDs(ids...)
+	return _u
+}
+
+// AddSharedUsers adds the "shared_users" edges to the User entity.
+func (_u *TemplateUpdate) AddSharedUsers(v ...*User) *TemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSharedUserIDs(ids...)
+}
+
+// Mutation returns the TemplateMutation object of the builder.
+func (_u *TemplateUpdate) Mutation() *TemplateMutation {
+	return _u.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (_u *TemplateUpdate) ClearCreator() *TemplateUpdate {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// ClearTemplateLinks clears all "template_links" edges to the TemplateLink entity.
+func (_u *TemplateUpdate) ClearTemplateLinks() *TemplateUpdate {
+	_u.mutation.ClearTemplateLinks()
+	return _u
+}
+
+// RemoveTemplateLinkIDs removes the "template_links" edge to TemplateLink entities by IDs.
+func (_u *TemplateUpdate) RemoveTemplateLinkIDs(ids ...uuid.UUID) *TemplateUpdate {
+	_u.mutation.RemoveTemplateLinkIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateLinks removes "template_links" edges to TemplateLink entities.
+func (_u *TemplateUpdate) RemoveTemplateLinks(v ...*TemplateLink) *TemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateLinkIDs(ids...)
+}
+
+// ClearSharedUsers clears all "shared_users" edges to the User entity.
+func (_u *TemplateUpdate) ClearSharedUsers() *TemplateUpdate {
+	_u.mutation.ClearSharedUsers()
+	return _u
+}
+
+// RemoveSharedUserIDs removes the "shared_users" edge to User entities by IDs.
+func (_u *TemplateUpdate) RemoveSharedUserIDs(ids ...uuid.UUID) *TemplateUpdate {
+	_u.mutation.RemoveSharedUserIDs(ids...)
+	return _u
+}
+
+// RemoveSharedUsers removes "shared_users" edges to User entities.
+func (_u *TemplateUpdate) RemoveSharedUsers(v ...*User) *TemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSharedUserIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := template.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := template.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Template.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := template.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Template.visibility": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Template.creator"`)
+	}
+	return nil
+}
+
+func (_u *TemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(template.Table, template.Columns, sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(template.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(template.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(template.FieldVisibility, field.TypeEnum, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.CreatorTable,
+			Columns: []string{template.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Template
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.CreatorTable,
+			Columns: []string{template.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TemplateLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateLinksIDs(); len(nodes) > 0 && !_u.mutation.TemplateLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharedUsersIDs(); len(nodes) > 0 && !_u.mutation.SharedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Template
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{template.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TemplateUpdateOne is the builder for updating a single Template entity.
+type TemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TemplateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TemplateUpdateOne) SetUpdatedAt(v time.Time) *TemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TemplateUpdateOne) SetTitle(v string) *TemplateUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TemplateUpdateOne) SetNillableTitle(v *string) *TemplateUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (_u *TemplateUpdateOne) SetCreatorID(v uuid.UUID) *TemplateUpdateOne {
+	_u.mutation.SetCreatorID(v)
+	return _u
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (_u *TemplateUpdateOne) SetNillableCreatorID(v *uuid.UUID) *TemplateUpdateOne {
+	if v != nil {
+		_u.SetCreatorID(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *TemplateUpdateOne) SetVisibility(v template.Visibility) *TemplateUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *TemplateUpdateOne) SetNillableVisibility(v *template.Visibility) *TemplateUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_u *TemplateUpdateOne) SetCreator(v *User) *TemplateUpdateOne {
+	return _u.SetCreatorID(v.ID)
+}
+
+// AddTemplateLinkIDs adds the "template_links" edge to the TemplateLink entity by IDs.
+func (_u *TemplateUpdateOne) AddTemplateLinkIDs(ids ...uuid.UUID) *TemplateUpdateOne {
+	_u.mutation.AddTemplateLinkIDs(ids...)
+	return _u
+}
+
+// AddTemplateLinks adds the "template_links" edges to the TemplateLink entity.
+func (_u *TemplateUpdateOne) AddTemplateLinks(v ...*TemplateLink) *TemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateLinkIDs(ids...)
+}
+
+// AddSharedUserIDs adds the "shared_users" edge to the User entity by IDs.
+func (_u *TemplateUpdateOne) AddSharedUserIDs(ids ...uuid.UUID) *TemplateUpdateOne {
+	_u.mutation.AddSharedUserIDs(ids...)
+	return _u
+}
+
+// AddSharedUsers adds the "shared_users" edges to the User entity.
+func (_u *TemplateUpdateOne) AddSharedUsers(v ...*User) *TemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSharedUserIDs(ids...)
+}
+
+// Mutation returns the TemplateMutation object of the builder.
+func (_u *TemplateUpdateOne) Mutation() *TemplateMutation {
+	return _u.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (_u *TemplateUpdateOne) ClearCreator() *TemplateUpdateOne {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// ClearTemplateLinks clears all "template_links" edges to the TemplateLink entity.
+func (_u *TemplateUpdateOne) ClearTemplateLinks() *TemplateUpdateOne {
+	_u.mutation.ClearTemplateLinks()
+	return _u
+}
+
+// RemoveTemplateLinkIDs removes the "template_links" edge to TemplateLink entities by IDs.
+func (_u *TemplateUpdateOne) RemoveTemplateLinkIDs(ids ...uuid.UUID) *TemplateUpdateOne {
+	_u.mutation.RemoveTemplateLinkIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateLinks removes "template_links" edges to TemplateLink entities.
+func (_u *TemplateUpdateOne) RemoveTemplateLinks(v ...*TemplateLink) *TemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateLinkIDs(ids...)
+}
+
+// ClearSharedUsers clears all "shared_users" edges to the User entity.
+func (_u *TemplateUpdateOne) ClearSharedUsers() *TemplateUpdateOne {
+	_u.mutation.ClearSharedUsers()
+	return _u
+}
+
+// RemoveSharedUserIDs removes the "shared_users" edge to User entities by IDs.
+func (_u *TemplateUpdateOne) RemoveSharedUserIDs(ids ...uuid.UUID) *TemplateUpdateOne {
+	_u.mutation.RemoveSharedUserIDs(ids...)
+	return _u
+}
+
+// RemoveSharedUsers removes "shared_users" edges to User entities.
+func (_u *TemplateUpdateOne) RemoveSharedUsers(v ...*User) *TemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSharedUserIDs(ids...)
+}
+
+// Where appends a list predicates to the TemplateUpdate builder.
+func (_u *TemplateUpdateOne) Where(ps ...predicate.Template) *TemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TemplateUpdateOne) Select(field string, fields ...string) *TemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Template entity.
+func (_u *TemplateUpdateOne) Save(ctx context.Context) (*Template, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateUpdateOne) SaveX(ctx context.Context) *Template {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := template.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := template.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Template.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := template.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Template.visibility": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Template.creator"`)
+	}
+	return nil
+}
+
+func (_u *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(template.Table, template.Columns, sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Template.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, template.FieldID)
+		for _, f := range fields {
+			if !template.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != template.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(template.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(template.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(template.FieldVisibility, field.TypeEnum, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.CreatorTable,
+			Columns: []string{template.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Template
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.CreatorTable,
+			Columns: []string{template.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TemplateLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateLinksIDs(); len(nodes) > 0 && !_u.mutation.TemplateLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.TemplateLinksTable,
+			Columns: []string{template.TemplateLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharedUsersIDs(); len(nodes) > 0 && !_u.mutation.SharedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   template.SharedUsersTable,
+			Columns: template.SharedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateSharedUsers
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Template
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Template{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{template.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
+)
+
+// TemplateLinkCreate is the builder for creating a TemplateLink entity.
+type TemplateLinkCreate struct {
+	config
+	mutation *TemplateLinkMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TemplateLinkCreate) SetCreatedAt(v time.Time) *TemplateLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TemplateLinkCreate) SetNillableCreatedAt(v *time.Time) *TemplateLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TemplateLinkCreate) SetUpdatedAt(v time.Time) *TemplateLinkCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TemplateLinkCreate) SetNillableUpdatedAt(v *time.Time) *TemplateLinkCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_c *TemplateLinkCreate) SetTemplateID(v uuid.UUID) *TemplateLinkCreate {
+	_c.mutation.SetTemplateID(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *TemplateLinkCreate) SetURL(v string) *TemplateLinkCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *TemplateLinkCreate) SetMemo(v string) *TemplateLinkCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *TemplateLinkCreate) SetNillableMemo(v *string) *TemplateLinkCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TemplateLinkCreate) SetPriority(v int) *TemplateLinkCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TemplateLinkCreate) SetNillablePriority(v *int) *TemplateLinkCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TemplateLinkCreate) SetID(v uuid.UUID) *TemplateLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TemplateLinkCreate) SetNillableID(v *uuid.UUID) *TemplateLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" edge to the Template entity.
+func (_c *TemplateLinkCreate) SetTemplate(v *Template) *TemplateLinkCreate {
+	return _c.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateLinkMutation object of the builder.
+func (_c *TemplateLinkCreate) Mutation() *TemplateLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the TemplateLink in the database.
+func (_c *TemplateLinkCreate) Save(ctx context.Context) (*TemplateLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TemplateLinkCreate) SaveX(ctx context.Context) *TemplateLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TemplateLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := templatelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := templatelink.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := templatelink.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := templatelink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TemplateLinkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TemplateLink.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TemplateLink.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`ent: missing required field "TemplateLink.template_id"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "TemplateLink.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := templatelink.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "TemplateLink.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TemplateLink.priority"`)}
+	}
+	if len(_c.mutation.TemplateIDs()) == 0 {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required edge "TemplateLink.template"`)}
+	}
+	return nil
+}
+
+func (_c *TemplateLinkCreate) sqlSave(ctx context.Context) (*TemplateLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TemplateLinkCreate) createSpec() (*TemplateLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TemplateLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(templatelink.Table, sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.TemplateLink
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(templatelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(templatelink.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(templatelink.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(templatelink.FieldMemo, field.TypeString, value)
+		_node.Memo = &value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(templatelink.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if nodes := _c.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatelink.TemplateTable,
+			Columns: []string{templatelink.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TemplateLinkCreateBulk is the builder for creating many TemplateLink entities in bulk.
+type TemplateLinkCreateBulk struct {
+	config
+	err      error
+	builders []*TemplateLinkCreate
+}
+
+// Save creates the TemplateLink entities in the database.
+func (_c *TemplateLinkCreateBulk) Save(ctx context.Context) ([]*TemplateLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TemplateLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TemplateLinkCreateBulk) SaveX(ctx context.Context) []*TemplateLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
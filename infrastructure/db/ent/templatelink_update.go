@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/internal"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/predicate"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
+)
+
+// TemplateLinkUpdate is the builder for updating TemplateLink entities.
+type TemplateLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TemplateLinkMutation
+}
+
+// Where appends a list predicates to the TemplateLinkUpdate builder.
+func (_u *TemplateLinkUpdate) Where(ps ...predicate.TemplateLink) *TemplateLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TemplateLinkUpdate) SetUpdatedAt(v time.Time) *TemplateLinkUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_u *TemplateLinkUpdate) SetTemplateID(v uuid.UUID) *TemplateLinkUpdate {
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *TemplateLinkUpdate) SetNillableTemplateID(v *uuid.UUID) *TemplateLinkUpdate {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *TemplateLinkUpdate) SetURL(v string) *TemplateLinkUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *TemplateLinkUpdate) SetNillableURL(v *string) *TemplateLinkUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TemplateLinkUpdate) SetMemo(v string) *TemplateLinkUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TemplateLinkUpdate) SetNillableMemo(v *string) *TemplateLinkUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TemplateLinkUpdate) ClearMemo() *TemplateLinkUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TemplateLinkUpdate) SetPriority(v int) *TemplateLinkUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TemplateLinkUpdate) SetNillablePriority(v *int) *TemplateLinkUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TemplateLinkUpdate) AddPriority(v int) *TemplateLinkUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the Template entity.
+func (_u *TemplateLinkUpdate) SetTemplate(v *Template) *TemplateLinkUpdate {
+	return _u.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateLinkMutation object of the builder.
+func (_u *TemplateLinkUpdate) Mutation() *TemplateLinkMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the Template entity.
+func (_u *TemplateLinkUpdate) ClearTemplate() *TemplateLinkUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TemplateLinkUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TemplateLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TemplateLinkUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := templatelink.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateLinkUpdate) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := templatelink.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "TemplateLink.url": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateLink.template"`)
+	}
+	return nil
+}
+
+func (_u *TemplateLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatelink.Table, templatelink.Columns, sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(templatelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(templatelink.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(templatelink.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(templatelink.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(templatelink.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(templatelink.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatelink.TemplateTable,
+			Columns: []string{templatelink.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatelink.TemplateTable,
+			Columns: []string{templatelink.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TemplateLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TemplateLinkUpdateOne is the builder for updating a single TemplateLink entity.
+type TemplateLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TemplateLinkMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TemplateLinkUpdateOne) SetUpdatedAt(v time.Time) *TemplateLinkUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_u *TemplateLinkUpdateOne) SetTemplateID(v uuid.UUID) *TemplateLinkUpdateOne {
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *TemplateLinkUpdateOne) SetNillableTemplateID(v *uuid.UUID) *TemplateLinkUpdateOne {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *TemplateLinkUpdateOne) SetURL(v string) *TemplateLinkUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *TemplateLinkUpdateOne) SetNillableURL(v *string) *TemplateLinkUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TemplateLinkUpdateOne) SetMemo(v string) *TemplateLinkUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TemplateLinkUpdateOne) SetNillableMemo(v *string) *TemplateLinkUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TemplateLinkUpdateOne) ClearMemo() *TemplateLinkUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TemplateLinkUpdateOne) SetPriority(v int) *TemplateLinkUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TemplateLinkUpdateOne) SetNillablePriority(v *int) *TemplateLinkUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TemplateLinkUpdateOne) AddPriority(v int) *TemplateLinkUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the Template entity.
+func (_u *TemplateLinkUpdateOne) SetTemplate(v *Template) *TemplateLinkUpdateOne {
+	return _u.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateLinkMutation object of the builder.
+func (_u *TemplateLinkUpdateOne) Mutation() *TemplateLinkMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the Template entity.
+func (_u *TemplateLinkUpdateOne) ClearTemplate() *TemplateLinkUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// Where appends a list predicates to the TemplateLinkUpdate builder.
+func (_u *TemplateLinkUpdateOne) Where(ps ...predicate.TemplateLink) *TemplateLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TemplateLinkUpdateOne) Select(field string, fields ...string) *TemplateLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TemplateLink entity.
+func (_u *TemplateLinkUpdateOne) Save(ctx context.Context) (*TemplateLink, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateLinkUpdateOne) SaveX(ctx context.Context) *TemplateLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TemplateLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TemplateLinkUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := templatelink.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := templatelink.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "TemplateLink.url": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateLink.template"`)
+	}
+	return nil
+}
+
+func (_u *TemplateLinkUpdateOne) sqlSave(ctx context.Context) (_node *TemplateLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatelink.Table, templatelink.Columns, sqlgraph.NewFieldSpec(templatelink.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TemplateLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templatelink.FieldID)
+		for _, f := range fields {
+			if !templatelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != templatelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(templatelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(templatelink.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(templatelink.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(templatelink.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(templatelink.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(templatelink.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatelink.TemplateTable,
+			Columns: []string{templatelink.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatelink.TemplateTable,
+			Columns: []string{templatelink.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.TemplateLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TemplateLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &TemplateLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
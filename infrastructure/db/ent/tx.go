@@ -12,12 +12,46 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
+	// LinkClick is the client for interacting with the LinkClick builders.
+	LinkClick *LinkClickClient
 	// LinkItem is the client for interacting with the LinkItem builders.
 	LinkItem *LinkItemClient
+	// LinkReaction is the client for interacting with the LinkReaction builders.
+	LinkReaction *LinkReactionClient
+	// LinkSnapshot is the client for interacting with the LinkSnapshot builders.
+	LinkSnapshot *LinkSnapshotClient
+	// LinkState is the client for interacting with the LinkState builders.
+	LinkState *LinkStateClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// Page is the client for interacting with the Page builders.
 	Page *PageClient
+	// PagePreference is the client for interacting with the PagePreference builders.
+	PagePreference *PagePreferenceClient
+	// PageSlug is the client for interacting with the PageSlug builders.
+	PageSlug *PageSlugClient
+	// Section is the client for interacting with the Section builders.
+	Section *SectionClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
+	// TemplateLink is the client for interacting with the TemplateLink builders.
+	TemplateLink *TemplateLinkClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +183,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.Device = NewDeviceClient(tx.config)
+	tx.Invitation = NewInvitationClient(tx.config)
+	tx.LinkClick = NewLinkClickClient(tx.config)
 	tx.LinkItem = NewLinkItemClient(tx.config)
+	tx.LinkReaction = NewLinkReactionClient(tx.config)
+	tx.LinkSnapshot = NewLinkSnapshotClient(tx.config)
+	tx.LinkState = NewLinkStateClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
 	tx.Page = NewPageClient(tx.config)
+	tx.PagePreference = NewPagePreferenceClient(tx.config)
+	tx.PageSlug = NewPageSlugClient(tx.config)
+	tx.Section = NewSectionClient(tx.config)
+	tx.Template = NewTemplateClient(tx.config)
+	tx.TemplateLink = NewTemplateLinkClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +212,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: LinkItem.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -28,6 +28,12 @@ type User struct {
 	Provider user.Provider `json:"provider,omitempty"`
 	// Email holds the value of the "email" field.
 	Email *string `json:"email,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// AvatarURL holds the value of the "avatar_url" field.
+	AvatarURL string `json:"avatar_url,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale string `json:"locale,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -40,9 +46,31 @@ type UserEdges struct {
 	CreatedPages []*Page `json:"created_pages,omitempty"`
 	// InvitedPages holds the value of the invited_pages edge.
 	InvitedPages []*Page `json:"invited_pages,omitempty"`
+	// CreatedTemplates holds the value of the created_templates edge.
+	CreatedTemplates []*Template `json:"created_templates,omitempty"`
+	// SharedTemplates holds the value of the shared_templates edge.
+	SharedTemplates []*Template `json:"shared_templates,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// LinkReactions holds the value of the link_reactions edge.
+	LinkReactions []*LinkReaction `json:"link_reactions,omitempty"`
+	// LinkStates holds the value of the link_states edge.
+	LinkStates []*LinkState `json:"link_states,omitempty"`
+	// PagePreferences holds the value of the page_preferences edge.
+	PagePreferences []*PagePreference `json:"page_preferences,omitempty"`
+	// SentInvitations holds the value of the sent_invitations edge.
+	SentInvitations []*Invitation `json:"sent_invitations,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences,omitempty"`
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [13]bool
 }
 
 // CreatedPagesOrErr returns the CreatedPages value or an error if the edge
@@ -63,12 +91,111 @@ func (e UserEdges) InvitedPagesOrErr() ([]*Page, error) {
 	return nil, &NotLoadedError{edge: "invited_pages"}
 }
 
+// CreatedTemplatesOrErr returns the CreatedTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreatedTemplatesOrErr() ([]*Template, error) {
+	if e.loadedTypes[2] {
+		return e.CreatedTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "created_templates"}
+}
+
+// SharedTemplatesOrErr returns the SharedTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharedTemplatesOrErr() ([]*Template, error) {
+	if e.loadedTypes[3] {
+		return e.SharedTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_templates"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[4] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// LinkReactionsOrErr returns the LinkReactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LinkReactionsOrErr() ([]*LinkReaction, error) {
+	if e.loadedTypes[5] {
+		return e.LinkReactions, nil
+	}
+	return nil, &NotLoadedError{edge: "link_reactions"}
+}
+
+// LinkStatesOrErr returns the LinkStates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LinkStatesOrErr() ([]*LinkState, error) {
+	if e.loadedTypes[6] {
+		return e.LinkStates, nil
+	}
+	return nil, &NotLoadedError{edge: "link_states"}
+}
+
+// PagePreferencesOrErr returns the PagePreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PagePreferencesOrErr() ([]*PagePreference, error) {
+	if e.loadedTypes[7] {
+		return e.PagePreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "page_preferences"}
+}
+
+// SentInvitationsOrErr returns the SentInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentInvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[8] {
+		return e.SentInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_invitations"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[9] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationPreferencesOrErr() ([]*NotificationPreference, error) {
+	if e.loadedTypes[10] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[11] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[12] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldUID, user.FieldProvider, user.FieldEmail:
+		case user.FieldUID, user.FieldProvider, user.FieldEmail, user.FieldDisplayName, user.FieldAvatarURL, user.FieldLocale:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -126,6 +253,24 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.Email = new(string)
 				*_m.Email = value.String
 			}
+		case user.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case user.FieldAvatarURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
+			} else if value.Valid {
+				_m.AvatarURL = value.String
+			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -149,6 +294,61 @@ func (_m *User) QueryInvitedPages() *PageQuery {
 	return NewUserClient(_m.config).QueryInvitedPages(_m)
 }
 
+// QueryCreatedTemplates queries the "created_templates" edge of the User entity.
+func (_m *User) QueryCreatedTemplates() *TemplateQuery {
+	return NewUserClient(_m.config).QueryCreatedTemplates(_m)
+}
+
+// QuerySharedTemplates queries the "shared_templates" edge of the User entity.
+func (_m *User) QuerySharedTemplates() *TemplateQuery {
+	return NewUserClient(_m.config).QuerySharedTemplates(_m)
+}
+
+// QueryComments queries the "comments" edge of the User entity.
+func (_m *User) QueryComments() *CommentQuery {
+	return NewUserClient(_m.config).QueryComments(_m)
+}
+
+// QueryLinkReactions queries the "link_reactions" edge of the User entity.
+func (_m *User) QueryLinkReactions() *LinkReactionQuery {
+	return NewUserClient(_m.config).QueryLinkReactions(_m)
+}
+
+// QueryLinkStates queries the "link_states" edge of the User entity.
+func (_m *User) QueryLinkStates() *LinkStateQuery {
+	return NewUserClient(_m.config).QueryLinkStates(_m)
+}
+
+// QueryPagePreferences queries the "page_preferences" edge of the User entity.
+func (_m *User) QueryPagePreferences() *PagePreferenceQuery {
+	return NewUserClient(_m.config).QueryPagePreferences(_m)
+}
+
+// QuerySentInvitations queries the "sent_invitations" edge of the User entity.
+func (_m *User) QuerySentInvitations() *InvitationQuery {
+	return NewUserClient(_m.config).QuerySentInvitations(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryNotificationPreferences queries the "notification_preferences" edge of the User entity.
+func (_m *User) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	return NewUserClient(_m.config).QueryNotificationPreferences(_m)
+}
+
+// QueryDevices queries the "devices" edge of the User entity.
+func (_m *User) QueryDevices() *DeviceQuery {
+	return NewUserClient(_m.config).QueryDevices(_m)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (_m *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(_m.config).QueryAccessTokens(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -188,6 +388,15 @@ func (_m *User) String() string {
 		builder.WriteString("email=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("avatar_url=")
+	builder.WriteString(_m.AvatarURL)
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
 	builder.WriteByte(')')
 	return builder.String()
 }
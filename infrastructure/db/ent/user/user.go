@@ -26,10 +26,38 @@ const (
 	FieldProvider = "provider"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
+	FieldAvatarURL = "avatar_url"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
 	// EdgeCreatedPages holds the string denoting the created_pages edge name in mutations.
 	EdgeCreatedPages = "created_pages"
 	// EdgeInvitedPages holds the string denoting the invited_pages edge name in mutations.
 	EdgeInvitedPages = "invited_pages"
+	// EdgeCreatedTemplates holds the string denoting the created_templates edge name in mutations.
+	EdgeCreatedTemplates = "created_templates"
+	// EdgeSharedTemplates holds the string denoting the shared_templates edge name in mutations.
+	EdgeSharedTemplates = "shared_templates"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeLinkReactions holds the string denoting the link_reactions edge name in mutations.
+	EdgeLinkReactions = "link_reactions"
+	// EdgeLinkStates holds the string denoting the link_states edge name in mutations.
+	EdgeLinkStates = "link_states"
+	// EdgePagePreferences holds the string denoting the page_preferences edge name in mutations.
+	EdgePagePreferences = "page_preferences"
+	// EdgeSentInvitations holds the string denoting the sent_invitations edge name in mutations.
+	EdgeSentInvitations = "sent_invitations"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
+	// EdgeDevices holds the string denoting the devices edge name in mutations.
+	EdgeDevices = "devices"
+	// EdgeAccessTokens holds the string denoting the access_tokens edge name in mutations.
+	EdgeAccessTokens = "access_tokens"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// CreatedPagesTable is the table that holds the created_pages relation/edge.
@@ -44,6 +72,81 @@ const (
 	// InvitedPagesInverseTable is the table name for the Page entity.
 	// It exists in this package in order to avoid circular dependency with the "page" package.
 	InvitedPagesInverseTable = "pages"
+	// CreatedTemplatesTable is the table that holds the created_templates relation/edge.
+	CreatedTemplatesTable = "templates"
+	// CreatedTemplatesInverseTable is the table name for the Template entity.
+	// It exists in this package in order to avoid circular dependency with the "template" package.
+	CreatedTemplatesInverseTable = "templates"
+	// CreatedTemplatesColumn is the table column denoting the created_templates relation/edge.
+	CreatedTemplatesColumn = "creator_id"
+	// SharedTemplatesTable is the table that holds the shared_templates relation/edge. The primary key declared below.
+	SharedTemplatesTable = "template_users"
+	// SharedTemplatesInverseTable is the table name for the Template entity.
+	// It exists in this package in order to avoid circular dependency with the "template" package.
+	SharedTemplatesInverseTable = "templates"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "link_comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "link_comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "author_id"
+	// LinkReactionsTable is the table that holds the link_reactions relation/edge.
+	LinkReactionsTable = "link_reactions"
+	// LinkReactionsInverseTable is the table name for the LinkReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "linkreaction" package.
+	LinkReactionsInverseTable = "link_reactions"
+	// LinkReactionsColumn is the table column denoting the link_reactions relation/edge.
+	LinkReactionsColumn = "user_id"
+	// LinkStatesTable is the table that holds the link_states relation/edge.
+	LinkStatesTable = "link_states"
+	// LinkStatesInverseTable is the table name for the LinkState entity.
+	// It exists in this package in order to avoid circular dependency with the "linkstate" package.
+	LinkStatesInverseTable = "link_states"
+	// LinkStatesColumn is the table column denoting the link_states relation/edge.
+	LinkStatesColumn = "user_id"
+	// PagePreferencesTable is the table that holds the page_preferences relation/edge.
+	PagePreferencesTable = "page_preferences"
+	// PagePreferencesInverseTable is the table name for the PagePreference entity.
+	// It exists in this package in order to avoid circular dependency with the "pagepreference" package.
+	PagePreferencesInverseTable = "page_preferences"
+	// PagePreferencesColumn is the table column denoting the page_preferences relation/edge.
+	PagePreferencesColumn = "user_id"
+	// SentInvitationsTable is the table that holds the sent_invitations relation/edge.
+	SentInvitationsTable = "invitations"
+	// SentInvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	SentInvitationsInverseTable = "invitations"
+	// SentInvitationsColumn is the table column denoting the sent_invitations relation/edge.
+	SentInvitationsColumn = "invited_by_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "recipient_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferencesInverseTable = "notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "user_id"
+	// DevicesTable is the table that holds the devices relation/edge.
+	DevicesTable = "devices"
+	// DevicesInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DevicesInverseTable = "devices"
+	// DevicesColumn is the table column denoting the devices relation/edge.
+	DevicesColumn = "user_id"
+	// AccessTokensTable is the table that holds the access_tokens relation/edge.
+	AccessTokensTable = "access_tokens"
+	// AccessTokensInverseTable is the table name for the AccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "accesstoken" package.
+	AccessTokensInverseTable = "access_tokens"
+	// AccessTokensColumn is the table column denoting the access_tokens relation/edge.
+	AccessTokensColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -54,12 +157,18 @@ var Columns = []string{
 	FieldUID,
 	FieldProvider,
 	FieldEmail,
+	FieldDisplayName,
+	FieldAvatarURL,
+	FieldLocale,
 }
 
 var (
 	// InvitedPagesPrimaryKey and InvitedPagesColumn2 are the table columns denoting the
 	// primary key for the invited_pages relation (M2M).
 	InvitedPagesPrimaryKey = []string{"page_id", "user_id"}
+	// SharedTemplatesPrimaryKey and SharedTemplatesColumn2 are the table columns denoting the
+	// primary key for the shared_templates relation (M2M).
+	SharedTemplatesPrimaryKey = []string{"template_id", "user_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -81,6 +190,16 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
 	UIDValidator func(string) error
+	// DefaultDisplayName holds the default value on creation for the "display_name" field.
+	DefaultDisplayName string
+	// DefaultAvatarURL holds the default value on creation for the "avatar_url" field.
+	DefaultAvatarURL string
+	// AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	AvatarURLValidator func(string) error
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	LocaleValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -145,6 +264,21 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByAvatarURL orders the results by the avatar_url field.
+func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
 // ByCreatedPagesCount orders the results by created_pages count.
 func ByCreatedPagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -172,6 +306,160 @@ func ByInvitedPages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInvitedPagesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCreatedTemplatesCount orders the results by created_templates count.
+func ByCreatedTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreatedTemplatesStep(), opts...)
+	}
+}
+
+// ByCreatedTemplates orders the results by created_templates terms.
+func ByCreatedTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySharedTemplatesCount orders the results by shared_templates count.
+func BySharedTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharedTemplatesStep(), opts...)
+	}
+}
+
+// BySharedTemplates orders the results by shared_templates terms.
+func BySharedTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharedTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinkReactionsCount orders the results by link_reactions count.
+func ByLinkReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinkReactionsStep(), opts...)
+	}
+}
+
+// ByLinkReactions orders the results by link_reactions terms.
+func ByLinkReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinkStatesCount orders the results by link_states count.
+func ByLinkStatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinkStatesStep(), opts...)
+	}
+}
+
+// ByLinkStates orders the results by link_states terms.
+func ByLinkStates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkStatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPagePreferencesCount orders the results by page_preferences count.
+func ByPagePreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPagePreferencesStep(), opts...)
+	}
+}
+
+// ByPagePreferences orders the results by page_preferences terms.
+func ByPagePreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPagePreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentInvitationsCount orders the results by sent_invitations count.
+func BySentInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentInvitationsStep(), opts...)
+	}
+}
+
+// BySentInvitations orders the results by sent_invitations terms.
+func BySentInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDevicesCount orders the results by devices count.
+func ByDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDevicesStep(), opts...)
+	}
+}
+
+// ByDevices orders the results by devices terms.
+func ByDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessTokensCount orders the results by access_tokens count.
+func ByAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessTokensStep(), opts...)
+	}
+}
+
+// ByAccessTokens orders the results by access_tokens terms.
+func ByAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCreatedPagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -186,3 +474,80 @@ func newInvitedPagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, InvitedPagesTable, InvitedPagesPrimaryKey...),
 	)
 }
+func newCreatedTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreatedTemplatesTable, CreatedTemplatesColumn),
+	)
+}
+func newSharedTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharedTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SharedTemplatesTable, SharedTemplatesPrimaryKey...),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newLinkReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinkReactionsTable, LinkReactionsColumn),
+	)
+}
+func newLinkStatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkStatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinkStatesTable, LinkStatesColumn),
+	)
+}
+func newPagePreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PagePreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PagePreferencesTable, PagePreferencesColumn),
+	)
+}
+func newSentInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentInvitationsTable, SentInvitationsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
+func newDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+	)
+}
+func newAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessTokensTable, AccessTokensColumn),
+	)
+}
@@ -0,0 +1,57 @@
+package fixture
+
+import (
+	guuid "github.com/google/uuid"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	"github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+type commentRow struct {
+	id        guuid.UUID
+	pageKey   string
+	linkKey   string
+	parentKey string
+	authorKey string
+	body      string
+	deleted   bool
+}
+
+// NewComment prepares a comment row based on the domain model. Call Setup to insert.
+// The page, link, parent and author may be given as aliases or IDs. A non-empty body is
+// registered as an alias for the generated comment ID.
+func (f *Fixture) NewComment(comment *dcomment.Comment) {
+	var commentID guuid.UUID
+	if comment.ID() == "" {
+		commentID = uuid.NewV7()
+	} else {
+		parsed, err := guuid.Parse(comment.ID())
+		if err != nil {
+			panic("invalid comment ID: " + comment.ID())
+		}
+		commentID = parsed
+	}
+	if comment.PageID() == "" || comment.LinkID() == "" {
+		panic("cannot add comment without page and link")
+	}
+	authorKey := comment.Author().ID()
+	if authorKey == "" {
+		authorKey = comment.Author().UID()
+	}
+	if authorKey == "" {
+		panic("comment author must have id or uid")
+	}
+
+	f.comments = append(f.comments, commentRow{
+		id:        commentID,
+		pageKey:   comment.PageID(),
+		linkKey:   comment.LinkID(),
+		parentKey: comment.ParentID(),
+		authorKey: authorKey,
+		body:      comment.Body(),
+		deleted:   comment.Deleted(),
+	})
+	if comment.Body() != "" {
+		f.idMap[comment.Body()] = commentID
+	}
+}
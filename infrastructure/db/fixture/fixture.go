@@ -4,19 +4,36 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	guuid "github.com/google/uuid"
 
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entinvitation "github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
+	entlinkstate "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
+	enttemplate "github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
 	entuser "github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
 )
 
 // Fixture collects data to be inserted (prepared by New*), and Setup actually inserts them.
 type Fixture struct {
-	users     []userRow
-	pages     []pageRow
-	linkItems []linkItemRow
-	pageUsers []pageUserRow
+	users         []userRow
+	pages         []pageRow
+	linkItems     []linkItemRow
+	linkReactions []linkReactionRow
+	linkStates    []linkStateRow
+	sections      []sectionRow
+	pageUsers     []pageUserRow
+
+	templates     []templateRow
+	templateLinks []templateLinkRow
+	templateUsers []templateUserRow
+
+	comments []commentRow
+
+	pagePreferences []pagePreferenceRow
+
+	invitations []invitationRow
 
 	// alias to generated UUID mapping (after Setup)
 	idMap map[string]guuid.UUID
@@ -48,6 +65,9 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 			b := client.User.Create().
 				SetUID(u.uid).
 				SetProvider(entuser.Provider(u.provider)).
+				SetDisplayName(u.profile.DisplayName()).
+				SetAvatarURL(u.profile.AvatarURL()).
+				SetLocale(u.profile.Locale()).
 				SetID(u.id)
 			if u.email != nil {
 				b = b.SetNillableEmail(u.email)
@@ -68,6 +88,14 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 				SetTitle(p.title).
 				SetCreatorID(p.creatorID).
 				SetInviteCode(p.invite).
+				SetNillableFeedTokenHash(p.feedHash).
+				SetNillableSourcePageID(p.sourceID).
+				SetChecklist(p.checklist).
+				SetArchived(p.archived).
+				SetDescription(p.description).
+				SetIcon(p.icon).
+				SetColor(p.color).
+				SetNillableSlug(p.slug).
 				SetID(p.id)
 			builders = append(builders, b)
 		}
@@ -75,6 +103,38 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 		if err != nil {
 			return err
 		}
+
+		// Keep the slugs resolvable like pages saved through the repository.
+		slugBuilders := make([]*ent.PageSlugCreate, 0, len(f.pages))
+		for _, p := range f.pages {
+			if p.slug == nil {
+				continue
+			}
+			slugBuilders = append(slugBuilders, client.PageSlug.Create().
+				SetSlug(*p.slug).
+				SetPageID(p.id).
+				SetCreatedAt(time.Now()))
+		}
+		if len(slugBuilders) > 0 {
+			if _, err := client.PageSlug.CreateBulk(slugBuilders...).Save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Insert sections after pages and before link items (link items may reference them)
+	if len(f.sections) > 0 {
+		builders := make([]*ent.SectionCreate, 0, len(f.sections))
+		for _, s := range f.sections {
+			builders = append(builders, client.Section.Create().
+				SetPageID(s.pageID).
+				SetName(s.name).
+				SetPriority(s.priority).
+				SetNillableID(s.id))
+		}
+		if _, err := client.Section.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
 	}
 
 	// Insert link items after pages (need page IDs)
@@ -84,10 +144,20 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 			b := client.LinkItem.Create().
 				SetPageID(li.pageID).
 				SetURL(li.url).
-				SetPriority(li.priority)
+				SetPriority(li.priority).
+				SetNillableSectionID(li.sectionID).
+				SetNillableDoneAt(li.doneAt).
+				SetNillableID(li.id)
 			if li.memo != nil {
 				b = b.SetMemo(*li.memo)
 			}
+			if li.doneByKey != "" {
+				doneBy, err := guuid.Parse(f.resolveID(li.doneByKey))
+				if err != nil {
+					return fmt.Errorf("invalid done by user reference %q: %w", li.doneByKey, err)
+				}
+				b = b.SetDoneByID(doneBy)
+			}
 			builders = append(builders, b)
 		}
 		_, err := client.LinkItem.CreateBulk(builders...).Save(ctx)
@@ -96,6 +166,42 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 		}
 	}
 
+	// Insert link reactions after link items. The user may be given as an alias or ID.
+	if len(f.linkReactions) > 0 {
+		builders := make([]*ent.LinkReactionCreate, 0, len(f.linkReactions))
+		for _, lr := range f.linkReactions {
+			userUUID, err := guuid.Parse(f.resolveID(lr.userKey))
+			if err != nil {
+				return fmt.Errorf("invalid reaction user reference %q: %w", lr.userKey, err)
+			}
+			builders = append(builders, client.LinkReaction.Create().
+				SetLinkItemID(lr.linkID).
+				SetUserID(userUUID).
+				SetEmoji(lr.emoji))
+		}
+		if _, err := client.LinkReaction.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Insert link states after link items. The user may be given as an alias or ID.
+	if len(f.linkStates) > 0 {
+		builders := make([]*ent.LinkStateCreate, 0, len(f.linkStates))
+		for _, ls := range f.linkStates {
+			userUUID, err := guuid.Parse(f.resolveID(ls.userKey))
+			if err != nil {
+				return fmt.Errorf("invalid link state user reference %q: %w", ls.userKey, err)
+			}
+			builders = append(builders, client.LinkState.Create().
+				SetLinkItemID(ls.linkID).
+				SetUserID(userUUID).
+				SetStatus(entlinkstate.Status(ls.status)))
+		}
+		if _, err := client.LinkState.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
 	if len(f.pageUsers) > 0 {
 		grouped := make(map[guuid.UUID][]guuid.UUID)
 		for _, pu := range f.pageUsers {
@@ -118,6 +224,128 @@ func (f *Fixture) Setup(ctx context.Context, t *testing.T, client *ent.Client) e
 		}
 	}
 
+	// Insert templates after users (need creator IDs)
+	if len(f.templates) > 0 {
+		builders := make([]*ent.TemplateCreate, 0, len(f.templates))
+		for _, t := range f.templates {
+			builders = append(builders, client.Template.Create().
+				SetTitle(t.title).
+				SetCreatorID(t.creatorID).
+				SetVisibility(enttemplate.Visibility(t.visibility)).
+				SetID(t.id))
+		}
+		if _, err := client.Template.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(f.templateLinks) > 0 {
+		builders := make([]*ent.TemplateLinkCreate, 0, len(f.templateLinks))
+		for _, l := range f.templateLinks {
+			builders = append(builders, client.TemplateLink.Create().
+				SetTemplateID(l.templateID).
+				SetURL(l.url).
+				SetNillableMemo(l.memo).
+				SetPriority(l.priority))
+		}
+		if _, err := client.TemplateLink.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, tu := range f.templateUsers {
+		userUUID, err := guuid.Parse(f.resolveID(tu.userKey))
+		if err != nil {
+			return fmt.Errorf("invalid user reference %q: %w", tu.userKey, err)
+		}
+		if err := client.Template.UpdateOneID(tu.templateID).AddSharedUserIDs(userUUID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert template_users: %w", err)
+		}
+	}
+
+	// Insert comments one by one so replies can reference comments inserted before them.
+	for _, c := range f.comments {
+		refs := make(map[string]guuid.UUID, 4)
+		for name, key := range map[string]string{"page": c.pageKey, "link": c.linkKey, "author": c.authorKey, "parent": c.parentKey} {
+			if key == "" {
+				continue
+			}
+			parsed, err := guuid.Parse(f.resolveID(key))
+			if err != nil {
+				return fmt.Errorf("invalid comment %s reference %q: %w", name, key, err)
+			}
+			refs[name] = parsed
+		}
+		b := client.Comment.Create().
+			SetID(c.id).
+			SetPageID(refs["page"]).
+			SetLinkItemID(refs["link"]).
+			SetAuthorID(refs["author"]).
+			SetBody(c.body)
+		if parentID, ok := refs["parent"]; ok {
+			b = b.SetParentID(parentID)
+		}
+		if c.deleted {
+			b = b.SetDeletedAt(time.Now())
+		}
+		if err := b.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert comment: %w", err)
+		}
+	}
+
+	// Insert page preferences
+	if len(f.pagePreferences) > 0 {
+		builders := make([]*ent.PagePreferenceCreate, 0, len(f.pagePreferences))
+		for _, p := range f.pagePreferences {
+			userID, err := guuid.Parse(f.resolveID(p.userKey))
+			if err != nil {
+				return fmt.Errorf("invalid page preference user reference %q: %w", p.userKey, err)
+			}
+			pageID, err := guuid.Parse(f.resolveID(p.pageKey))
+			if err != nil {
+				return fmt.Errorf("invalid page preference page reference %q: %w", p.pageKey, err)
+			}
+			builders = append(builders, client.PagePreference.Create().
+				SetUserID(userID).
+				SetPageID(pageID).
+				SetPinned(p.pinned).
+				SetPosition(p.position).
+				SetInbox(p.inbox))
+		}
+		if _, err := client.PagePreference.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("failed to insert page preferences: %w", err)
+		}
+	}
+
+	// Insert invitations
+	for _, i := range f.invitations {
+		refs := make(map[string]guuid.UUID, 3)
+		for name, key := range map[string]string{"page": i.pageKey, "inviter": i.inviterKey, "accepted by": i.acceptedByKey} {
+			if key == "" {
+				continue
+			}
+			parsed, err := guuid.Parse(f.resolveID(key))
+			if err != nil {
+				return fmt.Errorf("invalid invitation %s reference %q: %w", name, key, err)
+			}
+			refs[name] = parsed
+		}
+		b := client.Invitation.Create().
+			SetID(i.id).
+			SetPageID(refs["page"]).
+			SetInvitedByID(refs["inviter"]).
+			SetEmail(i.email).
+			SetTokenHash(i.tokenHash).
+			SetStatus(entinvitation.Status(i.status)).
+			SetExpiresAt(i.expiresAt)
+		if acceptedBy, ok := refs["accepted by"]; ok {
+			b = b.SetAcceptedByID(acceptedBy)
+		}
+		if err := b.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert invitation: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,52 @@
+package fixture
+
+import (
+	"time"
+
+	guuid "github.com/google/uuid"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	"github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+type invitationRow struct {
+	id            guuid.UUID
+	pageKey       string
+	inviterKey    string
+	acceptedByKey string
+	email         string
+	tokenHash     string
+	status        string
+	expiresAt     time.Time
+}
+
+// NewInvitation prepares an invitation row based on the domain model. Call Setup to insert.
+// The page, inviter and accepting user may be given as aliases or IDs. The token hash is registered
+// as an alias for the generated invitation ID.
+func (f *Fixture) NewInvitation(invitation *dinvitation.Invitation) {
+	var id guuid.UUID
+	if invitation.ID() == "" {
+		id = uuid.NewV7()
+	} else {
+		parsed, err := guuid.Parse(invitation.ID())
+		if err != nil {
+			panic("invalid invitation ID: " + invitation.ID())
+		}
+		id = parsed
+	}
+	if invitation.PageID() == "" || invitation.InvitedByID() == "" || invitation.TokenHash() == "" {
+		panic("cannot add invitation without page, inviter and token hash")
+	}
+
+	f.invitations = append(f.invitations, invitationRow{
+		id:            id,
+		pageKey:       invitation.PageID(),
+		inviterKey:    invitation.InvitedByID(),
+		acceptedByKey: invitation.AcceptedByID(),
+		email:         invitation.Email(),
+		tokenHash:     invitation.TokenHash(),
+		status:        string(invitation.StoredStatus()),
+		expiresAt:     invitation.ExpiresAt(),
+	})
+	f.idMap[invitation.TokenHash()] = id
+}
@@ -1,6 +1,8 @@
 package fixture
 
 import (
+	"time"
+
 	guuid "github.com/google/uuid"
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
@@ -9,16 +11,47 @@ import (
 )
 
 type pageRow struct {
-	id        guuid.UUID
-	title     string
-	creatorID guuid.UUID
-	invite    string
+	id          guuid.UUID
+	title       string
+	creatorID   guuid.UUID
+	invite      string
+	feedHash    *string
+	sourceID    *guuid.UUID
+	checklist   bool
+	archived    bool
+	description string
+	icon        string
+	color       string
+	slug        *string
 }
 
 type linkItemRow struct {
+	id        *guuid.UUID
+	pageID    guuid.UUID
+	url       string
+	memo      *string
+	priority  int
+	sectionID *guuid.UUID
+	doneByKey string
+	doneAt    *time.Time
+}
+
+type linkReactionRow struct {
+	linkID  guuid.UUID
+	userKey string
+	emoji   string
+}
+
+type linkStateRow struct {
+	linkID  guuid.UUID
+	userKey string
+	status  string
+}
+
+type sectionRow struct {
+	id       *guuid.UUID
 	pageID   guuid.UUID
-	url      string
-	memo     *string
+	name     string
 	priority int
 }
 
@@ -53,12 +86,31 @@ func (f *Fixture) NewPage(page *dpage.Page) {
 		}
 		creatorID = parsed
 	}
-	f.pages = append(f.pages, pageRow{
-		id:        pageID,
-		title:     title,
-		creatorID: creatorID,
-		invite:    page.InviteCode(page.CreatedBy()),
-	})
+	row := pageRow{
+		id:          pageID,
+		title:       title,
+		creatorID:   creatorID,
+		invite:      page.InviteCode(page.CreatedBy()),
+		checklist:   page.IsChecklist(),
+		archived:    page.IsArchived(),
+		description: page.Description(),
+		icon:        page.Icon(),
+		color:       page.Color(),
+	}
+	if h := page.FeedTokenHash(); h != "" {
+		row.feedHash = ptr.Ptr(h)
+	}
+	if id := page.SourcePageID(); id != "" {
+		parsed, err := guuid.Parse(id)
+		if err != nil {
+			panic("invalid source page ID: " + id)
+		}
+		row.sourceID = &parsed
+	}
+	if slug := page.Slug(); slug != "" {
+		row.slug = ptr.Ptr(slug)
+	}
+	f.pages = append(f.pages, row)
 
 	// Map alias to generated UUID
 	f.idMap[title] = pageID
@@ -70,16 +122,60 @@ func (f *Fixture) NewPage(page *dpage.Page) {
 			if url == "" {
 				panic("cannot add link item with empty URL")
 			}
-			rows = append(rows, linkItemRow{
+			row := linkItemRow{
 				pageID:   pageID,
 				url:      url,
 				memo:     ptr.Ptr(l.Memo()),
 				priority: i + 1,
-			})
+			}
+			if l.ID() != "" {
+				parsed, err := guuid.Parse(l.ID())
+				if err != nil {
+					panic("invalid link ID: " + l.ID())
+				}
+				row.id = &parsed
+			}
+			if l.SectionID() != "" {
+				parsed, err := guuid.Parse(l.SectionID())
+				if err != nil {
+					panic("invalid section ID: " + l.SectionID())
+				}
+				row.sectionID = &parsed
+			}
+			if l.Done() {
+				row.doneByKey = l.DoneBy()
+				row.doneAt = ptr.Ptr(l.DoneAt())
+			}
+			if (len(l.Reactions()) > 0 || len(l.States()) > 0) && row.id == nil {
+				panic("cannot add reactions or states to link item without ID: " + url)
+			}
+			for _, r := range l.Reactions() {
+				f.linkReactions = append(f.linkReactions, linkReactionRow{linkID: *row.id, userKey: r.UserID(), emoji: r.Emoji()})
+			}
+			for _, st := range l.States() {
+				f.linkStates = append(f.linkStates, linkStateRow{linkID: *row.id, userKey: st.UserID(), status: string(st.Status())})
+			}
+			rows = append(rows, row)
 		}
 		return rows
 	}()...)
 
+	for i, s := range page.Sections() {
+		row := sectionRow{
+			pageID:   pageID,
+			name:     s.Name(),
+			priority: i + 1,
+		}
+		if s.ID() != "" {
+			parsed, err := guuid.Parse(s.ID())
+			if err != nil {
+				panic("invalid section ID: " + s.ID())
+			}
+			row.id = &parsed
+		}
+		f.sections = append(f.sections, row)
+	}
+
 	for _, invited := range page.InvitedUsers() {
 		if invited == nil {
 			panic("cannot add nil invited user")
@@ -0,0 +1,30 @@
+package fixture
+
+import (
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+)
+
+type pagePreferenceRow struct {
+	userKey  string
+	pageKey  string
+	pinned   bool
+	position int
+	inbox    bool
+}
+
+// NewPreferences prepares page preference rows based on the domain model. Call Setup to insert.
+// The user and the pages may be given as aliases or IDs.
+func (f *Fixture) NewPreferences(preferences *dpage.Preferences) {
+	if preferences.UserID() == "" {
+		panic("cannot add preferences without user")
+	}
+	for _, p := range preferences.Items() {
+		f.pagePreferences = append(f.pagePreferences, pagePreferenceRow{
+			userKey:  preferences.UserID(),
+			pageKey:  p.PageID(),
+			pinned:   p.Pinned(),
+			position: p.Position(),
+			inbox:    p.Inbox(),
+		})
+	}
+}
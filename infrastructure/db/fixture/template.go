@@ -0,0 +1,92 @@
+package fixture
+
+import (
+	guuid "github.com/google/uuid"
+
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	"github.com/naka-sei/tsudzuri/pkg/ptr"
+	"github.com/naka-sei/tsudzuri/pkg/uuid"
+)
+
+type templateRow struct {
+	id         guuid.UUID
+	title      string
+	creatorID  guuid.UUID
+	visibility string
+}
+
+type templateLinkRow struct {
+	templateID guuid.UUID
+	url        string
+	memo       *string
+	priority   int
+}
+
+type templateUserRow struct {
+	templateID guuid.UUID
+	userKey    string
+}
+
+// NewTemplate prepares a template row based on the domain model. Call Setup to insert.
+// The template title is registered as an alias for its generated ID.
+func (f *Fixture) NewTemplate(template *dtemplate.Template) {
+	var templateID guuid.UUID
+	if template.ID() == "" {
+		templateID = uuid.NewV7()
+	} else {
+		parsed, err := guuid.Parse(template.ID())
+		if err != nil {
+			panic("invalid template ID: " + template.ID())
+		}
+		templateID = parsed
+	}
+	title := template.Title()
+	if title == "" {
+		panic("cannot add template with empty title")
+	}
+	var creatorID guuid.UUID
+	if template.CreatedBy().ID() == "" {
+		uid := template.CreatedBy().UID()
+		mappedID, ok := f.idMap[uid]
+		if !ok {
+			panic("cannot add template for user not in fixture: " + uid)
+		}
+		creatorID = mappedID
+	} else {
+		parsed, err := guuid.Parse(template.CreatedBy().ID())
+		if err != nil {
+			panic("invalid creator ID: " + template.CreatedBy().ID())
+		}
+		creatorID = parsed
+	}
+	f.templates = append(f.templates, templateRow{
+		id:         templateID,
+		title:      title,
+		creatorID:  creatorID,
+		visibility: string(template.Visibility()),
+	})
+	f.idMap[title] = templateID
+
+	for i, l := range template.Links() {
+		if l.URL() == "" {
+			panic("cannot add template link with empty URL")
+		}
+		f.templateLinks = append(f.templateLinks, templateLinkRow{
+			templateID: templateID,
+			url:        l.URL(),
+			memo:       ptr.Ptr(l.Memo()),
+			priority:   i + 1,
+		})
+	}
+
+	for _, u := range template.SharedUsers() {
+		if u == nil {
+			panic("cannot add nil shared user")
+		}
+		key := u.ID()
+		if key == "" {
+			key = u.UID()
+		}
+		f.templateUsers = append(f.templateUsers, templateUserRow{templateID: templateID, userKey: key})
+	}
+}
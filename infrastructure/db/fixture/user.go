@@ -12,6 +12,7 @@ type userRow struct {
 	uid      string
 	provider string
 	email    *string
+	profile  duser.Profile
 }
 
 // NewUser prepares a user row based on the domain model. Call Setup to insert.
@@ -40,6 +41,7 @@ func (f *Fixture) NewUser(user *duser.User) {
 		uid:      uid,
 		provider: string(provider),
 		email:    user.Email(),
+		profile:  user.Profile(),
 	}
 	f.users = append(f.users, u)
 
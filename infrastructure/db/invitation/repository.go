@@ -0,0 +1,177 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entinvitation "github.com/naka-sei/tsudzuri/infrastructure/db/ent/invitation"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type invitationRepository struct {
+	conn *postgres.Connection
+}
+
+func NewInvitationRepository(conn *postgres.Connection) dinvitation.InvitationRepository {
+	return &invitationRepository{conn: conn}
+}
+
+// Get fetches an invitation by ID. Returns (nil, nil) if not found or id empty.
+func (r *invitationRepository) Get(ctx context.Context, id string) (*dinvitation.Invitation, error) {
+	if id == "" {
+		return nil, nil
+	}
+	iid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invitation id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.Invitation.Get(ctx, iid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// GetByTokenHash fetches the invitation issued with the token of the given hash. Returns (nil, nil) if not found.
+func (r *invitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*dinvitation.Invitation, error) {
+	if tokenHash == "" {
+		return nil, nil
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.Invitation.Query().
+		Where(entinvitation.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// ListByPage returns the invitations of the page, newest first.
+func (r *invitationRepository) ListByPage(ctx context.Context, pageID string) ([]*dinvitation.Invitation, error) {
+	pid, err := uuid.Parse(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	list, err := client.Invitation.Query().
+		Where(entinvitation.PageIDEQ(pid)).
+		Order(ent.Desc(entinvitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*dinvitation.Invitation, 0, len(list))
+	for _, i := range list {
+		invitations = append(invitations, entToDomain(i))
+	}
+	return invitations, nil
+}
+
+// Save inserts a new invitation or updates the state of an existing one.
+func (r *invitationRepository) Save(ctx context.Context, i *dinvitation.Invitation) (*dinvitation.Invitation, error) {
+	if i == nil {
+		return nil, errors.New("nil invitation")
+	}
+	client := r.conn.WriteDB(ctx)
+
+	var acceptedBy *uuid.UUID
+	if i.AcceptedByID() != "" {
+		id, err := uuid.Parse(i.AcceptedByID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid accepted by id: %w", err)
+		}
+		acceptedBy = &id
+	}
+
+	if i.ID() == "" { // create
+		pageUUID, err := uuid.Parse(i.PageID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid page id: %w", err)
+		}
+		inviterUUID, err := uuid.Parse(i.InvitedByID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid inviter id: %w", err)
+		}
+		created, err := client.Invitation.Create().
+			SetPageID(pageUUID).
+			SetInvitedByID(inviterUUID).
+			SetEmail(i.Email()).
+			SetTokenHash(i.TokenHash()).
+			SetStatus(entinvitation.Status(i.StoredStatus())).
+			SetNillableAcceptedByID(acceptedBy).
+			SetExpiresAt(i.ExpiresAt()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return entToDomain(created), nil
+	}
+
+	iid, err := uuid.Parse(i.ID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid invitation id: %w", err)
+	}
+	// An invitation only ever leaves the pending state, so the update is conditional on it still being pending.
+	// This keeps a link single-use when two requests accept (or revoke) it at the same time.
+	updated, err := client.Invitation.UpdateOneID(iid).
+		Where(entinvitation.StatusEQ(entinvitation.StatusPending)).
+		SetStatus(entinvitation.Status(i.StoredStatus())).
+		SetNillableAcceptedByID(acceptedBy).
+		SetExpiresAt(i.ExpiresAt()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, r.notPendingError(ctx, client, iid)
+		}
+		return nil, err
+	}
+	return entToDomain(updated), nil
+}
+
+// notPendingError reports why a conditional update of the invitation matched no row.
+func (r *invitationRepository) notPendingError(ctx context.Context, client *ent.Client, iid uuid.UUID) error {
+	found, err := client.Invitation.Get(ctx, iid)
+	if err != nil {
+		return err
+	}
+	if found.Status == entinvitation.StatusRevoked {
+		return dinvitation.ErrInvitationRevoked
+	}
+	return dinvitation.ErrInvitationAlreadyAccepted
+}
+
+func entToDomain(i *ent.Invitation) *dinvitation.Invitation {
+	opts := []dinvitation.ReconstructOption{
+		dinvitation.WithTimestamps(i.CreatedAt, i.UpdatedAt),
+	}
+	if i.AcceptedByID != nil {
+		opts = append(opts, dinvitation.WithAcceptedByID(i.AcceptedByID.String()))
+	}
+	return dinvitation.ReconstructInvitation(
+		i.ID.String(),
+		i.PageID.String(),
+		i.Email,
+		i.InvitedByID.String(),
+		i.TokenHash,
+		dinvitation.Status(i.Status),
+		i.ExpiresAt,
+		opts...,
+	)
+}
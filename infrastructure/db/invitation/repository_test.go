@@ -0,0 +1,246 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+var expiresAt = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func invitationCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(dinvitation.Invitation{}),
+		// Timestamps are generated by the database.
+		cmpopts.IgnoreFields(dinvitation.Invitation{}, "createdAt", "updatedAt"),
+		cmpopts.EquateApproxTime(0),
+	}
+}
+
+// newPage registers a creator and a page owned by them.
+func newPage(fx *fixture.Fixture, uid string, title string) {
+	user := duser.ReconstructUser("", uid, string(duser.ProviderGoogle), nil)
+	fx.NewUser(user)
+	fx.NewPage(dpage.ReconstructPage("", title, *user, "INVITE01", nil, nil))
+}
+
+func TestInvitationRepository_GetByTokenHash(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		hash    string
+		want    func(*fixture.Fixture) *dinvitation.Invitation
+	}{
+		{
+			name: "success",
+			prepare: func(fx *fixture.Fixture) {
+				newPage(fx, "invitation-get-user", "invitation-get-page")
+				fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-get-page", "friend@example.com", "invitation-get-user",
+					"invitation-get-hash", dinvitation.StatusPending, expiresAt))
+			},
+			hash: "invitation-get-hash",
+			want: func(fx *fixture.Fixture) *dinvitation.Invitation {
+				return dinvitation.ReconstructInvitation(fx.ID("invitation-get-hash"), fx.ID("invitation-get-page"), "friend@example.com",
+					fx.ID("invitation-get-user"), "invitation-get-hash", dinvitation.StatusPending, expiresAt)
+			},
+		},
+		{
+			name: "not_found",
+			hash: "invitation-unknown-hash",
+			want: func(fx *fixture.Fixture) *dinvitation.Invitation { return nil },
+		},
+		{
+			name: "empty_hash",
+			want: func(fx *fixture.Fixture) *dinvitation.Invitation { return nil },
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			repo := NewInvitationRepository(conn)
+			got, err := repo.GetByTokenHash(ctx, tt.hash)
+			if err != nil {
+				t.Fatalf("GetByTokenHash() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want(fx), got, invitationCmpOpts()...); diff != "" {
+				t.Fatalf("invitation mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInvitationRepository_ListByPage(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	newPage(fx, "invitation-list-user", "invitation-list-page")
+	newPage(fx, "invitation-list-other-user", "invitation-list-other-page")
+	fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-list-page", "a@example.com", "invitation-list-user",
+		"invitation-list-hash-1", dinvitation.StatusRevoked, expiresAt))
+	fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-list-page", "b@example.com", "invitation-list-user",
+		"invitation-list-hash-2", dinvitation.StatusPending, expiresAt))
+	fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-list-other-page", "c@example.com", "invitation-list-other-user",
+		"invitation-list-hash-3", dinvitation.StatusPending, expiresAt))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewInvitationRepository(conn)
+	got, err := repo.ListByPage(ctx, fx.ID("invitation-list-page"))
+	if err != nil {
+		t.Fatalf("ListByPage() error = %v", err)
+	}
+	want := []*dinvitation.Invitation{
+		dinvitation.ReconstructInvitation(fx.ID("invitation-list-hash-2"), fx.ID("invitation-list-page"), "b@example.com",
+			fx.ID("invitation-list-user"), "invitation-list-hash-2", dinvitation.StatusPending, expiresAt),
+		dinvitation.ReconstructInvitation(fx.ID("invitation-list-hash-1"), fx.ID("invitation-list-page"), "a@example.com",
+			fx.ID("invitation-list-user"), "invitation-list-hash-1", dinvitation.StatusRevoked, expiresAt),
+	}
+	if diff := cmp.Diff(want, got, invitationCmpOpts()...); diff != "" {
+		t.Fatalf("invitations mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestInvitationRepository_Save(t *testing.T) {
+	type want struct {
+		invitation *dinvitation.Invitation
+		err        error
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		args    func(*fixture.Fixture) *dinvitation.Invitation
+		want    func(*fixture.Fixture) want
+	}{
+		{
+			name: "create",
+			prepare: func(fx *fixture.Fixture) {
+				newPage(fx, "invitation-create-user", "invitation-create-page")
+			},
+			args: func(fx *fixture.Fixture) *dinvitation.Invitation {
+				return dinvitation.ReconstructInvitation("", fx.ID("invitation-create-page"), "friend@example.com",
+					fx.ID("invitation-create-user"), "invitation-create-hash", dinvitation.StatusPending, expiresAt)
+			},
+			want: func(fx *fixture.Fixture) want {
+				return want{invitation: dinvitation.ReconstructInvitation("", fx.ID("invitation-create-page"), "friend@example.com",
+					fx.ID("invitation-create-user"), "invitation-create-hash", dinvitation.StatusPending, expiresAt)}
+			},
+		},
+		{
+			name: "accept",
+			prepare: func(fx *fixture.Fixture) {
+				newPage(fx, "invitation-accept-user", "invitation-accept-page")
+				fx.NewUser(duser.ReconstructUser("", "invitation-accept-invitee", string(duser.ProviderAnonymous), nil))
+				fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-accept-page", "friend@example.com", "invitation-accept-user",
+					"invitation-accept-hash", dinvitation.StatusPending, expiresAt))
+			},
+			args: func(fx *fixture.Fixture) *dinvitation.Invitation {
+				return dinvitation.ReconstructInvitation(fx.ID("invitation-accept-hash"), fx.ID("invitation-accept-page"), "friend@example.com",
+					fx.ID("invitation-accept-user"), "invitation-accept-hash", dinvitation.StatusAccepted, expiresAt,
+					dinvitation.WithAcceptedByID(fx.ID("invitation-accept-invitee")))
+			},
+			want: func(fx *fixture.Fixture) want {
+				return want{invitation: dinvitation.ReconstructInvitation(fx.ID("invitation-accept-hash"), fx.ID("invitation-accept-page"), "friend@example.com",
+					fx.ID("invitation-accept-user"), "invitation-accept-hash", dinvitation.StatusAccepted, expiresAt,
+					dinvitation.WithAcceptedByID(fx.ID("invitation-accept-invitee")))}
+			},
+		},
+		{
+			name: "accept_already_accepted",
+			prepare: func(fx *fixture.Fixture) {
+				newPage(fx, "invitation-reaccept-user", "invitation-reaccept-page")
+				fx.NewUser(duser.ReconstructUser("", "invitation-reaccept-first", string(duser.ProviderAnonymous), nil))
+				fx.NewUser(duser.ReconstructUser("", "invitation-reaccept-second", string(duser.ProviderAnonymous), nil))
+				fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-reaccept-page", "friend@example.com", "invitation-reaccept-user",
+					"invitation-reaccept-hash", dinvitation.StatusAccepted, expiresAt,
+					dinvitation.WithAcceptedByID("invitation-reaccept-first")))
+			},
+			// A second request that read the invitation while it was still pending must not accept it again.
+			args: func(fx *fixture.Fixture) *dinvitation.Invitation {
+				return dinvitation.ReconstructInvitation(fx.ID("invitation-reaccept-hash"), fx.ID("invitation-reaccept-page"), "friend@example.com",
+					fx.ID("invitation-reaccept-user"), "invitation-reaccept-hash", dinvitation.StatusAccepted, expiresAt,
+					dinvitation.WithAcceptedByID(fx.ID("invitation-reaccept-second")))
+			},
+			want: func(fx *fixture.Fixture) want { return want{err: dinvitation.ErrInvitationAlreadyAccepted} },
+		},
+		{
+			name: "revoke_already_revoked",
+			prepare: func(fx *fixture.Fixture) {
+				newPage(fx, "invitation-rerevoke-user", "invitation-rerevoke-page")
+				fx.NewInvitation(dinvitation.ReconstructInvitation("", "invitation-rerevoke-page", "friend@example.com", "invitation-rerevoke-user",
+					"invitation-rerevoke-hash", dinvitation.StatusRevoked, expiresAt))
+			},
+			args: func(fx *fixture.Fixture) *dinvitation.Invitation {
+				return dinvitation.ReconstructInvitation(fx.ID("invitation-rerevoke-hash"), fx.ID("invitation-rerevoke-page"), "friend@example.com",
+					fx.ID("invitation-rerevoke-user"), "invitation-rerevoke-hash", dinvitation.StatusRevoked, expiresAt)
+			},
+			want: func(fx *fixture.Fixture) want { return want{err: dinvitation.ErrInvitationRevoked} },
+		},
+		{
+			name: "nil_invitation",
+			args: func(fx *fixture.Fixture) *dinvitation.Invitation { return nil },
+			want: func(fx *fixture.Fixture) want { return want{err: errors.New("nil invitation")} },
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			repo := NewInvitationRepository(conn)
+			w := tt.want(fx)
+
+			res, err := repo.Save(ctx, tt.args(fx))
+			testutil.EqualErr(t, w.err, err)
+			if w.err != nil {
+				return
+			}
+
+			got, err := repo.Get(ctx, res.ID())
+			if err != nil {
+				t.Fatalf("failed to get saved invitation: %v", err)
+			}
+			cmpOpts := invitationCmpOpts()
+			if w.invitation.ID() == "" {
+				cmpOpts = append(cmpOpts, cmpopts.IgnoreFields(dinvitation.Invitation{}, "id"))
+			}
+			if diff := cmp.Diff(w.invitation, got, cmpOpts...); diff != "" {
+				t.Fatalf("saved invitation mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
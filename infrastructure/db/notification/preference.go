@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entnotificationpreference "github.com/naka-sei/tsudzuri/infrastructure/db/ent/notificationpreference"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type preferenceRepository struct {
+	conn *postgres.Connection
+}
+
+func NewPreferenceRepository(conn *postgres.Connection) dnotification.PreferenceRepository {
+	return &preferenceRepository{conn: conn}
+}
+
+// Get returns the notification preferences of the user.
+func (r *preferenceRepository) Get(ctx context.Context, userID string) (*dnotification.Preferences, error) {
+	list, err := r.ListByUserIDs(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// ListByUserIDs returns the notification preferences of the users in the given order.
+func (r *preferenceRepository) ListByUserIDs(ctx context.Context, userIDs []string) ([]*dnotification.Preferences, error) {
+	uids := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %w", err)
+		}
+		uids = append(uids, uid)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.NotificationPreference.Query().
+		Where(entnotificationpreference.UserIDIn(uids...)).
+		Order(ent.Asc(entnotificationpreference.FieldKind)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	muted := make(map[string][]dnotification.Kind, len(userIDs))
+	for _, row := range rows {
+		key := row.UserID.String()
+		muted[key] = append(muted[key], dnotification.Kind(row.Kind))
+	}
+
+	preferences := make([]*dnotification.Preferences, 0, len(uids))
+	for _, uid := range uids {
+		preferences = append(preferences, dnotification.ReconstructPreferences(uid.String(), muted[uid.String()]))
+	}
+	return preferences, nil
+}
+
+// Save makes the stored muted kinds of the user match the given preferences.
+func (r *preferenceRepository) Save(ctx context.Context, preferences *dnotification.Preferences) error {
+	if preferences == nil {
+		return errors.New("nil preferences")
+	}
+	uid, err := uuid.Parse(preferences.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	muted := preferences.Muted()
+	kinds := make([]string, 0, len(muted))
+	for _, k := range muted {
+		kinds = append(kinds, string(k))
+	}
+
+	client := r.conn.WriteDB(ctx)
+	if _, err := client.NotificationPreference.Delete().
+		Where(
+			entnotificationpreference.UserIDEQ(uid),
+			entnotificationpreference.KindNotIn(kinds...),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	stored, err := client.NotificationPreference.Query().
+		Where(entnotificationpreference.UserIDEQ(uid)).
+		Select(entnotificationpreference.FieldKind).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	var bulk []*ent.NotificationPreferenceCreate
+	for _, k := range kinds {
+		if slices.Contains(stored, k) {
+			continue
+		}
+		bulk = append(bulk, client.NotificationPreference.Create().
+			SetUserID(uid).
+			SetKind(k))
+	}
+	if len(bulk) == 0 {
+		return nil
+	}
+	return client.NotificationPreference.CreateBulk(bulk...).Exec(ctx)
+}
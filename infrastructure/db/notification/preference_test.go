@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+func TestPreferenceRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	fx.NewUser(duser.ReconstructUser("", "notification-pref-user", string(duser.ProviderGoogle), nil))
+	fx.NewUser(duser.ReconstructUser("", "notification-pref-other", string(duser.ProviderGoogle), nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+	userID := fx.ID("notification-pref-user")
+	otherID := fx.ID("notification-pref-other")
+
+	repo := NewPreferenceRepository(conn)
+	steps := []struct {
+		name  string
+		muted []dnotification.Kind
+	}{
+		{name: "mute", muted: []dnotification.Kind{dnotification.KindLinksAdded, dnotification.KindPageArchived}},
+		{name: "change", muted: []dnotification.Kind{dnotification.KindMemberJoined, dnotification.KindLinksAdded}},
+		{name: "reset", muted: []dnotification.Kind{}},
+	}
+	for _, step := range steps {
+		if err := repo.Save(ctx, dnotification.ReconstructPreferences(userID, step.muted)); err != nil {
+			t.Fatalf("%s: Save() error = %v", step.name, err)
+		}
+		got, err := repo.ListByUserIDs(ctx, []string{otherID, userID})
+		if err != nil {
+			t.Fatalf("%s: ListByUserIDs() error = %v", step.name, err)
+		}
+		if len(got) != 2 || got[0].UserID() != otherID || got[1].UserID() != userID {
+			t.Fatalf("%s: ListByUserIDs() returned users out of order", step.name)
+		}
+		if diff := cmp.Diff([]dnotification.Kind{}, got[0].Muted()); diff != "" {
+			t.Fatalf("%s: other user's muted kinds mismatch (-want +got):\n%s", step.name, diff)
+		}
+		if diff := cmp.Diff(step.muted, got[1].Muted()); diff != "" {
+			t.Fatalf("%s: muted kinds mismatch (-want +got):\n%s", step.name, diff)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entnotification "github.com/naka-sei/tsudzuri/infrastructure/db/ent/notification"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type notificationRepository struct {
+	conn *postgres.Connection
+}
+
+func NewNotificationRepository(conn *postgres.Connection) dnotification.NotificationRepository {
+	return &notificationRepository{conn: conn}
+}
+
+// List returns the notifications of the recipient, newest first.
+func (r *notificationRepository) List(ctx context.Context, recipientID string, options ...dnotification.SearchOption) ([]*dnotification.Notification, error) {
+	rid, err := uuid.Parse(recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient id: %w", err)
+	}
+
+	params := &dnotification.SearchParams{}
+	for _, opt := range options {
+		opt.Apply(params)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	q := client.Notification.Query().
+		Where(entnotification.RecipientIDEQ(rid))
+	if params.UnreadOnly {
+		q = q.Where(entnotification.ReadAtIsNil())
+	}
+
+	// IDs are UUIDv7, so they sort by creation time.
+	q = q.Order(ent.Desc(entnotification.FieldID))
+	page := postgres.PtrInt32ToInt(params.Page)
+	pageSize := postgres.PtrInt32ToInt(params.PageSize)
+	if pageSize > 0 {
+		if page <= 0 {
+			page = 1
+		}
+		q = q.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	list, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*dnotification.Notification, 0, len(list))
+	for _, n := range list {
+		notifications = append(notifications, entToDomain(n))
+	}
+	return notifications, nil
+}
+
+// CountUnread returns the number of unread notifications of the recipient.
+func (r *notificationRepository) CountUnread(ctx context.Context, recipientID string) (int, error) {
+	rid, err := uuid.Parse(recipientID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid recipient id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	return client.Notification.Query().
+		Where(
+			entnotification.RecipientIDEQ(rid),
+			entnotification.ReadAtIsNil(),
+		).
+		Count(ctx)
+}
+
+// Create stores new notifications in one batch.
+func (r *notificationRepository) Create(ctx context.Context, notifications []*dnotification.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	client := r.conn.WriteDB(ctx)
+	bulk := make([]*ent.NotificationCreate, 0, len(notifications))
+	for _, n := range notifications {
+		rid, err := uuid.Parse(n.RecipientID())
+		if err != nil {
+			return fmt.Errorf("invalid recipient id: %w", err)
+		}
+		pid, err := uuid.Parse(n.PageID())
+		if err != nil {
+			return fmt.Errorf("invalid page id: %w", err)
+		}
+		aid, err := uuid.Parse(n.ActorID())
+		if err != nil {
+			return fmt.Errorf("invalid actor id: %w", err)
+		}
+
+		create := client.Notification.Create().
+			SetRecipientID(rid).
+			SetPageID(pid).
+			SetActorID(aid).
+			SetKind(string(n.Kind())).
+			SetCount(n.Count()).
+			SetNillableReadAt(n.ReadAt())
+		if !n.CreatedAt().IsZero() {
+			create = create.SetCreatedAt(n.CreatedAt()).SetUpdatedAt(n.CreatedAt())
+		}
+		bulk = append(bulk, create)
+	}
+	return client.Notification.CreateBulk(bulk...).Exec(ctx)
+}
+
+// MarkRead marks the unread notifications of the recipient as read. An empty ids marks all of them.
+func (r *notificationRepository) MarkRead(ctx context.Context, recipientID string, ids []string, readAt time.Time) error {
+	rid, err := uuid.Parse(recipientID)
+	if err != nil {
+		return fmt.Errorf("invalid recipient id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	update := client.Notification.Update().
+		Where(
+			entnotification.RecipientIDEQ(rid),
+			entnotification.ReadAtIsNil(),
+		)
+	if len(ids) > 0 {
+		nids := make([]uuid.UUID, 0, len(ids))
+		for _, id := range ids {
+			nid, err := uuid.Parse(id)
+			if err != nil {
+				return fmt.Errorf("invalid notification id: %w", err)
+			}
+			nids = append(nids, nid)
+		}
+		update = update.Where(entnotification.IDIn(nids...))
+	}
+
+	_, err = update.
+		SetReadAt(readAt).
+		SetUpdatedAt(readAt).
+		Save(ctx)
+	return err
+}
+
+func entToDomain(n *ent.Notification) *dnotification.Notification {
+	return dnotification.ReconstructNotification(
+		n.ID.String(),
+		n.RecipientID.String(),
+		n.ActorID.String(),
+		n.PageID.String(),
+		dnotification.Kind(n.Kind),
+		n.Count,
+		n.ReadAt,
+		n.CreatedAt,
+	)
+}
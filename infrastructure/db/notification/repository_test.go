@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+var createdAt = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func notificationCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(dnotification.Notification{}),
+		// IDs are generated by the repository.
+		cmpopts.IgnoreFields(dnotification.Notification{}, "id"),
+		cmpopts.EquateApproxTime(0),
+	}
+}
+
+// newPageWithMember registers a creator, a member and a page owned by the creator.
+func newPageWithMember(fx *fixture.Fixture, prefix string) {
+	creator := duser.ReconstructUser("", prefix+"-creator", string(duser.ProviderGoogle), nil)
+	member := duser.ReconstructUser("", prefix+"-member", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewUser(member)
+	fx.NewPage(dpage.ReconstructPage("", prefix+"-page", *creator, "INVITE01", nil, nil))
+}
+
+func TestNotificationRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	newPageWithMember(fx, "notification-repo")
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	creatorID := fx.ID("notification-repo-creator")
+	memberID := fx.ID("notification-repo-member")
+	pageID := fx.ID("notification-repo-page")
+	newNotification := func(recipientID string, kind dnotification.Kind, count int, readAt *time.Time) *dnotification.Notification {
+		return dnotification.ReconstructNotification("", recipientID, memberID, pageID, kind, count, readAt, createdAt)
+	}
+
+	repo := NewNotificationRepository(conn)
+	if err := repo.Create(ctx, []*dnotification.Notification{
+		newNotification(creatorID, dnotification.KindMemberJoined, 1, nil),
+	}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := repo.Create(ctx, []*dnotification.Notification{
+		newNotification(creatorID, dnotification.KindLinksAdded, 3, nil),
+	}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := repo.List(ctx, creatorID)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []*dnotification.Notification{
+		newNotification(creatorID, dnotification.KindLinksAdded, 3, nil),
+		newNotification(creatorID, dnotification.KindMemberJoined, 1, nil),
+	}
+	if diff := cmp.Diff(want, got, notificationCmpOpts()...); diff != "" {
+		t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+	}
+
+	count, err := repo.CountUnread(ctx, creatorID)
+	if err != nil {
+		t.Fatalf("CountUnread() error = %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("CountUnread() = %d, want 2", count)
+	}
+
+	// Marking by the other user's ID must not touch the creator's inbox.
+	readAt := createdAt.Add(time.Hour)
+	if err := repo.MarkRead(ctx, memberID, []string{got[1].ID()}, readAt); err != nil {
+		t.Fatalf("MarkRead() error = %v", err)
+	}
+	if err := repo.MarkRead(ctx, creatorID, []string{got[1].ID()}, readAt); err != nil {
+		t.Fatalf("MarkRead() error = %v", err)
+	}
+
+	unread, err := repo.List(ctx, creatorID, dnotification.WithUnreadOnly(true))
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if diff := cmp.Diff(want[:1], unread, notificationCmpOpts()...); diff != "" {
+		t.Fatalf("List(unread) mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := repo.MarkRead(ctx, creatorID, nil, readAt); err != nil {
+		t.Fatalf("MarkRead() error = %v", err)
+	}
+	count, err = repo.CountUnread(ctx, creatorID)
+	if err != nil {
+		t.Fatalf("CountUnread() error = %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("CountUnread() = %d, want 0", count)
+	}
+
+	paged, err := repo.List(ctx, creatorID, dnotification.WithPageSearchOption(2), dnotification.WithPageSizeSearchOption(1))
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	wantPaged := []*dnotification.Notification{
+		newNotification(creatorID, dnotification.KindMemberJoined, 1, &readAt),
+	}
+	if diff := cmp.Diff(wantPaged, paged, notificationCmpOpts()...); diff != "" {
+		t.Fatalf("List(paged) mismatch (-want +got):\n%s", diff)
+	}
+}
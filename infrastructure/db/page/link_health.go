@@ -0,0 +1,67 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinkitem "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type linkHealthRepository struct {
+	conn *postgres.Connection
+}
+
+func NewLinkHealthRepository(conn *postgres.Connection) dpage.LinkHealthRepository {
+	return &linkHealthRepository{conn: conn}
+}
+
+// ListDue returns the link items never checked or last checked before checkedBefore, unchecked items first
+// and then the least recently checked ones.
+func (r *linkHealthRepository) ListDue(ctx context.Context, checkedBefore time.Time, limit int) (dpage.Links, error) {
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.LinkItem.Query().
+		Where(entlinkitem.Or(
+			entlinkitem.CheckedAtIsNil(),
+			entlinkitem.CheckedAtLT(checkedBefore),
+		)).
+		Order(entlinkitem.ByCheckedAt(sql.OrderNullsFirst()), ent.Asc(entlinkitem.FieldID)).
+		Limit(limit).
+		Select(entlinkitem.FieldID, entlinkitem.FieldURL).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(dpage.Links, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, dpage.ReconstructLink(row.URL, "", 0, dpage.WithLinkID(row.ID.String())))
+	}
+	return links, nil
+}
+
+// Save stores the outcome of the check on the link item if it still has the checked URL.
+func (r *linkHealthRepository) Save(ctx context.Context, link dpage.Link, health dpage.LinkHealth) error {
+	id, err := uuid.Parse(link.ID())
+	if err != nil {
+		return fmt.Errorf("invalid link id: %w", err)
+	}
+
+	update := r.conn.WriteDB(ctx).LinkItem.Update().
+		Where(entlinkitem.IDEQ(id), entlinkitem.URLEQ(link.URL())).
+		SetCheckStatus(health.StatusCode()).
+		SetCheckedAt(health.CheckedAt())
+	if u := health.RedirectURL(); u != "" {
+		update = update.SetRedirectURL(u)
+	} else {
+		update = update.ClearRedirectURL()
+	}
+	_, err = update.Save(ctx)
+	return err
+}
@@ -0,0 +1,93 @@
+package page
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+func TestLinkHealthRepository(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	linkRedirected := uuid.NewString()
+	linkMoved := uuid.NewString()
+	creator := duser.ReconstructUser("", "link-health-creator", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "link-health-page", *creator, "INVHEALTH", dpage.Links{
+		dpage.ReconstructLink("https://example.com/old", "", 1, dpage.WithLinkID(linkRedirected)),
+		dpage.ReconstructLink("https://example.com/moved", "", 2, dpage.WithLinkID(linkMoved)),
+	}, nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewLinkHealthRepository(conn)
+	checkedAt := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	due, err := repo.ListDue(ctx, checkedAt, 1000)
+	if err != nil {
+		t.Fatalf("failed to list due links: %v", err)
+	}
+	for _, id := range []string{linkRedirected, linkMoved} {
+		if !slices.ContainsFunc(due, func(l dpage.Link) bool { return l.ID() == id }) {
+			t.Fatalf("unchecked link %s is not due", id)
+		}
+	}
+
+	redirected := dpage.NewLinkHealth(301, "https://example.com/new", checkedAt)
+	if err := repo.Save(ctx, dpage.ReconstructLink("https://example.com/old", "", 0, dpage.WithLinkID(linkRedirected)), redirected); err != nil {
+		t.Fatalf("failed to save link health: %v", err)
+	}
+	// The URL changed since the link was listed, so the outcome is dropped.
+	if err := repo.Save(ctx, dpage.ReconstructLink("https://example.com/before", "", 0, dpage.WithLinkID(linkMoved)), dpage.NewLinkHealth(404, "", checkedAt)); err != nil {
+		t.Fatalf("failed to save link health: %v", err)
+	}
+
+	page, err := NewPageRepository(conn).Get(ctx, fx.ID("link-health-page"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	got := []dpage.LinkHealthStatus{page.Links()[0].Health().Status(), page.Links()[1].Health().Status()}
+	if diff := cmp.Diff([]dpage.LinkHealthStatus{dpage.LinkHealthRedirected, dpage.LinkHealthUnchecked}, got); diff != "" {
+		t.Fatalf("health mismatch (-want +got):\n%s", diff)
+	}
+	if got := page.Links()[0].Health().RedirectURL(); got != "https://example.com/new" {
+		t.Fatalf("redirect URL = %q", got)
+	}
+
+	due, err = repo.ListDue(ctx, checkedAt, 1000)
+	if err != nil {
+		t.Fatalf("failed to list due links: %v", err)
+	}
+	if slices.ContainsFunc(due, func(l dpage.Link) bool { return l.ID() == linkRedirected }) {
+		t.Fatalf("link checked at %v is due before it", checkedAt)
+	}
+
+	// Accepting the redirect changes the URL and clears the outcome.
+	if _, err := page.AcceptLinkRedirect(page.CreatedBy(), linkRedirected); err != nil {
+		t.Fatalf("failed to accept redirect: %v", err)
+	}
+	if _, err := NewPageRepository(conn).Save(ctx, page); err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+	page, err = NewPageRepository(conn).Get(ctx, fx.ID("link-health-page"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	accepted := page.Links()[0]
+	if accepted.URL() != "https://example.com/new" || accepted.Health().Status() != dpage.LinkHealthUnchecked {
+		t.Fatalf("accepted link = %s %q, want https://example.com/new unchecked", accepted.URL(), accepted.Health().Status())
+	}
+}
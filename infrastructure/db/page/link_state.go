@@ -0,0 +1,84 @@
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinkstate "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type linkStateRepository struct {
+	conn *postgres.Connection
+}
+
+func NewLinkStateRepository(conn *postgres.Connection) dpage.LinkStateRepository {
+	return &linkStateRepository{conn: conn}
+}
+
+// Save sets the user's status on the link items, inserting rows for items the user has no state on yet.
+func (r *linkStateRepository) Save(ctx context.Context, linkIDs []string, state dpage.LinkState) error {
+	if len(linkIDs) == 0 {
+		return nil
+	}
+	uid, err := uuid.Parse(state.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	lids := make([]uuid.UUID, 0, len(linkIDs))
+	for _, id := range linkIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("invalid link id: %w", err)
+		}
+		lids = append(lids, parsed)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	status := entlinkstate.Status(state.Status())
+	existing, err := client.LinkState.Query().
+		Where(entlinkstate.UserIDEQ(uid), entlinkstate.LinkItemIDIn(lids...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[uuid.UUID]struct{}, len(existing))
+	changed := make([]uuid.UUID, 0, len(existing))
+	for _, ls := range existing {
+		stored[ls.LinkItemID] = struct{}{}
+		if ls.Status != status {
+			changed = append(changed, ls.ID)
+		}
+	}
+	if len(changed) > 0 {
+		if err := client.LinkState.Update().
+			Where(entlinkstate.IDIn(changed...)).
+			SetStatus(status).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	var bulk []*ent.LinkStateCreate
+	for _, lid := range lids {
+		if _, ok := stored[lid]; ok {
+			continue
+		}
+		stored[lid] = struct{}{}
+		bulk = append(bulk, client.LinkState.Create().
+			SetLinkItemID(lid).
+			SetUserID(uid).
+			SetStatus(status))
+	}
+	if len(bulk) > 0 {
+		if _, err := client.LinkState.CreateBulk(bulk...).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+func TestLinkStateRepository_Save(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	linkRead := uuid.NewString()
+	linkDone := uuid.NewString()
+	linkNew := uuid.NewString()
+	creator := duser.ReconstructUser("", "link-state-creator", string(duser.ProviderAnonymous), nil)
+	member := duser.ReconstructUser("", "link-state-member", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewUser(member)
+	fx.NewPage(dpage.ReconstructPage("", "link-state-page", *creator, "INVSTATE", dpage.Links{
+		dpage.ReconstructLink("https://example.com/1", "", 1, dpage.WithLinkID(linkRead), dpage.WithLinkStates(dpage.LinkStates{
+			dpage.ReconstructLinkState("link-state-creator", dpage.LinkStatusRead),
+			dpage.ReconstructLinkState("link-state-member", dpage.LinkStatusRead),
+		})),
+		dpage.ReconstructLink("https://example.com/2", "", 2, dpage.WithLinkID(linkDone), dpage.WithLinkStates(dpage.LinkStates{
+			dpage.ReconstructLinkState("link-state-member", dpage.LinkStatusDone),
+		})),
+		dpage.ReconstructLink("https://example.com/3", "", 3, dpage.WithLinkID(linkNew)),
+	}, duser.Users{member}))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewLinkStateRepository(conn)
+	state := dpage.ReconstructLinkState(fx.ID("link-state-member"), dpage.LinkStatusDone)
+	if err := repo.Save(ctx, []string{linkRead, linkDone, linkNew}, state); err != nil {
+		t.Fatalf("failed to save link states: %v", err)
+	}
+
+	got, err := NewPageRepository(conn).Get(ctx, fx.ID("link-state-page"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	creatorID, memberID := fx.ID("link-state-creator"), fx.ID("link-state-member")
+	want := []map[string]dpage.LinkStatus{
+		{creatorID: dpage.LinkStatusRead, memberID: dpage.LinkStatusDone},
+		{creatorID: dpage.LinkStatusUnread, memberID: dpage.LinkStatusDone},
+		{creatorID: dpage.LinkStatusUnread, memberID: dpage.LinkStatusDone},
+	}
+	for i, l := range got.Links() {
+		gotStatuses := map[string]dpage.LinkStatus{
+			creatorID: l.States().Of(creatorID),
+			memberID:  l.States().Of(memberID),
+		}
+		if diff := cmp.Diff(want[i], gotStatuses); diff != "" {
+			t.Fatalf("link %d states mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
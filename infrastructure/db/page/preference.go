@@ -0,0 +1,116 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entpagepreference "github.com/naka-sei/tsudzuri/infrastructure/db/ent/pagepreference"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type preferenceRepository struct {
+	conn *postgres.Connection
+}
+
+func NewPreferenceRepository(conn *postgres.Connection) dpage.PreferenceRepository {
+	return &preferenceRepository{conn: conn}
+}
+
+// Get returns the page preferences of the user, ordered by page ID.
+func (r *preferenceRepository) Get(ctx context.Context, userID string) (*dpage.Preferences, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.PagePreference.Query().
+		Where(entpagepreference.UserIDEQ(uid)).
+		Order(ent.Asc(entpagepreference.FieldPageID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []dpage.Preference
+	for _, row := range rows {
+		items = append(items, dpage.ReconstructPreference(row.PageID.String(), row.Pinned, row.Position, row.Inbox))
+	}
+	return dpage.ReconstructPreferences(userID, items), nil
+}
+
+// Save makes the stored preferences of the user match the given ones. Pages back to the default
+// setting lose their row, changed rows are updated and new ones are inserted.
+func (r *preferenceRepository) Save(ctx context.Context, preferences *dpage.Preferences) error {
+	if preferences == nil {
+		return errors.New("nil preferences")
+	}
+	uid, err := uuid.Parse(preferences.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	existing, err := client.PagePreference.Query().
+		Where(entpagepreference.UserIDEQ(uid)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	stored := make(map[uuid.UUID]*ent.PagePreference, len(existing))
+	for _, row := range existing {
+		stored[row.PageID] = row
+	}
+
+	keep := make([]uuid.UUID, 0, len(preferences.Items()))
+	kept := 0
+	var bulk []*ent.PagePreferenceCreate
+	for _, p := range preferences.Items() {
+		pid, err := uuid.Parse(p.PageID())
+		if err != nil {
+			return fmt.Errorf("invalid page id: %w", err)
+		}
+		keep = append(keep, pid)
+
+		row, ok := stored[pid]
+		if !ok {
+			bulk = append(bulk, client.PagePreference.Create().
+				SetUserID(uid).
+				SetPageID(pid).
+				SetPinned(p.Pinned()).
+				SetPosition(p.Position()).
+				SetInbox(p.Inbox()))
+			continue
+		}
+		kept++
+		if row.Pinned != p.Pinned() || row.Position != p.Position() || row.Inbox != p.Inbox() {
+			if err := client.PagePreference.UpdateOneID(row.ID).
+				SetPinned(p.Pinned()).
+				SetPosition(p.Position()).
+				SetInbox(p.Inbox()).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(existing) > kept {
+		if _, err := client.PagePreference.Delete().
+			Where(entpagepreference.UserIDEQ(uid), entpagepreference.PageIDNotIn(keep...)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(bulk) > 0 {
+		if _, err := client.PagePreference.CreateBulk(bulk...).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+func TestPreferenceRepository_Save(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	user := duser.ReconstructUser("", "preference-user", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(user)
+	for _, title := range []string{"preference-page-1", "preference-page-2", "preference-page-3"} {
+		fx.NewPage(dpage.ReconstructPage("", title, *user, "INV"+title[len(title)-1:], nil, nil))
+	}
+	fx.NewPreferences(dpage.ReconstructPreferences("preference-user", []dpage.Preference{
+		dpage.ReconstructPreference("preference-page-1", true, 0, false),
+		dpage.ReconstructPreference("preference-page-2", false, 1, false),
+	}))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPreferenceRepository(conn)
+	userID := fx.ID("preference-user")
+	page1, page2, page3 := fx.ID("preference-page-1"), fx.ID("preference-page-2"), fx.ID("preference-page-3")
+
+	// page 1 is updated, page 2 is back to the default and page 3 is new.
+	if err := repo.Save(ctx, dpage.ReconstructPreferences(userID, []dpage.Preference{
+		dpage.ReconstructPreference(page1, true, 2, false),
+		dpage.ReconstructPreference(page2, false, 0, false),
+		dpage.ReconstructPreference(page3, false, 1, true),
+	})); err != nil {
+		t.Fatalf("failed to save preferences: %v", err)
+	}
+
+	got, err := repo.Get(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get preferences: %v", err)
+	}
+	want := map[string]dpage.Preference{
+		page1: dpage.ReconstructPreference(page1, true, 2, false),
+		page3: dpage.ReconstructPreference(page3, false, 1, true),
+	}
+	gotItems := make(map[string]dpage.Preference, len(got.Items()))
+	for _, p := range got.Items() {
+		gotItems[p.PageID()] = p
+	}
+	if diff := cmp.Diff(want, gotItems, cmp.AllowUnexported(dpage.Preference{})); diff != "" {
+		t.Fatalf("preferences mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,74 @@
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinkreaction "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type reactionRepository struct {
+	conn *postgres.Connection
+}
+
+func NewReactionRepository(conn *postgres.Connection) dpage.ReactionRepository {
+	return &reactionRepository{conn: conn}
+}
+
+// Save stores the user's reaction to the link item, replacing the previous one.
+func (r *reactionRepository) Save(ctx context.Context, linkID string, reaction dpage.Reaction) error {
+	lid, uid, err := parseReactionKey(linkID, reaction.UserID())
+	if err != nil {
+		return err
+	}
+
+	client := r.conn.WriteDB(ctx)
+	existing, err := client.LinkReaction.Query().
+		Where(entlinkreaction.LinkItemIDEQ(lid), entlinkreaction.UserIDEQ(uid)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if existing != nil {
+		if existing.Emoji == reaction.Emoji() {
+			return nil
+		}
+		return client.LinkReaction.UpdateOneID(existing.ID).SetEmoji(reaction.Emoji()).Exec(ctx)
+	}
+	return client.LinkReaction.Create().
+		SetLinkItemID(lid).
+		SetUserID(uid).
+		SetEmoji(reaction.Emoji()).
+		Exec(ctx)
+}
+
+// Delete removes the user's reaction to the link item, if any.
+func (r *reactionRepository) Delete(ctx context.Context, linkID string, userID string) error {
+	lid, uid, err := parseReactionKey(linkID, userID)
+	if err != nil {
+		return err
+	}
+
+	client := r.conn.WriteDB(ctx)
+	_, err = client.LinkReaction.Delete().
+		Where(entlinkreaction.LinkItemIDEQ(lid), entlinkreaction.UserIDEQ(uid)).
+		Exec(ctx)
+	return err
+}
+
+func parseReactionKey(linkID string, userID string) (uuid.UUID, uuid.UUID, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid link id: %w", err)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	return lid, uid, nil
+}
@@ -0,0 +1,130 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+// newReactionPage registers a creator, a member and a page holding a single link with the given reactions.
+func newReactionPage(fx *fixture.Fixture, prefix string, linkID string, reactions dpage.Reactions) {
+	creator := duser.ReconstructUser("", prefix+"-creator", string(duser.ProviderAnonymous), nil)
+	member := duser.ReconstructUser("", prefix+"-member", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewUser(member)
+	fx.NewPage(dpage.ReconstructPage("", prefix+"-page", *creator, "INVREACT", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID(linkID), dpage.WithLinkReactions(reactions)),
+	}, duser.Users{member}))
+}
+
+func TestReactionRepository_Save(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    string
+		existing  func(prefix string) dpage.Reactions
+		userAlias string
+		emoji     string
+		want      func(fx *fixture.Fixture, prefix string) dpage.Reactions
+	}{
+		{
+			name:      "create",
+			prefix:    "reaction-save-create",
+			existing:  func(string) dpage.Reactions { return nil },
+			userAlias: "-member",
+			emoji:     dpage.UpvoteReaction,
+			want: func(fx *fixture.Fixture, prefix string) dpage.Reactions {
+				return dpage.Reactions{dpage.ReconstructReaction(fx.ID(prefix+"-member"), dpage.UpvoteReaction)}
+			},
+		},
+		{
+			name:   "replace",
+			prefix: "reaction-save-replace",
+			existing: func(prefix string) dpage.Reactions {
+				return dpage.Reactions{
+					dpage.ReconstructReaction(prefix+"-creator", "🎉"),
+					dpage.ReconstructReaction(prefix+"-member", dpage.UpvoteReaction),
+				}
+			},
+			userAlias: "-member",
+			emoji:     "👀",
+			want: func(fx *fixture.Fixture, prefix string) dpage.Reactions {
+				return dpage.Reactions{
+					dpage.ReconstructReaction(fx.ID(prefix+"-creator"), "🎉"),
+					dpage.ReconstructReaction(fx.ID(prefix+"-member"), "👀"),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			linkID := uuid.NewString()
+			newReactionPage(fx, tt.prefix, linkID, tt.existing(tt.prefix))
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			repo := NewReactionRepository(conn)
+			err := repo.Save(ctx, linkID, dpage.ReconstructReaction(fx.ID(tt.prefix+tt.userAlias), tt.emoji))
+			testutil.EqualErr(t, nil, err)
+
+			got, err := NewPageRepository(conn).Get(ctx, fx.ID(tt.prefix+"-page"))
+			if err != nil {
+				t.Fatalf("failed to get page: %v", err)
+			}
+			if diff := cmp.Diff(tt.want(fx, tt.prefix), got.Links()[0].Reactions(),
+				cmp.AllowUnexported(dpage.Reaction{}),
+				cmpopts.SortSlices(func(a, b dpage.Reaction) bool { return a.UserID() < b.UserID() }),
+			); diff != "" {
+				t.Fatalf("reactions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReactionRepository_Delete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	linkID := uuid.NewString()
+	newReactionPage(fx, "reaction-delete", linkID, dpage.Reactions{
+		dpage.ReconstructReaction("reaction-delete-creator", dpage.UpvoteReaction),
+		dpage.ReconstructReaction("reaction-delete-member", dpage.UpvoteReaction),
+	})
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewReactionRepository(conn)
+	if err := repo.Delete(ctx, linkID, fx.ID("reaction-delete-member")); err != nil {
+		t.Fatalf("failed to delete reaction: %v", err)
+	}
+	// Deleting a reaction that does not exist is not an error.
+	if err := repo.Delete(ctx, linkID, fx.ID("reaction-delete-member")); err != nil {
+		t.Fatalf("failed to delete missing reaction: %v", err)
+	}
+
+	got, err := NewPageRepository(conn).Get(ctx, fx.ID("reaction-delete-page"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	want := dpage.Reactions{dpage.ReconstructReaction(fx.ID("reaction-delete-creator"), dpage.UpvoteReaction)}
+	if diff := cmp.Diff(want, got.Links()[0].Reactions(), cmp.AllowUnexported(dpage.Reaction{})); diff != "" {
+		t.Fatalf("reactions mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -5,13 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
 	entlinkitem "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkitem"
+	entlinkreaction "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkreaction"
+	entlinkstate "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linkstate"
 	entpage "github.com/naka-sei/tsudzuri/infrastructure/db/ent/page"
+	entpageslug "github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+	entsection "github.com/naka-sei/tsudzuri/infrastructure/db/ent/section"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
 
 	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
 )
@@ -39,7 +45,12 @@ func (r *pageRepository) Get(ctx context.Context, id string) (*dpage.Page, error
 		Query().
 		Where(entpage.IDEQ(uid)).
 		WithCreator().
-		WithLinkItems(func(q *ent.LinkItemQuery) { q.Order(ent.Asc(entlinkitem.FieldPriority)) }).
+		WithLinkItems(func(q *ent.LinkItemQuery) {
+			q.Order(ent.Asc(entlinkitem.FieldPriority)).
+				WithReactions(func(q *ent.LinkReactionQuery) { q.Order(ent.Asc(entlinkreaction.FieldID)) }).
+				WithStates()
+		}).
+		WithSections(func(q *ent.SectionQuery) { q.Order(ent.Asc(entsection.FieldPriority)) }).
 		WithInvitedUsers().
 		Only(ctx)
 	if err != nil {
@@ -61,7 +72,12 @@ func (r *pageRepository) List(ctx context.Context, options ...dpage.SearchOption
 	client := r.conn.ReadOnlyDB(ctx)
 	q := client.Page.Query().
 		WithCreator().
-		WithLinkItems(func(q *ent.LinkItemQuery) { q.Order(ent.Asc(entlinkitem.FieldPriority)) }).
+		WithLinkItems(func(q *ent.LinkItemQuery) {
+			q.Order(ent.Asc(entlinkitem.FieldPriority)).
+				WithReactions(func(q *ent.LinkReactionQuery) { q.Order(ent.Asc(entlinkreaction.FieldID)) }).
+				WithStates()
+		}).
+		WithSections(func(q *ent.SectionQuery) { q.Order(ent.Asc(entsection.FieldPriority)) }).
 		WithInvitedUsers()
 
 	if len(params.IDs) > 0 {
@@ -80,6 +96,9 @@ func (r *pageRepository) List(ctx context.Context, options ...dpage.SearchOption
 			q = q.Where(entpage.CreatorIDEQ(cid))
 		}
 	}
+	if !params.IncludeArchived {
+		q = q.Where(entpage.ArchivedEQ(false))
+	}
 
 	q = q.Order(ent.Asc(entpage.FieldID))
 	page := postgres.PtrInt32ToInt(params.Page)
@@ -131,7 +150,10 @@ func (r *pageRepository) Save(ctx context.Context, pg *dpage.Page) (*dpage.Page,
 	}
 
 	// Upsert pattern: if ID empty -> create, else update title and sync links.
-	var pageID uuid.UUID
+	var (
+		pageID               uuid.UUID
+		createdAt, updatedAt time.Time
+	)
 	if pg.ID() == "" { // create
 		creatorUUID, err := uuid.Parse(pg.CreatedBy().ID())
 		if err != nil {
@@ -140,7 +162,25 @@ func (r *pageRepository) Save(ctx context.Context, pg *dpage.Page) (*dpage.Page,
 		createBuilder := client.Page.Create().
 			SetTitle(pg.Title()).
 			SetCreatorID(creatorUUID).
-			SetInviteCode(pg.InviteCode(pg.CreatedBy()))
+			SetInviteCode(pg.InviteCode(pg.CreatedBy())).
+			SetChecklist(pg.IsChecklist()).
+			SetArchived(pg.IsArchived()).
+			SetDescription(pg.Description()).
+			SetIcon(pg.Icon()).
+			SetColor(pg.Color())
+		if h := pg.FeedTokenHash(); h != "" {
+			createBuilder = createBuilder.SetFeedTokenHash(h)
+		}
+		if slug := pg.Slug(); slug != "" {
+			createBuilder = createBuilder.SetSlug(slug)
+		}
+		if id := pg.SourcePageID(); id != "" {
+			sourceUUID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid source page id: %w", err)
+			}
+			createBuilder = createBuilder.SetSourcePageID(sourceUUID)
+		}
 		if len(invitedUUIDs) > 0 {
 			createBuilder = createBuilder.AddInvitedUserIDs(invitedUUIDs...)
 		}
@@ -150,6 +190,7 @@ func (r *pageRepository) Save(ctx context.Context, pg *dpage.Page) (*dpage.Page,
 		}
 		// Hold created page ID for subsequent operations; reflect to domain later.
 		pageID = created.ID
+		createdAt, updatedAt = created.CreatedAt, created.UpdatedAt
 	} else { // update basic fields
 		pid, err := uuid.Parse(pg.ID())
 		if err != nil {
@@ -157,41 +198,331 @@ func (r *pageRepository) Save(ctx context.Context, pg *dpage.Page) (*dpage.Page,
 		}
 		update := client.Page.UpdateOneID(pid).
 			SetTitle(pg.Title()).
+			SetChecklist(pg.IsChecklist()).
+			SetArchived(pg.IsArchived()).
+			SetDescription(pg.Description()).
+			SetIcon(pg.Icon()).
+			SetColor(pg.Color()).
 			ClearInvitedUsers()
+		if h := pg.FeedTokenHash(); h != "" {
+			update = update.SetFeedTokenHash(h)
+		} else {
+			update = update.ClearFeedTokenHash()
+		}
+		if slug := pg.Slug(); slug != "" {
+			update = update.SetSlug(slug)
+		}
 		if len(invitedUUIDs) > 0 {
 			update = update.AddInvitedUserIDs(invitedUUIDs...)
 		}
-		if _, err := update.Save(ctx); err != nil {
+		updated, err := update.Save(ctx)
+		if err != nil {
 			return nil, err
 		}
-		// Sync link items: simplistic approach delete then recreate in priority order.
-		if _, err := client.LinkItem.Delete().Where(entlinkitem.PageIDEQ(pid)).Exec(ctx); err != nil {
+		pageID = pid
+		createdAt, updatedAt = updated.CreatedAt, updated.UpdatedAt
+	}
+
+	if err := r.recordSlug(ctx, client, pageID, pg.Slug()); err != nil {
+		return nil, err
+	}
+
+	sections, err := r.syncSections(ctx, client, pageID, pg.Sections())
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := r.syncLinkItems(ctx, client, pageID, pg.Links())
+	if err != nil {
+		return nil, err
+	}
+
+	return dpage.ReconstructPage(
+		pageID.String(),
+		pg.Title(),
+		*pg.CreatedBy(),
+		pg.InviteCode(pg.CreatedBy()),
+		links,
+		pg.InvitedUsers(),
+		dpage.WithFeedTokenHash(pg.FeedTokenHash()),
+		dpage.WithSourcePageID(pg.SourcePageID()),
+		dpage.WithSections(sections),
+		dpage.WithChecklist(pg.IsChecklist()),
+		dpage.WithArchived(pg.IsArchived()),
+		dpage.WithDetails(pg.Description(), pg.Icon(), pg.Color()),
+		dpage.WithSlug(pg.Slug()),
+		dpage.WithTimestamps(createdAt, updatedAt),
+	), nil
+}
+
+// recordSlug adds the slug to the slug history of the page unless it is already there, so that the slug keeps
+// addressing the page after it is replaced. A slug in the history of another page is never reused.
+func (r *pageRepository) recordSlug(ctx context.Context, client *ent.Client, pageID uuid.UUID, slug string) error {
+	if slug == "" {
+		return nil
+	}
+	found, err := client.PageSlug.Query().Where(entpageslug.SlugEQ(slug)).Only(ctx)
+	switch {
+	case err == nil:
+		if found.PageID != pageID {
+			return dpage.ErrSlugTaken
+		}
+		return nil
+	case !ent.IsNotFound(err):
+		return err
+	}
+	return client.PageSlug.Create().
+		SetSlug(slug).
+		SetPageID(pageID).
+		SetCreatedAt(ctxtime.Now(ctx)).
+		Exec(ctx)
+}
+
+// syncLinkItems makes the stored link items of the page match the given links.
+// Links that already have an ID keep their row (and therefore their created_at); only rows whose
+// URL, memo, priority, section or done flag changed are updated, removed links are deleted and links without an ID are inserted
+// together with their member states.
+// It returns the links with their persisted IDs and creation times, ordered by priority.
+func (r *pageRepository) syncLinkItems(ctx context.Context, client *ent.Client, pageID uuid.UUID, links dpage.Links) (dpage.Links, error) {
+	existing, err := client.LinkItem.Query().Where(entlinkitem.PageIDEQ(pageID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingByID := make(map[uuid.UUID]*ent.LinkItem, len(existing))
+	for _, li := range existing {
+		existingByID[li.ID] = li
+	}
+
+	sorted := slices.Clone(links)
+	slices.SortStableFunc(sorted, func(a, b dpage.Link) int { return a.Priority() - b.Priority() })
+
+	result := make(dpage.Links, len(sorted))
+	keep := make([]uuid.UUID, 0, len(sorted))
+	var (
+		bulk       []*ent.LinkItemCreate
+		bulkTarget []int
+	)
+	for i, l := range sorted {
+		sectionID, err := parseSectionID(l.SectionID())
+		if err != nil {
 			return nil, err
 		}
-		pageID = pid
+		doneBy, doneAt, err := parseLinkDone(l)
+		if err != nil {
+			return nil, err
+		}
+		if id, err := uuid.Parse(l.ID()); err == nil {
+			if li, ok := existingByID[id]; ok {
+				keep = append(keep, id)
+				if li.URL != l.URL() || li.Priority != l.Priority() || linkItemMemo(li) != l.Memo() || !sameUUID(li.SectionID, sectionID) || !sameDone(li, doneBy, doneAt) {
+					update := client.LinkItem.UpdateOneID(id).
+						SetURL(l.URL()).
+						SetPriority(l.Priority()).
+						SetNillableSectionID(sectionID).
+						SetNillableDoneByID(doneBy).
+						SetNillableDoneAt(doneAt)
+					if m := l.Memo(); m != "" {
+						update = update.SetMemo(m)
+					} else {
+						update = update.ClearMemo()
+					}
+					if sectionID == nil {
+						update = update.ClearSectionID()
+					}
+					if doneBy == nil {
+						update = update.ClearDoneByID()
+					}
+					if doneAt == nil {
+						update = update.ClearDoneAt()
+					}
+					if li.URL != l.URL() {
+						// The outcome of the last check is for the old URL.
+						update = update.ClearCheckStatus().ClearRedirectURL().ClearCheckedAt()
+					}
+					if err := update.Exec(ctx); err != nil {
+						return nil, err
+					}
+				}
+				result[i] = reconstructLinkItem(l, li)
+				continue
+			}
+		}
+		create := client.LinkItem.Create().
+			SetPageID(pageID).
+			SetURL(l.URL()).
+			SetPriority(l.Priority()).
+			SetNillableSectionID(sectionID).
+			SetNillableDoneByID(doneBy).
+			SetNillableDoneAt(doneAt)
+		if m := l.Memo(); m != "" {
+			create.SetMemo(m)
+		}
+		bulk = append(bulk, create)
+		bulkTarget = append(bulkTarget, i)
+	}
+
+	if len(existing) > len(keep) {
+		if _, err := client.LinkItem.Delete().
+			Where(entlinkitem.PageIDEQ(pageID), entlinkitem.IDNotIn(keep...)).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(bulk) > 0 {
+		created, err := client.LinkItem.CreateBulk(bulk...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var states []*ent.LinkStateCreate
+		for j, li := range created {
+			l := sorted[bulkTarget[j]]
+			result[bulkTarget[j]] = reconstructLinkItem(l, li)
+			// New links can only carry the state of the member who added them, so there is nothing to overwrite.
+			for _, st := range l.States() {
+				uid, err := uuid.Parse(st.UserID())
+				if err != nil {
+					return nil, fmt.Errorf("invalid link state user id: %w", err)
+				}
+				states = append(states, client.LinkState.Create().
+					SetLinkItemID(li.ID).
+					SetUserID(uid).
+					SetStatus(entlinkstate.Status(st.Status())))
+			}
+		}
+		if len(states) > 0 {
+			if _, err := client.LinkState.CreateBulk(states...).Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// syncSections makes the stored sections of the page match the given sections in the same way as syncLinkItems.
+// Deleting a section row leaves its link items in place with section_id cleared by the foreign key.
+func (r *pageRepository) syncSections(ctx context.Context, client *ent.Client, pageID uuid.UUID, sections dpage.Sections) (dpage.Sections, error) {
+	existing, err := client.Section.Query().Where(entsection.PageIDEQ(pageID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingByID := make(map[uuid.UUID]*ent.Section, len(existing))
+	for _, s := range existing {
+		existingByID[s.ID] = s
 	}
 
-	// (Re)create link items from domain state
-	if len(pg.Links()) > 0 {
-		bulk := make([]*ent.LinkItemCreate, 0, len(pg.Links()))
-		slices.SortFunc(pg.Links(), func(a, b dpage.Link) int { return a.Priority() - b.Priority() })
-		for _, l := range pg.Links() {
-			li := client.LinkItem.Create().
-				SetPageID(pageID).
-				SetURL(l.URL()).
-				SetPriority(l.Priority())
-			if m := l.Memo(); m != "" {
-				memo := l.Memo()
-				li.SetMemo(memo)
+	sorted := slices.Clone(sections)
+	slices.SortStableFunc(sorted, func(a, b dpage.Section) int { return a.Priority() - b.Priority() })
+
+	result := make(dpage.Sections, len(sorted))
+	keep := make([]uuid.UUID, 0, len(sorted))
+	var (
+		bulk       []*ent.SectionCreate
+		bulkTarget []int
+	)
+	for i, sec := range sorted {
+		if id, err := uuid.Parse(sec.ID()); err == nil {
+			if es, ok := existingByID[id]; ok {
+				keep = append(keep, id)
+				if es.Priority != sec.Priority() || es.Name != sec.Name() {
+					if err := client.Section.UpdateOneID(id).
+						SetName(sec.Name()).
+						SetPriority(sec.Priority()).
+						Exec(ctx); err != nil {
+						return nil, err
+					}
+				}
+				result[i] = sec
+				continue
 			}
-			bulk = append(bulk, li)
 		}
-		if _, err := client.LinkItem.CreateBulk(bulk...).Save(ctx); err != nil {
+		create := client.Section.Create().
+			SetPageID(pageID).
+			SetName(sec.Name()).
+			SetPriority(sec.Priority())
+		if id, err := uuid.Parse(sec.ID()); err == nil {
+			// Sections copied by Page.Duplicate carry new IDs that their links already refer to.
+			create.SetID(id)
+		}
+		bulk = append(bulk, create)
+		bulkTarget = append(bulkTarget, i)
+	}
+
+	if len(existing) > len(keep) {
+		if _, err := client.Section.Delete().
+			Where(entsection.PageIDEQ(pageID), entsection.IDNotIn(keep...)).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(bulk) > 0 {
+		created, err := client.Section.CreateBulk(bulk...).Save(ctx)
+		if err != nil {
 			return nil, err
 		}
+		for j, es := range created {
+			result[bulkTarget[j]] = dpage.ReconstructSection(es.ID.String(), es.Name, es.Priority)
+		}
+	}
+
+	return result, nil
+}
+
+func parseSectionID(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid section id: %w", err)
+	}
+	return &parsed, nil
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// parseLinkDone returns the done flag columns of the link, or nils if the link is not done.
+func parseLinkDone(l dpage.Link) (*uuid.UUID, *time.Time, error) {
+	if !l.Done() {
+		return nil, nil, nil
+	}
+	doneAt := l.DoneAt()
+	if l.DoneBy() == "" {
+		return nil, &doneAt, nil
+	}
+	doneBy, err := uuid.Parse(l.DoneBy())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid done by user id: %w", err)
 	}
+	return &doneBy, &doneAt, nil
+}
+
+// sameDone reports whether the stored done flag matches. The time only changes when the flag is toggled,
+// so comparing whether it is set is enough and avoids rewriting rows over sub-microsecond differences.
+func sameDone(li *ent.LinkItem, doneBy *uuid.UUID, doneAt *time.Time) bool {
+	return sameUUID(li.DoneByID, doneBy) && (li.DoneAt == nil) == (doneAt == nil)
+}
 
-	return dpage.ReconstructPage(pageID.String(), pg.Title(), *pg.CreatedBy(), pg.InviteCode(pg.CreatedBy()), pg.Links(), pg.InvitedUsers()), nil
+func reconstructLinkItem(l dpage.Link, li *ent.LinkItem) dpage.Link {
+	return dpage.ReconstructLink(
+		l.URL(),
+		l.Memo(),
+		l.Priority(),
+		dpage.WithLinkID(li.ID.String()),
+		dpage.WithLinkCreatedAt(li.CreatedAt),
+		dpage.WithLinkSectionID(l.SectionID()),
+		dpage.WithLinkReactions(l.Reactions()),
+		dpage.WithLinkStates(l.States()),
+		dpage.WithLinkDone(l.DoneBy(), l.DoneAt()),
+		dpage.WithLinkHealth(l.Health()),
+	)
 }
 
 // DeleteByID deletes a page by ID (cascade relies on FK / DB constraints).
@@ -218,22 +549,110 @@ func (r *pageRepository) entToDomain(p *ent.Page) (*dpage.Page, error) {
 	creator := r.entUserToDomain(p.Edges.Creator)
 	links := make(dpage.Links, 0, len(p.Edges.LinkItems))
 	for _, li := range p.Edges.LinkItems {
-		memo := ""
-		if li.Memo != nil {
-			memo = *li.Memo
-		}
-		links = append(links, dpage.ReconstructLink(li.URL, memo, li.Priority))
+		links = append(links, dpage.ReconstructLink(
+			li.URL,
+			linkItemMemo(li),
+			li.Priority,
+			dpage.WithLinkID(li.ID.String()),
+			dpage.WithLinkCreatedAt(li.CreatedAt),
+			dpage.WithLinkSectionID(linkItemSectionID(li)),
+			dpage.WithLinkReactions(linkItemReactions(li)),
+			dpage.WithLinkStates(linkItemStates(li)),
+			dpage.WithLinkDone(linkItemDone(li)),
+			dpage.WithLinkHealth(linkItemHealth(li)),
+		))
+	}
+	sections := make(dpage.Sections, 0, len(p.Edges.Sections))
+	for _, s := range p.Edges.Sections {
+		sections = append(sections, dpage.ReconstructSection(s.ID.String(), s.Name, s.Priority))
 	}
 	invited := make(duser.Users, 0, len(p.Edges.InvitedUsers))
 	for _, u := range p.Edges.InvitedUsers {
 		invited = append(invited, r.entUserToDomain(u))
 	}
-	return dpage.ReconstructPage(p.ID.String(), p.Title, *creator, p.InviteCode, links, invited), nil
+	opts := []dpage.ReconstructOption{
+		dpage.WithSections(sections),
+		dpage.WithChecklist(p.Checklist),
+		dpage.WithArchived(p.Archived),
+		dpage.WithDetails(p.Description, p.Icon, p.Color),
+		dpage.WithTimestamps(p.CreatedAt, p.UpdatedAt),
+	}
+	if p.Slug != nil {
+		opts = append(opts, dpage.WithSlug(*p.Slug))
+	}
+	if p.FeedTokenHash != nil {
+		opts = append(opts, dpage.WithFeedTokenHash(*p.FeedTokenHash))
+	}
+	if p.SourcePageID != nil {
+		opts = append(opts, dpage.WithSourcePageID(p.SourcePageID.String()))
+	}
+	return dpage.ReconstructPage(p.ID.String(), p.Title, *creator, p.InviteCode, links, invited, opts...), nil
+}
+
+func linkItemMemo(li *ent.LinkItem) string {
+	if li.Memo == nil {
+		return ""
+	}
+	return *li.Memo
+}
+
+func linkItemSectionID(li *ent.LinkItem) string {
+	if li.SectionID == nil {
+		return ""
+	}
+	return li.SectionID.String()
+}
+
+func linkItemDone(li *ent.LinkItem) (string, time.Time) {
+	if li.DoneAt == nil {
+		return "", time.Time{}
+	}
+	if li.DoneByID == nil {
+		return "", *li.DoneAt
+	}
+	return li.DoneByID.String(), *li.DoneAt
+}
+
+func linkItemHealth(li *ent.LinkItem) dpage.LinkHealth {
+	if li.CheckedAt == nil {
+		return dpage.LinkHealth{}
+	}
+	var status int
+	if li.CheckStatus != nil {
+		status = *li.CheckStatus
+	}
+	var redirectURL string
+	if li.RedirectURL != nil {
+		redirectURL = *li.RedirectURL
+	}
+	return dpage.NewLinkHealth(status, redirectURL, *li.CheckedAt)
+}
+
+func linkItemReactions(li *ent.LinkItem) dpage.Reactions {
+	if len(li.Edges.Reactions) == 0 {
+		return nil
+	}
+	reactions := make(dpage.Reactions, 0, len(li.Edges.Reactions))
+	for _, lr := range li.Edges.Reactions {
+		reactions = append(reactions, dpage.ReconstructReaction(lr.UserID.String(), lr.Emoji))
+	}
+	return reactions
+}
+
+func linkItemStates(li *ent.LinkItem) dpage.LinkStates {
+	if len(li.Edges.States) == 0 {
+		return nil
+	}
+	states := make(dpage.LinkStates, 0, len(li.Edges.States))
+	for _, ls := range li.Edges.States {
+		states = append(states, dpage.ReconstructLinkState(ls.UserID.String(), dpage.LinkStatus(ls.Status)))
+	}
+	return states
 }
 
 func (r *pageRepository) entUserToDomain(u *ent.User) *duser.User {
 	if u == nil {
 		return nil
 	}
-	return duser.ReconstructUser(u.ID.String(), u.UID, string(u.Provider), u.Email)
+	return duser.ReconstructUser(u.ID.String(), u.UID, string(u.Provider), u.Email, duser.WithProfile(duser.ReconstructProfile(u.DisplayName, u.AvatarURL, u.Locale)))
 }
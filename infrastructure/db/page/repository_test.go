@@ -3,7 +3,9 @@ package page
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -19,7 +21,11 @@ import (
 
 func pageCmpOpts() []cmp.Option {
 	return []cmp.Option{
-		cmp.AllowUnexported(dpage.Link{}, dpage.Page{}, duser.User{}),
+		cmp.AllowUnexported(dpage.Link{}, dpage.Section{}, dpage.Reaction{}, dpage.Page{}, duser.User{}, duser.Profile{}),
+		// Link IDs and creation times are generated by the database.
+		cmpopts.IgnoreFields(dpage.Link{}, "id", "createdAt"),
+		// Page timestamps are set by the database.
+		cmpopts.IgnoreFields(dpage.Page{}, "createdAt", "updatedAt"),
 		cmpopts.SortSlices(func(a, b dpage.Link) bool { return a.Priority() < b.Priority() }),
 		// Treat nil and empty slices as equal (e.g., invited users)
 		cmpopts.EquateEmpty(),
@@ -223,6 +229,41 @@ func TestPageRepository_List(t *testing.T) {
 				return want{pages: []*dpage.Page{dpage.ReconstructPage(fx.ID("list-G"), "list-G", *creator, "INVLISTG", nil, nil)}}
 			},
 		},
+		{
+			name: "archived_hidden",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "creator-uid-arc", string(duser.ProviderGoogle), ptr.Ptr("arc@example.com"))
+				fx.NewUser(creator)
+				fx.NewPage(dpage.ReconstructPage("", "list-ARC1", *creator, "INVARC01", nil, nil))
+				fx.NewPage(dpage.ReconstructPage("", "list-ARC2", *creator, "INVARC02", nil, nil, dpage.WithArchived(true)))
+			},
+			args: func(fx *fixture.Fixture) args {
+				return args{opts: []dpage.SearchOption{dpage.WithCreatedByUserID(fx.ID("creator-uid-arc"))}}
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("creator-uid-arc"), "creator-uid-arc", string(duser.ProviderGoogle), ptr.Ptr("arc@example.com"))
+				return want{pages: []*dpage.Page{dpage.ReconstructPage(fx.ID("list-ARC1"), "list-ARC1", *creator, "INVARC01", nil, nil)}}
+			},
+		},
+		{
+			name: "include_archived",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "creator-uid-arcin", string(duser.ProviderGoogle), ptr.Ptr("arcin@example.com"))
+				fx.NewUser(creator)
+				fx.NewPage(dpage.ReconstructPage("", "list-ARC3", *creator, "INVARC03", nil, nil))
+				fx.NewPage(dpage.ReconstructPage("", "list-ARC4", *creator, "INVARC04", nil, nil, dpage.WithArchived(true)))
+			},
+			args: func(fx *fixture.Fixture) args {
+				return args{opts: []dpage.SearchOption{dpage.WithCreatedByUserID(fx.ID("creator-uid-arcin")), dpage.WithIncludeArchived(true)}}
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("creator-uid-arcin"), "creator-uid-arcin", string(duser.ProviderGoogle), ptr.Ptr("arcin@example.com"))
+				return want{pages: []*dpage.Page{
+					dpage.ReconstructPage(fx.ID("list-ARC3"), "list-ARC3", *creator, "INVARC03", nil, nil),
+					dpage.ReconstructPage(fx.ID("list-ARC4"), "list-ARC4", *creator, "INVARC04", nil, nil, dpage.WithArchived(true)),
+				}}
+			},
+		},
 		{
 			name: "empty",
 			args: func(fx *fixture.Fixture) args { return args{} },
@@ -320,6 +361,28 @@ func TestPageRepository_Save(t *testing.T) {
 				return want{page: expected}
 			},
 		},
+		{
+			name: "create_with_source_page_id",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "creator-dup-uid", string(duser.ProviderGoogle), ptr.Ptr("dup@example.com"))
+				fx.NewUser(creator)
+				fx.NewPage(dpage.ReconstructPage("", "save-dup-source", *creator, "INVDS01", nil, nil))
+			},
+			args: func(fx *fixture.Fixture) args {
+				creator := duser.ReconstructUser(fx.ID("creator-dup-uid"), "creator-dup-uid", string(duser.ProviderGoogle), ptr.Ptr("dup@example.com"))
+				pg := dpage.ReconstructPage("", "save-dup-source のコピー", *creator, "INVDC01", dpage.Links{
+					dpage.ReconstructLink("https://dup.com/1", "d1", 1),
+				}, nil, dpage.WithSourcePageID(fx.ID("save-dup-source")))
+				return args{page: pg}
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("creator-dup-uid"), "creator-dup-uid", string(duser.ProviderGoogle), ptr.Ptr("dup@example.com"))
+				expected := dpage.ReconstructPage("", "save-dup-source のコピー", *creator, "INVDC01", dpage.Links{
+					dpage.ReconstructLink("https://dup.com/1", "d1", 1),
+				}, nil, dpage.WithSourcePageID(fx.ID("save-dup-source")))
+				return want{page: expected}
+			},
+		},
 		{
 			name: "update_success_title_and_links_edit",
 			prepare: func(fx *fixture.Fixture) {
@@ -371,6 +434,40 @@ func TestPageRepository_Save(t *testing.T) {
 				return want{page: expected}
 			},
 		},
+		{
+			name: "update_feed_token_hash",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "creator-feed-uid", string(duser.ProviderGoogle), ptr.Ptr("feed@example.com"))
+				page := dpage.ReconstructPage("", "save-feed", *creator, "INVFEED1", nil, nil, dpage.WithFeedTokenHash("old-hash"))
+				fx.NewUser(creator)
+				fx.NewPage(page)
+			},
+			args: func(fx *fixture.Fixture) args {
+				creator := duser.ReconstructUser(fx.ID("creator-feed-uid"), "creator-feed-uid", string(duser.ProviderGoogle), ptr.Ptr("feed@example.com"))
+				return args{page: dpage.ReconstructPage(fx.ID("save-feed"), "save-feed", *creator, "INVFEED1", nil, nil, dpage.WithFeedTokenHash("new-hash"))}
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("creator-feed-uid"), "creator-feed-uid", string(duser.ProviderGoogle), ptr.Ptr("feed@example.com"))
+				return want{page: dpage.ReconstructPage(fx.ID("save-feed"), "save-feed", *creator, "INVFEED1", nil, nil, dpage.WithFeedTokenHash("new-hash"))}
+			},
+		},
+		{
+			name: "update_revoke_feed_token_hash",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "creator-revoke-uid", string(duser.ProviderGoogle), ptr.Ptr("revoke@example.com"))
+				page := dpage.ReconstructPage("", "save-revoke", *creator, "INVREVK1", nil, nil, dpage.WithFeedTokenHash("old-hash"))
+				fx.NewUser(creator)
+				fx.NewPage(page)
+			},
+			args: func(fx *fixture.Fixture) args {
+				creator := duser.ReconstructUser(fx.ID("creator-revoke-uid"), "creator-revoke-uid", string(duser.ProviderGoogle), ptr.Ptr("revoke@example.com"))
+				return args{page: dpage.ReconstructPage(fx.ID("save-revoke"), "save-revoke", *creator, "INVREVK1", nil, nil)}
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("creator-revoke-uid"), "creator-revoke-uid", string(duser.ProviderGoogle), ptr.Ptr("revoke@example.com"))
+				return want{page: dpage.ReconstructPage(fx.ID("save-revoke"), "save-revoke", *creator, "INVREVK1", nil, nil)}
+			},
+		},
 		{
 			name: "create_invalid_creator_id",
 			args: func(fx *fixture.Fixture) args {
@@ -443,6 +540,241 @@ func TestPageRepository_Save(t *testing.T) {
 	}
 }
 
+// TestPageRepository_Save_keepsLinkIdentity verifies that existing link rows survive an update
+// while removed links are deleted and new links get fresh IDs.
+func TestPageRepository_Save_keepsLinkIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	linkA := uuid.NewString()
+	linkB := uuid.NewString()
+	creator := duser.ReconstructUser("", "creator-identity-uid", string(duser.ProviderGoogle), ptr.Ptr("identity@example.com"))
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "save-identity", *creator, "INVIDEN1", dpage.Links{
+		dpage.ReconstructLink("https://identity.com/a", "a", 1, dpage.WithLinkID(linkA)),
+		dpage.ReconstructLink("https://identity.com/b", "b", 2, dpage.WithLinkID(linkB)),
+	}, nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	before, err := repo.Get(ctx, fx.ID("save-identity"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+
+	saver := duser.ReconstructUser(fx.ID("creator-identity-uid"), "creator-identity-uid", string(duser.ProviderGoogle), ptr.Ptr("identity@example.com"))
+	updated := dpage.ReconstructPage(fx.ID("save-identity"), "save-identity", *saver, "INVIDEN1", dpage.Links{
+		dpage.ReconstructLink("https://identity.com/b", "b-new", 1, dpage.WithLinkID(linkB)),
+		dpage.ReconstructLink("https://identity.com/c", "c", 2),
+	}, nil)
+	saved, err := repo.Save(ctx, updated)
+	if err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+
+	got, err := repo.Get(ctx, fx.ID("save-identity"))
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if diff := cmp.Diff(saved.Links(), got.Links(), cmp.AllowUnexported(dpage.Link{}), cmpopts.EquateApproxTime(time.Millisecond)); diff != "" {
+		t.Fatalf("returned links mismatch (-saved +got):\n%s", diff)
+	}
+	if got.Links()[0].ID() != linkB {
+		t.Fatalf("link b id changed: want %s, got %s", linkB, got.Links()[0].ID())
+	}
+	if !got.Links()[0].CreatedAt().Equal(before.Links()[1].CreatedAt()) {
+		t.Fatalf("link b created_at changed: want %v, got %v", before.Links()[1].CreatedAt(), got.Links()[0].CreatedAt())
+	}
+	if id := got.Links()[1].ID(); id == "" || id == linkA {
+		t.Fatalf("link c should have a new id, got %q", id)
+	}
+}
+
+// TestPageRepository_Save_checklist verifies that the checklist mode and the done flags of the links are stored.
+func TestPageRepository_Save_checklist(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	linkA := uuid.NewString()
+	linkB := uuid.NewString()
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	creator := duser.ReconstructUser("", "creator-checklist-uid", string(duser.ProviderGoogle), ptr.Ptr("checklist@example.com"))
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "save-checklist", *creator, "INVCHK01", dpage.Links{
+		dpage.ReconstructLink("https://checklist.com/a", "a", 1, dpage.WithLinkID(linkA), dpage.WithLinkDone("creator-checklist-uid", doneAt)),
+		dpage.ReconstructLink("https://checklist.com/b", "b", 2, dpage.WithLinkID(linkB)),
+	}, nil, dpage.WithChecklist(true)))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	pg, err := repo.Get(ctx, fx.ID("save-checklist"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	if !pg.IsChecklist() {
+		t.Fatal("page should be in checklist mode")
+	}
+	if l := pg.Links()[0]; !l.Done() || l.DoneBy() != fx.ID("creator-checklist-uid") || !l.DoneAt().Equal(doneAt) {
+		t.Fatalf("link a done flag mismatch: done=%v by=%q at=%v", l.Done(), l.DoneBy(), l.DoneAt())
+	}
+
+	user := duser.ReconstructUser(fx.ID("creator-checklist-uid"), "creator-checklist-uid", string(duser.ProviderGoogle), ptr.Ptr("checklist@example.com"))
+	if _, err := pg.ToggleLinkDone(user, linkA, doneAt); err != nil {
+		t.Fatalf("failed to toggle link a: %v", err)
+	}
+	if _, err := pg.ToggleLinkDone(user, linkB, doneAt); err != nil {
+		t.Fatalf("failed to toggle link b: %v", err)
+	}
+	if _, err := repo.Save(ctx, pg); err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+
+	got, err := repo.Get(ctx, fx.ID("save-checklist"))
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if done, total := got.Progress(); done != 1 || total != 2 {
+		t.Fatalf("Progress() = (%d, %d), want (1, 2)", done, total)
+	}
+	if got.Links()[0].Done() {
+		t.Fatal("link a should not be done")
+	}
+	if l := got.Links()[1]; !l.Done() || l.DoneBy() != user.ID() || !l.DoneAt().Equal(doneAt) {
+		t.Fatalf("link b done flag mismatch: done=%v by=%q at=%v", l.Done(), l.DoneBy(), l.DoneAt())
+	}
+}
+
+func TestPageRepository_Save_details(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	creator := duser.ReconstructUser("", "creator-details-uid", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "save-details", *creator, "INVDTL01", nil, nil, dpage.WithDetails("old", "📚", "#123456")))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	before, err := repo.Get(ctx, fx.ID("save-details"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	if before.Description() != "old" || before.Icon() != "📚" || before.Color() != "#123456" {
+		t.Fatalf("fixture details mismatch: %q %q %q", before.Description(), before.Icon(), before.Color())
+	}
+	if before.CreatedAt().IsZero() || before.UpdatedAt().IsZero() {
+		t.Fatal("timestamps should be loaded")
+	}
+
+	user := duser.ReconstructUser(fx.ID("creator-details-uid"), "creator-details-uid", string(duser.ProviderAnonymous), nil)
+	if err := before.EditDetails(user, "**new**", "🏝️", "#ABCDEF"); err != nil {
+		t.Fatalf("failed to edit details: %v", err)
+	}
+	saved, err := repo.Save(ctx, before)
+	if err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+	if !saved.CreatedAt().Equal(before.CreatedAt()) || saved.UpdatedAt().Before(before.UpdatedAt()) {
+		t.Fatalf("timestamps mismatch: created %v -> %v, updated %v -> %v", before.CreatedAt(), saved.CreatedAt(), before.UpdatedAt(), saved.UpdatedAt())
+	}
+
+	got, err := repo.Get(ctx, fx.ID("save-details"))
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if diff := cmp.Diff([]string{"**new**", "🏝️", "#abcdef"}, []string{got.Description(), got.Icon(), got.Color()}); diff != "" {
+		t.Fatalf("details mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestPageRepository_Save_multibyteTitle tests that the title limit counts characters, not bytes.
+func TestPageRepository_Save_multibyteTitle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	fx.NewUser(duser.ReconstructUser("", "creator-multibyte-uid", string(duser.ProviderAnonymous), nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	creator := duser.ReconstructUser(fx.ID("creator-multibyte-uid"), "creator-multibyte-uid", string(duser.ProviderAnonymous), nil)
+	title := strings.Repeat("綴", dpage.MaxTitleLength)
+	page, err := dpage.NewPage(title, creator)
+	if err != nil {
+		t.Fatalf("failed to create page: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	saved, err := repo.Save(ctx, page)
+	if err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+	got, err := repo.Get(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if got.Title() != title {
+		t.Fatalf("title = %q, want %q", got.Title(), title)
+	}
+}
+
+// TestPageRepository_Save_duplicateMultibyteTitle tests saving the copy of a page whose title fills MaxTitleLength
+// with multibyte characters, so the copy suffix makes it shortened.
+func TestPageRepository_Save_duplicateMultibyteTitle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	creator := duser.ReconstructUser("", "creator-duplicate-uid", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", strings.Repeat("旅", dpage.MaxTitleLength), *creator, "INVDUP01", dpage.Links{
+		dpage.ReconstructLink("https://example.com/1", "memo", 1),
+	}, nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	source, err := repo.Get(ctx, fx.ID(strings.Repeat("旅", dpage.MaxTitleLength)))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	user := duser.ReconstructUser(fx.ID("creator-duplicate-uid"), "creator-duplicate-uid", string(duser.ProviderAnonymous), nil)
+	dup, err := source.Duplicate(user)
+	if err != nil {
+		t.Fatalf("failed to duplicate page: %v", err)
+	}
+
+	saved, err := repo.Save(ctx, dup)
+	if err != nil {
+		t.Fatalf("failed to save duplicated page: %v", err)
+	}
+	got, err := repo.Get(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("failed to get duplicated page: %v", err)
+	}
+	if want := dup.Title(); got.Title() != want {
+		t.Fatalf("title = %q, want %q", got.Title(), want)
+	}
+	if got.SourcePageID() != source.ID() || len(got.Links()) != 1 {
+		t.Fatalf("duplicate mismatch: source=%q links=%d", got.SourcePageID(), len(got.Links()))
+	}
+}
+
 // TestPageRepository_DeleteByID tests deleting a page.
 func TestPageRepository_DeleteByID(t *testing.T) {
 	type args struct{ id string }
@@ -513,3 +845,110 @@ func TestPageRepository_DeleteByID(t *testing.T) {
 		})
 	}
 }
+
+// TestPageRepository_Save_sections verifies that sections are renamed, reordered, removed and created,
+// and that link items follow their section.
+func TestPageRepository_Save_sections(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	sectionA := uuid.NewString()
+	sectionB := uuid.NewString()
+	linkA := uuid.NewString()
+	linkB := uuid.NewString()
+	creator := duser.ReconstructUser("", "creator-section-uid", string(duser.ProviderGoogle), ptr.Ptr("section@example.com"))
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "save-sections", *creator, "INVSECT1", dpage.Links{
+		dpage.ReconstructLink("https://section.com/a", "a", 1, dpage.WithLinkID(linkA), dpage.WithLinkSectionID(sectionA)),
+		dpage.ReconstructLink("https://section.com/b", "b", 2, dpage.WithLinkID(linkB), dpage.WithLinkSectionID(sectionB)),
+	}, nil, dpage.WithSections(dpage.Sections{
+		dpage.ReconstructSection(sectionA, "A", 1),
+		dpage.ReconstructSection(sectionB, "B", 2),
+	})))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	saver := duser.ReconstructUser(fx.ID("creator-section-uid"), "creator-section-uid", string(duser.ProviderGoogle), ptr.Ptr("section@example.com"))
+	updated := dpage.ReconstructPage(fx.ID("save-sections"), "save-sections", *saver, "INVSECT1", dpage.Links{
+		dpage.ReconstructLink("https://section.com/b", "b", 1, dpage.WithLinkID(linkB)),
+		dpage.ReconstructLink("https://section.com/a", "a", 2, dpage.WithLinkID(linkA), dpage.WithLinkSectionID(sectionA)),
+	}, nil, dpage.WithSections(dpage.Sections{
+		dpage.ReconstructSection(sectionA, "A renamed", 1),
+		dpage.ReconstructSection("", "C", 2),
+	}))
+	saved, err := repo.Save(ctx, updated)
+	if err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+
+	got, err := repo.Get(ctx, fx.ID("save-sections"))
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if diff := cmp.Diff(saved.Sections(), got.Sections(), cmp.AllowUnexported(dpage.Section{})); diff != "" {
+		t.Fatalf("returned sections mismatch (-saved +got):\n%s", diff)
+	}
+	if len(got.Sections()) != 2 || got.Sections()[0].ID() != sectionA || got.Sections()[0].Name() != "A renamed" {
+		t.Fatalf("unexpected sections: %+v", got.Sections())
+	}
+	if id := got.Sections()[1].ID(); id == "" || id == sectionB {
+		t.Fatalf("section c should have a new id, got %q", id)
+	}
+	if diff := cmp.Diff([]string{"", sectionA}, []string{got.Links()[0].SectionID(), got.Links()[1].SectionID()}); diff != "" {
+		t.Fatalf("link sections mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestPageRepository_Save_duplicatedSections verifies that a duplicated page is stored with the sections it was
+// given and that its links stay in their copied sections.
+func TestPageRepository_Save_duplicatedSections(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	sectionA := uuid.NewString()
+	creator := duser.ReconstructUser("", "creator-dup-section-uid", string(duser.ProviderGoogle), ptr.Ptr("dup-section@example.com"))
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "duplicate-sections", *creator, "INVDSEC1", dpage.Links{
+		dpage.ReconstructLink("https://dup-section.com/a", "a", 1, dpage.WithLinkID(uuid.NewString()), dpage.WithLinkSectionID(sectionA)),
+		dpage.ReconstructLink("https://dup-section.com/b", "b", 2, dpage.WithLinkID(uuid.NewString())),
+	}, nil, dpage.WithSections(dpage.Sections{
+		dpage.ReconstructSection(sectionA, "A", 1),
+	})))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewPageRepository(conn)
+	source, err := repo.Get(ctx, fx.ID("duplicate-sections"))
+	if err != nil {
+		t.Fatalf("failed to get source page: %v", err)
+	}
+	dup, err := source.Duplicate(source.CreatedBy())
+	if err != nil {
+		t.Fatalf("failed to duplicate page: %v", err)
+	}
+	saved, err := repo.Save(ctx, dup)
+	if err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+
+	got, err := repo.Get(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("failed to get saved page: %v", err)
+	}
+	if diff := cmp.Diff(dup.Sections(), got.Sections(), cmp.AllowUnexported(dpage.Section{})); diff != "" {
+		t.Fatalf("sections mismatch (-duplicated +got):\n%s", diff)
+	}
+	if got.Sections()[0].ID() == sectionA {
+		t.Fatalf("duplicated section should have a new id, got %q", got.Sections()[0].ID())
+	}
+	if diff := cmp.Diff([]string{got.Sections()[0].ID(), ""}, []string{got.Links()[0].SectionID(), got.Links()[1].SectionID()}); diff != "" {
+		t.Fatalf("link sections mismatch (-want +got):\n%s", diff)
+	}
+}
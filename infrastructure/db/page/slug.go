@@ -0,0 +1,36 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entpageslug "github.com/naka-sei/tsudzuri/infrastructure/db/ent/pageslug"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type slugRepository struct {
+	conn *postgres.Connection
+}
+
+func NewSlugRepository(conn *postgres.Connection) dpage.SlugRepository {
+	return &slugRepository{conn: conn}
+}
+
+// Resolve looks the slug up in the slug history, so that replaced slugs still resolve to their page.
+func (r *slugRepository) Resolve(ctx context.Context, slug string) (string, error) {
+	if slug == "" {
+		return "", nil
+	}
+	found, err := r.conn.ReadOnlyDB(ctx).PageSlug.Query().
+		Where(entpageslug.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return found.PageID.String(), nil
+}
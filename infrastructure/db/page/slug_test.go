@@ -0,0 +1,79 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestSlugRepository_Resolve(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+
+	creator := duser.ReconstructUser("", "slug-creator", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(creator)
+	fx.NewPage(dpage.ReconstructPage("", "slug-page", *creator, "INVSLG01", nil, nil, dpage.WithSlug("slug-old")))
+	fx.NewPage(dpage.ReconstructPage("", "slug-other", *creator, "INVSLG02", nil, nil, dpage.WithSlug("slug-other")))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	pages := NewPageRepository(conn)
+	user := duser.ReconstructUser(fx.ID("slug-creator"), "slug-creator", string(duser.ProviderAnonymous), nil)
+	page, err := pages.Get(ctx, fx.ID("slug-page"))
+	if err != nil {
+		t.Fatalf("failed to get page: %v", err)
+	}
+	if got := page.Slug(); got != "slug-old" {
+		t.Fatalf("fixture slug = %q, want %q", got, "slug-old")
+	}
+	if err := page.SetSlug(user, "slug-new"); err != nil {
+		t.Fatalf("failed to set slug: %v", err)
+	}
+	if _, err := pages.Save(ctx, page); err != nil {
+		t.Fatalf("failed to save page: %v", err)
+	}
+
+	repo := NewSlugRepository(conn)
+	tests := []struct {
+		name string
+		slug string
+		want string
+	}{
+		{name: "current", slug: "slug-new", want: fx.ID("slug-page")},
+		{name: "replaced", slug: "slug-old", want: fx.ID("slug-page")},
+		{name: "other_page", slug: "slug-other", want: fx.ID("slug-other")},
+		{name: "unknown", slug: "slug-unknown"},
+		{name: "empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.Resolve(ctx, tt.slug)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("replaced_slug_is_not_reused", func(t *testing.T) {
+		other, err := pages.Get(ctx, fx.ID("slug-other"))
+		if err != nil {
+			t.Fatalf("failed to get page: %v", err)
+		}
+		if err := other.SetSlug(user, "slug-old"); err != nil {
+			t.Fatalf("failed to set slug: %v", err)
+		}
+		_, err = pages.Save(ctx, other)
+		testutil.EqualErr(t, dpage.ErrSlugTaken, err)
+	})
+}
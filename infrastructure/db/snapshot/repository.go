@@ -0,0 +1,123 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entlinksnapshot "github.com/naka-sei/tsudzuri/infrastructure/db/ent/linksnapshot"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type snapshotRepository struct {
+	conn *postgres.Connection
+}
+
+func NewSnapshotRepository(conn *postgres.Connection) dsnapshot.SnapshotRepository {
+	return &snapshotRepository{conn: conn}
+}
+
+// Get fetches a snapshot by ID.
+func (r *snapshotRepository) Get(ctx context.Context, id string) (*dsnapshot.Snapshot, error) {
+	if id == "" {
+		return nil, nil
+	}
+	sid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.LinkSnapshot.Get(ctx, sid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// ListByLink returns the snapshots of the link, newest first.
+func (r *snapshotRepository) ListByLink(ctx context.Context, linkID string) ([]*dsnapshot.Snapshot, error) {
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	list, err := client.LinkSnapshot.Query().
+		Where(entlinksnapshot.LinkItemIDEQ(lid)).
+		Order(ent.Desc(entlinksnapshot.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*dsnapshot.Snapshot, 0, len(list))
+	for _, s := range list {
+		snapshots = append(snapshots, entToDomain(s))
+	}
+	return snapshots, nil
+}
+
+// Create inserts the snapshot.
+func (r *snapshotRepository) Create(ctx context.Context, s *dsnapshot.Snapshot) (*dsnapshot.Snapshot, error) {
+	if s == nil {
+		return nil, errors.New("nil snapshot")
+	}
+	if s.ID() != "" {
+		return nil, errors.New("snapshot already created")
+	}
+	pageUUID, err := uuid.Parse(s.PageID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid page id: %w", err)
+	}
+	linkUUID, err := uuid.Parse(s.LinkID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid link id: %w", err)
+	}
+
+	create := r.conn.WriteDB(ctx).LinkSnapshot.Create().
+		SetPageID(pageUUID).
+		SetLinkItemID(linkUUID).
+		SetURL(s.URL()).
+		SetStorageKey(s.StorageKey()).
+		SetSize(s.Size())
+	if s.CreatedBy() != "" {
+		userUUID, err := uuid.Parse(s.CreatedBy())
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %w", err)
+		}
+		create = create.SetCreatedByID(userUUID)
+	}
+	if !s.CreatedAt().IsZero() {
+		create = create.SetCreatedAt(s.CreatedAt())
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entToDomain(created), nil
+}
+
+func entToDomain(s *ent.LinkSnapshot) *dsnapshot.Snapshot {
+	var createdBy string
+	if s.CreatedByID != nil {
+		createdBy = s.CreatedByID.String()
+	}
+	return dsnapshot.ReconstructSnapshot(
+		s.ID.String(),
+		s.PageID.String(),
+		s.LinkItemID.String(),
+		s.URL,
+		s.StorageKey,
+		s.Size,
+		createdBy,
+		s.CreatedAt,
+	)
+}
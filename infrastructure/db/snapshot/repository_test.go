@@ -0,0 +1,106 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+// newLinkPage registers a user and a page holding a single link with the given ID.
+func newLinkPage(fx *fixture.Fixture, uid string, title string, linkID string) {
+	user := duser.ReconstructUser("", uid, string(duser.ProviderAnonymous), nil)
+	fx.NewUser(user)
+	fx.NewPage(dpage.ReconstructPage("", title, *user, "INVITE01", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID(linkID)),
+	}, nil))
+}
+
+func TestSnapshotRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+
+	linkID := uuid.NewString()
+	fx := fixture.New()
+	newLinkPage(fx, "snapshot-user", "snapshot-page", linkID)
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	repo := NewSnapshotRepository(conn)
+	first := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	var created []*dsnapshot.Snapshot
+	for i, createdAt := range []time.Time{first, second} {
+		s := dsnapshot.ReconstructSnapshot("", fx.ID("snapshot-page"), linkID, "https://example.com",
+			fmt.Sprintf("snapshots/snapshot-%d.html", i), int64(100+i), fx.ID("snapshot-user"), createdAt)
+		got, err := repo.Create(ctx, s)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if got.ID() == "" {
+			t.Fatal("Create() returned a snapshot without ID")
+		}
+		want := dsnapshot.ReconstructSnapshot(got.ID(), s.PageID(), s.LinkID(), s.URL(), s.StorageKey(), s.Size(), s.CreatedBy(), createdAt)
+		if diff := cmp.Diff(want, got, cmp.AllowUnexported(dsnapshot.Snapshot{}), cmpopts.EquateApproxTime(time.Second)); diff != "" {
+			t.Fatalf("Create() mismatch (-want +got):\n%s", diff)
+		}
+		created = append(created, got)
+	}
+
+	t.Run("get", func(t *testing.T) {
+		got, err := repo.Get(ctx, created[0].ID())
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if diff := cmp.Diff(created[0], got, cmp.AllowUnexported(dsnapshot.Snapshot{}), cmpopts.EquateApproxTime(time.Second)); diff != "" {
+			t.Fatalf("Get() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("get_not_found", func(t *testing.T) {
+		got, err := repo.Get(ctx, uuid.NewString())
+		if err != nil || got != nil {
+			t.Fatalf("Get() = %v, %v, want nil, nil", got, err)
+		}
+	})
+
+	t.Run("get_invalid_id", func(t *testing.T) {
+		_, invalidUUIDErr := uuid.Parse("invalid")
+		_, err := repo.Get(ctx, "invalid")
+		testutil.EqualErr(t, invalidUUIDErr, err)
+	})
+
+	t.Run("list_newest_first", func(t *testing.T) {
+		got, err := repo.ListByLink(ctx, linkID)
+		if err != nil {
+			t.Fatalf("ListByLink() error = %v", err)
+		}
+		ids := make([]string, 0, len(got))
+		for _, s := range got {
+			ids = append(ids, s.ID())
+		}
+		if diff := cmp.Diff([]string{created[1].ID(), created[0].ID()}, ids); diff != "" {
+			t.Fatalf("ListByLink() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("list_other_link", func(t *testing.T) {
+		got, err := repo.ListByLink(ctx, uuid.NewString())
+		if err != nil || len(got) != 0 {
+			t.Fatalf("ListByLink() = %v, %v, want empty", got, err)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	enttemplate "github.com/naka-sei/tsudzuri/infrastructure/db/ent/template"
+	enttemplatelink "github.com/naka-sei/tsudzuri/infrastructure/db/ent/templatelink"
+	entuser "github.com/naka-sei/tsudzuri/infrastructure/db/ent/user"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type templateRepository struct {
+	conn *postgres.Connection
+}
+
+func NewTemplateRepository(conn *postgres.Connection) dtemplate.TemplateRepository {
+	return &templateRepository{conn: conn}
+}
+
+// Get fetches a template by ID with creator, links, and shared users.
+func (r *templateRepository) Get(ctx context.Context, id string) (*dtemplate.Template, error) {
+	if id == "" {
+		return nil, nil
+	}
+	tid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := r.query(client).
+		Where(enttemplate.IDEQ(tid)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.entToDomain(found)
+}
+
+// ListAvailable returns the templates created by the user and the shared templates available to the user,
+// newest first.
+func (r *templateRepository) ListAvailable(ctx context.Context, userID string) ([]*dtemplate.Template, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	list, err := r.query(client).
+		Where(enttemplate.Or(
+			enttemplate.CreatorIDEQ(uid),
+			enttemplate.And(
+				enttemplate.VisibilityEQ(enttemplate.VisibilityShared),
+				enttemplate.HasSharedUsersWith(entuser.IDEQ(uid)),
+			),
+		)).
+		Order(ent.Desc(enttemplate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*dtemplate.Template, 0, len(list))
+	for _, t := range list {
+		dt, err := r.entToDomain(t)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, dt)
+	}
+	return templates, nil
+}
+
+// Save inserts a template with its links and shared users. Templates are immutable once saved.
+func (r *templateRepository) Save(ctx context.Context, t *dtemplate.Template) (*dtemplate.Template, error) {
+	if t == nil {
+		return nil, errors.New("nil template")
+	}
+	if t.ID() != "" {
+		return nil, errors.New("template already saved")
+	}
+	client := r.conn.WriteDB(ctx)
+
+	creatorUUID, err := uuid.Parse(t.CreatedBy().ID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator id: %w", err)
+	}
+
+	sharedUUIDs := make([]uuid.UUID, 0, len(t.SharedUsers()))
+	for _, u := range t.SharedUsers() {
+		if u == nil {
+			return nil, errors.New("nil shared user")
+		}
+		parsed, err := uuid.Parse(u.ID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid shared user id: %w", err)
+		}
+		sharedUUIDs = append(sharedUUIDs, parsed)
+	}
+
+	create := client.Template.Create().
+		SetTitle(t.Title()).
+		SetCreatorID(creatorUUID).
+		SetVisibility(enttemplate.Visibility(t.Visibility()))
+	if len(sharedUUIDs) > 0 {
+		create = create.AddSharedUserIDs(sharedUUIDs...)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(dpage.Links, 0, len(t.Links()))
+	if len(t.Links()) > 0 {
+		builders := make([]*ent.TemplateLinkCreate, 0, len(t.Links()))
+		for _, l := range t.Links() {
+			b := client.TemplateLink.Create().
+				SetTemplateID(created.ID).
+				SetURL(l.URL()).
+				SetPriority(l.Priority())
+			if l.Memo() != "" {
+				b = b.SetMemo(l.Memo())
+			}
+			builders = append(builders, b)
+		}
+		rows, err := client.TemplateLink.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			links = append(links, templateLinkToDomain(row))
+		}
+	}
+
+	return dtemplate.ReconstructTemplate(
+		created.ID.String(),
+		t.Title(),
+		*t.CreatedBy(),
+		t.Visibility(),
+		links,
+		t.SharedUsers(),
+	), nil
+}
+
+func (r *templateRepository) query(client *ent.Client) *ent.TemplateQuery {
+	return client.Template.Query().
+		WithCreator().
+		WithTemplateLinks(func(q *ent.TemplateLinkQuery) { q.Order(ent.Asc(enttemplatelink.FieldPriority)) }).
+		WithSharedUsers()
+}
+
+// Helper: convert ent.Template to domain.Template.
+func (r *templateRepository) entToDomain(t *ent.Template) (*dtemplate.Template, error) {
+	if t == nil {
+		return nil, nil
+	}
+	if t.Edges.Creator == nil {
+		return nil, errors.New("template creator not loaded")
+	}
+	links := make(dpage.Links, 0, len(t.Edges.TemplateLinks))
+	for _, l := range t.Edges.TemplateLinks {
+		links = append(links, templateLinkToDomain(l))
+	}
+	shared := make(duser.Users, 0, len(t.Edges.SharedUsers))
+	for _, u := range t.Edges.SharedUsers {
+		shared = append(shared, entUserToDomain(u))
+	}
+	return dtemplate.ReconstructTemplate(
+		t.ID.String(),
+		t.Title,
+		*entUserToDomain(t.Edges.Creator),
+		dtemplate.Visibility(t.Visibility),
+		links,
+		shared,
+	), nil
+}
+
+func templateLinkToDomain(l *ent.TemplateLink) dpage.Link {
+	memo := ""
+	if l.Memo != nil {
+		memo = *l.Memo
+	}
+	return dpage.ReconstructLink(
+		l.URL,
+		memo,
+		l.Priority,
+		dpage.WithLinkID(l.ID.String()),
+		dpage.WithLinkCreatedAt(l.CreatedAt),
+	)
+}
+
+func entUserToDomain(u *ent.User) *duser.User {
+	return duser.ReconstructUser(u.ID.String(), u.UID, string(u.Provider), u.Email, duser.WithProfile(duser.ReconstructProfile(u.DisplayName, u.AvatarURL, u.Locale)))
+}
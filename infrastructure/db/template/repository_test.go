@@ -0,0 +1,278 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+	"github.com/naka-sei/tsudzuri/pkg/ptr"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func templateCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(dtemplate.Template{}, dpage.Link{}, duser.User{}, duser.Profile{}),
+		// Link IDs and creation times are generated by the database.
+		cmpopts.IgnoreFields(dpage.Link{}, "id", "createdAt"),
+		cmpopts.SortSlices(func(a, b *duser.User) bool { return a.UID() < b.UID() }),
+		cmpopts.EquateEmpty(),
+	}
+}
+
+func TestTemplateRepository_Get(t *testing.T) {
+	type args struct {
+		id string
+	}
+	type want struct {
+		template *dtemplate.Template
+		err      error
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		args    func(*fixture.Fixture) args
+		want    func(*fixture.Fixture) want
+	}{
+		{
+			name: "success",
+			prepare: func(fx *fixture.Fixture) {
+				creator := duser.ReconstructUser("", "tmpl-get-creator", string(duser.ProviderGoogle), ptr.Ptr("tmpl-get@example.com"))
+				member := duser.ReconstructUser("", "tmpl-get-member", string(duser.ProviderAnonymous), nil)
+				fx.NewUser(creator)
+				fx.NewUser(member)
+				fx.NewTemplate(dtemplate.ReconstructTemplate("", "tmpl-get", *creator, dtemplate.VisibilityShared, dpage.Links{
+					dpage.ReconstructLink("https://example.com/1", "first", 1),
+					dpage.ReconstructLink("https://example.com/2", "second", 2),
+				}, duser.Users{member}))
+			},
+			args: func(fx *fixture.Fixture) args { return args{id: fx.ID("tmpl-get")} },
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("tmpl-get-creator"), "tmpl-get-creator", string(duser.ProviderGoogle), ptr.Ptr("tmpl-get@example.com"))
+				member := duser.ReconstructUser(fx.ID("tmpl-get-member"), "tmpl-get-member", string(duser.ProviderAnonymous), nil)
+				return want{template: dtemplate.ReconstructTemplate(fx.ID("tmpl-get"), "tmpl-get", *creator, dtemplate.VisibilityShared, dpage.Links{
+					dpage.ReconstructLink("https://example.com/1", "first", 1),
+					dpage.ReconstructLink("https://example.com/2", "second", 2),
+				}, duser.Users{member})}
+			},
+		},
+		{
+			name: "empty_id",
+			args: func(fx *fixture.Fixture) args { return args{id: ""} },
+			want: func(fx *fixture.Fixture) want { return want{} },
+		},
+		{
+			name: "not_found",
+			args: func(fx *fixture.Fixture) args { return args{id: uuid.NewString()} },
+			want: func(fx *fixture.Fixture) want { return want{} },
+		},
+		{
+			name: "invalid_id",
+			args: func(fx *fixture.Fixture) args { return args{id: "invalid"} },
+			want: func(fx *fixture.Fixture) want {
+				return want{err: func() error {
+					_, invalidUUIDErr := uuid.Parse("invalid")
+					return invalidUUIDErr
+				}()}
+			},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			a := tt.args(fx)
+			w := tt.want(fx)
+
+			repo := NewTemplateRepository(conn)
+			got, err := repo.Get(ctx, a.id)
+			testutil.EqualErr(t, w.err, err)
+			if diff := cmp.Diff(w.template, got, templateCmpOpts()...); diff != "" {
+				t.Fatalf("template mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_ListAvailable(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+
+	fx := fixture.New()
+	owner := duser.ReconstructUser("", "tmpl-list-owner", string(duser.ProviderAnonymous), nil)
+	member := duser.ReconstructUser("", "tmpl-list-member", string(duser.ProviderAnonymous), nil)
+	fx.NewUser(owner)
+	fx.NewUser(member)
+	fx.NewTemplate(dtemplate.ReconstructTemplate("", "tmpl-list-private", *owner, dtemplate.VisibilityPrivate, nil, nil))
+	fx.NewTemplate(dtemplate.ReconstructTemplate("", "tmpl-list-shared", *owner, dtemplate.VisibilityShared, nil, duser.Users{member}))
+	fx.NewTemplate(dtemplate.ReconstructTemplate("", "tmpl-list-member-own", *member, dtemplate.VisibilityPrivate, nil, nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   []string
+		err    error
+	}{
+		{
+			name:   "owner_sees_own_templates",
+			userID: fx.ID("tmpl-list-owner"),
+			want:   []string{"tmpl-list-private", "tmpl-list-shared"},
+		},
+		{
+			name:   "member_sees_shared_and_own",
+			userID: fx.ID("tmpl-list-member"),
+			want:   []string{"tmpl-list-member-own", "tmpl-list-shared"},
+		},
+		{
+			name:   "invalid_user_id",
+			userID: "invalid",
+			err: func() error {
+				_, invalidUUIDErr := uuid.Parse("invalid")
+				return invalidUUIDErr
+			}(),
+		},
+	}
+
+	repo := NewTemplateRepository(conn)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.ListAvailable(ctx, tt.userID)
+			testutil.EqualErr(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			titles := make([]string, 0, len(got))
+			for _, tmpl := range got {
+				titles = append(titles, tmpl.Title())
+			}
+			if diff := cmp.Diff(tt.want, titles, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Fatalf("titles mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_Save(t *testing.T) {
+	type want struct {
+		template *dtemplate.Template
+		err      error
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(*fixture.Fixture)
+		args    func(*fixture.Fixture) *dtemplate.Template
+		want    func(*fixture.Fixture) want
+	}{
+		{
+			name: "create_success",
+			prepare: func(fx *fixture.Fixture) {
+				fx.NewUser(duser.ReconstructUser("", "tmpl-save-creator", string(duser.ProviderAnonymous), nil))
+				fx.NewUser(duser.ReconstructUser("", "tmpl-save-member", string(duser.ProviderAnonymous), nil))
+			},
+			args: func(fx *fixture.Fixture) *dtemplate.Template {
+				creator := duser.ReconstructUser(fx.ID("tmpl-save-creator"), "tmpl-save-creator", string(duser.ProviderAnonymous), nil)
+				member := duser.ReconstructUser(fx.ID("tmpl-save-member"), "tmpl-save-member", string(duser.ProviderAnonymous), nil)
+				return dtemplate.ReconstructTemplate("", "tmpl-save", *creator, dtemplate.VisibilityShared, dpage.Links{
+					dpage.ReconstructLink("https://save.com/1", "s1", 1),
+					dpage.ReconstructLink("https://save.com/2", "", 2),
+				}, duser.Users{member})
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("tmpl-save-creator"), "tmpl-save-creator", string(duser.ProviderAnonymous), nil)
+				member := duser.ReconstructUser(fx.ID("tmpl-save-member"), "tmpl-save-member", string(duser.ProviderAnonymous), nil)
+				return want{template: dtemplate.ReconstructTemplate("", "tmpl-save", *creator, dtemplate.VisibilityShared, dpage.Links{
+					dpage.ReconstructLink("https://save.com/1", "s1", 1),
+					dpage.ReconstructLink("https://save.com/2", "", 2),
+				}, duser.Users{member})}
+			},
+		},
+		{
+			// The title limit counts characters, not bytes.
+			name: "create_multibyte_title",
+			prepare: func(fx *fixture.Fixture) {
+				fx.NewUser(duser.ReconstructUser("", "tmpl-save-multibyte", string(duser.ProviderAnonymous), nil))
+			},
+			args: func(fx *fixture.Fixture) *dtemplate.Template {
+				creator := duser.ReconstructUser(fx.ID("tmpl-save-multibyte"), "tmpl-save-multibyte", string(duser.ProviderAnonymous), nil)
+				return dtemplate.ReconstructTemplate("", strings.Repeat("旅", dpage.MaxTitleLength), *creator, dtemplate.VisibilityPrivate, nil, nil)
+			},
+			want: func(fx *fixture.Fixture) want {
+				creator := duser.ReconstructUser(fx.ID("tmpl-save-multibyte"), "tmpl-save-multibyte", string(duser.ProviderAnonymous), nil)
+				return want{template: dtemplate.ReconstructTemplate("", strings.Repeat("旅", dpage.MaxTitleLength), *creator, dtemplate.VisibilityPrivate, nil, nil)}
+			},
+		},
+		{
+			name: "already_saved",
+			args: func(fx *fixture.Fixture) *dtemplate.Template {
+				creator := duser.ReconstructUser(uuid.NewString(), "tmpl-save-x", string(duser.ProviderAnonymous), nil)
+				return dtemplate.ReconstructTemplate(uuid.NewString(), "tmpl-saved", *creator, dtemplate.VisibilityPrivate, nil, nil)
+			},
+			want: func(fx *fixture.Fixture) want { return want{err: errors.New("template already saved")} },
+		},
+		{
+			name: "nil_template",
+			args: func(fx *fixture.Fixture) *dtemplate.Template { return nil },
+			want: func(fx *fixture.Fixture) want { return want{err: errors.New("nil template")} },
+		},
+	}
+
+	ctx := context.Background()
+	cmpOpts := append(templateCmpOpts(), cmpopts.IgnoreFields(dtemplate.Template{}, "id"))
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conn := postgres.SetupTestDBConnection(t)
+			fx := fixture.New()
+			if tt.prepare != nil {
+				tt.prepare(fx)
+			}
+			if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+				t.Fatalf("failed to setup fixture: %v", err)
+			}
+
+			repo := NewTemplateRepository(conn)
+			w := tt.want(fx)
+
+			res, err := repo.Save(ctx, tt.args(fx))
+			testutil.EqualErr(t, w.err, err)
+			if w.err != nil {
+				return
+			}
+
+			got, err := repo.Get(ctx, res.ID())
+			if err != nil {
+				t.Fatalf("failed to get saved template: %v", err)
+			}
+			if diff := cmp.Diff(w.template, got, cmpOpts...); diff != "" {
+				t.Fatalf("saved template mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
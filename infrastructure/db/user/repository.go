@@ -72,7 +72,7 @@ func (r *userRepository) List(ctx context.Context, options ...duser.SearchOption
 }
 
 // Save creates or updates a user.
-// Create when user.ID() == ""; update provider/email when existing.
+// Create when user.ID() == ""; update provider/email/profile when existing.
 func (r *userRepository) Save(ctx context.Context, user *duser.User) (*duser.User, error) {
 	if user == nil {
 		return nil, errors.New("nil user")
@@ -83,6 +83,9 @@ func (r *userRepository) Save(ctx context.Context, user *duser.User) (*duser.Use
 			SetUID(user.UID()).
 			SetProvider(entuser.Provider(user.Provider())).
 			SetNillableEmail(user.Email()).
+			SetDisplayName(user.Profile().DisplayName()).
+			SetAvatarURL(user.Profile().AvatarURL()).
+			SetLocale(user.Profile().Locale()).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -98,7 +101,10 @@ func (r *userRepository) Save(ctx context.Context, user *duser.User) (*duser.Use
 	upd := client.User.UpdateOneID(uid).
 		SetUID(user.UID()).
 		SetProvider(entuser.Provider(user.Provider())).
-		SetNillableEmail(user.Email())
+		SetNillableEmail(user.Email()).
+		SetDisplayName(user.Profile().DisplayName()).
+		SetAvatarURL(user.Profile().AvatarURL()).
+		SetLocale(user.Profile().Locale())
 	if _, err := upd.Save(ctx); err != nil {
 		return nil, err
 	}
@@ -117,10 +123,18 @@ func (r *userRepository) entToDomain(u *ent.User) *duser.User {
 			joinedIDs = append(joinedIDs, p.ID.String())
 		}
 	}
-	return duser.ReconstructUser(u.ID.String(), u.UID, string(u.Provider), u.Email, duser.WithJoinedPageIDs(joinedIDs))
+	return duser.ReconstructUser(
+		u.ID.String(), u.UID, string(u.Provider), u.Email,
+		duser.WithProfile(duser.ReconstructProfile(u.DisplayName, u.AvatarURL, u.Locale)),
+		duser.WithJoinedPageIDs(joinedIDs),
+	)
 }
 
 // setUserID reconstructs user with new ID.
 func (r *userRepository) setUserID(u *duser.User, id string) {
-	*u = *duser.ReconstructUser(id, u.UID(), string(u.Provider()), u.Email(), duser.WithJoinedPageIDs(u.JoinedPageIDs()))
+	*u = *duser.ReconstructUser(
+		id, u.UID(), string(u.Provider()), u.Email(),
+		duser.WithProfile(u.Profile()),
+		duser.WithJoinedPageIDs(u.JoinedPageIDs()),
+	)
 }
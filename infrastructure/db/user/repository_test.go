@@ -17,7 +17,7 @@ import (
 )
 
 func userCmpOpts() []cmp.Option {
-	return []cmp.Option{cmp.AllowUnexported(duser.User{}), cmpopts.EquateEmpty()}
+	return []cmp.Option{cmp.AllowUnexported(duser.User{}, duser.Profile{}), cmpopts.EquateEmpty()}
 }
 
 func TestUserRepository_Get(t *testing.T) {
@@ -43,6 +43,19 @@ func TestUserRepository_Get(t *testing.T) {
 				return want{user: duser.ReconstructUser(fx.ID("uid-get"), "uid-get", string(duser.ProviderGoogle), ptr.Ptr("g@example.com"))}
 			},
 		},
+		{
+			name: "success_with_profile",
+			prepare: func(fx *fixture.Fixture) {
+				profile := duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja-JP")
+				u := duser.ReconstructUser("", "uid-profile", string(duser.ProviderGoogle), ptr.Ptr("p@example.com"), duser.WithProfile(profile))
+				fx.NewUser(u)
+			},
+			args: args{id: "uid-profile"},
+			want: func(fx *fixture.Fixture) want {
+				profile := duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja-JP")
+				return want{user: duser.ReconstructUser(fx.ID("uid-profile"), "uid-profile", string(duser.ProviderGoogle), ptr.Ptr("p@example.com"), duser.WithProfile(profile))}
+			},
+		},
 		{
 			name: "success_with_joined_pages",
 			prepare: func(fx *fixture.Fixture) {
@@ -217,6 +230,20 @@ func TestUserRepository_Save(t *testing.T) {
 				return want{user: duser.ReconstructUser(fx.ID("uid-save-2"), "uid-save-2", string(duser.ProviderFacebook), ptr.Ptr("after@example.com"))}
 			},
 		},
+		{
+			name: "update_profile",
+			prepare: func(fx *fixture.Fixture) {
+				fx.NewUser(duser.ReconstructUser("", "uid-save-4", string(duser.ProviderGoogle), ptr.Ptr("profile@example.com")))
+			},
+			args: func(fx *fixture.Fixture) args {
+				profile := duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja")
+				return args{user: duser.ReconstructUser(fx.ID("uid-save-4"), "uid-save-4", string(duser.ProviderGoogle), ptr.Ptr("profile@example.com"), duser.WithProfile(profile))}
+			},
+			want: func(fx *fixture.Fixture) want {
+				profile := duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja")
+				return want{user: duser.ReconstructUser(fx.ID("uid-save-4"), "uid-save-4", string(duser.ProviderGoogle), ptr.Ptr("profile@example.com"), duser.WithProfile(profile))}
+			},
+		},
 		{
 			name: "invalid_id_update",
 			args: func(fx *fixture.Fixture) args {
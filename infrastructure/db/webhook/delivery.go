@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entdelivery "github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhookdelivery"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type deliveryRepository struct {
+	conn *postgres.Connection
+}
+
+func NewDeliveryRepository(conn *postgres.Connection) dwebhook.DeliveryRepository {
+	return &deliveryRepository{conn: conn}
+}
+
+// Add stores the delivery and drops the oldest deliveries of its webhook beyond dwebhook.MaxDeliveries.
+func (r *deliveryRepository) Add(ctx context.Context, d *dwebhook.Delivery) error {
+	if d == nil {
+		return errors.New("nil delivery")
+	}
+	wid, err := uuid.Parse(d.WebhookID())
+	if err != nil {
+		return fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	create := client.WebhookDelivery.Create().
+		SetWebhookID(wid).
+		SetEventID(d.EventID()).
+		SetEventType(string(d.EventType())).
+		SetAttempt(d.Attempt()).
+		SetStatusCode(d.StatusCode()).
+		SetError(d.Error()).
+		SetDurationMs(d.Duration().Milliseconds())
+	if !d.CreatedAt().IsZero() {
+		create = create.SetCreatedAt(d.CreatedAt()).SetUpdatedAt(d.CreatedAt())
+	}
+	if err := create.Exec(ctx); err != nil {
+		return err
+	}
+
+	// IDs are UUIDv7, so they sort by creation.
+	stale, err := client.WebhookDelivery.Query().
+		Where(entdelivery.WebhookIDEQ(wid)).
+		Order(ent.Desc(entdelivery.FieldID)).
+		Offset(dwebhook.MaxDeliveries).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	_, err = client.WebhookDelivery.Delete().
+		Where(entdelivery.IDIn(stale...)).
+		Exec(ctx)
+	return err
+}
+
+// ListByWebhookID returns the deliveries of the webhook, newest first, at most limit of them.
+func (r *deliveryRepository) ListByWebhookID(ctx context.Context, webhookID string, limit int) ([]*dwebhook.Delivery, error) {
+	wid, err := uuid.Parse(webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	query := client.WebhookDelivery.Query().
+		Where(entdelivery.WebhookIDEQ(wid)).
+		Order(ent.Desc(entdelivery.FieldID))
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*dwebhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, deliveryToDomain(row))
+	}
+	return deliveries, nil
+}
+
+func deliveryToDomain(d *ent.WebhookDelivery) *dwebhook.Delivery {
+	return dwebhook.ReconstructDelivery(
+		d.ID.String(),
+		d.WebhookID.String(),
+		d.EventID,
+		dwebhook.EventType(d.EventType),
+		d.Attempt,
+		d.StatusCode,
+		d.Error,
+		time.Duration(d.DurationMs)*time.Millisecond,
+		d.CreatedAt,
+	)
+}
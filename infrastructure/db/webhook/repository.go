@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/ent"
+	entwebhook "github.com/naka-sei/tsudzuri/infrastructure/db/ent/webhook"
+
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+type webhookRepository struct {
+	conn *postgres.Connection
+}
+
+func NewWebhookRepository(conn *postgres.Connection) dwebhook.WebhookRepository {
+	return &webhookRepository{conn: conn}
+}
+
+// Get fetches a webhook by ID. Returns (nil, nil) if not found or id empty.
+func (r *webhookRepository) Get(ctx context.Context, id string) (*dwebhook.Webhook, error) {
+	if id == "" {
+		return nil, nil
+	}
+	wid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	found, err := client.Webhook.Get(ctx, wid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entToDomain(found), nil
+}
+
+// ListByPageID returns the webhooks of the page, oldest first.
+func (r *webhookRepository) ListByPageID(ctx context.Context, pageID string) ([]*dwebhook.Webhook, error) {
+	pid, err := uuid.Parse(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page id: %w", err)
+	}
+
+	client := r.conn.ReadOnlyDB(ctx)
+	rows, err := client.Webhook.Query().
+		Where(entwebhook.PageIDEQ(pid)).
+		Order(ent.Asc(entwebhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*dwebhook.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhooks = append(webhooks, entToDomain(row))
+	}
+	return webhooks, nil
+}
+
+// Save inserts a new webhook or updates the settings and the failures of an existing one.
+func (r *webhookRepository) Save(ctx context.Context, w *dwebhook.Webhook) (*dwebhook.Webhook, error) {
+	if w == nil {
+		return nil, errors.New("nil webhook")
+	}
+	client := r.conn.WriteDB(ctx)
+
+	events := make([]string, 0, len(w.Events()))
+	for _, e := range w.Events() {
+		events = append(events, string(e))
+	}
+
+	if w.ID() == "" { // create
+		pageUUID, err := uuid.Parse(w.PageID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid page id: %w", err)
+		}
+		creatorUUID, err := uuid.Parse(w.CreatedByID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid creator id: %w", err)
+		}
+		create := client.Webhook.Create().
+			SetPageID(pageUUID).
+			SetCreatedByID(creatorUUID).
+			SetURL(w.URL()).
+			SetSecret(w.Secret()).
+			SetEvents(events).
+			SetFailureCount(w.FailureCount()).
+			SetNillableDisabledAt(w.DisabledAt())
+		if !w.CreatedAt().IsZero() {
+			create = create.SetCreatedAt(w.CreatedAt()).SetUpdatedAt(w.UpdatedAt())
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return entToDomain(created), nil
+	}
+
+	wid, err := uuid.Parse(w.ID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook id: %w", err)
+	}
+	update := client.Webhook.UpdateOneID(wid).
+		SetURL(w.URL()).
+		SetEvents(events).
+		SetFailureCount(w.FailureCount())
+	if w.DisabledAt() != nil {
+		update = update.SetDisabledAt(*w.DisabledAt())
+	} else {
+		update = update.ClearDisabledAt()
+	}
+	if !w.UpdatedAt().IsZero() {
+		update = update.SetUpdatedAt(w.UpdatedAt())
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entToDomain(updated), nil
+}
+
+// Delete removes the webhook. Its deliveries are removed by the foreign key.
+func (r *webhookRepository) Delete(ctx context.Context, id string) error {
+	wid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid webhook id: %w", err)
+	}
+
+	client := r.conn.WriteDB(ctx)
+	_, err = client.Webhook.Delete().
+		Where(entwebhook.IDEQ(wid)).
+		Exec(ctx)
+	return err
+}
+
+func entToDomain(w *ent.Webhook) *dwebhook.Webhook {
+	events := make([]dwebhook.EventType, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, dwebhook.EventType(e))
+	}
+	return dwebhook.ReconstructWebhook(
+		w.ID.String(),
+		w.PageID.String(),
+		w.URL,
+		w.Secret,
+		events,
+		w.CreatedByID.String(),
+		dwebhook.WithFailures(w.FailureCount, w.DisabledAt),
+		dwebhook.WithTimestamps(w.CreatedAt, w.UpdatedAt),
+	)
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/fixture"
+	"github.com/naka-sei/tsudzuri/infrastructure/db/postgres"
+)
+
+var createdAt = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func webhookCmpOpts() []cmp.Option {
+	return []cmp.Option{
+		cmp.AllowUnexported(dwebhook.Webhook{}, dwebhook.Delivery{}),
+		// IDs are generated by the repository.
+		cmpopts.IgnoreFields(dwebhook.Webhook{}, "id"),
+		cmpopts.IgnoreFields(dwebhook.Delivery{}, "id"),
+		cmpopts.EquateApproxTime(0),
+		cmpopts.EquateEmpty(),
+	}
+}
+
+func TestWebhookRepository(t *testing.T) {
+	ctx := context.Background()
+	conn := postgres.SetupTestDBConnection(t)
+	fx := fixture.New()
+	user := duser.ReconstructUser("", "webhook-repo-user", string(duser.ProviderGoogle), nil)
+	fx.NewUser(user)
+	fx.NewPage(dpage.ReconstructPage("", "webhook-repo-page", *user, "WEBHOOK1", nil, nil))
+	if err := fx.Setup(ctx, t, conn.ReadOnlyDB(ctx)); err != nil {
+		t.Fatalf("failed to setup fixture: %v", err)
+	}
+
+	pageID := fx.ID("webhook-repo-page")
+	userID := fx.ID("webhook-repo-user")
+	repo := NewWebhookRepository(conn)
+
+	newWebhook := func(url string, events []dwebhook.EventType) *dwebhook.Webhook {
+		return dwebhook.ReconstructWebhook("", pageID, url, "whsec_secret", events, userID,
+			dwebhook.WithTimestamps(createdAt, createdAt))
+	}
+	first, err := repo.Save(ctx, newWebhook("https://example.com/1", []dwebhook.EventType{dwebhook.EventLinkAdded}))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := repo.Save(ctx, newWebhook("https://example.com/2", nil)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := repo.ListByPageID(ctx, pageID)
+	if err != nil {
+		t.Fatalf("ListByPageID() error = %v", err)
+	}
+	want := []*dwebhook.Webhook{
+		newWebhook("https://example.com/1", []dwebhook.EventType{dwebhook.EventLinkAdded}),
+		newWebhook("https://example.com/2", nil),
+	}
+	if diff := cmp.Diff(want, got, webhookCmpOpts()...); diff != "" {
+		t.Fatalf("ListByPageID() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Failures and disabling are persisted on update.
+	for i := 0; i < dwebhook.MaxConsecutiveFailures; i++ {
+		first.RecordDelivery(false, createdAt)
+	}
+	if _, err := repo.Save(ctx, first); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	reloaded, err := repo.Get(ctx, first.ID())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if reloaded.Active() || reloaded.FailureCount() != dwebhook.MaxConsecutiveFailures {
+		t.Fatalf("Get() = active %v failures %d, want disabled after %d failures",
+			reloaded.Active(), reloaded.FailureCount(), dwebhook.MaxConsecutiveFailures)
+	}
+
+	deliveries := NewDeliveryRepository(conn)
+	for i := 1; i <= dwebhook.MaxDeliveries+2; i++ {
+		d := dwebhook.NewDelivery(first, fmt.Sprintf("event-%d", i), dwebhook.EventLinkAdded, 1, 500,
+			errors.New("status 500"), time.Second, createdAt.Add(time.Duration(i)*time.Second))
+		if err := deliveries.Add(ctx, d); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	recent, err := deliveries.ListByWebhookID(ctx, first.ID(), 0)
+	if err != nil {
+		t.Fatalf("ListByWebhookID() error = %v", err)
+	}
+	if len(recent) != dwebhook.MaxDeliveries {
+		t.Fatalf("ListByWebhookID() returned %d deliveries, want %d", len(recent), dwebhook.MaxDeliveries)
+	}
+	wantNewest := dwebhook.ReconstructDelivery("", first.ID(), fmt.Sprintf("event-%d", dwebhook.MaxDeliveries+2),
+		dwebhook.EventLinkAdded, 1, 500, "status 500", time.Second, createdAt.Add(time.Duration(dwebhook.MaxDeliveries+2)*time.Second))
+	if diff := cmp.Diff(wantNewest, recent[0], webhookCmpOpts()...); diff != "" {
+		t.Fatalf("ListByWebhookID() newest mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := repo.Delete(ctx, first.ID()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, err := repo.Get(ctx, first.ID()); err != nil || got != nil {
+		t.Fatalf("Get() after Delete() = %v, %v, want nil, nil", got, err)
+	}
+	if got, err := deliveries.ListByWebhookID(ctx, first.ID(), 10); err != nil || len(got) != 0 {
+		t.Fatalf("ListByWebhookID() after Delete() = %d deliveries, %v, want none", len(got), err)
+	}
+}
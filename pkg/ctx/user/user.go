@@ -25,3 +25,17 @@ func UserFromContext(ctx context.Context) (*duser.User, bool) {
 	}
 	return nil, false
 }
+
+// claimedProfileCtxKey is the context key for the profile claimed by the identity provider.
+type claimedProfileCtxKey struct{}
+
+// WithClaimedProfile adds the profile claimed by the identity provider to the context.
+func WithClaimedProfile(ctx context.Context, profile duser.Profile) context.Context {
+	return context.WithValue(ctx, claimedProfileCtxKey{}, profile)
+}
+
+// ClaimedProfileFromContext retrieves the profile claimed by the identity provider from the context.
+func ClaimedProfileFromContext(ctx context.Context) (duser.Profile, bool) {
+	p, ok := ctx.Value(claimedProfileCtxKey{}).(duser.Profile)
+	return p, ok
+}
@@ -83,3 +83,40 @@ func TestUserFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimedProfileFromContext(t *testing.T) {
+	type want struct {
+		profile duser.Profile
+		ok      bool
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want want
+	}{
+		{
+			name: "with_profile",
+			ctx:  WithClaimedProfile(context.Background(), duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja")),
+			want: want{profile: duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja"), ok: true},
+		},
+		{
+			name: "without_profile",
+			ctx:  context.Background(),
+			want: want{profile: duser.Profile{}, ok: false},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ClaimedProfileFromContext(tt.ctx)
+			if ok != tt.want.ok {
+				t.Fatalf("ok mismatch: want %v, got %v", tt.want.ok, ok)
+			}
+			if diff := cmp.Diff(tt.want.profile, got, cmp.AllowUnexported(duser.Profile{})); diff != "" {
+				t.Fatalf("profile mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,22 +2,41 @@ package auth
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	"github.com/naka-sei/tsudzuri/infrastructure/api/firebase"
 	"github.com/naka-sei/tsudzuri/pkg/cache"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/presentation/errcode"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
+// accessTokenDeniedMethods are the methods a personal access token cannot call, so that a leaked token
+// cannot be used to sign in or to issue more tokens.
+var accessTokenDeniedMethods = []string{
+	tsudzuriv1.TsudzuriService_CreateUser_FullMethodName,
+	tsudzuriv1.TsudzuriService_Login_FullMethodName,
+	tsudzuriv1.TsudzuriService_CreateAccessToken_FullMethodName,
+	tsudzuriv1.TsudzuriService_ListAccessTokens_FullMethodName,
+	tsudzuriv1.TsudzuriService_RevokeAccessToken_FullMethodName,
+}
+
+// readMethodPrefixes are the name prefixes of the methods that only read, which the read scope allows.
+var readMethodPrefixes = []string{"Get", "List", "Export"}
+
 // NewAuthenticationUnaryServerInterceptor creates a new gRPC unary server interceptor for authentication.
+// The bearer token is either a Firebase ID token or a personal access token, which is told apart by its prefix.
 func NewAuthenticationUnaryServerInterceptor(
 	authenticator firebase.Authenticator,
 	userRepo duser.UserRepository,
+	accessTokenRepo daccesstoken.AccessTokenRepository,
 	userCache cache.Cache[*duser.User],
 ) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
@@ -31,10 +50,19 @@ func NewAuthenticationUnaryServerInterceptor(
 			return nil, errcode.ToGRPCStatus(duser.ErrUserNotFound)
 		}
 
+		if daccesstoken.IsAccessToken(idToken) {
+			accessToken, user, err := authenticateAccessToken(ctx, accessTokenRepo, userRepo, idToken, info.FullMethod)
+			if err != nil {
+				return nil, errcode.ToGRPCStatus(err)
+			}
+			return handler(withAccessToken(ctxuser.WithUser(ctx, user), accessToken), req)
+		}
+
 		token, err := authenticator.VerifyIDToken(ctx, idToken)
 		if err != nil {
 			return nil, errcode.ToGRPCStatus(duser.ErrUserNotFound)
 		}
+		ctx = ctxuser.WithClaimedProfile(ctx, firebase.ProfileClaims(token))
 
 		if info.FullMethod == tsudzuriv1.TsudzuriService_CreateUser_FullMethodName {
 			return handler(ctxuser.WithUser(ctx, duser.NewUser(token.UID)), req)
@@ -62,6 +90,62 @@ func NewAuthenticationUnaryServerInterceptor(
 	}
 }
 
+// authenticateAccessToken resolves the user of a personal access token and checks that the token allows the method.
+// The pages named in the request are checked by the page scope interceptor once their slugs are resolved.
+func authenticateAccessToken(
+	ctx context.Context,
+	accessTokenRepo daccesstoken.AccessTokenRepository,
+	userRepo duser.UserRepository,
+	rawToken string,
+	fullMethod string,
+) (*daccesstoken.AccessToken, *duser.User, error) {
+	accessToken, err := accessTokenRepo.GetByTokenHash(ctx, daccesstoken.HashToken(rawToken))
+	if err != nil {
+		return nil, nil, err
+	}
+	if accessToken == nil {
+		return nil, nil, duser.ErrUserNotFound
+	}
+
+	now := ctxtime.Now(ctx)
+	if err := accessToken.Authenticate(now); err != nil {
+		return nil, nil, err
+	}
+	if slices.Contains(accessTokenDeniedMethods, fullMethod) {
+		return nil, nil, daccesstoken.ErrInsufficientScope
+	}
+	if err := accessToken.PermitsScope(methodScope(fullMethod)); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := userRepo.List(ctx, duser.WithIDs([]string{accessToken.UserID()}))
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, duser.ErrUserNotFound
+	}
+
+	if accessToken.MarkUsed(now) {
+		// Recording the use is best effort and must not fail the request.
+		if _, err := accessTokenRepo.Save(ctx, accessToken); err != nil {
+			log.LoggerFromContext(ctx).Sugar().Warnf("failed to record use of access token %s: %v", accessToken.ID(), err)
+		}
+	}
+	return accessToken, user, nil
+}
+
+// methodScope returns the scope an access token needs to call the method.
+func methodScope(fullMethod string) daccesstoken.Scope {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return daccesstoken.ScopeRead
+		}
+	}
+	return daccesstoken.ScopeWrite
+}
+
 func extractAuthorization(md metadata.MD) string {
 	values := md.Get("authorization")
 	if len(values) == 0 {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"firebase.google.com/go/v4/auth"
 	"go.uber.org/mock/gomock"
@@ -12,6 +13,9 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	mockaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken/mock/mock_accesstoken"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	mockuser "github.com/naka-sei/tsudzuri/domain/user/mock/mock_user"
 	mockauthenticator "github.com/naka-sei/tsudzuri/infrastructure/api/firebase/mock/mock_authenticator"
@@ -24,11 +28,22 @@ func TestNewAuthenticationUnaryServerInterceptor(t *testing.T) {
 	email := "test@example.com"
 	testUser := duser.ReconstructUser("test-id", "test-uid", "google", &email)
 	testToken := &auth.Token{UID: "test-uid"}
+	rawAccessToken := daccesstoken.TokenPrefix + "secret"
+	accessTokenHash := daccesstoken.HashToken(rawAccessToken)
+	expiredAt := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAccessToken := func(scopes []daccesstoken.Scope, pageIDs []string, options ...daccesstoken.ReconstructOption) *daccesstoken.AccessToken {
+		return daccesstoken.ReconstructAccessToken("token-1", "test-id", "bot", accessTokenHash, "hint", scopes, pageIDs, options...)
+	}
+	accessTokenCtx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "Bearer " + rawAccessToken,
+	}))
+	addLinkInfo := &grpc.UnaryServerInfo{FullMethod: tsudzuriv1.TsudzuriService_AddLink_FullMethodName}
 
 	type fields struct {
-		authenticator *mockauthenticator.MockAuthenticator
-		userRepo      *mockuser.MockUserRepository
-		cache         *mockcache.MockCache[*duser.User]
+		authenticator   *mockauthenticator.MockAuthenticator
+		userRepo        *mockuser.MockUserRepository
+		accessTokenRepo *mockaccesstoken.MockAccessTokenRepository
+		cache           *mockcache.MockCache[*duser.User]
 	}
 
 	type args struct {
@@ -258,6 +273,126 @@ func TestNewAuthenticationUnaryServerInterceptor(t *testing.T) {
 				expectUserInCtx: true,
 			},
 		},
+		{
+			name: "access_token_for_allowed_page_should_succeed_and_record_use",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(newAccessToken(daccesstoken.Scopes(), []string{"page-1"}), nil)
+				f.userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testUser, nil)
+				f.accessTokenRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.AddLinkRequest{PageId: "page-1"},
+				info:     addLinkInfo,
+				useCache: true,
+			},
+			want: want{
+				hasErr:          false,
+				expectUserInCtx: true,
+			},
+		},
+		{
+			name: "unknown_access_token_should_return_unauthenticated",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(nil, nil)
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.AddLinkRequest{PageId: "page-1"},
+				info:     addLinkInfo,
+				useCache: true,
+			},
+			want: want{
+				hasErr:  true,
+				errCode: codes.Unauthenticated,
+			},
+		},
+		{
+			name: "expired_access_token_should_return_unauthenticated",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(newAccessToken(daccesstoken.Scopes(), nil, daccesstoken.WithExpiresAt(&expiredAt)), nil)
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.AddLinkRequest{PageId: "page-1"},
+				info:     addLinkInfo,
+				useCache: true,
+			},
+			want: want{
+				hasErr:  true,
+				errCode: codes.Unauthenticated,
+			},
+		},
+		{
+			name: "read_only_access_token_should_not_write",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(newAccessToken([]daccesstoken.Scope{daccesstoken.ScopeRead}, nil), nil)
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.AddLinkRequest{PageId: "page-1"},
+				info:     addLinkInfo,
+				useCache: true,
+			},
+			want: want{
+				hasErr:  true,
+				errCode: codes.PermissionDenied,
+			},
+		},
+		{
+			name: "read_only_access_token_should_read",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(newAccessToken([]daccesstoken.Scope{daccesstoken.ScopeRead}, nil), nil)
+				f.userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testUser, nil)
+				f.accessTokenRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.GetPageRequest{PageId: "page-1"},
+				info:     &grpc.UnaryServerInfo{FullMethod: tsudzuriv1.TsudzuriService_GetPage_FullMethodName},
+				useCache: true,
+			},
+			want: want{
+				hasErr:          false,
+				expectUserInCtx: true,
+			},
+		},
+		{
+			name: "access_token_should_not_issue_access_tokens",
+			setup: func(f *fields) {
+				f.accessTokenRepo.EXPECT().
+					GetByTokenHash(gomock.Any(), accessTokenHash).
+					Return(newAccessToken(daccesstoken.Scopes(), nil), nil)
+			},
+			args: args{
+				ctx:      accessTokenCtx,
+				req:      &tsudzuriv1.CreateAccessTokenRequest{Name: "another"},
+				info:     &grpc.UnaryServerInfo{FullMethod: tsudzuriv1.TsudzuriService_CreateAccessToken_FullMethodName},
+				useCache: true,
+			},
+			want: want{
+				hasErr:  true,
+				errCode: codes.PermissionDenied,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -267,9 +402,10 @@ func TestNewAuthenticationUnaryServerInterceptor(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := &fields{
-				authenticator: mockauthenticator.NewMockAuthenticator(ctrl),
-				userRepo:      mockuser.NewMockUserRepository(ctrl),
-				cache:         mockcache.NewMockCache[*duser.User](ctrl),
+				authenticator:   mockauthenticator.NewMockAuthenticator(ctrl),
+				userRepo:        mockuser.NewMockUserRepository(ctrl),
+				accessTokenRepo: mockaccesstoken.NewMockAccessTokenRepository(ctrl),
+				cache:           mockcache.NewMockCache[*duser.User](ctrl),
 			}
 
 			if tt.setup != nil {
@@ -284,6 +420,7 @@ func TestNewAuthenticationUnaryServerInterceptor(t *testing.T) {
 			interceptor := NewAuthenticationUnaryServerInterceptor(
 				f.authenticator,
 				f.userRepo,
+				f.accessTokenRepo,
 				cacheUnderTest,
 			)
 
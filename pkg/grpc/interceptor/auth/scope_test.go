@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+)
+
+func TestNewPageScopeUnaryServerInterceptor(t *testing.T) {
+	newAccessToken := func(pageIDs []string) *daccesstoken.AccessToken {
+		return daccesstoken.ReconstructAccessToken("token-1", "test-id", "bot", "hash", "hint", daccesstoken.Scopes(), pageIDs)
+	}
+	moveLinksInfo := &grpc.UnaryServerInfo{FullMethod: tsudzuriv1.TsudzuriService_MoveLinks_FullMethodName}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     any
+		want    codes.Code
+		handled bool
+	}{
+		{
+			name:    "without_access_token",
+			ctx:     context.Background(),
+			req:     &tsudzuriv1.MoveLinksRequest{SourcePageId: "page-1", TargetPageId: "page-2"},
+			want:    codes.OK,
+			handled: true,
+		},
+		{
+			name:    "unrestricted_access_token",
+			ctx:     withAccessToken(context.Background(), newAccessToken(nil)),
+			req:     &tsudzuriv1.MoveLinksRequest{SourcePageId: "page-1", TargetPageId: "page-2"},
+			want:    codes.OK,
+			handled: true,
+		},
+		{
+			name:    "page_scoped_access_token_for_allowed_pages",
+			ctx:     withAccessToken(context.Background(), newAccessToken([]string{"page-1", "page-2"})),
+			req:     &tsudzuriv1.MoveLinksRequest{SourcePageId: "page-1", TargetPageId: "page-2"},
+			want:    codes.OK,
+			handled: true,
+		},
+		{
+			name: "page_scoped_access_token_should_not_access_other_pages",
+			ctx:  withAccessToken(context.Background(), newAccessToken([]string{"page-1"})),
+			req:  &tsudzuriv1.MoveLinksRequest{SourcePageId: "page-1", TargetPageId: "page-2"},
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "page_scoped_access_token_should_name_a_page",
+			ctx:  withAccessToken(context.Background(), newAccessToken([]string{"page-1"})),
+			req:  &tsudzuriv1.ListPagesRequest{},
+			want: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var handled bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				handled = true
+				return "success", nil
+			}
+
+			_, err := NewPageScopeUnaryServerInterceptor()(tt.ctx, tt.req, moveLinksInfo, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("expected code %v, got %v (%v)", tt.want, got, err)
+			}
+			if handled != tt.handled {
+				t.Fatalf("handler called = %v, want %v", handled, tt.handled)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package slug
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/presentation/errcode"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+// pageIDFields are the request fields that name pages. Each of them accepts a slug in place of the page ID.
+var pageIDFields = []protoreflect.Name{"page_id", "source_page_id", "target_page_id", "page_ids"}
+
+// NewSlugUnaryServerInterceptor creates a new gRPC unary server interceptor that replaces page slugs in the
+// request with the IDs of their pages, so that handlers and the access token page scope check only ever see page IDs.
+// It runs after authentication, so that unauthenticated callers cannot look slugs up, and before the page scope check.
+func NewSlugUnaryServerInterceptor(slugRepo dpage.SlugRepository) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if m, ok := req.(proto.Message); ok {
+			if err := resolveSlugs(ctx, slugRepo, m.ProtoReflect()); err != nil {
+				return nil, errcode.ToGRPCStatus(err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// resolveSlugs replaces the slugs in the top level page ID fields of the message.
+func resolveSlugs(ctx context.Context, slugRepo dpage.SlugRepository, m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	for _, name := range pageIDFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				id, err := resolve(ctx, slugRepo, list.Get(i).String())
+				if err != nil {
+					return err
+				}
+				list.Set(i, protoreflect.ValueOfString(id))
+			}
+			continue
+		}
+		value := m.Get(fd).String()
+		id, err := resolve(ctx, slugRepo, value)
+		if err != nil {
+			return err
+		}
+		if id != value {
+			m.Set(fd, protoreflect.ValueOfString(id))
+		}
+	}
+	return nil
+}
+
+// resolve returns the ID of the page the value names. Values that are empty or already page IDs are returned as is.
+func resolve(ctx context.Context, slugRepo dpage.SlugRepository, value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	if _, err := uuid.Parse(value); err == nil {
+		return value, nil
+	}
+	slug := dpage.NormalizeSlug(value)
+	if err := dpage.ValidateSlug(slug); err != nil {
+		return "", upage.ErrPageNotFound
+	}
+	id, err := slugRepo.Resolve(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", upage.ErrPageNotFound
+	}
+	return id, nil
+}
@@ -0,0 +1,130 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	"github.com/naka-sei/tsudzuri/presentation/errcode"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestNewSlugUnaryServerInterceptor(t *testing.T) {
+	const (
+		pageID  = "0190c7e0-0000-7000-8000-000000000001"
+		otherID = "0190c7e0-0000-7000-8000-000000000002"
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: tsudzuriv1.TsudzuriService_GetPage_FullMethodName}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mockpage.MockSlugRepository)
+		req     any
+		wantReq any
+		wantErr error
+	}{
+		{
+			name: "slug",
+			setup: func(m *mockpage.MockSlugRepository) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(pageID, nil)
+			},
+			req:     &tsudzuriv1.GetPageRequest{PageId: "Trip-2026", LinkOrder: "votes"},
+			wantReq: &tsudzuriv1.GetPageRequest{PageId: pageID, LinkOrder: "votes"},
+		},
+		{
+			name:    "page_id",
+			req:     &tsudzuriv1.GetPageRequest{PageId: pageID},
+			wantReq: &tsudzuriv1.GetPageRequest{PageId: pageID},
+		},
+		{
+			name:    "no_page",
+			req:     &tsudzuriv1.ListPagesRequest{IncludeArchived: true},
+			wantReq: &tsudzuriv1.ListPagesRequest{IncludeArchived: true},
+		},
+		{
+			name: "source_and_target",
+			setup: func(m *mockpage.MockSlugRepository) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(pageID, nil)
+			},
+			req:     &tsudzuriv1.MoveLinksRequest{SourcePageId: "trip-2026", TargetPageId: otherID, LinkIds: []string{"link-1"}},
+			wantReq: &tsudzuriv1.MoveLinksRequest{SourcePageId: pageID, TargetPageId: otherID, LinkIds: []string{"link-1"}},
+		},
+		{
+			name: "page_ids",
+			setup: func(m *mockpage.MockSlugRepository) {
+				m.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return(otherID, nil)
+			},
+			req:     &tsudzuriv1.ReorderMyPagesRequest{PageIds: []string{pageID, "abcdefgh23"}},
+			wantReq: &tsudzuriv1.ReorderMyPagesRequest{PageIds: []string{pageID, otherID}},
+		},
+		{
+			name: "unknown_slug",
+			setup: func(m *mockpage.MockSlugRepository) {
+				m.EXPECT().Resolve(gomock.Any(), "unknown").Return("", nil)
+			},
+			req:     &tsudzuriv1.GetPageRequest{PageId: "unknown"},
+			wantErr: errcode.ToGRPCStatus(upage.ErrPageNotFound),
+		},
+		{
+			name:    "invalid_slug",
+			req:     &tsudzuriv1.GetPageRequest{PageId: "not a slug"},
+			wantErr: errcode.ToGRPCStatus(upage.ErrPageNotFound),
+		},
+		{
+			name: "resolve_error",
+			setup: func(m *mockpage.MockSlugRepository) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("", errors.New("resolve error"))
+			},
+			req:     &tsudzuriv1.GetPageRequest{PageId: "trip-2026"},
+			wantErr: errcode.ToGRPCStatus(errors.New("resolve error")),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			slugRepo := mockpage.NewMockSlugRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(slugRepo)
+			}
+
+			var gotReq any
+			handler := func(ctx context.Context, req any) (any, error) {
+				gotReq = req
+				return "ok", nil
+			}
+			interceptor := NewSlugUnaryServerInterceptor(slugRepo)
+			res, err := interceptor(context.Background(), tt.req, info, handler)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				if gotReq != nil {
+					t.Fatal("handler should not be called")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res != "ok" {
+				t.Fatalf("response = %v, want ok", res)
+			}
+			if diff := cmp.Diff(tt.wantReq.(proto.Message), gotReq.(proto.Message), protocmp.Transform()); diff != "" {
+				t.Fatalf("request mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/infrastructure/api/firebase"
+	"github.com/naka-sei/tsudzuri/pkg/cache"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+)
+
+// NewOptionalAuthenticationMiddleware puts the signed in user into the request context when the request carries
+// a Firebase ID token as its bearer token. Requests without a token, with an invalid one or of unknown users are
+// served anonymously, so it suits endpoints that work for everyone, like link redirects.
+func NewOptionalAuthenticationMiddleware(
+	authenticator firebase.Authenticator,
+	userRepo duser.UserRepository,
+	userCache cache.Cache[*duser.User],
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idToken := bearerToken(r)
+			if idToken == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			token, err := authenticator.VerifyIDToken(ctx, idToken)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if userCache != nil {
+				if cachedUser, ok := userCache.Get(ctx, token.UID); ok {
+					next.ServeHTTP(w, r.WithContext(ctxuser.WithUser(ctx, cachedUser)))
+					return
+				}
+			}
+
+			user, err := userRepo.Get(ctx, token.UID)
+			if err != nil {
+				log.LoggerFromContext(ctx).Sugar().Warnf("failed to get user of the request: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if user == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if userCache != nil {
+				userCache.Set(ctx, token.UID, user)
+			}
+			next.ServeHTTP(w, r.WithContext(ctxuser.WithUser(ctx, user)))
+		})
+	}
+}
+
+// bearerToken returns the token of the Authorization header with or without the Bearer prefix.
+func bearerToken(r *http.Request) string {
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	if len(header) >= len("bearer") && strings.EqualFold(header[:len("bearer")], "bearer") {
+		rest := header[len("bearer"):]
+		if rest == "" || (rest[0] != ' ' && rest[0] != '\t') {
+			return ""
+		}
+		return strings.TrimSpace(rest)
+	}
+	return header
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"firebase.google.com/go/v4/auth"
+	"go.uber.org/mock/gomock"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	mockuser "github.com/naka-sei/tsudzuri/domain/user/mock/mock_user"
+	mockauthenticator "github.com/naka-sei/tsudzuri/infrastructure/api/firebase/mock/mock_authenticator"
+	mockcache "github.com/naka-sei/tsudzuri/pkg/cache/mock/mock_cache"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+)
+
+func TestNewOptionalAuthenticationMiddleware(t *testing.T) {
+	type fields struct {
+		authenticator *mockauthenticator.MockAuthenticator
+		userRepo      *mockuser.MockUserRepository
+		cache         *mockcache.MockCache[*duser.User]
+	}
+
+	testUser := duser.ReconstructUser("test-id", "test-uid", "anonymous", nil)
+	testToken := &auth.Token{UID: "test-uid"}
+
+	tests := []struct {
+		name          string
+		authorization string
+		setup         func(f *fields)
+		want          *duser.User
+	}{
+		{
+			name: "no_token",
+		},
+		{
+			name:          "valid_token",
+			authorization: "Bearer id-token",
+			setup: func(f *fields) {
+				f.authenticator.EXPECT().VerifyIDToken(gomock.Any(), "id-token").Return(testToken, nil)
+				f.cache.EXPECT().Get(gomock.Any(), "test-uid").Return(nil, false)
+				f.userRepo.EXPECT().Get(gomock.Any(), "test-uid").Return(testUser, nil)
+				f.cache.EXPECT().Set(gomock.Any(), "test-uid", testUser)
+			},
+			want: testUser,
+		},
+		{
+			name:          "cached_user",
+			authorization: "bearer   id-token ",
+			setup: func(f *fields) {
+				f.authenticator.EXPECT().VerifyIDToken(gomock.Any(), "id-token").Return(testToken, nil)
+				f.cache.EXPECT().Get(gomock.Any(), "test-uid").Return(testUser, true)
+			},
+			want: testUser,
+		},
+		{
+			name:          "invalid_token_is_anonymous",
+			authorization: "Bearer id-token",
+			setup: func(f *fields) {
+				f.authenticator.EXPECT().VerifyIDToken(gomock.Any(), "id-token").Return(nil, errors.New("invalid token"))
+			},
+		},
+		{
+			name:          "unknown_user_is_anonymous",
+			authorization: "Bearer id-token",
+			setup: func(f *fields) {
+				f.authenticator.EXPECT().VerifyIDToken(gomock.Any(), "id-token").Return(testToken, nil)
+				f.cache.EXPECT().Get(gomock.Any(), "test-uid").Return(nil, false)
+				f.userRepo.EXPECT().Get(gomock.Any(), "test-uid").Return(nil, nil)
+			},
+		},
+		{
+			name:          "repository_error_is_anonymous",
+			authorization: "Bearer id-token",
+			setup: func(f *fields) {
+				f.authenticator.EXPECT().VerifyIDToken(gomock.Any(), "id-token").Return(testToken, nil)
+				f.cache.EXPECT().Get(gomock.Any(), "test-uid").Return(nil, false)
+				f.userRepo.EXPECT().Get(gomock.Any(), "test-uid").Return(nil, errors.New("get error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := &fields{
+				authenticator: mockauthenticator.NewMockAuthenticator(ctrl),
+				userRepo:      mockuser.NewMockUserRepository(ctrl),
+				cache:         mockcache.NewMockCache[*duser.User](ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			var (
+				called bool
+				got    *duser.User
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				got, _ = ctxuser.UserFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/r/link-1", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			NewOptionalAuthenticationMiddleware(f.authenticator, f.userRepo, f.cache)(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			if !called {
+				t.Fatal("next handler was not called")
+			}
+			if got != tt.want {
+				t.Fatalf("user in context = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/naka-sei/tsudzuri/pkg/log"
+)
+
+// NewLoggerMiddleware puts a request scoped logger into the request context so that
+// plain HTTP handlers can log the same way gRPC handlers do.
+func NewLoggerMiddleware(logger *zap.Logger, projectID string) func(http.Handler) http.Handler {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestLogger := logger.With(zap.String("http.method", r.Method), zap.String("http.path", r.URL.Path))
+			ctx := log.NewLoggerContext(r.Context(), requestLogger, projectID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package publicnet
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{name: "public_ipv4", address: "93.184.216.34:443"},
+		{name: "public_ipv6", address: "[2606:2800:220:1:248:1893:25c8:1946]:443"},
+		{name: "loopback_ipv4", address: "127.0.0.1:80", wantErr: true},
+		{name: "loopback_ipv6", address: "[::1]:80", wantErr: true},
+		{name: "private", address: "10.0.0.1:80", wantErr: true},
+		{name: "link_local_metadata", address: "169.254.169.254:80", wantErr: true},
+		{name: "unspecified", address: "0.0.0.0:80", wantErr: true},
+		{name: "this_network", address: "0.1.2.3:80", wantErr: true},
+		{name: "carrier_grade_nat", address: "100.64.0.1:80", wantErr: true},
+		{name: "carrier_grade_nat_end", address: "100.127.255.254:80", wantErr: true},
+		{name: "benchmarking", address: "198.18.0.1:80", wantErr: true},
+		{name: "benchmarking_end", address: "198.19.255.254:80", wantErr: true},
+		{name: "documentation", address: "192.0.2.1:80", wantErr: true},
+		{name: "multicast", address: "224.0.0.1:80", wantErr: true},
+		{name: "broadcast", address: "255.255.255.255:80", wantErr: true},
+		{name: "reserved", address: "240.0.0.1:80", wantErr: true},
+		{name: "ipv4_mapped_loopback", address: "[::ffff:127.0.0.1]:80", wantErr: true},
+		{name: "ipv6_unique_local", address: "[fd00::1]:80", wantErr: true},
+		{name: "ipv6_link_local", address: "[fe80::1]:80", wantErr: true},
+		{name: "nat64", address: "[64:ff9b::a00:1]:80", wantErr: true},
+		{name: "6to4", address: "[2002:a00:1::1]:80", wantErr: true},
+		{name: "public_next_to_carrier_grade_nat", address: "100.128.0.1:443"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := Control("tcp", tt.address, nil)
+			if tt.wantErr != errors.Is(err, ErrNotPublicAddress) {
+				t.Fatalf("Control(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTransport_refusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	t.Cleanup(server.Close)
+
+	transport := NewTransport()
+	if transport.Proxy != nil {
+		t.Fatal("NewTransport() uses a proxy")
+	}
+	_, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if !errors.Is(err, ErrNotPublicAddress) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrNotPublicAddress)
+	}
+}
@@ -0,0 +1,186 @@
+// Package markdown renders a small, safe subset of Markdown to HTML.
+//
+// Raw HTML in the source is never passed through: every piece of text is escaped and only the
+// tags produced by the renderer itself reach the output. Links are kept only for http, https and
+// mailto URLs. Supported syntax is headings, paragraphs, block quotes, ordered and unordered lists,
+// fenced code blocks, code spans, **strong**, *emphasis*, [links](https://example.com) and backslash escapes.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})[ \t]+(.*?)[ \t#]*$`)
+	unorderedPattern   = regexp.MustCompile(`^[-*+][ \t]+(.*)$`)
+	orderedPattern     = regexp.MustCompile(`^\d{1,9}[.)][ \t]+(.*)$`)
+	fencePattern       = regexp.MustCompile("^(```|~~~)")
+	blockquotePattern  = regexp.MustCompile(`^>[ \t]?(.*)$`)
+	allowedLinkSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+)
+
+// Render converts the Markdown source to sanitized HTML.
+func Render(src string) string {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(lines); {
+		line := strings.TrimRight(lines[i], " \t")
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+		case fencePattern.MatchString(line):
+			i = renderCodeBlock(&b, lines, i)
+		case headingPattern.MatchString(line):
+			m := headingPattern.FindStringSubmatch(line)
+			level := string(rune('0' + len(m[1])))
+			b.WriteString("<h" + level + ">" + renderInline(m[2]) + "</h" + level + ">\n")
+			i++
+		case blockquotePattern.MatchString(line):
+			i = renderBlock(&b, lines, i, blockquotePattern, "<blockquote><p>", "</p></blockquote>\n")
+		case unorderedPattern.MatchString(line):
+			i = renderList(&b, lines, i, unorderedPattern, "ul")
+		case orderedPattern.MatchString(line):
+			i = renderList(&b, lines, i, orderedPattern, "ol")
+		default:
+			i = renderParagraph(&b, lines, i)
+		}
+	}
+	return b.String()
+}
+
+// renderCodeBlock writes the fenced code block starting at lines[start] and returns the index after it.
+// An unclosed fence runs to the end of the source.
+func renderCodeBlock(b *strings.Builder, lines []string, start int) int {
+	fence := fencePattern.FindString(lines[start])
+	var code []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+	b.WriteString("<pre><code>")
+	if len(code) > 0 {
+		b.WriteString(html.EscapeString(strings.Join(code, "\n")) + "\n")
+	}
+	b.WriteString("</code></pre>\n")
+	return i
+}
+
+// renderBlock writes consecutive lines matching pattern as one block and returns the index after them.
+func renderBlock(b *strings.Builder, lines []string, start int, pattern *regexp.Regexp, open, close string) int {
+	var parts []string
+	i := start
+	for ; i < len(lines) && pattern.MatchString(lines[i]); i++ {
+		parts = append(parts, renderInline(strings.TrimSpace(pattern.FindStringSubmatch(lines[i])[1])))
+	}
+	b.WriteString(open + strings.Join(parts, "<br>\n") + close)
+	return i
+}
+
+// renderList writes consecutive list items matching pattern and returns the index after them.
+func renderList(b *strings.Builder, lines []string, start int, pattern *regexp.Regexp, tag string) int {
+	b.WriteString("<" + tag + ">\n")
+	i := start
+	for ; i < len(lines) && pattern.MatchString(lines[i]); i++ {
+		b.WriteString("<li>" + renderInline(strings.TrimSpace(pattern.FindStringSubmatch(lines[i])[1])) + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+	return i
+}
+
+// renderParagraph writes lines up to the next blank line or block element as a paragraph
+// and returns the index after them. Line breaks inside the paragraph are kept.
+func renderParagraph(b *strings.Builder, lines []string, start int) int {
+	var parts []string
+	i := start
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || (i > start && startsBlock(line)) {
+			break
+		}
+		parts = append(parts, renderInline(line))
+	}
+	b.WriteString("<p>" + strings.Join(parts, "<br>\n") + "</p>\n")
+	return i
+}
+
+func startsBlock(line string) bool {
+	return fencePattern.MatchString(line) ||
+		headingPattern.MatchString(line) ||
+		blockquotePattern.MatchString(line) ||
+		unorderedPattern.MatchString(line) ||
+		orderedPattern.MatchString(line)
+}
+
+// renderInline converts the inline syntax of s to HTML, escaping everything else.
+func renderInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_[]()#+-.!>", s[i+1]) >= 0:
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+			continue
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				b.WriteString("<code>" + html.EscapeString(s[i+1:i+1+end]) + "</code>")
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(s[i:], "**"):
+			if end := strings.Index(s[i+2:], "**"); end > 0 {
+				b.WriteString("<strong>" + renderInline(s[i+2:i+2+end]) + "</strong>")
+				i += end + 4
+				continue
+			}
+		case c == '*':
+			if end := strings.IndexByte(s[i+1:], '*'); end > 0 {
+				b.WriteString("<em>" + renderInline(s[i+1:i+1+end]) + "</em>")
+				i += end + 2
+				continue
+			}
+		case c == '[':
+			if text, href, n, ok := parseLink(s[i:]); ok {
+				if safe, ok := safeURL(href); ok {
+					b.WriteString(`<a href="` + html.EscapeString(safe) + `" rel="nofollow noopener noreferrer">` + renderInline(text) + "</a>")
+				} else {
+					b.WriteString(renderInline(text))
+				}
+				i += n
+				continue
+			}
+		}
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+// parseLink parses "[text](href)" at the start of s and returns its parts and length.
+func parseLink(s string) (text string, href string, n int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if closeText < 0 {
+		return "", "", 0, false
+	}
+	closeHref := strings.IndexByte(s[closeText+2:], ')')
+	if closeHref < 0 {
+		return "", "", 0, false
+	}
+	return s[1:closeText], strings.TrimSpace(s[closeText+2 : closeText+2+closeHref]), closeText + 3 + closeHref, true
+}
+
+// safeURL reports whether href is an absolute URL with an allowed scheme and returns it normalized.
+func safeURL(href string) (string, bool) {
+	u, err := url.Parse(href)
+	if err != nil || !allowedLinkSchemes[strings.ToLower(u.Scheme)] {
+		return "", false
+	}
+	return u.String(), true
+}
@@ -0,0 +1,88 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "empty",
+			src:  "",
+			want: "",
+		},
+		{
+			name: "paragraphs_and_line_breaks",
+			src:  "first line\nsecond line\n\nnext paragraph",
+			want: "<p>first line<br>\nsecond line</p>\n<p>next paragraph</p>\n",
+		},
+		{
+			name: "heading",
+			src:  "## Trip to *Kyoto* ##",
+			want: "<h2>Trip to <em>Kyoto</em></h2>\n",
+		},
+		{
+			name: "inline",
+			src:  "**must** read `a<b` and \\*not emphasis\\*",
+			want: "<p><strong>must</strong> read <code>a&lt;b</code> and *not emphasis*</p>\n",
+		},
+		{
+			name: "lists",
+			src:  "- one\n- two\n1. first\n2) second",
+			want: "<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n<ol>\n<li>first</li>\n<li>second</li>\n</ol>\n",
+		},
+		{
+			name: "blockquote",
+			src:  "> quoted\n> more",
+			want: "<blockquote><p>quoted<br>\nmore</p></blockquote>\n",
+		},
+		{
+			name: "code_block",
+			src:  "```go\nif a < b {\n}\n```\nafter",
+			want: "<pre><code>if a &lt; b {\n}\n</code></pre>\n<p>after</p>\n",
+		},
+		{
+			name: "link",
+			src:  "[docs](https://example.com/a?b=1&c=\"2\")",
+			want: "<p><a href=\"https://example.com/a?b=1&amp;c=&#34;2&#34;\" rel=\"nofollow noopener noreferrer\">docs</a></p>\n",
+		},
+		{
+			name: "unsafe_link_keeps_text_only",
+			src:  "[click](javascript:alert(1)) [rel](/relative)",
+			want: "<p>click) rel</p>\n",
+		},
+		{
+			name: "raw_html_is_escaped",
+			src:  "<script>alert('x')</script>\n<img src=x onerror=alert(1)>",
+			want: "<p>&lt;script&gt;alert(&#39;x&#39;)&lt;/script&gt;<br>\n&lt;img src=x onerror=alert(1)&gt;</p>\n",
+		},
+		{
+			name: "html_in_link_text_is_escaped",
+			src:  "[<b>bold</b>](https://example.com)",
+			want: "<p><a href=\"https://example.com\" rel=\"nofollow noopener noreferrer\">&lt;b&gt;bold&lt;/b&gt;</a></p>\n",
+		},
+		{
+			name: "japanese",
+			src:  "# 旅行\n行きたい場所",
+			want: "<h1>旅行</h1>\n<p>行きたい場所</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, Render(tt.src)); diff != "" {
+				t.Fatalf("Render() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
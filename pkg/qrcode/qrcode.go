@@ -0,0 +1,120 @@
+// Package qrcode renders QR codes as PNG or SVG images with a configurable size and error correction level.
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+var (
+	// ErrInvalidSize is returned when the image size is out of the supported range.
+	ErrInvalidSize = errors.New("invalid QR code size")
+	// ErrInvalidLevel is returned when the error correction level is unknown.
+	ErrInvalidLevel = errors.New("invalid QR code error correction level")
+)
+
+const (
+	// MinSize is the smallest width and height of an image in pixels.
+	MinSize = 64
+	// MaxSize is the largest width and height of an image in pixels.
+	MaxSize = 1024
+	// DefaultSize is used when no size is given.
+	DefaultSize = 256
+)
+
+// Level is the error correction level, the share of the code that can be damaged or covered and still be read.
+// Higher levels make denser codes for the same content.
+type Level string
+
+const (
+	LevelLow      Level = "L" // About 7% can be restored.
+	LevelMedium   Level = "M" // About 15% can be restored.
+	LevelQuartile Level = "Q" // About 25% can be restored.
+	LevelHigh     Level = "H" // About 30% can be restored.
+
+	// DefaultLevel is used when no level is given.
+	DefaultLevel = LevelMedium
+)
+
+var recoveryLevels = map[Level]goqrcode.RecoveryLevel{
+	LevelLow:      goqrcode.Low,
+	LevelMedium:   goqrcode.Medium,
+	LevelQuartile: goqrcode.High,
+	LevelHigh:     goqrcode.Highest,
+}
+
+// Options configures the rendered image. Zero values fall back to DefaultSize and DefaultLevel.
+type Options struct {
+	// Size is the width and height of the image in pixels, including the quiet zone around the code.
+	Size int
+	// Level is the error correction level. It is matched without case.
+	Level Level
+}
+
+// PNG renders the content as a black on white PNG image.
+func PNG(content string, opts Options) ([]byte, error) {
+	code, size, err := encode(content, opts)
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(size)
+}
+
+// SVG renders the content as a black on white SVG image. Dark modules are drawn as one path so the image stays small.
+func SVG(content string, opts Options) ([]byte, error) {
+	code, size, err := encode(content, opts)
+	if err != nil {
+		return nil, err
+	}
+	bitmap := code.Bitmap()
+	modules := len(bitmap)
+
+	var path strings.Builder
+	for y, row := range bitmap {
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&path, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, modules, modules)
+	buf.WriteString(`<rect width="100%" height="100%" fill="#fff"/>`)
+	fmt.Fprintf(&buf, `<path fill="#000" d="%s"/>`, path.String())
+	buf.WriteString("</svg>\n")
+	return buf.Bytes(), nil
+}
+
+func encode(content string, opts Options) (*goqrcode.QRCode, int, error) {
+	size := opts.Size
+	if size == 0 {
+		size = DefaultSize
+	}
+	if size < MinSize || size > MaxSize {
+		return nil, 0, ErrInvalidSize
+	}
+	level := Level(strings.ToUpper(string(opts.Level)))
+	if level == "" {
+		level = DefaultLevel
+	}
+	recovery, ok := recoveryLevels[level]
+	if !ok {
+		return nil, 0, ErrInvalidLevel
+	}
+	code, err := goqrcode.New(content, recovery)
+	if err != nil {
+		return nil, 0, err
+	}
+	return code, size, nil
+}
@@ -0,0 +1,108 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+const content = "https://tsudzuri.app/join?code=INVITE01&page_id=0190c7e0-0000-7000-8000-000000000001"
+
+func TestPNG(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     Options
+		wantSize int
+		wantErr  error
+	}{
+		{name: "defaults", wantSize: DefaultSize},
+		{name: "smallest", opts: Options{Size: MinSize, Level: LevelLow}, wantSize: MinSize},
+		{name: "largest", opts: Options{Size: MaxSize, Level: LevelHigh}, wantSize: MaxSize},
+		{name: "lowercase_level", opts: Options{Size: 300, Level: "q"}, wantSize: 300},
+		{name: "too_small", opts: Options{Size: MinSize - 1}, wantErr: ErrInvalidSize},
+		{name: "too_large", opts: Options{Size: MaxSize + 1}, wantErr: ErrInvalidSize},
+		{name: "negative", opts: Options{Size: -4}, wantErr: ErrInvalidSize},
+		{name: "unknown_level", opts: Options{Level: "X"}, wantErr: ErrInvalidLevel},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := PNG(content, tt.opts)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+			img, err := png.Decode(bytes.NewReader(got))
+			if err != nil {
+				t.Fatalf("failed to decode PNG: %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != tt.wantSize || b.Dy() != tt.wantSize {
+				t.Fatalf("image size = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.wantSize, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestSVG(t *testing.T) {
+	t.Parallel()
+
+	got, err := SVG(content, Options{Size: 200, Level: LevelQuartile})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var doc struct {
+		XMLName xml.Name `xml:"svg"`
+		Width   string   `xml:"width,attr"`
+		Height  string   `xml:"height,attr"`
+		ViewBox string   `xml:"viewBox,attr"`
+		Path    struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+	}
+	if err := xml.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("invalid SVG: %v", err)
+	}
+	if doc.Width != "200" || doc.Height != "200" {
+		t.Fatalf("size = %sx%s, want 200x200", doc.Width, doc.Height)
+	}
+	if doc.Path.D == "" {
+		t.Fatal("no modules drawn")
+	}
+
+	// The quiet zone is left white, so the first dark module is the corner of the top left finder pattern.
+	var modules int
+	if _, err := fmt.Sscanf(doc.ViewBox, "0 0 %d", &modules); err != nil {
+		t.Fatalf("unexpected viewBox %q: %v", doc.ViewBox, err)
+	}
+	// The smallest code is 21 modules wide, with 4 modules of quiet zone on each side.
+	if modules < 29 {
+		t.Fatalf("viewBox %q is smaller than any QR code", doc.ViewBox)
+	}
+	if !strings.HasPrefix(doc.Path.D, "M4 4h7v1h-7z") {
+		t.Fatalf("path should start with the finder pattern, got %q", doc.Path.D[:min(len(doc.Path.D), 32)])
+	}
+
+	// A higher level encodes the same content in more modules.
+	low, err := SVG(content, Options{Level: LevelLow})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	high, err := SVG(content, Options{Level: LevelHigh})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(high) <= len(low) {
+		t.Fatalf("level H image (%d bytes) should be larger than level L image (%d bytes)", len(high), len(low))
+	}
+
+	_, err = SVG(content, Options{Size: MaxSize + 1})
+	testutil.EqualErr(t, ErrInvalidSize, err)
+}
@@ -0,0 +1,44 @@
+package signature
+
+import (
+	"testing"
+
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	signer, err := NewSigner([]byte("secret"))
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+	other, err := NewSigner([]byte("other"))
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		signed string
+		want   string
+		err    error
+	}{
+		{name: "valid", signed: signer.Sign("value"), want: "value"},
+		{name: "other_key", signed: other.Sign("value"), err: ErrInvalidSignature},
+		{name: "tampered_value", signed: "other" + signer.Sign("value")[len("value"):], err: ErrInvalidSignature},
+		{name: "no_signature", signed: "value", err: ErrInvalidSignature},
+		{name: "bad_encoding", signed: "value.!!", err: ErrInvalidSignature},
+		{name: "empty", signed: "", err: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := signer.Verify(tt.signed)
+			testutil.EqualErr(t, tt.err, err)
+			if got != tt.want {
+				t.Fatalf("Verify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,10 +4,26 @@ import (
 	"errors"
 	"fmt"
 
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/presentation/grpc/pagination"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
 	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
 	uuser "github.com/naka-sei/tsudzuri/usecase/user"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -75,11 +91,151 @@ func GetErrorReason(err error) *ErrorReason {
 			ErrorCode: CodePageInvalidParameter,
 			Message:   "ページ作成者は招待コードによる参加を行う必要はありません。",
 		}
+	case errors.Is(err, dpage.ErrNoLinksProvided):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "対象のリンクを1つ以上指定してください。",
+		}
+	case errors.Is(err, dpage.ErrNoPageProvided):
+		return &ErrorReason{
+			ErrorCode: CodePageInternalError,
+			Message:   "ページ情報を取得できませんでした。再度お試しください。",
+		}
+	case errors.Is(err, dpage.ErrSamePage):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "移動元と移動先に同じページは指定できません。",
+		}
+	case errors.Is(err, dpage.ErrInvalidFeedToken):
+		return &ErrorReason{
+			ErrorCode: CodePageAuthorizationFailed,
+			Message:   "フィードのトークンが正しくないため、フィードを表示できません。",
+		}
+	case errors.Is(err, dpage.ErrNoSectionNameProvided):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "セクション名を入力してください。",
+		}
+	case errors.Is(err, dpage.ErrSectionNameTooLong):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "セクション名は50文字以内で入力してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidSectionsLength):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "セクションの数が現在のページのセクション数と一致しません。再度お試しください。",
+		}
+	case errors.Is(err, dpage.ErrNotFoundSection):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "指定されたセクションが見つかりません。",
+		}
+	case errors.Is(err, dpage.ErrInvalidReaction):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "リアクションには絵文字を1つ指定してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidLinkOrder):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "リンクの並び順は priority または votes を指定してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidLinkStatus):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "リンクの状態は unread、read、done のいずれかを指定してください。",
+		}
+	case errors.Is(err, dpage.ErrNotChecklist):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "チェックリストモードではない綴りのリンクは完了にできません。",
+		}
+	case errors.Is(err, dpage.ErrPageArchived):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "アーカイブされた綴りは変更できません。",
+		}
+	case errors.Is(err, dpage.ErrInvalidPageOrder):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "並び順には参加している綴りを重複なく指定してください。",
+		}
+	case errors.Is(err, dpage.ErrNotPreferenceOwner):
+		return &ErrorReason{
+			ErrorCode: CodePageAuthorizationFailed,
+			Message:   "他のユーザーの設定は変更できません。",
+		}
+	case errors.Is(err, dpage.ErrTitleTooLong):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "タイトルは50文字以内で入力してください。",
+		}
+	case errors.Is(err, dpage.ErrDescriptionTooLong):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "説明は2000文字以内で入力してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidIcon):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "アイコンには絵文字を1つ指定してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidColor):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "カラーは #rrggbb 形式で指定してください。",
+		}
+	case errors.Is(err, dpage.ErrInvalidCaptureURL):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "取り込むURLは http または https で始まる2048文字以内のURLを指定してください。",
+		}
+	case errors.Is(err, dpage.ErrNoLinkRedirect):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "リンクのリダイレクトが検出されていません。",
+		}
+	case errors.Is(err, dpage.ErrInvalidSlug):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "スラッグは3〜30文字の英小文字と数字で、単語をハイフン1つで区切って指定してください。",
+		}
+	case errors.Is(err, dpage.ErrSlugTaken):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "指定されたスラッグは他のページで使用されています。",
+		}
 	case errors.Is(err, upage.ErrPageNotFound):
 		return &ErrorReason{
 			ErrorCode: CodePageInvalidParameter,
 			Message:   "指定されたページが見つかりません。ページIDを確認してください。",
 		}
+	case errors.Is(err, upage.ErrUnsupportedExportFormat):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "指定されたエクスポート形式には対応していません。",
+		}
+	case errors.Is(err, upage.ErrUnsupportedQRCodeFormat):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "指定されたQRコードの形式には対応していません。",
+		}
+	case errors.Is(err, qrcode.ErrInvalidSize):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   fmt.Sprintf("QRコードのサイズは%d〜%dピクセルで指定してください。", qrcode.MinSize, qrcode.MaxSize),
+		}
+	case errors.Is(err, qrcode.ErrInvalidLevel):
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   "QRコードの誤り訂正レベルは L, M, Q, H のいずれかを指定してください。",
+		}
+	case errors.As(err, &pageUserErr) && pageUserErr.URL == "":
+		return &ErrorReason{
+			ErrorCode: CodePageInvalidParameter,
+			Message:   fmt.Sprintf("ページに存在しないリンクのため、操作を実行できません。リンクID: %s", pageUserErr.ID),
+		}
 	case errors.As(err, &pageUserErr):
 		return &ErrorReason{
 			ErrorCode: CodePageInvalidParameter,
@@ -87,6 +243,219 @@ func GetErrorReason(err error) *ErrorReason {
 		}
 	}
 
+	var templateVisibilityErr *dtemplate.InvalidVisibilityError
+
+	switch {
+	case errors.As(err, &templateVisibilityErr):
+		return &ErrorReason{
+			ErrorCode: CodeTemplateInvalidParameter,
+			Message:   "テンプレートの公開範囲は private または shared を指定してください。",
+		}
+	case errors.Is(err, dtemplate.ErrTemplateNotAccessible):
+		return &ErrorReason{
+			ErrorCode: CodeTemplateAuthorizationFailed,
+			Message:   "このテンプレートを利用する権限がありません。",
+		}
+	case errors.Is(err, upage.ErrTemplateNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeTemplateInvalidParameter,
+			Message:   "指定されたテンプレートが見つかりません。テンプレートIDを確認してください。",
+		}
+	}
+
+	switch {
+	case errors.Is(err, dcomment.ErrNoCommentBodyProvided):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "コメントを入力してください。",
+		}
+	case errors.Is(err, dcomment.ErrCommentBodyTooLong):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "コメントは1000文字以内で入力してください。",
+		}
+	case errors.Is(err, dcomment.ErrInvalidParentComment):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "返信先のコメントが同じリンクのコメントではありません。",
+		}
+	case errors.Is(err, dcomment.ErrPageMismatch):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "指定されたコメントはこのページのコメントではありません。",
+		}
+	case errors.Is(err, dcomment.ErrCommentDeleted):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "このコメントは既に削除されています。",
+		}
+	case errors.Is(err, dcomment.ErrNotCommentAuthor):
+		return &ErrorReason{
+			ErrorCode: CodeCommentAuthorizationFailed,
+			Message:   "コメントを書いたユーザーのみ編集・削除できます。",
+		}
+	case errors.Is(err, ucomment.ErrCommentNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeCommentInvalidParameter,
+			Message:   "指定されたコメントが見つかりません。コメントIDを確認してください。",
+		}
+	case errors.Is(err, dinvitation.ErrInvalidEmail):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "招待するメールアドレスの形式が正しくありません。",
+		}
+	case errors.Is(err, dinvitation.ErrInvalidInvitationToken):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "招待リンクが無効です。リンクをもう一度確認してください。",
+		}
+	case errors.Is(err, dinvitation.ErrInvitationAlreadyAccepted):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "この招待リンクは既に使用されています。",
+		}
+	case errors.Is(err, dinvitation.ErrInvitationRevoked):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "この招待は取り消されています。",
+		}
+	case errors.Is(err, dinvitation.ErrInvitationExpired):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "招待リンクの有効期限が切れています。再度招待を依頼してください。",
+		}
+	case errors.Is(err, dinvitation.ErrInvitationPageMismatch):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "指定された招待はこの綴りのものではありません。",
+		}
+	case errors.Is(err, dinvitation.ErrInvitationEmailMismatch):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationAuthorizationFailed,
+			Message:   "この招待は別のメールアドレス宛てです。招待されたメールアドレスでログインしてください。",
+		}
+	case errors.Is(err, uinvitation.ErrInvitationNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeInvitationInvalidParameter,
+			Message:   "指定された招待が見つかりません。招待IDを確認してください。",
+		}
+	case errors.Is(err, dnotification.ErrInvalidKind):
+		return &ErrorReason{
+			ErrorCode: CodeNotificationInvalidParameter,
+			Message:   "無効な通知の種類が指定されました。",
+		}
+	case errors.Is(err, dnotification.ErrInvalidCount):
+		return &ErrorReason{
+			ErrorCode: CodeNotificationInvalidParameter,
+			Message:   "通知の件数が不正です。",
+		}
+	case errors.Is(err, dnotification.ErrNotPreferencesOwner):
+		return &ErrorReason{
+			ErrorCode: CodeNotificationAuthorizationFailed,
+			Message:   "他のユーザーの通知設定は変更できません。",
+		}
+	case errors.Is(err, pagination.ErrInvalidPage):
+		return &ErrorReason{
+			ErrorCode: CodeNotificationInvalidParameter,
+			Message:   "ページ番号またはページサイズが不正です。",
+		}
+	case errors.Is(err, ddevice.ErrInvalidToken):
+		return &ErrorReason{
+			ErrorCode: CodeDeviceInvalidParameter,
+			Message:   "端末のトークンが不正です。",
+		}
+	case errors.Is(err, ddevice.ErrInvalidPlatform):
+		return &ErrorReason{
+			ErrorCode: CodeDeviceInvalidParameter,
+			Message:   "端末の種類は ios、android、web のいずれかを指定してください。",
+		}
+	case errors.Is(err, dwebhook.ErrInvalidURL):
+		return &ErrorReason{
+			ErrorCode: CodeWebhookInvalidParameter,
+			Message:   "Webhook の URL には http または https の URL を指定してください。",
+		}
+	case errors.Is(err, dwebhook.ErrInvalidEventType):
+		return &ErrorReason{
+			ErrorCode: CodeWebhookInvalidParameter,
+			Message:   "無効なイベントの種類が指定されました。",
+		}
+	case errors.Is(err, dwebhook.ErrTooManyWebhooks):
+		return &ErrorReason{
+			ErrorCode: CodeWebhookInvalidParameter,
+			Message:   "1つの綴りに登録できる Webhook は10件までです。",
+		}
+	case errors.Is(err, dwebhook.ErrWebhookPageMismatch):
+		return &ErrorReason{
+			ErrorCode: CodeWebhookInvalidParameter,
+			Message:   "指定された Webhook はこの綴りのものではありません。",
+		}
+	case errors.Is(err, uwebhook.ErrWebhookNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeWebhookInvalidParameter,
+			Message:   "指定された Webhook が見つかりません。Webhook IDを確認してください。",
+		}
+	case errors.Is(err, daccesstoken.ErrInvalidName):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenInvalidParameter,
+			Message:   "トークンの名前は1文字以上100文字以内で入力してください。",
+		}
+	case errors.Is(err, daccesstoken.ErrInvalidScope):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenInvalidParameter,
+			Message:   "トークンの権限は read または write を指定し、綴りは20件以内で指定してください。",
+		}
+	case errors.Is(err, daccesstoken.ErrInvalidExpiry):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenInvalidParameter,
+			Message:   "トークンの有効期限には未来の日時を指定してください。",
+		}
+	case errors.Is(err, daccesstoken.ErrTooManyAccessTokens):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenInvalidParameter,
+			Message:   "有効なトークンは20件までです。不要なトークンを失効させてください。",
+		}
+	case errors.Is(err, daccesstoken.ErrNotAccessTokenOwner):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenAuthorizationFailed,
+			Message:   "他のユーザーのトークンは操作できません。",
+		}
+	case errors.Is(err, daccesstoken.ErrInsufficientScope):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenAuthorizationFailed,
+			Message:   "トークンにこの操作の権限がありません。",
+		}
+	case errors.Is(err, daccesstoken.ErrAccessTokenExpired):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenUnauthorized,
+			Message:   "トークンの有効期限が切れています。",
+		}
+	case errors.Is(err, daccesstoken.ErrAccessTokenRevoked):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenUnauthorized,
+			Message:   "トークンは失効しています。",
+		}
+	case errors.Is(err, uaccesstoken.ErrAccessTokenNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeAccessTokenInvalidParameter,
+			Message:   "指定されたトークンが見つかりません。トークンIDを確認してください。",
+		}
+	case errors.Is(err, dsnapshot.ErrPageMismatch):
+		return &ErrorReason{
+			ErrorCode: CodeSnapshotInvalidParameter,
+			Message:   "指定されたスナップショットはこのページのスナップショットではありません。",
+		}
+	case errors.Is(err, usnapshot.ErrSnapshotNotFound):
+		return &ErrorReason{
+			ErrorCode: CodeSnapshotInvalidParameter,
+			Message:   "指定されたスナップショットが見つかりません。スナップショットIDを確認してください。",
+		}
+	case errors.Is(err, service.ErrSnapshotUnavailable):
+		return &ErrorReason{
+			ErrorCode: CodeSnapshotInvalidParameter,
+			Message:   "リンク先のページを取得できませんでした。時間をおいて再度お試しください。",
+		}
+	}
+
 	var (
 		userProviderErr *duser.InvalidProviderError
 		userLoginErr    *duser.AlreadyLoggedInError
@@ -118,6 +487,21 @@ func GetErrorReason(err error) *ErrorReason {
 			ErrorCode: CodeUserInvalidParameter,
 			Message:   "指定されたユーザーは既に存在しています。",
 		}
+	case errors.Is(err, duser.ErrDisplayNameTooLong):
+		return &ErrorReason{
+			ErrorCode: CodeUserInvalidParameter,
+			Message:   "表示名は50文字以内で入力してください。",
+		}
+	case errors.Is(err, duser.ErrInvalidAvatarURL):
+		return &ErrorReason{
+			ErrorCode: CodeUserInvalidParameter,
+			Message:   "アバターには http または https の URL を指定してください。",
+		}
+	case errors.Is(err, duser.ErrInvalidLocale):
+		return &ErrorReason{
+			ErrorCode: CodeUserInvalidParameter,
+			Message:   "言語設定は ja-JP のような形式で指定してください。",
+		}
 	}
 
 	return &ErrorReason{
@@ -130,11 +514,11 @@ func GetErrorReason(err error) *ErrorReason {
 func getGRPCCode(err error) codes.Code {
 	errReason := GetErrorReason(err)
 	switch errReason.ErrorCode {
-	case CodeUserUnauthorized:
+	case CodeUserUnauthorized, CodeAccessTokenUnauthorized:
 		return codes.Unauthenticated
-	case CodeUserAuthorizationFailed, CodePageAuthorizationFailed:
+	case CodeUserAuthorizationFailed, CodePageAuthorizationFailed, CodeTemplateAuthorizationFailed, CodeCommentAuthorizationFailed, CodeInvitationAuthorizationFailed, CodeNotificationAuthorizationFailed, CodeAccessTokenAuthorizationFailed:
 		return codes.PermissionDenied
-	case CodeUserInvalidParameter, CodePageInvalidParameter:
+	case CodeUserInvalidParameter, CodePageInvalidParameter, CodeTemplateInvalidParameter, CodeCommentInvalidParameter, CodeInvitationInvalidParameter, CodeNotificationInvalidParameter, CodeDeviceInvalidParameter, CodeWebhookInvalidParameter, CodeAccessTokenInvalidParameter, CodeSnapshotInvalidParameter:
 		return codes.InvalidArgument
 	case CodeUserInternalError, CodePageInternalError:
 		return codes.Internal
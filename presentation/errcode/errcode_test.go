@@ -8,10 +8,26 @@ import (
 	cmpopts "github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/grpc/codes"
 
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/presentation/grpc/pagination"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
 	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
 	uuser "github.com/naka-sei/tsudzuri/usecase/user"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
 )
 
 func TestGetErrorReason(t *testing.T) {
@@ -81,6 +97,198 @@ func TestGetErrorReason(t *testing.T) {
 				Message:   "ページ作成者は招待コードによる参加を行う必要はありません。",
 			},
 		},
+		{
+			name: "page_ErrNoLinksProvided",
+			err:  dpage.ErrNoLinksProvided,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "対象のリンクを1つ以上指定してください。",
+			},
+		},
+		{
+			name: "page_ErrNoPageProvided",
+			err:  dpage.ErrNoPageProvided,
+			want: &ErrorReason{
+				ErrorCode: CodePageInternalError,
+				Message:   "ページ情報を取得できませんでした。再度お試しください。",
+			},
+		},
+		{
+			name: "page_ErrSamePage",
+			err:  dpage.ErrSamePage,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "移動元と移動先に同じページは指定できません。",
+			},
+		},
+		{
+			name: "page_NotFoundLinkByIDError",
+			err:  dpage.ErrNotFoundLinkByID("link-1"),
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "ページに存在しないリンクのため、操作を実行できません。リンクID: link-1",
+			},
+		},
+		{
+			name: "page_ErrInvalidFeedToken",
+			err:  dpage.ErrInvalidFeedToken,
+			want: &ErrorReason{
+				ErrorCode: CodePageAuthorizationFailed,
+				Message:   "フィードのトークンが正しくないため、フィードを表示できません。",
+			},
+		},
+		{
+			name: "page_ErrNoSectionNameProvided",
+			err:  dpage.ErrNoSectionNameProvided,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "セクション名を入力してください。",
+			},
+		},
+		{
+			name: "page_ErrSectionNameTooLong",
+			err:  dpage.ErrSectionNameTooLong,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "セクション名は50文字以内で入力してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidSectionsLength",
+			err:  dpage.ErrInvalidSectionsLength,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "セクションの数が現在のページのセクション数と一致しません。再度お試しください。",
+			},
+		},
+		{
+			name: "page_ErrNotFoundSection",
+			err:  dpage.ErrNotFoundSection,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "指定されたセクションが見つかりません。",
+			},
+		},
+		{
+			name: "page_ErrInvalidReaction",
+			err:  dpage.ErrInvalidReaction,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "リアクションには絵文字を1つ指定してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidLinkOrder",
+			err:  dpage.ErrInvalidLinkOrder,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "リンクの並び順は priority または votes を指定してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidLinkStatus",
+			err:  dpage.ErrInvalidLinkStatus,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "リンクの状態は unread、read、done のいずれかを指定してください。",
+			},
+		},
+		{
+			name: "page_ErrNotChecklist",
+			err:  dpage.ErrNotChecklist,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "チェックリストモードではない綴りのリンクは完了にできません。",
+			},
+		},
+		{
+			name: "page_ErrPageArchived",
+			err:  dpage.ErrPageArchived,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "アーカイブされた綴りは変更できません。",
+			},
+		},
+		{
+			name: "page_ErrInvalidPageOrder",
+			err:  dpage.ErrInvalidPageOrder,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "並び順には参加している綴りを重複なく指定してください。",
+			},
+		},
+		{
+			name: "page_ErrNotPreferenceOwner",
+			err:  dpage.ErrNotPreferenceOwner,
+			want: &ErrorReason{
+				ErrorCode: CodePageAuthorizationFailed,
+				Message:   "他のユーザーの設定は変更できません。",
+			},
+		},
+		{
+			name: "page_ErrTitleTooLong",
+			err:  dpage.ErrTitleTooLong,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "タイトルは50文字以内で入力してください。",
+			},
+		},
+		{
+			name: "page_ErrDescriptionTooLong",
+			err:  dpage.ErrDescriptionTooLong,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "説明は2000文字以内で入力してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidIcon",
+			err:  dpage.ErrInvalidIcon,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "アイコンには絵文字を1つ指定してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidColor",
+			err:  dpage.ErrInvalidColor,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "カラーは #rrggbb 形式で指定してください。",
+			},
+		},
+		{
+			name: "page_ErrInvalidCaptureURL",
+			err:  dpage.ErrInvalidCaptureURL,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "取り込むURLは http または https で始まる2048文字以内のURLを指定してください。",
+			},
+		},
+		{
+			name: "page_ErrNoLinkRedirect",
+			err:  dpage.ErrNoLinkRedirect,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "リンクのリダイレクトが検出されていません。",
+			},
+		},
+		{
+			name: "page_ErrInvalidSlug",
+			err:  dpage.ErrInvalidSlug,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "スラッグは3〜30文字の英小文字と数字で、単語をハイフン1つで区切って指定してください。",
+			},
+		},
+		{
+			name: "page_ErrSlugTaken",
+			err:  dpage.ErrSlugTaken,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "指定されたスラッグは他のページで使用されています。",
+			},
+		},
 		{
 			name: "page_NotFoundError",
 			err:  upage.ErrPageNotFound,
@@ -89,6 +297,38 @@ func TestGetErrorReason(t *testing.T) {
 				Message:   "指定されたページが見つかりません。ページIDを確認してください。",
 			},
 		},
+		{
+			name: "page_ErrUnsupportedExportFormat",
+			err:  upage.ErrUnsupportedExportFormat,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "指定されたエクスポート形式には対応していません。",
+			},
+		},
+		{
+			name: "page_ErrUnsupportedQRCodeFormat",
+			err:  upage.ErrUnsupportedQRCodeFormat,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "指定されたQRコードの形式には対応していません。",
+			},
+		},
+		{
+			name: "qrcode_ErrInvalidSize",
+			err:  qrcode.ErrInvalidSize,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "QRコードのサイズは64〜1024ピクセルで指定してください。",
+			},
+		},
+		{
+			name: "qrcode_ErrInvalidLevel",
+			err:  qrcode.ErrInvalidLevel,
+			want: &ErrorReason{
+				ErrorCode: CodePageInvalidParameter,
+				Message:   "QRコードの誤り訂正レベルは L, M, Q, H のいずれかを指定してください。",
+			},
+		},
 		{
 			name: "page_NotFoundLinkError",
 			err:  &dpage.NotFoundLinkError{URL: "http://example.com"},
@@ -97,6 +337,334 @@ func TestGetErrorReason(t *testing.T) {
 				Message:   "ページに存在しないリンクのため、操作を実行できません。リンク: http://example.com",
 			},
 		},
+		{
+			name: "template_InvalidVisibilityError",
+			err:  dtemplate.ErrInvalidVisibility(dtemplate.Visibility("public")),
+			want: &ErrorReason{
+				ErrorCode: CodeTemplateInvalidParameter,
+				Message:   "テンプレートの公開範囲は private または shared を指定してください。",
+			},
+		},
+		{
+			name: "template_ErrTemplateNotAccessible",
+			err:  dtemplate.ErrTemplateNotAccessible,
+			want: &ErrorReason{
+				ErrorCode: CodeTemplateAuthorizationFailed,
+				Message:   "このテンプレートを利用する権限がありません。",
+			},
+		},
+		{
+			name: "template_NotFoundError",
+			err:  upage.ErrTemplateNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeTemplateInvalidParameter,
+				Message:   "指定されたテンプレートが見つかりません。テンプレートIDを確認してください。",
+			},
+		},
+		{
+			name: "comment_ErrNoCommentBodyProvided",
+			err:  dcomment.ErrNoCommentBodyProvided,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "コメントを入力してください。",
+			},
+		},
+		{
+			name: "comment_ErrCommentBodyTooLong",
+			err:  dcomment.ErrCommentBodyTooLong,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "コメントは1000文字以内で入力してください。",
+			},
+		},
+		{
+			name: "comment_ErrInvalidParentComment",
+			err:  dcomment.ErrInvalidParentComment,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "返信先のコメントが同じリンクのコメントではありません。",
+			},
+		},
+		{
+			name: "comment_ErrPageMismatch",
+			err:  dcomment.ErrPageMismatch,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "指定されたコメントはこのページのコメントではありません。",
+			},
+		},
+		{
+			name: "comment_ErrCommentDeleted",
+			err:  dcomment.ErrCommentDeleted,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "このコメントは既に削除されています。",
+			},
+		},
+		{
+			name: "comment_ErrNotCommentAuthor",
+			err:  dcomment.ErrNotCommentAuthor,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentAuthorizationFailed,
+				Message:   "コメントを書いたユーザーのみ編集・削除できます。",
+			},
+		},
+		{
+			name: "comment_NotFoundError",
+			err:  ucomment.ErrCommentNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeCommentInvalidParameter,
+				Message:   "指定されたコメントが見つかりません。コメントIDを確認してください。",
+			},
+		},
+		{
+			name: "invitation_ErrInvalidEmail",
+			err:  dinvitation.ErrInvalidEmail,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "招待するメールアドレスの形式が正しくありません。",
+			},
+		},
+		{
+			name: "invitation_ErrInvalidInvitationToken",
+			err:  dinvitation.ErrInvalidInvitationToken,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "招待リンクが無効です。リンクをもう一度確認してください。",
+			},
+		},
+		{
+			name: "invitation_ErrInvitationAlreadyAccepted",
+			err:  dinvitation.ErrInvitationAlreadyAccepted,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "この招待リンクは既に使用されています。",
+			},
+		},
+		{
+			name: "invitation_ErrInvitationRevoked",
+			err:  dinvitation.ErrInvitationRevoked,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "この招待は取り消されています。",
+			},
+		},
+		{
+			name: "invitation_ErrInvitationExpired",
+			err:  dinvitation.ErrInvitationExpired,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "招待リンクの有効期限が切れています。再度招待を依頼してください。",
+			},
+		},
+		{
+			name: "invitation_ErrInvitationPageMismatch",
+			err:  dinvitation.ErrInvitationPageMismatch,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "指定された招待はこの綴りのものではありません。",
+			},
+		},
+		{
+			name: "invitation_ErrInvitationEmailMismatch",
+			err:  dinvitation.ErrInvitationEmailMismatch,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationAuthorizationFailed,
+				Message:   "この招待は別のメールアドレス宛てです。招待されたメールアドレスでログインしてください。",
+			},
+		},
+		{
+			name: "invitation_NotFoundError",
+			err:  uinvitation.ErrInvitationNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeInvitationInvalidParameter,
+				Message:   "指定された招待が見つかりません。招待IDを確認してください。",
+			},
+		},
+		{
+			name: "notification_ErrInvalidKind",
+			err:  dnotification.ErrInvalidKind,
+			want: &ErrorReason{
+				ErrorCode: CodeNotificationInvalidParameter,
+				Message:   "無効な通知の種類が指定されました。",
+			},
+		},
+		{
+			name: "notification_ErrInvalidCount",
+			err:  dnotification.ErrInvalidCount,
+			want: &ErrorReason{
+				ErrorCode: CodeNotificationInvalidParameter,
+				Message:   "通知の件数が不正です。",
+			},
+		},
+		{
+			name: "notification_ErrNotPreferencesOwner",
+			err:  dnotification.ErrNotPreferencesOwner,
+			want: &ErrorReason{
+				ErrorCode: CodeNotificationAuthorizationFailed,
+				Message:   "他のユーザーの通知設定は変更できません。",
+			},
+		},
+		{
+			name: "pagination_ErrInvalidPage",
+			err:  pagination.ErrInvalidPage,
+			want: &ErrorReason{
+				ErrorCode: CodeNotificationInvalidParameter,
+				Message:   "ページ番号またはページサイズが不正です。",
+			},
+		},
+		{
+			name: "device_ErrInvalidToken",
+			err:  ddevice.ErrInvalidToken,
+			want: &ErrorReason{
+				ErrorCode: CodeDeviceInvalidParameter,
+				Message:   "端末のトークンが不正です。",
+			},
+		},
+		{
+			name: "device_ErrInvalidPlatform",
+			err:  ddevice.ErrInvalidPlatform,
+			want: &ErrorReason{
+				ErrorCode: CodeDeviceInvalidParameter,
+				Message:   "端末の種類は ios、android、web のいずれかを指定してください。",
+			},
+		},
+		{
+			name: "webhook_ErrInvalidURL",
+			err:  dwebhook.ErrInvalidURL,
+			want: &ErrorReason{
+				ErrorCode: CodeWebhookInvalidParameter,
+				Message:   "Webhook の URL には http または https の URL を指定してください。",
+			},
+		},
+		{
+			name: "webhook_ErrInvalidEventType",
+			err:  dwebhook.ErrInvalidEventType,
+			want: &ErrorReason{
+				ErrorCode: CodeWebhookInvalidParameter,
+				Message:   "無効なイベントの種類が指定されました。",
+			},
+		},
+		{
+			name: "webhook_ErrTooManyWebhooks",
+			err:  dwebhook.ErrTooManyWebhooks,
+			want: &ErrorReason{
+				ErrorCode: CodeWebhookInvalidParameter,
+				Message:   "1つの綴りに登録できる Webhook は10件までです。",
+			},
+		},
+		{
+			name: "webhook_ErrWebhookPageMismatch",
+			err:  dwebhook.ErrWebhookPageMismatch,
+			want: &ErrorReason{
+				ErrorCode: CodeWebhookInvalidParameter,
+				Message:   "指定された Webhook はこの綴りのものではありません。",
+			},
+		},
+		{
+			name: "webhook_NotFoundError",
+			err:  uwebhook.ErrWebhookNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeWebhookInvalidParameter,
+				Message:   "指定された Webhook が見つかりません。Webhook IDを確認してください。",
+			},
+		},
+		{
+			name: "accesstoken_ErrInvalidName",
+			err:  daccesstoken.ErrInvalidName,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenInvalidParameter,
+				Message:   "トークンの名前は1文字以上100文字以内で入力してください。",
+			},
+		},
+		{
+			name: "accesstoken_ErrInvalidScope",
+			err:  daccesstoken.ErrInvalidScope,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenInvalidParameter,
+				Message:   "トークンの権限は read または write を指定し、綴りは20件以内で指定してください。",
+			},
+		},
+		{
+			name: "accesstoken_ErrInvalidExpiry",
+			err:  daccesstoken.ErrInvalidExpiry,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenInvalidParameter,
+				Message:   "トークンの有効期限には未来の日時を指定してください。",
+			},
+		},
+		{
+			name: "accesstoken_ErrTooManyAccessTokens",
+			err:  daccesstoken.ErrTooManyAccessTokens,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenInvalidParameter,
+				Message:   "有効なトークンは20件までです。不要なトークンを失効させてください。",
+			},
+		},
+		{
+			name: "accesstoken_ErrNotAccessTokenOwner",
+			err:  daccesstoken.ErrNotAccessTokenOwner,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenAuthorizationFailed,
+				Message:   "他のユーザーのトークンは操作できません。",
+			},
+		},
+		{
+			name: "accesstoken_ErrInsufficientScope",
+			err:  daccesstoken.ErrInsufficientScope,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenAuthorizationFailed,
+				Message:   "トークンにこの操作の権限がありません。",
+			},
+		},
+		{
+			name: "accesstoken_ErrAccessTokenExpired",
+			err:  daccesstoken.ErrAccessTokenExpired,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenUnauthorized,
+				Message:   "トークンの有効期限が切れています。",
+			},
+		},
+		{
+			name: "accesstoken_ErrAccessTokenRevoked",
+			err:  daccesstoken.ErrAccessTokenRevoked,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenUnauthorized,
+				Message:   "トークンは失効しています。",
+			},
+		},
+		{
+			name: "accesstoken_NotFoundError",
+			err:  uaccesstoken.ErrAccessTokenNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeAccessTokenInvalidParameter,
+				Message:   "指定されたトークンが見つかりません。トークンIDを確認してください。",
+			},
+		},
+		{
+			name: "snapshot_ErrPageMismatch",
+			err:  dsnapshot.ErrPageMismatch,
+			want: &ErrorReason{
+				ErrorCode: CodeSnapshotInvalidParameter,
+				Message:   "指定されたスナップショットはこのページのスナップショットではありません。",
+			},
+		},
+		{
+			name: "snapshot_NotFoundError",
+			err:  usnapshot.ErrSnapshotNotFound,
+			want: &ErrorReason{
+				ErrorCode: CodeSnapshotInvalidParameter,
+				Message:   "指定されたスナップショットが見つかりません。スナップショットIDを確認してください。",
+			},
+		},
+		{
+			name: "snapshot_ErrSnapshotUnavailable",
+			err:  service.ErrSnapshotUnavailable,
+			want: &ErrorReason{
+				ErrorCode: CodeSnapshotInvalidParameter,
+				Message:   "リンク先のページを取得できませんでした。時間をおいて再度お試しください。",
+			},
+		},
 		{
 			name: "user_InvalidProviderError",
 			err:  duser.ErrInvalidProvider(duser.Provider("invalid")),
@@ -137,6 +705,30 @@ func TestGetErrorReason(t *testing.T) {
 				Message:   "指定されたユーザーは既に存在しています。",
 			},
 		},
+		{
+			name: "user_ErrDisplayNameTooLong",
+			err:  duser.ErrDisplayNameTooLong,
+			want: &ErrorReason{
+				ErrorCode: CodeUserInvalidParameter,
+				Message:   "表示名は50文字以内で入力してください。",
+			},
+		},
+		{
+			name: "user_ErrInvalidAvatarURL",
+			err:  duser.ErrInvalidAvatarURL,
+			want: &ErrorReason{
+				ErrorCode: CodeUserInvalidParameter,
+				Message:   "アバターには http または https の URL を指定してください。",
+			},
+		},
+		{
+			name: "user_ErrInvalidLocale",
+			err:  duser.ErrInvalidLocale,
+			want: &ErrorReason{
+				ErrorCode: CodeUserInvalidParameter,
+				Message:   "言語設定は ja-JP のような形式で指定してください。",
+			},
+		},
 		{
 			name: "unknown_error",
 			err:  errors.New("unknown error"),
@@ -173,6 +765,76 @@ func TestGetGRPCCode(t *testing.T) {
 			err:  dpage.ErrNotCreatedByUser,
 			want: codes.PermissionDenied,
 		},
+		{
+			name: "template_invalid_argument",
+			err:  upage.ErrTemplateNotFound,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "template_permission_denied",
+			err:  dtemplate.ErrTemplateNotAccessible,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "comment_invalid_argument",
+			err:  ucomment.ErrCommentNotFound,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "comment_permission_denied",
+			err:  dcomment.ErrNotCommentAuthor,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "invitation_invalid_argument",
+			err:  dinvitation.ErrInvitationExpired,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "invitation_permission_denied",
+			err:  dinvitation.ErrInvitationEmailMismatch,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "notification_invalid_argument",
+			err:  dnotification.ErrInvalidKind,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "notification_permission_denied",
+			err:  dnotification.ErrNotPreferencesOwner,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "device_invalid_argument",
+			err:  ddevice.ErrInvalidPlatform,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "webhook_invalid_argument",
+			err:  dwebhook.ErrTooManyWebhooks,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "accesstoken_invalid_argument",
+			err:  daccesstoken.ErrInvalidExpiry,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "accesstoken_permission_denied",
+			err:  daccesstoken.ErrInsufficientScope,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "accesstoken_unauthenticated",
+			err:  daccesstoken.ErrAccessTokenRevoked,
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "snapshot_invalid_argument",
+			err:  usnapshot.ErrSnapshotNotFound,
+			want: codes.InvalidArgument,
+		},
 		{
 			name: "unauthenticated",
 			err:  duser.ErrUserNotFound,
@@ -7,6 +7,52 @@ var (
 	CodePageInternalError       = newErrorCode("page", "internal-error", "An internal error occurred in the page domain.")
 )
 
+// Error codes for the template domain.
+var (
+	CodeTemplateInvalidParameter    = newErrorCode("template", "invalid-parameter", "Invalid parameter is provided.")
+	CodeTemplateAuthorizationFailed = newErrorCode("template", "authorization-failed", "Authorization failed for the requested operation.")
+)
+
+// Error codes for the comment domain.
+var (
+	CodeCommentInvalidParameter    = newErrorCode("comment", "invalid-parameter", "Invalid parameter is provided.")
+	CodeCommentAuthorizationFailed = newErrorCode("comment", "authorization-failed", "Authorization failed for the requested operation.")
+)
+
+// Error codes for the invitation domain.
+var (
+	CodeInvitationInvalidParameter    = newErrorCode("invitation", "invalid-parameter", "Invalid parameter is provided.")
+	CodeInvitationAuthorizationFailed = newErrorCode("invitation", "authorization-failed", "Authorization failed for the requested operation.")
+)
+
+// Error codes for the notification domain.
+var (
+	CodeNotificationInvalidParameter    = newErrorCode("notification", "invalid-parameter", "Invalid parameter is provided.")
+	CodeNotificationAuthorizationFailed = newErrorCode("notification", "authorization-failed", "Authorization failed for the requested operation.")
+)
+
+// Error codes for the device domain.
+var (
+	CodeDeviceInvalidParameter = newErrorCode("device", "invalid-parameter", "Invalid parameter is provided.")
+)
+
+// Error codes for the webhook domain.
+var (
+	CodeWebhookInvalidParameter = newErrorCode("webhook", "invalid-parameter", "Invalid parameter is provided.")
+)
+
+// Error codes for the access token domain.
+var (
+	CodeAccessTokenInvalidParameter    = newErrorCode("access-token", "invalid-parameter", "Invalid parameter is provided.")
+	CodeAccessTokenAuthorizationFailed = newErrorCode("access-token", "authorization-failed", "Authorization failed for the requested operation.")
+	CodeAccessTokenUnauthorized        = newErrorCode("access-token", "unauthorized", "Authentication is required or has failed.")
+)
+
+// Error codes for the snapshot domain.
+var (
+	CodeSnapshotInvalidParameter = newErrorCode("snapshot", "invalid-parameter", "Invalid parameter is provided.")
+)
+
 // Error codes for the user domain.
 var (
 	CodeUserInvalidParameter    = newErrorCode("user", "invalid-parameter", "Invalid parameter is provided.")
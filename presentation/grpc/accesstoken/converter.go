@@ -0,0 +1,65 @@
+package accesstoken
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+)
+
+// toProtoAccessToken converts the access token. Whether it is active is resolved at now.
+func toProtoAccessToken(t *daccesstoken.AccessToken, now time.Time) *tsudzuriv1.AccessToken {
+	if t == nil {
+		return nil
+	}
+
+	scopes := make([]string, 0, len(t.Scopes()))
+	for _, s := range t.Scopes() {
+		scopes = append(scopes, string(s))
+	}
+
+	return &tsudzuriv1.AccessToken{
+		Id:         t.ID(),
+		Name:       t.Name(),
+		Hint:       t.Hint(),
+		Scopes:     scopes,
+		PageIds:    t.PageIDs(),
+		Active:     t.Active(now),
+		ExpiresAt:  toProtoNillableTimestamp(t.ExpiresAt()),
+		LastUsedAt: toProtoNillableTimestamp(t.LastUsedAt()),
+		RevokedAt:  toProtoNillableTimestamp(t.RevokedAt()),
+		CreatedAt:  toProtoTimestamp(t.CreatedAt()),
+	}
+}
+
+func toProtoAccessTokens(tokens []*daccesstoken.AccessToken, now time.Time) []*tsudzuriv1.AccessToken {
+	protoTokens := make([]*tsudzuriv1.AccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		protoTokens = append(protoTokens, toProtoAccessToken(t, now))
+	}
+	return protoTokens
+}
+
+func toScopes(scopes []string) []daccesstoken.Scope {
+	domainScopes := make([]daccesstoken.Scope, 0, len(scopes))
+	for _, s := range scopes {
+		domainScopes = append(domainScopes, daccesstoken.Scope(s))
+	}
+	return domainScopes
+}
+
+func toProtoNillableTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return toProtoTimestamp(*t)
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,61 @@
+package accesstoken
+
+import (
+	"context"
+	"time"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+)
+
+type CreateService struct {
+	usecase struct {
+		create uaccesstoken.CreateUsecase
+	}
+}
+
+func NewCreateService(cu uaccesstoken.CreateUsecase) *CreateService {
+	return &CreateService{
+		usecase: struct{ create uaccesstoken.CreateUsecase }{create: cu},
+	}
+}
+
+func (s *CreateService) Create(ctx context.Context, req *tsudzuriv1.CreateAccessTokenRequest) (*tsudzuriv1.CreateAccessTokenResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/accesstoken.Create")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Access token create request scopes=%v page_count=%d user_uid=%s", req.GetScopes(), len(req.GetPageIds()), user.UID())
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		t := req.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	accessToken, token, err := s.usecase.create.Create(ctx, uaccesstoken.CreateUsecaseInput{
+		Name:      req.GetName(),
+		Scopes:    toScopes(req.GetScopes()),
+		PageIDs:   req.GetPageIds(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Access token create succeeded access_token_id=%s user_uid=%s", accessToken.ID(), user.UID())
+	return &tsudzuriv1.CreateAccessTokenResponse{
+		AccessToken: toProtoAccessToken(accessToken, ctxtime.Now(ctx)),
+		Token:       token,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package accesstoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	mockcreate "github.com/naka-sei/tsudzuri/usecase/accesstoken/mock/mock_create"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateAccessTokenRequest
+	}
+	type want struct {
+		res *tsudzuriv1.CreateAccessTokenResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	expiresAt := fixedNow.Add(30 * 24 * time.Hour)
+	req := &tsudzuriv1.CreateAccessTokenRequest{
+		Name:      "ci bot",
+		Scopes:    []string{"write"},
+		PageIds:   []string{"page-1"},
+		ExpiresAt: timestamppb.New(expiresAt),
+	}
+	input := uaccesstoken.CreateUsecaseInput{
+		Name:      "ci bot",
+		Scopes:    []daccesstoken.Scope{daccesstoken.ScopeWrite},
+		PageIDs:   []string{"page-1"},
+		ExpiresAt: &expiresAt,
+	}
+	accessToken := daccesstoken.ReconstructAccessToken("token-1", "user-id", "ci bot", "hash", "tsz_pat_abcd",
+		daccesstoken.Scopes(), []string{"page-1"}, daccesstoken.WithExpiresAt(&expiresAt), daccesstoken.WithCreatedAt(fixedNow))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockcreate.MockCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), input).Return(accessToken, "tsz_pat_abcdefgh", nil)
+			},
+			args: args{
+				ctx: ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.CreateAccessTokenResponse{
+					AccessToken: &tsudzuriv1.AccessToken{
+						Id:        "token-1",
+						Name:      "ci bot",
+						Hint:      "tsz_pat_abcd",
+						Scopes:    []string{"read", "write"},
+						PageIds:   []string{"page-1"},
+						Active:    true,
+						ExpiresAt: timestamppb.New(expiresAt),
+						CreatedAt: timestamppb.New(fixedNow),
+					},
+					Token: "tsz_pat_abcdefgh",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), input).Return(nil, "", daccesstoken.ErrInvalidExpiry)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: daccesstoken.ErrInvalidExpiry},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockcreate.NewMockCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package accesstoken
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+)
+
+type ListService struct {
+	usecase struct {
+		list uaccesstoken.ListUsecase
+	}
+}
+
+func NewListService(lu uaccesstoken.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list uaccesstoken.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, _ *emptypb.Empty) (*tsudzuriv1.ListAccessTokensResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/accesstoken.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Access token list request user_uid=%s", user.UID())
+
+	tokens, err := s.usecase.list.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListAccessTokensResponse{
+		AccessTokens: toProtoAccessTokens(tokens, ctxtime.Now(ctx)),
+	}
+
+	logger.Sugar().Infof("Access token list responded: count=%d user_uid=%s", len(resp.GetAccessTokens()), user.UID())
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+package accesstoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/accesstoken/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	type want struct {
+		res *tsudzuriv1.ListAccessTokensResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	usedAt := fixedNow.Add(-time.Hour)
+	expiredAt := fixedNow.Add(-time.Minute)
+	tokens := []*daccesstoken.AccessToken{
+		daccesstoken.ReconstructAccessToken("token-2", "user-id", "reader", "hash-2", "tsz_pat_efgh",
+			[]daccesstoken.Scope{daccesstoken.ScopeRead}, nil,
+			daccesstoken.WithUsage(&usedAt, nil), daccesstoken.WithCreatedAt(fixedNow)),
+		daccesstoken.ReconstructAccessToken("token-1", "user-id", "old bot", "hash-1", "tsz_pat_abcd",
+			daccesstoken.Scopes(), nil, daccesstoken.WithExpiresAt(&expiredAt), daccesstoken.WithCreatedAt(fixedNow)),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any()).Return(tokens, nil)
+			},
+			args: args{ctx: ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow)},
+			want: want{
+				res: &tsudzuriv1.ListAccessTokensResponse{
+					AccessTokens: []*tsudzuriv1.AccessToken{
+						{
+							Id:         "token-2",
+							Name:       "reader",
+							Hint:       "tsz_pat_efgh",
+							Scopes:     []string{"read"},
+							Active:     true,
+							LastUsedAt: timestamppb.New(usedAt),
+							CreatedAt:  timestamppb.New(fixedNow),
+						},
+						{
+							Id:        "token-1",
+							Name:      "old bot",
+							Hint:      "tsz_pat_abcd",
+							Scopes:    []string{"read", "write"},
+							ExpiresAt: timestamppb.New(expiredAt),
+							CreatedAt: timestamppb.New(fixedNow),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user)},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background()},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, &emptypb.Empty{})
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
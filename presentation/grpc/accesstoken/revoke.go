@@ -0,0 +1,46 @@
+package accesstoken
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+)
+
+type RevokeService struct {
+	usecase struct {
+		revoke uaccesstoken.RevokeUsecase
+	}
+}
+
+func NewRevokeService(ru uaccesstoken.RevokeUsecase) *RevokeService {
+	return &RevokeService{
+		usecase: struct{ revoke uaccesstoken.RevokeUsecase }{revoke: ru},
+	}
+}
+
+func (s *RevokeService) Revoke(ctx context.Context, req *tsudzuriv1.RevokeAccessTokenRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/accesstoken.Revoke")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Access token revoke request access_token_id=%s user_uid=%s", req.GetAccessTokenId(), user.UID())
+
+	if err := s.usecase.revoke.Revoke(ctx, req.GetAccessTokenId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Access token revoke succeeded access_token_id=%s user_uid=%s", req.GetAccessTokenId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
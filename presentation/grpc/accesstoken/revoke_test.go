@@ -0,0 +1,91 @@
+package accesstoken
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	uaccesstoken "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	mockrevoke "github.com/naka-sei/tsudzuri/usecase/accesstoken/mock/mock_revoke"
+)
+
+func TestRevokeService_Revoke(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.RevokeAccessTokenRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.RevokeAccessTokenRequest{AccessTokenId: "token-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockrevoke.MockRevokeUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockrevoke.MockRevokeUsecase) {
+				m.EXPECT().Revoke(gomock.Any(), "token-1").Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &emptypb.Empty{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockrevoke.MockRevokeUsecase) {
+				m.EXPECT().Revoke(gomock.Any(), "token-1").Return(uaccesstoken.ErrAccessTokenNotFound)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: uaccesstoken.ErrAccessTokenNotFound},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockrevoke.NewMockRevokeUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewRevokeService(usecase)
+			got, err := svc.Revoke(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
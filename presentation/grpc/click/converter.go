@@ -0,0 +1,40 @@
+package click
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+)
+
+func toProtoPageStats(s *dclick.PageStats) *tsudzuriv1.PageStats {
+	if s == nil {
+		return nil
+	}
+
+	links := make([]*tsudzuriv1.LinkStats, 0, len(s.Links()))
+	for _, l := range s.Links() {
+		links = append(links, &tsudzuriv1.LinkStats{
+			LinkId:          l.LinkID(),
+			Url:             l.URL(),
+			ClicksLastDay:   l.Clicks(dclick.WindowDay),
+			ClicksLastWeek:  l.Clicks(dclick.WindowWeek),
+			ClicksLastMonth: l.Clicks(dclick.WindowMonth),
+			ClicksTotal:     l.Clicks(dclick.WindowAll),
+		})
+	}
+	return &tsudzuriv1.PageStats{
+		PageId:      s.PageID(),
+		Links:       links,
+		GeneratedAt: toProtoTimestamp(s.GeneratedAt()),
+	}
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
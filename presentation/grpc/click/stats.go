@@ -0,0 +1,45 @@
+package click
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uclick "github.com/naka-sei/tsudzuri/usecase/click"
+)
+
+type StatsService struct {
+	usecase struct {
+		stats uclick.StatsUsecase
+	}
+}
+
+func NewStatsService(su uclick.StatsUsecase) *StatsService {
+	return &StatsService{
+		usecase: struct{ stats uclick.StatsUsecase }{stats: su},
+	}
+}
+
+func (s *StatsService) Get(ctx context.Context, req *tsudzuriv1.GetPageStatsRequest) (*tsudzuriv1.PageStats, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/click.Get")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page stats request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	stats, err := s.usecase.stats.Stats(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page stats responded: links=%d user_uid=%s", len(stats.Links()), user.UID())
+	return toProtoPageStats(stats), nil
+}
@@ -0,0 +1,105 @@
+package click
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockstats "github.com/naka-sei/tsudzuri/usecase/click/mock/mock_stats"
+)
+
+func TestStatsService_Get(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.GetPageStatsRequest
+	}
+	type want struct {
+		res *tsudzuriv1.PageStats
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	page := dpage.ReconstructPage("page-1", "Test Page", *user, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com/a", "", 1, dpage.WithLinkID("link-1")),
+		dpage.ReconstructLink("https://example.com/b", "", 2, dpage.WithLinkID("link-2")),
+	}, nil)
+	stats := dclick.NewPageStats(page, map[dclick.Window]map[string]int64{
+		dclick.WindowDay:   {"link-1": 1},
+		dclick.WindowWeek:  {"link-1": 2},
+		dclick.WindowMonth: {"link-1": 3},
+		dclick.WindowAll:   {"link-1": 4, "link-2": 5},
+	}, now)
+	req := &tsudzuriv1.GetPageStatsRequest{PageId: "page-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockstats.MockStatsUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockstats.MockStatsUsecase) {
+				m.EXPECT().Stats(gomock.Any(), "page-1").Return(stats, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.PageStats{
+					PageId: "page-1",
+					Links: []*tsudzuriv1.LinkStats{
+						{LinkId: "link-1", Url: "https://example.com/a", ClicksLastDay: 1, ClicksLastWeek: 2, ClicksLastMonth: 3, ClicksTotal: 4},
+						{LinkId: "link-2", Url: "https://example.com/b", ClicksTotal: 5},
+					},
+					GeneratedAt: timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockstats.MockStatsUsecase) {
+				m.EXPECT().Stats(gomock.Any(), "page-1").Return(nil, errors.New("stats error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: errors.New("stats error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockstats.NewMockStatsUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewStatsService(usecase)
+			got, err := svc.Get(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package comment
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+)
+
+type AddService struct {
+	usecase struct {
+		add ucomment.AddUsecase
+	}
+}
+
+func NewAddService(au ucomment.AddUsecase) *AddService {
+	return &AddService{
+		usecase: struct{ add ucomment.AddUsecase }{add: au},
+	}
+}
+
+func (s *AddService) Add(ctx context.Context, req *tsudzuriv1.AddCommentRequest) (*tsudzuriv1.Comment, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/comment.Add")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Comment add request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	comment, err := s.usecase.add.Add(ctx, req.GetPageId(), req.GetLinkId(), req.GetParentId(), req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toProtoComment(comment, user)
+	logger.Sugar().Infof("Comment add succeeded comment_id=%s user_uid=%s", resp.GetId(), user.UID())
+
+	return resp, nil
+}
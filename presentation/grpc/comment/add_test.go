@@ -0,0 +1,109 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockadd "github.com/naka-sei/tsudzuri/usecase/comment/mock/mock_add"
+)
+
+func TestAddService_Add(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.AddCommentRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Comment
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.AddCommentRequest{PageId: "page-1", LinkId: "link-1", ParentId: "c1", Body: "body"}
+	comment := dcomment.ReconstructComment("c2", "page-1", "link-1", *user, "body", dcomment.WithParentID("c1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockadd.MockAddUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockadd.MockAddUsecase) {
+				m.EXPECT().Add(gomock.Any(), "page-1", "link-1", "c1", "body").Return(comment, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Comment{
+					Id:       "c2",
+					LinkId:   "link-1",
+					ParentId: "c1",
+					AuthorId: "user-id",
+					Body:     "body",
+					Owned:    true,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockadd.MockAddUsecase) {
+				m.EXPECT().Add(gomock.Any(), "page-1", "link-1", "c1", "body").Return(nil, errors.New("add error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("add error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockadd.NewMockAddUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewAddService(usecase)
+			got, err := svc.Add(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package comment
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+func toProtoComment(c *dcomment.Comment, user *duser.User) *tsudzuriv1.Comment {
+	if c == nil {
+		return nil
+	}
+
+	return &tsudzuriv1.Comment{
+		Id:        c.ID(),
+		LinkId:    c.LinkID(),
+		ParentId:  c.ParentID(),
+		AuthorId:  c.Author().ID(),
+		Body:      c.Body(),
+		Deleted:   c.Deleted(),
+		CreatedAt: toProtoTimestamp(c.CreatedAt()),
+		UpdatedAt: toProtoTimestamp(c.UpdatedAt()),
+		Owned:     !c.Deleted() && c.IsWrittenBy(user),
+	}
+}
+
+// toProtoThreads nests the replies under the first comment of their thread.
+// Deleted comments are only kept while their thread still has replies.
+func toProtoThreads(comments []*dcomment.Comment, user *duser.User) []*tsudzuriv1.Comment {
+	threads := make([]*tsudzuriv1.Comment, 0, len(comments))
+	byID := make(map[string]*tsudzuriv1.Comment, len(comments))
+	for _, c := range comments {
+		protoComment := toProtoComment(c, user)
+		if root, ok := byID[c.ParentID()]; ok {
+			if !c.Deleted() {
+				root.Replies = append(root.Replies, protoComment)
+			}
+			continue
+		}
+		byID[c.ID()] = protoComment
+		threads = append(threads, protoComment)
+	}
+
+	visible := threads[:0]
+	for _, t := range threads {
+		if t.GetDeleted() && len(t.GetReplies()) == 0 {
+			continue
+		}
+		visible = append(visible, t)
+	}
+	return visible
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,46 @@
+package comment
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+)
+
+type DeleteService struct {
+	usecase struct {
+		delete ucomment.DeleteUsecase
+	}
+}
+
+func NewDeleteService(du ucomment.DeleteUsecase) *DeleteService {
+	return &DeleteService{
+		usecase: struct{ delete ucomment.DeleteUsecase }{delete: du},
+	}
+}
+
+func (s *DeleteService) Delete(ctx context.Context, req *tsudzuriv1.DeleteCommentRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/comment.Delete")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Comment delete request comment_id=%s user_uid=%s", req.GetCommentId(), user.UID())
+
+	if err := s.usecase.delete.Delete(ctx, req.GetCommentId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Comment delete succeeded comment_id=%s user_uid=%s", req.GetCommentId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+)
+
+type EditService struct {
+	usecase struct {
+		edit ucomment.EditUsecase
+	}
+}
+
+func NewEditService(eu ucomment.EditUsecase) *EditService {
+	return &EditService{
+		usecase: struct{ edit ucomment.EditUsecase }{edit: eu},
+	}
+}
+
+func (s *EditService) Edit(ctx context.Context, req *tsudzuriv1.EditCommentRequest) (*tsudzuriv1.Comment, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/comment.Edit")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Comment edit request comment_id=%s user_uid=%s", req.GetCommentId(), user.UID())
+
+	comment, err := s.usecase.edit.Edit(ctx, req.GetCommentId(), req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Comment edit succeeded comment_id=%s user_uid=%s", req.GetCommentId(), user.UID())
+	return toProtoComment(comment, user), nil
+}
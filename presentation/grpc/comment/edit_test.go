@@ -0,0 +1,108 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockedit "github.com/naka-sei/tsudzuri/usecase/comment/mock/mock_edit"
+)
+
+func TestEditService_Edit(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.EditCommentRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Comment
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.EditCommentRequest{CommentId: "c1", Body: "after"}
+	comment := dcomment.ReconstructComment("c1", "page-1", "link-1", *user, "after")
+
+	tests := []struct {
+		name  string
+		setup func(m *mockedit.MockEditUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockedit.MockEditUsecase) {
+				m.EXPECT().Edit(gomock.Any(), "c1", "after").Return(comment, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Comment{
+					Id:       "c1",
+					LinkId:   "link-1",
+					AuthorId: "user-id",
+					Body:     "after",
+					Owned:    true,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockedit.MockEditUsecase) {
+				m.EXPECT().Edit(gomock.Any(), "c1", "after").Return(nil, errors.New("edit error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("edit error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockedit.NewMockEditUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewEditService(usecase)
+			got, err := svc.Edit(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	ucomment "github.com/naka-sei/tsudzuri/usecase/comment"
+)
+
+type ListService struct {
+	usecase struct {
+		list ucomment.ListUsecase
+	}
+}
+
+func NewListService(lu ucomment.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list ucomment.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListCommentsRequest) (*tsudzuriv1.ListCommentsResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/comment.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Comment list request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	comments, err := s.usecase.list.List(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListCommentsResponse{
+		Comments: toProtoThreads(comments, user),
+	}
+
+	logger.Sugar().Infof("Comment list responded: threads=%d user_uid=%s", len(resp.GetComments()), user.UID())
+	return resp, nil
+}
@@ -0,0 +1,157 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/comment/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListCommentsRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListCommentsResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	other := duser.ReconstructUser("other-id", "uid-2", "anonymous", nil)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Minute)
+	ts := dcomment.WithTimestamps(createdAt, updatedAt)
+
+	comments := []*dcomment.Comment{
+		dcomment.ReconstructComment("c1", "page-1", "link-1", *user, "first", ts),
+		dcomment.ReconstructComment("c2", "page-1", "link-1", *other, "", ts, dcomment.WithDeleted()),
+		dcomment.ReconstructComment("c3", "page-1", "link-1", *other, "reply", ts, dcomment.WithParentID("c1")),
+		dcomment.ReconstructComment("c4", "page-1", "link-1", *user, "", ts, dcomment.WithDeleted(), dcomment.WithParentID("c1")),
+		dcomment.ReconstructComment("c5", "page-1", "link-1", *user, "", ts, dcomment.WithDeleted()),
+		dcomment.ReconstructComment("c6", "page-1", "link-1", *user, "reply to deleted", ts, dcomment.WithParentID("c5")),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(comments, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListCommentsRequest{PageId: "page-1", LinkId: "link-1"},
+			},
+			want: want{
+				res: &tsudzuriv1.ListCommentsResponse{
+					Comments: []*tsudzuriv1.Comment{
+						{
+							Id:        "c1",
+							LinkId:    "link-1",
+							AuthorId:  "user-id",
+							Body:      "first",
+							CreatedAt: timestamppb.New(createdAt),
+							UpdatedAt: timestamppb.New(updatedAt),
+							Owned:     true,
+							Replies: []*tsudzuriv1.Comment{{
+								Id:        "c3",
+								LinkId:    "link-1",
+								ParentId:  "c1",
+								AuthorId:  "other-id",
+								Body:      "reply",
+								CreatedAt: timestamppb.New(createdAt),
+								UpdatedAt: timestamppb.New(updatedAt),
+							}},
+						},
+						{
+							Id:        "c5",
+							LinkId:    "link-1",
+							AuthorId:  "user-id",
+							Deleted:   true,
+							CreatedAt: timestamppb.New(createdAt),
+							UpdatedAt: timestamppb.New(updatedAt),
+							Replies: []*tsudzuriv1.Comment{{
+								Id:        "c6",
+								LinkId:    "link-1",
+								ParentId:  "c5",
+								AuthorId:  "user-id",
+								Body:      "reply to deleted",
+								CreatedAt: timestamppb.New(createdAt),
+								UpdatedAt: timestamppb.New(updatedAt),
+								Owned:     true,
+							}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no_comments",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(nil, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListCommentsRequest{PageId: "page-1", LinkId: "link-1"},
+			},
+			want: want{res: &tsudzuriv1.ListCommentsResponse{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(nil, errors.New("list error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListCommentsRequest{PageId: "page-1", LinkId: "link-1"},
+			},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.ListCommentsRequest{PageId: "page-1", LinkId: "link-1"},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
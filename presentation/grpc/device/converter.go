@@ -0,0 +1,30 @@
+package device
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+)
+
+// toProtoDevice converts the device. The token is left out as the client already knows it.
+func toProtoDevice(d *ddevice.Device) *tsudzuriv1.Device {
+	if d == nil {
+		return nil
+	}
+
+	return &tsudzuriv1.Device{
+		Id:        d.ID(),
+		Platform:  string(d.Platform()),
+		CreatedAt: toProtoTimestamp(d.CreatedAt()),
+	}
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,46 @@
+package device
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	udevice "github.com/naka-sei/tsudzuri/usecase/device"
+)
+
+type RegisterService struct {
+	usecase struct {
+		register udevice.RegisterUsecase
+	}
+}
+
+func NewRegisterService(ru udevice.RegisterUsecase) *RegisterService {
+	return &RegisterService{
+		usecase: struct{ register udevice.RegisterUsecase }{register: ru},
+	}
+}
+
+func (s *RegisterService) Register(ctx context.Context, req *tsudzuriv1.RegisterDeviceRequest) (*tsudzuriv1.Device, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/device.Register")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Device register request platform=%s user_uid=%s", req.GetPlatform(), user.UID())
+
+	device, err := s.usecase.register.Register(ctx, req.GetToken(), ddevice.Platform(req.GetPlatform()))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Device register succeeded platform=%s user_uid=%s", device.Platform(), user.UID())
+	return toProtoDevice(device), nil
+}
@@ -0,0 +1,86 @@
+package device
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockregister "github.com/naka-sei/tsudzuri/usecase/device/mock/mock_register"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestRegisterService_Register(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.RegisterDeviceRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Device
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.RegisterDeviceRequest{Token: "token-1", Platform: "ios"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockregister.MockRegisterUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockregister.MockRegisterUsecase) {
+				m.EXPECT().Register(gomock.Any(), "token-1", ddevice.PlatformIOS).
+					Return(ddevice.ReconstructDevice("device-1", "user-id", "token-1", ddevice.PlatformIOS, fixedNow), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{res: &tsudzuriv1.Device{Id: "device-1", Platform: "ios", CreatedAt: timestamppb.New(fixedNow)}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockregister.MockRegisterUsecase) {
+				m.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, ddevice.ErrInvalidPlatform)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: ddevice.ErrInvalidPlatform},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockregister.NewMockRegisterUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewRegisterService(usecase)
+			got, err := svc.Register(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package device
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	udevice "github.com/naka-sei/tsudzuri/usecase/device"
+)
+
+type UnregisterService struct {
+	usecase struct {
+		unregister udevice.UnregisterUsecase
+	}
+}
+
+func NewUnregisterService(uu udevice.UnregisterUsecase) *UnregisterService {
+	return &UnregisterService{
+		usecase: struct{ unregister udevice.UnregisterUsecase }{unregister: uu},
+	}
+}
+
+func (s *UnregisterService) Unregister(ctx context.Context, req *tsudzuriv1.UnregisterDeviceRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/device.Unregister")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Device unregister request user_uid=%s", user.UID())
+
+	if err := s.usecase.unregister.Unregister(ctx, req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Device unregister succeeded user_uid=%s", user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,46 @@
+package invitation
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
+)
+
+type AcceptService struct {
+	usecase struct {
+		accept uinvitation.AcceptUsecase
+	}
+}
+
+func NewAcceptService(au uinvitation.AcceptUsecase) *AcceptService {
+	return &AcceptService{
+		usecase: struct{ accept uinvitation.AcceptUsecase }{accept: au},
+	}
+}
+
+func (s *AcceptService) Accept(ctx context.Context, req *tsudzuriv1.AcceptInvitationRequest) (*tsudzuriv1.AcceptInvitationResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/invitation.Accept")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	// The token itself is never logged.
+	logger.Sugar().Infof("Invitation accept request user_uid=%s", user.UID())
+
+	pageID, err := s.usecase.accept.Accept(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Invitation accept succeeded page_id=%s user_uid=%s", pageID, user.UID())
+	return &tsudzuriv1.AcceptInvitationResponse{PageId: pageID}, nil
+}
@@ -0,0 +1,90 @@
+package invitation
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockaccept "github.com/naka-sei/tsudzuri/usecase/invitation/mock/mock_accept"
+)
+
+func TestAcceptService_Accept(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.AcceptInvitationRequest
+	}
+	type want struct {
+		res *tsudzuriv1.AcceptInvitationResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.AcceptInvitationRequest{Token: "signed"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockaccept.MockAcceptUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockaccept.MockAcceptUsecase) {
+				m.EXPECT().Accept(gomock.Any(), "signed").Return("page-1", nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &tsudzuriv1.AcceptInvitationResponse{PageId: "page-1"}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockaccept.MockAcceptUsecase) {
+				m.EXPECT().Accept(gomock.Any(), "signed").Return("", dinvitation.ErrInvitationExpired)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: dinvitation.ErrInvitationExpired},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockaccept.NewMockAcceptUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewAcceptService(usecase)
+			got, err := svc.Accept(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
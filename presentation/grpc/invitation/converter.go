@@ -0,0 +1,42 @@
+package invitation
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+)
+
+// toProtoInvitation converts the invitation. The status is resolved at now so that pending invitations past their expiry read as expired.
+func toProtoInvitation(i *dinvitation.Invitation, now time.Time) *tsudzuriv1.Invitation {
+	if i == nil {
+		return nil
+	}
+
+	return &tsudzuriv1.Invitation{
+		Id:           i.ID(),
+		PageId:       i.PageID(),
+		Email:        i.Email(),
+		Status:       string(i.Status(now)),
+		ExpiresAt:    toProtoTimestamp(i.ExpiresAt()),
+		CreatedAt:    toProtoTimestamp(i.CreatedAt()),
+		AcceptedById: i.AcceptedByID(),
+	}
+}
+
+func toProtoInvitations(invitations []*dinvitation.Invitation, now time.Time) []*tsudzuriv1.Invitation {
+	protoInvitations := make([]*tsudzuriv1.Invitation, 0, len(invitations))
+	for _, i := range invitations {
+		protoInvitations = append(protoInvitations, toProtoInvitation(i, now))
+	}
+	return protoInvitations
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
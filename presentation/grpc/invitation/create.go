@@ -0,0 +1,46 @@
+package invitation
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
+)
+
+type CreateService struct {
+	usecase struct {
+		create uinvitation.CreateUsecase
+	}
+}
+
+func NewCreateService(cu uinvitation.CreateUsecase) *CreateService {
+	return &CreateService{
+		usecase: struct{ create uinvitation.CreateUsecase }{create: cu},
+	}
+}
+
+func (s *CreateService) Create(ctx context.Context, req *tsudzuriv1.CreateInvitationRequest) (*tsudzuriv1.Invitation, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/invitation.Create")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Invitation create request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	invitation, err := s.usecase.create.Create(ctx, req.GetPageId(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Invitation create succeeded invitation_id=%s user_uid=%s", invitation.ID(), user.UID())
+	return toProtoInvitation(invitation, ctxtime.Now(ctx)), nil
+}
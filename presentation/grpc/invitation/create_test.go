@@ -0,0 +1,118 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockcreate "github.com/naka-sei/tsudzuri/usecase/invitation/mock/mock_create"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateInvitationRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Invitation
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.CreateInvitationRequest{PageId: "page-1", Email: "friend@example.com"}
+	invitation := dinvitation.ReconstructInvitation("inv-1", "page-1", "friend@example.com", "user-id", "hash",
+		dinvitation.StatusPending, fixedNow.Add(dinvitation.ValidFor), dinvitation.WithTimestamps(fixedNow, fixedNow))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockcreate.MockCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "friend@example.com").Return(invitation, nil)
+			},
+			args: args{
+				ctx: ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Invitation{
+					Id:        "inv-1",
+					PageId:    "page-1",
+					Email:     "friend@example.com",
+					Status:    "pending",
+					ExpiresAt: timestamppb.New(fixedNow.Add(dinvitation.ValidFor)),
+					CreatedAt: timestamppb.New(fixedNow),
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "friend@example.com").Return(nil, dinvitation.ErrInvalidEmail)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: dinvitation.ErrInvalidEmail},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "mail_error",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "friend@example.com").Return(nil, errors.New("smtp error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: errors.New("smtp error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockcreate.NewMockCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package invitation
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
+)
+
+type ListService struct {
+	usecase struct {
+		list uinvitation.ListUsecase
+	}
+}
+
+func NewListService(lu uinvitation.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list uinvitation.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListInvitationsRequest) (*tsudzuriv1.ListInvitationsResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/invitation.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Invitation list request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	invitations, err := s.usecase.list.List(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListInvitationsResponse{
+		Invitations: toProtoInvitations(invitations, ctxtime.Now(ctx)),
+	}
+
+	logger.Sugar().Infof("Invitation list responded: count=%d user_uid=%s", len(resp.GetInvitations()), user.UID())
+	return resp, nil
+}
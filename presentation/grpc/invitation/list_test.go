@@ -0,0 +1,110 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/invitation/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListInvitationsRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListInvitationsResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.ListInvitationsRequest{PageId: "page-1"}
+	invitations := []*dinvitation.Invitation{
+		dinvitation.ReconstructInvitation("inv-3", "page-1", "c@example.com", "user-id", "hash-3",
+			dinvitation.StatusPending, fixedNow.Add(time.Hour)),
+		dinvitation.ReconstructInvitation("inv-2", "page-1", "b@example.com", "user-id", "hash-2",
+			dinvitation.StatusPending, fixedNow.Add(-time.Hour)),
+		dinvitation.ReconstructInvitation("inv-1", "page-1", "a@example.com", "user-id", "hash-1",
+			dinvitation.StatusAccepted, fixedNow.Add(-time.Hour), dinvitation.WithAcceptedByID("guest-id")),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success_resolves_expired",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1").Return(invitations, nil)
+			},
+			args: args{
+				ctx: ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.ListInvitationsResponse{
+					Invitations: []*tsudzuriv1.Invitation{
+						{Id: "inv-3", PageId: "page-1", Email: "c@example.com", Status: "pending", ExpiresAt: timestamppb.New(fixedNow.Add(time.Hour))},
+						{Id: "inv-2", PageId: "page-1", Email: "b@example.com", Status: "expired", ExpiresAt: timestamppb.New(fixedNow.Add(-time.Hour))},
+						{Id: "inv-1", PageId: "page-1", Email: "a@example.com", Status: "accepted", ExpiresAt: timestamppb.New(fixedNow.Add(-time.Hour)), AcceptedById: "guest-id"},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1").Return(nil, errors.New("list error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package invitation
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uinvitation "github.com/naka-sei/tsudzuri/usecase/invitation"
+)
+
+type RevokeService struct {
+	usecase struct {
+		revoke uinvitation.RevokeUsecase
+	}
+}
+
+func NewRevokeService(ru uinvitation.RevokeUsecase) *RevokeService {
+	return &RevokeService{
+		usecase: struct{ revoke uinvitation.RevokeUsecase }{revoke: ru},
+	}
+}
+
+func (s *RevokeService) Revoke(ctx context.Context, req *tsudzuriv1.RevokeInvitationRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/invitation.Revoke")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Invitation revoke request page_id=%s invitation_id=%s user_uid=%s", req.GetPageId(), req.GetInvitationId(), user.UID())
+
+	if err := s.usecase.revoke.Revoke(ctx, req.GetPageId(), req.GetInvitationId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Invitation revoke succeeded invitation_id=%s user_uid=%s", req.GetInvitationId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+)
+
+func toProtoNotification(n *dnotification.Notification) *tsudzuriv1.Notification {
+	if n == nil {
+		return nil
+	}
+
+	return &tsudzuriv1.Notification{
+		Id:        n.ID(),
+		Kind:      string(n.Kind()),
+		PageId:    n.PageID(),
+		ActorId:   n.ActorID(),
+		Count:     int32(n.Count()),
+		Read:      n.IsRead(),
+		CreatedAt: toProtoTimestamp(n.CreatedAt()),
+	}
+}
+
+func toProtoNotifications(notifications []*dnotification.Notification) []*tsudzuriv1.Notification {
+	protoNotifications := make([]*tsudzuriv1.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		protoNotifications = append(protoNotifications, toProtoNotification(n))
+	}
+	return protoNotifications
+}
+
+// toProtoPreferences lists every kind with its setting so that clients can render all toggles.
+func toProtoPreferences(ps *dnotification.Preferences) *tsudzuriv1.NotificationPreferences {
+	kinds := dnotification.Kinds()
+	preferences := make([]*tsudzuriv1.NotificationPreference, 0, len(kinds))
+	for _, kind := range kinds {
+		preferences = append(preferences, &tsudzuriv1.NotificationPreference{
+			Kind:    string(kind),
+			Enabled: ps.Enabled(kind),
+		})
+	}
+	return &tsudzuriv1.NotificationPreferences{Preferences: preferences}
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/presentation/grpc/pagination"
+	unotification "github.com/naka-sei/tsudzuri/usecase/notification"
+)
+
+type ListService struct {
+	usecase struct {
+		list unotification.ListUsecase
+	}
+}
+
+func NewListService(lu unotification.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list unotification.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListNotificationsRequest) (*tsudzuriv1.ListNotificationsResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/notification.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Notification list request unread_only=%t user_uid=%s", req.GetUnreadOnly(), user.UID())
+
+	page, err := pagination.ValidtePage(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := pagination.ValidatePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, unread, err := s.usecase.list.List(ctx,
+		dnotification.WithUnreadOnly(req.GetUnreadOnly()),
+		dnotification.WithPageSearchOption(page),
+		dnotification.WithPageSizeSearchOption(pageSize),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListNotificationsResponse{
+		Notifications: toProtoNotifications(notifications),
+		UnreadCount:   int32(unread),
+	}
+
+	logger.Sugar().Infof("Notification list responded: count=%d unread=%d user_uid=%s", len(resp.GetNotifications()), unread, user.UID())
+	return resp, nil
+}
@@ -0,0 +1,127 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	"github.com/naka-sei/tsudzuri/presentation/grpc/pagination"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/notification/mock/mock_list"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListNotificationsRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListNotificationsResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	readAt := fixedNow.Add(time.Minute)
+	notifications := []*dnotification.Notification{
+		dnotification.ReconstructNotification("n-2", "user-id", "actor-id", "page-1", dnotification.KindLinksAdded, 3, nil, fixedNow),
+		dnotification.ReconstructNotification("n-1", "user-id", "actor-id", "page-1", dnotification.KindMemberJoined, 1, &readAt, fixedNow),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, options ...dnotification.SearchOption) ([]*dnotification.Notification, int, error) {
+						var params dnotification.SearchParams
+						for _, o := range options {
+							o.Apply(&params)
+						}
+						if !params.UnreadOnly || *params.Page != 2 || *params.PageSize != pagination.DefaultPageSize {
+							t.Errorf("unexpected search params: %+v", params)
+						}
+						return notifications, 1, nil
+					})
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListNotificationsRequest{UnreadOnly: true, Page: proto.Int32(2)},
+			},
+			want: want{
+				res: &tsudzuriv1.ListNotificationsResponse{
+					Notifications: []*tsudzuriv1.Notification{
+						{Id: "n-2", Kind: "links_added", PageId: "page-1", ActorId: "actor-id", Count: 3, CreatedAt: timestamppb.New(fixedNow)},
+						{Id: "n-1", Kind: "member_joined", PageId: "page-1", ActorId: "actor-id", Count: 1, Read: true, CreatedAt: timestamppb.New(fixedNow)},
+					},
+					UnreadCount: 1,
+				},
+			},
+		},
+		{
+			name: "invalid_page_size",
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListNotificationsRequest{PageSize: proto.Int32(pagination.MaxPageSize + 1)},
+			},
+			want: want{err: pagination.ErrInvalidPage},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("list error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListNotificationsRequest{},
+			},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.ListNotificationsRequest{},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	unotification "github.com/naka-sei/tsudzuri/usecase/notification"
+)
+
+type MarkReadService struct {
+	usecase struct {
+		markRead unotification.MarkReadUsecase
+	}
+}
+
+func NewMarkReadService(mu unotification.MarkReadUsecase) *MarkReadService {
+	return &MarkReadService{
+		usecase: struct{ markRead unotification.MarkReadUsecase }{markRead: mu},
+	}
+}
+
+func (s *MarkReadService) MarkRead(ctx context.Context, req *tsudzuriv1.MarkNotificationsReadRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/notification.MarkRead")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Notification mark read request count=%d user_uid=%s", len(req.GetIds()), user.UID())
+
+	if err := s.usecase.markRead.MarkRead(ctx, req.GetIds()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Notification mark read succeeded user_uid=%s", user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	unotification "github.com/naka-sei/tsudzuri/usecase/notification"
+)
+
+type PreferenceGetService struct {
+	usecase struct {
+		get unotification.PreferenceGetUsecase
+	}
+}
+
+func NewPreferenceGetService(gu unotification.PreferenceGetUsecase) *PreferenceGetService {
+	return &PreferenceGetService{
+		usecase: struct {
+			get unotification.PreferenceGetUsecase
+		}{get: gu},
+	}
+}
+
+func (s *PreferenceGetService) Get(ctx context.Context, _ *emptypb.Empty) (*tsudzuriv1.NotificationPreferences, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/notification.GetPreferences")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Notification preferences get request user_uid=%s", user.UID())
+
+	preferences, err := s.usecase.get.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Notification preferences get succeeded user_uid=%s", user.UID())
+	return toProtoPreferences(preferences), nil
+}
@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockpreferenceget "github.com/naka-sei/tsudzuri/usecase/notification/mock/mock_preference_get"
+)
+
+func TestPreferenceGetService_Get(t *testing.T) {
+	type want struct {
+		res *tsudzuriv1.NotificationPreferences
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockpreferenceget.MockPreferenceGetUsecase)
+		ctx   context.Context
+		want  want
+	}{
+		{
+			name: "success_lists_every_kind",
+			setup: func(m *mockpreferenceget.MockPreferenceGetUsecase) {
+				m.EXPECT().GetPreferences(gomock.Any()).
+					Return(dnotification.ReconstructPreferences("user-id", []dnotification.Kind{dnotification.KindLinksAdded}), nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+			want: want{
+				res: &tsudzuriv1.NotificationPreferences{
+					Preferences: []*tsudzuriv1.NotificationPreference{
+						{Kind: "member_joined", Enabled: true},
+						{Kind: "links_added", Enabled: false},
+						{Kind: "page_archived", Enabled: true},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockpreferenceget.MockPreferenceGetUsecase) {
+				m.EXPECT().GetPreferences(gomock.Any()).Return(nil, errors.New("get error"))
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{err: errors.New("get error")},
+		},
+		{
+			name: "user_not_found",
+			ctx:  context.Background(),
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockpreferenceget.NewMockPreferenceGetUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewPreferenceGetService(usecase)
+			got, err := svc.Get(tt.ctx, &emptypb.Empty{})
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
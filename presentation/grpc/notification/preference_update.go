@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	unotification "github.com/naka-sei/tsudzuri/usecase/notification"
+)
+
+type PreferenceUpdateService struct {
+	usecase struct {
+		update unotification.PreferenceUpdateUsecase
+	}
+}
+
+func NewPreferenceUpdateService(uu unotification.PreferenceUpdateUsecase) *PreferenceUpdateService {
+	return &PreferenceUpdateService{
+		usecase: struct {
+			update unotification.PreferenceUpdateUsecase
+		}{update: uu},
+	}
+}
+
+func (s *PreferenceUpdateService) Update(ctx context.Context, req *tsudzuriv1.UpdateNotificationPreferencesRequest) (*tsudzuriv1.NotificationPreferences, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/notification.UpdatePreferences")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Notification preferences update request count=%d user_uid=%s", len(req.GetPreferences()), user.UID())
+
+	settings := make(map[dnotification.Kind]bool, len(req.GetPreferences()))
+	for _, p := range req.GetPreferences() {
+		settings[dnotification.Kind(p.GetKind())] = p.GetEnabled()
+	}
+
+	preferences, err := s.usecase.update.UpdatePreferences(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Notification preferences update succeeded user_uid=%s", user.UID())
+	return toProtoPreferences(preferences), nil
+}
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockpreferenceupdate "github.com/naka-sei/tsudzuri/usecase/notification/mock/mock_preference_update"
+)
+
+func TestPreferenceUpdateService_Update(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.UpdateNotificationPreferencesRequest
+	}
+	type want struct {
+		res *tsudzuriv1.NotificationPreferences
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.UpdateNotificationPreferencesRequest{
+		Preferences: []*tsudzuriv1.NotificationPreference{
+			{Kind: "member_joined", Enabled: false},
+			{Kind: "links_added", Enabled: true},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockpreferenceupdate.MockPreferenceUpdateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockpreferenceupdate.MockPreferenceUpdateUsecase) {
+				m.EXPECT().UpdatePreferences(gomock.Any(), map[dnotification.Kind]bool{
+					dnotification.KindMemberJoined: false,
+					dnotification.KindLinksAdded:   true,
+				}).Return(dnotification.ReconstructPreferences("user-id", []dnotification.Kind{dnotification.KindMemberJoined}), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.NotificationPreferences{
+					Preferences: []*tsudzuriv1.NotificationPreference{
+						{Kind: "member_joined", Enabled: false},
+						{Kind: "links_added", Enabled: true},
+						{Kind: "page_archived", Enabled: true},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockpreferenceupdate.MockPreferenceUpdateUsecase) {
+				m.EXPECT().UpdatePreferences(gomock.Any(), gomock.Any()).Return(nil, dnotification.ErrInvalidKind)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: dnotification.ErrInvalidKind},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockpreferenceupdate.NewMockPreferenceUpdateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewPreferenceUpdateService(usecase)
+			got, err := svc.Update(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
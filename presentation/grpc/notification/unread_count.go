@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	unotification "github.com/naka-sei/tsudzuri/usecase/notification"
+)
+
+type UnreadCountService struct {
+	usecase struct {
+		unreadCount unotification.UnreadCountUsecase
+	}
+}
+
+func NewUnreadCountService(uu unotification.UnreadCountUsecase) *UnreadCountService {
+	return &UnreadCountService{
+		usecase: struct {
+			unreadCount unotification.UnreadCountUsecase
+		}{unreadCount: uu},
+	}
+}
+
+func (s *UnreadCountService) UnreadCount(ctx context.Context, _ *emptypb.Empty) (*tsudzuriv1.UnreadNotificationCount, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/notification.UnreadCount")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Notification unread count request user_uid=%s", user.UID())
+
+	count, err := s.usecase.unreadCount.UnreadCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Notification unread count responded: count=%d user_uid=%s", count, user.UID())
+	return &tsudzuriv1.UnreadNotificationCount{Count: int32(count)}, nil
+}
@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockunreadcount "github.com/naka-sei/tsudzuri/usecase/notification/mock/mock_unread_count"
+)
+
+func TestUnreadCountService_UnreadCount(t *testing.T) {
+	type want struct {
+		res *tsudzuriv1.UnreadNotificationCount
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockunreadcount.MockUnreadCountUsecase)
+		ctx   context.Context
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockunreadcount.MockUnreadCountUsecase) {
+				m.EXPECT().UnreadCount(gomock.Any()).Return(5, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{res: &tsudzuriv1.UnreadNotificationCount{Count: 5}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockunreadcount.MockUnreadCountUsecase) {
+				m.EXPECT().UnreadCount(gomock.Any()).Return(0, errors.New("count error"))
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{err: errors.New("count error")},
+		},
+		{
+			name: "user_not_found",
+			ctx:  context.Background(),
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockunreadcount.NewMockUnreadCountUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewUnreadCountService(usecase)
+			got, err := svc.UnreadCount(tt.ctx, &emptypb.Empty{})
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
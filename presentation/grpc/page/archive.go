@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type ArchiveService struct {
+	usecase struct {
+		archive upage.ArchiveUsecase
+	}
+}
+
+func NewArchiveService(u upage.ArchiveUsecase) *ArchiveService {
+	return &ArchiveService{
+		usecase: struct{ archive upage.ArchiveUsecase }{archive: u},
+	}
+}
+
+func (s *ArchiveService) Archive(ctx context.Context, req *tsudzuriv1.ArchivePageRequest) (*tsudzuriv1.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ArchivePage")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page archive request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	page, err := s.usecase.archive.Archive(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page archive succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return toProtoPage(page, user), nil
+}
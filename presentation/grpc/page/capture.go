@@ -0,0 +1,54 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type CaptureService struct {
+	usecase struct {
+		capture upage.CaptureUsecase
+	}
+}
+
+func NewCaptureService(cu upage.CaptureUsecase) *CaptureService {
+	return &CaptureService{
+		usecase: struct{ capture upage.CaptureUsecase }{capture: cu},
+	}
+}
+
+func (s *CaptureService) Capture(ctx context.Context, req *tsudzuriv1.CaptureLinkRequest) (*tsudzuriv1.CaptureLinkResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.CaptureLink")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link capture request url=%s user_uid=%s", req.GetUrl(), user.UID())
+
+	input := upage.CaptureUsecaseInput{
+		URL:   req.GetUrl(),
+		Title: req.GetTitle(),
+		Text:  req.GetText(),
+	}
+
+	page, added, err := s.usecase.capture.Capture(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link capture succeeded page_id=%s added=%t user_uid=%s", page.ID(), added, user.UID())
+	return &tsudzuriv1.CaptureLinkResponse{
+		PageId: page.ID(),
+		Added:  added,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockcapture "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_capture"
+)
+
+func TestCaptureService_Capture(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CaptureLinkRequest
+	}
+	type want struct {
+		res *tsudzuriv1.CaptureLinkResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	inbox := dpage.ReconstructPage("page-1", "受信箱", *user, "invite-code", dpage.Links{}, nil)
+	req := &tsudzuriv1.CaptureLinkRequest{Url: "https://example.com", Title: "Example", Text: "selected"}
+	input := upage.CaptureUsecaseInput{URL: "https://example.com", Title: "Example", Text: "selected"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockcapture.MockCaptureUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "added",
+			setup: func(m *mockcapture.MockCaptureUsecase) {
+				m.EXPECT().Capture(gomock.Any(), input).Return(inbox, true, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.CaptureLinkResponse{PageId: "page-1", Added: true},
+			},
+		},
+		{
+			name: "captured_recently",
+			setup: func(m *mockcapture.MockCaptureUsecase) {
+				m.EXPECT().Capture(gomock.Any(), input).Return(inbox, false, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.CaptureLinkResponse{PageId: "page-1"},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockcapture.MockCaptureUsecase) {
+				m.EXPECT().Capture(gomock.Any(), input).Return(nil, false, errors.New("capture error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				err: errors.New("capture error"),
+			},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockcapture.NewMockCaptureUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewCaptureService(usecase)
+			got, err := svc.Capture(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type ChecklistSetService struct {
+	usecase struct {
+		checklistSet upage.ChecklistSetUsecase
+	}
+}
+
+func NewChecklistSetService(u upage.ChecklistSetUsecase) *ChecklistSetService {
+	return &ChecklistSetService{
+		usecase: struct{ checklistSet upage.ChecklistSetUsecase }{checklistSet: u},
+	}
+}
+
+func (s *ChecklistSetService) Set(ctx context.Context, req *tsudzuriv1.SetChecklistModeRequest) (*tsudzuriv1.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.SetChecklistMode")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Checklist mode set request page_id=%s enabled=%t user_uid=%s", req.GetPageId(), req.GetEnabled(), user.UID())
+
+	page, err := s.usecase.checklistSet.SetChecklist(ctx, req.GetPageId(), req.GetEnabled())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Checklist mode set succeeded page_id=%s enabled=%t user_uid=%s", req.GetPageId(), req.GetEnabled(), user.UID())
+	return toProtoPage(page, user), nil
+}
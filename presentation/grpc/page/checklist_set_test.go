@@ -0,0 +1,120 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockchecklistset "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_checklist_set"
+)
+
+func TestChecklistSetService_Set(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.SetChecklistModeRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Page
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.SetChecklistModeRequest{PageId: "page-1", Enabled: true}
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	page := dpage.ReconstructPage("page-1", "Title", *user, "code", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "", 1, dpage.WithLinkID("link-1"), dpage.WithLinkDone("user-id", doneAt)),
+		dpage.ReconstructLink("https://b.com", "", 2, dpage.WithLinkID("link-2")),
+	}, nil, dpage.WithChecklist(true))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockchecklistset.MockChecklistSetUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockchecklistset.MockChecklistSetUsecase) {
+				m.EXPECT().SetChecklist(gomock.Any(), "page-1", true).Return(page, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:    "page-1",
+					Title: "Title",
+					Links: []*tsudzuriv1.Link{
+						{Id: "link-1", Url: "https://a.com", Priority: 1, State: "unread", Done: true, DoneByUserId: "user-id", DoneAt: timestamppb.New(doneAt)},
+						{Id: "link-2", Url: "https://b.com", Priority: 2, State: "unread"},
+					},
+					InviteCode:  "code",
+					Creator:     &tsudzuriv1.PageUser{Id: "user-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "user-id", Provider: "anonymous"}},
+					UnreadCount: 2,
+					Checklist:   true,
+					Progress:    &tsudzuriv1.ChecklistProgress{Done: 1, Total: 2},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockchecklistset.MockChecklistSetUsecase) {
+				m.EXPECT().SetChecklist(gomock.Any(), "page-1", true).Return(nil, errors.New("set error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("set error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockchecklistset.NewMockChecklistSetUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewChecklistSetService(usecase)
+			got, err := svc.Set(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -2,10 +2,14 @@ package page
 
 import (
 	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/markdown"
 )
 
 func toProtoPage(p *dpage.Page, user *duser.User) *tsudzuriv1.Page {
@@ -14,28 +18,137 @@ func toProtoPage(p *dpage.Page, user *duser.User) *tsudzuriv1.Page {
 	}
 
 	protoPage := &tsudzuriv1.Page{
-		Id:         p.ID(),
-		Title:      p.Title(),
-		InviteCode: p.InviteCode(user),
-	}
-
-	if links := p.Links(); len(links) > 0 {
-		protoPage.Links = make([]*tsudzuriv1.Link, 0, len(links))
-		for _, lnk := range links {
-			priority := lnk.Priority()
-			var priorityInt32 int32
-			if priority < math.MinInt32 || priority > math.MaxInt32 {
-				priorityInt32 = 0 // Default to 0 if out of range
-			} else {
-				priorityInt32 = int32(priority) // #nosec G115 - validated range above
-			}
-			protoPage.Links = append(protoPage.Links, &tsudzuriv1.Link{
-				Url:      lnk.URL(),
-				Memo:     lnk.Memo(),
-				Priority: priorityInt32,
-			})
+		Id:           p.ID(),
+		Title:        p.Title(),
+		InviteCode:   p.InviteCode(user),
+		SourcePageId: p.SourcePageID(),
+		UnreadCount:  toProtoPriority(p.UnreadCount(user)),
+		Checklist:    p.IsChecklist(),
+		Archived:     p.IsArchived(),
+		Icon:         p.Icon(),
+		Color:        p.Color(),
+		Slug:         p.Slug(),
+		CreatedAt:    toProtoTimestamp(p.CreatedAt()),
+		UpdatedAt:    toProtoTimestamp(p.UpdatedAt()),
+		Creator:      toProtoPageUser(p.CreatedBy()),
+		Members:      toProtoPageMembers(p),
+	}
+	if d := p.Description(); d != "" {
+		protoPage.Description = d
+		protoPage.DescriptionHtml = markdown.Render(d)
+	}
+	if p.IsChecklist() {
+		done, total := p.Progress()
+		protoPage.Progress = &tsudzuriv1.ChecklistProgress{
+			Done:  toProtoPriority(done),
+			Total: toProtoPriority(total),
+		}
+	}
+
+	sectionsByID := make(map[string]*tsudzuriv1.Section, len(p.Sections()))
+	if sections := p.Sections(); len(sections) > 0 {
+		protoPage.Sections = make([]*tsudzuriv1.Section, 0, len(sections))
+		for _, s := range sections {
+			protoSection := toProtoSection(s)
+			protoPage.Sections = append(protoPage.Sections, protoSection)
+			sectionsByID[s.ID()] = protoSection
 		}
 	}
 
+	// Links of a section are nested under it; the rest stay on the page.
+	for _, lnk := range p.Links() {
+		protoLink := toProtoLink(lnk, user)
+		if section, ok := sectionsByID[lnk.SectionID()]; ok {
+			section.Links = append(section.Links, protoLink)
+			continue
+		}
+		protoPage.Links = append(protoPage.Links, protoLink)
+	}
+
 	return protoPage
 }
+
+// toProtoPageUser returns the public information of the user. The email must never be included.
+func toProtoPageUser(u *duser.User) *tsudzuriv1.PageUser {
+	if u == nil || u.ID() == "" {
+		return nil
+	}
+	profile := u.Profile()
+	return &tsudzuriv1.PageUser{
+		Id:          u.ID(),
+		Provider:    string(u.Provider()),
+		DisplayName: profile.DisplayName(),
+		AvatarUrl:   profile.AvatarURL(),
+	}
+}
+
+// toProtoPageMembers returns the creator followed by the invited users.
+func toProtoPageMembers(p *dpage.Page) []*tsudzuriv1.PageUser {
+	members := make([]*tsudzuriv1.PageUser, 0, len(p.InvitedUsers())+1)
+	if creator := toProtoPageUser(p.CreatedBy()); creator != nil {
+		members = append(members, creator)
+	}
+	for _, u := range p.InvitedUsers() {
+		if member := toProtoPageUser(u); member != nil {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func toProtoSection(s dpage.Section) *tsudzuriv1.Section {
+	return &tsudzuriv1.Section{
+		Id:       s.ID(),
+		Name:     s.Name(),
+		Priority: toProtoPriority(s.Priority()),
+	}
+}
+
+func toProtoLink(l dpage.Link, user *duser.User) *tsudzuriv1.Link {
+	protoLink := &tsudzuriv1.Link{
+		Id:        l.ID(),
+		Url:       l.URL(),
+		Memo:      l.Memo(),
+		Priority:  toProtoPriority(l.Priority()),
+		SectionId: l.SectionID(),
+		Votes:     toProtoPriority(l.Reactions().Votes()),
+	}
+	if l.Done() {
+		protoLink.Done = true
+		protoLink.DoneByUserId = l.DoneBy()
+		protoLink.DoneAt = timestamppb.New(l.DoneAt())
+	}
+	if h := l.Health(); h.Status() != dpage.LinkHealthUnchecked {
+		protoLink.Health = string(h.Status())
+		protoLink.HealthStatusCode = toProtoPriority(h.StatusCode())
+		protoLink.RedirectUrl = h.RedirectURL()
+		protoLink.CheckedAt = timestamppb.New(h.CheckedAt())
+	}
+	for _, c := range l.Reactions().Counts() {
+		protoLink.Reactions = append(protoLink.Reactions, &tsudzuriv1.ReactionCount{
+			Emoji: c.Emoji,
+			Count: toProtoPriority(c.Count),
+		})
+	}
+	if user != nil {
+		if r, ok := l.Reactions().Of(user.ID()); ok {
+			protoLink.MyReaction = r.Emoji()
+		}
+		protoLink.State = string(l.States().Of(user.ID()))
+	}
+	return protoLink
+}
+
+func toProtoPriority(priority int) int32 {
+	if priority < math.MinInt32 || priority > math.MaxInt32 {
+		return 0 // Default to 0 if out of range
+	}
+	return int32(priority) // #nosec G115 - validated range above
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
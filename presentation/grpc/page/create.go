@@ -34,9 +34,9 @@ func (s *CreateService) Create(ctx context.Context, req *tsudzuriv1.CreatePageRe
 		return nil, duser.ErrUserNotFound
 	}
 
-	logger.Sugar().Infof("Page create request: title=%s user_uid=%s", req.GetTitle(), user.UID())
+	logger.Sugar().Infof("Page create request: title=%s template_id=%s user_uid=%s", req.GetTitle(), req.GetTemplateId(), user.UID())
 
-	if _, err := s.usecase.create.Create(ctx, req.GetTitle()); err != nil {
+	if _, err := s.usecase.create.Create(ctx, req.GetTitle(), req.GetTemplateId()); err != nil {
 		return nil, err
 	}
 
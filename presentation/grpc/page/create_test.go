@@ -40,7 +40,7 @@ func TestCreateService_Create(t *testing.T) {
 			name: "success",
 			setup: func(m *mockcreate.MockCreateUsecase) {
 				page := dpage.ReconstructPage("page-id", "test-title", *user, "invite", nil, nil)
-				m.EXPECT().Create(gomock.Any(), "test-title").Return(page, nil)
+				m.EXPECT().Create(gomock.Any(), "test-title", "").Return(page, nil)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), user),
@@ -51,10 +51,25 @@ func TestCreateService_Create(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "success_from_template",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				page := dpage.ReconstructPage("page-id", "template-title", *user, "invite", nil, nil)
+				m.EXPECT().Create(gomock.Any(), "", "template-id").Return(page, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.CreatePageRequest{TemplateId: "template-id"},
+			},
+			want: want{
+				res: &emptypb.Empty{},
+				err: nil,
+			},
+		},
 		{
 			name: "usecase_error",
 			setup: func(m *mockcreate.MockCreateUsecase) {
-				m.EXPECT().Create(gomock.Any(), "fail-title").Return(nil, errors.New("usecase error"))
+				m.EXPECT().Create(gomock.Any(), "fail-title", "").Return(nil, errors.New("usecase error"))
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), user),
@@ -0,0 +1,47 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type DuplicateService struct {
+	usecase struct {
+		duplicate upage.DuplicateUsecase
+	}
+}
+
+func NewDuplicateService(du upage.DuplicateUsecase) *DuplicateService {
+	return &DuplicateService{
+		usecase: struct{ duplicate upage.DuplicateUsecase }{duplicate: du},
+	}
+}
+
+func (s *DuplicateService) Duplicate(ctx context.Context, req *tsudzuriv1.DuplicatePageRequest) (*tsudzuriv1.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.Duplicate")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page duplicate request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	page, err := s.usecase.duplicate.Duplicate(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toProtoPage(page, user)
+	logger.Sugar().Infof("Page duplicate succeeded: source_page_id=%s page_id=%s user_uid=%s", req.GetPageId(), resp.GetId(), user.UID())
+
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockduplicate "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_duplicate"
+)
+
+func TestDuplicateService_Duplicate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.DuplicatePageRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Page
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	duplicated := dpage.ReconstructPage("page-2", "title のコピー", *user, "NEWCODE1", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1")),
+	}, nil, dpage.WithSourcePageID("page-1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockduplicate.MockDuplicateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockduplicate.MockDuplicateUsecase) {
+				m.EXPECT().Duplicate(gomock.Any(), "page-1").Return(duplicated, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.DuplicatePageRequest{PageId: "page-1"},
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:           "page-2",
+					Title:        "title のコピー",
+					InviteCode:   "NEWCODE1",
+					Creator:      &tsudzuriv1.PageUser{Id: "user-id", Provider: "anonymous"},
+					Members:      []*tsudzuriv1.PageUser{{Id: "user-id", Provider: "anonymous"}},
+					SourcePageId: "page-1",
+					UnreadCount:  1,
+					Links: []*tsudzuriv1.Link{{
+						Id:       "link-1",
+						Url:      "https://example.com",
+						Memo:     "memo",
+						Priority: 1,
+						State:    "unread",
+					}},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockduplicate.MockDuplicateUsecase) {
+				m.EXPECT().Duplicate(gomock.Any(), "page-1").Return(nil, errors.New("duplicate error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.DuplicatePageRequest{PageId: "page-1"},
+			},
+			want: want{
+				err: errors.New("duplicate error"),
+			},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.DuplicatePageRequest{PageId: "page-1"},
+			},
+			want: want{
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockduplicate.NewMockDuplicateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewDuplicateService(usecase)
+			got, err := svc.Duplicate(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
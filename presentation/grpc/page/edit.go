@@ -42,7 +42,18 @@ func (s *EditService) Edit(ctx context.Context, req *tsudzuriv1.EditPageRequest)
 		links = append(links, dpage.ReconstructLink(lnk.GetUrl(), lnk.GetMemo(), int(lnk.GetPriority())))
 	}
 
-	if err := s.usecase.edit.Edit(ctx, req.GetPageId(), req.GetTitle(), links); err != nil {
+	var options []upage.EditOption
+	if req.Description != nil {
+		options = append(options, upage.WithDescription(req.GetDescription().GetValue()))
+	}
+	if req.Icon != nil {
+		options = append(options, upage.WithIcon(req.GetIcon().GetValue()))
+	}
+	if req.Color != nil {
+		options = append(options, upage.WithColor(req.GetColor().GetValue()))
+	}
+
+	if err := s.usecase.edit.Edit(ctx, req.GetPageId(), req.GetTitle(), links, options...); err != nil {
 		return nil, err
 	}
 
@@ -9,12 +9,14 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
 	mockedit "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_edit"
 )
 
@@ -61,6 +63,32 @@ func TestEditService_Edit(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "success_with_details",
+			setup: func(m *mockedit.MockEditUsecase) {
+				m.EXPECT().Edit(gomock.Any(), "page-1", "new-title", dpage.Links{}, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ string, _ dpage.Links, options ...upage.EditOption) error {
+						// Only the description and the color are set in the request.
+						if len(options) != 2 {
+							t.Errorf("options = %d, want 2", len(options))
+						}
+						return nil
+					})
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.EditPageRequest{
+					PageId:      "page-1",
+					Title:       "new-title",
+					Description: wrapperspb.String("**desc**"),
+					Color:       wrapperspb.String(""),
+				},
+			},
+			want: want{
+				res: &emptypb.Empty{},
+				err: nil,
+			},
+		},
 		{
 			name: "usecase_error",
 			setup: func(m *mockedit.MockEditUsecase) {
@@ -0,0 +1,50 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type ExportService struct {
+	usecase struct {
+		export upage.ExportUsecase
+	}
+}
+
+func NewExportService(eu upage.ExportUsecase) *ExportService {
+	return &ExportService{
+		usecase: struct{ export upage.ExportUsecase }{export: eu},
+	}
+}
+
+func (s *ExportService) Export(ctx context.Context, req *tsudzuriv1.ExportPageRequest) (*httpbody.HttpBody, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.Export")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page export request page_id=%s format=%s user_uid=%s", req.GetPageId(), req.GetFormat(), user.UID())
+
+	out, err := s.usecase.export.Export(ctx, req.GetPageId(), req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page export responded: page_id=%s bytes=%d user_uid=%s", req.GetPageId(), len(out.Content), user.UID())
+	return &httpbody.HttpBody{
+		ContentType: out.ContentType,
+		Data:        out.Content,
+	}, nil
+}
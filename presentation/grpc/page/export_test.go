@@ -0,0 +1,99 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockexport "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_export"
+)
+
+func TestExportService_Export(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ExportPageRequest
+	}
+	type want struct {
+		res *httpbody.HttpBody
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockexport.MockExportUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockexport.MockExportUsecase) {
+				m.EXPECT().Export(gomock.Any(), "page-1", "opml").Return(&upage.ExportUsecaseOutput{
+					ContentType: "text/x-opml; charset=utf-8",
+					Content:     []byte("<opml/>"),
+				}, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ExportPageRequest{PageId: "page-1", Format: "opml"},
+			},
+			want: want{
+				res: &httpbody.HttpBody{
+					ContentType: "text/x-opml; charset=utf-8",
+					Data:        []byte("<opml/>"),
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockexport.MockExportUsecase) {
+				m.EXPECT().Export(gomock.Any(), "page-1", "").Return(nil, errors.New("export error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ExportPageRequest{PageId: "page-1"},
+			},
+			want: want{err: errors.New("export error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.ExportPageRequest{PageId: "page-1"},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockexport.NewMockExportUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewExportService(usecase)
+			got, err := svc.Export(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
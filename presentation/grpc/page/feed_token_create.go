@@ -0,0 +1,50 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/presentation/http/feed"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type FeedTokenCreateService struct {
+	usecase struct {
+		feedTokenCreate upage.FeedTokenCreateUsecase
+	}
+}
+
+func NewFeedTokenCreateService(fu upage.FeedTokenCreateUsecase) *FeedTokenCreateService {
+	return &FeedTokenCreateService{
+		usecase: struct{ feedTokenCreate upage.FeedTokenCreateUsecase }{feedTokenCreate: fu},
+	}
+}
+
+func (s *FeedTokenCreateService) Create(ctx context.Context, req *tsudzuriv1.CreateFeedTokenRequest) (*tsudzuriv1.CreateFeedTokenResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.CreateFeedToken")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page feed token create request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	token, err := s.usecase.feedTokenCreate.CreateFeedToken(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page feed token create succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &tsudzuriv1.CreateFeedTokenResponse{
+		Token:    token,
+		AtomPath: feed.AtomPath(req.GetPageId(), token),
+		RssPath:  feed.RSSPath(req.GetPageId(), token),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockfeedtokencreate "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_feed_token_create"
+)
+
+func TestFeedTokenCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateFeedTokenRequest
+	}
+	type want struct {
+		res *tsudzuriv1.CreateFeedTokenResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockfeedtokencreate.MockFeedTokenCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockfeedtokencreate.MockFeedTokenCreateUsecase) {
+				m.EXPECT().CreateFeedToken(gomock.Any(), "page-1").Return("secret", nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.CreateFeedTokenRequest{PageId: "page-1"},
+			},
+			want: want{
+				res: &tsudzuriv1.CreateFeedTokenResponse{
+					Token:    "secret",
+					AtomPath: "/feeds/pages/page-1.atom?token=secret",
+					RssPath:  "/feeds/pages/page-1.rss?token=secret",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockfeedtokencreate.MockFeedTokenCreateUsecase) {
+				m.EXPECT().CreateFeedToken(gomock.Any(), "page-1").Return("", errors.New("create error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.CreateFeedTokenRequest{PageId: "page-1"},
+			},
+			want: want{err: errors.New("create error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.CreateFeedTokenRequest{PageId: "page-1"},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockfeedtokencreate.NewMockFeedTokenCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewFeedTokenCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
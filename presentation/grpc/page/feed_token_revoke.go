@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type FeedTokenRevokeService struct {
+	usecase struct {
+		feedTokenRevoke upage.FeedTokenRevokeUsecase
+	}
+}
+
+func NewFeedTokenRevokeService(fu upage.FeedTokenRevokeUsecase) *FeedTokenRevokeService {
+	return &FeedTokenRevokeService{
+		usecase: struct{ feedTokenRevoke upage.FeedTokenRevokeUsecase }{feedTokenRevoke: fu},
+	}
+}
+
+func (s *FeedTokenRevokeService) Revoke(ctx context.Context, req *tsudzuriv1.RevokeFeedTokenRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.RevokeFeedToken")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page feed token revoke request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	if err := s.usecase.feedTokenRevoke.RevokeFeedToken(ctx, req.GetPageId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page feed token revoke succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
@@ -33,9 +34,9 @@ func (s *GetService) Get(ctx context.Context, req *tsudzuriv1.GetPageRequest) (*
 		return nil, duser.ErrUserNotFound
 	}
 
-	logger.Sugar().Infof("Page get request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	logger.Sugar().Infof("Page get request page_id=%s link_order=%s user_uid=%s", req.GetPageId(), req.GetLinkOrder(), user.UID())
 
-	page, err := s.usecase.get.Get(ctx, req.GetPageId())
+	page, err := s.usecase.get.Get(ctx, req.GetPageId(), dpage.LinkOrder(req.GetLinkOrder()))
 	if err != nil {
 		return nil, err
 	}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	cmp "github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/ptr"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
 	mockget "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_get"
 )
@@ -28,12 +31,40 @@ func TestGetService_Get(t *testing.T) {
 	}
 
 	creator := duser.ReconstructUser("creator-id", "uid-1", "anonymous", nil)
-	invited := duser.ReconstructUser("invited-id", "uid-2", "anonymous", nil)
+	invited := duser.ReconstructUser("invited-id", "uid-2", "anonymous", ptr.Ptr("invited@example.com"),
+		duser.WithProfile(duser.ReconstructProfile("Invited", "https://example.com/invited.png", "ja")))
 
 	pageWithoutLinks := dpage.ReconstructPage("page-1", "title-1", *creator, "invite-code", nil, nil)
 	pageWithLinks := dpage.ReconstructPage("page-2", "title-2", *creator, "invite-code", dpage.Links{
 		dpage.ReconstructLink("https://example.com", "memo", 1),
 	}, duser.Users{invited})
+	pageWithSections := dpage.ReconstructPage("page-3", "title-3", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com/1", "memo1", 1, dpage.WithLinkID("link-1")),
+		dpage.ReconstructLink("https://example.com/2", "memo2", 2, dpage.WithLinkID("link-2"), dpage.WithLinkSectionID("section-1")),
+	}, nil, dpage.WithSections(dpage.Sections{
+		dpage.ReconstructSection("section-1", "Section 1", 1),
+		dpage.ReconstructSection("section-2", "Section 2", 2),
+	}))
+	pageWithReactions := dpage.ReconstructPage("page-4", "title-4", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com/2", "", 2, dpage.WithLinkID("link-2"), dpage.WithLinkReactions(dpage.Reactions{
+			dpage.ReconstructReaction("creator-id", "🎉"),
+			dpage.ReconstructReaction("invited-id", dpage.UpvoteReaction),
+		})),
+		dpage.ReconstructLink("https://example.com/1", "", 1, dpage.WithLinkID("link-1")),
+	}, duser.Users{invited})
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	checkedAt := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	pageWithHealth := dpage.ReconstructPage("page-6", "title-6", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com/old", "", 1, dpage.WithLinkID("link-1"),
+			dpage.WithLinkHealth(dpage.NewLinkHealth(301, "https://example.com/new", checkedAt))),
+		dpage.ReconstructLink("https://example.com/gone", "", 2, dpage.WithLinkID("link-2"),
+			dpage.WithLinkHealth(dpage.NewLinkHealth(404, "", checkedAt))),
+	}, nil)
+	updatedAt := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	pageWithDetails := dpage.ReconstructPage("page-5", "title-5", *creator, "invite-code", nil, duser.Users{invited},
+		dpage.WithDetails("**Trip** <script>x</script>", "🏝️", "#ffaa00"),
+		dpage.WithTimestamps(createdAt, updatedAt),
+	)
 
 	tests := []struct {
 		name  string
@@ -44,7 +75,7 @@ func TestGetService_Get(t *testing.T) {
 		{
 			name: "success_without_links",
 			setup: func(m *mockget.MockGetUsecase) {
-				m.EXPECT().Get(gomock.Any(), "page-1").Return(pageWithoutLinks, nil)
+				m.EXPECT().Get(gomock.Any(), "page-1", dpage.LinkOrder("")).Return(pageWithoutLinks, nil)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
@@ -55,6 +86,75 @@ func TestGetService_Get(t *testing.T) {
 					Id:         "page-1",
 					Title:      "title-1",
 					InviteCode: "invite-code",
+					Creator:    &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:    []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_with_details",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-5", dpage.LinkOrder("")).Return(pageWithDetails, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), invited),
+				req: &tsudzuriv1.GetPageRequest{PageId: "page-5"},
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:              "page-5",
+					Title:           "title-5",
+					Creator:         &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:         []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}, {Id: "invited-id", Provider: "anonymous", DisplayName: "Invited", AvatarUrl: "https://example.com/invited.png"}},
+					Description:     "**Trip** <script>x</script>",
+					DescriptionHtml: "<p><strong>Trip</strong> &lt;script&gt;x&lt;/script&gt;</p>\n",
+					Icon:            "🏝️",
+					Color:           "#ffaa00",
+					CreatedAt:       timestamppb.New(createdAt),
+					UpdatedAt:       timestamppb.New(updatedAt),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_with_link_health",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-6", dpage.LinkOrder("")).Return(pageWithHealth, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+				req: &tsudzuriv1.GetPageRequest{PageId: "page-6"},
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:          "page-6",
+					Title:       "title-6",
+					InviteCode:  "invite-code",
+					Creator:     &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+					UnreadCount: 2,
+					Links: []*tsudzuriv1.Link{
+						{
+							Id:               "link-1",
+							Url:              "https://example.com/old",
+							Priority:         1,
+							State:            "unread",
+							Health:           "redirected",
+							HealthStatusCode: 301,
+							RedirectUrl:      "https://example.com/new",
+							CheckedAt:        timestamppb.New(checkedAt),
+						},
+						{
+							Id:               "link-2",
+							Url:              "https://example.com/gone",
+							Priority:         2,
+							State:            "unread",
+							Health:           "broken",
+							HealthStatusCode: 404,
+							CheckedAt:        timestamppb.New(checkedAt),
+						},
+					},
 				},
 				err: nil,
 			},
@@ -62,7 +162,7 @@ func TestGetService_Get(t *testing.T) {
 		{
 			name: "success_with_links",
 			setup: func(m *mockget.MockGetUsecase) {
-				m.EXPECT().Get(gomock.Any(), "page-2").Return(pageWithLinks, nil)
+				m.EXPECT().Get(gomock.Any(), "page-2", dpage.LinkOrder("")).Return(pageWithLinks, nil)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), invited),
@@ -70,14 +170,106 @@ func TestGetService_Get(t *testing.T) {
 			},
 			want: want{
 				res: &tsudzuriv1.Page{
-					Id:         "page-2",
-					Title:      "title-2",
-					InviteCode: "",
+					Id:          "page-2",
+					Title:       "title-2",
+					InviteCode:  "",
+					Creator:     &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}, {Id: "invited-id", Provider: "anonymous", DisplayName: "Invited", AvatarUrl: "https://example.com/invited.png"}},
+					UnreadCount: 1,
 					Links: []*tsudzuriv1.Link{{
 						Url:      "https://example.com",
 						Memo:     "memo",
 						Priority: 1,
+						State:    "unread",
+					}},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_with_sections",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-3", dpage.LinkOrder("")).Return(pageWithSections, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+				req: &tsudzuriv1.GetPageRequest{PageId: "page-3"},
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:          "page-3",
+					Title:       "title-3",
+					InviteCode:  "invite-code",
+					Creator:     &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+					UnreadCount: 2,
+					Links: []*tsudzuriv1.Link{{
+						Id:       "link-1",
+						Url:      "https://example.com/1",
+						Memo:     "memo1",
+						Priority: 1,
+						State:    "unread",
 					}},
+					Sections: []*tsudzuriv1.Section{
+						{
+							Id:       "section-1",
+							Name:     "Section 1",
+							Priority: 1,
+							Links: []*tsudzuriv1.Link{{
+								Id:        "link-2",
+								Url:       "https://example.com/2",
+								Memo:      "memo2",
+								Priority:  2,
+								SectionId: "section-1",
+								State:     "unread",
+							}},
+						},
+						{
+							Id:       "section-2",
+							Name:     "Section 2",
+							Priority: 2,
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_with_reactions_by_votes",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-4", dpage.LinkOrderVotes).Return(pageWithReactions, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), invited),
+				req: &tsudzuriv1.GetPageRequest{PageId: "page-4", LinkOrder: "votes"},
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:          "page-4",
+					Title:       "title-4",
+					Creator:     &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}, {Id: "invited-id", Provider: "anonymous", DisplayName: "Invited", AvatarUrl: "https://example.com/invited.png"}},
+					UnreadCount: 2,
+					Links: []*tsudzuriv1.Link{
+						{
+							Id:       "link-2",
+							Url:      "https://example.com/2",
+							Priority: 2,
+							Reactions: []*tsudzuriv1.ReactionCount{
+								{Emoji: dpage.UpvoteReaction, Count: 1},
+								{Emoji: "🎉", Count: 1},
+							},
+							MyReaction: dpage.UpvoteReaction,
+							Votes:      2,
+							State:      "unread",
+						},
+						{
+							Id:       "link-1",
+							Url:      "https://example.com/1",
+							Priority: 1,
+							State:    "unread",
+						},
+					},
 				},
 				err: nil,
 			},
@@ -85,7 +277,7 @@ func TestGetService_Get(t *testing.T) {
 		{
 			name: "usecase_error",
 			setup: func(m *mockget.MockGetUsecase) {
-				m.EXPECT().Get(gomock.Any(), "page-1").Return(nil, errors.New("get error"))
+				m.EXPECT().Get(gomock.Any(), "page-1", dpage.LinkOrder("")).Return(nil, errors.New("get error"))
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
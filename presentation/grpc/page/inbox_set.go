@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type InboxSetService struct {
+	usecase struct {
+		inboxSet upage.InboxSetUsecase
+	}
+}
+
+func NewInboxSetService(iu upage.InboxSetUsecase) *InboxSetService {
+	return &InboxSetService{
+		usecase: struct{ inboxSet upage.InboxSetUsecase }{inboxSet: iu},
+	}
+}
+
+func (s *InboxSetService) SetInbox(ctx context.Context, req *tsudzuriv1.SetInboxPageRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.SetInboxPage")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page inbox set request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	if err := s.usecase.inboxSet.SetInbox(ctx, req.GetPageId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page inbox set succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,66 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type InviteQRCodeService struct {
+	usecase struct {
+		inviteQRCode upage.InviteQRCodeUsecase
+	}
+}
+
+func NewInviteQRCodeService(u upage.InviteQRCodeUsecase) *InviteQRCodeService {
+	return &InviteQRCodeService{
+		usecase: struct{ inviteQRCode upage.InviteQRCodeUsecase }{inviteQRCode: u},
+	}
+}
+
+func (s *InviteQRCodeService) PNG(ctx context.Context, req *tsudzuriv1.GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.GetPageInviteQRCode")
+	defer end()
+
+	return s.render(ctx, req, upage.InviteQRCodeFormatPNG)
+}
+
+func (s *InviteQRCodeService) SVG(ctx context.Context, req *tsudzuriv1.GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.GetPageInviteQRCodeSVG")
+	defer end()
+
+	return s.render(ctx, req, upage.InviteQRCodeFormatSVG)
+}
+
+func (s *InviteQRCodeService) render(ctx context.Context, req *tsudzuriv1.GetPageInviteQRCodeRequest, format string) (*httpbody.HttpBody, error) {
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Invite QR code request page_id=%s format=%s size=%d error_correction=%s user_uid=%s",
+		req.GetPageId(), format, req.GetSize(), req.GetErrorCorrection(), user.UID())
+
+	out, err := s.usecase.inviteQRCode.QRCode(ctx, req.GetPageId(), format, qrcode.Options{
+		Size:  int(req.GetSize()),
+		Level: qrcode.Level(req.GetErrorCorrection()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Invite QR code responded: page_id=%s format=%s bytes=%d user_uid=%s", req.GetPageId(), format, len(out.Content), user.UID())
+	return &httpbody.HttpBody{
+		ContentType: out.ContentType,
+		Data:        out.Content,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockinviteqr "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_invite_qr"
+)
+
+func TestInviteQRCodeService(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.GetPageInviteQRCodeRequest
+		svg bool
+	}
+	type want struct {
+		res *httpbody.HttpBody
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.GetPageInviteQRCodeRequest{PageId: "page-1", Size: 512, ErrorCorrection: "h"}
+	opts := qrcode.Options{Size: 512, Level: "h"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockinviteqr.MockInviteQRCodeUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "png",
+			setup: func(m *mockinviteqr.MockInviteQRCodeUsecase) {
+				m.EXPECT().QRCode(gomock.Any(), "page-1", upage.InviteQRCodeFormatPNG, opts).
+					Return(&upage.InviteQRCodeUsecaseOutput{ContentType: "image/png", Content: []byte("png")}, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{res: &httpbody.HttpBody{ContentType: "image/png", Data: []byte("png")}},
+		},
+		{
+			name: "svg_defaults",
+			setup: func(m *mockinviteqr.MockInviteQRCodeUsecase) {
+				m.EXPECT().QRCode(gomock.Any(), "page-1", upage.InviteQRCodeFormatSVG, qrcode.Options{}).
+					Return(&upage.InviteQRCodeUsecaseOutput{ContentType: "image/svg+xml", Content: []byte("<svg/>")}, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: &tsudzuriv1.GetPageInviteQRCodeRequest{PageId: "page-1"}, svg: true},
+			want: want{res: &httpbody.HttpBody{ContentType: "image/svg+xml", Data: []byte("<svg/>")}},
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mockinviteqr.MockInviteQRCodeUsecase) {
+				m.EXPECT().QRCode(gomock.Any(), "page-1", upage.InviteQRCodeFormatPNG, opts).Return(nil, dpage.ErrNotCreatedByUser)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockinviteqr.MockInviteQRCodeUsecase) {
+				m.EXPECT().QRCode(gomock.Any(), "page-1", upage.InviteQRCodeFormatSVG, opts).Return(nil, errors.New("render error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req, svg: true},
+			want: want{err: errors.New("render error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockinviteqr.NewMockInviteQRCodeUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewInviteQRCodeService(usecase)
+			render := svc.PNG
+			if tt.args.svg {
+				render = svc.SVG
+			}
+			got, err := render(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
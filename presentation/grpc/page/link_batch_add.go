@@ -0,0 +1,57 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkBatchAddService struct {
+	usecase struct {
+		linkBatchAdd upage.LinkBatchAddUsecase
+	}
+}
+
+func NewLinkBatchAddService(lu upage.LinkBatchAddUsecase) *LinkBatchAddService {
+	return &LinkBatchAddService{
+		usecase: struct{ linkBatchAdd upage.LinkBatchAddUsecase }{linkBatchAdd: lu},
+	}
+}
+
+func (s *LinkBatchAddService) BatchAdd(ctx context.Context, req *tsudzuriv1.BatchAddLinksRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.LinkBatchAdd")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page link batch add request page_id=%s count=%d user_uid=%s", req.GetPageId(), len(req.GetLinks()), user.UID())
+
+	input := upage.LinkBatchAddUsecaseInput{
+		PageID: req.GetPageId(),
+		Links:  make([]upage.LinkBatchAddUsecaseInputLink, 0, len(req.GetLinks())),
+	}
+	for _, l := range req.GetLinks() {
+		input.Links = append(input.Links, upage.LinkBatchAddUsecaseInputLink{
+			URL:  l.GetUrl(),
+			Memo: l.GetMemo(),
+		})
+	}
+
+	if err := s.usecase.linkBatchAdd.LinkBatchAdd(ctx, input); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page link batch add succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,105 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocklinkbatchadd "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_batch_add"
+)
+
+func TestLinkBatchAddService_BatchAdd(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.BatchAddLinksRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.BatchAddLinksRequest{
+		PageId: "page-1",
+		Links: []*tsudzuriv1.BatchAddLinksRequest_Link{
+			{Url: "https://a.com", Memo: "A"},
+			{Url: "https://b.com"},
+		},
+	}
+	expected := upage.LinkBatchAddUsecaseInput{
+		PageID: "page-1",
+		Links: []upage.LinkBatchAddUsecaseInputLink{
+			{URL: "https://a.com", Memo: "A"},
+			{URL: "https://b.com"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkbatchadd.MockLinkBatchAddUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkbatchadd.MockLinkBatchAddUsecase) {
+				m.EXPECT().LinkBatchAdd(gomock.Any(), expected).Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &emptypb.Empty{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkbatchadd.MockLinkBatchAddUsecase) {
+				m.EXPECT().LinkBatchAdd(gomock.Any(), expected).Return(errors.New("batch add error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: errors.New("batch add error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkbatchadd.NewMockLinkBatchAddUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkBatchAddService(usecase)
+			got, err := svc.BatchAdd(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkBatchRemoveService struct {
+	usecase struct {
+		linkBatchRemove upage.LinkBatchRemoveUsecase
+	}
+}
+
+func NewLinkBatchRemoveService(lu upage.LinkBatchRemoveUsecase) *LinkBatchRemoveService {
+	return &LinkBatchRemoveService{
+		usecase: struct{ linkBatchRemove upage.LinkBatchRemoveUsecase }{linkBatchRemove: lu},
+	}
+}
+
+func (s *LinkBatchRemoveService) BatchRemove(ctx context.Context, req *tsudzuriv1.BatchRemoveLinksRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.LinkBatchRemove")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page link batch remove request page_id=%s count=%d user_uid=%s", req.GetPageId(), len(req.GetLinkIds()), user.UID())
+
+	input := upage.LinkBatchRemoveUsecaseInput{
+		PageID:  req.GetPageId(),
+		LinkIDs: req.GetLinkIds(),
+	}
+
+	if err := s.usecase.linkBatchRemove.LinkBatchRemove(ctx, input); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page link batch remove succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
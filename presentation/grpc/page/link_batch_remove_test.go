@@ -0,0 +1,93 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocklinkbatchremove "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_batch_remove"
+)
+
+func TestLinkBatchRemoveService_BatchRemove(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.BatchRemoveLinksRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.BatchRemoveLinksRequest{PageId: "page-1", LinkIds: []string{"l1", "l2"}}
+	expected := upage.LinkBatchRemoveUsecaseInput{PageID: "page-1", LinkIDs: []string{"l1", "l2"}}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkbatchremove.MockLinkBatchRemoveUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkbatchremove.MockLinkBatchRemoveUsecase) {
+				m.EXPECT().LinkBatchRemove(gomock.Any(), expected).Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &emptypb.Empty{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkbatchremove.MockLinkBatchRemoveUsecase) {
+				m.EXPECT().LinkBatchRemove(gomock.Any(), expected).Return(errors.New("batch remove error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: errors.New("batch remove error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkbatchremove.NewMockLinkBatchRemoveUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkBatchRemoveService(usecase)
+			got, err := svc.BatchRemove(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
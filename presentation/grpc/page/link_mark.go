@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkMarkService struct {
+	usecase struct {
+		linkMark upage.LinkMarkUsecase
+	}
+}
+
+func NewLinkMarkService(u upage.LinkMarkUsecase) *LinkMarkService {
+	return &LinkMarkService{
+		usecase: struct{ linkMark upage.LinkMarkUsecase }{linkMark: u},
+	}
+}
+
+func (s *LinkMarkService) Mark(ctx context.Context, req *tsudzuriv1.MarkLinkRequest) (*tsudzuriv1.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.MarkLink")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link mark request page_id=%s link_id=%s state=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), req.GetState(), user.UID())
+
+	link, err := s.usecase.linkMark.MarkLink(ctx, req.GetPageId(), req.GetLinkId(), dpage.LinkStatus(req.GetState()))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link mark succeeded page_id=%s link_id=%s state=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), req.GetState(), user.UID())
+	return toProtoLink(*link, user), nil
+}
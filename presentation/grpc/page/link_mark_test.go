@@ -0,0 +1,108 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklinkmark "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_mark"
+)
+
+func TestLinkMarkService_Mark(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.MarkLinkRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Link
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.MarkLinkRequest{PageId: "page-1", LinkId: "link-1", State: "done"}
+	link := dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1"), dpage.WithLinkStates(dpage.LinkStates{dpage.ReconstructLinkState("user-id", dpage.LinkStatusDone)}))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkmark.MockLinkMarkUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkmark.MockLinkMarkUsecase) {
+				m.EXPECT().MarkLink(gomock.Any(), "page-1", "link-1", dpage.LinkStatusDone).Return(&link, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Link{
+					Id:       "link-1",
+					Url:      "https://example.com",
+					Memo:     "memo",
+					Priority: 1,
+					State:    "done",
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkmark.MockLinkMarkUsecase) {
+				m.EXPECT().MarkLink(gomock.Any(), "page-1", "link-1", dpage.LinkStatusDone).Return(nil, errors.New("mark error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("mark error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkmark.NewMockLinkMarkUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkMarkService(usecase)
+			got, err := svc.Mark(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
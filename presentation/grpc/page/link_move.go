@@ -0,0 +1,54 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkMoveService struct {
+	usecase struct {
+		linkMove upage.LinkMoveUsecase
+	}
+}
+
+func NewLinkMoveService(lu upage.LinkMoveUsecase) *LinkMoveService {
+	return &LinkMoveService{
+		usecase: struct{ linkMove upage.LinkMoveUsecase }{linkMove: lu},
+	}
+}
+
+func (s *LinkMoveService) Move(ctx context.Context, req *tsudzuriv1.MoveLinksRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.LinkMove")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page link move request source_page_id=%s target_page_id=%s count=%d copy=%t user_uid=%s",
+		req.GetSourcePageId(), req.GetTargetPageId(), len(req.GetLinkIds()), req.GetCopy(), user.UID())
+
+	input := upage.LinkMoveUsecaseInput{
+		SourcePageID: req.GetSourcePageId(),
+		TargetPageID: req.GetTargetPageId(),
+		LinkIDs:      req.GetLinkIds(),
+		Copy:         req.GetCopy(),
+	}
+
+	if err := s.usecase.linkMove.LinkMove(ctx, input); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page link move succeeded source_page_id=%s target_page_id=%s user_uid=%s", req.GetSourcePageId(), req.GetTargetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
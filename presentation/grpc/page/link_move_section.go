@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkMoveSectionService struct {
+	usecase struct {
+		linkMoveSection upage.LinkMoveSectionUsecase
+	}
+}
+
+func NewLinkMoveSectionService(u upage.LinkMoveSectionUsecase) *LinkMoveSectionService {
+	return &LinkMoveSectionService{
+		usecase: struct{ linkMoveSection upage.LinkMoveSectionUsecase }{linkMoveSection: u},
+	}
+}
+
+func (s *LinkMoveSectionService) MoveToSection(ctx context.Context, req *tsudzuriv1.MoveLinksToSectionRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.MoveLinksToSection")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page links move to section request page_id=%s section_id=%s count=%d user_uid=%s", req.GetPageId(), req.GetSectionId(), len(req.GetLinkIds()), user.UID())
+
+	if err := s.usecase.linkMoveSection.MoveLinksToSection(ctx, req.GetPageId(), req.GetLinkIds(), req.GetSectionId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page links move to section succeeded page_id=%s section_id=%s count=%d user_uid=%s", req.GetPageId(), req.GetSectionId(), len(req.GetLinkIds()), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,103 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocklinkmove "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_move"
+)
+
+func TestLinkMoveService_Move(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.MoveLinksRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.MoveLinksRequest{
+		SourcePageId: "page-1",
+		TargetPageId: "page-2",
+		LinkIds:      []string{"l1"},
+		Copy:         true,
+	}
+	expected := upage.LinkMoveUsecaseInput{
+		SourcePageID: "page-1",
+		TargetPageID: "page-2",
+		LinkIDs:      []string{"l1"},
+		Copy:         true,
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkmove.MockLinkMoveUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkmove.MockLinkMoveUsecase) {
+				m.EXPECT().LinkMove(gomock.Any(), expected).Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &emptypb.Empty{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkmove.MockLinkMoveUsecase) {
+				m.EXPECT().LinkMove(gomock.Any(), expected).Return(errors.New("move error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: errors.New("move error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkmove.NewMockLinkMoveUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkMoveService(usecase)
+			got, err := svc.Move(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
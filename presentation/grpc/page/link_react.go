@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkReactService struct {
+	usecase struct {
+		linkReact upage.LinkReactUsecase
+	}
+}
+
+func NewLinkReactService(u upage.LinkReactUsecase) *LinkReactService {
+	return &LinkReactService{
+		usecase: struct{ linkReact upage.LinkReactUsecase }{linkReact: u},
+	}
+}
+
+func (s *LinkReactService) React(ctx context.Context, req *tsudzuriv1.ReactToLinkRequest) (*tsudzuriv1.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ReactToLink")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link react request page_id=%s link_id=%s emoji=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), req.GetEmoji(), user.UID())
+
+	link, err := s.usecase.linkReact.React(ctx, req.GetPageId(), req.GetLinkId(), req.GetEmoji())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link react succeeded page_id=%s link_id=%s votes=%d user_uid=%s", req.GetPageId(), req.GetLinkId(), link.Reactions().Votes(), user.UID())
+	return toProtoLink(*link, user), nil
+}
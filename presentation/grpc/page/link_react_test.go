@@ -0,0 +1,111 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklinkreact "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_react"
+)
+
+func TestLinkReactService_React(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ReactToLinkRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Link
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.ReactToLinkRequest{PageId: "page-1", LinkId: "link-1", Emoji: "🎉"}
+	link := dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1"), dpage.WithLinkReactions(dpage.Reactions{dpage.ReconstructReaction("user-id", "🎉")}))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkreact.MockLinkReactUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkreact.MockLinkReactUsecase) {
+				m.EXPECT().React(gomock.Any(), "page-1", "link-1", "🎉").Return(&link, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Link{
+					Id:         "link-1",
+					Url:        "https://example.com",
+					Memo:       "memo",
+					Priority:   1,
+					Reactions:  []*tsudzuriv1.ReactionCount{{Emoji: "🎉", Count: 1}},
+					MyReaction: "🎉",
+					Votes:      1,
+					State:      "unread",
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkreact.MockLinkReactUsecase) {
+				m.EXPECT().React(gomock.Any(), "page-1", "link-1", "🎉").Return(nil, errors.New("react error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("react error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkreact.NewMockLinkReactUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkReactService(usecase)
+			got, err := svc.React(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
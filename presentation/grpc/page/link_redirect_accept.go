@@ -0,0 +1,47 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkRedirectAcceptService struct {
+	usecase struct {
+		linkRedirectAccept upage.LinkRedirectAcceptUsecase
+	}
+}
+
+func NewLinkRedirectAcceptService(u upage.LinkRedirectAcceptUsecase) *LinkRedirectAcceptService {
+	return &LinkRedirectAcceptService{
+		usecase: struct {
+			linkRedirectAccept upage.LinkRedirectAcceptUsecase
+		}{linkRedirectAccept: u},
+	}
+}
+
+func (s *LinkRedirectAcceptService) AcceptRedirect(ctx context.Context, req *tsudzuriv1.AcceptLinkRedirectRequest) (*tsudzuriv1.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.AcceptLinkRedirect")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link redirect accept request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	link, err := s.usecase.linkRedirectAccept.AcceptLinkRedirect(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link redirect accept succeeded page_id=%s link_id=%s url=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), link.URL(), user.UID())
+	return toProtoLink(*link, user), nil
+}
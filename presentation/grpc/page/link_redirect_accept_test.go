@@ -0,0 +1,106 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklinkredirectaccept "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_redirect_accept"
+)
+
+func TestLinkRedirectAcceptService_ToggleDone(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.AcceptLinkRedirectRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Link
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.AcceptLinkRedirectRequest{PageId: "page-1", LinkId: "link-1"}
+	acceptedLink := dpage.ReconstructLink("https://example.com/new", "memo", 1, dpage.WithLinkID("link-1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinkredirectaccept.MockLinkRedirectAcceptUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklinkredirectaccept.MockLinkRedirectAcceptUsecase) {
+				m.EXPECT().AcceptLinkRedirect(gomock.Any(), "page-1", "link-1").Return(&acceptedLink, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Link{
+					Id:       "link-1",
+					Url:      "https://example.com/new",
+					Memo:     "memo",
+					Priority: 1,
+					State:    "unread",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinkredirectaccept.MockLinkRedirectAcceptUsecase) {
+				m.EXPECT().AcceptLinkRedirect(gomock.Any(), "page-1", "link-1").Return(nil, dpage.ErrNoLinkRedirect)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: dpage.ErrNoLinkRedirect,
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinkredirectaccept.NewMockLinkRedirectAcceptUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkRedirectAcceptService(usecase)
+			got, err := svc.AcceptRedirect(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkToggleDoneService struct {
+	usecase struct {
+		linkToggleDone upage.LinkToggleDoneUsecase
+	}
+}
+
+func NewLinkToggleDoneService(u upage.LinkToggleDoneUsecase) *LinkToggleDoneService {
+	return &LinkToggleDoneService{
+		usecase: struct{ linkToggleDone upage.LinkToggleDoneUsecase }{linkToggleDone: u},
+	}
+}
+
+func (s *LinkToggleDoneService) ToggleDone(ctx context.Context, req *tsudzuriv1.ToggleLinkDoneRequest) (*tsudzuriv1.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ToggleLinkDone")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link toggle done request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	link, err := s.usecase.linkToggleDone.ToggleLinkDone(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link toggle done succeeded page_id=%s link_id=%s done=%t user_uid=%s", req.GetPageId(), req.GetLinkId(), link.Done(), user.UID())
+	return toProtoLink(*link, user), nil
+}
@@ -0,0 +1,133 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklinktoggledone "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_link_toggle_done"
+)
+
+func TestLinkToggleDoneService_ToggleDone(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ToggleLinkDoneRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Link
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.ToggleLinkDoneRequest{PageId: "page-1", LinkId: "link-1"}
+	doneAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	doneLink := dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1"), dpage.WithLinkDone("user-id", doneAt))
+	undoneLink := dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklinktoggledone.MockLinkToggleDoneUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success_done",
+			setup: func(m *mocklinktoggledone.MockLinkToggleDoneUsecase) {
+				m.EXPECT().ToggleLinkDone(gomock.Any(), "page-1", "link-1").Return(&doneLink, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Link{
+					Id:           "link-1",
+					Url:          "https://example.com",
+					Memo:         "memo",
+					Priority:     1,
+					State:        "unread",
+					Done:         true,
+					DoneByUserId: "user-id",
+					DoneAt:       timestamppb.New(doneAt),
+				},
+			},
+		},
+		{
+			name: "success_undone",
+			setup: func(m *mocklinktoggledone.MockLinkToggleDoneUsecase) {
+				m.EXPECT().ToggleLinkDone(gomock.Any(), "page-1", "link-1").Return(&undoneLink, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Link{
+					Id:       "link-1",
+					Url:      "https://example.com",
+					Memo:     "memo",
+					Priority: 1,
+					State:    "unread",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklinktoggledone.MockLinkToggleDoneUsecase) {
+				m.EXPECT().ToggleLinkDone(gomock.Any(), "page-1", "link-1").Return(nil, errors.New("toggle error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("toggle error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklinktoggledone.NewMockLinkToggleDoneUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewLinkToggleDoneService(usecase)
+			got, err := svc.ToggleDone(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
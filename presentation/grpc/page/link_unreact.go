@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type LinkUnreactService struct {
+	usecase struct {
+		linkUnreact upage.LinkUnreactUsecase
+	}
+}
+
+func NewLinkUnreactService(u upage.LinkUnreactUsecase) *LinkUnreactService {
+	return &LinkUnreactService{
+		usecase: struct{ linkUnreact upage.LinkUnreactUsecase }{linkUnreact: u},
+	}
+}
+
+func (s *LinkUnreactService) Unreact(ctx context.Context, req *tsudzuriv1.RemoveLinkReactionRequest) (*tsudzuriv1.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.RemoveLinkReaction")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Link reaction remove request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	link, err := s.usecase.linkUnreact.Unreact(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Link reaction remove succeeded page_id=%s link_id=%s votes=%d user_uid=%s", req.GetPageId(), req.GetLinkId(), link.Reactions().Votes(), user.UID())
+	return toProtoLink(*link, user), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
@@ -23,7 +24,7 @@ func NewListService(lu upage.ListUsecase) *ListService {
 	}
 }
 
-func (s *ListService) List(ctx context.Context, _ *tsudzuriv1.ListPagesRequest) (*tsudzuriv1.ListPagesResponse, error) {
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListPagesRequest) (*tsudzuriv1.ListPagesResponse, error) {
 	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.List")
 	defer end()
 
@@ -33,9 +34,14 @@ func (s *ListService) List(ctx context.Context, _ *tsudzuriv1.ListPagesRequest)
 		return nil, duser.ErrUserNotFound
 	}
 
-	logger.Sugar().Infof("Page list request user_uid=%s", user.UID())
+	logger.Sugar().Infof("Page list request include_archived=%t user_uid=%s", req.GetIncludeArchived(), user.UID())
 
-	pages, err := s.usecase.list.List(ctx)
+	var options []dpage.SearchOption
+	if req.GetIncludeArchived() {
+		options = append(options, dpage.WithIncludeArchived(true))
+	}
+
+	pages, preferences, err := s.usecase.list.List(ctx, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +50,10 @@ func (s *ListService) List(ctx context.Context, _ *tsudzuriv1.ListPagesRequest)
 	if len(pages) > 0 {
 		resp.Pages = make([]*tsudzuriv1.Page, 0, len(pages))
 		for _, p := range pages {
-			resp.Pages = append(resp.Pages, toProtoPage(p, user))
+			pp := toProtoPage(p, user)
+			pp.Pinned = preferences.IsPinned(p.ID())
+			pp.Inbox = preferences.IsInbox(p.ID())
+			resp.Pages = append(resp.Pages, pp)
 		}
 	}
 
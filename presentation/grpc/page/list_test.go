@@ -32,7 +32,12 @@ func TestListService_List(t *testing.T) {
 	page1 := dpage.ReconstructPage("page-1", "title-1", *creator, "code-1", nil, nil)
 	page2 := dpage.ReconstructPage("page-2", "title-2", *creator, "code-2", dpage.Links{
 		dpage.ReconstructLink("https://example.com", "memo", 1),
+		dpage.ReconstructLink("https://example.com/read", "", 2, dpage.WithLinkStates(dpage.LinkStates{
+			dpage.ReconstructLinkState("creator-id", dpage.LinkStatusRead),
+		})),
 	}, nil)
+	archived := dpage.ReconstructPage("page-3", "title-3", *creator, "code-3", nil, nil, dpage.WithArchived(true))
+	noPreferences := dpage.ReconstructPreferences("creator-id", nil)
 
 	tests := []struct {
 		name  string
@@ -43,7 +48,7 @@ func TestListService_List(t *testing.T) {
 		{
 			name: "success_with_pages",
 			setup: func(m *mocklist.MockListUsecase) {
-				m.EXPECT().List(gomock.Any()).Return([]*dpage.Page{page1, page2}, nil)
+				m.EXPECT().List(gomock.Any()).Return([]*dpage.Page{page1, page2}, noPreferences, nil)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
@@ -56,16 +61,92 @@ func TestListService_List(t *testing.T) {
 							Id:         "page-1",
 							Title:      "title-1",
 							InviteCode: "code-1",
+							Creator:    &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+							Members:    []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
 						},
 						{
-							Id:         "page-2",
-							Title:      "title-2",
-							InviteCode: "code-2",
-							Links: []*tsudzuriv1.Link{{
-								Url:      "https://example.com",
-								Memo:     "memo",
-								Priority: 1,
-							}},
+							Id:          "page-2",
+							Title:       "title-2",
+							InviteCode:  "code-2",
+							Creator:     &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+							Members:     []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+							UnreadCount: 1,
+							Links: []*tsudzuriv1.Link{
+								{
+									Url:      "https://example.com",
+									Memo:     "memo",
+									Priority: 1,
+									State:    "unread",
+								},
+								{
+									Url:      "https://example.com/read",
+									Priority: 2,
+									State:    "read",
+								},
+							},
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_include_archived",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, options ...dpage.SearchOption) ([]*dpage.Page, *dpage.Preferences, error) {
+						var params dpage.SearchParams
+						for _, o := range options {
+							o.Apply(&params)
+						}
+						if !params.IncludeArchived {
+							t.Errorf("IncludeArchived = false, want true")
+						}
+						return []*dpage.Page{archived}, noPreferences, nil
+					})
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+				req: &tsudzuriv1.ListPagesRequest{IncludeArchived: true},
+			},
+			want: want{
+				res: &tsudzuriv1.ListPagesResponse{
+					Pages: []*tsudzuriv1.Page{
+						{
+							Id:         "page-3",
+							Title:      "title-3",
+							InviteCode: "code-3",
+							Creator:    &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+							Members:    []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+							Archived:   true,
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success_pinned_inbox",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any()).Return([]*dpage.Page{page1}, dpage.ReconstructPreferences("creator-id", []dpage.Preference{
+					dpage.ReconstructPreference("page-1", true, 0, true),
+				}), nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+				req: &tsudzuriv1.ListPagesRequest{},
+			},
+			want: want{
+				res: &tsudzuriv1.ListPagesResponse{
+					Pages: []*tsudzuriv1.Page{
+						{
+							Id:         "page-1",
+							Title:      "title-1",
+							InviteCode: "code-1",
+							Creator:    &tsudzuriv1.PageUser{Id: "creator-id", Provider: "anonymous"},
+							Members:    []*tsudzuriv1.PageUser{{Id: "creator-id", Provider: "anonymous"}},
+							Pinned:     true,
+							Inbox:      true,
 						},
 					},
 				},
@@ -75,7 +156,7 @@ func TestListService_List(t *testing.T) {
 		{
 			name: "success_no_pages",
 			setup: func(m *mocklist.MockListUsecase) {
-				m.EXPECT().List(gomock.Any()).Return([]*dpage.Page{}, nil)
+				m.EXPECT().List(gomock.Any()).Return([]*dpage.Page{}, noPreferences, nil)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
@@ -89,7 +170,7 @@ func TestListService_List(t *testing.T) {
 		{
 			name: "usecase_error",
 			setup: func(m *mocklist.MockListUsecase) {
-				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("list error"))
+				m.EXPECT().List(gomock.Any()).Return(nil, nil, errors.New("list error"))
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
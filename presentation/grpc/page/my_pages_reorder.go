@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type MyPagesReorderService struct {
+	usecase struct {
+		myPagesReorder upage.MyPagesReorderUsecase
+	}
+}
+
+func NewMyPagesReorderService(mu upage.MyPagesReorderUsecase) *MyPagesReorderService {
+	return &MyPagesReorderService{
+		usecase: struct{ myPagesReorder upage.MyPagesReorderUsecase }{myPagesReorder: mu},
+	}
+}
+
+func (s *MyPagesReorderService) Reorder(ctx context.Context, req *tsudzuriv1.ReorderMyPagesRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ReorderMyPages")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("My pages reorder request count=%d user_uid=%s", len(req.GetPageIds()), user.UID())
+
+	if err := s.usecase.myPagesReorder.ReorderMyPages(ctx, req.GetPageIds()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("My pages reorder succeeded count=%d user_uid=%s", len(req.GetPageIds()), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,100 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockmypagesreorder "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_my_pages_reorder"
+)
+
+func TestMyPagesReorderService_Reorder(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ReorderMyPagesRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockmypagesreorder.MockMyPagesReorderUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockmypagesreorder.MockMyPagesReorderUsecase) {
+				m.EXPECT().ReorderMyPages(gomock.Any(), []string{"page-2", "page-1"}).Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ReorderMyPagesRequest{PageIds: []string{"page-2", "page-1"}},
+			},
+			want: want{
+				res: &emptypb.Empty{},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockmypagesreorder.MockMyPagesReorderUsecase) {
+				m.EXPECT().ReorderMyPages(gomock.Any(), []string{"page-2", "page-1"}).Return(dpage.ErrInvalidPageOrder)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ReorderMyPagesRequest{PageIds: []string{"page-2", "page-1"}},
+			},
+			want: want{
+				res: nil,
+				err: dpage.ErrInvalidPageOrder,
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.ReorderMyPagesRequest{PageIds: []string{"page-2", "page-1"}},
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockmypagesreorder.NewMockMyPagesReorderUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewMyPagesReorderService(usecase)
+			got, err := svc.Reorder(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
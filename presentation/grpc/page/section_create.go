@@ -0,0 +1,47 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type SectionCreateService struct {
+	usecase struct {
+		sectionCreate upage.SectionCreateUsecase
+	}
+}
+
+func NewSectionCreateService(su upage.SectionCreateUsecase) *SectionCreateService {
+	return &SectionCreateService{
+		usecase: struct{ sectionCreate upage.SectionCreateUsecase }{sectionCreate: su},
+	}
+}
+
+func (s *SectionCreateService) Create(ctx context.Context, req *tsudzuriv1.CreateSectionRequest) (*tsudzuriv1.Section, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.CreateSection")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page section create request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	section, err := s.usecase.sectionCreate.CreateSection(ctx, req.GetPageId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toProtoSection(*section)
+	logger.Sugar().Infof("Page section create succeeded page_id=%s section_id=%s user_uid=%s", req.GetPageId(), resp.GetId(), user.UID())
+
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocksectioncreate "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_section_create"
+)
+
+func TestSectionCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateSectionRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Section
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	section := dpage.ReconstructSection("section-1", "name", 1)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocksectioncreate.MockSectionCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocksectioncreate.MockSectionCreateUsecase) {
+				m.EXPECT().CreateSection(gomock.Any(), "page-1", "name").Return(&section, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.CreateSectionRequest{PageId: "page-1", Name: "name"},
+			},
+			want: want{
+				res: &tsudzuriv1.Section{
+					Id:       "section-1",
+					Name:     "name",
+					Priority: 1,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocksectioncreate.MockSectionCreateUsecase) {
+				m.EXPECT().CreateSection(gomock.Any(), "page-1", "name").Return(nil, errors.New("create error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.CreateSectionRequest{PageId: "page-1", Name: "name"},
+			},
+			want: want{
+				res: nil,
+				err: errors.New("create error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.CreateSectionRequest{PageId: "page-1", Name: "name"},
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocksectioncreate.NewMockSectionCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewSectionCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type SectionDeleteService struct {
+	usecase struct {
+		sectionDelete upage.SectionDeleteUsecase
+	}
+}
+
+func NewSectionDeleteService(u upage.SectionDeleteUsecase) *SectionDeleteService {
+	return &SectionDeleteService{
+		usecase: struct{ sectionDelete upage.SectionDeleteUsecase }{sectionDelete: u},
+	}
+}
+
+func (s *SectionDeleteService) Delete(ctx context.Context, req *tsudzuriv1.DeleteSectionRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.DeleteSection")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page section delete request page_id=%s section_id=%s user_uid=%s", req.GetPageId(), req.GetSectionId(), user.UID())
+
+	if err := s.usecase.sectionDelete.DeleteSection(ctx, req.GetPageId(), req.GetSectionId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page section delete succeeded page_id=%s section_id=%s user_uid=%s", req.GetPageId(), req.GetSectionId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type SectionReorderService struct {
+	usecase struct {
+		sectionReorder upage.SectionReorderUsecase
+	}
+}
+
+func NewSectionReorderService(u upage.SectionReorderUsecase) *SectionReorderService {
+	return &SectionReorderService{
+		usecase: struct{ sectionReorder upage.SectionReorderUsecase }{sectionReorder: u},
+	}
+}
+
+func (s *SectionReorderService) Reorder(ctx context.Context, req *tsudzuriv1.ReorderSectionsRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ReorderSections")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page sections reorder request page_id=%s count=%d user_uid=%s", req.GetPageId(), len(req.GetSectionIds()), user.UID())
+
+	if err := s.usecase.sectionReorder.ReorderSections(ctx, req.GetPageId(), req.GetSectionIds()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page sections reorder succeeded page_id=%s count=%d user_uid=%s", req.GetPageId(), len(req.GetSectionIds()), user.UID())
+	return &emptypb.Empty{}, nil
+}
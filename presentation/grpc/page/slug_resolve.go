@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type SlugResolveService struct {
+	usecase struct {
+		slugResolve upage.SlugResolveUsecase
+	}
+}
+
+func NewSlugResolveService(u upage.SlugResolveUsecase) *SlugResolveService {
+	return &SlugResolveService{
+		usecase: struct{ slugResolve upage.SlugResolveUsecase }{slugResolve: u},
+	}
+}
+
+func (s *SlugResolveService) Resolve(ctx context.Context, req *tsudzuriv1.ResolveSlugRequest) (*tsudzuriv1.ResolveSlugResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.ResolveSlug")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Slug resolve request slug=%s user_uid=%s", req.GetSlug(), user.UID())
+
+	page, err := s.usecase.slugResolve.Resolve(ctx, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Slug resolve succeeded slug=%s page_id=%s user_uid=%s", req.GetSlug(), page.ID(), user.UID())
+	return &tsudzuriv1.ResolveSlugResponse{PageId: page.ID(), Slug: page.Slug()}, nil
+}
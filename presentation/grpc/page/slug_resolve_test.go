@@ -0,0 +1,93 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockslugresolve "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_slug_resolve"
+)
+
+func TestSlugResolveService_Resolve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ResolveSlugRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ResolveSlugResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	// The page was renamed from the requested slug.
+	req := &tsudzuriv1.ResolveSlugRequest{Slug: "abcdefgh23"}
+	page := dpage.ReconstructPage("page-1", "Title", *user, "code", nil, nil, dpage.WithSlug("trip-2026"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockslugresolve.MockSlugResolveUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockslugresolve.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{res: &tsudzuriv1.ResolveSlugResponse{PageId: "page-1", Slug: "trip-2026"}},
+		},
+		{
+			name: "not_found",
+			setup: func(m *mockslugresolve.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return(nil, upage.ErrPageNotFound)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockslugresolve.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return(nil, errors.New("resolve error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: errors.New("resolve error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockslugresolve.NewMockSlugResolveUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewSlugResolveService(usecase)
+			got, err := svc.Resolve(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
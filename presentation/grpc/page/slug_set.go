@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type SlugSetService struct {
+	usecase struct {
+		slugSet upage.SlugSetUsecase
+	}
+}
+
+func NewSlugSetService(u upage.SlugSetUsecase) *SlugSetService {
+	return &SlugSetService{
+		usecase: struct{ slugSet upage.SlugSetUsecase }{slugSet: u},
+	}
+}
+
+func (s *SlugSetService) Set(ctx context.Context, req *tsudzuriv1.SetPageSlugRequest) (*tsudzuriv1.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.SetPageSlug")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page slug set request page_id=%s slug=%s user_uid=%s", req.GetPageId(), req.GetSlug(), user.UID())
+
+	page, err := s.usecase.slugSet.SetSlug(ctx, req.GetPageId(), req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page slug set succeeded page_id=%s slug=%s user_uid=%s", req.GetPageId(), page.Slug(), user.UID())
+	return toProtoPage(page, user), nil
+}
@@ -0,0 +1,91 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockslugset "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_slug_set"
+)
+
+func TestSlugSetService_Set(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.SetPageSlugRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Page
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.SetPageSlugRequest{PageId: "page-1", Slug: "Trip-2026"}
+	page := dpage.ReconstructPage("page-1", "Title", *user, "code", nil, nil, dpage.WithSlug("trip-2026"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockslugset.MockSlugSetUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockslugset.MockSlugSetUsecase) {
+				m.EXPECT().SetSlug(gomock.Any(), "page-1", "Trip-2026").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:         "page-1",
+					Title:      "Title",
+					InviteCode: "code",
+					Slug:       "trip-2026",
+					Creator:    &tsudzuriv1.PageUser{Id: "user-id", Provider: "anonymous"},
+					Members:    []*tsudzuriv1.PageUser{{Id: "user-id", Provider: "anonymous"}},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockslugset.MockSlugSetUsecase) {
+				m.EXPECT().SetSlug(gomock.Any(), "page-1", "Trip-2026").Return(nil, dpage.ErrSlugTaken)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: dpage.ErrSlugTaken},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockslugset.NewMockSlugSetUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewSlugSetService(usecase)
+			got, err := svc.Set(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type UnarchiveService struct {
+	usecase struct {
+		unarchive upage.UnarchiveUsecase
+	}
+}
+
+func NewUnarchiveService(u upage.UnarchiveUsecase) *UnarchiveService {
+	return &UnarchiveService{
+		usecase: struct{ unarchive upage.UnarchiveUsecase }{unarchive: u},
+	}
+}
+
+func (s *UnarchiveService) Unarchive(ctx context.Context, req *tsudzuriv1.UnarchivePageRequest) (*tsudzuriv1.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.UnarchivePage")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page unarchive request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	page, err := s.usecase.unarchive.Unarchive(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page unarchive succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return toProtoPage(page, user), nil
+}
@@ -0,0 +1,113 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockunarchive "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_unarchive"
+)
+
+func TestUnarchiveService_Unarchive(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.UnarchivePageRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Page
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	req := &tsudzuriv1.UnarchivePageRequest{PageId: "page-1"}
+	page := dpage.ReconstructPage("page-1", "Title", *user, "code", dpage.Links{
+		dpage.ReconstructLink("https://a.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil, dpage.WithArchived(false))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockunarchive.MockUnarchiveUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockunarchive.MockUnarchiveUsecase) {
+				m.EXPECT().Unarchive(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Page{
+					Id:    "page-1",
+					Title: "Title",
+					Links: []*tsudzuriv1.Link{
+						{Id: "link-1", Url: "https://a.com", Priority: 1, State: "unread"},
+					},
+					InviteCode:  "code",
+					Creator:     &tsudzuriv1.PageUser{Id: "user-id", Provider: "anonymous"},
+					Members:     []*tsudzuriv1.PageUser{{Id: "user-id", Provider: "anonymous"}},
+					UnreadCount: 1,
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockunarchive.MockUnarchiveUsecase) {
+				m.EXPECT().Unarchive(gomock.Any(), "page-1").Return(nil, errors.New("unarchive error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: errors.New("unarchive error"),
+			},
+		},
+		{
+			name:  "user_not_found",
+			setup: nil,
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{
+				res: nil,
+				err: duser.ErrUserNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockunarchive.NewMockUnarchiveUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewUnarchiveService(usecase)
+			got, err := svc.Unarchive(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
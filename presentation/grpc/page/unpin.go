@@ -0,0 +1,46 @@
+package page
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+type UnpinService struct {
+	usecase struct {
+		unpin upage.UnpinUsecase
+	}
+}
+
+func NewUnpinService(uu upage.UnpinUsecase) *UnpinService {
+	return &UnpinService{
+		usecase: struct{ unpin upage.UnpinUsecase }{unpin: uu},
+	}
+}
+
+func (s *UnpinService) Unpin(ctx context.Context, req *tsudzuriv1.UnpinPageRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/page.UnpinPage")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Page unpin request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	if err := s.usecase.unpin.Unpin(ctx, req.GetPageId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Page unpin succeeded page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -3,34 +3,122 @@ package presentationgrpc
 import (
 	"context"
 
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	"github.com/naka-sei/tsudzuri/pkg/cache"
 	"github.com/naka-sei/tsudzuri/presentation/errcode"
+	grpcaccesstoken "github.com/naka-sei/tsudzuri/presentation/grpc/accesstoken"
+	grpcclick "github.com/naka-sei/tsudzuri/presentation/grpc/click"
+	grpccomment "github.com/naka-sei/tsudzuri/presentation/grpc/comment"
+	grpcdevice "github.com/naka-sei/tsudzuri/presentation/grpc/device"
+	grpcinvitation "github.com/naka-sei/tsudzuri/presentation/grpc/invitation"
+	grpcnotification "github.com/naka-sei/tsudzuri/presentation/grpc/notification"
 	grpcpage "github.com/naka-sei/tsudzuri/presentation/grpc/page"
+	grpcsnapshot "github.com/naka-sei/tsudzuri/presentation/grpc/snapshot"
+	grpctemplate "github.com/naka-sei/tsudzuri/presentation/grpc/template"
 	grpcuser "github.com/naka-sei/tsudzuri/presentation/grpc/user"
+	grpcwebhook "github.com/naka-sei/tsudzuri/presentation/grpc/webhook"
 )
 
 type Server struct {
 	tsudzuriv1.UnimplementedTsudzuriServiceServer
 
 	page struct {
-		create     *grpcpage.CreateService
-		get        *grpcpage.GetService
-		list       *grpcpage.ListService
-		edit       *grpcpage.EditService
-		delete     *grpcpage.DeleteService
-		linkAdd    *grpcpage.LinkAddService
-		linkRemove *grpcpage.LinkRemoveService
-		join       *grpcpage.JoinService
+		create             *grpcpage.CreateService
+		get                *grpcpage.GetService
+		list               *grpcpage.ListService
+		edit               *grpcpage.EditService
+		delete             *grpcpage.DeleteService
+		linkAdd            *grpcpage.LinkAddService
+		linkRemove         *grpcpage.LinkRemoveService
+		join               *grpcpage.JoinService
+		export             *grpcpage.ExportService
+		inviteQRCode       *grpcpage.InviteQRCodeService
+		feedTokenCreate    *grpcpage.FeedTokenCreateService
+		feedTokenRevoke    *grpcpage.FeedTokenRevokeService
+		linkBatchAdd       *grpcpage.LinkBatchAddService
+		linkBatchRemove    *grpcpage.LinkBatchRemoveService
+		linkMove           *grpcpage.LinkMoveService
+		duplicate          *grpcpage.DuplicateService
+		sectionCreate      *grpcpage.SectionCreateService
+		sectionRename      *grpcpage.SectionRenameService
+		sectionReorder     *grpcpage.SectionReorderService
+		sectionDelete      *grpcpage.SectionDeleteService
+		linkMoveSection    *grpcpage.LinkMoveSectionService
+		linkReact          *grpcpage.LinkReactService
+		linkUnreact        *grpcpage.LinkUnreactService
+		linkMark           *grpcpage.LinkMarkService
+		linkMarkAllRead    *grpcpage.LinkMarkAllReadService
+		checklistSet       *grpcpage.ChecklistSetService
+		linkToggleDone     *grpcpage.LinkToggleDoneService
+		linkRedirectAccept *grpcpage.LinkRedirectAcceptService
+		archive            *grpcpage.ArchiveService
+		unarchive          *grpcpage.UnarchiveService
+		pin                *grpcpage.PinService
+		unpin              *grpcpage.UnpinService
+		myPagesReorder     *grpcpage.MyPagesReorderService
+		inboxSet           *grpcpage.InboxSetService
+		capture            *grpcpage.CaptureService
+		slugSet            *grpcpage.SlugSetService
+		slugResolve        *grpcpage.SlugResolveService
+	}
+
+	template struct {
+		save *grpctemplate.SaveService
+		list *grpctemplate.ListService
+	}
+	comment struct {
+		add    *grpccomment.AddService
+		list   *grpccomment.ListService
+		edit   *grpccomment.EditService
+		delete *grpccomment.DeleteService
+	}
+	snapshot struct {
+		create *grpcsnapshot.CreateService
+		list   *grpcsnapshot.ListService
+		get    *grpcsnapshot.GetService
+	}
+	click struct {
+		stats *grpcclick.StatsService
+	}
+	invitation struct {
+		create *grpcinvitation.CreateService
+		list   *grpcinvitation.ListService
+		revoke *grpcinvitation.RevokeService
+		accept *grpcinvitation.AcceptService
+	}
+	notification struct {
+		list             *grpcnotification.ListService
+		markRead         *grpcnotification.MarkReadService
+		unreadCount      *grpcnotification.UnreadCountService
+		preferenceGet    *grpcnotification.PreferenceGetService
+		preferenceUpdate *grpcnotification.PreferenceUpdateService
+	}
+	device struct {
+		register   *grpcdevice.RegisterService
+		unregister *grpcdevice.UnregisterService
+	}
+	webhook struct {
+		create       *grpcwebhook.CreateService
+		list         *grpcwebhook.ListService
+		update       *grpcwebhook.UpdateService
+		delete       *grpcwebhook.DeleteService
+		deliveryList *grpcwebhook.DeliveryListService
+	}
+	accessToken struct {
+		create *grpcaccesstoken.CreateService
+		list   *grpcaccesstoken.ListService
+		revoke *grpcaccesstoken.RevokeService
 	}
 
 	user struct {
-		create *grpcuser.CreateService
-		login  *grpcuser.LoginService
-		get    *grpcuser.GetService
+		create        *grpcuser.CreateService
+		login         *grpcuser.LoginService
+		get           *grpcuser.GetService
+		profileUpdate *grpcuser.ProfileUpdateService
 	}
 }
 
@@ -43,38 +131,242 @@ func NewServer(
 	addLink *grpcpage.LinkAddService,
 	removeLink *grpcpage.LinkRemoveService,
 	joinPage *grpcpage.JoinService,
+	exportPage *grpcpage.ExportService,
+	getPageInviteQRCode *grpcpage.InviteQRCodeService,
+	createFeedToken *grpcpage.FeedTokenCreateService,
+	revokeFeedToken *grpcpage.FeedTokenRevokeService,
+	batchAddLinks *grpcpage.LinkBatchAddService,
+	batchRemoveLinks *grpcpage.LinkBatchRemoveService,
+	moveLinks *grpcpage.LinkMoveService,
+	duplicatePage *grpcpage.DuplicateService,
+	createSection *grpcpage.SectionCreateService,
+	renameSection *grpcpage.SectionRenameService,
+	reorderSections *grpcpage.SectionReorderService,
+	deleteSection *grpcpage.SectionDeleteService,
+	moveLinksToSection *grpcpage.LinkMoveSectionService,
+	reactToLink *grpcpage.LinkReactService,
+	removeLinkReaction *grpcpage.LinkUnreactService,
+	markLink *grpcpage.LinkMarkService,
+	markAllLinksRead *grpcpage.LinkMarkAllReadService,
+	setChecklistMode *grpcpage.ChecklistSetService,
+	toggleLinkDone *grpcpage.LinkToggleDoneService,
+	acceptLinkRedirect *grpcpage.LinkRedirectAcceptService,
+	archivePage *grpcpage.ArchiveService,
+	unarchivePage *grpcpage.UnarchiveService,
+	pinPage *grpcpage.PinService,
+	unpinPage *grpcpage.UnpinService,
+	reorderMyPages *grpcpage.MyPagesReorderService,
+	setInboxPage *grpcpage.InboxSetService,
+	captureLink *grpcpage.CaptureService,
+	setPageSlug *grpcpage.SlugSetService,
+	resolveSlug *grpcpage.SlugResolveService,
+	saveTemplate *grpctemplate.SaveService,
+	listTemplates *grpctemplate.ListService,
+	addComment *grpccomment.AddService,
+	listComments *grpccomment.ListService,
+	editComment *grpccomment.EditService,
+	deleteComment *grpccomment.DeleteService,
+	createLinkSnapshot *grpcsnapshot.CreateService,
+	listLinkSnapshots *grpcsnapshot.ListService,
+	getLinkSnapshot *grpcsnapshot.GetService,
+	getPageStats *grpcclick.StatsService,
+	createInvitation *grpcinvitation.CreateService,
+	listInvitations *grpcinvitation.ListService,
+	revokeInvitation *grpcinvitation.RevokeService,
+	acceptInvitation *grpcinvitation.AcceptService,
+	listNotifications *grpcnotification.ListService,
+	markNotificationsRead *grpcnotification.MarkReadService,
+	getUnreadNotificationCount *grpcnotification.UnreadCountService,
+	getNotificationPreferences *grpcnotification.PreferenceGetService,
+	updateNotificationPreferences *grpcnotification.PreferenceUpdateService,
+	registerDevice *grpcdevice.RegisterService,
+	unregisterDevice *grpcdevice.UnregisterService,
+	createWebhook *grpcwebhook.CreateService,
+	listWebhooks *grpcwebhook.ListService,
+	updateWebhook *grpcwebhook.UpdateService,
+	deleteWebhook *grpcwebhook.DeleteService,
+	listWebhookDeliveries *grpcwebhook.DeliveryListService,
+	createAccessToken *grpcaccesstoken.CreateService,
+	listAccessTokens *grpcaccesstoken.ListService,
+	revokeAccessToken *grpcaccesstoken.RevokeService,
 	createUser *grpcuser.CreateService,
 	loginUser *grpcuser.LoginService,
 	getUser *grpcuser.GetService,
+	updateProfile *grpcuser.ProfileUpdateService,
 ) *Server {
 	s := &Server{}
 	s.page = struct {
-		create     *grpcpage.CreateService
-		get        *grpcpage.GetService
-		list       *grpcpage.ListService
-		edit       *grpcpage.EditService
-		delete     *grpcpage.DeleteService
-		linkAdd    *grpcpage.LinkAddService
-		linkRemove *grpcpage.LinkRemoveService
-		join       *grpcpage.JoinService
+		create             *grpcpage.CreateService
+		get                *grpcpage.GetService
+		list               *grpcpage.ListService
+		edit               *grpcpage.EditService
+		delete             *grpcpage.DeleteService
+		linkAdd            *grpcpage.LinkAddService
+		linkRemove         *grpcpage.LinkRemoveService
+		join               *grpcpage.JoinService
+		export             *grpcpage.ExportService
+		inviteQRCode       *grpcpage.InviteQRCodeService
+		feedTokenCreate    *grpcpage.FeedTokenCreateService
+		feedTokenRevoke    *grpcpage.FeedTokenRevokeService
+		linkBatchAdd       *grpcpage.LinkBatchAddService
+		linkBatchRemove    *grpcpage.LinkBatchRemoveService
+		linkMove           *grpcpage.LinkMoveService
+		duplicate          *grpcpage.DuplicateService
+		sectionCreate      *grpcpage.SectionCreateService
+		sectionRename      *grpcpage.SectionRenameService
+		sectionReorder     *grpcpage.SectionReorderService
+		sectionDelete      *grpcpage.SectionDeleteService
+		linkMoveSection    *grpcpage.LinkMoveSectionService
+		linkReact          *grpcpage.LinkReactService
+		linkUnreact        *grpcpage.LinkUnreactService
+		linkMark           *grpcpage.LinkMarkService
+		linkMarkAllRead    *grpcpage.LinkMarkAllReadService
+		checklistSet       *grpcpage.ChecklistSetService
+		linkToggleDone     *grpcpage.LinkToggleDoneService
+		linkRedirectAccept *grpcpage.LinkRedirectAcceptService
+		archive            *grpcpage.ArchiveService
+		unarchive          *grpcpage.UnarchiveService
+		pin                *grpcpage.PinService
+		unpin              *grpcpage.UnpinService
+		myPagesReorder     *grpcpage.MyPagesReorderService
+		inboxSet           *grpcpage.InboxSetService
+		capture            *grpcpage.CaptureService
+		slugSet            *grpcpage.SlugSetService
+		slugResolve        *grpcpage.SlugResolveService
 	}{
-		create:     createPage,
-		get:        getPage,
-		list:       listPages,
-		edit:       editPage,
-		delete:     deletePage,
-		linkAdd:    addLink,
-		linkRemove: removeLink,
-		join:       joinPage,
+		create:             createPage,
+		get:                getPage,
+		list:               listPages,
+		edit:               editPage,
+		delete:             deletePage,
+		linkAdd:            addLink,
+		linkRemove:         removeLink,
+		join:               joinPage,
+		export:             exportPage,
+		inviteQRCode:       getPageInviteQRCode,
+		feedTokenCreate:    createFeedToken,
+		feedTokenRevoke:    revokeFeedToken,
+		linkBatchAdd:       batchAddLinks,
+		linkBatchRemove:    batchRemoveLinks,
+		linkMove:           moveLinks,
+		duplicate:          duplicatePage,
+		sectionCreate:      createSection,
+		sectionRename:      renameSection,
+		sectionReorder:     reorderSections,
+		sectionDelete:      deleteSection,
+		linkMoveSection:    moveLinksToSection,
+		linkReact:          reactToLink,
+		linkUnreact:        removeLinkReaction,
+		linkMark:           markLink,
+		linkMarkAllRead:    markAllLinksRead,
+		checklistSet:       setChecklistMode,
+		linkToggleDone:     toggleLinkDone,
+		linkRedirectAccept: acceptLinkRedirect,
+		archive:            archivePage,
+		unarchive:          unarchivePage,
+		pin:                pinPage,
+		unpin:              unpinPage,
+		myPagesReorder:     reorderMyPages,
+		inboxSet:           setInboxPage,
+		capture:            captureLink,
+		slugSet:            setPageSlug,
+		slugResolve:        resolveSlug,
+	}
+	s.template = struct {
+		save *grpctemplate.SaveService
+		list *grpctemplate.ListService
+	}{
+		save: saveTemplate,
+		list: listTemplates,
+	}
+	s.comment = struct {
+		add    *grpccomment.AddService
+		list   *grpccomment.ListService
+		edit   *grpccomment.EditService
+		delete *grpccomment.DeleteService
+	}{
+		add:    addComment,
+		list:   listComments,
+		edit:   editComment,
+		delete: deleteComment,
+	}
+	s.snapshot = struct {
+		create *grpcsnapshot.CreateService
+		list   *grpcsnapshot.ListService
+		get    *grpcsnapshot.GetService
+	}{
+		create: createLinkSnapshot,
+		list:   listLinkSnapshots,
+		get:    getLinkSnapshot,
+	}
+	s.click = struct {
+		stats *grpcclick.StatsService
+	}{
+		stats: getPageStats,
+	}
+	s.invitation = struct {
+		create *grpcinvitation.CreateService
+		list   *grpcinvitation.ListService
+		revoke *grpcinvitation.RevokeService
+		accept *grpcinvitation.AcceptService
+	}{
+		create: createInvitation,
+		list:   listInvitations,
+		revoke: revokeInvitation,
+		accept: acceptInvitation,
+	}
+	s.notification = struct {
+		list             *grpcnotification.ListService
+		markRead         *grpcnotification.MarkReadService
+		unreadCount      *grpcnotification.UnreadCountService
+		preferenceGet    *grpcnotification.PreferenceGetService
+		preferenceUpdate *grpcnotification.PreferenceUpdateService
+	}{
+		list:             listNotifications,
+		markRead:         markNotificationsRead,
+		unreadCount:      getUnreadNotificationCount,
+		preferenceGet:    getNotificationPreferences,
+		preferenceUpdate: updateNotificationPreferences,
+	}
+	s.device = struct {
+		register   *grpcdevice.RegisterService
+		unregister *grpcdevice.UnregisterService
+	}{
+		register:   registerDevice,
+		unregister: unregisterDevice,
+	}
+	s.webhook = struct {
+		create       *grpcwebhook.CreateService
+		list         *grpcwebhook.ListService
+		update       *grpcwebhook.UpdateService
+		delete       *grpcwebhook.DeleteService
+		deliveryList *grpcwebhook.DeliveryListService
+	}{
+		create:       createWebhook,
+		list:         listWebhooks,
+		update:       updateWebhook,
+		delete:       deleteWebhook,
+		deliveryList: listWebhookDeliveries,
+	}
+	s.accessToken = struct {
+		create *grpcaccesstoken.CreateService
+		list   *grpcaccesstoken.ListService
+		revoke *grpcaccesstoken.RevokeService
+	}{
+		create: createAccessToken,
+		list:   listAccessTokens,
+		revoke: revokeAccessToken,
 	}
 	s.user = struct {
-		create *grpcuser.CreateService
-		login  *grpcuser.LoginService
-		get    *grpcuser.GetService
+		create        *grpcuser.CreateService
+		login         *grpcuser.LoginService
+		get           *grpcuser.GetService
+		profileUpdate *grpcuser.ProfileUpdateService
 	}{
-		create: createUser,
-		login:  loginUser,
-		get:    getUser,
+		create:        createUser,
+		login:         loginUser,
+		get:           getUser,
+		profileUpdate: updateProfile,
 	}
 	return s
 }
@@ -120,6 +412,242 @@ func (s *Server) JoinPage(ctx context.Context, req *tsudzuriv1.JoinPageRequest)
 	return errcode.WrapGRPC(s.page.join.Join(ctx, req))
 }
 
+func (s *Server) ExportPage(ctx context.Context, req *tsudzuriv1.ExportPageRequest) (*httpbody.HttpBody, error) {
+	return errcode.WrapGRPC(s.page.export.Export(ctx, req))
+}
+
+func (s *Server) GetPageInviteQRCode(ctx context.Context, req *tsudzuriv1.GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	return errcode.WrapGRPC(s.page.inviteQRCode.PNG(ctx, req))
+}
+
+func (s *Server) GetPageInviteQRCodeSVG(ctx context.Context, req *tsudzuriv1.GetPageInviteQRCodeRequest) (*httpbody.HttpBody, error) {
+	return errcode.WrapGRPC(s.page.inviteQRCode.SVG(ctx, req))
+}
+
+func (s *Server) CreateFeedToken(ctx context.Context, req *tsudzuriv1.CreateFeedTokenRequest) (*tsudzuriv1.CreateFeedTokenResponse, error) {
+	return errcode.WrapGRPC(s.page.feedTokenCreate.Create(ctx, req))
+}
+
+func (s *Server) RevokeFeedToken(ctx context.Context, req *tsudzuriv1.RevokeFeedTokenRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.feedTokenRevoke.Revoke(ctx, req))
+}
+
+func (s *Server) BatchAddLinks(ctx context.Context, req *tsudzuriv1.BatchAddLinksRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.linkBatchAdd.BatchAdd(ctx, req))
+}
+
+func (s *Server) BatchRemoveLinks(ctx context.Context, req *tsudzuriv1.BatchRemoveLinksRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.linkBatchRemove.BatchRemove(ctx, req))
+}
+
+func (s *Server) MoveLinks(ctx context.Context, req *tsudzuriv1.MoveLinksRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.linkMove.Move(ctx, req))
+}
+
+func (s *Server) DuplicatePage(ctx context.Context, req *tsudzuriv1.DuplicatePageRequest) (*tsudzuriv1.Page, error) {
+	return errcode.WrapGRPC(s.page.duplicate.Duplicate(ctx, req))
+}
+
+func (s *Server) CreateSection(ctx context.Context, req *tsudzuriv1.CreateSectionRequest) (*tsudzuriv1.Section, error) {
+	return errcode.WrapGRPC(s.page.sectionCreate.Create(ctx, req))
+}
+
+func (s *Server) RenameSection(ctx context.Context, req *tsudzuriv1.RenameSectionRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.sectionRename.Rename(ctx, req))
+}
+
+func (s *Server) ReorderSections(ctx context.Context, req *tsudzuriv1.ReorderSectionsRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.sectionReorder.Reorder(ctx, req))
+}
+
+func (s *Server) DeleteSection(ctx context.Context, req *tsudzuriv1.DeleteSectionRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.sectionDelete.Delete(ctx, req))
+}
+
+func (s *Server) MoveLinksToSection(ctx context.Context, req *tsudzuriv1.MoveLinksToSectionRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.linkMoveSection.MoveToSection(ctx, req))
+}
+
+func (s *Server) ReactToLink(ctx context.Context, req *tsudzuriv1.ReactToLinkRequest) (*tsudzuriv1.Link, error) {
+	return errcode.WrapGRPC(s.page.linkReact.React(ctx, req))
+}
+
+func (s *Server) RemoveLinkReaction(ctx context.Context, req *tsudzuriv1.RemoveLinkReactionRequest) (*tsudzuriv1.Link, error) {
+	return errcode.WrapGRPC(s.page.linkUnreact.Unreact(ctx, req))
+}
+
+func (s *Server) MarkLink(ctx context.Context, req *tsudzuriv1.MarkLinkRequest) (*tsudzuriv1.Link, error) {
+	return errcode.WrapGRPC(s.page.linkMark.Mark(ctx, req))
+}
+
+func (s *Server) MarkAllLinksRead(ctx context.Context, req *tsudzuriv1.MarkAllLinksReadRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.linkMarkAllRead.MarkAllRead(ctx, req))
+}
+
+func (s *Server) SetChecklistMode(ctx context.Context, req *tsudzuriv1.SetChecklistModeRequest) (*tsudzuriv1.Page, error) {
+	return errcode.WrapGRPC(s.page.checklistSet.Set(ctx, req))
+}
+
+func (s *Server) ToggleLinkDone(ctx context.Context, req *tsudzuriv1.ToggleLinkDoneRequest) (*tsudzuriv1.Link, error) {
+	return errcode.WrapGRPC(s.page.linkToggleDone.ToggleDone(ctx, req))
+}
+
+func (s *Server) AcceptLinkRedirect(ctx context.Context, req *tsudzuriv1.AcceptLinkRedirectRequest) (*tsudzuriv1.Link, error) {
+	return errcode.WrapGRPC(s.page.linkRedirectAccept.AcceptRedirect(ctx, req))
+}
+
+func (s *Server) ArchivePage(ctx context.Context, req *tsudzuriv1.ArchivePageRequest) (*tsudzuriv1.Page, error) {
+	return errcode.WrapGRPC(s.page.archive.Archive(ctx, req))
+}
+
+func (s *Server) UnarchivePage(ctx context.Context, req *tsudzuriv1.UnarchivePageRequest) (*tsudzuriv1.Page, error) {
+	return errcode.WrapGRPC(s.page.unarchive.Unarchive(ctx, req))
+}
+
+func (s *Server) PinPage(ctx context.Context, req *tsudzuriv1.PinPageRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.pin.Pin(ctx, req))
+}
+
+func (s *Server) UnpinPage(ctx context.Context, req *tsudzuriv1.UnpinPageRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.unpin.Unpin(ctx, req))
+}
+
+func (s *Server) ReorderMyPages(ctx context.Context, req *tsudzuriv1.ReorderMyPagesRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.myPagesReorder.Reorder(ctx, req))
+}
+
+func (s *Server) SetInboxPage(ctx context.Context, req *tsudzuriv1.SetInboxPageRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.page.inboxSet.SetInbox(ctx, req))
+}
+
+func (s *Server) CaptureLink(ctx context.Context, req *tsudzuriv1.CaptureLinkRequest) (*tsudzuriv1.CaptureLinkResponse, error) {
+	return errcode.WrapGRPC(s.page.capture.Capture(ctx, req))
+}
+
+func (s *Server) SetPageSlug(ctx context.Context, req *tsudzuriv1.SetPageSlugRequest) (*tsudzuriv1.Page, error) {
+	return errcode.WrapGRPC(s.page.slugSet.Set(ctx, req))
+}
+
+func (s *Server) ResolveSlug(ctx context.Context, req *tsudzuriv1.ResolveSlugRequest) (*tsudzuriv1.ResolveSlugResponse, error) {
+	return errcode.WrapGRPC(s.page.slugResolve.Resolve(ctx, req))
+}
+
+func (s *Server) SaveTemplate(ctx context.Context, req *tsudzuriv1.SaveTemplateRequest) (*tsudzuriv1.Template, error) {
+	return errcode.WrapGRPC(s.template.save.Save(ctx, req))
+}
+
+func (s *Server) ListTemplates(ctx context.Context, req *tsudzuriv1.ListTemplatesRequest) (*tsudzuriv1.ListTemplatesResponse, error) {
+	return errcode.WrapGRPC(s.template.list.List(ctx, req))
+}
+
+func (s *Server) AddComment(ctx context.Context, req *tsudzuriv1.AddCommentRequest) (*tsudzuriv1.Comment, error) {
+	return errcode.WrapGRPC(s.comment.add.Add(ctx, req))
+}
+
+func (s *Server) ListComments(ctx context.Context, req *tsudzuriv1.ListCommentsRequest) (*tsudzuriv1.ListCommentsResponse, error) {
+	return errcode.WrapGRPC(s.comment.list.List(ctx, req))
+}
+
+func (s *Server) EditComment(ctx context.Context, req *tsudzuriv1.EditCommentRequest) (*tsudzuriv1.Comment, error) {
+	return errcode.WrapGRPC(s.comment.edit.Edit(ctx, req))
+}
+
+func (s *Server) DeleteComment(ctx context.Context, req *tsudzuriv1.DeleteCommentRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.comment.delete.Delete(ctx, req))
+}
+
+func (s *Server) CreateLinkSnapshot(ctx context.Context, req *tsudzuriv1.CreateLinkSnapshotRequest) (*tsudzuriv1.LinkSnapshot, error) {
+	return errcode.WrapGRPC(s.snapshot.create.Create(ctx, req))
+}
+
+func (s *Server) ListLinkSnapshots(ctx context.Context, req *tsudzuriv1.ListLinkSnapshotsRequest) (*tsudzuriv1.ListLinkSnapshotsResponse, error) {
+	return errcode.WrapGRPC(s.snapshot.list.List(ctx, req))
+}
+
+func (s *Server) GetLinkSnapshot(ctx context.Context, req *tsudzuriv1.GetLinkSnapshotRequest) (*tsudzuriv1.GetLinkSnapshotResponse, error) {
+	return errcode.WrapGRPC(s.snapshot.get.Get(ctx, req))
+}
+
+func (s *Server) GetPageStats(ctx context.Context, req *tsudzuriv1.GetPageStatsRequest) (*tsudzuriv1.PageStats, error) {
+	return errcode.WrapGRPC(s.click.stats.Get(ctx, req))
+}
+
+func (s *Server) CreateInvitation(ctx context.Context, req *tsudzuriv1.CreateInvitationRequest) (*tsudzuriv1.Invitation, error) {
+	return errcode.WrapGRPC(s.invitation.create.Create(ctx, req))
+}
+
+func (s *Server) ListInvitations(ctx context.Context, req *tsudzuriv1.ListInvitationsRequest) (*tsudzuriv1.ListInvitationsResponse, error) {
+	return errcode.WrapGRPC(s.invitation.list.List(ctx, req))
+}
+
+func (s *Server) RevokeInvitation(ctx context.Context, req *tsudzuriv1.RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.invitation.revoke.Revoke(ctx, req))
+}
+
+func (s *Server) AcceptInvitation(ctx context.Context, req *tsudzuriv1.AcceptInvitationRequest) (*tsudzuriv1.AcceptInvitationResponse, error) {
+	return errcode.WrapGRPC(s.invitation.accept.Accept(ctx, req))
+}
+
+func (s *Server) ListNotifications(ctx context.Context, req *tsudzuriv1.ListNotificationsRequest) (*tsudzuriv1.ListNotificationsResponse, error) {
+	return errcode.WrapGRPC(s.notification.list.List(ctx, req))
+}
+
+func (s *Server) MarkNotificationsRead(ctx context.Context, req *tsudzuriv1.MarkNotificationsReadRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.notification.markRead.MarkRead(ctx, req))
+}
+
+func (s *Server) GetUnreadNotificationCount(ctx context.Context, req *emptypb.Empty) (*tsudzuriv1.UnreadNotificationCount, error) {
+	return errcode.WrapGRPC(s.notification.unreadCount.UnreadCount(ctx, req))
+}
+
+func (s *Server) GetNotificationPreferences(ctx context.Context, req *emptypb.Empty) (*tsudzuriv1.NotificationPreferences, error) {
+	return errcode.WrapGRPC(s.notification.preferenceGet.Get(ctx, req))
+}
+
+func (s *Server) UpdateNotificationPreferences(ctx context.Context, req *tsudzuriv1.UpdateNotificationPreferencesRequest) (*tsudzuriv1.NotificationPreferences, error) {
+	return errcode.WrapGRPC(s.notification.preferenceUpdate.Update(ctx, req))
+}
+
+func (s *Server) RegisterDevice(ctx context.Context, req *tsudzuriv1.RegisterDeviceRequest) (*tsudzuriv1.Device, error) {
+	return errcode.WrapGRPC(s.device.register.Register(ctx, req))
+}
+
+func (s *Server) UnregisterDevice(ctx context.Context, req *tsudzuriv1.UnregisterDeviceRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.device.unregister.Unregister(ctx, req))
+}
+
+func (s *Server) CreateWebhook(ctx context.Context, req *tsudzuriv1.CreateWebhookRequest) (*tsudzuriv1.CreateWebhookResponse, error) {
+	return errcode.WrapGRPC(s.webhook.create.Create(ctx, req))
+}
+
+func (s *Server) ListWebhooks(ctx context.Context, req *tsudzuriv1.ListWebhooksRequest) (*tsudzuriv1.ListWebhooksResponse, error) {
+	return errcode.WrapGRPC(s.webhook.list.List(ctx, req))
+}
+
+func (s *Server) UpdateWebhook(ctx context.Context, req *tsudzuriv1.UpdateWebhookRequest) (*tsudzuriv1.Webhook, error) {
+	return errcode.WrapGRPC(s.webhook.update.Update(ctx, req))
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *tsudzuriv1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.webhook.delete.Delete(ctx, req))
+}
+
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *tsudzuriv1.ListWebhookDeliveriesRequest) (*tsudzuriv1.ListWebhookDeliveriesResponse, error) {
+	return errcode.WrapGRPC(s.webhook.deliveryList.List(ctx, req))
+}
+
+func (s *Server) CreateAccessToken(ctx context.Context, req *tsudzuriv1.CreateAccessTokenRequest) (*tsudzuriv1.CreateAccessTokenResponse, error) {
+	return errcode.WrapGRPC(s.accessToken.create.Create(ctx, req))
+}
+
+func (s *Server) ListAccessTokens(ctx context.Context, req *emptypb.Empty) (*tsudzuriv1.ListAccessTokensResponse, error) {
+	return errcode.WrapGRPC(s.accessToken.list.List(ctx, req))
+}
+
+func (s *Server) RevokeAccessToken(ctx context.Context, req *tsudzuriv1.RevokeAccessTokenRequest) (*emptypb.Empty, error) {
+	return errcode.WrapGRPC(s.accessToken.revoke.Revoke(ctx, req))
+}
+
 func (s *Server) CreateUser(ctx context.Context, req *emptypb.Empty) (*tsudzuriv1.User, error) {
 	return errcode.WrapGRPC(s.user.create.Create(ctx, req))
 }
@@ -131,3 +659,7 @@ func (s *Server) Login(ctx context.Context, req *tsudzuriv1.LoginRequest) (*empt
 func (s *Server) Get(ctx context.Context, req *emptypb.Empty) (*tsudzuriv1.User, error) {
 	return errcode.WrapGRPC(s.user.get.Get(ctx, req))
 }
+
+func (s *Server) UpdateProfile(ctx context.Context, req *tsudzuriv1.UpdateProfileRequest) (*tsudzuriv1.User, error) {
+	return errcode.WrapGRPC(s.user.profileUpdate.UpdateProfile(ctx, req))
+}
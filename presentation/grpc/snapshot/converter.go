@@ -0,0 +1,32 @@
+package snapshot
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+)
+
+func toProtoSnapshot(s *dsnapshot.Snapshot) *tsudzuriv1.LinkSnapshot {
+	if s == nil {
+		return nil
+	}
+
+	return &tsudzuriv1.LinkSnapshot{
+		Id:              s.ID(),
+		LinkId:          s.LinkID(),
+		Url:             s.URL(),
+		Size:            s.Size(),
+		CreatedByUserId: s.CreatedBy(),
+		CreatedAt:       toProtoTimestamp(s.CreatedAt()),
+	}
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,45 @@
+package snapshot
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
+)
+
+type CreateService struct {
+	usecase struct {
+		create usnapshot.CreateUsecase
+	}
+}
+
+func NewCreateService(cu usnapshot.CreateUsecase) *CreateService {
+	return &CreateService{
+		usecase: struct{ create usnapshot.CreateUsecase }{create: cu},
+	}
+}
+
+func (s *CreateService) Create(ctx context.Context, req *tsudzuriv1.CreateLinkSnapshotRequest) (*tsudzuriv1.LinkSnapshot, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/snapshot.Create")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Snapshot create request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	snapshot, err := s.usecase.create.Create(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Snapshot create succeeded snapshot_id=%s size=%d user_uid=%s", snapshot.ID(), snapshot.Size(), user.UID())
+	return toProtoSnapshot(snapshot), nil
+}
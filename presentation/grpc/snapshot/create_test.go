@@ -0,0 +1,95 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockcreate "github.com/naka-sei/tsudzuri/usecase/snapshot/mock/mock_create"
+)
+
+func TestCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateLinkSnapshotRequest
+	}
+	type want struct {
+		res *tsudzuriv1.LinkSnapshot
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	snapshot := dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key", 1234, "user-id", createdAt)
+	req := &tsudzuriv1.CreateLinkSnapshotRequest{PageId: "page-1", LinkId: "link-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockcreate.MockCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "link-1").Return(snapshot, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.LinkSnapshot{
+					Id:              "snapshot-1",
+					LinkId:          "link-1",
+					Url:             "https://example.com",
+					Size:            1234,
+					CreatedByUserId: "user-id",
+					CreatedAt:       timestamppb.New(createdAt),
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "link-1").Return(nil, errors.New("create error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: errors.New("create error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockcreate.NewMockCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package snapshot
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
+)
+
+type GetService struct {
+	usecase struct {
+		get usnapshot.GetUsecase
+	}
+}
+
+func NewGetService(gu usnapshot.GetUsecase) *GetService {
+	return &GetService{
+		usecase: struct{ get usnapshot.GetUsecase }{get: gu},
+	}
+}
+
+func (s *GetService) Get(ctx context.Context, req *tsudzuriv1.GetLinkSnapshotRequest) (*tsudzuriv1.GetLinkSnapshotResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/snapshot.Get")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Snapshot get request page_id=%s link_id=%s snapshot_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), req.GetSnapshotId(), user.UID())
+
+	snapshot, content, err := s.usecase.get.Get(ctx, req.GetPageId(), req.GetLinkId(), req.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Snapshot get succeeded snapshot_id=%s user_uid=%s", snapshot.ID(), user.UID())
+	return &tsudzuriv1.GetLinkSnapshotResponse{
+		Snapshot: toProtoSnapshot(snapshot),
+		Html:     string(content),
+	}, nil
+}
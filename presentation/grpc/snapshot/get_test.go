@@ -0,0 +1,98 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
+	mockget "github.com/naka-sei/tsudzuri/usecase/snapshot/mock/mock_get"
+)
+
+func TestGetService_Get(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.GetLinkSnapshotRequest
+	}
+	type want struct {
+		res *tsudzuriv1.GetLinkSnapshotResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	snapshot := dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key", 13, "user-id", createdAt)
+	req := &tsudzuriv1.GetLinkSnapshotRequest{PageId: "page-1", LinkId: "link-1", SnapshotId: "snapshot-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockget.MockGetUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-1", "link-1", "snapshot-1").Return(snapshot, []byte("<p>café</p>"), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.GetLinkSnapshotResponse{
+					Snapshot: &tsudzuriv1.LinkSnapshot{
+						Id:              "snapshot-1",
+						LinkId:          "link-1",
+						Url:             "https://example.com",
+						Size:            13,
+						CreatedByUserId: "user-id",
+						CreatedAt:       timestamppb.New(createdAt),
+					},
+					Html: "<p>café</p>",
+				},
+			},
+		},
+		{
+			name: "not_found",
+			setup: func(m *mockget.MockGetUsecase) {
+				m.EXPECT().Get(gomock.Any(), "page-1", "link-1", "snapshot-1").Return(nil, nil, usnapshot.ErrSnapshotNotFound)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: usnapshot.ErrSnapshotNotFound},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockget.NewMockGetUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewGetService(usecase)
+			got, err := svc.Get(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package snapshot
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	usnapshot "github.com/naka-sei/tsudzuri/usecase/snapshot"
+)
+
+type ListService struct {
+	usecase struct {
+		list usnapshot.ListUsecase
+	}
+}
+
+func NewListService(lu usnapshot.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list usnapshot.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListLinkSnapshotsRequest) (*tsudzuriv1.ListLinkSnapshotsResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/snapshot.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Snapshot list request page_id=%s link_id=%s user_uid=%s", req.GetPageId(), req.GetLinkId(), user.UID())
+
+	snapshots, err := s.usecase.list.List(ctx, req.GetPageId(), req.GetLinkId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListLinkSnapshotsResponse{
+		Snapshots: make([]*tsudzuriv1.LinkSnapshot, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, toProtoSnapshot(snapshot))
+	}
+
+	logger.Sugar().Infof("Snapshot list responded: snapshots=%d user_uid=%s", len(resp.GetSnapshots()), user.UID())
+	return resp, nil
+}
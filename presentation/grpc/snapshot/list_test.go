@@ -0,0 +1,105 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/snapshot/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListLinkSnapshotsRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListLinkSnapshotsResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	snapshots := []*dsnapshot.Snapshot{
+		dsnapshot.ReconstructSnapshot("snapshot-2", "page-1", "link-1", "https://example.com/new", "key-2", 20, "user-id", createdAt.Add(time.Hour)),
+		// The requester was deleted.
+		dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key-1", 10, "", createdAt),
+	}
+	req := &tsudzuriv1.ListLinkSnapshotsRequest{PageId: "page-1", LinkId: "link-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(snapshots, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{
+				res: &tsudzuriv1.ListLinkSnapshotsResponse{
+					Snapshots: []*tsudzuriv1.LinkSnapshot{
+						{Id: "snapshot-2", LinkId: "link-1", Url: "https://example.com/new", Size: 20, CreatedByUserId: "user-id", CreatedAt: timestamppb.New(createdAt.Add(time.Hour))},
+						{Id: "snapshot-1", LinkId: "link-1", Url: "https://example.com", Size: 10, CreatedAt: timestamppb.New(createdAt)},
+					},
+				},
+			},
+		},
+		{
+			name: "empty",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{res: &tsudzuriv1.ListLinkSnapshotsResponse{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1", "link-1").Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user), req: req},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), req: req},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
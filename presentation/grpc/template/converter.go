@@ -0,0 +1,43 @@
+package template
+
+import (
+	"math"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+)
+
+func toProtoTemplate(t *dtemplate.Template, user *duser.User) *tsudzuriv1.Template {
+	if t == nil {
+		return nil
+	}
+
+	protoTemplate := &tsudzuriv1.Template{
+		Id:         t.ID(),
+		Title:      t.Title(),
+		Visibility: string(t.Visibility()),
+		Owned:      t.IsOwnedBy(user),
+	}
+
+	if links := t.Links(); len(links) > 0 {
+		protoTemplate.Links = make([]*tsudzuriv1.Link, 0, len(links))
+		for _, lnk := range links {
+			priority := lnk.Priority()
+			var priorityInt32 int32
+			if priority < math.MinInt32 || priority > math.MaxInt32 {
+				priorityInt32 = 0 // Default to 0 if out of range
+			} else {
+				priorityInt32 = int32(priority) // #nosec G115 - validated range above
+			}
+			protoTemplate.Links = append(protoTemplate.Links, &tsudzuriv1.Link{
+				Id:       lnk.ID(),
+				Url:      lnk.URL(),
+				Memo:     lnk.Memo(),
+				Priority: priorityInt32,
+			})
+		}
+	}
+
+	return protoTemplate
+}
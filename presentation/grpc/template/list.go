@@ -0,0 +1,52 @@
+package template
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	utemplate "github.com/naka-sei/tsudzuri/usecase/template"
+)
+
+type ListService struct {
+	usecase struct {
+		list utemplate.ListUsecase
+	}
+}
+
+func NewListService(lu utemplate.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list utemplate.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, _ *tsudzuriv1.ListTemplatesRequest) (*tsudzuriv1.ListTemplatesResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/template.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Template list request user_uid=%s", user.UID())
+
+	templates, err := s.usecase.list.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListTemplatesResponse{
+		Templates: make([]*tsudzuriv1.Template, 0, len(templates)),
+	}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toProtoTemplate(t, user))
+	}
+
+	logger.Sugar().Infof("Template list responded: count=%d user_uid=%s", len(resp.GetTemplates()), user.UID())
+	return resp, nil
+}
@@ -0,0 +1,102 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/template/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListTemplatesRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListTemplatesResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	teammate := duser.ReconstructUser("teammate-id", "uid-2", "anonymous", nil)
+	templates := []*dtemplate.Template{
+		dtemplate.ReconstructTemplate("template-1", "Mine", *user, dtemplate.VisibilityPrivate, nil, nil),
+		dtemplate.ReconstructTemplate("template-2", "Team", *teammate, dtemplate.VisibilityShared, nil, duser.Users{user}),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any()).Return(templates, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListTemplatesRequest{},
+			},
+			want: want{
+				res: &tsudzuriv1.ListTemplatesResponse{
+					Templates: []*tsudzuriv1.Template{
+						{Id: "template-1", Title: "Mine", Visibility: "private", Owned: true},
+						{Id: "template-2", Title: "Team", Visibility: "shared"},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("list error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.ListTemplatesRequest{},
+			},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.ListTemplatesRequest{},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
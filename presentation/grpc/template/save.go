@@ -0,0 +1,48 @@
+package template
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	utemplate "github.com/naka-sei/tsudzuri/usecase/template"
+)
+
+type SaveService struct {
+	usecase struct {
+		save utemplate.SaveUsecase
+	}
+}
+
+func NewSaveService(su utemplate.SaveUsecase) *SaveService {
+	return &SaveService{
+		usecase: struct{ save utemplate.SaveUsecase }{save: su},
+	}
+}
+
+func (s *SaveService) Save(ctx context.Context, req *tsudzuriv1.SaveTemplateRequest) (*tsudzuriv1.Template, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/template.Save")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Template save request page_id=%s visibility=%s user_uid=%s", req.GetPageId(), req.GetVisibility(), user.UID())
+
+	template, err := s.usecase.save.Save(ctx, req.GetPageId(), req.GetTitle(), dtemplate.Visibility(req.GetVisibility()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toProtoTemplate(template, user)
+	logger.Sugar().Infof("Template save succeeded: template_id=%s page_id=%s user_uid=%s", resp.GetId(), req.GetPageId(), user.UID())
+
+	return resp, nil
+}
@@ -0,0 +1,107 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocksave "github.com/naka-sei/tsudzuri/usecase/template/mock/mock_save"
+)
+
+func TestSaveService_Save(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.SaveTemplateRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Template
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	saved := dtemplate.ReconstructTemplate("template-1", "Trip", *user, dtemplate.VisibilityShared, dpage.Links{
+		dpage.ReconstructLink("https://example.com", "memo", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocksave.MockSaveUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocksave.MockSaveUsecase) {
+				m.EXPECT().Save(gomock.Any(), "page-1", "Trip", dtemplate.VisibilityShared).Return(saved, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.SaveTemplateRequest{PageId: "page-1", Title: "Trip", Visibility: "shared"},
+			},
+			want: want{
+				res: &tsudzuriv1.Template{
+					Id:         "template-1",
+					Title:      "Trip",
+					Visibility: "shared",
+					Owned:      true,
+					Links: []*tsudzuriv1.Link{{
+						Id:       "link-1",
+						Url:      "https://example.com",
+						Memo:     "memo",
+						Priority: 1,
+					}},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocksave.MockSaveUsecase) {
+				m.EXPECT().Save(gomock.Any(), "page-1", "", dtemplate.VisibilityPrivate).Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: &tsudzuriv1.SaveTemplateRequest{PageId: "page-1", Visibility: "private"},
+			},
+			want: want{err: errors.New("save error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.SaveTemplateRequest{PageId: "page-1", Visibility: "private"},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocksave.NewMockSaveUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewSaveService(usecase)
+			got, err := svc.Save(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -10,11 +10,15 @@ func toProtoUser(u *duser.User) *tsudzuriv1.User {
 	if u == nil {
 		return nil
 	}
+	profile := u.Profile()
 	proto := &tsudzuriv1.User{
 		Id:            u.ID(),
 		Uid:           u.UID(),
 		Provider:      string(u.Provider()),
 		JoinedPageIds: u.JoinedPageIDs(),
+		DisplayName:   profile.DisplayName(),
+		AvatarUrl:     profile.AvatarURL(),
+		Locale:        profile.Locale(),
 	}
 	if email := u.Email(); email != nil {
 		proto.Email = wrapperspb.String(*email)
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uuser "github.com/naka-sei/tsudzuri/usecase/user"
+)
+
+type ProfileUpdateService struct {
+	usecase struct {
+		profileUpdate uuser.ProfileUpdateUsecase
+	}
+}
+
+func NewProfileUpdateService(pu uuser.ProfileUpdateUsecase) *ProfileUpdateService {
+	return &ProfileUpdateService{
+		usecase: struct{ profileUpdate uuser.ProfileUpdateUsecase }{profileUpdate: pu},
+	}
+}
+
+func (s *ProfileUpdateService) UpdateProfile(ctx context.Context, req *tsudzuriv1.UpdateProfileRequest) (*tsudzuriv1.User, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/user.UpdateProfile")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("User profile update request: user_uid=%s", user.UID())
+
+	updated, err := s.usecase.profileUpdate.UpdateProfile(ctx, req.GetDisplayName(), req.GetAvatarUrl(), req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("User profile updated: user_uid=%s", user.UID())
+	return toProtoUser(updated), nil
+}
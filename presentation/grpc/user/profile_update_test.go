@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockprofileupdate "github.com/naka-sei/tsudzuri/usecase/user/mock/mock_profile_update"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProfileUpdateService_UpdateProfile(t *testing.T) {
+	type fields struct {
+		usecase *mockprofileupdate.MockProfileUpdateUsecase
+	}
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.UpdateProfileRequest
+	}
+	type want struct {
+		res *tsudzuriv1.User
+		err error
+	}
+
+	ctxUser := duser.ReconstructUser("id-1", "uid-1", string(duser.ProviderGoogle), nil)
+
+	tests := []struct {
+		name  string
+		setup func(f *fields)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(f *fields) {
+				f.usecase.EXPECT().UpdateProfile(gomock.Any(), "Naka", "https://example.com/a.png", "ja").Return(
+					duser.ReconstructUser("id-1", "uid-1", string(duser.ProviderGoogle), nil,
+						duser.WithProfile(duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja"))), nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), ctxUser),
+				req: &tsudzuriv1.UpdateProfileRequest{DisplayName: "Naka", AvatarUrl: "https://example.com/a.png", Locale: "ja"},
+			},
+			want: want{
+				res: &tsudzuriv1.User{
+					Id:            "id-1",
+					Uid:           "uid-1",
+					Provider:      string(duser.ProviderGoogle),
+					JoinedPageIds: []string{},
+					DisplayName:   "Naka",
+					AvatarUrl:     "https://example.com/a.png",
+					Locale:        "ja",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(f *fields) {
+				f.usecase.EXPECT().UpdateProfile(gomock.Any(), "", "", "??").Return(nil, duser.ErrInvalidLocale)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), ctxUser),
+				req: &tsudzuriv1.UpdateProfileRequest{Locale: "??"},
+			},
+			want: want{err: duser.ErrInvalidLocale},
+		},
+		{
+			name:  "no_user_in_context",
+			setup: func(f *fields) {},
+			args: args{
+				ctx: context.Background(),
+				req: &tsudzuriv1.UpdateProfileRequest{},
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := &fields{usecase: mockprofileupdate.NewMockProfileUpdateUsecase(ctrl)}
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			svc := NewProfileUpdateService(f.usecase)
+			got, err := svc.UpdateProfile(tt.args.ctx, tt.args.req)
+
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
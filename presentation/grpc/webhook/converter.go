@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+)
+
+// toProtoWebhook converts the webhook. The signing secret is never included; it is only returned once on creation.
+func toProtoWebhook(w *dwebhook.Webhook) *tsudzuriv1.Webhook {
+	if w == nil {
+		return nil
+	}
+
+	events := make([]string, 0, len(w.Events()))
+	for _, e := range w.Events() {
+		events = append(events, string(e))
+	}
+
+	var disabledAt *timestamppb.Timestamp
+	if w.DisabledAt() != nil {
+		disabledAt = toProtoTimestamp(*w.DisabledAt())
+	}
+
+	return &tsudzuriv1.Webhook{
+		Id:           w.ID(),
+		PageId:       w.PageID(),
+		Url:          w.URL(),
+		Events:       events,
+		Active:       w.Active(),
+		FailureCount: int32(w.FailureCount()),
+		DisabledAt:   disabledAt,
+		CreatedAt:    toProtoTimestamp(w.CreatedAt()),
+	}
+}
+
+func toProtoWebhooks(webhooks []*dwebhook.Webhook) []*tsudzuriv1.Webhook {
+	protoWebhooks := make([]*tsudzuriv1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		protoWebhooks = append(protoWebhooks, toProtoWebhook(w))
+	}
+	return protoWebhooks
+}
+
+func toProtoDeliveries(deliveries []*dwebhook.Delivery) []*tsudzuriv1.WebhookDelivery {
+	protoDeliveries := make([]*tsudzuriv1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		protoDeliveries = append(protoDeliveries, &tsudzuriv1.WebhookDelivery{
+			Id:         d.ID(),
+			EventId:    d.EventID(),
+			Event:      string(d.EventType()),
+			Attempt:    int32(d.Attempt()),
+			StatusCode: int32(d.StatusCode()),
+			Error:      d.Error(),
+			Succeeded:  d.Succeeded(),
+			DurationMs: d.Duration().Milliseconds(),
+			CreatedAt:  toProtoTimestamp(d.CreatedAt()),
+		})
+	}
+	return protoDeliveries
+}
+
+func toEventTypes(events []string) []dwebhook.EventType {
+	eventTypes := make([]dwebhook.EventType, 0, len(events))
+	for _, e := range events {
+		eventTypes = append(eventTypes, dwebhook.EventType(e))
+	}
+	return eventTypes
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+)
+
+type CreateService struct {
+	usecase struct {
+		create uwebhook.CreateUsecase
+	}
+}
+
+func NewCreateService(cu uwebhook.CreateUsecase) *CreateService {
+	return &CreateService{
+		usecase: struct{ create uwebhook.CreateUsecase }{create: cu},
+	}
+}
+
+func (s *CreateService) Create(ctx context.Context, req *tsudzuriv1.CreateWebhookRequest) (*tsudzuriv1.CreateWebhookResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/webhook.Create")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Webhook create request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	webhook, err := s.usecase.create.Create(ctx, req.GetPageId(), req.GetUrl(), toEventTypes(req.GetEvents()))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Webhook create succeeded webhook_id=%s user_uid=%s", webhook.ID(), user.UID())
+	return &tsudzuriv1.CreateWebhookResponse{
+		Webhook: toProtoWebhook(webhook),
+		Secret:  webhook.Secret(),
+	}, nil
+}
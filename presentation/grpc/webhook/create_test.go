@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockcreate "github.com/naka-sei/tsudzuri/usecase/webhook/mock/mock_create"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateService_Create(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.CreateWebhookRequest
+	}
+	type want struct {
+		res *tsudzuriv1.CreateWebhookResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.CreateWebhookRequest{PageId: "page-1", Url: "https://example.com/hook", Events: []string{"link.added"}}
+	webhook := dwebhook.ReconstructWebhook("wh-1", "page-1", "https://example.com/hook", "whsec_secret",
+		[]dwebhook.EventType{dwebhook.EventLinkAdded}, "user-id", dwebhook.WithTimestamps(fixedNow, fixedNow))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockcreate.MockCreateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "https://example.com/hook", []dwebhook.EventType{dwebhook.EventLinkAdded}).Return(webhook, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.CreateWebhookResponse{
+					Webhook: &tsudzuriv1.Webhook{
+						Id:        "wh-1",
+						PageId:    "page-1",
+						Url:       "https://example.com/hook",
+						Events:    []string{"link.added"},
+						Active:    true,
+						CreatedAt: timestamppb.New(fixedNow),
+					},
+					Secret: "whsec_secret",
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockcreate.MockCreateUsecase) {
+				m.EXPECT().Create(gomock.Any(), "page-1", "https://example.com/hook", []dwebhook.EventType{dwebhook.EventLinkAdded}).Return(nil, dwebhook.ErrInvalidURL)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: dwebhook.ErrInvalidURL},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockcreate.NewMockCreateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewCreateService(usecase)
+			got, err := svc.Create(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+)
+
+type DeleteService struct {
+	usecase struct {
+		delete uwebhook.DeleteUsecase
+	}
+}
+
+func NewDeleteService(du uwebhook.DeleteUsecase) *DeleteService {
+	return &DeleteService{
+		usecase: struct{ delete uwebhook.DeleteUsecase }{delete: du},
+	}
+}
+
+func (s *DeleteService) Delete(ctx context.Context, req *tsudzuriv1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/webhook.Delete")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Webhook delete request page_id=%s webhook_id=%s user_uid=%s", req.GetPageId(), req.GetWebhookId(), user.UID())
+
+	if err := s.usecase.delete.Delete(ctx, req.GetPageId(), req.GetWebhookId()); err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Webhook delete succeeded webhook_id=%s user_uid=%s", req.GetWebhookId(), user.UID())
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+	mockdelete "github.com/naka-sei/tsudzuri/usecase/webhook/mock/mock_delete"
+)
+
+func TestDeleteService_Delete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.DeleteWebhookRequest
+	}
+	type want struct {
+		res *emptypb.Empty
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.DeleteWebhookRequest{PageId: "page-1", WebhookId: "wh-1"}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockdelete.MockDeleteUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockdelete.MockDeleteUsecase) {
+				m.EXPECT().Delete(gomock.Any(), "page-1", "wh-1").Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{res: &emptypb.Empty{}},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockdelete.MockDeleteUsecase) {
+				m.EXPECT().Delete(gomock.Any(), "page-1", "wh-1").Return(uwebhook.ErrWebhookNotFound)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: uwebhook.ErrWebhookNotFound},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockdelete.NewMockDeleteUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewDeleteService(usecase)
+			got, err := svc.Delete(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+)
+
+type DeliveryListService struct {
+	usecase struct {
+		deliveryList uwebhook.DeliveryListUsecase
+	}
+}
+
+func NewDeliveryListService(du uwebhook.DeliveryListUsecase) *DeliveryListService {
+	return &DeliveryListService{
+		usecase: struct{ deliveryList uwebhook.DeliveryListUsecase }{deliveryList: du},
+	}
+}
+
+func (s *DeliveryListService) List(ctx context.Context, req *tsudzuriv1.ListWebhookDeliveriesRequest) (*tsudzuriv1.ListWebhookDeliveriesResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/webhook.DeliveryList")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Webhook delivery list request page_id=%s webhook_id=%s user_uid=%s", req.GetPageId(), req.GetWebhookId(), user.UID())
+
+	deliveries, err := s.usecase.deliveryList.DeliveryList(ctx, req.GetPageId(), req.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListWebhookDeliveriesResponse{
+		Deliveries: toProtoDeliveries(deliveries),
+	}
+
+	logger.Sugar().Infof("Webhook delivery list responded: count=%d user_uid=%s", len(resp.GetDeliveries()), user.UID())
+	return resp, nil
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+	mockdeliverylist "github.com/naka-sei/tsudzuri/usecase/webhook/mock/mock_delivery_list"
+)
+
+func TestDeliveryListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListWebhookDeliveriesRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListWebhookDeliveriesResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.ListWebhookDeliveriesRequest{PageId: "page-1", WebhookId: "wh-1"}
+	deliveries := []*dwebhook.Delivery{
+		dwebhook.ReconstructDelivery("d-2", "wh-1", "evt-1", dwebhook.EventLinkAdded, 2, 200, "", 120*time.Millisecond, fixedNow),
+		dwebhook.ReconstructDelivery("d-1", "wh-1", "evt-1", dwebhook.EventLinkAdded, 1, 500, "unexpected status code 500", 80*time.Millisecond, fixedNow.Add(-2*time.Second)),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockdeliverylist.MockDeliveryListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockdeliverylist.MockDeliveryListUsecase) {
+				m.EXPECT().DeliveryList(gomock.Any(), "page-1", "wh-1").Return(deliveries, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.ListWebhookDeliveriesResponse{
+					Deliveries: []*tsudzuriv1.WebhookDelivery{
+						{
+							Id:         "d-2",
+							EventId:    "evt-1",
+							Event:      "link.added",
+							Attempt:    2,
+							StatusCode: 200,
+							Succeeded:  true,
+							DurationMs: 120,
+							CreatedAt:  timestamppb.New(fixedNow),
+						},
+						{
+							Id:         "d-1",
+							EventId:    "evt-1",
+							Event:      "link.added",
+							Attempt:    1,
+							StatusCode: 500,
+							Error:      "unexpected status code 500",
+							DurationMs: 80,
+							CreatedAt:  timestamppb.New(fixedNow.Add(-2 * time.Second)),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockdeliverylist.MockDeliveryListUsecase) {
+				m.EXPECT().DeliveryList(gomock.Any(), "page-1", "wh-1").Return(nil, uwebhook.ErrWebhookNotFound)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: uwebhook.ErrWebhookNotFound},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockdeliverylist.NewMockDeliveryListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewDeliveryListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+)
+
+type ListService struct {
+	usecase struct {
+		list uwebhook.ListUsecase
+	}
+}
+
+func NewListService(lu uwebhook.ListUsecase) *ListService {
+	return &ListService{
+		usecase: struct{ list uwebhook.ListUsecase }{list: lu},
+	}
+}
+
+func (s *ListService) List(ctx context.Context, req *tsudzuriv1.ListWebhooksRequest) (*tsudzuriv1.ListWebhooksResponse, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/webhook.List")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Webhook list request page_id=%s user_uid=%s", req.GetPageId(), user.UID())
+
+	webhooks, err := s.usecase.list.List(ctx, req.GetPageId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tsudzuriv1.ListWebhooksResponse{
+		Webhooks: toProtoWebhooks(webhooks),
+	}
+
+	logger.Sugar().Infof("Webhook list responded: count=%d user_uid=%s", len(resp.GetWebhooks()), user.UID())
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocklist "github.com/naka-sei/tsudzuri/usecase/webhook/mock/mock_list"
+)
+
+func TestListService_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.ListWebhooksRequest
+	}
+	type want struct {
+		res *tsudzuriv1.ListWebhooksResponse
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.ListWebhooksRequest{PageId: "page-1"}
+	disabledAt := fixedNow.Add(-time.Hour)
+	webhooks := []*dwebhook.Webhook{
+		dwebhook.ReconstructWebhook("wh-1", "page-1", "https://example.com/a", "whsec_a",
+			dwebhook.EventTypes(), "user-id", dwebhook.WithTimestamps(fixedNow, fixedNow)),
+		dwebhook.ReconstructWebhook("wh-2", "page-1", "https://example.com/b", "whsec_b",
+			[]dwebhook.EventType{dwebhook.EventPageDeleted}, "user-id",
+			dwebhook.WithFailures(dwebhook.MaxConsecutiveFailures, &disabledAt), dwebhook.WithTimestamps(fixedNow, fixedNow)),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocklist.MockListUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1").Return(webhooks, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.ListWebhooksResponse{
+					Webhooks: []*tsudzuriv1.Webhook{
+						{
+							Id:        "wh-1",
+							PageId:    "page-1",
+							Url:       "https://example.com/a",
+							Events:    []string{"link.added", "link.removed", "link.edited", "member.joined", "page.deleted"},
+							Active:    true,
+							CreatedAt: timestamppb.New(fixedNow),
+						},
+						{
+							Id:           "wh-2",
+							PageId:       "page-1",
+							Url:          "https://example.com/b",
+							Events:       []string{"page.deleted"},
+							FailureCount: dwebhook.MaxConsecutiveFailures,
+							DisabledAt:   timestamppb.New(disabledAt),
+							CreatedAt:    timestamppb.New(fixedNow),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mocklist.MockListUsecase) {
+				m.EXPECT().List(gomock.Any(), "page-1").Return(nil, dpage.ErrNotCreatedByUser)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mocklist.NewMockListUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewListService(usecase)
+			got, err := svc.List(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
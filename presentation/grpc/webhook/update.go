@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+)
+
+type UpdateService struct {
+	usecase struct {
+		update uwebhook.UpdateUsecase
+	}
+}
+
+func NewUpdateService(uu uwebhook.UpdateUsecase) *UpdateService {
+	return &UpdateService{
+		usecase: struct{ update uwebhook.UpdateUsecase }{update: uu},
+	}
+}
+
+func (s *UpdateService) Update(ctx context.Context, req *tsudzuriv1.UpdateWebhookRequest) (*tsudzuriv1.Webhook, error) {
+	ctx, end := trace.StartSpan(ctx, "presentation/grpc/webhook.Update")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	logger.Sugar().Infof("Webhook update request page_id=%s webhook_id=%s user_uid=%s", req.GetPageId(), req.GetWebhookId(), user.UID())
+
+	webhook, err := s.usecase.update.Update(ctx, uwebhook.UpdateUsecaseInput{
+		PageID:    req.GetPageId(),
+		WebhookID: req.GetWebhookId(),
+		URL:       req.GetUrl(),
+		Events:    toEventTypes(req.GetEvents()),
+		Active:    req.GetActive(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Sugar().Infof("Webhook update succeeded webhook_id=%s user_uid=%s", webhook.ID(), user.UID())
+	return toProtoWebhook(webhook), nil
+}
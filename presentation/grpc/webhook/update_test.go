@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tsudzuriv1 "github.com/naka-sei/tsudzuri/api/tsudzuri/v1"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	uwebhook "github.com/naka-sei/tsudzuri/usecase/webhook"
+	mockupdate "github.com/naka-sei/tsudzuri/usecase/webhook/mock/mock_update"
+)
+
+func TestUpdateService_Update(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tsudzuriv1.UpdateWebhookRequest
+	}
+	type want struct {
+		res *tsudzuriv1.Webhook
+		err error
+	}
+
+	user := duser.ReconstructUser("user-id", "uid-1", "google", nil)
+	req := &tsudzuriv1.UpdateWebhookRequest{
+		PageId:    "page-1",
+		WebhookId: "wh-1",
+		Url:       "https://example.com/new",
+		Events:    []string{"link.removed"},
+		Active:    true,
+	}
+	input := uwebhook.UpdateUsecaseInput{
+		PageID:    "page-1",
+		WebhookID: "wh-1",
+		URL:       "https://example.com/new",
+		Events:    []dwebhook.EventType{dwebhook.EventLinkRemoved},
+		Active:    true,
+	}
+	webhook := dwebhook.ReconstructWebhook("wh-1", "page-1", "https://example.com/new", "whsec_secret",
+		[]dwebhook.EventType{dwebhook.EventLinkRemoved}, "user-id", dwebhook.WithTimestamps(fixedNow, fixedNow))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockupdate.MockUpdateUsecase)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockupdate.MockUpdateUsecase) {
+				m.EXPECT().Update(gomock.Any(), input).Return(webhook, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{
+				res: &tsudzuriv1.Webhook{
+					Id:        "wh-1",
+					PageId:    "page-1",
+					Url:       "https://example.com/new",
+					Events:    []string{"link.removed"},
+					Active:    true,
+					CreatedAt: timestamppb.New(fixedNow),
+				},
+			},
+		},
+		{
+			name: "usecase_error",
+			setup: func(m *mockupdate.MockUpdateUsecase) {
+				m.EXPECT().Update(gomock.Any(), input).Return(nil, uwebhook.ErrWebhookNotFound)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), user),
+				req: req,
+			},
+			want: want{err: uwebhook.ErrWebhookNotFound},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockupdate.NewMockUpdateUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			svc := NewUpdateService(usecase)
+			got, err := svc.Update(tt.args.ctx, tt.args.req)
+			if diff := cmp.Diff(tt.want.res, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("response mismatch (-want +got):\n%s", diff)
+			}
+			testutil.EqualErr(t, tt.want.err, err)
+		})
+	}
+}
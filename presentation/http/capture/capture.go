@@ -0,0 +1,98 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+// Pattern is the route pattern of the CaptureLink gateway route the form handler is mounted on.
+const Pattern = "POST /api/v1/capture"
+
+const (
+	// maxFormSize is the maximum size of a form body. Captures carry a URL and a little text only.
+	maxFormSize = 64 << 10
+
+	formContentType      = "application/x-www-form-urlencoded"
+	multipartContentType = "multipart/form-data"
+)
+
+// request is the JSON body of the CaptureLink gateway route.
+type request struct {
+	URL   string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
+// FormHandler lets share sheets and bookmarklets post captures as HTML forms. Form bodies, URL encoded or
+// multipart, are rewritten to the JSON body of the CaptureLink route and passed to gateway together with
+// the original headers, so authentication is left to the gateway. Other bodies are passed through as is.
+func FormHandler(gateway http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctype, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if ctype != formContentType && ctype != multipartContentType {
+			gateway.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, end := trace.StartSpan(r.Context(), "presentation/http/capture.FormHandler")
+		defer end()
+
+		logger := log.LoggerFromContext(ctx)
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
+		var err error
+		if ctype == multipartContentType {
+			err = r.ParseMultipartForm(maxFormSize)
+		} else {
+			err = r.ParseForm()
+		}
+		if err != nil {
+			logger.Sugar().Warnf("Capture form parse failed: %v", err)
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		body, err := json.Marshal(fromForm(r.PostFormValue("url"), r.PostFormValue("title"), r.PostFormValue("text")))
+		if err != nil {
+			logger.Sugar().Errorf("Capture form encode failed: %v", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		forwarded := r.Clone(ctx)
+		forwarded.Body = io.NopCloser(bytes.NewReader(body))
+		forwarded.ContentLength = int64(len(body))
+		forwarded.Header.Set("Content-Type", "application/json")
+		forwarded.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		forwarded.Form, forwarded.PostForm, forwarded.MultipartForm = nil, nil, nil
+
+		gateway.ServeHTTP(w, forwarded)
+	})
+}
+
+// fromForm builds the capture from form values. Share targets on some platforms send the URL inside the
+// text field, so when url is empty the first http(s) URL in text is used and removed from the text.
+func fromForm(url string, title string, text string) request {
+	req := request{URL: strings.TrimSpace(url), Title: title, Text: text}
+	if req.URL != "" {
+		return req
+	}
+
+	fields := strings.Fields(text)
+	for i, f := range fields {
+		if strings.HasPrefix(f, "http://") || strings.HasPrefix(f, "https://") {
+			req.URL = f
+			req.Text = strings.Join(append(fields[:i:i], fields[i+1:]...), " ")
+			break
+		}
+	}
+	return req
+}
@@ -0,0 +1,125 @@
+package capture
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestFormHandler(t *testing.T) {
+	type want struct {
+		status        int
+		forwarded     bool
+		contentType   string
+		body          string
+		authorization string
+	}
+
+	multipartBody := func() (string, string) {
+		var b strings.Builder
+		mw := multipart.NewWriter(&b)
+		_ = mw.WriteField("title", "Example")
+		_ = mw.WriteField("text", "Look at this https://example.com/shared")
+		_ = mw.Close()
+		return b.String(), mw.FormDataContentType()
+	}
+	multipartPayload, multipartType := multipartBody()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        want
+	}{
+		{
+			name:        "url_encoded_form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"url": {" https://example.com "}, "title": {"Example"}, "text": {"selected"}}.Encode(),
+			want: want{
+				status:        http.StatusOK,
+				forwarded:     true,
+				contentType:   "application/json",
+				body:          `{"url":"https://example.com","title":"Example","text":"selected"}`,
+				authorization: "Bearer token",
+			},
+		},
+		{
+			name:        "multipart_form_with_url_in_text",
+			contentType: multipartType,
+			body:        multipartPayload,
+			want: want{
+				status:        http.StatusOK,
+				forwarded:     true,
+				contentType:   "application/json",
+				body:          `{"url":"https://example.com/shared","title":"Example","text":"Look at this"}`,
+				authorization: "Bearer token",
+			},
+		},
+		{
+			name:        "json_is_passed_through",
+			contentType: "application/json",
+			body:        `{"url":"https://example.com"}`,
+			want: want{
+				status:        http.StatusOK,
+				forwarded:     true,
+				contentType:   "application/json",
+				body:          `{"url":"https://example.com"}`,
+				authorization: "Bearer token",
+			},
+		},
+		{
+			name:        "form_too_large",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "text=" + strings.Repeat("a", maxFormSize),
+			want: want{
+				status: http.StatusBadRequest,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				forwarded                 bool
+				gotType, gotBody, gotAuth string
+			)
+			gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				forwarded = true
+				gotType = r.Header.Get("Content-Type")
+				gotAuth = r.Header.Get("Authorization")
+				b, _ := io.ReadAll(r.Body)
+				gotBody = string(b)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/capture", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set("Authorization", "Bearer token")
+			rec := httptest.NewRecorder()
+
+			FormHandler(gateway).ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want.status)
+			}
+			if forwarded != tt.want.forwarded {
+				t.Fatalf("forwarded = %v, want %v", forwarded, tt.want.forwarded)
+			}
+			if gotType != tt.want.contentType {
+				t.Errorf("content type = %q, want %q", gotType, tt.want.contentType)
+			}
+			if gotBody != tt.want.body {
+				t.Errorf("body = %s, want %s", gotBody, tt.want.body)
+			}
+			if gotAuth != tt.want.authorization {
+				t.Errorf("authorization = %q, want %q", gotAuth, tt.want.authorization)
+			}
+		})
+	}
+}
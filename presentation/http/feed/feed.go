@@ -0,0 +1,170 @@
+package feed
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+// Pattern is the route pattern the feed handler is mounted on.
+// The last segment is "{page_id}.atom" or "{page_id}.rss".
+const Pattern = "GET /feeds/pages/{file}"
+
+const (
+	atomExtension = ".atom"
+	rssExtension  = ".rss"
+)
+
+// AtomPath returns the path of the Atom feed of the page, including the secret token.
+func AtomPath(pageID string, token string) string {
+	return feedPath(pageID, atomExtension, token)
+}
+
+// RSSPath returns the path of the RSS feed of the page, including the secret token.
+func RSSPath(pageID string, token string) string {
+	return feedPath(pageID, rssExtension, token)
+}
+
+func feedPath(pageID string, ext string, token string) string {
+	return "/feeds/pages/" + url.PathEscape(pageID) + ext + "?token=" + url.QueryEscape(token)
+}
+
+type Handler struct {
+	usecase struct {
+		feed upage.FeedUsecase
+	}
+}
+
+func NewHandler(fu upage.FeedUsecase) *Handler {
+	return &Handler{
+		usecase: struct{ feed upage.FeedUsecase }{feed: fu},
+	}
+}
+
+// ServeHTTP renders the page as an Atom or RSS feed depending on the requested extension.
+// Unknown pages and invalid tokens are both reported as 404 so that page IDs cannot be probed.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, end := trace.StartSpan(r.Context(), "presentation/http/feed.ServeHTTP")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+
+	var (
+		file   = r.PathValue("file")
+		render func(*document) ([]byte, error)
+		ctype  string
+		pageID string
+	)
+	switch {
+	case strings.HasSuffix(file, atomExtension):
+		pageID, render, ctype = strings.TrimSuffix(file, atomExtension), renderAtom, "application/atom+xml; charset=utf-8"
+	case strings.HasSuffix(file, rssExtension):
+		pageID, render, ctype = strings.TrimSuffix(file, rssExtension), renderRSS, "application/rss+xml; charset=utf-8"
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	logger.Sugar().Infof("Page feed request page_id=%s format=%s", pageID, strings.TrimPrefix(file, pageID+"."))
+
+	page, err := h.usecase.feed.Feed(ctx, pageID, r.URL.Query().Get("token"))
+	if err != nil {
+		if errors.Is(err, upage.ErrPageNotFound) || errors.Is(err, dpage.ErrInvalidFeedToken) {
+			http.NotFound(w, r)
+			return
+		}
+		logger.Sugar().Errorf("failed to get page feed page_id=%s: %v", pageID, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := render(newDocument(page, baseURL(r), ctxtime.Now(ctx)))
+	if err != nil {
+		logger.Sugar().Errorf("failed to render page feed page_id=%s: %v", pageID, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ctype)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	if _, err := w.Write(body); err != nil {
+		logger.Sugar().Errorf("failed to write page feed page_id=%s: %v", pageID, err)
+		return
+	}
+	logger.Sugar().Infof("Page feed responded page_id=%s entries=%d", pageID, len(page.Links()))
+}
+
+// document is the format independent representation of a page feed.
+type document struct {
+	id      string
+	title   string
+	link    string
+	updated time.Time
+	entries []entry
+}
+
+type entry struct {
+	id        string
+	title     string
+	url       string
+	memo      string
+	createdAt time.Time
+}
+
+// newDocument builds the feed of the page with the most recently added links first.
+// The feed is considered updated when its newest link was added, or now if it has no links.
+func newDocument(p *dpage.Page, base string, now time.Time) *document {
+	links := slices.Clone(p.Links())
+	slices.SortStableFunc(links, func(a, b dpage.Link) int {
+		if c := b.CreatedAt().Compare(a.CreatedAt()); c != 0 {
+			return c
+		}
+		return a.Priority() - b.Priority()
+	})
+
+	doc := &document{
+		id:      p.ID(),
+		title:   p.Title(),
+		link:    base + "/",
+		updated: now,
+		entries: make([]entry, 0, len(links)),
+	}
+	if len(links) > 0 {
+		doc.updated = links[0].CreatedAt()
+	}
+	for _, l := range links {
+		title := l.Memo()
+		if title == "" {
+			title = l.URL()
+		}
+		doc.entries = append(doc.entries, entry{
+			id:        l.ID(),
+			title:     title,
+			url:       l.URL(),
+			memo:      l.Memo(),
+			createdAt: l.CreatedAt(),
+		})
+	}
+	return doc
+}
+
+// baseURL returns the scheme and host the request was made to, honoring reverse proxies.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host
+}
@@ -0,0 +1,203 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockfeed "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_feed"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	type want struct {
+		status      int
+		contentType string
+		body        string
+	}
+
+	creator := duser.ReconstructUser("user-id", "uid-1", "anonymous", nil)
+	page := dpage.ReconstructPage("0190c7e0-0000-7000-8000-000000000001", "Trip <2026>", *creator, "invite", dpage.Links{
+		dpage.ReconstructLink("https://example.com/old", "hotel & spa", 1,
+			dpage.WithLinkID("0190c7e0-0000-7000-8000-00000000000a"),
+			dpage.WithLinkCreatedAt(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC))),
+		dpage.ReconstructLink("https://example.com/new", "", 2,
+			dpage.WithLinkID("0190c7e0-0000-7000-8000-00000000000b"),
+			dpage.WithLinkCreatedAt(time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC))),
+	}, nil)
+
+	tests := []struct {
+		name  string
+		path  string
+		setup func(m *mockfeed.MockFeedUsecase)
+		want  want
+	}{
+		{
+			name: "atom",
+			path: "/feeds/pages/0190c7e0-0000-7000-8000-000000000001.atom?token=secret",
+			setup: func(m *mockfeed.MockFeedUsecase) {
+				m.EXPECT().Feed(gomock.Any(), "0190c7e0-0000-7000-8000-000000000001", "secret").Return(page, nil)
+			},
+			want: want{
+				status:      http.StatusOK,
+				contentType: "application/atom+xml; charset=utf-8",
+				body: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+					"<feed xmlns=\"http://www.w3.org/2005/Atom\">\n" +
+					"  <id>urn:uuid:0190c7e0-0000-7000-8000-000000000001</id>\n" +
+					"  <title>Trip &lt;2026&gt;</title>\n" +
+					"  <updated>2026-01-02T09:00:00Z</updated>\n" +
+					"  <author>\n" +
+					"    <name>tsudzuri</name>\n" +
+					"  </author>\n" +
+					"  <entry>\n" +
+					"    <id>urn:uuid:0190c7e0-0000-7000-8000-00000000000b</id>\n" +
+					"    <title>https://example.com/new</title>\n" +
+					"    <link href=\"https://example.com/new\"></link>\n" +
+					"    <published>2026-01-02T09:00:00Z</published>\n" +
+					"    <updated>2026-01-02T09:00:00Z</updated>\n" +
+					"  </entry>\n" +
+					"  <entry>\n" +
+					"    <id>urn:uuid:0190c7e0-0000-7000-8000-00000000000a</id>\n" +
+					"    <title>hotel &amp; spa</title>\n" +
+					"    <link href=\"https://example.com/old\"></link>\n" +
+					"    <published>2026-01-01T09:00:00Z</published>\n" +
+					"    <updated>2026-01-01T09:00:00Z</updated>\n" +
+					"    <summary>hotel &amp; spa</summary>\n" +
+					"  </entry>\n" +
+					"</feed>\n",
+			},
+		},
+		{
+			name: "rss",
+			path: "/feeds/pages/0190c7e0-0000-7000-8000-000000000001.rss?token=secret",
+			setup: func(m *mockfeed.MockFeedUsecase) {
+				m.EXPECT().Feed(gomock.Any(), "0190c7e0-0000-7000-8000-000000000001", "secret").Return(page, nil)
+			},
+			want: want{
+				status:      http.StatusOK,
+				contentType: "application/rss+xml; charset=utf-8",
+				body: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+					"<rss version=\"2.0\">\n" +
+					"  <channel>\n" +
+					"    <title>Trip &lt;2026&gt;</title>\n" +
+					"    <link>http://example.com/</link>\n" +
+					"    <description>Trip &lt;2026&gt;</description>\n" +
+					"    <lastBuildDate>Fri, 02 Jan 2026 09:00:00 +0000</lastBuildDate>\n" +
+					"    <item>\n" +
+					"      <title>https://example.com/new</title>\n" +
+					"      <link>https://example.com/new</link>\n" +
+					"      <guid isPermaLink=\"false\">0190c7e0-0000-7000-8000-00000000000b</guid>\n" +
+					"      <pubDate>Fri, 02 Jan 2026 09:00:00 +0000</pubDate>\n" +
+					"    </item>\n" +
+					"    <item>\n" +
+					"      <title>hotel &amp; spa</title>\n" +
+					"      <link>https://example.com/old</link>\n" +
+					"      <description>hotel &amp; spa</description>\n" +
+					"      <guid isPermaLink=\"false\">0190c7e0-0000-7000-8000-00000000000a</guid>\n" +
+					"      <pubDate>Thu, 01 Jan 2026 09:00:00 +0000</pubDate>\n" +
+					"    </item>\n" +
+					"  </channel>\n" +
+					"</rss>\n",
+			},
+		},
+		{
+			name: "invalid_token",
+			path: "/feeds/pages/page-1.atom?token=wrong",
+			setup: func(m *mockfeed.MockFeedUsecase) {
+				m.EXPECT().Feed(gomock.Any(), "page-1", "wrong").Return(nil, dpage.ErrInvalidFeedToken)
+			},
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "page_not_found",
+			path: "/feeds/pages/page-1.rss?token=secret",
+			setup: func(m *mockfeed.MockFeedUsecase) {
+				m.EXPECT().Feed(gomock.Any(), "page-1", "secret").Return(nil, upage.ErrPageNotFound)
+			},
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "unknown_extension",
+			path: "/feeds/pages/page-1.json?token=secret",
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "usecase_error",
+			path: "/feeds/pages/page-1.atom?token=secret",
+			setup: func(m *mockfeed.MockFeedUsecase) {
+				m.EXPECT().Feed(gomock.Any(), "page-1", "secret").Return(nil, errors.New("db error"))
+			},
+			want: want{status: http.StatusInternalServerError},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockfeed.NewMockFeedUsecase(ctrl)
+			if tt.setup != nil {
+				tt.setup(usecase)
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle(Pattern, NewHandler(usecase))
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want.status)
+			}
+			if tt.want.status != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.want.contentType {
+				t.Fatalf("Content-Type = %q, want %q", got, tt.want.contentType)
+			}
+			if diff := cmp.Diff(tt.want.body, rec.Body.String()); diff != "" {
+				t.Fatalf("body mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAtomPath(t *testing.T) {
+	if got, want := AtomPath("page-1", "a+b"), "/feeds/pages/page-1.atom?token=a%2Bb"; got != want {
+		t.Fatalf("AtomPath() = %q, want %q", got, want)
+	}
+	if got, want := RSSPath("page-1", "token"), "/feeds/pages/page-1.rss?token=token"; got != want {
+		t.Fatalf("RSSPath() = %q, want %q", got, want)
+	}
+}
+
+// TestHandler_ServeHTTP_malformedPageID runs the handler with the feed usecase, so that a page ID the database
+// cannot parse is shown to be answered like an unknown page.
+func TestHandler_ServeHTTP_malformedPageID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mux := http.NewServeMux()
+	mux.Handle(Pattern, NewHandler(upage.NewFeedUsecase(mockpage.NewMockPageRepository(ctrl))))
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/feeds/pages/foo.atom?token=secret", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
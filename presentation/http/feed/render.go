@@ -0,0 +1,117 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string   `xml:"id"`
+	Title     string   `xml:"title"`
+	Link      atomLink `xml:"link"`
+	Published string   `xml:"published"`
+	Updated   string   `xml:"updated"`
+	Summary   string   `xml:"summary,omitempty"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+func renderAtom(doc *document) ([]byte, error) {
+	out := atomFeed{
+		ID:      "urn:uuid:" + doc.id,
+		Title:   doc.title,
+		Updated: doc.updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: "tsudzuri"},
+		Entries: make([]atomEntry, 0, len(doc.entries)),
+	}
+	for _, e := range doc.entries {
+		ts := e.createdAt.UTC().Format(time.RFC3339)
+		out.Entries = append(out.Entries, atomEntry{
+			ID:        "urn:uuid:" + e.id,
+			Title:     e.title,
+			Link:      atomLink{Href: e.url},
+			Published: ts,
+			Updated:   ts,
+			Summary:   e.memo,
+		})
+	}
+	return encodeXML(out)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func renderRSS(doc *document) ([]byte, error) {
+	out := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         doc.title,
+			Link:          doc.link,
+			Description:   doc.title,
+			LastBuildDate: doc.updated.UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(doc.entries)),
+		},
+	}
+	for _, e := range doc.entries {
+		out.Channel.Items = append(out.Channel.Items, rssItem{
+			Title:       e.title,
+			Link:        e.url,
+			Description: e.memo,
+			GUID:        rssGUID{Value: e.id},
+			PubDate:     e.createdAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+	return encodeXML(out)
+}
+
+func encodeXML(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,58 @@
+package redirect
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	uclick "github.com/naka-sei/tsudzuri/usecase/click"
+)
+
+// Pattern is the route pattern the redirect handler is mounted on.
+const Pattern = "GET /r/{link_id}"
+
+// Path returns the path that counts a click on the link and redirects to it.
+func Path(linkID string) string {
+	return "/r/" + url.PathEscape(linkID)
+}
+
+type Handler struct {
+	usecase struct {
+		redirect uclick.RedirectUsecase
+	}
+}
+
+func NewHandler(ru uclick.RedirectUsecase) *Handler {
+	return &Handler{
+		usecase: struct{ redirect uclick.RedirectUsecase }{redirect: ru},
+	}
+}
+
+// ServeHTTP records a click on the link and redirects to its URL with 302 Found.
+// The response must not be cached so that every click reaches the server and is counted.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, end := trace.StartSpan(r.Context(), "presentation/http/redirect.ServeHTTP")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	linkID := r.PathValue("link_id")
+
+	logger.Sugar().Infof("Link redirect request link_id=%s", linkID)
+
+	target, err := h.usecase.redirect.Redirect(ctx, linkID, r.Referer())
+	if err != nil {
+		if errors.Is(err, uclick.ErrLinkNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		logger.Sugar().Errorf("failed to redirect link link_id=%s: %v", linkID, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, target, http.StatusFound)
+	logger.Sugar().Infof("Link redirect responded link_id=%s", linkID)
+}
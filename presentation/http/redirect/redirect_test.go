@@ -0,0 +1,89 @@
+package redirect
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	uclick "github.com/naka-sei/tsudzuri/usecase/click"
+	mockredirect "github.com/naka-sei/tsudzuri/usecase/click/mock/mock_redirect"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	type want struct {
+		status   int
+		location string
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		referrer string
+		setup    func(m *mockredirect.MockRedirectUsecase)
+		want     want
+	}{
+		{
+			name:     "redirects",
+			path:     Path("link-1"),
+			referrer: "https://tsudzuri.example.com/pages/page-1",
+			setup: func(m *mockredirect.MockRedirectUsecase) {
+				m.EXPECT().Redirect(gomock.Any(), "link-1", "https://tsudzuri.example.com/pages/page-1").Return("https://example.com/a?b=c", nil)
+			},
+			want: want{status: http.StatusFound, location: "https://example.com/a?b=c"},
+		},
+		{
+			name: "link_not_found",
+			path: Path("link-x"),
+			setup: func(m *mockredirect.MockRedirectUsecase) {
+				m.EXPECT().Redirect(gomock.Any(), "link-x", "").Return("", uclick.ErrLinkNotFound)
+			},
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "usecase_error",
+			path: Path("link-1"),
+			setup: func(m *mockredirect.MockRedirectUsecase) {
+				m.EXPECT().Redirect(gomock.Any(), "link-1", "").Return("", errors.New("get error"))
+			},
+			want: want{status: http.StatusInternalServerError},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockredirect.NewMockRedirectUsecase(ctrl)
+			tt.setup(usecase)
+
+			mux := http.NewServeMux()
+			mux.Handle(Pattern, NewHandler(usecase))
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			if tt.referrer != "" {
+				req.Header.Set("Referer", tt.referrer)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want.status)
+			}
+			if got := rec.Header().Get("Location"); got != tt.want.location {
+				t.Fatalf("Location = %q, want %q", got, tt.want.location)
+			}
+			if tt.want.status == http.StatusFound {
+				if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+					t.Fatalf("Cache-Control = %q, want no-store", got)
+				}
+			}
+		})
+	}
+}
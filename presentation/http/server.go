@@ -0,0 +1,48 @@
+package presentationhttp
+
+import (
+	"net/http"
+
+	"github.com/naka-sei/tsudzuri/presentation/http/capture"
+	"github.com/naka-sei/tsudzuri/presentation/http/feed"
+	"github.com/naka-sei/tsudzuri/presentation/http/redirect"
+	"github.com/naka-sei/tsudzuri/presentation/http/slug"
+)
+
+// Server serves the endpoints that are plain HTTP rather than gRPC-gateway routes.
+type Server struct {
+	feed     *feed.Handler
+	redirect *redirect.Handler
+	slug     *slug.Handler
+	// authenticate resolves the signed in user of the endpoints that work with and without one.
+	authenticate func(http.Handler) http.Handler
+}
+
+func NewServer(feedHandler *feed.Handler, redirectHandler *redirect.Handler, slugHandler *slug.Handler) *Server {
+	return &Server{
+		feed:         feedHandler,
+		redirect:     redirectHandler,
+		slug:         slugHandler,
+		authenticate: func(h http.Handler) http.Handler { return h },
+	}
+}
+
+// WithAuthentication sets the middleware that resolves the signed in user of the redirect and vanity URL endpoints.
+// Without it every click is anonymous and no vanity URL resolves.
+func (s *Server) WithAuthentication(authenticate func(http.Handler) http.Handler) {
+	if s == nil || authenticate == nil {
+		return
+	}
+	s.authenticate = authenticate
+}
+
+// Handler returns a handler that serves the HTTP endpoints and delegates every other request to gateway.
+func (s *Server) Handler(gateway http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(feed.Pattern, s.feed)
+	mux.Handle(capture.Pattern, capture.FormHandler(gateway))
+	mux.Handle(redirect.Pattern, s.authenticate(s.redirect))
+	mux.Handle(slug.Pattern, s.authenticate(s.slug))
+	mux.Handle("/", gateway)
+	return mux
+}
@@ -0,0 +1,71 @@
+package slug
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+// Pattern is the route pattern the vanity URL handler is mounted on.
+const Pattern = "GET /p/{slug}"
+
+// Path returns the vanity URL path of the slug.
+func Path(slug string) string {
+	return "/p/" + url.PathEscape(slug)
+}
+
+// PagePath returns the path of the page the vanity URL redirects to.
+func PagePath(pageID string) string {
+	return "/api/v1/pages/" + url.PathEscape(pageID)
+}
+
+type Handler struct {
+	usecase struct {
+		resolve upage.SlugResolveUsecase
+	}
+}
+
+func NewHandler(sru upage.SlugResolveUsecase) *Handler {
+	return &Handler{
+		usecase: struct{ resolve upage.SlugResolveUsecase }{resolve: sru},
+	}
+}
+
+// ServeHTTP resolves the current or a replaced slug and redirects to the page with 302 Found.
+// Only signed in members of the page are redirected. Other users get 404 Not Found so that the vanity URL does not
+// reveal whether the page exists.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, end := trace.StartSpan(r.Context(), "presentation/http/slug.ServeHTTP")
+	defer end()
+
+	logger := log.LoggerFromContext(ctx)
+	slug := r.PathValue("slug")
+
+	logger.Sugar().Infof("Vanity URL request slug=%s", slug)
+
+	page, err := h.usecase.resolve.Resolve(ctx, slug)
+	if err != nil {
+		switch {
+		case errors.Is(err, duser.ErrUserNotFound):
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		case errors.Is(err, upage.ErrPageNotFound),
+			errors.Is(err, dpage.ErrNotCreatedByUser),
+			errors.Is(err, dpage.ErrNoUserProvided):
+			http.NotFound(w, r)
+		default:
+			logger.Sugar().Errorf("failed to resolve slug slug=%s: %v", slug, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.Redirect(w, r, PagePath(page.ID()), http.StatusFound)
+	logger.Sugar().Infof("Vanity URL responded slug=%s page_id=%s", slug, page.ID())
+}
@@ -0,0 +1,108 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mockslugresolveusecase "github.com/naka-sei/tsudzuri/usecase/page/mock/mock_slug_resolve"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	creator := duser.ReconstructUser("creator-id", "uid-c", "google", nil)
+	page := dpage.ReconstructPage("page-1", "Trip", *creator, "invite-code", dpage.Links{}, duser.Users{}, dpage.WithSlug("trip-2026"))
+
+	type want struct {
+		status   int
+		location string
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		setup func(m *mockslugresolveusecase.MockSlugResolveUsecase)
+		want  want
+	}{
+		{
+			name: "current_slug",
+			path: Path("trip-2026"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(page, nil)
+			},
+			want: want{status: http.StatusFound, location: "/api/v1/pages/page-1"},
+		},
+		{
+			name: "replaced_slug",
+			path: Path("trip-2025"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2025").Return(page, nil)
+			},
+			want: want{status: http.StatusFound, location: "/api/v1/pages/page-1"},
+		},
+		{
+			name: "unknown_slug",
+			path: Path("unknown"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "unknown").Return(nil, upage.ErrPageNotFound)
+			},
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "not_member",
+			path: Path("trip-2026"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(nil, dpage.ErrNotCreatedByUser)
+			},
+			want: want{status: http.StatusNotFound},
+		},
+		{
+			name: "signed_out",
+			path: Path("trip-2026"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(nil, duser.ErrUserNotFound)
+			},
+			want: want{status: http.StatusUnauthorized},
+		},
+		{
+			name: "usecase_error",
+			path: Path("trip-2026"),
+			setup: func(m *mockslugresolveusecase.MockSlugResolveUsecase) {
+				m.EXPECT().Resolve(gomock.Any(), "trip-2026").Return(nil, errors.New("resolve error"))
+			},
+			want: want{status: http.StatusInternalServerError},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usecase := mockslugresolveusecase.NewMockSlugResolveUsecase(ctrl)
+			tt.setup(usecase)
+
+			mux := http.NewServeMux()
+			mux.Handle(Pattern, NewHandler(usecase))
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want.status)
+			}
+			if got := rec.Header().Get("Location"); got != tt.want.location {
+				t.Fatalf("Location = %q, want %q", got, tt.want.location)
+			}
+		})
+	}
+}
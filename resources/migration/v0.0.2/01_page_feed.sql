@@ -0,0 +1,5 @@
+-- Page フィードトークン (tsudzuri.pages.feed_token_hash)
+ALTER TABLE tsudzuri.pages
+	ADD COLUMN IF NOT EXISTS feed_token_hash VARCHAR(64) UNIQUE;
+
+COMMENT ON COLUMN tsudzuri.pages.feed_token_hash IS 'RSS/Atom フィード閲覧用シークレットトークンの SHA-256 ハッシュ。NULL の場合フィードは無効';
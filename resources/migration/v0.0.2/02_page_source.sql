@@ -0,0 +1,7 @@
+-- Page 複製元 (tsudzuri.pages.source_page_id)
+ALTER TABLE tsudzuri.pages
+	ADD COLUMN IF NOT EXISTS source_page_id UUID;
+
+COMMENT ON COLUMN tsudzuri.pages.source_page_id IS '複製元の綴りのID。複製で作成された場合のみ設定され、複製元が削除されても保持する';
+
+CREATE INDEX IF NOT EXISTS idx_pages_source_page ON tsudzuri.pages (source_page_id);
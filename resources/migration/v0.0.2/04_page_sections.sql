@@ -0,0 +1,34 @@
+-- Page sections (綴り内のセクション) テーブル (tsudzuri.page_sections)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.page_sections (
+		id UUID PRIMARY KEY,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		name VARCHAR(50) NOT NULL,
+		priority INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.page_sections IS '綴り内のリンクをグループ化するセクションを管理するテーブル';
+
+COMMENT ON COLUMN tsudzuri.page_sections.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.page_sections.page_id IS '所属する綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.page_sections.name IS 'セクション名（最大50文字）';
+
+COMMENT ON COLUMN tsudzuri.page_sections.priority IS 'セクションの表示順序（同一page_id内での並び順）';
+
+COMMENT ON COLUMN tsudzuri.page_sections.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.page_sections.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_page_sections_page_priority ON tsudzuri.page_sections (page_id, priority);
+
+-- Link items のセクション (tsudzuri.link_items.section_id)
+ALTER TABLE tsudzuri.link_items
+	ADD COLUMN IF NOT EXISTS section_id UUID REFERENCES tsudzuri.page_sections (id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN tsudzuri.link_items.section_id IS '所属するセクションのID。NULL の場合はセクションに属さない';
+
+CREATE INDEX IF NOT EXISTS idx_link_items_section ON tsudzuri.link_items (section_id);
@@ -0,0 +1,37 @@
+-- Link comments (リンクへのコメント) テーブル (tsudzuri.link_comments)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.link_comments (
+		id UUID PRIMARY KEY,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		link_item_id UUID NOT NULL REFERENCES tsudzuri.link_items (id) ON DELETE CASCADE,
+		parent_id UUID REFERENCES tsudzuri.link_comments (id) ON DELETE CASCADE,
+		author_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+		body TEXT NOT NULL,
+		deleted_at TIMESTAMPTZ,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.link_comments IS '綴り内のリンクに付けられたコメントを管理するテーブル';
+
+COMMENT ON COLUMN tsudzuri.link_comments.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.link_comments.page_id IS 'コメント対象のリンクが所属する綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.link_comments.link_item_id IS 'コメント対象のリンクのID';
+
+COMMENT ON COLUMN tsudzuri.link_comments.parent_id IS 'スレッドの最初のコメントのID。NULL の場合はスレッドの最初のコメント';
+
+COMMENT ON COLUMN tsudzuri.link_comments.author_id IS 'コメントを書いたユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.link_comments.body IS 'コメント本文（最大1000文字）。削除済みの場合は空文字';
+
+COMMENT ON COLUMN tsudzuri.link_comments.deleted_at IS 'コメントの削除日時。返信を残すため論理削除とする';
+
+COMMENT ON COLUMN tsudzuri.link_comments.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.link_comments.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_link_comments_link_item ON tsudzuri.link_comments (link_item_id);
+
+CREATE INDEX IF NOT EXISTS idx_link_comments_parent ON tsudzuri.link_comments (parent_id);
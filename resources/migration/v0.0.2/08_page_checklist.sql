@@ -0,0 +1,14 @@
+-- Page チェックリストモード (tsudzuri.pages.checklist)
+ALTER TABLE tsudzuri.pages
+	ADD COLUMN IF NOT EXISTS checklist BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN tsudzuri.pages.checklist IS 'チェックリストモードかどうか。有効な場合、リンクごとにメンバー共通の完了フラグを持つ';
+
+-- Link items の完了フラグ (tsudzuri.link_items.done_by_id, tsudzuri.link_items.done_at)
+ALTER TABLE tsudzuri.link_items
+	ADD COLUMN IF NOT EXISTS done_by_id UUID REFERENCES tsudzuri.users (id) ON DELETE SET NULL,
+	ADD COLUMN IF NOT EXISTS done_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN tsudzuri.link_items.done_by_id IS '完了にしたユーザーのID。ユーザーが削除された場合は NULL になる';
+
+COMMENT ON COLUMN tsudzuri.link_items.done_at IS '完了にした日時。NULL の場合は未完了';
@@ -0,0 +1,11 @@
+-- Page 詳細情報 (tsudzuri.pages.description / icon / color)
+ALTER TABLE tsudzuri.pages
+	ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS icon VARCHAR(64) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS color VARCHAR(7) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN tsudzuri.pages.description IS '綴りの説明（Markdown、最大2000文字）。表示時にサニタイズするため、ここには HTML を保存しない';
+
+COMMENT ON COLUMN tsudzuri.pages.icon IS '綴りのアイコン（絵文字1つ）。空文字の場合はアイコンなし';
+
+COMMENT ON COLUMN tsudzuri.pages.color IS '綴りのアクセントカラー（#rrggbb 形式）。空文字の場合は既定の色';
@@ -0,0 +1,59 @@
+-- Notifications (綴りの更新の通知) テーブル (tsudzuri.notifications)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.notifications (
+		id UUID PRIMARY KEY,
+		recipient_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		actor_id UUID NOT NULL,
+		kind VARCHAR(50) NOT NULL,
+		count INTEGER NOT NULL DEFAULT 1,
+		read_at TIMESTAMPTZ,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.notifications IS 'ユーザーごとの通知の受信箱。綴りのメンバーの参加やリンクの追加などを、操作したユーザー以外のメンバーに記録する';
+
+COMMENT ON COLUMN tsudzuri.notifications.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.notifications.recipient_id IS '通知を受け取るユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.notifications.page_id IS '更新があった綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.notifications.actor_id IS '操作したユーザーのID。ユーザーの削除後も通知を残すため外部キーにしない';
+
+COMMENT ON COLUMN tsudzuri.notifications.kind IS '通知の種類 (member_joined: メンバーの参加, links_added: リンクの追加, page_archived: 綴りのアーカイブ)';
+
+COMMENT ON COLUMN tsudzuri.notifications.count IS '通知の対象となった件数（追加されたリンクの数など）';
+
+COMMENT ON COLUMN tsudzuri.notifications.read_at IS '既読にした日時。未読の場合は NULL';
+
+COMMENT ON COLUMN tsudzuri.notifications.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.notifications.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_read ON tsudzuri.notifications (recipient_id, read_at);
+
+-- Notification preferences (ユーザーごとの通知設定) テーブル (tsudzuri.notification_preferences)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.notification_preferences (
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+		kind VARCHAR(50) NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.notification_preferences IS 'ユーザーが受け取らないように設定した通知の種類を管理するテーブル。行がない種類は通知を受け取る';
+
+COMMENT ON COLUMN tsudzuri.notification_preferences.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.notification_preferences.user_id IS '設定を持つユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.notification_preferences.kind IS '受け取らない通知の種類';
+
+COMMENT ON COLUMN tsudzuri.notification_preferences.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.notification_preferences.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_preferences_user_kind ON tsudzuri.notification_preferences (user_id, kind);
@@ -0,0 +1,28 @@
+-- Devices (プッシュ通知を受け取る端末) テーブル (tsudzuri.devices)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.devices (
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+		token VARCHAR(4096) NOT NULL,
+		platform VARCHAR(20) NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.devices IS 'ユーザーがプッシュ通知を受け取る端末を管理するテーブル。無効になったトークンは配信時に削除される';
+
+COMMENT ON COLUMN tsudzuri.devices.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.devices.user_id IS '端末を登録したユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.devices.token IS 'プッシュ通知サービス (FCM) が発行した端末のトークン';
+
+COMMENT ON COLUMN tsudzuri.devices.platform IS '端末の種類 (ios, android, web)';
+
+COMMENT ON COLUMN tsudzuri.devices.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.devices.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_devices_token ON tsudzuri.devices (token);
+
+CREATE INDEX IF NOT EXISTS idx_devices_user_id ON tsudzuri.devices (user_id);
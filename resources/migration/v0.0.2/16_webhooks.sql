@@ -0,0 +1,77 @@
+-- Webhooks (綴りの更新を外部に送信する Webhook) テーブル (tsudzuri.webhooks)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.webhooks (
+		id UUID PRIMARY KEY,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		created_by_id UUID NOT NULL,
+		url VARCHAR(2048) NOT NULL,
+		secret VARCHAR(255) NOT NULL,
+		events JSONB NOT NULL DEFAULT '[]',
+		failure_count INTEGER NOT NULL DEFAULT 0,
+		disabled_at TIMESTAMPTZ,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.webhooks IS '綴り（page）の更新を署名付きの JSON で外部の URL に送信する Webhook を管理するテーブル。綴りの作成者のみが管理できる';
+
+COMMENT ON COLUMN tsudzuri.webhooks.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.webhooks.page_id IS 'Webhook を設定した綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.webhooks.created_by_id IS 'Webhook を作成したユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.webhooks.url IS '送信先の URL (http または https)';
+
+COMMENT ON COLUMN tsudzuri.webhooks.secret IS '送信内容の HMAC-SHA256 署名に使う秘密鍵';
+
+COMMENT ON COLUMN tsudzuri.webhooks.events IS '送信するイベントの種類の配列。空の場合はすべてのイベントを送信する';
+
+COMMENT ON COLUMN tsudzuri.webhooks.failure_count IS '連続して失敗した送信の回数。成功すると 0 に戻る';
+
+COMMENT ON COLUMN tsudzuri.webhooks.disabled_at IS '無効にした日時。連続して失敗すると自動で無効になる。有効な場合は NULL';
+
+COMMENT ON COLUMN tsudzuri.webhooks.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.webhooks.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_page_id ON tsudzuri.webhooks (page_id);
+
+-- Webhook deliveries (Webhook の送信履歴) テーブル (tsudzuri.webhook_deliveries)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.webhook_deliveries (
+		id UUID PRIMARY KEY,
+		webhook_id UUID NOT NULL REFERENCES tsudzuri.webhooks (id) ON DELETE CASCADE,
+		event_id VARCHAR(255) NOT NULL,
+		event_type VARCHAR(50) NOT NULL,
+		attempt INTEGER NOT NULL,
+		status_code INTEGER NOT NULL DEFAULT 0,
+		error TEXT NOT NULL DEFAULT '',
+		duration_ms BIGINT NOT NULL DEFAULT 0,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.webhook_deliveries IS 'Webhook への送信の試行履歴。Webhook ごとに直近のものだけを残す';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.webhook_id IS '送信先の Webhook のID';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.event_id IS 'イベントのID。同じイベントの再送では同じ値になる';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.event_type IS 'イベントの種類 (link.added, link.removed, link.edited, member.joined, page.deleted)';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.attempt IS '何回目の試行か（1 始まり）';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.status_code IS '応答の HTTP ステータスコード。応答がなかった場合は 0';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.error IS '失敗した理由。成功した場合は空文字';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.duration_ms IS '送信にかかった時間（ミリ秒）';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.webhook_deliveries.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id_created_at ON tsudzuri.webhook_deliveries (webhook_id, created_at);
@@ -0,0 +1,7 @@
+-- Page 受信箱 (tsudzuri.page_preferences.inbox)
+ALTER TABLE tsudzuri.page_preferences
+	ADD COLUMN IF NOT EXISTS inbox BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN tsudzuri.page_preferences.inbox IS 'リンクの取り込み先（受信箱）の綴りかどうか。ユーザーごとに最大1件';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_page_preferences_user_inbox ON tsudzuri.page_preferences (user_id) WHERE inbox;
@@ -0,0 +1,35 @@
+-- Link snapshots (リンク先ページのアーカイブ) テーブル (tsudzuri.link_snapshots)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.link_snapshots (
+		id UUID PRIMARY KEY,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		link_item_id UUID NOT NULL REFERENCES tsudzuri.link_items (id) ON DELETE CASCADE,
+		url TEXT NOT NULL,
+		storage_key TEXT NOT NULL,
+		size BIGINT NOT NULL DEFAULT 0,
+		created_by_id UUID REFERENCES tsudzuri.users (id) ON DELETE SET NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+	);
+
+COMMENT ON TABLE tsudzuri.link_snapshots IS '綴り内のリンク先ページを保存したアーカイブを管理するテーブル。本文は Blob ストレージに保存する';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.page_id IS 'アーカイブ対象のリンクが所属する綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.link_item_id IS 'アーカイブ対象のリンクのID';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.url IS 'アーカイブ取得時のリンクのURL';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.storage_key IS 'Blob ストレージ上の保存先キー。スクリプトを除去したHTMLを保存する';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.size IS '保存したHTMLのサイズ（バイト）';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.created_by_id IS 'アーカイブを依頼したユーザーのID。ユーザーが削除された場合は NULL になる';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.created_at IS 'レコード作成日時（アーカイブ取得日時）';
+
+COMMENT ON COLUMN tsudzuri.link_snapshots.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_link_snapshots_link_item ON tsudzuri.link_snapshots (link_item_id);
@@ -0,0 +1,26 @@
+-- Link clicks (リンクのクリック履歴) テーブル (tsudzuri.link_clicks)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.link_clicks (
+		id UUID PRIMARY KEY,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		link_item_id UUID NOT NULL REFERENCES tsudzuri.link_items (id) ON DELETE CASCADE,
+		user_id UUID REFERENCES tsudzuri.users (id) ON DELETE SET NULL,
+		referrer TEXT NOT NULL DEFAULT '',
+		clicked_at TIMESTAMPTZ NOT NULL
+	);
+
+COMMENT ON TABLE tsudzuri.link_clicks IS 'リダイレクト用エンドポイント経由のリンクのクリックを記録するテーブル。追記のみで更新はしない';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.page_id IS 'クリックされたリンクが所属する綴り（page）のID。集計用に保持する';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.link_item_id IS 'クリックされたリンクのID';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.user_id IS 'クリックしたユーザーのID。未ログインの場合やユーザーが削除された場合は NULL';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.referrer IS 'クリック時の Referer ヘッダー。無い場合は空文字';
+
+COMMENT ON COLUMN tsudzuri.link_clicks.clicked_at IS 'クリック日時';
+
+CREATE INDEX IF NOT EXISTS idx_link_clicks_page_clicked_at ON tsudzuri.link_clicks (page_id, clicked_at);
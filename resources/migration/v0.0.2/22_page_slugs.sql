@@ -0,0 +1,46 @@
+-- Page スラッグ (tsudzuri.pages.slug)
+ALTER TABLE tsudzuri.pages
+	ADD COLUMN IF NOT EXISTS slug VARCHAR(30) UNIQUE;
+
+COMMENT ON COLUMN tsudzuri.pages.slug IS 'URL で ID の代わりに使う現在のスラッグ。過去のスラッグは page_slugs に保持する';
+
+-- Page slugs (綴りのスラッグ履歴) テーブル (tsudzuri.page_slugs)
+CREATE TABLE
+	IF NOT EXISTS tsudzuri.page_slugs (
+		id UUID PRIMARY KEY,
+		slug VARCHAR(30) NOT NULL UNIQUE,
+		page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+		created_at TIMESTAMPTZ NOT NULL
+	);
+
+COMMENT ON TABLE tsudzuri.page_slugs IS '綴り（page）が過去に使ったものを含む全てのスラッグを保持するテーブル。スラッグ変更後も古いスラッグで綴りを引けるようにする';
+
+COMMENT ON COLUMN tsudzuri.page_slugs.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.page_slugs.slug IS 'スラッグ。一度使われたスラッグは他の綴りでは使えない';
+
+COMMENT ON COLUMN tsudzuri.page_slugs.page_id IS 'スラッグを使う綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.page_slugs.created_at IS 'スラッグが設定された日時';
+
+CREATE INDEX IF NOT EXISTS idx_page_slugs_page ON tsudzuri.page_slugs (page_id);
+
+-- 既存の綴りには ID の末尾からスラッグを割り当てる
+UPDATE tsudzuri.pages
+SET
+	slug = right(replace(id::text, '-', ''), 10)
+WHERE
+	slug IS NULL;
+
+INSERT INTO
+	tsudzuri.page_slugs (id, slug, page_id, created_at)
+SELECT
+	gen_random_uuid(),
+	slug,
+	id,
+	now()
+FROM
+	tsudzuri.pages
+WHERE
+	slug IS NOT NULL
+ON CONFLICT (slug) DO NOTHING;
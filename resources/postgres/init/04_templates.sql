@@ -0,0 +1,72 @@
+-- Templates (綴りのテンプレート) テーブル (tsudzuri.templates)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.templates (
+        id UUID PRIMARY KEY,
+        title VARCHAR(50) NOT NULL,
+        creator_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        visibility VARCHAR(20) NOT NULL DEFAULT 'private',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.templates IS '綴りを新規作成する際のひな形を管理するテーブル。綴り本体とは別に保存する';
+
+COMMENT ON COLUMN tsudzuri.templates.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.templates.title IS 'テンプレートのタイトル（最大50文字）';
+
+COMMENT ON COLUMN tsudzuri.templates.creator_id IS '作成者のユーザーID';
+
+COMMENT ON COLUMN tsudzuri.templates.visibility IS '公開範囲（private: 作成者のみ, shared: 保存元の綴りのメンバーと共有）';
+
+COMMENT ON COLUMN tsudzuri.templates.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.templates.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_templates_creator ON tsudzuri.templates (creator_id);
+
+-- Template links テーブル (tsudzuri.template_links)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.template_links (
+        id UUID PRIMARY KEY,
+        template_id UUID NOT NULL REFERENCES tsudzuri.templates (id) ON DELETE CASCADE,
+        url TEXT NOT NULL,
+        memo TEXT,
+        priority INTEGER NOT NULL DEFAULT 0,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.template_links IS 'テンプレートに含まれるリンクとそのメタデータを管理するテーブル';
+
+COMMENT ON COLUMN tsudzuri.template_links.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.template_links.template_id IS '所属するテンプレートのID';
+
+COMMENT ON COLUMN tsudzuri.template_links.url IS 'リンクのURL';
+
+COMMENT ON COLUMN tsudzuri.template_links.memo IS 'ユーザーが付けたメモ';
+
+COMMENT ON COLUMN tsudzuri.template_links.priority IS 'リンクの表示順序（同一template_id内での並び順）';
+
+COMMENT ON COLUMN tsudzuri.template_links.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.template_links.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_template_links_template_priority ON tsudzuri.template_links (template_id, priority);
+
+-- Template Users (共有テンプレートの利用者) テーブル (tsudzuri.template_users)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.template_users (
+        template_id UUID NOT NULL REFERENCES tsudzuri.templates (id) ON DELETE CASCADE,
+        user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        PRIMARY KEY (template_id, user_id)
+    );
+
+COMMENT ON TABLE tsudzuri.template_users IS '共有テンプレートを利用できるユーザーを管理する中間テーブル。保存時点の綴りのメンバーを記録する';
+
+COMMENT ON COLUMN tsudzuri.template_users.template_id IS '関連するテンプレートのID';
+
+COMMENT ON COLUMN tsudzuri.template_users.user_id IS '関連するユーザーのID';
+
+CREATE INDEX IF NOT EXISTS idx_template_users_user ON tsudzuri.template_users (user_id);
@@ -0,0 +1,26 @@
+-- Link reactions (リンクへのリアクション) テーブル (tsudzuri.link_reactions)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.link_reactions (
+        id UUID PRIMARY KEY,
+        link_item_id UUID NOT NULL REFERENCES tsudzuri.link_items (id) ON DELETE CASCADE,
+        user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        emoji VARCHAR(255) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.link_reactions IS '綴り内のリンクに対するメンバーのリアクション（投票）を管理するテーブル。1ユーザー1リンクにつき1行';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.link_item_id IS 'リアクション対象のリンクのID';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.user_id IS 'リアクションしたユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.emoji IS 'リアクションの絵文字。単純な投票の場合は ''+1''';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.link_reactions.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_link_reactions_link_item_user ON tsudzuri.link_reactions (link_item_id, user_id);
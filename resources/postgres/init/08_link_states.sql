@@ -0,0 +1,28 @@
+-- Link states (リンクの既読・完了状態) テーブル (tsudzuri.link_states)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.link_states (
+        id UUID PRIMARY KEY,
+        link_item_id UUID NOT NULL REFERENCES tsudzuri.link_items (id) ON DELETE CASCADE,
+        user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        status VARCHAR(20) NOT NULL DEFAULT 'unread',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.link_states IS '綴り内のリンクに対するメンバーごとの既読・完了状態を管理するテーブル。行がない場合は未読として扱う';
+
+COMMENT ON COLUMN tsudzuri.link_states.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.link_states.link_item_id IS '対象のリンクのID';
+
+COMMENT ON COLUMN tsudzuri.link_states.user_id IS '状態を持つユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.link_states.status IS '状態（unread: 未読, read: 既読, done: 完了）';
+
+COMMENT ON COLUMN tsudzuri.link_states.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.link_states.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_link_states_link_item_user ON tsudzuri.link_states (link_item_id, user_id);
+
+CREATE INDEX IF NOT EXISTS idx_link_states_user ON tsudzuri.link_states (user_id);
@@ -0,0 +1,5 @@
+-- Page アーカイブ (tsudzuri.pages.archived)
+ALTER TABLE tsudzuri.pages
+    ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN tsudzuri.pages.archived IS 'アーカイブ済みかどうか。アーカイブされた綴りは読み取り専用になり、一覧には既定で表示しない';
@@ -0,0 +1,31 @@
+-- Page preferences (ユーザーごとの綴りの設定) テーブル (tsudzuri.page_preferences)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.page_preferences (
+        id UUID PRIMARY KEY,
+        user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+        pinned BOOLEAN NOT NULL DEFAULT FALSE,
+        position INTEGER NOT NULL DEFAULT 0,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.page_preferences IS 'ユーザーごとの綴りのピン留めや並び順を管理するテーブル。page_users とは別に保持し、行がない場合は既定の設定として扱う';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.user_id IS '設定を持つユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.page_id IS '対象の綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.pinned IS 'ピン留めしているかどうか';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.position IS 'ユーザー独自の並び順（1から始まる）。0 の場合は並び順を指定しない';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.page_preferences.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_page_preferences_user_page ON tsudzuri.page_preferences (user_id, page_id);
+
+CREATE INDEX IF NOT EXISTS idx_page_preferences_page ON tsudzuri.page_preferences (page_id);
@@ -0,0 +1,11 @@
+-- ユーザープロフィール (tsudzuri.users.display_name / avatar_url / locale)
+ALTER TABLE tsudzuri.users
+    ADD COLUMN IF NOT EXISTS display_name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS avatar_url VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN tsudzuri.users.display_name IS '表示名（最大50文字）。他のメンバーに公開される。初回ログイン時に認証プロバイダの name クレームから設定';
+
+COMMENT ON COLUMN tsudzuri.users.avatar_url IS 'アバター画像の URL（http/https）。初回ログイン時に認証プロバイダの picture クレームから設定';
+
+COMMENT ON COLUMN tsudzuri.users.locale IS '言語設定（BCP 47 形式、例: ja-JP）。空文字の場合は未設定';
@@ -0,0 +1,38 @@
+-- Invitations (メールによる綴りへの招待) テーブル (tsudzuri.invitations)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.invitations (
+        id UUID PRIMARY KEY,
+        page_id UUID NOT NULL REFERENCES tsudzuri.pages (id) ON DELETE CASCADE,
+        invited_by_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        email VARCHAR(254) NOT NULL,
+        token_hash VARCHAR(64) NOT NULL UNIQUE,
+        status VARCHAR(20) NOT NULL DEFAULT 'pending',
+        accepted_by_id UUID REFERENCES tsudzuri.users (id) ON DELETE SET NULL,
+        expires_at TIMESTAMPTZ NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.invitations IS 'メールアドレス宛ての綴りへの招待を管理するテーブル。招待リンクのトークンは一度だけ使用でき、ハッシュのみを保存する';
+
+COMMENT ON COLUMN tsudzuri.invitations.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.invitations.page_id IS '招待先の綴り（page）のID';
+
+COMMENT ON COLUMN tsudzuri.invitations.invited_by_id IS '招待を送ったユーザー（綴りの作成者）のID';
+
+COMMENT ON COLUMN tsudzuri.invitations.email IS '招待したメールアドレス（小文字に正規化）';
+
+COMMENT ON COLUMN tsudzuri.invitations.token_hash IS '招待トークンの SHA-256 ハッシュ（16進数）';
+
+COMMENT ON COLUMN tsudzuri.invitations.status IS '招待の状態 (pending: 未承諾, accepted: 承諾済み, revoked: 取り消し済み)。期限切れは expires_at から判定し保存しない';
+
+COMMENT ON COLUMN tsudzuri.invitations.accepted_by_id IS '招待を承諾したユーザーのID';
+
+COMMENT ON COLUMN tsudzuri.invitations.expires_at IS '招待の有効期限';
+
+COMMENT ON COLUMN tsudzuri.invitations.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.invitations.updated_at IS 'レコード更新日時';
+
+CREATE INDEX IF NOT EXISTS idx_invitations_page ON tsudzuri.invitations (page_id);
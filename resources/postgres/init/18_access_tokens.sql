@@ -0,0 +1,46 @@
+-- Access tokens (スクリプトや連携用の個人アクセストークン) テーブル (tsudzuri.access_tokens)
+CREATE TABLE
+    IF NOT EXISTS tsudzuri.access_tokens (
+        id UUID PRIMARY KEY,
+        user_id UUID NOT NULL REFERENCES tsudzuri.users (id) ON DELETE CASCADE,
+        name VARCHAR(100) NOT NULL,
+        token_hash VARCHAR(255) NOT NULL,
+        hint VARCHAR(32) NOT NULL,
+        scopes JSONB NOT NULL DEFAULT '[]',
+        page_ids JSONB NOT NULL DEFAULT '[]',
+        expires_at TIMESTAMPTZ,
+        last_used_at TIMESTAMPTZ,
+        revoked_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+COMMENT ON TABLE tsudzuri.access_tokens IS 'ユーザーがスクリプトや外部連携のために発行する個人アクセストークンを管理するテーブル。トークンはハッシュのみを保存する';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.id IS 'プライマリーキー';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.user_id IS 'トークンを発行したユーザーのID。トークンはこのユーザーとして認証される';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.name IS 'トークンを見分けるためにユーザーが付けた名前';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.token_hash IS 'トークンの SHA-256 ハッシュ';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.hint IS 'トークンを見分けるための先頭の数文字';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.scopes IS '許可する操作 (read, write) の配列';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.page_ids IS '操作を許可する綴り（page）のIDの配列。空の場合はユーザーのすべての綴りを許可する';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.expires_at IS 'トークンの有効期限。NULL の場合は失効するまで有効';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.last_used_at IS 'トークンが最後に使われた日時';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.revoked_at IS 'トークンが失効された日時';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.created_at IS 'レコード作成日時';
+
+COMMENT ON COLUMN tsudzuri.access_tokens.updated_at IS 'レコード更新日時';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_access_tokens_token_hash ON tsudzuri.access_tokens (token_hash);
+
+CREATE INDEX IF NOT EXISTS idx_access_tokens_user_id ON tsudzuri.access_tokens (user_id);
@@ -0,0 +1,13 @@
+-- Link 死活チェック (tsudzuri.link_items.check_status, redirect_url, checked_at)
+ALTER TABLE tsudzuri.link_items
+    ADD COLUMN IF NOT EXISTS check_status INTEGER,
+    ADD COLUMN IF NOT EXISTS redirect_url TEXT,
+    ADD COLUMN IF NOT EXISTS checked_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN tsudzuri.link_items.check_status IS '最後の死活チェックで返った HTTP ステータス。0 の場合は接続できなかった';
+
+COMMENT ON COLUMN tsudzuri.link_items.redirect_url IS '最後の死活チェックで返ったリダイレクト先の URL';
+
+COMMENT ON COLUMN tsudzuri.link_items.checked_at IS '最後に死活チェックした日時。NULL の場合は未チェック';
+
+CREATE INDEX IF NOT EXISTS idx_link_items_checked_at ON tsudzuri.link_items (checked_at);
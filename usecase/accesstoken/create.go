@@ -0,0 +1,123 @@
+package accesstoken
+
+import (
+	"context"
+	"time"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type CreateUsecaseInput struct {
+	Name string
+	// Scopes are the kinds of requests the token allows. Empty scopes allow reading and writing.
+	Scopes []daccesstoken.Scope
+	// PageIDs restrict the token to the pages. Empty page IDs allow every page of the user.
+	PageIDs []string
+	// ExpiresAt is when the token stops working. Nil keeps it working until it is revoked.
+	ExpiresAt *time.Time
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+
+type CreateUsecase interface {
+	// Create issues a personal access token of the authenticated user and returns it with the plain token,
+	// which is not shown again.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Create(ctx context.Context, input CreateUsecaseInput) (*daccesstoken.AccessToken, string, error)
+}
+
+type createUsecase struct {
+	repository struct {
+		page        dpage.PageRepository
+		accessToken daccesstoken.AccessTokenRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewCreateUsecase(
+	pageRepo dpage.PageRepository,
+	accessTokenRepo daccesstoken.AccessTokenRepository,
+	txnService service.TransactionService,
+) CreateUsecase {
+	return &createUsecase{
+		repository: struct {
+			page        dpage.PageRepository
+			accessToken daccesstoken.AccessTokenRepository
+		}{
+			page:        pageRepo,
+			accessToken: accessTokenRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *createUsecase) Create(ctx context.Context, input CreateUsecaseInput) (*daccesstoken.AccessToken, string, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/accesstoken/createUsecase.Create")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, "", duser.ErrUserNotFound
+	}
+	l.Sugar().Infof("Creating access token of user %s for %d pages", user.ID(), len(input.PageIDs))
+
+	if len(input.PageIDs) > daccesstoken.MaxPagesPerAccessToken {
+		return nil, "", daccesstoken.ErrInvalidScope
+	}
+	pages := make([]*dpage.Page, 0, len(input.PageIDs))
+	for _, pageID := range input.PageIDs {
+		page, err := u.repository.page.Get(ctx, pageID)
+		if err != nil {
+			return nil, "", err
+		}
+		if page == nil {
+			return nil, "", upage.ErrPageNotFound
+		}
+		pages = append(pages, page)
+	}
+
+	now := ctxtime.Now(ctx)
+	accessToken, token, err := daccesstoken.NewAccessToken(user, input.Name, input.Scopes, pages, input.ExpiresAt, now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var created *daccesstoken.AccessToken
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		existing, err := u.repository.accessToken.ListByUserID(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		active := 0
+		for _, t := range existing {
+			if t.Active(now) {
+				active++
+			}
+		}
+		if active >= daccesstoken.MaxAccessTokensPerUser {
+			return daccesstoken.ErrTooManyAccessTokens
+		}
+		created, err = u.repository.accessToken.Save(ctx, accessToken)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return created, token, nil
+}
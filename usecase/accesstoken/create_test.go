@@ -0,0 +1,180 @@
+package accesstoken
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/mock/gomock"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	mockaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken/mock/mock_accesstoken"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateUsecase_Create(t *testing.T) {
+	type mocks struct {
+		pageRepo        *mockpage.MockPageRepository
+		accessTokenRepo *mockaccesstoken.MockAccessTokenRepository
+		txn             *mocktxn.MockTransactionService
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	other := duser.ReconstructUser("user-2", "uid-2", "google", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *user, "invite-code", nil, nil)
+	foreignPage := dpage.ReconstructPage("page-2", "Other Page", *other, "invite-code", nil, nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow)
+	expiresAt := fixedNow.Add(30 * 24 * time.Hour)
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	existing := func(n int, revoked bool) []*daccesstoken.AccessToken {
+		var revokedAt *time.Time
+		if revoked {
+			revokedAt = &fixedNow
+		}
+		tokens := make([]*daccesstoken.AccessToken, 0, n)
+		for range n {
+			tokens = append(tokens, daccesstoken.ReconstructAccessToken("token", "user-1", "bot", "hash", "hint",
+				daccesstoken.Scopes(), nil, daccesstoken.WithUsage(nil, revokedAt)))
+		}
+		return tokens
+	}
+	input := CreateUsecaseInput{
+		Name:      "ci bot",
+		Scopes:    []daccesstoken.Scope{daccesstoken.ScopeWrite},
+		PageIDs:   []string{"page-1"},
+		ExpiresAt: &expiresAt,
+	}
+	want := daccesstoken.ReconstructAccessToken("", "user-1", "ci bot", "", "",
+		[]daccesstoken.Scope{daccesstoken.ScopeRead, daccesstoken.ScopeWrite}, []string{"page-1"},
+		daccesstoken.WithExpiresAt(&expiresAt), daccesstoken.WithCreatedAt(fixedNow))
+	// The token is random, so its hash and hint are not compared.
+	cmpOpts := []cmp.Option{
+		cmp.AllowUnexported(daccesstoken.AccessToken{}),
+		cmpopts.IgnoreFields(daccesstoken.AccessToken{}, "tokenHash", "hint"),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		input CreateUsecaseInput
+		want  *daccesstoken.AccessToken
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.accessTokenRepo.EXPECT().ListByUserID(gomock.Any(), "user-1").
+					Return(existing(daccesstoken.MaxAccessTokensPerUser, true), nil)
+				m.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, t *daccesstoken.AccessToken) (*daccesstoken.AccessToken, error) {
+						return t, nil
+					})
+			},
+			ctx:   ctx,
+			input: input,
+			want:  want,
+		},
+		{
+			name:  "user_not_found_in_context",
+			ctx:   context.Background(),
+			input: input,
+			err:   duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx:   ctx,
+			input: input,
+			err:   upage.ErrPageNotFound,
+		},
+		{
+			name: "page_not_accessible",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-2").Return(foreignPage, nil)
+			},
+			ctx:   ctx,
+			input: CreateUsecaseInput{Name: "bot", PageIDs: []string{"page-2"}},
+			err:   dpage.ErrNotCreatedByUser,
+		},
+		{
+			name:  "invalid_name",
+			ctx:   ctx,
+			input: CreateUsecaseInput{Name: " "},
+			err:   daccesstoken.ErrInvalidName,
+		},
+		{
+			name: "too_many_access_tokens",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.accessTokenRepo.EXPECT().ListByUserID(gomock.Any(), "user-1").
+					Return(existing(daccesstoken.MaxAccessTokensPerUser, false), nil)
+			},
+			ctx:   ctx,
+			input: CreateUsecaseInput{Name: "bot"},
+			err:   daccesstoken.ErrTooManyAccessTokens,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.accessTokenRepo.EXPECT().ListByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			ctx:   ctx,
+			input: CreateUsecaseInput{Name: "bot"},
+			err:   errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:        mockpage.NewMockPageRepository(ctrl),
+				accessTokenRepo: mockaccesstoken.NewMockAccessTokenRepository(ctrl),
+				txn:             mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			got, token, err := NewCreateUsecase(m.pageRepo, m.accessTokenRepo, m.txn).Create(tt.ctx, tt.input)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmpOpts...); diff != "" {
+				t.Fatalf("Create() mismatch (-want +got):\n%s", diff)
+			}
+			if tt.want == nil {
+				return
+			}
+			if !strings.HasPrefix(token, daccesstoken.TokenPrefix) || got.TokenHash() != daccesstoken.HashToken(token) {
+				t.Fatalf("token %q does not match the stored hash", token)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package accesstoken
+
+import "errors"
+
+var ErrAccessTokenNotFound = errors.New("access token not found")
@@ -0,0 +1,51 @@
+package accesstoken
+
+import (
+	"context"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+
+type ListUsecase interface {
+	// List returns the personal access tokens of the authenticated user, newest first, including revoked
+	// and expired ones. The plain tokens are never returned.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context) ([]*daccesstoken.AccessToken, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		accessToken daccesstoken.AccessTokenRepository
+	}
+}
+
+func NewListUsecase(accessTokenRepo daccesstoken.AccessTokenRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			accessToken daccesstoken.AccessTokenRepository
+		}{
+			accessToken: accessTokenRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context) ([]*daccesstoken.AccessToken, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/accesstoken/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+	l.Sugar().Infof("Listing access tokens of user %s", user.ID())
+
+	return u.repository.accessToken.ListByUserID(ctx, user.ID())
+}
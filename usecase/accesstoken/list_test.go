@@ -0,0 +1,72 @@
+package accesstoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	mockaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken/mock/mock_accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	tokens := []*daccesstoken.AccessToken{
+		daccesstoken.ReconstructAccessToken("token-1", "user-1", "bot", "hash", "hint", daccesstoken.Scopes(), nil),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mockaccesstoken.MockAccessTokenRepository)
+		ctx   context.Context
+		want  []*daccesstoken.AccessToken
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mockaccesstoken.MockAccessTokenRepository) {
+				m.EXPECT().ListByUserID(gomock.Any(), "user-1").Return(tokens, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: tokens,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mockaccesstoken.MockAccessTokenRepository) {
+				m.EXPECT().ListByUserID(gomock.Any(), "user-1").Return(nil, errors.New("list error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+			err: errors.New("list error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockaccesstoken.NewMockAccessTokenRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			got, err := NewListUsecase(repo).List(tt.ctx)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(daccesstoken.AccessToken{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
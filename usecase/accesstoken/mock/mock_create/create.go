@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./create.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+//
+
+// Package mockcreateusecase is a generated GoMock package.
+package mockcreateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	accesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	accesstoken0 "github.com/naka-sei/tsudzuri/usecase/accesstoken"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateUsecase is a mock of CreateUsecase interface.
+type MockCreateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateUsecaseMockRecorder is the mock recorder for MockCreateUsecase.
+type MockCreateUsecaseMockRecorder struct {
+	mock *MockCreateUsecase
+}
+
+// NewMockCreateUsecase creates a new mock instance.
+func NewMockCreateUsecase(ctrl *gomock.Controller) *MockCreateUsecase {
+	mock := &MockCreateUsecase{ctrl: ctrl}
+	mock.recorder = &MockCreateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUsecase) EXPECT() *MockCreateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateUsecase) Create(ctx context.Context, input accesstoken0.CreateUsecaseInput) (*accesstoken.AccessToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(*accesstoken.AccessToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateUsecaseMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateUsecase)(nil).Create), ctx, input)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./revoke.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_revoke/revoke.go -source=./revoke.go -package=mockrevokeusecase
+//
+
+// Package mockrevokeusecase is a generated GoMock package.
+package mockrevokeusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevokeUsecase is a mock of RevokeUsecase interface.
+type MockRevokeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeUsecaseMockRecorder is the mock recorder for MockRevokeUsecase.
+type MockRevokeUsecaseMockRecorder struct {
+	mock *MockRevokeUsecase
+}
+
+// NewMockRevokeUsecase creates a new mock instance.
+func NewMockRevokeUsecase(ctrl *gomock.Controller) *MockRevokeUsecase {
+	mock := &MockRevokeUsecase{ctrl: ctrl}
+	mock.recorder = &MockRevokeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeUsecase) EXPECT() *MockRevokeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockRevokeUsecase) Revoke(ctx context.Context, accessTokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, accessTokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRevokeUsecaseMockRecorder) Revoke(ctx, accessTokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRevokeUsecase)(nil).Revoke), ctx, accessTokenID)
+}
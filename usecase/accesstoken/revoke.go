@@ -0,0 +1,78 @@
+package accesstoken
+
+import (
+	"context"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_revoke/revoke.go -source=./revoke.go -package=mockrevokeusecase
+
+type RevokeUsecase interface {
+	// Revoke stops a personal access token of the authenticated user from working.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Revoke(ctx context.Context, accessTokenID string) error
+}
+
+type revokeUsecase struct {
+	repository struct {
+		accessToken daccesstoken.AccessTokenRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewRevokeUsecase(
+	accessTokenRepo daccesstoken.AccessTokenRepository,
+	txnService service.TransactionService,
+) RevokeUsecase {
+	return &revokeUsecase{
+		repository: struct {
+			accessToken daccesstoken.AccessTokenRepository
+		}{
+			accessToken: accessTokenRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *revokeUsecase) Revoke(ctx context.Context, accessTokenID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/accesstoken/revokeUsecase.Revoke")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Revoking access token %s", accessTokenID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	accessToken, err := u.repository.accessToken.Get(ctx, accessTokenID)
+	if err != nil {
+		return err
+	}
+	// Tokens of other users are reported as missing so that their IDs are not disclosed.
+	if accessToken == nil || accessToken.UserID() != user.ID() {
+		return ErrAccessTokenNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := accessToken.Revoke(user, ctxtime.Now(ctx)); err != nil {
+			return err
+		}
+		_, err := u.repository.accessToken.Save(ctx, accessToken)
+		return err
+	})
+}
@@ -0,0 +1,112 @@
+package accesstoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	daccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken"
+	mockaccesstoken "github.com/naka-sei/tsudzuri/domain/accesstoken/mock/mock_accesstoken"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestRevokeUsecase_Revoke(t *testing.T) {
+	type mocks struct {
+		accessTokenRepo *mockaccesstoken.MockAccessTokenRepository
+		txn             *mocktxn.MockTransactionService
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow)
+	newToken := func(userID string) *daccesstoken.AccessToken {
+		return daccesstoken.ReconstructAccessToken("token-1", userID, "bot", "hash", "hint", daccesstoken.Scopes(), nil)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.accessTokenRepo.EXPECT().Get(gomock.Any(), "token-1").Return(newToken("user-1"), nil)
+				runTxn(m)
+				want := daccesstoken.ReconstructAccessToken("token-1", "user-1", "bot", "hash", "hint", daccesstoken.Scopes(), nil,
+					daccesstoken.WithUsage(nil, &fixedNow))
+				m.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, got *daccesstoken.AccessToken) (*daccesstoken.AccessToken, error) {
+						if diff := cmp.Diff(want, got, cmp.AllowUnexported(daccesstoken.AccessToken{})); diff != "" {
+							t.Errorf("Save() mismatch (-want +got):\n%s", diff)
+						}
+						return got, nil
+					})
+			},
+			ctx: ctx,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "not_found",
+			setup: func(m *mocks) {
+				m.accessTokenRepo.EXPECT().Get(gomock.Any(), "token-1").Return(nil, nil)
+			},
+			ctx: ctx,
+			err: ErrAccessTokenNotFound,
+		},
+		{
+			name: "token_of_another_user",
+			setup: func(m *mocks) {
+				m.accessTokenRepo.EXPECT().Get(gomock.Any(), "token-1").Return(newToken("user-2"), nil)
+			},
+			ctx: ctx,
+			err: ErrAccessTokenNotFound,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.accessTokenRepo.EXPECT().Get(gomock.Any(), "token-1").Return(newToken("user-1"), nil)
+				runTxn(m)
+				m.accessTokenRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			ctx: ctx,
+			err: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				accessTokenRepo: mockaccesstoken.NewMockAccessTokenRepository(ctrl),
+				txn:             mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			err := NewRevokeUsecase(m.accessTokenRepo, m.txn).Revoke(tt.ctx, "token-1")
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}
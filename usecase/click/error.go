@@ -0,0 +1,5 @@
+package click
+
+import "errors"
+
+var ErrLinkNotFound = errors.New("link not found")
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./redirect.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_redirect/redirect.go -source=./redirect.go -package=mockredirectusecase
+//
+
+// Package mockredirectusecase is a generated GoMock package.
+package mockredirectusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRedirectUsecase is a mock of RedirectUsecase interface.
+type MockRedirectUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedirectUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRedirectUsecaseMockRecorder is the mock recorder for MockRedirectUsecase.
+type MockRedirectUsecaseMockRecorder struct {
+	mock *MockRedirectUsecase
+}
+
+// NewMockRedirectUsecase creates a new mock instance.
+func NewMockRedirectUsecase(ctrl *gomock.Controller) *MockRedirectUsecase {
+	mock := &MockRedirectUsecase{ctrl: ctrl}
+	mock.recorder = &MockRedirectUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedirectUsecase) EXPECT() *MockRedirectUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Redirect mocks base method.
+func (m *MockRedirectUsecase) Redirect(ctx context.Context, linkID, referrer string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redirect", ctx, linkID, referrer)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redirect indicates an expected call of Redirect.
+func (mr *MockRedirectUsecaseMockRecorder) Redirect(ctx, linkID, referrer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redirect", reflect.TypeOf((*MockRedirectUsecase)(nil).Redirect), ctx, linkID, referrer)
+}
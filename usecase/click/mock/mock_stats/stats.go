@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./stats.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_stats/stats.go -source=./stats.go -package=mockstatsusecase
+//
+
+// Package mockstatsusecase is a generated GoMock package.
+package mockstatsusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	click "github.com/naka-sei/tsudzuri/domain/click"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStatsUsecase is a mock of StatsUsecase interface.
+type MockStatsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatsUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockStatsUsecaseMockRecorder is the mock recorder for MockStatsUsecase.
+type MockStatsUsecaseMockRecorder struct {
+	mock *MockStatsUsecase
+}
+
+// NewMockStatsUsecase creates a new mock instance.
+func NewMockStatsUsecase(ctrl *gomock.Controller) *MockStatsUsecase {
+	mock := &MockStatsUsecase{ctrl: ctrl}
+	mock.recorder = &MockStatsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatsUsecase) EXPECT() *MockStatsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Stats mocks base method.
+func (m *MockStatsUsecase) Stats(ctx context.Context, pageID string) (*click.PageStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, pageID)
+	ret0, _ := ret[0].(*click.PageStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockStatsUsecaseMockRecorder) Stats(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockStatsUsecase)(nil).Stats), ctx, pageID)
+}
@@ -0,0 +1,60 @@
+package click
+
+import (
+	"context"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_redirect/redirect.go -source=./redirect.go -package=mockredirectusecase
+type RedirectUsecase interface {
+	// Redirect returns the URL of the link and records the click in the background. Links that are not absolute
+	// http(s) URLs are reported as ErrLinkNotFound and their clicks are not recorded.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext when signed in; clicks without one are anonymous.
+	Redirect(ctx context.Context, linkID string, referrer string) (string, error)
+}
+
+type redirectUsecase struct {
+	repository struct {
+		target dclick.TargetRepository
+	}
+	service struct {
+		click service.ClickService
+	}
+}
+
+func NewRedirectUsecase(targetRepo dclick.TargetRepository, clickService service.ClickService) RedirectUsecase {
+	return &redirectUsecase{
+		repository: struct{ target dclick.TargetRepository }{target: targetRepo},
+		service:    struct{ click service.ClickService }{click: clickService},
+	}
+}
+
+func (u *redirectUsecase) Redirect(ctx context.Context, linkID string, referrer string) (string, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/click/redirectUsecase.Redirect")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Redirecting link %s", linkID)
+
+	target, err := u.repository.target.Get(ctx, linkID)
+	if err != nil {
+		return "", err
+	}
+	// Links are stored as entered, so only web URLs are redirected to: the redirect must not send visitors to
+	// javascript:, data: or other targets on behalf of this site.
+	if target == nil || !dpage.IsWebURL(target.URL()) {
+		return "", ErrLinkNotFound
+	}
+
+	user, _ := ctxuser.UserFromContext(ctx)
+	u.service.click.Record(ctx, dclick.NewClick(target, user, referrer, ctxtime.Now(ctx)))
+
+	return target.URL(), nil
+}
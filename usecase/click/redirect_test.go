@@ -0,0 +1,127 @@
+package click
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	mockclick "github.com/naka-sei/tsudzuri/domain/click/mock/mock_click"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mockclickservice "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_click"
+)
+
+func TestRedirectUsecase_Redirect(t *testing.T) {
+	type mocks struct {
+		targetRepo   *mockclick.MockTargetRepository
+		clickService *mockclickservice.MockClickService
+	}
+	type args struct {
+		ctx      context.Context
+		linkID   string
+		referrer string
+	}
+	type want struct {
+		url string
+		err error
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	user := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	target := dclick.ReconstructTarget("page-1", "link-1", "https://example.com")
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "signed_in",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-1").Return(target, nil)
+				m.clickService.EXPECT().Record(gomock.Any(), dclick.NewClick(target, user, "https://referrer.example.com", now))
+			},
+			args: args{ctx: ctxuser.WithUser(ctxtime.WithTime(context.Background(), now), user), linkID: "link-1", referrer: "https://referrer.example.com"},
+			want: want{url: "https://example.com"},
+		},
+		{
+			name: "anonymous",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-1").Return(target, nil)
+				m.clickService.EXPECT().Record(gomock.Any(), dclick.NewClick(target, nil, "", now))
+			},
+			args: args{ctx: ctxtime.WithTime(context.Background(), now), linkID: "link-1"},
+			want: want{url: "https://example.com"},
+		},
+		{
+			name: "link_not_found",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-x").Return(nil, nil)
+			},
+			args: args{ctx: context.Background(), linkID: "link-x"},
+			want: want{err: ErrLinkNotFound},
+		},
+		{
+			name: "javascript_url",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-js").Return(dclick.ReconstructTarget("page-1", "link-js", "javascript:alert(1)"), nil)
+			},
+			args: args{ctx: context.Background(), linkID: "link-js"},
+			want: want{err: ErrLinkNotFound},
+		},
+		{
+			name: "data_url",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-data").Return(dclick.ReconstructTarget("page-1", "link-data", "data:text/html,<script>alert(1)</script>"), nil)
+			},
+			args: args{ctx: context.Background(), linkID: "link-data"},
+			want: want{err: ErrLinkNotFound},
+		},
+		{
+			name: "relative_url",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-rel").Return(dclick.ReconstructTarget("page-1", "link-rel", "//evil.example.com"), nil)
+			},
+			args: args{ctx: context.Background(), linkID: "link-rel"},
+			want: want{err: ErrLinkNotFound},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				m.targetRepo.EXPECT().Get(gomock.Any(), "link-1").Return(nil, errors.New("get error"))
+			},
+			args: args{ctx: context.Background(), linkID: "link-1"},
+			want: want{err: errors.New("get error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				targetRepo:   mockclick.NewMockTargetRepository(ctrl),
+				clickService: mockclickservice.NewMockClickService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewRedirectUsecase(m.targetRepo, m.clickService)
+			got, err := u.Redirect(tt.args.ctx, tt.args.linkID, tt.args.referrer)
+			testutil.EqualErr(t, tt.want.err, err)
+			if got != tt.want.url {
+				t.Fatalf("Redirect() = %q, want %q", got, tt.want.url)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package click
+
+import (
+	"context"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_stats/stats.go -source=./stats.go -package=mockstatsusecase
+type StatsUsecase interface {
+	// Stats returns the clicks on the links of the page per window. Only the creator of the page can see them.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Stats(ctx context.Context, pageID string) (*dclick.PageStats, error)
+}
+
+type statsUsecase struct {
+	repository struct {
+		page  dpage.PageRepository
+		click dclick.ClickRepository
+	}
+}
+
+func NewStatsUsecase(pageRepo dpage.PageRepository, clickRepo dclick.ClickRepository) StatsUsecase {
+	return &statsUsecase{
+		repository: struct {
+			page  dpage.PageRepository
+			click dclick.ClickRepository
+		}{
+			page:  pageRepo,
+			click: clickRepo,
+		},
+	}
+}
+
+func (u *statsUsecase) Stats(ctx context.Context, pageID string) (*dclick.PageStats, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/click/statsUsecase.Stats")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Getting click stats of page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+	if err := dclick.AuthorizeStats(page, user); err != nil {
+		return nil, err
+	}
+
+	now := ctxtime.Now(ctx)
+	counts := make(map[dclick.Window]map[string]int64, len(dclick.Windows))
+	for _, w := range dclick.Windows {
+		c, err := u.repository.click.CountByLink(ctx, page.ID(), w.Since(now))
+		if err != nil {
+			return nil, err
+		}
+		counts[w] = c
+	}
+	return dclick.NewPageStats(page, counts, now), nil
+}
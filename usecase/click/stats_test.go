@@ -0,0 +1,122 @@
+package click
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	mockclick "github.com/naka-sei/tsudzuri/domain/click/mock/mock_click"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestStatsUsecase_Stats(t *testing.T) {
+	type mocks struct {
+		pageRepo  *mockpage.MockPageRepository
+		clickRepo *mockclick.MockClickRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+	type want struct {
+		stats *dclick.PageStats
+		err   error
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invited := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, duser.Users{invited})
+	counts := map[dclick.Window]map[string]int64{
+		dclick.WindowDay:   {"link-1": 1},
+		dclick.WindowWeek:  {"link-1": 2},
+		dclick.WindowMonth: {"link-1": 3},
+		dclick.WindowAll:   {"link-1": 4},
+	}
+	ctx := ctxtime.WithTime(context.Background(), now)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				for _, w := range dclick.Windows {
+					m.clickRepo.EXPECT().CountByLink(gomock.Any(), "page-1", w.Since(now)).Return(counts[w], nil)
+				}
+			},
+			args: args{ctx: ctxuser.WithUser(ctx, creator), pageID: "page-1"},
+			want: want{stats: dclick.NewPageStats(page, counts, now)},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: ctx, pageID: "page-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(ctx, creator), pageID: "page-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(ctx, invited), pageID: "page-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.clickRepo.EXPECT().CountByLink(gomock.Any(), "page-1", gomock.Any()).Return(nil, errors.New("count error"))
+			},
+			args: args{ctx: ctxuser.WithUser(ctx, creator), pageID: "page-1"},
+			want: want{err: errors.New("count error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:  mockpage.NewMockPageRepository(ctrl),
+				clickRepo: mockclick.NewMockClickRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewStatsUsecase(m.pageRepo, m.clickRepo)
+			got, err := u.Stats(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.stats, got, cmp.AllowUnexported(dclick.PageStats{}, dclick.LinkStats{})); diff != "" {
+				t.Fatalf("Stats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package click
+
+import (
+	"context"
+	"time"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+const (
+	// clickQueueSize is the number of clicks waiting to be written before new ones are dropped.
+	clickQueueSize = 1024
+	// clickBatchSize is the number of clicks written at once.
+	clickBatchSize = 100
+	// clickFlushInterval is the longest a click waits in a partial batch.
+	clickFlushInterval = 5 * time.Second
+	// clickWriteTimeout bounds the write of one batch.
+	clickWriteTimeout = 5 * time.Second
+)
+
+// ClickWriter stores clicks in the background so that redirects do not wait for the database.
+// Clicks are queued by Record and written in batches while Run is running, when a batch is full or
+// once per interval. The clicks still queued when Run is stopped are written before it returns.
+type ClickWriter struct {
+	repository struct {
+		click dclick.ClickRepository
+	}
+	queue         chan *dclick.Click
+	batchSize     int
+	flushInterval time.Duration
+}
+
+// NewClickWriter creates a ClickWriter storing clicks in the repository.
+func NewClickWriter(clickRepo dclick.ClickRepository) *ClickWriter {
+	return &ClickWriter{
+		repository:    struct{ click dclick.ClickRepository }{click: clickRepo},
+		queue:         make(chan *dclick.Click, clickQueueSize),
+		batchSize:     clickBatchSize,
+		flushInterval: clickFlushInterval,
+	}
+}
+
+// Record queues the click. It never blocks: when the queue is full the click is dropped.
+func (w *ClickWriter) Record(ctx context.Context, click *dclick.Click) {
+	select {
+	case w.queue <- click:
+	default:
+		log.LoggerFromContext(ctx).Sugar().Warnf("Click queue is full, dropping click on link %s", click.LinkID())
+	}
+}
+
+// Run writes queued clicks until ctx is done.
+func (w *ClickWriter) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*dclick.Click, 0, w.batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			w.drain(ctx, batch)
+			return
+		case click := <-w.queue:
+			batch = append(batch, click)
+			if len(batch) >= w.batchSize {
+				w.flush(ctx, batch)
+				batch = make([]*dclick.Click, 0, w.batchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				w.flush(ctx, batch)
+				batch = make([]*dclick.Click, 0, w.batchSize)
+			}
+		}
+	}
+}
+
+// drain writes the batch and the clicks still queued after ctx is done.
+func (w *ClickWriter) drain(ctx context.Context, batch []*dclick.Click) {
+	for queued := true; queued; {
+		select {
+		case click := <-w.queue:
+			batch = append(batch, click)
+		default:
+			queued = false
+		}
+	}
+	for len(batch) > 0 {
+		n := min(len(batch), w.batchSize)
+		w.flush(ctx, batch[:n])
+		batch = batch[n:]
+	}
+}
+
+// flush writes the clicks. The write is not cancelled with ctx so that stopping Run does not lose a batch.
+// Clicks that cannot be written are dropped, since they only feed the stats.
+func (w *ClickWriter) flush(ctx context.Context, clicks []*dclick.Click) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), clickWriteTimeout)
+	defer cancel()
+
+	ctx, end := trace.StartSpan(ctx, "usecase/click/ClickWriter.flush")
+	defer end()
+
+	if err := w.repository.click.CreateBulk(ctx, clicks); err != nil {
+		log.LoggerFromContext(ctx).Sugar().Errorf("failed to write %d clicks: %v", len(clicks), err)
+	}
+}
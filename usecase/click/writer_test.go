@@ -0,0 +1,117 @@
+package click
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+	mockclick "github.com/naka-sei/tsudzuri/domain/click/mock/mock_click"
+)
+
+func newTestClick(linkID string) *dclick.Click {
+	return dclick.NewClick(dclick.ReconstructTarget("page-1", linkID, "https://example.com"), nil, "", time.Time{})
+}
+
+func TestClickWriter_Run_writesFullBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clickRepo := mockclick.NewMockClickRepository(ctrl)
+	w := NewClickWriter(clickRepo)
+	w.batchSize = 2
+	w.flushInterval = time.Hour
+
+	c1, c2 := newTestClick("link-1"), newTestClick("link-2")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clickRepo.EXPECT().CreateBulk(gomock.Any(), []*dclick.Click{c1, c2}).
+		DoAndReturn(func(context.Context, []*dclick.Click) error {
+			cancel()
+			return nil
+		})
+
+	w.Record(ctx, c1)
+	w.Record(ctx, c2)
+	runUntilDone(t, ctx, w)
+}
+
+func TestClickWriter_Run_writesPartialBatchEveryInterval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clickRepo := mockclick.NewMockClickRepository(ctrl)
+	w := NewClickWriter(clickRepo)
+	w.flushInterval = 10 * time.Millisecond
+
+	c := newTestClick("link-1")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clickRepo.EXPECT().CreateBulk(gomock.Any(), []*dclick.Click{c}).
+		DoAndReturn(func(context.Context, []*dclick.Click) error {
+			cancel()
+			return errors.New("write error")
+		})
+
+	w.Record(ctx, c)
+	runUntilDone(t, ctx, w)
+}
+
+func TestClickWriter_Run_writesQueuedClicksOnStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clickRepo := mockclick.NewMockClickRepository(ctrl)
+	w := NewClickWriter(clickRepo)
+	w.batchSize = 2
+	w.flushInterval = time.Hour
+
+	clicks := []*dclick.Click{newTestClick("link-1"), newTestClick("link-2"), newTestClick("link-3")}
+	for _, c := range clicks {
+		w.Record(context.Background(), c)
+	}
+	gomock.InOrder(
+		clickRepo.EXPECT().CreateBulk(gomock.Any(), clicks[:2]).
+			DoAndReturn(func(ctx context.Context, _ []*dclick.Click) error {
+				if ctx.Err() != nil {
+					t.Errorf("CreateBulk() called with a done context: %v", ctx.Err())
+				}
+				return nil
+			}),
+		clickRepo.EXPECT().CreateBulk(gomock.Any(), clicks[2:]).Return(nil),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runUntilDone(t, ctx, w)
+}
+
+func TestClickWriter_Record_dropsWhenQueueIsFull(t *testing.T) {
+	w := NewClickWriter(nil)
+	w.queue = make(chan *dclick.Click, 1)
+
+	w.Record(context.Background(), newTestClick("link-1"))
+	w.Record(context.Background(), newTestClick("link-2"))
+
+	if got := len(w.queue); got != 1 {
+		t.Fatalf("queued %d clicks, want 1", got)
+	}
+}
+
+func runUntilDone(t *testing.T, ctx context.Context, w *ClickWriter) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was cancelled")
+	}
+}
@@ -0,0 +1,99 @@
+package comment
+
+import (
+	"context"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_add/add.go -source=./add.go -package=mockaddusecase
+type AddUsecase interface {
+	// Add writes a comment on a link of the page. A non-empty parentID makes it a reply in that comment's thread.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Add(ctx context.Context, pageID string, linkID string, parentID string, body string) (*dcomment.Comment, error)
+}
+
+type addUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		comment dcomment.CommentRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewAddUsecase(
+	pageRepo dpage.PageRepository,
+	commentRepo dcomment.CommentRepository,
+	txnService service.TransactionService,
+) AddUsecase {
+	return &addUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			comment dcomment.CommentRepository
+		}{
+			page:    pageRepo,
+			comment: commentRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *addUsecase) Add(ctx context.Context, pageID string, linkID string, parentID string, body string) (*dcomment.Comment, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/comment/addUsecase.Add")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Adding comment on link %s of page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	var parent *dcomment.Comment
+	if parentID != "" {
+		parent, err = u.repository.comment.Get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, ErrCommentNotFound
+		}
+	}
+
+	comment, err := dcomment.NewComment(page, user, linkID, parent, body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		comment, err = u.repository.comment.Save(ctx, comment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,143 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	mockcomment "github.com/naka-sei/tsudzuri/domain/comment/mock/mock_comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestAddUsecase_Add(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		commentRepo *mockcomment.MockCommentRepository
+		txn         *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx      context.Context
+		pageID   string
+		linkID   string
+		parentID string
+		body     string
+	}
+	type want struct {
+		comment *dcomment.Comment
+		err     error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	other := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+	root := dcomment.ReconstructComment("comment-1", "page-1", "link-1", *creator, "root")
+	saved := dcomment.ReconstructComment("comment-2", "page-1", "link-1", *creator, "reply", dcomment.WithParentID("comment-1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success_reply",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(root, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.commentRepo.EXPECT().Save(gomock.Any(), dcomment.ReconstructComment("", "page-1", "link-1", *creator, "reply",
+					dcomment.WithParentID("comment-1"))).Return(saved, nil)
+			},
+			args: args{
+				ctx:      ctxuser.WithUser(context.Background(), creator),
+				pageID:   "page-1",
+				linkID:   "link-1",
+				parentID: "comment-1",
+				body:     "reply",
+			},
+			want: want{comment: saved},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", linkID: "link-1", body: "body"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1", body: "body"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "parent_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-x").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1", parentID: "comment-x", body: "body"},
+			want: want{err: ErrCommentNotFound},
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), other), pageID: "page-1", linkID: "link-1", body: "body"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.commentRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1", body: "body"},
+			want: want{err: errors.New("save error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				commentRepo: mockcomment.NewMockCommentRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewAddUsecase(m.pageRepo, m.commentRepo, m.txn)
+			got, err := u.Add(tt.args.ctx, tt.args.pageID, tt.args.linkID, tt.args.parentID, tt.args.body)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.comment, got, cmp.AllowUnexported(dcomment.Comment{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("Add() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
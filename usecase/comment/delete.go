@@ -0,0 +1,77 @@
+package comment
+
+import (
+	"context"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_delete/delete.go -source=./delete.go -package=mockdeleteusecase
+type DeleteUsecase interface {
+	// Delete deletes a comment written by the user. Replies in its thread are kept.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Delete(ctx context.Context, commentID string) error
+}
+
+type deleteUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		comment dcomment.CommentRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewDeleteUsecase(
+	pageRepo dpage.PageRepository,
+	commentRepo dcomment.CommentRepository,
+	txnService service.TransactionService,
+) DeleteUsecase {
+	return &deleteUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			comment dcomment.CommentRepository
+		}{
+			page:    pageRepo,
+			comment: commentRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *deleteUsecase) Delete(ctx context.Context, commentID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/comment/deleteUsecase.Delete")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Deleting comment %s", commentID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	comment, page, err := getCommentWithPage(ctx, u.repository.comment, u.repository.page, commentID)
+	if err != nil {
+		return err
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := comment.Delete(page, user); err != nil {
+			return err
+		}
+		_, err := u.repository.comment.Save(ctx, comment)
+		return err
+	})
+}
@@ -0,0 +1,105 @@
+package comment
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	mockcomment "github.com/naka-sei/tsudzuri/domain/comment/mock/mock_comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestDeleteUsecase_Delete(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		commentRepo *mockcomment.MockCommentRepository
+		txn         *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx       context.Context
+		commentID string
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invited := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{invited})
+	comment := func() *dcomment.Comment {
+		return dcomment.ReconstructComment("comment-1", "page-1", "link-1", *invited, "body")
+	}
+	deleted := dcomment.ReconstructComment("comment-1", "page-1", "link-1", *invited, "", dcomment.WithDeleted())
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(comment(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.commentRepo.EXPECT().Save(gomock.Any(), deleted).Return(deleted, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), invited), commentID: "comment-1"},
+		},
+		{
+			name:    "user_not_found_in_context",
+			args:    args{ctx: context.Background(), commentID: "comment-1"},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "comment_not_found",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(nil, nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), invited), commentID: "comment-1"},
+			wantErr: ErrCommentNotFound,
+		},
+		{
+			name: "not_author",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(comment(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creator), commentID: "comment-1"},
+			wantErr: dcomment.ErrNotCommentAuthor,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				commentRepo: mockcomment.NewMockCommentRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewDeleteUsecase(m.pageRepo, m.commentRepo, m.txn)
+			err := u.Delete(tt.args.ctx, tt.args.commentID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package comment
+
+import (
+	"context"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_edit/edit.go -source=./edit.go -package=mockeditusecase
+type EditUsecase interface {
+	// Edit replaces the body of a comment written by the user.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Edit(ctx context.Context, commentID string, body string) (*dcomment.Comment, error)
+}
+
+type editUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		comment dcomment.CommentRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewEditUsecase(
+	pageRepo dpage.PageRepository,
+	commentRepo dcomment.CommentRepository,
+	txnService service.TransactionService,
+) EditUsecase {
+	return &editUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			comment dcomment.CommentRepository
+		}{
+			page:    pageRepo,
+			comment: commentRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *editUsecase) Edit(ctx context.Context, commentID string, body string) (*dcomment.Comment, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/comment/editUsecase.Edit")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Editing comment %s", commentID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	comment, page, err := getCommentWithPage(ctx, u.repository.comment, u.repository.page, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := comment.Edit(page, user, body); err != nil {
+			return err
+		}
+		comment, err = u.repository.comment.Save(ctx, comment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// getCommentWithPage fetches a comment and the page of the commented link.
+func getCommentWithPage(
+	ctx context.Context,
+	commentRepo dcomment.CommentRepository,
+	pageRepo dpage.PageRepository,
+	commentID string,
+) (*dcomment.Comment, *dpage.Page, error) {
+	comment, err := commentRepo.Get(ctx, commentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment == nil {
+		return nil, nil, ErrCommentNotFound
+	}
+
+	page, err := pageRepo.Get(ctx, comment.PageID())
+	if err != nil {
+		return nil, nil, err
+	}
+	if page == nil {
+		return nil, nil, upage.ErrPageNotFound
+	}
+
+	return comment, page, nil
+}
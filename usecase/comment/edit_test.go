@@ -0,0 +1,125 @@
+package comment
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	mockcomment "github.com/naka-sei/tsudzuri/domain/comment/mock/mock_comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestEditUsecase_Edit(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		commentRepo *mockcomment.MockCommentRepository
+		txn         *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx       context.Context
+		commentID string
+		body      string
+	}
+	type want struct {
+		comment *dcomment.Comment
+		err     error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invited := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{invited})
+	comment := func() *dcomment.Comment {
+		return dcomment.ReconstructComment("comment-1", "page-1", "link-1", *invited, "before")
+	}
+	edited := dcomment.ReconstructComment("comment-1", "page-1", "link-1", *invited, "after")
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(comment(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.commentRepo.EXPECT().Save(gomock.Any(), edited).Return(edited, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), invited), commentID: "comment-1", body: "after"},
+			want: want{comment: edited},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), commentID: "comment-1", body: "after"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "comment_not_found",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), invited), commentID: "comment-1", body: "after"},
+			want: want{err: ErrCommentNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(comment(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), invited), commentID: "comment-1", body: "after"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_author",
+			setup: func(m *mocks) {
+				m.commentRepo.EXPECT().Get(gomock.Any(), "comment-1").Return(comment(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), commentID: "comment-1", body: "after"},
+			want: want{err: dcomment.ErrNotCommentAuthor},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				commentRepo: mockcomment.NewMockCommentRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewEditUsecase(m.pageRepo, m.commentRepo, m.txn)
+			got, err := u.Edit(tt.args.ctx, tt.args.commentID, tt.args.body)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.comment, got, cmp.AllowUnexported(dcomment.Comment{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("Edit() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
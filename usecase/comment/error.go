@@ -0,0 +1,5 @@
+package comment
+
+import "errors"
+
+var ErrCommentNotFound = errors.New("comment not found")
@@ -0,0 +1,68 @@
+package comment
+
+import (
+	"context"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+type ListUsecase interface {
+	// List returns the comments on a link of the page, oldest first.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, pageID string, linkID string) ([]*dcomment.Comment, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		comment dcomment.CommentRepository
+	}
+}
+
+func NewListUsecase(pageRepo dpage.PageRepository, commentRepo dcomment.CommentRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			comment dcomment.CommentRepository
+		}{
+			page:    pageRepo,
+			comment: commentRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context, pageID string, linkID string) ([]*dcomment.Comment, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/comment/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing comments on link %s of page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	if !page.HasLink(linkID) {
+		return nil, dpage.ErrNotFoundLinkByID(linkID)
+	}
+
+	return u.repository.comment.ListByLink(ctx, linkID)
+}
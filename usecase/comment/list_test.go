@@ -0,0 +1,122 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dcomment "github.com/naka-sei/tsudzuri/domain/comment"
+	mockcomment "github.com/naka-sei/tsudzuri/domain/comment/mock/mock_comment"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		commentRepo *mockcomment.MockCommentRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+	}
+	type want struct {
+		comments []*dcomment.Comment
+		err      error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	other := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+	comments := []*dcomment.Comment{
+		dcomment.ReconstructComment("comment-1", "page-1", "link-1", *creator, "root"),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.commentRepo.EXPECT().ListByLink(gomock.Any(), "link-1").Return(comments, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{comments: comments},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", linkID: "link-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), other), pageID: "page-1", linkID: "link-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "link_not_on_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-x"},
+			want: want{err: dpage.ErrNotFoundLinkByID("link-x")},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.commentRepo.EXPECT().ListByLink(gomock.Any(), "link-1").Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{err: errors.New("list error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				commentRepo: mockcomment.NewMockCommentRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewListUsecase(m.pageRepo, m.commentRepo)
+			got, err := u.List(tt.args.ctx, tt.args.pageID, tt.args.linkID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.comments, got, cmp.AllowUnexported(dcomment.Comment{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
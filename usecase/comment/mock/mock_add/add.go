@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./add.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_add/add.go -source=./add.go -package=mockaddusecase
+//
+
+// Package mockaddusecase is a generated GoMock package.
+package mockaddusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	comment "github.com/naka-sei/tsudzuri/domain/comment"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAddUsecase is a mock of AddUsecase interface.
+type MockAddUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAddUsecaseMockRecorder is the mock recorder for MockAddUsecase.
+type MockAddUsecaseMockRecorder struct {
+	mock *MockAddUsecase
+}
+
+// NewMockAddUsecase creates a new mock instance.
+func NewMockAddUsecase(ctrl *gomock.Controller) *MockAddUsecase {
+	mock := &MockAddUsecase{ctrl: ctrl}
+	mock.recorder = &MockAddUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddUsecase) EXPECT() *MockAddUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockAddUsecase) Add(ctx context.Context, pageID, linkID, parentID, body string) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, pageID, linkID, parentID, body)
+	ret0, _ := ret[0].(*comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockAddUsecaseMockRecorder) Add(ctx, pageID, linkID, parentID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAddUsecase)(nil).Add), ctx, pageID, linkID, parentID, body)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./register.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_register/register.go -source=./register.go -package=mockregisterusecase
+//
+
+// Package mockregisterusecase is a generated GoMock package.
+package mockregisterusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	device "github.com/naka-sei/tsudzuri/domain/device"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegisterUsecase is a mock of RegisterUsecase interface.
+type MockRegisterUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRegisterUsecaseMockRecorder is the mock recorder for MockRegisterUsecase.
+type MockRegisterUsecaseMockRecorder struct {
+	mock *MockRegisterUsecase
+}
+
+// NewMockRegisterUsecase creates a new mock instance.
+func NewMockRegisterUsecase(ctrl *gomock.Controller) *MockRegisterUsecase {
+	mock := &MockRegisterUsecase{ctrl: ctrl}
+	mock.recorder = &MockRegisterUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterUsecase) EXPECT() *MockRegisterUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockRegisterUsecase) Register(ctx context.Context, token string, platform device.Platform) (*device.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, token, platform)
+	ret0, _ := ret[0].(*device.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockRegisterUsecaseMockRecorder) Register(ctx, token, platform any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockRegisterUsecase)(nil).Register), ctx, token, platform)
+}
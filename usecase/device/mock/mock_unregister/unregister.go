@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./unregister.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_unregister/unregister.go -source=./unregister.go -package=mockunregisterusecase
+//
+
+// Package mockunregisterusecase is a generated GoMock package.
+package mockunregisterusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnregisterUsecase is a mock of UnregisterUsecase interface.
+type MockUnregisterUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnregisterUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnregisterUsecaseMockRecorder is the mock recorder for MockUnregisterUsecase.
+type MockUnregisterUsecaseMockRecorder struct {
+	mock *MockUnregisterUsecase
+}
+
+// NewMockUnregisterUsecase creates a new mock instance.
+func NewMockUnregisterUsecase(ctrl *gomock.Controller) *MockUnregisterUsecase {
+	mock := &MockUnregisterUsecase{ctrl: ctrl}
+	mock.recorder = &MockUnregisterUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnregisterUsecase) EXPECT() *MockUnregisterUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Unregister mocks base method.
+func (m *MockUnregisterUsecase) Unregister(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unregister", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unregister indicates an expected call of Unregister.
+func (mr *MockUnregisterUsecaseMockRecorder) Unregister(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unregister", reflect.TypeOf((*MockUnregisterUsecase)(nil).Unregister), ctx, token)
+}
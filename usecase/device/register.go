@@ -0,0 +1,74 @@
+package device
+
+import (
+	"context"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_register/register.go -source=./register.go -package=mockregisterusecase
+
+type RegisterUsecase interface {
+	// Register makes the device with the push token receive push notifications of the authenticated user.
+	// A token registered before, even by another user, is moved to the user.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Register(ctx context.Context, token string, platform ddevice.Platform) (*ddevice.Device, error)
+}
+
+type registerUsecase struct {
+	repository struct {
+		device ddevice.DeviceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewRegisterUsecase(
+	deviceRepo ddevice.DeviceRepository,
+	txnService service.TransactionService,
+) RegisterUsecase {
+	return &registerUsecase{
+		repository: struct {
+			device ddevice.DeviceRepository
+		}{
+			device: deviceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *registerUsecase) Register(ctx context.Context, token string, platform ddevice.Platform) (*ddevice.Device, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/device/registerUsecase.Register")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+	l.Sugar().Infof("Registering %s device of user %s", platform, user.ID())
+
+	device, err := ddevice.NewDevice(user, token, platform, ctxtime.Now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		return u.repository.device.Save(ctx, device)
+	}); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
@@ -0,0 +1,102 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	mockdevice "github.com/naka-sei/tsudzuri/domain/device/mock/mock_device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestRegisterUsecase_Register(t *testing.T) {
+	type mocks struct {
+		deviceRepo *mockdevice.MockDeviceRepository
+		txn        *mocktxn.MockTransactionService
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow)
+	want := ddevice.ReconstructDevice("", "user-1", "token-1", ddevice.PlatformAndroid, fixedNow)
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(m *mocks)
+		ctx      context.Context
+		platform ddevice.Platform
+		want     *ddevice.Device
+		err      error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.deviceRepo.EXPECT().Save(gomock.Any(), want).Return(nil)
+			},
+			ctx:      ctx,
+			platform: ddevice.PlatformAndroid,
+			want:     want,
+		},
+		{
+			name:     "user_not_found_in_context",
+			ctx:      context.Background(),
+			platform: ddevice.PlatformAndroid,
+			err:      duser.ErrUserNotFound,
+		},
+		{
+			name:     "invalid_platform",
+			ctx:      ctx,
+			platform: "windows",
+			err:      ddevice.ErrInvalidPlatform,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.deviceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+			},
+			ctx:      ctx,
+			platform: ddevice.PlatformAndroid,
+			err:      errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				deviceRepo: mockdevice.NewMockDeviceRepository(ctrl),
+				txn:        mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			got, err := NewRegisterUsecase(m.deviceRepo, m.txn).Register(tt.ctx, "token-1", tt.platform)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(ddevice.Device{})); diff != "" {
+				t.Fatalf("Register() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
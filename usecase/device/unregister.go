@@ -0,0 +1,51 @@
+package device
+
+import (
+	"context"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_unregister/unregister.go -source=./unregister.go -package=mockunregisterusecase
+
+type UnregisterUsecase interface {
+	// Unregister stops push notifications to the device of the authenticated user with the token,
+	// e.g. when the user signs out on it. Tokens not registered by the user are ignored.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Unregister(ctx context.Context, token string) error
+}
+
+type unregisterUsecase struct {
+	repository struct {
+		device ddevice.DeviceRepository
+	}
+}
+
+func NewUnregisterUsecase(deviceRepo ddevice.DeviceRepository) UnregisterUsecase {
+	return &unregisterUsecase{
+		repository: struct {
+			device ddevice.DeviceRepository
+		}{
+			device: deviceRepo,
+		},
+	}
+}
+
+func (u *unregisterUsecase) Unregister(ctx context.Context, token string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/device/unregisterUsecase.Unregister")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+	l.Sugar().Infof("Unregistering device of user %s", user.ID())
+
+	return u.repository.device.Delete(ctx, user.ID(), token)
+}
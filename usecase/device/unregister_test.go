@@ -0,0 +1,62 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	mockdevice "github.com/naka-sei/tsudzuri/domain/device/mock/mock_device"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestUnregisterUsecase_Unregister(t *testing.T) {
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mockdevice.MockDeviceRepository)
+		ctx   context.Context
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mockdevice.MockDeviceRepository) {
+				m.EXPECT().Delete(gomock.Any(), "user-1", "token-1").Return(nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mockdevice.MockDeviceRepository) {
+				m.EXPECT().Delete(gomock.Any(), "user-1", "token-1").Return(errors.New("delete error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+			err: errors.New("delete error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockdevice.NewMockDeviceRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			err := NewUnregisterUsecase(repo).Unregister(tt.ctx, "token-1")
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}
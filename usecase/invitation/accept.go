@@ -0,0 +1,123 @@
+package invitation
+
+import (
+	"context"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_accept/accept.go -source=./accept.go -package=mockacceptusecase
+
+type AcceptUsecase interface {
+	// Accept joins the page with the signed token of an invite link and returns the ID of the page.
+	// The token can be used only once.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Accept(ctx context.Context, signedToken string) (string, error)
+}
+
+type acceptUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		invitation dinvitation.InvitationRepository
+	}
+	service struct {
+		txn          service.TransactionService
+		signer       service.TokenSigner
+		notification service.NotificationService
+		webhook      service.WebhookService
+	}
+}
+
+func NewAcceptUsecase(
+	pageRepo dpage.PageRepository,
+	invitationRepo dinvitation.InvitationRepository,
+	txnService service.TransactionService,
+	tokenSigner service.TokenSigner,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
+) AcceptUsecase {
+	return &acceptUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			invitation dinvitation.InvitationRepository
+		}{
+			page:       pageRepo,
+			invitation: invitationRepo,
+		},
+		service: struct {
+			txn          service.TransactionService
+			signer       service.TokenSigner
+			notification service.NotificationService
+			webhook      service.WebhookService
+		}{
+			txn:          txnService,
+			signer:       tokenSigner,
+			notification: notificationService,
+			webhook:      webhookService,
+		},
+	}
+}
+
+func (u *acceptUsecase) Accept(ctx context.Context, signedToken string) (string, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/invitation/acceptUsecase.Accept")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return "", duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Accepting invitation by user id: %s", user.ID())
+
+	// Tokens with a bad signature are rejected without touching the database.
+	token, err := u.service.signer.Verify(signedToken)
+	if err != nil {
+		return "", dinvitation.ErrInvalidInvitationToken
+	}
+
+	invitation, err := u.repository.invitation.GetByTokenHash(ctx, dinvitation.HashToken(token))
+	if err != nil {
+		return "", err
+	}
+	if invitation == nil {
+		return "", dinvitation.ErrInvalidInvitationToken
+	}
+
+	page, err := u.repository.page.Get(ctx, invitation.PageID())
+	if err != nil {
+		return "", err
+	}
+	if page == nil {
+		return "", upage.ErrPageNotFound
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := invitation.Accept(page, user, token, ctxtime.Now(ctx)); err != nil {
+			return err
+		}
+		if _, err := u.repository.page.Save(ctx, page); err != nil {
+			return err
+		}
+		_, err := u.repository.invitation.Save(ctx, invitation)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindMemberJoined, Page: page, Actor: user, Count: 1})
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{Type: dwebhook.EventMemberJoined, Page: page, Actor: user})
+	return page.ID(), nil
+}
@@ -0,0 +1,202 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	mockinvitation "github.com/naka-sei/tsudzuri/domain/invitation/mock/mock_invitation"
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/signature"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
+	mocksigner "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_signer"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
+)
+
+func TestAcceptUsecase_Accept(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		invitationRepo *mockinvitation.MockInvitationRepository
+		txn            *mocktxn.MockTransactionService
+		signer         *mocksigner.MockTokenSigner
+		notifier       *mocknotification.MockNotificationService
+		webhook        *mockwebhook.MockWebhookService
+	}
+	type want struct {
+		pageID string
+		err    error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	guestEmail := "guest@example.com"
+	guest := duser.ReconstructUser("3", "user3", "google", &guestEmail)
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, nil)
+	}
+	newInvitation := func(status dinvitation.Status, expiresAt time.Time) *dinvitation.Invitation {
+		return dinvitation.ReconstructInvitation("inv-1", "page-1", "guest@example.com", "1",
+			dinvitation.HashToken("token"), status, expiresAt)
+	}
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), guest), fixedNow)
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).
+					Return(newInvitation(dinvitation.StatusPending, fixedNow.Add(time.Hour)), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						if len(p.InvitedUsers()) != 1 || p.InvitedUsers()[0].ID() != "3" {
+							t.Errorf("expected guest to join the page, got %v", p.InvitedUsers())
+						}
+						return p, nil
+					})
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, inv *dinvitation.Invitation) (*dinvitation.Invitation, error) {
+						if inv.StoredStatus() != dinvitation.StatusAccepted || inv.AcceptedByID() != "3" {
+							t.Errorf("expected invitation accepted by guest, got %s %s", inv.StoredStatus(), inv.AcceptedByID())
+						}
+						return inv, nil
+					})
+				m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *dnotification.Event) {
+					if event.Kind != dnotification.KindMemberJoined || event.Actor.ID() != "3" || event.Page.ID() != "page-1" {
+						t.Errorf("unexpected event: %+v", event)
+					}
+				})
+				m.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *dwebhook.Event) {
+					if event.Type != dwebhook.EventMemberJoined || event.Actor.ID() != "3" || event.Page.ID() != "page-1" {
+						t.Errorf("unexpected webhook event: %+v", event)
+					}
+				})
+			},
+			ctx:  ctx,
+			want: want{pageID: "page-1"},
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "bad_signature",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("", signature.ErrInvalidSignature)
+			},
+			ctx:  ctx,
+			want: want{err: dinvitation.ErrInvalidInvitationToken},
+		},
+		{
+			name: "unknown_token",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).Return(nil, nil)
+			},
+			ctx:  ctx,
+			want: want{err: dinvitation.ErrInvalidInvitationToken},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).
+					Return(newInvitation(dinvitation.StatusPending, fixedNow.Add(time.Hour)), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx:  ctx,
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "already_accepted",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).
+					Return(newInvitation(dinvitation.StatusAccepted, fixedNow.Add(time.Hour)), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			ctx:  ctx,
+			want: want{err: dinvitation.ErrInvitationAlreadyAccepted},
+		},
+		{
+			name: "expired",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).
+					Return(newInvitation(dinvitation.StatusPending, fixedNow.Add(-time.Hour)), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			ctx:  ctx,
+			want: want{err: dinvitation.ErrInvitationExpired},
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.signer.EXPECT().Verify("signed").Return("token", nil)
+				m.invitationRepo.EXPECT().GetByTokenHash(gomock.Any(), dinvitation.HashToken("token")).
+					Return(newInvitation(dinvitation.StatusPending, fixedNow.Add(time.Hour)), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			ctx:  ctx,
+			want: want{err: errors.New("save error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				webhook:        mockwebhook.NewMockWebhookService(ctrl),
+				invitationRepo: mockinvitation.NewMockInvitationRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+				signer:         mocksigner.NewMockTokenSigner(ctrl),
+				notifier:       mocknotification.NewMockNotificationService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewAcceptUsecase(m.pageRepo, m.invitationRepo, m.txn, m.signer, m.notifier, m.webhook)
+			got, err := u.Accept(tt.ctx, "signed")
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.pageID, got); diff != "" {
+				t.Fatalf("Accept() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
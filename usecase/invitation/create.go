@@ -0,0 +1,145 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+
+// AcceptURL is the page of the web app that accepts invitations. The signed token is added as the "token" query parameter.
+type AcceptURL string
+
+type CreateUsecase interface {
+	// Create invites the email address to the page and mails it a signed, single-use invite link.
+	// A pending invitation to the same address is revoked so that only the newest link works.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Create(ctx context.Context, pageID string, email string) (*dinvitation.Invitation, error)
+}
+
+type createUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		invitation dinvitation.InvitationRepository
+	}
+	service struct {
+		txn    service.TransactionService
+		mail   service.MailService
+		signer service.TokenSigner
+	}
+	acceptURL AcceptURL
+}
+
+func NewCreateUsecase(
+	pageRepo dpage.PageRepository,
+	invitationRepo dinvitation.InvitationRepository,
+	txnService service.TransactionService,
+	mailService service.MailService,
+	tokenSigner service.TokenSigner,
+	acceptURL AcceptURL,
+) CreateUsecase {
+	return &createUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			invitation dinvitation.InvitationRepository
+		}{
+			page:       pageRepo,
+			invitation: invitationRepo,
+		},
+		service: struct {
+			txn    service.TransactionService
+			mail   service.MailService
+			signer service.TokenSigner
+		}{
+			txn:    txnService,
+			mail:   mailService,
+			signer: tokenSigner,
+		},
+		acceptURL: acceptURL,
+	}
+}
+
+func (u *createUsecase) Create(ctx context.Context, pageID string, email string) (*dinvitation.Invitation, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/invitation/createUsecase.Create")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Creating invitation for page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	now := ctxtime.Now(ctx)
+	invitation, token, err := dinvitation.NewInvitation(page, user, email, now)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.repository.invitation.ListByPage(ctx, page.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		for _, e := range existing {
+			if e.Email() != invitation.Email() || e.Status(now) != dinvitation.StatusPending {
+				continue
+			}
+			if err := e.Revoke(page, user, now); err != nil {
+				return err
+			}
+			if _, err := u.repository.invitation.Save(ctx, e); err != nil {
+				return err
+			}
+		}
+		invitation, err = u.repository.invitation.Save(ctx, invitation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The mail goes out after the commit so a link is never sent for an invitation that was not stored.
+	if err := u.service.mail.Send(ctx, u.buildMail(page, user, invitation, token)); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (u *createUsecase) buildMail(page *dpage.Page, user *duser.User, invitation *dinvitation.Invitation, token string) service.Mail {
+	inviter := user.Profile().DisplayName()
+	if inviter == "" {
+		inviter = "綴りのメンバー"
+	}
+	link := string(u.acceptURL) + "?token=" + url.QueryEscape(u.service.signer.Sign(token))
+
+	return service.Mail{
+		To:      invitation.Email(),
+		Subject: fmt.Sprintf("「%s」への招待が届いています", page.Title()),
+		Body: fmt.Sprintf(
+			"%sさんから綴り「%s」に招待されました。\n\n下のリンクを開くと参加できます。リンクは一度だけ使用でき、%s まで有効です。\n%s\n\nお心当たりがない場合は、このメールを破棄してください。\n",
+			inviter, page.Title(), invitation.ExpiresAt().Format("2006-01-02 15:04 MST"), link,
+		),
+	}
+}
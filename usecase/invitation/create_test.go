@@ -0,0 +1,201 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	mockinvitation "github.com/naka-sei/tsudzuri/domain/invitation/mock/mock_invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mockmail "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_mail"
+	mocksigner "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_signer"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateUsecase_Create(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		invitationRepo *mockinvitation.MockInvitationRepository
+		txn            *mocktxn.MockTransactionService
+		mail           *mockmail.MockMailService
+		signer         *mocksigner.MockTokenSigner
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		email  string
+	}
+	type want struct {
+		email string
+		err   error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	withCreator := func(u *duser.User) context.Context {
+		return ctxtime.WithTime(ctxuser.WithUser(context.Background(), u), fixedNow)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	saveAsIs := func(_ context.Context, inv *dinvitation.Invitation) (*dinvitation.Invitation, error) {
+		return inv, nil
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return(nil, nil)
+				runTxn(m)
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(saveAsIs)
+				m.signer.EXPECT().Sign(gomock.Any()).Return("signed.token")
+				m.mail.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, mail service.Mail) error {
+					if mail.To != "friend@example.com" {
+						t.Errorf("mail.To = %q", mail.To)
+					}
+					if !strings.Contains(mail.Body, "https://tsudzuri.test/accept?token=signed.token") {
+						t.Errorf("mail.Body does not contain the invite link: %q", mail.Body)
+					}
+					return nil
+				})
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "Friend@Example.com"},
+			want: want{email: "friend@example.com"},
+		},
+		{
+			name: "success_revokes_pending_invitation_to_same_email",
+			setup: func(m *mocks) {
+				pending := dinvitation.ReconstructInvitation("inv-1", "page-1", "friend@example.com", "1", "hash-1",
+					dinvitation.StatusPending, fixedNow.Add(time.Hour))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return([]*dinvitation.Invitation{pending}, nil)
+				runTxn(m)
+				gomock.InOrder(
+					m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, inv *dinvitation.Invitation) (*dinvitation.Invitation, error) {
+							if inv.ID() != "inv-1" || inv.StoredStatus() != dinvitation.StatusRevoked {
+								t.Errorf("expected inv-1 to be revoked, got %s %s", inv.ID(), inv.StoredStatus())
+							}
+							return inv, nil
+						}),
+					m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(saveAsIs),
+				)
+				m.signer.EXPECT().Sign(gomock.Any()).Return("signed.token")
+				m.mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "friend@example.com"},
+			want: want{email: "friend@example.com"},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", email: "friend@example.com"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "friend@example.com"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: withCreator(member), pageID: "page-1", email: "friend@example.com"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "invalid_email",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "not an email"},
+			want: want{err: dinvitation.ErrInvalidEmail},
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return(nil, nil)
+				runTxn(m)
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "friend@example.com"},
+			want: want{err: errors.New("save error")},
+		},
+		{
+			name: "mail_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return(nil, nil)
+				runTxn(m)
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(saveAsIs)
+				m.signer.EXPECT().Sign(gomock.Any()).Return("signed.token")
+				m.mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+			},
+			args: args{ctx: withCreator(creator), pageID: "page-1", email: "friend@example.com"},
+			want: want{err: errors.New("smtp error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				invitationRepo: mockinvitation.NewMockInvitationRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+				mail:           mockmail.NewMockMailService(ctrl),
+				signer:         mocksigner.NewMockTokenSigner(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewCreateUsecase(m.pageRepo, m.invitationRepo, m.txn, m.mail, m.signer, "https://tsudzuri.test/accept")
+			got, err := u.Create(tt.args.ctx, tt.args.pageID, tt.args.email)
+			testutil.EqualErr(t, tt.want.err, err)
+			if tt.want.err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want.email, got.Email()); diff != "" {
+				t.Fatalf("Create() email mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(dinvitation.StatusPending, got.Status(fixedNow)); diff != "" {
+				t.Fatalf("Create() status mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
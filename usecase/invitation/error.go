@@ -0,0 +1,5 @@
+package invitation
+
+import "errors"
+
+var ErrInvitationNotFound = errors.New("invitation not found")
@@ -0,0 +1,66 @@
+package invitation
+
+import (
+	"context"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+
+type ListUsecase interface {
+	// List returns the invitations of the page, newest first. Only the creator of the page can list them.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, pageID string) ([]*dinvitation.Invitation, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		invitation dinvitation.InvitationRepository
+	}
+}
+
+func NewListUsecase(pageRepo dpage.PageRepository, invitationRepo dinvitation.InvitationRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			invitation dinvitation.InvitationRepository
+		}{
+			page:       pageRepo,
+			invitation: invitationRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context, pageID string) ([]*dinvitation.Invitation, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/invitation/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing invitations of page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+	if err := dinvitation.AuthorizeManage(page, user); err != nil {
+		return nil, err
+	}
+
+	return u.repository.invitation.ListByPage(ctx, page.ID())
+}
@@ -0,0 +1,111 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	mockinvitation "github.com/naka-sei/tsudzuri/domain/invitation/mock/mock_invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		invitationRepo *mockinvitation.MockInvitationRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+	type want struct {
+		invitations []*dinvitation.Invitation
+		err         error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	invitations := []*dinvitation.Invitation{
+		dinvitation.ReconstructInvitation("inv-1", "page-1", "friend@example.com", "1", "hash-1", dinvitation.StatusPending, fixedNow),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return(invitations, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1"},
+			want: want{invitations: invitations},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), member), pageID: "page-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().ListByPage(gomock.Any(), "page-1").Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1"},
+			want: want{err: errors.New("list error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				invitationRepo: mockinvitation.NewMockInvitationRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewListUsecase(m.pageRepo, m.invitationRepo)
+			got, err := u.List(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.invitations, got, cmp.AllowUnexported(dinvitation.Invitation{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
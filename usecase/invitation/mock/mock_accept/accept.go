@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./accept.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_accept/accept.go -source=./accept.go -package=mockacceptusecase
+//
+
+// Package mockacceptusecase is a generated GoMock package.
+package mockacceptusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAcceptUsecase is a mock of AcceptUsecase interface.
+type MockAcceptUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAcceptUsecaseMockRecorder is the mock recorder for MockAcceptUsecase.
+type MockAcceptUsecaseMockRecorder struct {
+	mock *MockAcceptUsecase
+}
+
+// NewMockAcceptUsecase creates a new mock instance.
+func NewMockAcceptUsecase(ctrl *gomock.Controller) *MockAcceptUsecase {
+	mock := &MockAcceptUsecase{ctrl: ctrl}
+	mock.recorder = &MockAcceptUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptUsecase) EXPECT() *MockAcceptUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockAcceptUsecase) Accept(ctx context.Context, signedToken string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, signedToken)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockAcceptUsecaseMockRecorder) Accept(ctx, signedToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockAcceptUsecase)(nil).Accept), ctx, signedToken)
+}
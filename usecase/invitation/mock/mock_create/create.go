@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./create.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+//
+
+// Package mockcreateusecase is a generated GoMock package.
+package mockcreateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	invitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateUsecase is a mock of CreateUsecase interface.
+type MockCreateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateUsecaseMockRecorder is the mock recorder for MockCreateUsecase.
+type MockCreateUsecaseMockRecorder struct {
+	mock *MockCreateUsecase
+}
+
+// NewMockCreateUsecase creates a new mock instance.
+func NewMockCreateUsecase(ctrl *gomock.Controller) *MockCreateUsecase {
+	mock := &MockCreateUsecase{ctrl: ctrl}
+	mock.recorder = &MockCreateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUsecase) EXPECT() *MockCreateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateUsecase) Create(ctx context.Context, pageID, email string) (*invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, pageID, email)
+	ret0, _ := ret[0].(*invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateUsecaseMockRecorder) Create(ctx, pageID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateUsecase)(nil).Create), ctx, pageID, email)
+}
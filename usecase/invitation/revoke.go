@@ -0,0 +1,91 @@
+package invitation
+
+import (
+	"context"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_revoke/revoke.go -source=./revoke.go -package=mockrevokeusecase
+
+type RevokeUsecase interface {
+	// Revoke cancels a pending invitation of the page so its link stops working.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Revoke(ctx context.Context, pageID string, invitationID string) error
+}
+
+type revokeUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		invitation dinvitation.InvitationRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewRevokeUsecase(
+	pageRepo dpage.PageRepository,
+	invitationRepo dinvitation.InvitationRepository,
+	txnService service.TransactionService,
+) RevokeUsecase {
+	return &revokeUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			invitation dinvitation.InvitationRepository
+		}{
+			page:       pageRepo,
+			invitation: invitationRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *revokeUsecase) Revoke(ctx context.Context, pageID string, invitationID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/invitation/revokeUsecase.Revoke")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Revoking invitation %s of page %s", invitationID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return upage.ErrPageNotFound
+	}
+
+	invitation, err := u.repository.invitation.Get(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation == nil {
+		return ErrInvitationNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := invitation.Revoke(page, user, ctxtime.Now(ctx)); err != nil {
+			return err
+		}
+		_, err := u.repository.invitation.Save(ctx, invitation)
+		return err
+	})
+}
@@ -0,0 +1,139 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	mockinvitation "github.com/naka-sei/tsudzuri/domain/invitation/mock/mock_invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestRevokeUsecase_Revoke(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		invitationRepo *mockinvitation.MockInvitationRepository
+		txn            *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx          context.Context
+		pageID       string
+		invitationID string
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	pending := func() *dinvitation.Invitation {
+		return dinvitation.ReconstructInvitation("inv-1", "page-1", "friend@example.com", "1", "hash-1",
+			dinvitation.StatusPending, fixedNow.Add(time.Hour))
+	}
+	withUser := func(u *duser.User) context.Context {
+		return ctxtime.WithTime(ctxuser.WithUser(context.Background(), u), fixedNow)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().Get(gomock.Any(), "inv-1").Return(pending(), nil)
+				runTxn(m)
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, inv *dinvitation.Invitation) (*dinvitation.Invitation, error) {
+						if inv.StoredStatus() != dinvitation.StatusRevoked {
+							t.Errorf("status = %s, want revoked", inv.StoredStatus())
+						}
+						return inv, nil
+					})
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", invitationID: "inv-1"},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", invitationID: "inv-1"},
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", invitationID: "inv-1"},
+			err:  upage.ErrPageNotFound,
+		},
+		{
+			name: "invitation_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().Get(gomock.Any(), "inv-1").Return(nil, nil)
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", invitationID: "inv-1"},
+			err:  ErrInvitationNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().Get(gomock.Any(), "inv-1").Return(pending(), nil)
+				runTxn(m)
+			},
+			args: args{ctx: withUser(member), pageID: "page-1", invitationID: "inv-1"},
+			err:  dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.invitationRepo.EXPECT().Get(gomock.Any(), "inv-1").Return(pending(), nil)
+				runTxn(m)
+				m.invitationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", invitationID: "inv-1"},
+			err:  errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				invitationRepo: mockinvitation.NewMockInvitationRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewRevokeUsecase(m.pageRepo, m.invitationRepo, m.txn)
+			err := u.Revoke(tt.args.ctx, tt.args.pageID, tt.args.invitationID)
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}
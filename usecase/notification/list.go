@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+
+type ListUsecase interface {
+	// List returns the notifications of the authenticated user, newest first, and the number of unread ones.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, options ...dnotification.SearchOption) ([]*dnotification.Notification, int, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		notification dnotification.NotificationRepository
+	}
+}
+
+func NewListUsecase(notificationRepo dnotification.NotificationRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			notification dnotification.NotificationRepository
+		}{
+			notification: notificationRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context, options ...dnotification.SearchOption) ([]*dnotification.Notification, int, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, 0, duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Listing notifications for user id: %s", user.ID())
+
+	notifications, err := u.repository.notification.List(ctx, user.ID(), options...)
+	if err != nil {
+		return nil, 0, err
+	}
+	unread, err := u.repository.notification.CountUnread(ctx, user.ID())
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, unread, nil
+}
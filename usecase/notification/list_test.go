@@ -0,0 +1,91 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type want struct {
+		notifications []*dnotification.Notification
+		unread        int
+		err           error
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	notifications := []*dnotification.Notification{
+		dnotification.ReconstructNotification("n-1", "user-1", "user-2", "page-1", dnotification.KindMemberJoined, 1, nil, fixedNow),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocknotification.MockNotificationRepository)
+		ctx   context.Context
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocknotification.MockNotificationRepository) {
+				m.EXPECT().List(gomock.Any(), "user-1", gomock.Any()).Return(notifications, nil)
+				m.EXPECT().CountUnread(gomock.Any(), "user-1").Return(4, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{notifications: notifications, unread: 4},
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocknotification.MockNotificationRepository) {
+				m.EXPECT().List(gomock.Any(), "user-1", gomock.Any()).Return(nil, errors.New("list error"))
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "count_error",
+			setup: func(m *mocknotification.MockNotificationRepository) {
+				m.EXPECT().List(gomock.Any(), "user-1", gomock.Any()).Return(notifications, nil)
+				m.EXPECT().CountUnread(gomock.Any(), "user-1").Return(0, errors.New("count error"))
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: want{err: errors.New("count error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocknotification.NewMockNotificationRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			u := NewListUsecase(repo)
+			got, unread, err := u.List(tt.ctx, dnotification.WithUnreadOnly(true))
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.notifications, got, cmp.AllowUnexported(dnotification.Notification{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+			if unread != tt.want.unread {
+				t.Fatalf("List() unread = %d, want %d", unread, tt.want.unread)
+			}
+		})
+	}
+}
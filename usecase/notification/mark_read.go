@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_mark_read/mark_read.go -source=./mark_read.go -package=mockmarkreadusecase
+
+type MarkReadUsecase interface {
+	// MarkRead marks the given notifications of the authenticated user as read. Empty ids marks all of them.
+	// Notifications of other users are left untouched.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	MarkRead(ctx context.Context, ids []string) error
+}
+
+type markReadUsecase struct {
+	repository struct {
+		notification dnotification.NotificationRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewMarkReadUsecase(
+	notificationRepo dnotification.NotificationRepository,
+	txnService service.TransactionService,
+) MarkReadUsecase {
+	return &markReadUsecase{
+		repository: struct {
+			notification dnotification.NotificationRepository
+		}{
+			notification: notificationRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *markReadUsecase) MarkRead(ctx context.Context, ids []string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/markReadUsecase.MarkRead")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Marking %d notifications read for user id: %s", len(ids), user.ID())
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		return u.repository.notification.MarkRead(ctx, user.ID(), ids, ctxtime.Now(ctx))
+	})
+}
@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestMarkReadUsecase_MarkRead(t *testing.T) {
+	type mocks struct {
+		notificationRepo *mocknotification.MockNotificationRepository
+		txn              *mocktxn.MockTransactionService
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), fixedNow)
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		ids   []string
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.notificationRepo.EXPECT().MarkRead(gomock.Any(), "user-1", []string{"n-1", "n-2"}, fixedNow).Return(nil)
+			},
+			ctx: ctx,
+			ids: []string{"n-1", "n-2"},
+		},
+		{
+			name: "success_all",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.notificationRepo.EXPECT().MarkRead(gomock.Any(), "user-1", gomock.Nil(), fixedNow).Return(nil)
+			},
+			ctx: ctx,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.notificationRepo.EXPECT().MarkRead(gomock.Any(), "user-1", gomock.Any(), fixedNow).Return(errors.New("mark error"))
+			},
+			ctx: ctx,
+			ids: []string{"n-1"},
+			err: errors.New("mark error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				notificationRepo: mocknotification.NewMockNotificationRepository(ctrl),
+				txn:              mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			err := NewMarkReadUsecase(m.notificationRepo, m.txn).MarkRead(tt.ctx, tt.ids)
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./mark_read.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_mark_read/mark_read.go -source=./mark_read.go -package=mockmarkreadusecase
+//
+
+// Package mockmarkreadusecase is a generated GoMock package.
+package mockmarkreadusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMarkReadUsecase is a mock of MarkReadUsecase interface.
+type MockMarkReadUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarkReadUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMarkReadUsecaseMockRecorder is the mock recorder for MockMarkReadUsecase.
+type MockMarkReadUsecaseMockRecorder struct {
+	mock *MockMarkReadUsecase
+}
+
+// NewMockMarkReadUsecase creates a new mock instance.
+func NewMockMarkReadUsecase(ctrl *gomock.Controller) *MockMarkReadUsecase {
+	mock := &MockMarkReadUsecase{ctrl: ctrl}
+	mock.recorder = &MockMarkReadUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkReadUsecase) EXPECT() *MockMarkReadUsecaseMockRecorder {
+	return m.recorder
+}
+
+// MarkRead mocks base method.
+func (m *MockMarkReadUsecase) MarkRead(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockMarkReadUsecaseMockRecorder) MarkRead(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockMarkReadUsecase)(nil).MarkRead), ctx, ids)
+}
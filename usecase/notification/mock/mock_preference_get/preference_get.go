@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./preference_get.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_preference_get/preference_get.go -source=./preference_get.go -package=mockpreferencegetusecase
+//
+
+// Package mockpreferencegetusecase is a generated GoMock package.
+package mockpreferencegetusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	notification "github.com/naka-sei/tsudzuri/domain/notification"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPreferenceGetUsecase is a mock of PreferenceGetUsecase interface.
+type MockPreferenceGetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceGetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPreferenceGetUsecaseMockRecorder is the mock recorder for MockPreferenceGetUsecase.
+type MockPreferenceGetUsecaseMockRecorder struct {
+	mock *MockPreferenceGetUsecase
+}
+
+// NewMockPreferenceGetUsecase creates a new mock instance.
+func NewMockPreferenceGetUsecase(ctrl *gomock.Controller) *MockPreferenceGetUsecase {
+	mock := &MockPreferenceGetUsecase{ctrl: ctrl}
+	mock.recorder = &MockPreferenceGetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceGetUsecase) EXPECT() *MockPreferenceGetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetPreferences mocks base method.
+func (m *MockPreferenceGetUsecase) GetPreferences(ctx context.Context) (*notification.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx)
+	ret0, _ := ret[0].(*notification.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockPreferenceGetUsecaseMockRecorder) GetPreferences(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockPreferenceGetUsecase)(nil).GetPreferences), ctx)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./preference_update.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_preference_update/preference_update.go -source=./preference_update.go -package=mockpreferenceupdateusecase
+//
+
+// Package mockpreferenceupdateusecase is a generated GoMock package.
+package mockpreferenceupdateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	notification "github.com/naka-sei/tsudzuri/domain/notification"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPreferenceUpdateUsecase is a mock of PreferenceUpdateUsecase interface.
+type MockPreferenceUpdateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceUpdateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPreferenceUpdateUsecaseMockRecorder is the mock recorder for MockPreferenceUpdateUsecase.
+type MockPreferenceUpdateUsecaseMockRecorder struct {
+	mock *MockPreferenceUpdateUsecase
+}
+
+// NewMockPreferenceUpdateUsecase creates a new mock instance.
+func NewMockPreferenceUpdateUsecase(ctrl *gomock.Controller) *MockPreferenceUpdateUsecase {
+	mock := &MockPreferenceUpdateUsecase{ctrl: ctrl}
+	mock.recorder = &MockPreferenceUpdateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceUpdateUsecase) EXPECT() *MockPreferenceUpdateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockPreferenceUpdateUsecase) UpdatePreferences(ctx context.Context, settings map[notification.Kind]bool) (*notification.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, settings)
+	ret0, _ := ret[0].(*notification.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockPreferenceUpdateUsecaseMockRecorder) UpdatePreferences(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockPreferenceUpdateUsecase)(nil).UpdatePreferences), ctx, settings)
+}
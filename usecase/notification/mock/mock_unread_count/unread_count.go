@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./unread_count.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_unread_count/unread_count.go -source=./unread_count.go -package=mockunreadcountusecase
+//
+
+// Package mockunreadcountusecase is a generated GoMock package.
+package mockunreadcountusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnreadCountUsecase is a mock of UnreadCountUsecase interface.
+type MockUnreadCountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnreadCountUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnreadCountUsecaseMockRecorder is the mock recorder for MockUnreadCountUsecase.
+type MockUnreadCountUsecaseMockRecorder struct {
+	mock *MockUnreadCountUsecase
+}
+
+// NewMockUnreadCountUsecase creates a new mock instance.
+func NewMockUnreadCountUsecase(ctrl *gomock.Controller) *MockUnreadCountUsecase {
+	mock := &MockUnreadCountUsecase{ctrl: ctrl}
+	mock.recorder = &MockUnreadCountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnreadCountUsecase) EXPECT() *MockUnreadCountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UnreadCount mocks base method.
+func (m *MockUnreadCountUsecase) UnreadCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadCount", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadCount indicates an expected call of UnreadCount.
+func (mr *MockUnreadCountUsecaseMockRecorder) UnreadCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadCount", reflect.TypeOf((*MockUnreadCountUsecase)(nil).UnreadCount), ctx)
+}
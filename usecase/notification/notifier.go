@@ -0,0 +1,123 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type notifier struct {
+	repository struct {
+		notification dnotification.NotificationRepository
+		preference   dnotification.PreferenceRepository
+	}
+	service struct {
+		push service.PushService
+	}
+}
+
+// NewNotifier creates the NotificationService that fans events out to the inboxes of the page members.
+// Members who muted the kind of the event are skipped. The notified members also get a push on their devices.
+func NewNotifier(
+	notificationRepo dnotification.NotificationRepository,
+	preferenceRepo dnotification.PreferenceRepository,
+	pushService service.PushService,
+) service.NotificationService {
+	return &notifier{
+		repository: struct {
+			notification dnotification.NotificationRepository
+			preference   dnotification.PreferenceRepository
+		}{
+			notification: notificationRepo,
+			preference:   preferenceRepo,
+		},
+		service: struct{ push service.PushService }{push: pushService},
+	}
+}
+
+func (n *notifier) Notify(ctx context.Context, event *dnotification.Event) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/notifier.Notify")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	if err := n.notify(ctx, event); err != nil {
+		l.Sugar().Errorf("failed to notify page members kind=%s: %v", event.Kind, err)
+	}
+}
+
+func (n *notifier) notify(ctx context.Context, event *dnotification.Event) error {
+	notifications, err := dnotification.NewNotifications(event, ctxtime.Now(ctx))
+	if err != nil {
+		return err
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	recipientIDs := make([]string, 0, len(notifications))
+	for _, nt := range notifications {
+		recipientIDs = append(recipientIDs, nt.RecipientID())
+	}
+	preferences, err := n.repository.preference.ListByUserIDs(ctx, recipientIDs)
+	if err != nil {
+		return err
+	}
+	enabled := make(map[string]bool, len(preferences))
+	for _, p := range preferences {
+		enabled[p.UserID()] = p.Enabled(event.Kind)
+	}
+
+	wanted := notifications[:0]
+	for _, nt := range notifications {
+		if e, ok := enabled[nt.RecipientID()]; ok && !e {
+			continue
+		}
+		wanted = append(wanted, nt)
+	}
+
+	log.LoggerFromContext(ctx).Sugar().Infof("Notifying %d members of page %s kind=%s", len(wanted), event.Page.ID(), event.Kind)
+	if err := n.repository.notification.Create(ctx, wanted); err != nil {
+		return err
+	}
+
+	if len(wanted) > 0 {
+		userIDs := make([]string, 0, len(wanted))
+		for _, nt := range wanted {
+			userIDs = append(userIDs, nt.RecipientID())
+		}
+		n.service.push.Push(ctx, userIDs, pushMessage(event))
+	}
+	return nil
+}
+
+// pushMessage describes the event for a push notification. The page title is the title of the push.
+func pushMessage(event *dnotification.Event) service.PushMessage {
+	actor := event.Actor.Profile().DisplayName()
+	if actor == "" {
+		actor = "メンバー"
+	}
+
+	var body string
+	switch event.Kind {
+	case dnotification.KindMemberJoined:
+		body = fmt.Sprintf("%sさんが参加しました", actor)
+	case dnotification.KindLinksAdded:
+		body = fmt.Sprintf("%sさんがリンクを%d件追加しました", actor, event.Count)
+	case dnotification.KindPageArchived:
+		body = fmt.Sprintf("%sさんが綴りをアーカイブしました", actor)
+	}
+
+	return service.PushMessage{
+		Title: event.Page.Title(),
+		Body:  body,
+		Data: map[string]string{
+			"kind":    string(event.Kind),
+			"page_id": event.Page.ID(),
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mockpush "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_push"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNotifier_Notify(t *testing.T) {
+	type mocks struct {
+		notificationRepo *mocknotification.MockNotificationRepository
+		preferenceRepo   *mocknotification.MockPreferenceRepository
+		push             *mockpush.MockPushService
+	}
+
+	creator := duser.ReconstructUser("creator", "uid-c", "google", nil)
+	member1 := duser.ReconstructUser("member-1", "uid-1", "anonymous", nil, duser.WithProfile(duser.ReconstructProfile("Alice", "", "")))
+	member2 := duser.ReconstructUser("member-2", "uid-2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member1, member2})
+	event := &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: member1, Count: 2}
+	notificationFor := func(recipientID string) *dnotification.Notification {
+		return dnotification.ReconstructNotification("", recipientID, "member-1", "page-1", dnotification.KindLinksAdded, 2, nil, fixedNow)
+	}
+	push := service.PushMessage{
+		Title: "Test Page",
+		Body:  "Aliceさんがリンクを2件追加しました",
+		Data:  map[string]string{"kind": "links_added", "page_id": "page-1"},
+	}
+	assertCreated := func(want ...*dnotification.Notification) func(context.Context, []*dnotification.Notification) error {
+		return func(_ context.Context, got []*dnotification.Notification) error {
+			if diff := cmp.Diff(want, got, cmp.AllowUnexported(dnotification.Notification{})); diff != "" {
+				t.Errorf("created notifications mismatch (-want +got):\n%s", diff)
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name  string
+		event *dnotification.Event
+		setup func(m *mocks)
+	}{
+		{
+			name:  "fans_out_to_other_members",
+			event: event,
+			setup: func(m *mocks) {
+				m.preferenceRepo.EXPECT().ListByUserIDs(gomock.Any(), []string{"creator", "member-2"}).Return([]*dnotification.Preferences{
+					dnotification.ReconstructPreferences("creator", nil),
+					dnotification.ReconstructPreferences("member-2", []dnotification.Kind{dnotification.KindPageArchived}),
+				}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(assertCreated(notificationFor("creator"), notificationFor("member-2")))
+				m.push.EXPECT().Push(gomock.Any(), []string{"creator", "member-2"}, push)
+			},
+		},
+		{
+			name:  "skips_members_who_muted_the_kind",
+			event: event,
+			setup: func(m *mocks) {
+				m.preferenceRepo.EXPECT().ListByUserIDs(gomock.Any(), []string{"creator", "member-2"}).Return([]*dnotification.Preferences{
+					dnotification.ReconstructPreferences("creator", []dnotification.Kind{dnotification.KindLinksAdded}),
+					dnotification.ReconstructPreferences("member-2", nil),
+				}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(assertCreated(notificationFor("member-2")))
+				m.push.EXPECT().Push(gomock.Any(), []string{"member-2"}, push)
+			},
+		},
+		{
+			name: "no_other_members",
+			event: &dnotification.Event{
+				Kind:  dnotification.KindLinksAdded,
+				Page:  dpage.ReconstructPage("page-2", "Solo", *creator, "invite-code", nil, nil),
+				Actor: creator,
+				Count: 1,
+			},
+		},
+		{
+			name: "everyone_muted_skips_push",
+			event: &dnotification.Event{
+				Kind:  dnotification.KindMemberJoined,
+				Page:  dpage.ReconstructPage("page-3", "Pair", *creator, "invite-code", nil, duser.Users{member1}),
+				Actor: member1,
+				Count: 1,
+			},
+			setup: func(m *mocks) {
+				m.preferenceRepo.EXPECT().ListByUserIDs(gomock.Any(), []string{"creator"}).Return([]*dnotification.Preferences{
+					dnotification.ReconstructPreferences("creator", []dnotification.Kind{dnotification.KindMemberJoined}),
+				}, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Len(0)).Return(nil)
+			},
+		},
+		{
+			name:  "creation_error_skips_push",
+			event: event,
+			setup: func(m *mocks) {
+				m.preferenceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.notificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("create error"))
+			},
+		},
+		{
+			name:  "invalid_event_is_only_logged",
+			event: &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: member1},
+		},
+		{
+			name:  "repository_error_is_only_logged",
+			event: event,
+			setup: func(m *mocks) {
+				m.preferenceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("list error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				notificationRepo: mocknotification.NewMockNotificationRepository(ctrl),
+				preferenceRepo:   mocknotification.NewMockPreferenceRepository(ctrl),
+				push:             mockpush.NewMockPushService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			n := NewNotifier(m.notificationRepo, m.preferenceRepo, m.push)
+			n.Notify(ctxtime.WithTime(context.Background(), fixedNow), tt.event)
+		})
+	}
+}
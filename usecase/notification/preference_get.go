@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_preference_get/preference_get.go -source=./preference_get.go -package=mockpreferencegetusecase
+
+type PreferenceGetUsecase interface {
+	// GetPreferences returns the notification preferences of the authenticated user.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	GetPreferences(ctx context.Context) (*dnotification.Preferences, error)
+}
+
+type preferenceGetUsecase struct {
+	repository struct {
+		preference dnotification.PreferenceRepository
+	}
+}
+
+func NewPreferenceGetUsecase(preferenceRepo dnotification.PreferenceRepository) PreferenceGetUsecase {
+	return &preferenceGetUsecase{
+		repository: struct {
+			preference dnotification.PreferenceRepository
+		}{
+			preference: preferenceRepo,
+		},
+	}
+}
+
+func (u *preferenceGetUsecase) GetPreferences(ctx context.Context) (*dnotification.Preferences, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/preferenceGetUsecase.GetPreferences")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Getting notification preferences for user id: %s", user.ID())
+
+	return u.repository.preference.Get(ctx, user.ID())
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestPreferenceGetUsecase_GetPreferences(t *testing.T) {
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	preferences := dnotification.ReconstructPreferences("user-1", []dnotification.Kind{dnotification.KindLinksAdded})
+
+	tests := []struct {
+		name  string
+		setup func(m *mocknotification.MockPreferenceRepository)
+		ctx   context.Context
+		want  *dnotification.Preferences
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocknotification.MockPreferenceRepository) {
+				m.EXPECT().Get(gomock.Any(), "user-1").Return(preferences, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: preferences,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocknotification.MockPreferenceRepository) {
+				m.EXPECT().Get(gomock.Any(), "user-1").Return(nil, errors.New("get error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+			err: errors.New("get error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocknotification.NewMockPreferenceRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			got, err := NewPreferenceGetUsecase(repo).GetPreferences(tt.ctx)
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dnotification.Preferences{})); diff != "" {
+				t.Fatalf("GetPreferences() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_preference_update/preference_update.go -source=./preference_update.go -package=mockpreferenceupdateusecase
+
+type PreferenceUpdateUsecase interface {
+	// UpdatePreferences enables or mutes the given notification kinds for the authenticated user and returns
+	// the updated preferences. Kinds not in settings keep their setting.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	UpdatePreferences(ctx context.Context, settings map[dnotification.Kind]bool) (*dnotification.Preferences, error)
+}
+
+type preferenceUpdateUsecase struct {
+	repository struct {
+		preference dnotification.PreferenceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewPreferenceUpdateUsecase(
+	preferenceRepo dnotification.PreferenceRepository,
+	txnService service.TransactionService,
+) PreferenceUpdateUsecase {
+	return &preferenceUpdateUsecase{
+		repository: struct {
+			preference dnotification.PreferenceRepository
+		}{
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *preferenceUpdateUsecase) UpdatePreferences(ctx context.Context, settings map[dnotification.Kind]bool) (*dnotification.Preferences, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/preferenceUpdateUsecase.UpdatePreferences")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Updating notification preferences for user id: %s", user.ID())
+
+	for kind := range settings {
+		if !kind.Valid() {
+			return nil, dnotification.ErrInvalidKind
+		}
+	}
+
+	var preferences *dnotification.Preferences
+	err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		preferences, err = u.repository.preference.Get(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		for kind, enabled := range settings {
+			if err := preferences.Set(user, kind, enabled); err != nil {
+				return err
+			}
+		}
+		return u.repository.preference.Save(ctx, preferences)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestPreferenceUpdateUsecase_UpdatePreferences(t *testing.T) {
+	type mocks struct {
+		preferenceRepo *mocknotification.MockPreferenceRepository
+		txn            *mocktxn.MockTransactionService
+	}
+
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+	ctx := ctxuser.WithUser(context.Background(), user)
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(m *mocks)
+		ctx      context.Context
+		settings map[dnotification.Kind]bool
+		want     []dnotification.Kind
+		err      error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "user-1").
+					Return(dnotification.ReconstructPreferences("user-1", []dnotification.Kind{dnotification.KindMemberJoined}), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			ctx: ctx,
+			settings: map[dnotification.Kind]bool{
+				dnotification.KindMemberJoined: true,
+				dnotification.KindLinksAdded:   false,
+			},
+			want: []dnotification.Kind{dnotification.KindLinksAdded},
+		},
+		{
+			name:     "user_not_found_in_context",
+			ctx:      context.Background(),
+			settings: map[dnotification.Kind]bool{dnotification.KindLinksAdded: false},
+			err:      duser.ErrUserNotFound,
+		},
+		{
+			name:     "invalid_kind",
+			ctx:      ctx,
+			settings: map[dnotification.Kind]bool{"role_changed": false},
+			err:      dnotification.ErrInvalidKind,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "user-1").Return(dnotification.ReconstructPreferences("user-1", nil), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+			},
+			ctx:      ctx,
+			settings: map[dnotification.Kind]bool{dnotification.KindLinksAdded: false},
+			err:      errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				preferenceRepo: mocknotification.NewMockPreferenceRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			got, err := NewPreferenceUpdateUsecase(m.preferenceRepo, m.txn).UpdatePreferences(tt.ctx, tt.settings)
+			testutil.EqualErr(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got.Muted()); diff != "" {
+				t.Fatalf("UpdatePreferences() muted mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// pushQueueSize is the number of pushes waiting for delivery before new ones are dropped.
+	pushQueueSize = 256
+	// pushMaxAttempts is the number of times a batch is sent before the failing tokens are given up.
+	pushMaxAttempts = 3
+	// pushBaseBackoff is the wait before the first retry. It doubles with every further attempt.
+	pushBaseBackoff = time.Second
+)
+
+type pushJob struct {
+	userIDs []string
+	message service.PushMessage
+}
+
+// PushWorker delivers push notifications to the devices of users in the background.
+// Pushes are queued by Push and sent while Run is running; pushes still queued when Run returns are lost.
+type PushWorker struct {
+	repository struct {
+		device ddevice.DeviceRepository
+	}
+	service struct {
+		sender service.PushSender
+	}
+	queue       chan pushJob
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewPushWorker creates a PushWorker sending through the sender.
+func NewPushWorker(deviceRepo ddevice.DeviceRepository, sender service.PushSender) *PushWorker {
+	return &PushWorker{
+		repository:  struct{ device ddevice.DeviceRepository }{device: deviceRepo},
+		service:     struct{ sender service.PushSender }{sender: sender},
+		queue:       make(chan pushJob, pushQueueSize),
+		maxAttempts: pushMaxAttempts,
+		backoff:     pushBaseBackoff,
+	}
+}
+
+// Push queues the message for the devices of the users. It never blocks: when the queue is full the message is dropped.
+func (w *PushWorker) Push(ctx context.Context, userIDs []string, message service.PushMessage) {
+	if len(userIDs) == 0 {
+		return
+	}
+	select {
+	case w.queue <- pushJob{userIDs: userIDs, message: message}:
+	default:
+		log.LoggerFromContext(ctx).Sugar().Warnf("Push queue is full, dropping push to %d users", len(userIDs))
+	}
+}
+
+// Run delivers queued pushes one by one until ctx is done.
+func (w *PushWorker) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-w.queue:
+			w.deliver(ctx, job)
+		}
+	}
+}
+
+func (w *PushWorker) deliver(ctx context.Context, job pushJob) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/PushWorker.deliver")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	devices, err := w.repository.device.ListByUserIDs(ctx, job.userIDs)
+	if err != nil {
+		l.Sugar().Errorf("failed to list devices for push: %v", err)
+		return
+	}
+	if len(devices) == 0 {
+		return
+	}
+
+	tokens := make([]string, 0, len(devices))
+	for _, d := range devices {
+		tokens = append(tokens, d.Token())
+	}
+
+	size := w.service.sender.MaxBatchSize()
+	if size <= 0 {
+		size = len(tokens)
+	}
+	var invalid []string
+	for start := 0; start < len(tokens); start += size {
+		end := min(start+size, len(tokens))
+		invalid = append(invalid, w.sendBatch(ctx, tokens[start:end], job.message)...)
+	}
+
+	if len(invalid) > 0 {
+		if err := w.repository.device.DeleteByTokens(ctx, invalid); err != nil {
+			l.Sugar().Errorf("failed to remove %d invalid device tokens: %v", len(invalid), err)
+			return
+		}
+		l.Sugar().Infof("Removed %d invalid device tokens", len(invalid))
+	}
+	l.Sugar().Infof("Push delivered to %d devices of %d users", len(tokens)-len(invalid), len(job.userIDs))
+}
+
+// sendBatch sends the message to the tokens and retries the ones that failed with a growing backoff.
+// It returns the tokens the sender reported as invalid.
+func (w *PushWorker) sendBatch(ctx context.Context, tokens []string, message service.PushMessage) []string {
+	l := log.LoggerFromContext(ctx)
+
+	var invalid []string
+	pending := tokens
+	for attempt := 1; ; attempt++ {
+		results, err := w.service.sender.Send(ctx, pending, message)
+		if err == nil && len(results) != len(pending) {
+			err = errors.New("push sender returned a result count different from the token count")
+		}
+
+		var retry []string
+		if err != nil {
+			l.Sugar().Warnf("failed to send push batch attempt=%d: %v", attempt, err)
+			retry = pending
+		} else {
+			for i, token := range pending {
+				switch {
+				case results[i] == nil:
+				case errors.Is(results[i], service.ErrInvalidPushToken):
+					invalid = append(invalid, token)
+				default:
+					retry = append(retry, token)
+				}
+			}
+		}
+
+		if len(retry) == 0 {
+			return invalid
+		}
+		if attempt >= w.maxAttempts {
+			l.Sugar().Errorf("giving up push to %d devices after %d attempts", len(retry), attempt)
+			return invalid
+		}
+
+		timer := time.NewTimer(w.backoff << (attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return invalid
+		case <-timer.C:
+		}
+		pending = retry
+	}
+}
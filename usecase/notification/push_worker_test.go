@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	ddevice "github.com/naka-sei/tsudzuri/domain/device"
+	mockdevice "github.com/naka-sei/tsudzuri/domain/device/mock/mock_device"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mockpush "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_push"
+)
+
+func TestPushWorker_deliver(t *testing.T) {
+	type mocks struct {
+		deviceRepo *mockdevice.MockDeviceRepository
+		sender     *mockpush.MockPushSender
+	}
+
+	message := service.PushMessage{Title: "Test Page", Body: "body"}
+	devices := []*ddevice.Device{
+		ddevice.ReconstructDevice("d-1", "user-1", "token-1", ddevice.PlatformIOS, fixedNow),
+		ddevice.ReconstructDevice("d-2", "user-1", "token-2", ddevice.PlatformAndroid, fixedNow),
+		ddevice.ReconstructDevice("d-3", "user-2", "token-3", ddevice.PlatformWeb, fixedNow),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+	}{
+		{
+			name: "sends_in_batches",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), []string{"user-1", "user-2"}).Return(devices, nil)
+				m.sender.EXPECT().MaxBatchSize().Return(2)
+				gomock.InOrder(
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-1", "token-2"}, message).Return([]error{nil, nil}, nil),
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-3"}, message).Return([]error{nil}, nil),
+				)
+			},
+		},
+		{
+			name: "retries_failed_tokens_and_removes_invalid_ones",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(devices, nil)
+				m.sender.EXPECT().MaxBatchSize().Return(500)
+				gomock.InOrder(
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-1", "token-2", "token-3"}, message).
+						Return([]error{nil, service.ErrInvalidPushToken, errors.New("unavailable")}, nil),
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-3"}, message).Return([]error{nil}, nil),
+				)
+				m.deviceRepo.EXPECT().DeleteByTokens(gomock.Any(), []string{"token-2"}).Return(nil)
+			},
+		},
+		{
+			name: "gives_up_after_max_attempts",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(devices[:1], nil)
+				m.sender.EXPECT().MaxBatchSize().Return(500)
+				m.sender.EXPECT().Send(gomock.Any(), []string{"token-1"}, message).Return(nil, errors.New("send error")).Times(pushMaxAttempts)
+			},
+		},
+		{
+			name: "mismatched_result_count_is_retried",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(devices[:2], nil)
+				m.sender.EXPECT().MaxBatchSize().Return(500)
+				gomock.InOrder(
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-1", "token-2"}, message).Return([]error{nil}, nil),
+					m.sender.EXPECT().Send(gomock.Any(), []string{"token-1", "token-2"}, message).Return([]error{nil, nil}, nil),
+				)
+			},
+		},
+		{
+			name: "no_devices",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocks) {
+				m.deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("list error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				deviceRepo: mockdevice.NewMockDeviceRepository(ctrl),
+				sender:     mockpush.NewMockPushSender(ctrl),
+			}
+			tt.setup(m)
+
+			w := NewPushWorker(m.deviceRepo, m.sender)
+			w.backoff = time.Millisecond
+			w.deliver(context.Background(), pushJob{userIDs: []string{"user-1", "user-2"}, message: message})
+		})
+	}
+}
+
+func TestPushWorker_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deviceRepo := mockdevice.NewMockDeviceRepository(ctrl)
+	sender := mockpush.NewMockPushSender(ctrl)
+	message := service.PushMessage{Title: "Test Page", Body: "body"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deviceRepo.EXPECT().ListByUserIDs(gomock.Any(), []string{"user-1"}).
+		Return([]*ddevice.Device{ddevice.ReconstructDevice("d-1", "user-1", "token-1", ddevice.PlatformIOS, fixedNow)}, nil)
+	sender.EXPECT().MaxBatchSize().Return(500)
+	sender.EXPECT().Send(gomock.Any(), []string{"token-1"}, message).
+		DoAndReturn(func(context.Context, []string, service.PushMessage) ([]error, error) {
+			cancel()
+			return []error{nil}, nil
+		})
+
+	w := NewPushWorker(deviceRepo, sender)
+	// An empty push is not queued.
+	w.Push(ctx, nil, message)
+	w.Push(ctx, []string{"user-1"}, message)
+
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was cancelled")
+	}
+}
+
+func TestPushWorker_Push_dropsWhenFull(t *testing.T) {
+	w := NewPushWorker(nil, nil)
+	for range pushQueueSize + 1 {
+		w.Push(context.Background(), []string{"user-1"}, service.PushMessage{})
+	}
+	if got := len(w.queue); got != pushQueueSize {
+		t.Fatalf("queued pushes = %d, want %d", got, pushQueueSize)
+	}
+}
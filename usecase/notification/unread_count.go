@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_unread_count/unread_count.go -source=./unread_count.go -package=mockunreadcountusecase
+
+type UnreadCountUsecase interface {
+	// UnreadCount returns the number of unread notifications of the authenticated user.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	UnreadCount(ctx context.Context) (int, error)
+}
+
+type unreadCountUsecase struct {
+	repository struct {
+		notification dnotification.NotificationRepository
+	}
+}
+
+func NewUnreadCountUsecase(notificationRepo dnotification.NotificationRepository) UnreadCountUsecase {
+	return &unreadCountUsecase{
+		repository: struct {
+			notification dnotification.NotificationRepository
+		}{
+			notification: notificationRepo,
+		},
+	}
+}
+
+func (u *unreadCountUsecase) UnreadCount(ctx context.Context) (int, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/notification/unreadCountUsecase.UnreadCount")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return 0, duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Counting unread notifications for user id: %s", user.ID())
+
+	return u.repository.notification.CountUnread(ctx, user.ID())
+}
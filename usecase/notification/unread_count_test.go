@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	mocknotification "github.com/naka-sei/tsudzuri/domain/notification/mock/mock_notification"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestUnreadCountUsecase_UnreadCount(t *testing.T) {
+	user := duser.ReconstructUser("user-1", "uid-1", "google", nil)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocknotification.MockNotificationRepository)
+		ctx   context.Context
+		want  int
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocknotification.MockNotificationRepository) {
+				m.EXPECT().CountUnread(gomock.Any(), "user-1").Return(3, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), user),
+			want: 3,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocknotification.MockNotificationRepository) {
+				m.EXPECT().CountUnread(gomock.Any(), "user-1").Return(0, errors.New("count error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), user),
+			err: errors.New("count error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocknotification.NewMockNotificationRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			got, err := NewUnreadCountUsecase(repo).UnreadCount(tt.ctx)
+			testutil.EqualErr(t, tt.err, err)
+			if got != tt.want {
+				t.Fatalf("UnreadCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
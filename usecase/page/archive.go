@@ -0,0 +1,90 @@
+package page
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_archive/archive.go -source=./archive.go -package=mockarchiveusecase
+type ArchiveUsecase interface {
+	// Archive freezes the page as read-only and returns the page. Only the creator can archive the page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Archive(ctx context.Context, pageID string) (*dpage.Page, error)
+}
+
+type archiveUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn          service.TransactionService
+		notification service.NotificationService
+	}
+}
+
+func NewArchiveUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+	notificationService service.NotificationService,
+) ArchiveUsecase {
+	return &archiveUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn          service.TransactionService
+			notification service.NotificationService
+		}{
+			txn:          txnService,
+			notification: notificationService,
+		},
+	}
+}
+
+func (u *archiveUsecase) Archive(ctx context.Context, pageID string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/archiveUsecase.Archive")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Archiving page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	wasArchived := page.IsArchived()
+	var saved *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.Archive(user); err != nil {
+			return err
+		}
+		saved, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !wasArchived {
+		u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindPageArchived, Page: page, Actor: user, Count: 1})
+	}
+	return saved, nil
+}
@@ -0,0 +1,150 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestArchiveUsecase_Archive(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+		notifier *mocknotification.MockNotificationService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	newPage := func(opts ...dpage.ReconstructOption) *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{memberUser}, opts...)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Page
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithArchived(false)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), newPage(dpage.WithArchived(true))).Return(newPage(dpage.WithArchived(true)), nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), &dnotification.Event{
+					Kind: dnotification.KindPageArchived, Page: newPage(dpage.WithArchived(true)), Actor: creatorUser, Count: 1,
+				})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			want: newPage(dpage.WithArchived(true)),
+		},
+		{
+			name: "already_archived_does_not_notify",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithArchived(true)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), newPage(dpage.WithArchived(true))).Return(newPage(dpage.WithArchived(true)), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			want: newPage(dpage.WithArchived(true)),
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithArchived(false)), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithArchived(false)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+				notifier: mocknotification.NewMockNotificationService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewArchiveUsecase(m.pageRepo, m.txn, m.notifier)
+			got, err := u.Archive(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Errorf("Archive() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
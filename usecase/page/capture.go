@@ -0,0 +1,169 @@
+package page
+
+import (
+	"context"
+	"strings"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// inboxPageTitle is the title of the inbox page created on the first capture.
+const inboxPageTitle = "受信箱"
+
+type CaptureUsecaseInput struct {
+	URL string
+	// Title is the title of the captured web page, if known.
+	Title string
+	// Text is the text selected when capturing, if any.
+	Text string
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_capture/capture.go -source=./capture.go -package=mockcaptureusecase
+type CaptureUsecase interface {
+	// Capture adds the link to the user's inbox page, creating the page and making it the inbox if the user
+	// has none or can no longer add links to it. It returns the inbox page and whether the link was added,
+	// which is false when the URL was captured recently. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Capture(ctx context.Context, input CaptureUsecaseInput) (*dpage.Page, bool, error)
+}
+
+type captureUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		preference dpage.PreferenceRepository
+	}
+	service struct {
+		txn          service.TransactionService
+		notification service.NotificationService
+		webhook      service.WebhookService
+	}
+}
+
+func NewCaptureUsecase(
+	pageRepo dpage.PageRepository,
+	preferenceRepo dpage.PreferenceRepository,
+	txnService service.TransactionService,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
+) CaptureUsecase {
+	return &captureUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			preference dpage.PreferenceRepository
+		}{
+			page:       pageRepo,
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn          service.TransactionService
+			notification service.NotificationService
+			webhook      service.WebhookService
+		}{
+			txn:          txnService,
+			notification: notificationService,
+			webhook:      webhookService,
+		},
+	}
+}
+
+func (u *captureUsecase) Capture(ctx context.Context, input CaptureUsecaseInput) (*dpage.Page, bool, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/captureUsecase.Capture")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Capturing link: url=%s", input.URL)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, false, duser.ErrUserNotFound
+	}
+
+	url := strings.TrimSpace(input.URL)
+	memo := captureMemo(input.Title, input.Text)
+
+	var (
+		page  *dpage.Page
+		added bool
+	)
+	err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		page, err = u.inbox(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		added, err = page.Capture(user, url, memo, ctxtime.Now(ctx))
+		if err != nil || !added {
+			return err
+		}
+		page, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if added {
+		u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: user, Count: 1})
+		u.service.webhook.Dispatch(ctx, &dwebhook.Event{
+			Type:  dwebhook.EventLinkAdded,
+			Page:  page,
+			Actor: user,
+			Links: addedLinks(page, 1),
+		})
+	}
+	return page, added, nil
+}
+
+// inbox returns the user's inbox page. A new inbox page is created when the user has none, or when the
+// current one was deleted, archived or left by the user.
+func (u *captureUsecase) inbox(ctx context.Context, user *duser.User) (*dpage.Page, error) {
+	preferences, err := u.repository.preference.Get(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if id := preferences.InboxPageID(); id != "" {
+		page, err := u.repository.page.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if page != nil && !page.IsArchived() && page.Authorize(user) == nil {
+			return page, nil
+		}
+	}
+
+	page, err := dpage.NewPage(inboxPageTitle, user)
+	if err != nil {
+		return nil, err
+	}
+	page, err = u.repository.page.Save(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := preferences.SetInbox(user, page); err != nil {
+		return nil, err
+	}
+	if err := u.repository.preference.Save(ctx, preferences); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// captureMemo builds the memo of a captured link from the page title and the selected text.
+func captureMemo(title string, text string) string {
+	var parts []string
+	for _, s := range []string{title, text} {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,218 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
+)
+
+func TestCaptureUsecase_Capture(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		preferenceRepo *mockpage.MockPreferenceRepository
+		txn            *mocktxn.MockTransactionService
+		notifier       *mocknotification.MockNotificationService
+		webhook        *mockwebhook.MockWebhookService
+	}
+	type want struct {
+		pageID string
+		added  bool
+		err    error
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	user := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), user), now)
+
+	newInbox := func(options ...dpage.ReconstructOption) *dpage.Page {
+		return dpage.ReconstructPage("inbox-1", "受信箱", *user, "invite-code", dpage.Links{}, nil, options...)
+	}
+	recentInbox := func() *dpage.Page {
+		// A link without a creation time counts as just added.
+		links := dpage.Links{dpage.NewLink("https://example.com/recent", "")}
+		return dpage.ReconstructPage("inbox-1", "受信箱", *user, "invite-code", links, nil)
+	}
+	inboxPreferences := func() *dpage.Preferences {
+		return dpage.ReconstructPreferences("1", []dpage.Preference{dpage.ReconstructPreference("inbox-1", false, 0, true)})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	expectEvents := func(m *mocks, url string, memo string) {
+		m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, e *dnotification.Event) {
+				if e.Kind != dnotification.KindLinksAdded || e.Count != 1 || e.Actor != user {
+					t.Errorf("unexpected notification event: %+v", e)
+				}
+			})
+		m.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, e *dwebhook.Event) {
+				if e.Type != dwebhook.EventLinkAdded || len(e.Links) != 1 || e.Links[0].ID() != "link-new" || e.Links[0].URL() != url || e.Links[0].Memo() != memo {
+					t.Errorf("unexpected webhook event: %+v", e)
+				}
+			})
+	}
+	// saveCapture expects the inbox page to be saved and stores the captured link as "link-new".
+	saveCapture := func(m *mocks) {
+		m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+				links := slices.Clone(p.Links())
+				last := links[len(links)-1]
+				links[len(links)-1] = dpage.ReconstructLink(last.URL(), last.Memo(), last.Priority(), dpage.WithLinkID("link-new"))
+				return dpage.ReconstructPage(p.ID(), p.Title(), *user, "invite-code", links, nil), nil
+			})
+	}
+	// saveNewInbox expects the inbox page to be created as "inbox-2" and made the inbox.
+	saveNewInbox := func(m *mocks, preferences *dpage.Preferences) {
+		m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+				if p.Title() != "受信箱" || p.ID() != "" {
+					t.Errorf("unexpected new inbox page: title=%q id=%q", p.Title(), p.ID())
+				}
+				return dpage.ReconstructPage("inbox-2", p.Title(), *user, "invite-code", dpage.Links{}, nil), nil
+			})
+		m.preferenceRepo.EXPECT().Save(gomock.Any(), preferences).Return(nil)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		input CaptureUsecaseInput
+		want  want
+	}{
+		{
+			name: "adds_to_current_inbox",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(inboxPreferences(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "inbox-1").Return(newInbox(), nil)
+				saveCapture(m)
+				expectEvents(m, "https://example.com", "Example\nselected text")
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: " https://example.com ", Title: "Example", Text: " selected text "},
+			want:  want{pageID: "inbox-1", added: true},
+		},
+		{
+			name: "recently_captured_url",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(inboxPreferences(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "inbox-1").Return(recentInbox(), nil)
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: "https://example.com/recent"},
+			want:  want{pageID: "inbox-1", added: false},
+		},
+		{
+			name: "creates_inbox_on_first_capture",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(dpage.ReconstructPreferences("1", nil), nil)
+				saveNewInbox(m, dpage.ReconstructPreferences("1", []dpage.Preference{dpage.ReconstructPreference("inbox-2", false, 0, true)}))
+				saveCapture(m)
+				expectEvents(m, "https://example.com", "")
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: "https://example.com"},
+			want:  want{pageID: "inbox-2", added: true},
+		},
+		{
+			name: "replaces_archived_inbox",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(inboxPreferences(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "inbox-1").Return(newInbox(dpage.WithArchived(true)), nil)
+				saveNewInbox(m, dpage.ReconstructPreferences("1", []dpage.Preference{
+					dpage.ReconstructPreference("inbox-1", false, 0, false),
+					dpage.ReconstructPreference("inbox-2", false, 0, true),
+				}))
+				saveCapture(m)
+				expectEvents(m, "https://example.com", "")
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: "https://example.com"},
+			want:  want{pageID: "inbox-2", added: true},
+		},
+		{
+			name: "invalid_url",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(inboxPreferences(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "inbox-1").Return(newInbox(), nil)
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: "not a url"},
+			want:  want{err: dpage.ErrInvalidCaptureURL},
+		},
+		{
+			name: "preference_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, errors.New("get error"))
+			},
+			ctx:   ctx,
+			input: CaptureUsecaseInput{URL: "https://example.com"},
+			want:  want{err: errors.New("get error")},
+		},
+		{
+			name:  "user_not_found_in_context",
+			ctx:   context.Background(),
+			input: CaptureUsecaseInput{URL: "https://example.com"},
+			want:  want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				preferenceRepo: mockpage.NewMockPreferenceRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+				notifier:       mocknotification.NewMockNotificationService(ctrl),
+				webhook:        mockwebhook.NewMockWebhookService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			u := NewCaptureUsecase(m.pageRepo, m.preferenceRepo, m.txn, m.notifier, m.webhook)
+			page, added, err := u.Capture(tt.ctx, tt.input)
+			testutil.EqualErr(t, tt.want.err, err)
+			if tt.want.err != nil {
+				return
+			}
+			if page.ID() != tt.want.pageID {
+				t.Fatalf("Capture() page ID = %q, want %q", page.ID(), tt.want.pageID)
+			}
+			if added != tt.want.added {
+				t.Fatalf("Capture() added = %v, want %v", added, tt.want.added)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_checklist_set/checklist_set.go -source=./checklist_set.go -package=mockchecklistsetusecase
+type ChecklistSetUsecase interface {
+	// SetChecklist turns checklist mode of the page on or off and returns the page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	SetChecklist(ctx context.Context, pageID string, enabled bool) (*dpage.Page, error)
+}
+
+type checklistSetUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewChecklistSetUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) ChecklistSetUsecase {
+	return &checklistSetUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *checklistSetUsecase) SetChecklist(ctx context.Context, pageID string, enabled bool) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/checklistSetUsecase.SetChecklist")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Setting checklist mode of page %s to %t", pageID, enabled)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var saved *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.SetChecklist(user, enabled); err != nil {
+			return err
+		}
+		saved, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
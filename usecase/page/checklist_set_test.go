@@ -0,0 +1,136 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestChecklistSetUsecase_SetChecklist(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx     context.Context
+		pageID  string
+		enabled bool
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	newPage := func(opts ...dpage.ReconstructOption) *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{memberUser}, opts...)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Page
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), newPage(dpage.WithChecklist(true))).Return(newPage(dpage.WithChecklist(true)), nil)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:  "1",
+				enabled: true,
+			},
+			want: newPage(dpage.WithChecklist(true)),
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:     context.Background(),
+				pageID:  "1",
+				enabled: true,
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:  "1",
+				enabled: true,
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), memberUser),
+				pageID:  "1",
+				enabled: true,
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithChecklist(true)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:  "1",
+				enabled: false,
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewChecklistSetUsecase(m.pageRepo, m.txn)
+			got, err := u.SetChecklist(tt.args.ctx, tt.args.pageID, tt.args.enabled)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Errorf("SetChecklist() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
@@ -13,13 +14,16 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
 type CreateUsecase interface {
-	// Create creates a new page. The user is obtained from context via pkg/ctx/user.UserFromContext.
-	Create(ctx context.Context, title string) (*dpage.Page, error)
+	// Create creates a new page. When templateID is set, the page starts with the template's links and
+	// an empty title falls back to the template title.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Create(ctx context.Context, title string, templateID string) (*dpage.Page, error)
 }
 
 type createUsecase struct {
 	repository struct {
-		page dpage.PageRepository
+		page     dpage.PageRepository
+		template dtemplate.TemplateRepository
 	}
 	service struct {
 		txn service.TransactionService
@@ -28,13 +32,16 @@ type createUsecase struct {
 
 func NewCreateUsecase(
 	pageRepo dpage.PageRepository,
+	templateRepo dtemplate.TemplateRepository,
 	txnService service.TransactionService,
 ) CreateUsecase {
 	u := &createUsecase{
 		repository: struct {
-			page dpage.PageRepository
+			page     dpage.PageRepository
+			template dtemplate.TemplateRepository
 		}{
-			page: pageRepo,
+			page:     pageRepo,
+			template: templateRepo,
 		},
 		service: struct {
 			txn service.TransactionService
@@ -46,19 +53,19 @@ func NewCreateUsecase(
 }
 
 // Create creates a new page.
-func (u *createUsecase) Create(ctx context.Context, title string) (*dpage.Page, error) {
+func (u *createUsecase) Create(ctx context.Context, title string, templateID string) (*dpage.Page, error) {
 	ctx, end := trace.StartSpan(ctx, "usecase/page/createUsecase.Create")
 	defer end()
 
 	l := log.LoggerFromContext(ctx)
-	l.Sugar().Infof("Creating a new page with title: %s", title)
+	l.Sugar().Infof("Creating a new page with title: %s template: %s", title, templateID)
 
 	user, ok := ctxuser.UserFromContext(ctx)
 	if !ok {
 		return nil, duser.ErrUserNotFound
 	}
 
-	page, err := dpage.NewPage(title, user)
+	page, err := u.newPage(ctx, user, title, templateID)
 	if err != nil {
 		return nil, err
 	}
@@ -73,3 +80,19 @@ func (u *createUsecase) Create(ctx context.Context, title string) (*dpage.Page,
 
 	return page, nil
 }
+
+func (u *createUsecase) newPage(ctx context.Context, user *duser.User, title string, templateID string) (*dpage.Page, error) {
+	if templateID == "" {
+		return dpage.NewPage(title, user)
+	}
+
+	template, err := u.repository.template.Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, ErrTemplateNotFound
+	}
+
+	return template.NewPage(user, title)
+}
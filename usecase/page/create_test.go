@@ -11,6 +11,8 @@ import (
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	mocktemplate "github.com/naka-sei/tsudzuri/domain/template/mock/mock_template"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
@@ -19,12 +21,14 @@ import (
 
 func TestCreateUsecase_Create(t *testing.T) {
 	type fields struct {
-		pageRepo   *mockpage.MockPageRepository
-		txnService *mocktransaction.MockTransactionService
+		pageRepo     *mockpage.MockPageRepository
+		templateRepo *mocktemplate.MockTemplateRepository
+		txnService   *mocktransaction.MockTransactionService
 	}
 	type args struct {
-		ctx   context.Context
-		title string
+		ctx        context.Context
+		title      string
+		templateID string
 	}
 	type want struct {
 		page *dpage.Page
@@ -32,6 +36,13 @@ func TestCreateUsecase_Create(t *testing.T) {
 	}
 
 	user := duser.NewUser("uid-1")
+	other := duser.ReconstructUser("other-id", "uid-2", "anonymous", nil)
+	// Links copied from a template are read for the user who created the page.
+	readByUser := dpage.LinkStates{dpage.ReconstructLinkState(user.ID(), dpage.LinkStatusRead)}
+	tmpl := dtemplate.ReconstructTemplate("template-1", "template-title", *user, dtemplate.VisibilityPrivate, dpage.Links{
+		dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+	}, nil)
 
 	tests := []struct {
 		name  string
@@ -65,6 +76,59 @@ func TestCreateUsecase_Create(t *testing.T) {
 				err:  nil,
 			},
 		},
+		{
+			name: "success_from_template",
+			setup: func(f *fields) {
+				f.templateRepo.EXPECT().Get(gomock.Any(), "template-1").Return(tmpl, nil)
+				f.txnService.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				f.pageRepo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&dpage.Page{})).DoAndReturn(
+					func(ctx context.Context, pg *dpage.Page) (*dpage.Page, error) {
+						return pg, nil
+					},
+				)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), user),
+				templateID: "template-1",
+			},
+			want: want{
+				page: dpage.ReconstructPage("", "template-title", *user, "", dpage.Links{
+					dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkStates(readByUser)),
+					dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkStates(readByUser)),
+				}, nil),
+			},
+		},
+		{
+			name: "template_not_found",
+			setup: func(f *fields) {
+				f.templateRepo.EXPECT().Get(gomock.Any(), "template-1").Return(nil, nil)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), user),
+				templateID: "template-1",
+			},
+			want: want{
+				err: ErrTemplateNotFound,
+			},
+		},
+		{
+			name: "template_not_accessible",
+			setup: func(f *fields) {
+				f.templateRepo.EXPECT().Get(gomock.Any(), "template-1").Return(tmpl, nil)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), other),
+				title:      "title",
+				templateID: "template-1",
+			},
+			want: want{
+				err: dtemplate.ErrTemplateNotAccessible,
+			},
+		},
 		{
 			name: "page_save_error",
 			setup: func(f *fields) {
@@ -107,19 +171,20 @@ func TestCreateUsecase_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			f := &fields{
-				pageRepo:   mockpage.NewMockPageRepository(ctrl),
-				txnService: mocktransaction.NewMockTransactionService(ctrl),
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				templateRepo: mocktemplate.NewMockTemplateRepository(ctrl),
+				txnService:   mocktransaction.NewMockTransactionService(ctrl),
 			}
 			if tt.setup != nil {
 				tt.setup(f)
 			}
-			u := NewCreateUsecase(f.pageRepo, f.txnService)
-			got, err := u.Create(tt.args.ctx, tt.args.title)
+			u := NewCreateUsecase(f.pageRepo, f.templateRepo, f.txnService)
+			got, err := u.Create(tt.args.ctx, tt.args.title, tt.args.templateID)
 			if tt.want.page != nil {
 				if got == nil {
 					t.Fatalf("expected page, got nil")
 				}
-				if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(dpage.Page{}, duser.User{}), cmpopts.IgnoreFields(dpage.Page{}, "inviteCode")); diff != "" {
+				if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, dpage.LinkState{}, duser.User{}, duser.Profile{}), cmpopts.IgnoreFields(dpage.Page{}, "inviteCode", "slug")); diff != "" {
 					t.Errorf("page mismatch (-want +got):\n%s", diff)
 				}
 			} else if got != nil {
@@ -5,6 +5,7 @@ import (
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/pkg/trace"
@@ -22,13 +23,15 @@ type deleteUsecase struct {
 		page dpage.PageRepository
 	}
 	service struct {
-		txn service.TransactionService
+		txn     service.TransactionService
+		webhook service.WebhookService
 	}
 }
 
 func NewDeleteUsecase(
 	pageRepo dpage.PageRepository,
 	txnService service.TransactionService,
+	webhookService service.WebhookService,
 ) DeleteUsecase {
 	u := &deleteUsecase{
 		repository: struct {
@@ -37,9 +40,11 @@ func NewDeleteUsecase(
 			page: pageRepo,
 		},
 		service: struct {
-			txn service.TransactionService
+			txn     service.TransactionService
+			webhook service.WebhookService
 		}{
-			txn: txnService,
+			txn:     txnService,
+			webhook: webhookService,
 		},
 	}
 	return u
@@ -71,7 +76,17 @@ func (u *deleteUsecase) Delete(ctx context.Context, pageID string) error {
 		return err
 	}
 
-	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+	// The webhooks of the page are deleted with it, so they are looked up before the page goes away and only
+	// told once the deletion was committed.
+	dispatch := u.service.webhook.Prepare(ctx, &dwebhook.Event{Type: dwebhook.EventPageDeleted, Page: page, Actor: user})
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		return u.repository.page.DeleteByID(ctx, pageID)
 	})
+	if err != nil {
+		return err
+	}
+
+	dispatch(ctx)
+	return nil
 }
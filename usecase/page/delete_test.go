@@ -10,22 +10,28 @@ import (
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
 	mocktransaction "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
 )
 
 func TestDeleteUsecase_Delete(t *testing.T) {
 	type mocks struct {
 		pageRepo   *mockpage.MockPageRepository
 		txnService *mocktransaction.MockTransactionService
+		webhook    *mockwebhook.MockWebhookService
+		// dispatched reports whether the prepared page.deleted event was queued.
+		dispatched bool
 	}
 	type args struct {
 		ctx    context.Context
 		pageID string
 	}
 	type want struct {
-		err error
+		err        error
+		dispatched bool
 	}
 
 	user := duser.ReconstructUser("user-id-1", "uid-1", "anonymous", nil)
@@ -47,6 +53,7 @@ func TestDeleteUsecase_Delete(t *testing.T) {
 				)
 				p1 := dpage.ReconstructPage("page-1", "t", *user, "invite", dpage.Links{}, duser.Users{})
 				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(p1, nil)
+				m.webhook.EXPECT().Prepare(gomock.Any(), &dwebhook.Event{Type: dwebhook.EventPageDeleted, Page: p1, Actor: user}).Return(func(context.Context) { m.dispatched = true })
 				m.pageRepo.EXPECT().DeleteByID(gomock.Any(), "page-1").Return(nil)
 			},
 			args: args{
@@ -54,7 +61,8 @@ func TestDeleteUsecase_Delete(t *testing.T) {
 				pageID: "page-1",
 			},
 			want: want{
-				err: nil,
+				err:        nil,
+				dispatched: true,
 			},
 		},
 		{
@@ -81,6 +89,7 @@ func TestDeleteUsecase_Delete(t *testing.T) {
 				)
 				p3 := dpage.ReconstructPage("page-2", "t", *user, "invite", dpage.Links{}, duser.Users{})
 				m.pageRepo.EXPECT().Get(gomock.Any(), "page-2").Return(p3, nil)
+				m.webhook.EXPECT().Prepare(gomock.Any(), &dwebhook.Event{Type: dwebhook.EventPageDeleted, Page: p3, Actor: user}).Return(func(context.Context) { m.dispatched = true })
 				m.pageRepo.EXPECT().DeleteByID(gomock.Any(), "page-2").Return(errors.New("delete error"))
 			},
 			args: args{
@@ -96,6 +105,7 @@ func TestDeleteUsecase_Delete(t *testing.T) {
 			setup: func(m *mocks) {
 				p4 := dpage.ReconstructPage("page-3", "t", *user, "invite", dpage.Links{}, duser.Users{})
 				m.pageRepo.EXPECT().Get(gomock.Any(), "page-3").Return(p4, nil)
+				m.webhook.EXPECT().Prepare(gomock.Any(), &dwebhook.Event{Type: dwebhook.EventPageDeleted, Page: p4, Actor: user}).Return(func(context.Context) { m.dispatched = true })
 				m.txnService.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(errors.New("txn error"))
 			},
 			args: args{
@@ -116,14 +126,18 @@ func TestDeleteUsecase_Delete(t *testing.T) {
 			t.Parallel()
 			m := &mocks{
 				pageRepo:   mockpage.NewMockPageRepository(ctrl),
+				webhook:    mockwebhook.NewMockWebhookService(ctrl),
 				txnService: mocktransaction.NewMockTransactionService(ctrl),
 			}
 			if tt.setup != nil {
 				tt.setup(m)
 			}
-			u := NewDeleteUsecase(m.pageRepo, m.txnService)
+			u := NewDeleteUsecase(m.pageRepo, m.txnService, m.webhook)
 			err := u.Delete(tt.args.ctx, tt.args.pageID)
 			testutil.EqualErr(t, tt.want.err, err)
+			if m.dispatched != tt.want.dispatched {
+				t.Fatalf("dispatched = %v, want %v", m.dispatched, tt.want.dispatched)
+			}
 		})
 	}
 }
@@ -0,0 +1,86 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_duplicate/duplicate.go -source=./duplicate.go -package=mockduplicateusecase
+type DuplicateUsecase interface {
+	// Duplicate copies a page the user can access into a new page owned by the user and returns the new page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Duplicate(ctx context.Context, pageID string) (*dpage.Page, error)
+}
+
+type duplicateUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewDuplicateUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) DuplicateUsecase {
+	return &duplicateUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *duplicateUsecase) Duplicate(ctx context.Context, pageID string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/duplicateUsecase.Duplicate")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Duplicating page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	source, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var duplicated *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		page, err := source.Duplicate(user)
+		if err != nil {
+			return err
+		}
+		saved, err := u.repository.page.Save(ctx, page)
+		if err != nil {
+			return err
+		}
+		duplicated = saved
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return duplicated, nil
+}
@@ -0,0 +1,158 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestDuplicateUsecase_Duplicate(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invitedUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+	errSave := errors.New("save error")
+
+	newSource := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Onboarding", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+			dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("link-b")),
+		}, duser.Users{invitedUser})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(m *mocks)
+		args     args
+		wantPage string
+		wantErr  error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newSource(), nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						if p.ID() != "" {
+							t.Errorf("duplicated page should be new, got id %q", p.ID())
+						}
+						if p.SourcePageID() != "1" {
+							t.Errorf("source page id = %q, want %q", p.SourcePageID(), "1")
+						}
+						if p.CreatedBy().ID() != invitedUser.ID() {
+							t.Errorf("duplicated page should be owned by the caller")
+						}
+						if len(p.InvitedUsers()) != 0 {
+							t.Errorf("duplicated page should not have invited users")
+						}
+						if len(p.Links()) != 2 || p.Links()[0].URL() != "https://a.com" || p.Links()[0].ID() != "" {
+							t.Errorf("unexpected links: %+v", p.Links())
+						}
+						return dpage.ReconstructPage("10", p.Title(), *p.CreatedBy(), p.InviteCode(p.CreatedBy()), p.Links(), nil, dpage.WithSourcePageID(p.SourcePageID())), nil
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), invitedUser),
+				pageID: "1",
+			},
+			wantPage: "10",
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "unauthorized",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newSource(), nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), otherUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newSource(), nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errSave)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: errSave,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewDuplicateUsecase(m.pageRepo, m.txn)
+			got, err := u.Duplicate(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+			if got == nil || got.ID() != tt.wantPage {
+				t.Fatalf("unexpected page: %+v", got)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package page
 
 import (
 	"context"
+	"slices"
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/pkg/trace"
@@ -13,8 +15,33 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_edit/edit.go -source=./edit.go -package=mockeditusecase
 type EditUsecase interface {
-	// Edit edits a page by its ID. The user is obtained from context via pkg/ctx/user.UserFromContext.
-	Edit(ctx context.Context, pageID string, title string, links dpage.Links) error
+	// Edit edits a page by its ID. The description, icon and color are only changed when given as options.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Edit(ctx context.Context, pageID string, title string, links dpage.Links, options ...EditOption) error
+}
+
+// EditOption changes an optional detail of the page in Edit.
+type EditOption func(*editOptions)
+
+type editOptions struct {
+	description *string
+	icon        *string
+	color       *string
+}
+
+// WithDescription replaces the Markdown description. An empty description clears it.
+func WithDescription(description string) EditOption {
+	return func(o *editOptions) { o.description = &description }
+}
+
+// WithIcon replaces the emoji icon. An empty icon clears it.
+func WithIcon(icon string) EditOption {
+	return func(o *editOptions) { o.icon = &icon }
+}
+
+// WithColor replaces the accent color. An empty color clears it.
+func WithColor(color string) EditOption {
+	return func(o *editOptions) { o.color = &color }
 }
 
 type editUsecase struct {
@@ -22,20 +49,24 @@ type editUsecase struct {
 		page dpage.PageRepository
 	}
 	service struct {
-		txn service.TransactionService
+		txn     service.TransactionService
+		webhook service.WebhookService
 	}
 }
 
-func NewEditUsecase(pageRepo dpage.PageRepository, txn service.TransactionService) EditUsecase {
+func NewEditUsecase(pageRepo dpage.PageRepository, txn service.TransactionService, webhook service.WebhookService) EditUsecase {
 	u := &editUsecase{
 		repository: struct{ page dpage.PageRepository }{page: pageRepo},
-		service:    struct{ txn service.TransactionService }{txn: txn},
+		service: struct {
+			txn     service.TransactionService
+			webhook service.WebhookService
+		}{txn: txn, webhook: webhook},
 	}
 	return u
 }
 
 // Edit edits a page.
-func (u *editUsecase) Edit(ctx context.Context, pageID string, title string, links dpage.Links) error {
+func (u *editUsecase) Edit(ctx context.Context, pageID string, title string, links dpage.Links, options ...EditOption) error {
 	ctx, end := trace.StartSpan(ctx, "usecase/page/editUsecase.Edit")
 	defer end()
 
@@ -56,11 +87,40 @@ func (u *editUsecase) Edit(ctx context.Context, pageID string, title string, lin
 		return duser.ErrUserNotFound
 	}
 
-	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+	var o editOptions
+	for _, opt := range options {
+		opt(&o)
+	}
+
+	before := slices.Clone(page.Links())
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		if err := page.Edit(user, title, links); err != nil {
 			return err
 		}
+		if o.description != nil || o.icon != nil || o.color != nil {
+			description, icon, color := page.Description(), page.Icon(), page.Color()
+			if o.description != nil {
+				description = *o.description
+			}
+			if o.icon != nil {
+				icon = *o.icon
+			}
+			if o.color != nil {
+				color = *o.color
+			}
+			if err := page.EditDetails(user, description, icon, color); err != nil {
+				return err
+			}
+		}
 		_, err = u.repository.page.Save(ctx, page)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	if edited := dwebhook.EditedLinks(before, page.Links()); len(edited) > 0 {
+		u.service.webhook.Dispatch(ctx, &dwebhook.Event{Type: dwebhook.EventLinkEdited, Page: page, Actor: user, Links: edited})
+	}
+	return nil
 }
@@ -5,26 +5,31 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
 	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
 )
 
 func TestEditUsecase_Edit(t *testing.T) {
 	type mocks struct {
 		pageRepo *mockpage.MockPageRepository
 		txn      *mocktxn.MockTransactionService
+		webhook  *mockwebhook.MockWebhookService
 	}
 	type args struct {
-		ctx    context.Context
-		pageID string
-		title  string
-		links  dpage.Links
+		ctx     context.Context
+		pageID  string
+		title   string
+		links   dpage.Links
+		options []EditOption
 	}
 	type want struct {
 		err error
@@ -133,6 +138,87 @@ func TestEditUsecase_Edit(t *testing.T) {
 			},
 			want: want{err: dpage.ErrNotCreatedByUser},
 		},
+		{
+			name: "success_dispatches_edited_links",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("page-1", "t1", *user, "invite", dpage.Links{
+					dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+					dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("l2")),
+					dpage.ReconstructLink("https://c.com", "C", 3, dpage.WithLinkID("l3")),
+				}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+				)
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(page, nil)
+				m.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *dwebhook.Event) {
+					want := dpage.Links{
+						dpage.ReconstructLink("https://b.com", "B", 1, dpage.WithLinkID("l2")),
+						dpage.ReconstructLink("https://a.com", "new memo", 2, dpage.WithLinkID("l1")),
+					}
+					if event.Type != dwebhook.EventLinkEdited || event.Actor != user {
+						t.Errorf("unexpected webhook event: %+v", event)
+					}
+					if diff := cmp.Diff(want, event.Links, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+						t.Errorf("edited links mismatch (-want +got):\n%s", diff)
+					}
+				})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), user),
+				pageID: "page-1",
+				title:  "t1",
+				links: dpage.Links{
+					dpage.ReconstructLink("https://b.com", "B", 1),
+					dpage.ReconstructLink("https://a.com", "new memo", 2),
+					dpage.ReconstructLink("https://c.com", "C", 3),
+				},
+			},
+			want: want{err: nil},
+		},
+		{
+			name: "success_with_details",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("page-1", "t1", *user, "invite", dpage.Links{}, duser.Users{}, dpage.WithDetails("old", "📚", "#123456"))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+				)
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+					// The icon was not given and keeps its value.
+					if p.Description() != "**new**" || p.Icon() != "📚" || p.Color() != "" {
+						t.Errorf("details = %q %q %q, want %q %q %q", p.Description(), p.Icon(), p.Color(), "**new**", "📚", "")
+					}
+					return p, nil
+				})
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), user),
+				pageID:  "page-1",
+				title:   "new title",
+				links:   dpage.Links{},
+				options: []EditOption{WithDescription("**new**"), WithColor("")},
+			},
+			want: want{err: nil},
+		},
+		{
+			name: "invalid_color",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("page-1", "t1", *user, "invite", dpage.Links{}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+				)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), user),
+				pageID:  "page-1",
+				title:   "new title",
+				links:   dpage.Links{},
+				options: []EditOption{WithIcon("🏝️"), WithColor("red")},
+			},
+			want: want{err: dpage.ErrInvalidColor},
+		},
 		{
 			name: "save_error",
 			setup: func(m *mocks) {
@@ -161,13 +247,14 @@ func TestEditUsecase_Edit(t *testing.T) {
 			t.Parallel()
 			m := &mocks{
 				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				webhook:  mockwebhook.NewMockWebhookService(ctrl),
 				txn:      mocktxn.NewMockTransactionService(ctrl),
 			}
 			if tt.setup != nil {
 				tt.setup(m)
 			}
-			u := NewEditUsecase(m.pageRepo, m.txn)
-			err := u.Edit(tt.args.ctx, tt.args.pageID, tt.args.title, tt.args.links)
+			u := NewEditUsecase(m.pageRepo, m.txn, m.webhook)
+			err := u.Edit(tt.args.ctx, tt.args.pageID, tt.args.title, tt.args.links, tt.args.options...)
 			testutil.EqualErr(t, tt.want.err, err)
 		})
 	}
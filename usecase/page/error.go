@@ -2,4 +2,9 @@ package page
 
 import "fmt"
 
-var ErrPageNotFound = fmt.Errorf("page not found")
+var (
+	ErrPageNotFound            = fmt.Errorf("page not found")
+	ErrUnsupportedExportFormat = fmt.Errorf("unsupported export format")
+	ErrTemplateNotFound        = fmt.Errorf("template not found")
+	ErrUnsupportedQRCodeFormat = fmt.Errorf("unsupported QR code format")
+)
@@ -0,0 +1,109 @@
+package page
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/page/export"
+)
+
+// DefaultExportFormat is used when no export format is specified.
+const DefaultExportFormat = "markdown"
+
+type ExportUsecaseOutput struct {
+	ContentType string
+	Content     []byte
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_export/export.go -source=./export.go -package=mockexportusecase
+type ExportUsecase interface {
+	// Export renders the page in the given format. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Export(ctx context.Context, pageID string, format string) (*ExportUsecaseOutput, error)
+}
+
+type exportUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	formatters *export.Registry
+}
+
+func NewExportUsecase(pageRepo dpage.PageRepository, formatters *export.Registry) ExportUsecase {
+	return &exportUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		formatters: formatters,
+	}
+}
+
+func (u *exportUsecase) Export(ctx context.Context, pageID string, format string) (*ExportUsecaseOutput, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/exportUsecase.Export")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Exporting page id: %s format: %s", pageID, format)
+
+	if format == "" {
+		format = DefaultExportFormat
+	}
+	formatter, ok := u.formatters.Lookup(format)
+	if !ok {
+		return nil, ErrUnsupportedExportFormat
+	}
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := formatter.Format(&buf, toExportDocument(page)); err != nil {
+		return nil, err
+	}
+
+	return &ExportUsecaseOutput{
+		ContentType: formatter.ContentType(),
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// toExportDocument converts the page into an export document with links in priority order.
+func toExportDocument(p *dpage.Page) *export.Document {
+	links := slices.Clone(p.Links())
+	slices.SortStableFunc(links, func(a, b dpage.Link) int {
+		return a.Priority() - b.Priority()
+	})
+
+	doc := &export.Document{
+		Title: p.Title(),
+		Links: make([]export.Link, 0, len(links)),
+	}
+	for _, l := range links {
+		doc.Links = append(doc.Links, export.Link{
+			URL:  l.URL(),
+			Memo: l.Memo(),
+		})
+	}
+	return doc
+}
@@ -0,0 +1,77 @@
+package export
+
+import (
+	"io"
+	"slices"
+	"strings"
+)
+
+// Document is a format-agnostic representation of a page to be exported.
+type Document struct {
+	Title string
+	Links []Link
+}
+
+// Link is a single exported link. Links are rendered in slice order.
+type Link struct {
+	URL  string
+	Memo string
+}
+
+// Formatter renders a Document into a specific export format.
+type Formatter interface {
+	// Name returns the identifier used to select the formatter (e.g. "markdown").
+	Name() string
+	// ContentType returns the MIME type of the rendered output.
+	ContentType() string
+	// Format writes the rendered document to w.
+	Format(w io.Writer, doc *Document) error
+}
+
+// Registry holds the available formatters keyed by name.
+type Registry struct {
+	formatters map[string]Formatter
+}
+
+// NewRegistry creates a registry with the given formatters.
+func NewRegistry(formatters ...Formatter) *Registry {
+	r := &Registry{formatters: make(map[string]Formatter, len(formatters))}
+	for _, f := range formatters {
+		r.Register(f)
+	}
+	return r
+}
+
+// NewDefaultRegistry creates a registry with all built-in formatters.
+func NewDefaultRegistry() *Registry {
+	return NewRegistry(
+		NewMarkdownFormatter(),
+		NewHTMLFormatter(),
+		NewJSONFormatter(),
+		NewOPMLFormatter(),
+	)
+}
+
+// Register adds the formatter, replacing any formatter registered with the same name.
+func (r *Registry) Register(f Formatter) {
+	if f == nil {
+		return
+	}
+	r.formatters[strings.ToLower(f.Name())] = f
+}
+
+// Lookup returns the formatter registered with the given name. The lookup is case-insensitive.
+func (r *Registry) Lookup(name string) (Formatter, bool) {
+	f, ok := r.formatters[strings.ToLower(name)]
+	return f, ok
+}
+
+// Names returns the registered formatter names in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.formatters))
+	for name := range r.formatters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
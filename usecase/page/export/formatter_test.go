@@ -0,0 +1,133 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormatters_Format(t *testing.T) {
+	doc := &Document{
+		Title: "Trip <2026>",
+		Links: []Link{
+			{URL: "https://example.com/a", Memo: "hotel & *spa*"},
+			{URL: "https://example.com/b"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		formatter   Formatter
+		contentType string
+		want        string
+	}{
+		{
+			name:        "markdown",
+			formatter:   NewMarkdownFormatter(),
+			contentType: "text/markdown; charset=utf-8",
+			want: "# Trip \\<2026\\>\n" +
+				"\n" +
+				"- [https://example.com/a](https://example.com/a) - hotel & \\*spa\\*\n" +
+				"- [https://example.com/b](https://example.com/b)\n",
+		},
+		{
+			name:        "html",
+			formatter:   NewHTMLFormatter(),
+			contentType: "text/html; charset=utf-8",
+			want: "<!DOCTYPE NETSCAPE-Bookmark-file-1>\n" +
+				"<META HTTP-EQUIV=\"Content-Type\" CONTENT=\"text/html; charset=UTF-8\">\n" +
+				"<TITLE>Trip &lt;2026&gt;</TITLE>\n" +
+				"<H1>Trip &lt;2026&gt;</H1>\n" +
+				"<DL><p>\n" +
+				"    <DT><H3>Trip &lt;2026&gt;</H3>\n" +
+				"    <DL><p>\n" +
+				"        <DT><A HREF=\"https://example.com/a\">https://example.com/a</A>\n" +
+				"        <DD>hotel &amp; *spa*\n" +
+				"        <DT><A HREF=\"https://example.com/b\">https://example.com/b</A>\n" +
+				"    </DL><p>\n" +
+				"</DL><p>\n",
+		},
+		{
+			name:        "json",
+			formatter:   NewJSONFormatter(),
+			contentType: "application/json",
+			want: "{\n" +
+				"  \"title\": \"Trip \\u003c2026\\u003e\",\n" +
+				"  \"links\": [\n" +
+				"    {\n" +
+				"      \"url\": \"https://example.com/a\",\n" +
+				"      \"memo\": \"hotel \\u0026 *spa*\",\n" +
+				"      \"priority\": 1\n" +
+				"    },\n" +
+				"    {\n" +
+				"      \"url\": \"https://example.com/b\",\n" +
+				"      \"priority\": 2\n" +
+				"    }\n" +
+				"  ]\n" +
+				"}\n",
+		},
+		{
+			name:        "opml",
+			formatter:   NewOPMLFormatter(),
+			contentType: "text/x-opml; charset=utf-8",
+			want: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+				"<opml version=\"2.0\">\n" +
+				"  <head>\n" +
+				"    <title>Trip &lt;2026&gt;</title>\n" +
+				"  </head>\n" +
+				"  <body>\n" +
+				"    <outline text=\"hotel &amp; *spa*\" type=\"link\" url=\"https://example.com/a\" _note=\"hotel &amp; *spa*\"></outline>\n" +
+				"    <outline text=\"https://example.com/b\" type=\"link\" url=\"https://example.com/b\"></outline>\n" +
+				"  </body>\n" +
+				"</opml>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := tt.formatter.Format(&buf, doc); err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Fatalf("output mismatch (-want +got):\n%s", diff)
+			}
+			if got := tt.formatter.ContentType(); got != tt.contentType {
+				t.Fatalf("ContentType() = %q, want %q", got, tt.contentType)
+			}
+		})
+	}
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	if diff := cmp.Diff([]string{"html", "json", "markdown", "opml"}, r.Names()); diff != "" {
+		t.Fatalf("names mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		wantOK bool
+	}{
+		{name: "exact", format: "markdown", wantOK: true},
+		{name: "case_insensitive", format: "OPML", wantOK: true},
+		{name: "unknown", format: "pdf", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, ok := r.Lookup(tt.format)
+			if ok != tt.wantOK {
+				t.Fatalf("Lookup(%q) ok = %v, want %v", tt.format, ok, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package export
+
+import (
+	"html"
+	"io"
+	"strings"
+)
+
+type htmlFormatter struct{}
+
+// NewHTMLFormatter creates a formatter that renders a page in the Netscape bookmark
+// file format, which can be imported by every major browser.
+func NewHTMLFormatter() Formatter {
+	return htmlFormatter{}
+}
+
+func (htmlFormatter) Name() string { return "html" }
+
+func (htmlFormatter) ContentType() string { return "text/html; charset=utf-8" }
+
+// Format renders the page as a single bookmark folder named after the page title.
+func (htmlFormatter) Format(w io.Writer, doc *Document) error {
+	var b strings.Builder
+	title := html.EscapeString(doc.Title)
+
+	b.WriteString("<!DOCTYPE NETSCAPE-Bookmark-file-1>\n")
+	b.WriteString("<META HTTP-EQUIV=\"Content-Type\" CONTENT=\"text/html; charset=UTF-8\">\n")
+	b.WriteString("<TITLE>" + title + "</TITLE>\n")
+	b.WriteString("<H1>" + title + "</H1>\n")
+	b.WriteString("<DL><p>\n")
+	b.WriteString("    <DT><H3>" + title + "</H3>\n")
+	b.WriteString("    <DL><p>\n")
+	for _, l := range doc.Links {
+		url := html.EscapeString(l.URL)
+		b.WriteString("        <DT><A HREF=\"" + url + "\">" + url + "</A>\n")
+		if l.Memo != "" {
+			b.WriteString("        <DD>" + html.EscapeString(l.Memo) + "\n")
+		}
+	}
+	b.WriteString("    </DL><p>\n")
+	b.WriteString("</DL><p>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
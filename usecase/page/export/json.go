@@ -0,0 +1,48 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type jsonFormatter struct{}
+
+// NewJSONFormatter creates a formatter that renders a page as JSON.
+func NewJSONFormatter() Formatter {
+	return jsonFormatter{}
+}
+
+func (jsonFormatter) Name() string { return "json" }
+
+func (jsonFormatter) ContentType() string { return "application/json" }
+
+type jsonDocument struct {
+	Title string     `json:"title"`
+	Links []jsonLink `json:"links"`
+}
+
+type jsonLink struct {
+	URL      string `json:"url"`
+	Memo     string `json:"memo,omitempty"`
+	Priority int    `json:"priority"`
+}
+
+// Format renders the page as an indented JSON object. Priorities are 1-based and
+// follow the export order.
+func (jsonFormatter) Format(w io.Writer, doc *Document) error {
+	out := jsonDocument{
+		Title: doc.Title,
+		Links: make([]jsonLink, 0, len(doc.Links)),
+	}
+	for i, l := range doc.Links {
+		out.Links = append(out.Links, jsonLink{
+			URL:      l.URL,
+			Memo:     l.Memo,
+			Priority: i + 1,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
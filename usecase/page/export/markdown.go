@@ -0,0 +1,65 @@
+package export
+
+import (
+	"io"
+	"strings"
+)
+
+type markdownFormatter struct{}
+
+// NewMarkdownFormatter creates a formatter that renders a page as a Markdown list.
+func NewMarkdownFormatter() Formatter {
+	return markdownFormatter{}
+}
+
+func (markdownFormatter) Name() string { return "markdown" }
+
+func (markdownFormatter) ContentType() string { return "text/markdown; charset=utf-8" }
+
+// Format renders the title as a heading followed by one list item per link.
+// The memo, if any, follows the link on the same line.
+func (markdownFormatter) Format(w io.Writer, doc *Document) error {
+	var b strings.Builder
+
+	b.WriteString("# ")
+	b.WriteString(markdownEscaper.Replace(doc.Title))
+	b.WriteString("\n")
+
+	if len(doc.Links) > 0 {
+		b.WriteString("\n")
+	}
+	for _, l := range doc.Links {
+		b.WriteString("- [")
+		b.WriteString(markdownEscaper.Replace(l.URL))
+		b.WriteString("](")
+		b.WriteString(markdownURLEscaper.Replace(l.URL))
+		b.WriteString(")")
+		if memo := strings.Join(strings.Fields(l.Memo), " "); memo != "" {
+			b.WriteString(" - ")
+			b.WriteString(markdownEscaper.Replace(memo))
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var (
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"`", "\\`",
+		"*", `\*`,
+		"_", `\_`,
+		"[", `\[`,
+		"]", `\]`,
+		"<", `\<`,
+		">", `\>`,
+		"#", `\#`,
+	)
+	markdownURLEscaper = strings.NewReplacer(
+		"(", "%28",
+		")", "%29",
+		" ", "%20",
+	)
+)
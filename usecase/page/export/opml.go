@@ -0,0 +1,64 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type opmlFormatter struct{}
+
+// NewOPMLFormatter creates a formatter that renders a page as an OPML 2.0 outline.
+func NewOPMLFormatter() Formatter {
+	return opmlFormatter{}
+}
+
+func (opmlFormatter) Name() string { return "opml" }
+
+func (opmlFormatter) ContentType() string { return "text/x-opml; charset=utf-8" }
+
+type opmlDocument struct {
+	XMLName xml.Name      `xml:"opml"`
+	Version string        `xml:"version,attr"`
+	Title   string        `xml:"head>title"`
+	Outline []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text string `xml:"text,attr"`
+	Type string `xml:"type,attr"`
+	URL  string `xml:"url,attr"`
+	Note string `xml:"_note,attr,omitempty"`
+}
+
+// Format renders each link as an outline of type "link". The memo is used as the
+// outline text when present and is also kept in the "_note" attribute.
+func (opmlFormatter) Format(w io.Writer, doc *Document) error {
+	out := opmlDocument{
+		Version: "2.0",
+		Title:   doc.Title,
+		Outline: make([]opmlOutline, 0, len(doc.Links)),
+	}
+	for _, l := range doc.Links {
+		text := l.Memo
+		if text == "" {
+			text = l.URL
+		}
+		out.Outline = append(out.Outline, opmlOutline{
+			Text: text,
+			Type: "link",
+			URL:  l.URL,
+			Note: l.Memo,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
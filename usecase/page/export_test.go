@@ -0,0 +1,158 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	"github.com/naka-sei/tsudzuri/usecase/page/export"
+)
+
+func TestExportUsecase_Export(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		format string
+	}
+	type want struct {
+		out *ExportUsecaseOutput
+		err error
+	}
+
+	creator := duser.ReconstructUser("user-id-1", "uid-1", "anonymous", nil)
+	invited := duser.ReconstructUser("user-id-2", "uid-2", "anonymous", nil)
+	other := duser.ReconstructUser("user-id-3", "uid-3", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("page-1", "Links", *creator, "invite", dpage.Links{
+			dpage.ReconstructLink("https://example.com/2", "", 2),
+			dpage.ReconstructLink("https://example.com/1", "first", 1),
+		}, duser.Users{invited})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success_default_format_sorted_by_priority",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creator),
+				pageID: "page-1",
+			},
+			want: want{
+				out: &ExportUsecaseOutput{
+					ContentType: "text/markdown; charset=utf-8",
+					Content: []byte("# Links\n\n" +
+						"- [https://example.com/1](https://example.com/1) - first\n" +
+						"- [https://example.com/2](https://example.com/2)\n"),
+				},
+			},
+		},
+		{
+			name: "success_by_invited_user",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), invited),
+				pageID: "page-1",
+				format: "json",
+			},
+			want: want{
+				out: &ExportUsecaseOutput{
+					ContentType: "application/json",
+					Content: []byte("{\n" +
+						"  \"title\": \"Links\",\n" +
+						"  \"links\": [\n" +
+						"    {\n" +
+						"      \"url\": \"https://example.com/1\",\n" +
+						"      \"memo\": \"first\",\n" +
+						"      \"priority\": 1\n" +
+						"    },\n" +
+						"    {\n" +
+						"      \"url\": \"https://example.com/2\",\n" +
+						"      \"priority\": 2\n" +
+						"    }\n" +
+						"  ]\n" +
+						"}\n"),
+				},
+			},
+		},
+		{
+			name: "unsupported_format",
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creator),
+				pageID: "page-1",
+				format: "pdf",
+			},
+			want: want{err: ErrUnsupportedExportFormat},
+		},
+		{
+			name: "user_not_found",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "page-1",
+			},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creator),
+				pageID: "page-1",
+			},
+			want: want{err: ErrPageNotFound},
+		},
+		{
+			name: "unauthorized_user",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), other),
+				pageID: "page-1",
+			},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{pageRepo: mockpage.NewMockPageRepository(ctrl)}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			u := NewExportUsecase(m.pageRepo, export.NewDefaultRegistry())
+			got, err := u.Export(tt.args.ctx, tt.args.pageID, tt.args.format)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.out, got); diff != "" {
+				t.Fatalf("output mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
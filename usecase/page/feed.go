@@ -0,0 +1,61 @@
+package page
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_feed/feed.go -source=./feed.go -package=mockfeedusecase
+type FeedUsecase interface {
+	// Feed returns the page for rendering its feed. Feeds are read by feed readers without a user,
+	// so access is granted by the page's feed token instead of the authenticated user.
+	Feed(ctx context.Context, pageID string, token string) (*dpage.Page, error)
+}
+
+type feedUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+}
+
+func NewFeedUsecase(pageRepo dpage.PageRepository) FeedUsecase {
+	return &feedUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+	}
+}
+
+func (u *feedUsecase) Feed(ctx context.Context, pageID string, token string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/feedUsecase.Feed")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Getting feed of page %s", pageID)
+
+	// Feed URLs are public, so malformed IDs are reported like unknown pages rather than as a failed lookup.
+	if err := uuid.Validate(pageID); err != nil {
+		return nil, ErrPageNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	if err := page.VerifyFeedToken(token); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,91 @@
+package page
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestFeedUsecase_Feed(t *testing.T) {
+	type args struct {
+		pageID string
+		token  string
+	}
+	type want struct {
+		page *dpage.Page
+		err  error
+	}
+
+	const pageID = "0190c7e0-0000-7000-8000-000000000001"
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	sum := sha256.Sum256([]byte("feed-token"))
+	page := dpage.ReconstructPage(pageID, "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "memo", 1),
+	}, nil, dpage.WithFeedTokenHash(hex.EncodeToString(sum[:])))
+
+	tests := []struct {
+		name  string
+		setup func(m *mockpage.MockPageRepository)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mockpage.MockPageRepository) {
+				m.EXPECT().Get(gomock.Any(), pageID).Return(page, nil)
+			},
+			args: args{pageID: pageID, token: "feed-token"},
+			want: want{page: page},
+		},
+		{
+			name: "invalid_token",
+			setup: func(m *mockpage.MockPageRepository) {
+				m.EXPECT().Get(gomock.Any(), pageID).Return(page, nil)
+			},
+			args: args{pageID: pageID, token: "wrong"},
+			want: want{err: dpage.ErrInvalidFeedToken},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mockpage.MockPageRepository) {
+				m.EXPECT().Get(gomock.Any(), pageID).Return(nil, nil)
+			},
+			args: args{pageID: pageID, token: "feed-token"},
+			want: want{err: ErrPageNotFound},
+		},
+		{
+			name: "malformed_page_id",
+			args: args{pageID: "foo", token: "feed-token"},
+			want: want{err: ErrPageNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockpage.NewMockPageRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			u := NewFeedUsecase(repo)
+			got, err := u.Feed(context.Background(), tt.args.pageID, tt.args.token)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("page mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_feed_token_create/feed_token_create.go -source=./feed_token_create.go -package=mockfeedtokencreateusecase
+type FeedTokenCreateUsecase interface {
+	// CreateFeedToken issues a new feed token for the page and returns it. Any previous token stops working.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	CreateFeedToken(ctx context.Context, pageID string) (string, error)
+}
+
+type feedTokenCreateUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewFeedTokenCreateUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) FeedTokenCreateUsecase {
+	return &feedTokenCreateUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *feedTokenCreateUsecase) CreateFeedToken(ctx context.Context, pageID string) (string, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/feedTokenCreateUsecase.CreateFeedToken")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Creating feed token for page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return "", duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return "", err
+	}
+	if page == nil {
+		return "", ErrPageNotFound
+	}
+
+	var token string
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		t, err := page.IssueFeedToken(user)
+		if err != nil {
+			return err
+		}
+		if _, err := u.repository.page.Save(ctx, page); err != nil {
+			return err
+		}
+		token = t
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
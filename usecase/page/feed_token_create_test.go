@@ -0,0 +1,138 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestFeedTokenCreateUsecase_CreateFeedToken(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invitedUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	errSave := errors.New("save error")
+
+	tests := []struct {
+		name      string
+		setup     func(m *mocks)
+		args      args
+		wantToken bool
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{invitedUser})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						if p.FeedTokenHash() == "" {
+							t.Errorf("feed token hash should be set before saving")
+						}
+						return p, nil
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantToken: true,
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{invitedUser})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), invitedUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(nil, errSave)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: errSave,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewFeedTokenCreateUsecase(m.pageRepo, m.txn)
+			got, err := u.CreateFeedToken(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if (got != "") != tt.wantToken {
+				t.Fatalf("unexpected token: %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_feed_token_revoke/feed_token_revoke.go -source=./feed_token_revoke.go -package=mockfeedtokenrevokeusecase
+type FeedTokenRevokeUsecase interface {
+	// RevokeFeedToken disables the feeds of the page. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	RevokeFeedToken(ctx context.Context, pageID string) error
+}
+
+type feedTokenRevokeUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewFeedTokenRevokeUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) FeedTokenRevokeUsecase {
+	return &feedTokenRevokeUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *feedTokenRevokeUsecase) RevokeFeedToken(ctx context.Context, pageID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/feedTokenRevokeUsecase.RevokeFeedToken")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Revoking feed token for page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.RevokeFeedToken(user); err != nil {
+			return err
+		}
+		_, err := u.repository.page.Save(ctx, page)
+		return err
+	})
+}
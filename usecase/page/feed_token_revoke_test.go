@@ -0,0 +1,108 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestFeedTokenRevokeUsecase_RevokeFeedToken(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invitedUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{}, dpage.WithFeedTokenHash("hash"))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{})).Return(page, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{invitedUser}, dpage.WithFeedTokenHash("hash"))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), invitedUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewFeedTokenRevokeUsecase(m.pageRepo, m.txn)
+			err := u.RevokeFeedToken(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -12,8 +12,9 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_get/get.go -source=./get.go -package=mockgetusecase
 type GetUsecase interface {
-	// Get returns a page by its ID. The user is obtained from context via pkg/ctx/user.UserFromContext.
-	Get(ctx context.Context, pageID string) (*dpage.Page, error)
+	// Get returns a page by its ID with its links listed in the given order.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Get(ctx context.Context, pageID string, order dpage.LinkOrder) (*dpage.Page, error)
 }
 
 type getUsecase struct {
@@ -33,12 +34,12 @@ func NewGetUsecase(pageRepo dpage.PageRepository) GetUsecase {
 	return u
 }
 
-func (u *getUsecase) Get(ctx context.Context, pageID string) (*dpage.Page, error) {
+func (u *getUsecase) Get(ctx context.Context, pageID string, order dpage.LinkOrder) (*dpage.Page, error) {
 	ctx, end := trace.StartSpan(ctx, "usecase/page/getUsecase.Get")
 	defer end()
 
 	l := log.LoggerFromContext(ctx)
-	l.Sugar().Infof("Getting page with id: %s", pageID)
+	l.Sugar().Infof("Getting page with id: %s order: %s", pageID, order)
 
 	_, ok := ctxuser.UserFromContext(ctx)
 	if !ok {
@@ -54,5 +55,9 @@ func (u *getUsecase) Get(ctx context.Context, pageID string) (*dpage.Page, error
 		return nil, ErrPageNotFound
 	}
 
+	if err := page.SortLinks(order); err != nil {
+		return nil, err
+	}
+
 	return page, nil
 }
@@ -22,6 +22,7 @@ func TestGetUsecase_Get(t *testing.T) {
 	type args struct {
 		ctx    context.Context
 		pageID string
+		order  dpage.LinkOrder
 	}
 	type want struct {
 		page *dpage.Page
@@ -34,6 +35,13 @@ func TestGetUsecase_Get(t *testing.T) {
 
 	p1 := dpage.ReconstructPage("page-1", "t1", *user, "invite", dpage.Links{}, duser.Users{})
 	p2 := dpage.ReconstructPage("page-2", "t2", *other, "invite", dpage.Links{}, duser.Users{invitedUser})
+	voted := func() *dpage.Page {
+		return dpage.ReconstructPage("page-3", "t3", *user, "invite", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "", 1, dpage.WithLinkID("link-1")),
+			dpage.ReconstructLink("https://b.com", "", 2, dpage.WithLinkID("link-2"),
+				dpage.WithLinkReactions(dpage.Reactions{dpage.ReconstructReaction("user-id-1", dpage.UpvoteReaction)})),
+		}, duser.Users{})
+	}
 
 	tests := []struct {
 		name  string
@@ -69,6 +77,40 @@ func TestGetUsecase_Get(t *testing.T) {
 				err:  nil,
 			},
 		},
+		{
+			name: "success_sorted_by_votes",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-3").Return(voted(), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), user),
+				pageID: "page-3",
+				order:  dpage.LinkOrderVotes,
+			},
+			want: want{
+				page: dpage.ReconstructPage("page-3", "t3", *user, "invite", dpage.Links{
+					dpage.ReconstructLink("https://b.com", "", 2, dpage.WithLinkID("link-2"),
+						dpage.WithLinkReactions(dpage.Reactions{dpage.ReconstructReaction("user-id-1", dpage.UpvoteReaction)})),
+					dpage.ReconstructLink("https://a.com", "", 1, dpage.WithLinkID("link-1")),
+				}, duser.Users{}),
+				err: nil,
+			},
+		},
+		{
+			name: "invalid_link_order",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-3").Return(voted(), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), user),
+				pageID: "page-3",
+				order:  "random",
+			},
+			want: want{
+				page: nil,
+				err:  dpage.ErrInvalidLinkOrder,
+			},
+		},
 		{
 			name: "repo_error",
 			setup: func(m *mocks) {
@@ -126,8 +168,8 @@ func TestGetUsecase_Get(t *testing.T) {
 				tt.setup(m)
 			}
 			u := NewGetUsecase(m.pageRepo)
-			got, err := u.Get(tt.args.ctx, tt.args.pageID)
-			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(dpage.Page{}, duser.User{})); diff != "" {
+			got, err := u.Get(tt.args.ctx, tt.args.pageID, tt.args.order)
+			if diff := cmp.Diff(tt.want.page, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, dpage.Reaction{}, duser.User{}, duser.Profile{})); diff != "" {
 				t.Errorf("page mismatch (-want +got):\n%s", diff)
 			}
 			testutil.EqualErr(t, tt.want.err, err)
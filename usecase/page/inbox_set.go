@@ -0,0 +1,82 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_inbox_set/inbox_set.go -source=./inbox_set.go -package=mockinboxsetusecase
+type InboxSetUsecase interface {
+	// SetInbox makes the page the inbox captured links go to, in place of the current one.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	SetInbox(ctx context.Context, pageID string) error
+}
+
+type inboxSetUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		preference dpage.PreferenceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewInboxSetUsecase(
+	pageRepo dpage.PageRepository,
+	preferenceRepo dpage.PreferenceRepository,
+	txnService service.TransactionService,
+) InboxSetUsecase {
+	return &inboxSetUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			preference dpage.PreferenceRepository
+		}{
+			page:       pageRepo,
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *inboxSetUsecase) SetInbox(ctx context.Context, pageID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/inboxSetUsecase.SetInbox")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Setting inbox page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		preferences, err := u.repository.preference.Get(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if err := preferences.SetInbox(user, page); err != nil {
+			return err
+		}
+		return u.repository.preference.Save(ctx, preferences)
+	})
+}
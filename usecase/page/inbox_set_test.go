@@ -0,0 +1,146 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestInboxSetUsecase_SetInbox(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		preferenceRepo *mockpage.MockPreferenceRepository
+		txn            *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{memberUser})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", nil), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("1", false, 0, true),
+				})).Return(nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "3").Return(dpage.ReconstructPreferences("3", nil), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), otherUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "archived_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(
+					dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, nil, dpage.WithArchived(true)), nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(dpage.ReconstructPreferences("1", nil), nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrPageArchived,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "1").Return(dpage.ReconstructPreferences("1", nil), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				preferenceRepo: mockpage.NewMockPreferenceRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewInboxSetUsecase(m.pageRepo, m.preferenceRepo, m.txn)
+			err := u.SetInbox(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
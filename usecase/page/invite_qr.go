@@ -0,0 +1,104 @@
+package page
+
+import (
+	"context"
+	"net/url"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+// JoinURL is the page of the web app that joins a page. The page ID and the invite code are added as the
+// "page_id" and "invite_code" query parameters.
+type JoinURL string
+
+// Image formats of invite QR codes.
+const (
+	InviteQRCodeFormatPNG = "png"
+	InviteQRCodeFormatSVG = "svg"
+)
+
+type InviteQRCodeUsecaseOutput struct {
+	ContentType string
+	Content     []byte
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_invite_qr/invite_qr.go -source=./invite_qr.go -package=mockinviteqrusecase
+type InviteQRCodeUsecase interface {
+	// QRCode renders the join link of the page, carrying the page ID and the current invite code, as a QR code image
+	// in the given format. Only the creator can render it, as only the creator sees the invite code.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	QRCode(ctx context.Context, pageID string, format string, opts qrcode.Options) (*InviteQRCodeUsecaseOutput, error)
+}
+
+type inviteQRCodeUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	joinURL JoinURL
+}
+
+func NewInviteQRCodeUsecase(pageRepo dpage.PageRepository, joinURL JoinURL) InviteQRCodeUsecase {
+	return &inviteQRCodeUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		joinURL: joinURL,
+	}
+}
+
+func (u *inviteQRCodeUsecase) QRCode(ctx context.Context, pageID string, format string, opts qrcode.Options) (*InviteQRCodeUsecaseOutput, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/inviteQRCodeUsecase.QRCode")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Rendering invite QR code of page %s format: %s", pageID, format)
+
+	render, contentType := qrcode.PNG, "image/png"
+	switch format {
+	case InviteQRCodeFormatPNG:
+	case InviteQRCodeFormatSVG:
+		render, contentType = qrcode.SVG, "image/svg+xml"
+	default:
+		return nil, ErrUnsupportedQRCodeFormat
+	}
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	inviteCode := page.InviteCode(user)
+	if inviteCode == "" {
+		return nil, dpage.ErrNotCreatedByUser
+	}
+
+	link, err := url.Parse(string(u.joinURL))
+	if err != nil {
+		return nil, err
+	}
+	query := link.Query()
+	query.Set("page_id", page.ID())
+	query.Set("invite_code", inviteCode)
+	link.RawQuery = query.Encode()
+
+	content, err := render(link.String(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return &InviteQRCodeUsecaseOutput{ContentType: contentType, Content: content}, nil
+}
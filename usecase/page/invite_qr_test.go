@@ -0,0 +1,138 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/qrcode"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestInviteQRCodeUsecase_QRCode(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		format string
+		opts   qrcode.Options
+	}
+	type want struct {
+		out *InviteQRCodeUsecaseOutput
+		err error
+	}
+
+	creator := duser.ReconstructUser("user-id-1", "uid-1", "anonymous", nil)
+	invited := duser.ReconstructUser("user-id-2", "uid-2", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("page-1", "Links", *creator, "INVITE01", nil, duser.Users{invited})
+	}
+	// The existing query of the join URL is kept.
+	const joinURL = "https://tsudzuri.app/join?via=qr"
+	const link = "https://tsudzuri.app/join?invite_code=INVITE01&page_id=page-1&via=qr"
+	opts := qrcode.Options{Size: 128, Level: qrcode.LevelHigh}
+	pngContent, err := qrcode.PNG(link, opts)
+	if err != nil {
+		t.Fatalf("failed to render PNG: %v", err)
+	}
+	svgContent, err := qrcode.SVG(link, qrcode.Options{})
+	if err != nil {
+		t.Fatalf("failed to render SVG: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "png",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: InviteQRCodeFormatPNG, opts: opts},
+			want: want{out: &InviteQRCodeUsecaseOutput{ContentType: "image/png", Content: pngContent}},
+		},
+		{
+			name: "svg_defaults",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: InviteQRCodeFormatSVG},
+			want: want{out: &InviteQRCodeUsecaseOutput{ContentType: "image/svg+xml", Content: svgContent}},
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), invited), pageID: "page-1", format: InviteQRCodeFormatPNG},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "invalid_size",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: InviteQRCodeFormatPNG, opts: qrcode.Options{Size: 4096}},
+			want: want{err: qrcode.ErrInvalidSize},
+		},
+		{
+			name: "unsupported_format",
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: "gif"},
+			want: want{err: ErrUnsupportedQRCodeFormat},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background(), pageID: "page-1", format: InviteQRCodeFormatPNG},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: InviteQRCodeFormatPNG},
+			want: want{err: ErrPageNotFound},
+		},
+		{
+			name: "get_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, errors.New("get error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", format: InviteQRCodeFormatPNG},
+			want: want{err: errors.New("get error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{pageRepo: mockpage.NewMockPageRepository(ctrl)}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewInviteQRCodeUsecase(m.pageRepo, joinURL)
+			got, err := u.QRCode(tt.args.ctx, tt.args.pageID, tt.args.format, tt.args.opts)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.out, got); diff != "" {
+				t.Errorf("QRCode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
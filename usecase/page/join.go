@@ -3,8 +3,10 @@ package page
 import (
 	"context"
 
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/pkg/trace"
@@ -22,13 +24,17 @@ type joinUsecase struct {
 		page dpage.PageRepository
 	}
 	service struct {
-		txn service.TransactionService
+		txn          service.TransactionService
+		notification service.NotificationService
+		webhook      service.WebhookService
 	}
 }
 
 func NewJoinUsecase(
 	pageRepo dpage.PageRepository,
 	txnService service.TransactionService,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
 ) JoinUsecase {
 	return &joinUsecase{
 		repository: struct {
@@ -37,9 +43,13 @@ func NewJoinUsecase(
 			page: pageRepo,
 		},
 		service: struct {
-			txn service.TransactionService
+			txn          service.TransactionService
+			notification service.NotificationService
+			webhook      service.WebhookService
 		}{
-			txn: txnService,
+			txn:          txnService,
+			notification: notificationService,
+			webhook:      webhookService,
 		},
 	}
 }
@@ -64,11 +74,18 @@ func (u *joinUsecase) Join(ctx context.Context, pageID string, inviteCode string
 		return ErrPageNotFound
 	}
 
-	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		if err := page.Join(user, inviteCode); err != nil {
 			return err
 		}
 		_, err := u.repository.page.Save(ctx, page)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindMemberJoined, Page: page, Actor: user, Count: 1})
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{Type: dwebhook.EventMemberJoined, Page: page, Actor: user})
+	return nil
 }
@@ -7,18 +7,24 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
 	mocktransaction "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
 )
 
 func TestJoinUsecase_Join(t *testing.T) {
 	type fields struct {
 		pageRepo   *mockpage.MockPageRepository
 		txnService *mocktransaction.MockTransactionService
+		notifier   *mocknotification.MockNotificationService
+		webhook    *mockwebhook.MockWebhookService
 	}
 	type args struct {
 		ctx        context.Context
@@ -71,6 +77,20 @@ func TestJoinUsecase_Join(t *testing.T) {
 						return pg, nil
 					},
 				)
+				f.notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Do(
+					func(ctx context.Context, event *dnotification.Event) {
+						if event.Kind != dnotification.KindMemberJoined || event.Page != page || event.Actor.ID() != "user-id" {
+							t.Errorf("unexpected event: %+v", event)
+						}
+					},
+				)
+				f.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Do(
+					func(ctx context.Context, event *dwebhook.Event) {
+						if event.Type != dwebhook.EventMemberJoined || event.Page != page || event.Actor.ID() != "user-id" {
+							t.Errorf("unexpected webhook event: %+v", event)
+						}
+					},
+				)
 			},
 			want: want{err: nil},
 		},
@@ -157,13 +177,15 @@ func TestJoinUsecase_Join(t *testing.T) {
 
 			f := &fields{
 				pageRepo:   mockpage.NewMockPageRepository(ctrl),
+				webhook:    mockwebhook.NewMockWebhookService(ctrl),
 				txnService: mocktransaction.NewMockTransactionService(ctrl),
+				notifier:   mocknotification.NewMockNotificationService(ctrl),
 			}
 			if tt.setup != nil {
 				tt.setup(t, f, &tt.args)
 			}
 
-			u := NewJoinUsecase(f.pageRepo, f.txnService)
+			u := NewJoinUsecase(f.pageRepo, f.txnService, f.notifier, f.webhook)
 			err := u.Join(tt.args.ctx, tt.args.pageID, tt.args.inviteCode)
 			testutil.EqualErr(t, tt.want.err, err)
 		})
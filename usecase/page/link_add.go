@@ -3,8 +3,10 @@ package page
 import (
 	"context"
 
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/pkg/trace"
@@ -28,13 +30,17 @@ type linkAddUsecase struct {
 		page dpage.PageRepository
 	}
 	service struct {
-		txn service.TransactionService
+		txn          service.TransactionService
+		notification service.NotificationService
+		webhook      service.WebhookService
 	}
 }
 
 func NewLinkAddUsecase(
 	pageRepo dpage.PageRepository,
 	txnService service.TransactionService,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
 ) LinkAddUseCase {
 	u := &linkAddUsecase{
 		repository: struct {
@@ -43,9 +49,13 @@ func NewLinkAddUsecase(
 			page: pageRepo,
 		},
 		service: struct {
-			txn service.TransactionService
+			txn          service.TransactionService
+			notification service.NotificationService
+			webhook      service.WebhookService
 		}{
-			txn: txnService,
+			txn:          txnService,
+			notification: notificationService,
+			webhook:      webhookService,
 		},
 	}
 	return u
@@ -76,11 +86,31 @@ func (u *linkAddUsecase) LinkAdd(ctx context.Context, input LinkAddUsecaseInput)
 		return err
 	}
 
-	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+	var saved *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		if err := page.AddLink(user, input.URL, input.Memo); err != nil {
 			return err
 		}
-		_, err = u.repository.page.Save(ctx, page)
+		saved, err = u.repository.page.Save(ctx, page)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: user, Count: 1})
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{
+		Type:  dwebhook.EventLinkAdded,
+		Page:  page,
+		Actor: user,
+		Links: addedLinks(saved, 1),
+	})
+	return nil
+}
+
+// addedLinks returns the last n links of the saved page. Links are added to the end of a page, so these are the
+// links just added, with the IDs and priorities they were stored with.
+func addedLinks(saved *dpage.Page, n int) dpage.Links {
+	links := saved.Links()
+	return links[max(len(links)-n, 0):]
 }
@@ -6,18 +6,24 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
 	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
 )
 
 func TestLinkAddUseCase_LinkAdd(t *testing.T) {
 	type mocks struct {
 		pageRepo *mockpage.MockPageRepository
 		txn      *mocktxn.MockTransactionService
+		notifier *mocknotification.MockNotificationService
+		webhook  *mockwebhook.MockWebhookService
 	}
 	type args struct {
 		ctx   context.Context
@@ -43,7 +49,18 @@ func TestLinkAddUseCase_LinkAdd(t *testing.T) {
 					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
 						return f(ctx)
 					})
-				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(page, nil)
+				saved := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+					dpage.ReconstructLink("https://example.com", "test link", 1, dpage.WithLinkID("link-1")),
+				}, duser.Users{})
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(saved, nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: creatorUser, Count: 1})
+				// The event carries the link as it was stored.
+				m.webhook.EXPECT().Dispatch(gomock.Any(), &dwebhook.Event{
+					Type:  dwebhook.EventLinkAdded,
+					Page:  page,
+					Actor: creatorUser,
+					Links: dpage.Links{dpage.ReconstructLink("https://example.com", "test link", 1, dpage.WithLinkID("link-1"))},
+				})
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creatorUser),
@@ -64,7 +81,18 @@ func TestLinkAddUseCase_LinkAdd(t *testing.T) {
 					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
 						return f(ctx)
 					})
-				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(page, nil)
+				saved := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+					dpage.ReconstructLink("https://example.com", "test link", 1, dpage.WithLinkID("link-1")),
+				}, duser.Users{invitedUser})
+				m.pageRepo.EXPECT().Save(gomock.Any(), page).Return(saved, nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: invitedUser, Count: 1})
+				// The event carries the link as it was stored.
+				m.webhook.EXPECT().Dispatch(gomock.Any(), &dwebhook.Event{
+					Type:  dwebhook.EventLinkAdded,
+					Page:  page,
+					Actor: invitedUser,
+					Links: dpage.Links{dpage.ReconstructLink("https://example.com", "test link", 1, dpage.WithLinkID("link-1"))},
+				})
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), invitedUser),
@@ -133,12 +161,14 @@ func TestLinkAddUseCase_LinkAdd(t *testing.T) {
 			t.Parallel()
 			m := &mocks{
 				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				webhook:  mockwebhook.NewMockWebhookService(ctrl),
 				txn:      mocktxn.NewMockTransactionService(ctrl),
+				notifier: mocknotification.NewMockNotificationService(ctrl),
 			}
 			if tt.setup != nil {
 				tt.setup(m)
 			}
-			u := NewLinkAddUsecase(m.pageRepo, m.txn)
+			u := NewLinkAddUsecase(m.pageRepo, m.txn, m.notifier, m.webhook)
 			err := u.LinkAdd(tt.args.ctx, tt.args.input)
 			testutil.EqualErr(t, tt.wantErr, err)
 		})
@@ -0,0 +1,108 @@
+package page
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type LinkBatchAddUsecaseInput struct {
+	PageID string
+	Links  []LinkBatchAddUsecaseInputLink
+}
+
+type LinkBatchAddUsecaseInputLink struct {
+	URL  string
+	Memo string
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_batch_add/link_batch_add.go -source=./link_batch_add.go -package=mocklinkbatchaddusecase
+type LinkBatchAddUsecase interface {
+	// LinkBatchAdd adds links to the end of a page in one transaction. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	LinkBatchAdd(ctx context.Context, input LinkBatchAddUsecaseInput) error
+}
+
+type linkBatchAddUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn          service.TransactionService
+		notification service.NotificationService
+		webhook      service.WebhookService
+	}
+}
+
+func NewLinkBatchAddUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
+) LinkBatchAddUsecase {
+	return &linkBatchAddUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn          service.TransactionService
+			notification service.NotificationService
+			webhook      service.WebhookService
+		}{
+			txn:          txnService,
+			notification: notificationService,
+			webhook:      webhookService,
+		},
+	}
+}
+
+func (u *linkBatchAddUsecase) LinkBatchAdd(ctx context.Context, input LinkBatchAddUsecaseInput) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkBatchAddUsecase.LinkBatchAdd")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Adding %d links to page %s", len(input.Links), input.PageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	links := make(dpage.Links, 0, len(input.Links))
+	for _, lnk := range input.Links {
+		links = append(links, dpage.NewLink(lnk.URL, lnk.Memo))
+	}
+
+	var page, saved *dpage.Page
+	err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		page, err = u.repository.page.Get(ctx, input.PageID)
+		if err != nil {
+			return err
+		}
+		if page == nil {
+			return ErrPageNotFound
+		}
+
+		if err := page.AddLinks(user, links); err != nil {
+			return err
+		}
+		saved, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	u.service.notification.Notify(ctx, &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: user, Count: len(links)})
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{Type: dwebhook.EventLinkAdded, Page: page, Actor: user, Links: addedLinks(saved, len(links))})
+	return nil
+}
@@ -0,0 +1,169 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocknotification "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_notification"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
+)
+
+func TestLinkBatchAddUsecase_LinkBatchAdd(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+		notifier *mocknotification.MockNotificationService
+		webhook  *mockwebhook.MockWebhookService
+	}
+	type args struct {
+		ctx   context.Context
+		input LinkBatchAddUsecaseInput
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+	// Added links are read for the user who added them.
+	read := dpage.LinkStates{dpage.ReconstructLinkState("1", dpage.LinkStatusRead)}
+
+	input := LinkBatchAddUsecaseInput{
+		PageID: "1",
+		Links: []LinkBatchAddUsecaseInputLink{
+			{URL: "https://b.com", Memo: "B"},
+			{URL: "https://c.com"},
+		},
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				runTxn(m)
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+					dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+				}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						want := dpage.Links{
+							dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+							dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkStates(read)),
+							dpage.ReconstructLink("https://c.com", "", 3, dpage.WithLinkStates(read)),
+						}
+						if diff := cmp.Diff(want, p.Links(), cmp.AllowUnexported(dpage.Link{}, dpage.LinkState{})); diff != "" {
+							t.Errorf("saved links mismatch (-want +got):\n%s", diff)
+						}
+						return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+							dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+							dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("l2")),
+							dpage.ReconstructLink("https://c.com", "", 3, dpage.WithLinkID("l3")),
+						}, duser.Users{}), nil
+					})
+				m.notifier.EXPECT().Notify(gomock.Any(), &dnotification.Event{Kind: dnotification.KindLinksAdded, Page: page, Actor: creatorUser, Count: 2})
+				// The event carries the added links as they were stored.
+				m.webhook.EXPECT().Dispatch(gomock.Any(), &dwebhook.Event{
+					Type:  dwebhook.EventLinkAdded,
+					Page:  page,
+					Actor: creatorUser,
+					Links: dpage.Links{
+						dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("l2")),
+						dpage.ReconstructLink("https://c.com", "", 3, dpage.WithLinkID("l3")),
+					},
+				})
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: input,
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: input,
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "unauthorized_user",
+			setup: func(m *mocks) {
+				runTxn(m)
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), otherUser),
+				input: input,
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "no_links",
+			setup: func(m *mocks) {
+				runTxn(m)
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{})
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkBatchAddUsecaseInput{PageID: "1"},
+			},
+			wantErr: dpage.ErrNoLinksProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				webhook:  mockwebhook.NewMockWebhookService(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+				notifier: mocknotification.NewMockNotificationService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkBatchAddUsecase(m.pageRepo, m.txn, m.notifier, m.webhook)
+			err := u.LinkBatchAdd(tt.args.ctx, tt.args.input)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
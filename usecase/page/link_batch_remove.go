@@ -0,0 +1,102 @@
+package page
+
+import (
+	"context"
+	"slices"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type LinkBatchRemoveUsecaseInput struct {
+	PageID  string
+	LinkIDs []string
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_batch_remove/link_batch_remove.go -source=./link_batch_remove.go -package=mocklinkbatchremoveusecase
+type LinkBatchRemoveUsecase interface {
+	// LinkBatchRemove removes links from a page in one transaction. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	LinkBatchRemove(ctx context.Context, input LinkBatchRemoveUsecaseInput) error
+}
+
+type linkBatchRemoveUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn     service.TransactionService
+		webhook service.WebhookService
+	}
+}
+
+func NewLinkBatchRemoveUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+	webhookService service.WebhookService,
+) LinkBatchRemoveUsecase {
+	return &linkBatchRemoveUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn     service.TransactionService
+			webhook service.WebhookService
+		}{
+			txn:     txnService,
+			webhook: webhookService,
+		},
+	}
+}
+
+func (u *linkBatchRemoveUsecase) LinkBatchRemove(ctx context.Context, input LinkBatchRemoveUsecaseInput) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkBatchRemoveUsecase.LinkBatchRemove")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Removing %d links from page %s", len(input.LinkIDs), input.PageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	var (
+		page   *dpage.Page
+		before dpage.Links
+	)
+	err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		page, err = u.repository.page.Get(ctx, input.PageID)
+		if err != nil {
+			return err
+		}
+		if page == nil {
+			return ErrPageNotFound
+		}
+
+		before = slices.Clone(page.Links())
+		if err := page.RemoveLinks(user, input.LinkIDs); err != nil {
+			return err
+		}
+		_, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{
+		Type:  dwebhook.EventLinkRemoved,
+		Page:  page,
+		Actor: user,
+		Links: dwebhook.RemovedLinks(before, page.Links()),
+	})
+	return nil
+}
@@ -0,0 +1,147 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
+)
+
+func TestLinkBatchRemoveUsecase_LinkBatchRemove(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+		webhook  *mockwebhook.MockWebhookService
+	}
+	type args struct {
+		ctx   context.Context
+		input LinkBatchRemoveUsecaseInput
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	invitedUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+			dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("l2")),
+			dpage.ReconstructLink("https://c.com", "C", 3, dpage.WithLinkID("l3")),
+		}, duser.Users{invitedUser})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success_by_invited_user",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+					dpage.ReconstructLink("https://b.com", "B", 1, dpage.WithLinkID("l2")),
+				}, duser.Users{invitedUser})).Return(nil, nil)
+				m.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *dwebhook.Event) {
+					want := dpage.Links{
+						dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+						dpage.ReconstructLink("https://c.com", "C", 3, dpage.WithLinkID("l3")),
+					}
+					if event.Type != dwebhook.EventLinkRemoved || event.Actor != invitedUser {
+						t.Errorf("unexpected webhook event: %+v", event)
+					}
+					if diff := cmp.Diff(want, event.Links, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+						t.Errorf("removed links mismatch (-want +got):\n%s", diff)
+					}
+				})
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), invitedUser),
+				input: LinkBatchRemoveUsecaseInput{PageID: "1", LinkIDs: []string{"l1", "l3"}},
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:   context.Background(),
+				input: LinkBatchRemoveUsecaseInput{PageID: "1", LinkIDs: []string{"l1"}},
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkBatchRemoveUsecaseInput{PageID: "1", LinkIDs: []string{"l1"}},
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "unauthorized_user",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), otherUser),
+				input: LinkBatchRemoveUsecaseInput{PageID: "1", LinkIDs: []string{"l1"}},
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "link_not_found",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkBatchRemoveUsecaseInput{PageID: "1", LinkIDs: []string{"l1", "missing"}},
+			},
+			wantErr: dpage.ErrNotFoundLinkByID("missing"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				webhook:  mockwebhook.NewMockWebhookService(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkBatchRemoveUsecase(m.pageRepo, m.txn, m.webhook)
+			err := u.LinkBatchRemove(tt.args.ctx, tt.args.input)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
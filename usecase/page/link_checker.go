@@ -0,0 +1,167 @@
+package page
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// linkCheckInterval is the wait between two scans.
+	linkCheckInterval = time.Hour
+	// linkCheckAge is how long the outcome of a check is trusted before the link is checked again.
+	linkCheckAge = 7 * 24 * time.Hour
+	// linkCheckBatchSize is the number of links checked in one scan.
+	linkCheckBatchSize = 500
+	// linkCheckHostDelay is the wait between two requests to the same host.
+	linkCheckHostDelay = 2 * time.Second
+	// linkCheckConcurrency is the number of hosts checked at once.
+	linkCheckConcurrency = 4
+)
+
+// LinkChecker finds dead and redirected links in the background.
+// Every scan checks the links due for a check, one request at a time per host with a pause in between,
+// and records the outcome on the links. Links are checked again once their outcome is older than a week.
+type LinkChecker struct {
+	repository struct {
+		health dpage.LinkHealthRepository
+	}
+	service struct {
+		checker service.LinkChecker
+	}
+	interval    time.Duration
+	age         time.Duration
+	batchSize   int
+	hostDelay   time.Duration
+	concurrency int
+}
+
+// NewLinkChecker creates a LinkChecker requesting links through the checker.
+func NewLinkChecker(healthRepo dpage.LinkHealthRepository, checker service.LinkChecker) *LinkChecker {
+	return &LinkChecker{
+		repository:  struct{ health dpage.LinkHealthRepository }{health: healthRepo},
+		service:     struct{ checker service.LinkChecker }{checker: checker},
+		interval:    linkCheckInterval,
+		age:         linkCheckAge,
+		batchSize:   linkCheckBatchSize,
+		hostDelay:   linkCheckHostDelay,
+		concurrency: linkCheckConcurrency,
+	}
+}
+
+// Run scans right away and then once per interval until ctx is done.
+func (c *LinkChecker) Run(ctx context.Context) {
+	for {
+		c.scan(ctx)
+
+		timer := time.NewTimer(c.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// scan checks the links due for a check, grouped by host so that each host gets one request at a time.
+func (c *LinkChecker) scan(ctx context.Context) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/LinkChecker.scan")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	links, err := c.repository.health.ListDue(ctx, ctxtime.Now(ctx).Add(-c.age), c.batchSize)
+	if err != nil {
+		l.Sugar().Errorf("failed to list links to check: %v", err)
+		return
+	}
+	if len(links) == 0 {
+		return
+	}
+	l.Sugar().Infof("Checking %d links", len(links))
+
+	var (
+		hosts  []string
+		byHost = make(map[string]dpage.Links)
+	)
+	for _, link := range links {
+		host := linkHost(link.URL())
+		if _, ok := byHost[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		byHost[host] = append(byHost[host], link)
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, c.concurrency)
+	)
+	for _, host := range hosts {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(links dpage.Links) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c.checkHost(ctx, links)
+		}(byHost[host])
+	}
+	wg.Wait()
+}
+
+// checkHost checks the links of one host in order, pausing between requests.
+func (c *LinkChecker) checkHost(ctx context.Context, links dpage.Links) {
+	for i, link := range links {
+		if i > 0 {
+			timer := time.NewTimer(c.hostDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+		c.check(ctx, link)
+	}
+}
+
+// check requests the link and records the outcome. A request without a response is recorded as unreachable.
+func (c *LinkChecker) check(ctx context.Context, link dpage.Link) {
+	l := log.LoggerFromContext(ctx)
+
+	result, err := c.service.checker.Check(ctx, link.URL())
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		l.Sugar().Infof("Link %s could not be reached: %v", link.ID(), err)
+		result = service.LinkCheckResult{}
+	}
+
+	health := dpage.NewLinkHealth(result.StatusCode, result.Location, ctxtime.Now(ctx))
+	if err := c.repository.health.Save(ctx, link, health); err != nil {
+		l.Sugar().Errorf("failed to record check of link %s: %v", link.ID(), err)
+	}
+}
+
+// linkHost returns the host the rate limit of the link applies to. Hosts are compared without case and port.
+func linkHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
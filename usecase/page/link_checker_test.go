@@ -0,0 +1,160 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mocklinkchecker "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_link_checker"
+)
+
+func TestLinkChecker_scan(t *testing.T) {
+	type mocks struct {
+		healthRepo *mockpage.MockLinkHealthRepository
+		checker    *mocklinkchecker.MockLinkChecker
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	ctx := ctxtime.WithTime(context.Background(), now)
+	cutoff := now.Add(-linkCheckAge)
+
+	a1 := dpage.ReconstructLink("https://a.example.com/1", "", 0, dpage.WithLinkID("a-1"))
+	a2 := dpage.ReconstructLink("https://A.example.com:443/2", "", 0, dpage.WithLinkID("a-2"))
+	b1 := dpage.ReconstructLink("https://b.example.com/1", "", 0, dpage.WithLinkID("b-1"))
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+	}{
+		{
+			name: "records_outcome_per_link",
+			setup: func(m *mocks) {
+				m.healthRepo.EXPECT().ListDue(gomock.Any(), cutoff, linkCheckBatchSize).Return(dpage.Links{a1, b1, a2}, nil)
+				// Links of the same host are checked in order.
+				gomock.InOrder(
+					m.checker.EXPECT().Check(gomock.Any(), a1.URL()).Return(service.LinkCheckResult{StatusCode: 200}, nil),
+					m.checker.EXPECT().Check(gomock.Any(), a2.URL()).
+						Return(service.LinkCheckResult{StatusCode: 301, Location: "https://a.example.com/new"}, nil),
+				)
+				m.checker.EXPECT().Check(gomock.Any(), b1.URL()).Return(service.LinkCheckResult{}, errors.New("no such host"))
+
+				m.healthRepo.EXPECT().Save(gomock.Any(), a1, dpage.NewLinkHealth(200, "", now)).Return(nil)
+				m.healthRepo.EXPECT().Save(gomock.Any(), a2, dpage.NewLinkHealth(301, "https://a.example.com/new", now)).Return(nil)
+				m.healthRepo.EXPECT().Save(gomock.Any(), b1, dpage.NewLinkHealth(0, "", now)).Return(nil)
+			},
+		},
+		{
+			name: "save_error_does_not_stop_scan",
+			setup: func(m *mocks) {
+				m.healthRepo.EXPECT().ListDue(gomock.Any(), cutoff, linkCheckBatchSize).Return(dpage.Links{a1, a2}, nil)
+				m.checker.EXPECT().Check(gomock.Any(), gomock.Any()).Return(service.LinkCheckResult{StatusCode: 404}, nil).Times(2)
+				gomock.InOrder(
+					m.healthRepo.EXPECT().Save(gomock.Any(), a1, gomock.Any()).Return(errors.New("save error")),
+					m.healthRepo.EXPECT().Save(gomock.Any(), a2, gomock.Any()).Return(nil),
+				)
+			},
+		},
+		{
+			name: "nothing_due",
+			setup: func(m *mocks) {
+				m.healthRepo.EXPECT().ListDue(gomock.Any(), cutoff, linkCheckBatchSize).Return(nil, nil)
+			},
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocks) {
+				m.healthRepo.EXPECT().ListDue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("list error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				healthRepo: mockpage.NewMockLinkHealthRepository(ctrl),
+				checker:    mocklinkchecker.NewMockLinkChecker(ctrl),
+			}
+			tt.setup(m)
+
+			c := NewLinkChecker(m.healthRepo, m.checker)
+			c.hostDelay = time.Millisecond
+			c.scan(ctx)
+		})
+	}
+}
+
+func TestLinkChecker_scan_waitsBetweenRequestsToSameHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthRepo := mockpage.NewMockLinkHealthRepository(ctrl)
+	checker := mocklinkchecker.NewMockLinkChecker(ctrl)
+
+	links := dpage.Links{
+		dpage.ReconstructLink("https://example.com/1", "", 0, dpage.WithLinkID("1")),
+		dpage.ReconstructLink("https://example.com/2", "", 0, dpage.WithLinkID("2")),
+	}
+	healthRepo.EXPECT().ListDue(gomock.Any(), gomock.Any(), gomock.Any()).Return(links, nil)
+	healthRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+	checker.EXPECT().Check(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string) (service.LinkCheckResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			times = append(times, time.Now())
+			return service.LinkCheckResult{StatusCode: 200}, nil
+		}).Times(2)
+
+	c := NewLinkChecker(healthRepo, checker)
+	c.hostDelay = 50 * time.Millisecond
+	c.scan(context.Background())
+
+	if got := times[1].Sub(times[0]); got < c.hostDelay {
+		t.Fatalf("requests to the same host %v apart, want at least %v", got, c.hostDelay)
+	}
+}
+
+func TestLinkChecker_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthRepo := mockpage.NewMockLinkHealthRepository(ctrl)
+	checker := mocklinkchecker.NewMockLinkChecker(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	healthRepo.EXPECT().ListDue(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, time.Time, int) (dpage.Links, error) {
+			cancel()
+			return nil, nil
+		})
+
+	c := NewLinkChecker(healthRepo, checker)
+	done := make(chan struct{})
+	go func() {
+		c.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was cancelled")
+	}
+}
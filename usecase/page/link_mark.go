@@ -0,0 +1,88 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_mark/link_mark.go -source=./link_mark.go -package=mocklinkmarkusecase
+type LinkMarkUsecase interface {
+	// MarkLink sets the user's own status on the link and returns the link.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	MarkLink(ctx context.Context, pageID string, linkID string, status dpage.LinkStatus) (*dpage.Link, error)
+}
+
+type linkMarkUsecase struct {
+	repository struct {
+		page      dpage.PageRepository
+		linkState dpage.LinkStateRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkMarkUsecase(
+	pageRepo dpage.PageRepository,
+	linkStateRepo dpage.LinkStateRepository,
+	txnService service.TransactionService,
+) LinkMarkUsecase {
+	return &linkMarkUsecase{
+		repository: struct {
+			page      dpage.PageRepository
+			linkState dpage.LinkStateRepository
+		}{
+			page:      pageRepo,
+			linkState: linkStateRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkMarkUsecase) MarkLink(ctx context.Context, pageID string, linkID string, status dpage.LinkStatus) (*dpage.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkMarkUsecase.MarkLink")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Marking link %s on page %s as %s", linkID, pageID, status)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		state, err := page.MarkLink(user, linkID, status)
+		if err != nil {
+			return err
+		}
+		return u.repository.linkState.Save(ctx, []string{linkID}, state)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := page.Link(linkID)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
@@ -0,0 +1,79 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_mark_all_read/link_mark_all_read.go -source=./link_mark_all_read.go -package=mocklinkmarkallreadusecase
+type LinkMarkAllReadUsecase interface {
+	// MarkAllRead marks every link of the page the user has not read yet as read.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	MarkAllRead(ctx context.Context, pageID string) error
+}
+
+type linkMarkAllReadUsecase struct {
+	repository struct {
+		page      dpage.PageRepository
+		linkState dpage.LinkStateRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkMarkAllReadUsecase(
+	pageRepo dpage.PageRepository,
+	linkStateRepo dpage.LinkStateRepository,
+	txnService service.TransactionService,
+) LinkMarkAllReadUsecase {
+	return &linkMarkAllReadUsecase{
+		repository: struct {
+			page      dpage.PageRepository
+			linkState dpage.LinkStateRepository
+		}{
+			page:      pageRepo,
+			linkState: linkStateRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkMarkAllReadUsecase) MarkAllRead(ctx context.Context, pageID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkMarkAllReadUsecase.MarkAllRead")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Marking all links on page %s as read", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		linkIDs, err := page.MarkAllRead(user)
+		if err != nil {
+			return err
+		}
+		return u.repository.linkState.Save(ctx, linkIDs, dpage.ReconstructLinkState(user.ID(), dpage.LinkStatusRead))
+	})
+}
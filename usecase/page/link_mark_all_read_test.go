@@ -0,0 +1,132 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkMarkAllReadUsecase_MarkAllRead(t *testing.T) {
+	type mocks struct {
+		pageRepo      *mockpage.MockPageRepository
+		linkStateRepo *mockpage.MockLinkStateRepository
+		txn           *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	otherUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "", 1, dpage.WithLinkID("l1")),
+			dpage.ReconstructLink("https://b.com", "", 2, dpage.WithLinkID("l2"),
+				dpage.WithLinkStates(dpage.LinkStates{dpage.ReconstructLinkState("1", dpage.LinkStatusDone)})),
+			dpage.ReconstructLink("https://c.com", "", 3, dpage.WithLinkID("l3")),
+		}, duser.Users{})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	read := dpage.ReconstructLinkState("1", dpage.LinkStatusRead)
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.linkStateRepo.EXPECT().Save(gomock.Any(), []string{"l1", "l3"}, read).Return(nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_joined",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), otherUser),
+				pageID: "1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.linkStateRepo.EXPECT().Save(gomock.Any(), []string{"l1", "l3"}, read).Return(errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:      mockpage.NewMockPageRepository(ctrl),
+				linkStateRepo: mockpage.NewMockLinkStateRepository(ctrl),
+				txn:           mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkMarkAllReadUsecase(m.pageRepo, m.linkStateRepo, m.txn)
+			err := u.MarkAllRead(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type LinkMoveUsecaseInput struct {
+	SourcePageID string
+	TargetPageID string
+	LinkIDs      []string
+	// Copy keeps the links on the source page.
+	Copy bool
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_move/link_move.go -source=./link_move.go -package=mocklinkmoveusecase
+type LinkMoveUsecase interface {
+	// LinkMove moves or copies links from one page to another in one transaction.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext and must be authorized on both pages.
+	LinkMove(ctx context.Context, input LinkMoveUsecaseInput) error
+}
+
+type linkMoveUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkMoveUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) LinkMoveUsecase {
+	return &linkMoveUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkMoveUsecase) LinkMove(ctx context.Context, input LinkMoveUsecaseInput) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkMoveUsecase.LinkMove")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Moving %d links from page %s to page %s copy=%t", len(input.LinkIDs), input.SourcePageID, input.TargetPageID, input.Copy)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	if input.SourcePageID == input.TargetPageID {
+		return dpage.ErrSamePage
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		source, err := u.repository.page.Get(ctx, input.SourcePageID)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return ErrPageNotFound
+		}
+
+		target, err := u.repository.page.Get(ctx, input.TargetPageID)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return ErrPageNotFound
+		}
+
+		if err := source.MoveLinks(user, target, input.LinkIDs, input.Copy); err != nil {
+			return err
+		}
+
+		if !input.Copy {
+			if _, err := u.repository.page.Save(ctx, source); err != nil {
+				return err
+			}
+		}
+		_, err = u.repository.page.Save(ctx, target)
+		return err
+	})
+}
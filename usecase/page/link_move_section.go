@@ -0,0 +1,75 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_move_section/link_move_section.go -source=./link_move_section.go -package=mocklinkmovesectionusecase
+type LinkMoveSectionUsecase interface {
+	// MoveLinksToSection moves links to the end of a section. An empty sectionID moves them out of any section.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	MoveLinksToSection(ctx context.Context, pageID string, linkIDs []string, sectionID string) error
+}
+
+type linkMoveSectionUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkMoveSectionUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) LinkMoveSectionUsecase {
+	return &linkMoveSectionUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkMoveSectionUsecase) MoveLinksToSection(ctx context.Context, pageID string, linkIDs []string, sectionID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkMoveSectionUsecase.MoveLinksToSection")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Moving %d links of page %s to section %q", len(linkIDs), pageID, sectionID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.MoveLinksToSection(user, linkIDs, sectionID); err != nil {
+			return err
+		}
+		_, err := u.repository.page.Save(ctx, page)
+		return err
+	})
+}
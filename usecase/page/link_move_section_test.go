@@ -0,0 +1,151 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkMoveSectionUsecase_MoveLinksToSection(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx       context.Context
+		pageID    string
+		linkIDs   []string
+		sectionID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	otherUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	sections := func() dpage.Sections {
+		return dpage.Sections{
+			dpage.ReconstructSection("s1", "Section 1", 1),
+			dpage.ReconstructSection("s2", "Section 2", 2),
+		}
+	}
+	links := func() dpage.Links {
+		return dpage.Links{
+			dpage.ReconstructLink("https://example.com/1", "memo1", 1, dpage.WithLinkID("l1")),
+			dpage.ReconstructLink("https://example.com/2", "memo2", 2, dpage.WithLinkID("l2"), dpage.WithLinkSectionID("s1")),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", links(), duser.Users{}, dpage.WithSections(sections()))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+					dpage.ReconstructLink("https://example.com/2", "memo2", 1, dpage.WithLinkID("l2"), dpage.WithLinkSectionID("s1")),
+					dpage.ReconstructLink("https://example.com/1", "memo1", 2, dpage.WithLinkID("l1"), dpage.WithLinkSectionID("s2")),
+				}, duser.Users{}, dpage.WithSections(sections()))).Return(page, nil)
+			},
+			args: args{
+				ctx:       ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:    "1",
+				linkIDs:   []string{"l1"},
+				sectionID: "s2",
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:       context.Background(),
+				pageID:    "1",
+				linkIDs:   []string{"l1"},
+				sectionID: "s2",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:       ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:    "1",
+				linkIDs:   []string{"l1"},
+				sectionID: "s2",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_joined",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", links(), duser.Users{}, dpage.WithSections(sections()))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{
+				ctx:       ctxuser.WithUser(context.Background(), otherUser),
+				pageID:    "1",
+				linkIDs:   []string{"l1"},
+				sectionID: "s2",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "section_not_found",
+			setup: func(m *mocks) {
+				page := dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", links(), duser.Users{}, dpage.WithSections(sections()))
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(page, nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			args: args{
+				ctx:       ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:    "1",
+				linkIDs:   []string{"l1"},
+				sectionID: "unknown",
+			},
+			wantErr: dpage.ErrNotFoundSection,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkMoveSectionUsecase(m.pageRepo, m.txn)
+			err := u.MoveLinksToSection(tt.args.ctx, tt.args.pageID, tt.args.linkIDs, tt.args.sectionID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
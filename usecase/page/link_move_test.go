@@ -0,0 +1,169 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkMoveUsecase_LinkMove(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx   context.Context
+		input LinkMoveUsecaseInput
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	errSave := errors.New("save error")
+
+	newSource := func() *dpage.Page {
+		return dpage.ReconstructPage("src", "Source", *creatorUser, "code1", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("l1")),
+			dpage.ReconstructLink("https://b.com", "B", 2, dpage.WithLinkID("l2")),
+		}, duser.Users{memberUser})
+	}
+	newTarget := func() *dpage.Page {
+		return dpage.ReconstructPage("dst", "Target", *creatorUser, "code2", dpage.Links{
+			dpage.ReconstructLink("https://z.com", "", 1, dpage.WithLinkID("l9")),
+		}, nil)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "move",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "src").Return(newSource(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "dst").Return(newTarget(), nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("src", "Source", *creatorUser, "code1", dpage.Links{
+					dpage.ReconstructLink("https://b.com", "B", 1, dpage.WithLinkID("l2")),
+				}, duser.Users{memberUser})).Return(nil, nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("dst", "Target", *creatorUser, "code2", dpage.Links{
+					dpage.ReconstructLink("https://z.com", "", 1, dpage.WithLinkID("l9")),
+					dpage.ReconstructLink("https://a.com", "A", 2),
+				}, nil)).Return(nil, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l1"}},
+			},
+		},
+		{
+			name: "copy_saves_only_target",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "src").Return(newSource(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "dst").Return(newTarget(), nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPage("dst", "Target", *creatorUser, "code2", dpage.Links{
+					dpage.ReconstructLink("https://z.com", "", 1, dpage.WithLinkID("l9")),
+					dpage.ReconstructLink("https://a.com", "A", 2),
+					dpage.ReconstructLink("https://b.com", "B", 3),
+				}, nil)).Return(nil, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l2", "l1"}, Copy: true},
+			},
+		},
+		{
+			name: "not_authorized_on_target",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "src").Return(newSource(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "dst").Return(newTarget(), nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), memberUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l1"}},
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "target_not_found",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "src").Return(newSource(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "dst").Return(nil, nil)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l1"}},
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "same_page",
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "src", LinkIDs: []string{"l1"}},
+			},
+			wantErr: dpage.ErrSamePage,
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:   context.Background(),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l1"}},
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "src").Return(newSource(), nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "dst").Return(newTarget(), nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errSave)
+			},
+			args: args{
+				ctx:   ctxuser.WithUser(context.Background(), creatorUser),
+				input: LinkMoveUsecaseInput{SourcePageID: "src", TargetPageID: "dst", LinkIDs: []string{"l1"}},
+			},
+			wantErr: errSave,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkMoveUsecase(m.pageRepo, m.txn)
+			err := u.LinkMove(tt.args.ctx, tt.args.input)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_react/link_react.go -source=./link_react.go -package=mocklinkreactusecase
+type LinkReactUsecase interface {
+	// React sets the user's reaction to the link and returns the link with its updated reactions.
+	// An empty emoji is an upvote. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	React(ctx context.Context, pageID string, linkID string, emoji string) (*dpage.Link, error)
+}
+
+type linkReactUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		reaction dpage.ReactionRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkReactUsecase(
+	pageRepo dpage.PageRepository,
+	reactionRepo dpage.ReactionRepository,
+	txnService service.TransactionService,
+) LinkReactUsecase {
+	return &linkReactUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			reaction dpage.ReactionRepository
+		}{
+			page:     pageRepo,
+			reaction: reactionRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkReactUsecase) React(ctx context.Context, pageID string, linkID string, emoji string) (*dpage.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkReactUsecase.React")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Reacting %q to link %s on page %s", emoji, linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		reaction, err := page.React(user, linkID, emoji)
+		if err != nil {
+			return err
+		}
+		return u.repository.reaction.Save(ctx, linkID, reaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := page.Link(linkID)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
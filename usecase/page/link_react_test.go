@@ -0,0 +1,160 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkReactUsecase_React(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		reactionRepo *mockpage.MockReactionRepository
+		txn          *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+		emoji  string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	otherUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("l1")),
+		}, duser.Users{})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Link
+		wantErr error
+	}{
+		{
+			name: "success_upvote",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.reactionRepo.EXPECT().Save(gomock.Any(), "l1", dpage.ReconstructReaction("1", dpage.UpvoteReaction)).Return(nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			want: func() *dpage.Link {
+				l := dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("l1"),
+					dpage.WithLinkReactions(dpage.Reactions{dpage.ReconstructReaction("1", dpage.UpvoteReaction)}))
+				return &l
+			}(),
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_joined",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), otherUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "invalid_reaction",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+				emoji:  "nice",
+			},
+			wantErr: dpage.ErrInvalidReaction,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.reactionRepo.EXPECT().Save(gomock.Any(), "l1", gomock.Any()).Return(errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+				emoji:  "🎉",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				reactionRepo: mockpage.NewMockReactionRepository(ctrl),
+				txn:          mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkReactUsecase(m.pageRepo, m.reactionRepo, m.txn)
+			got, err := u.React(tt.args.ctx, tt.args.pageID, tt.args.linkID, tt.args.emoji)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Link{}, dpage.Reaction{})); diff != "" {
+				t.Errorf("React() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_redirect_accept/link_redirect_accept.go -source=./link_redirect_accept.go -package=mocklinkredirectacceptusecase
+type LinkRedirectAcceptUsecase interface {
+	// AcceptLinkRedirect replaces the URL of a link the dead link checker found redirected with the target of the
+	// redirect and returns the link. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	AcceptLinkRedirect(ctx context.Context, pageID string, linkID string) (*dpage.Link, error)
+}
+
+type linkRedirectAcceptUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkRedirectAcceptUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) LinkRedirectAcceptUsecase {
+	return &linkRedirectAcceptUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkRedirectAcceptUsecase) AcceptLinkRedirect(ctx context.Context, pageID string, linkID string) (*dpage.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkRedirectAcceptUsecase.AcceptLinkRedirect")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Accepting redirect of link %s on page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var link dpage.Link
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if _, err := page.AcceptLinkRedirect(user, linkID); err != nil {
+			return err
+		}
+		saved, err := u.repository.page.Save(ctx, page)
+		if err != nil {
+			return err
+		}
+		link, err = saved.Link(linkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
@@ -0,0 +1,147 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkRedirectAcceptUsecase_AcceptLinkRedirect(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	checkedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://example.com/old", "memo", 1, dpage.WithLinkID("l1"),
+				dpage.WithLinkHealth(dpage.NewLinkHealth(308, "https://example.com/new", checkedAt))),
+		}, duser.Users{memberUser})
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Link
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						return p, nil
+					})
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			want: func() *dpage.Link {
+				l := dpage.ReconstructLink("https://example.com/new", "memo", 1, dpage.WithLinkID("l1"))
+				return &l
+			}(),
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "member_is_not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkRedirectAcceptUsecase(m.pageRepo, m.txn)
+			got, err := u.AcceptLinkRedirect(tt.args.ctx, tt.args.pageID, tt.args.linkID)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+				t.Errorf("AcceptLinkRedirect() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
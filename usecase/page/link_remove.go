@@ -2,9 +2,11 @@ package page
 
 import (
 	"context"
+	"slices"
 
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/log"
 	"github.com/naka-sei/tsudzuri/pkg/trace"
@@ -27,13 +29,15 @@ type linkRemoveUsecase struct {
 		page dpage.PageRepository
 	}
 	service struct {
-		txn service.TransactionService
+		txn     service.TransactionService
+		webhook service.WebhookService
 	}
 }
 
 func NewLinkRemoveUsecase(
 	pageRepo dpage.PageRepository,
 	txnService service.TransactionService,
+	webhookService service.WebhookService,
 ) LinkRemoveUseCase {
 	u := &linkRemoveUsecase{
 		repository: struct {
@@ -42,9 +46,11 @@ func NewLinkRemoveUsecase(
 			page: pageRepo,
 		},
 		service: struct {
-			txn service.TransactionService
+			txn     service.TransactionService
+			webhook service.WebhookService
 		}{
-			txn: txnService,
+			txn:     txnService,
+			webhook: webhookService,
 		},
 	}
 	return u
@@ -75,11 +81,23 @@ func (u *linkRemoveUsecase) LinkRemove(ctx context.Context, input LinkRemoveUsec
 		return err
 	}
 
-	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+	before := slices.Clone(page.Links())
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		if err := page.RemoveLink(user, input.URL); err != nil {
 			return err
 		}
 		_, err = u.repository.page.Save(ctx, page)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	u.service.webhook.Dispatch(ctx, &dwebhook.Event{
+		Type:  dwebhook.EventLinkRemoved,
+		Page:  page,
+		Actor: user,
+		Links: dwebhook.RemovedLinks(before, page.Links()),
+	})
+	return nil
 }
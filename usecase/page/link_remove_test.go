@@ -5,12 +5,15 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	dpage "github.com/naka-sei/tsudzuri/domain/page"
 	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
 	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
 	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
 	"github.com/naka-sei/tsudzuri/pkg/testutil"
 	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	mockwebhook "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_webhook"
 	"go.uber.org/mock/gomock"
 )
 
@@ -18,6 +21,7 @@ func TestLinkRemoveUsecase_LinkRemove(t *testing.T) {
 	type mocks struct {
 		pageRepo *mockpage.MockPageRepository
 		txn      *mocktxn.MockTransactionService
+		webhook  *mockwebhook.MockWebhookService
 	}
 	type args struct {
 		ctx   context.Context
@@ -44,6 +48,17 @@ func TestLinkRemoveUsecase_LinkRemove(t *testing.T) {
 		dpage.ReconstructLink("https://link3.com", "Memo 3", 2),
 	}
 	expectedPageAfterRemove := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", expectedLinks, invitedUsers)
+	expectDispatch := func(m *mocks, actor *duser.User) {
+		m.webhook.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *dwebhook.Event) {
+			want := dpage.Links{dpage.ReconstructLink("https://link2.com", "Memo 2", 2)}
+			if event.Type != dwebhook.EventLinkRemoved || event.Actor != actor {
+				t.Errorf("unexpected webhook event: %+v", event)
+			}
+			if diff := cmp.Diff(want, event.Links, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+				t.Errorf("removed links mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
 
 	tests := []struct {
 		name  string
@@ -66,6 +81,7 @@ func TestLinkRemoveUsecase_LinkRemove(t *testing.T) {
 					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
 				)
 				m.pageRepo.EXPECT().Save(gomock.Any(), expectedPageAfterRemove).Return(page, nil)
+				expectDispatch(m, creator)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), creator),
@@ -84,6 +100,7 @@ func TestLinkRemoveUsecase_LinkRemove(t *testing.T) {
 					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
 				)
 				m.pageRepo.EXPECT().Save(gomock.Any(), expectedPageAfterRemove).Return(expectedPageAfterRemove, nil)
+				expectDispatch(m, invitedUser)
 			},
 			args: args{
 				ctx: ctxuser.WithUser(context.Background(), invitedUser),
@@ -211,10 +228,11 @@ func TestLinkRemoveUsecase_LinkRemove(t *testing.T) {
 			t.Parallel()
 			m := &mocks{
 				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				webhook:  mockwebhook.NewMockWebhookService(ctrl),
 				txn:      mocktxn.NewMockTransactionService(ctrl),
 			}
 			tt.setup(m)
-			u := NewLinkRemoveUsecase(m.pageRepo, m.txn)
+			u := NewLinkRemoveUsecase(m.pageRepo, m.txn, m.webhook)
 			err := u.LinkRemove(tt.args.ctx, tt.args.input)
 			testutil.EqualErr(t, tt.want.err, err)
 		})
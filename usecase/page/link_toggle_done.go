@@ -0,0 +1,85 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_toggle_done/link_toggle_done.go -source=./link_toggle_done.go -package=mocklinktoggledoneusecase
+type LinkToggleDoneUsecase interface {
+	// ToggleLinkDone marks the link done, or clears its done flag, on a page in checklist mode and returns the link.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	ToggleLinkDone(ctx context.Context, pageID string, linkID string) (*dpage.Link, error)
+}
+
+type linkToggleDoneUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkToggleDoneUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) LinkToggleDoneUsecase {
+	return &linkToggleDoneUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkToggleDoneUsecase) ToggleLinkDone(ctx context.Context, pageID string, linkID string) (*dpage.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkToggleDoneUsecase.ToggleLinkDone")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Toggling done flag of link %s on page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var link dpage.Link
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if _, err := page.ToggleLinkDone(user, linkID, ctxtime.Now(ctx)); err != nil {
+			return err
+		}
+		saved, err := u.repository.page.Save(ctx, page)
+		if err != nil {
+			return err
+		}
+		link, err = saved.Link(linkID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
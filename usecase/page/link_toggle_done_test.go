@@ -0,0 +1,164 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestLinkToggleDoneUsecase_ToggleLinkDone(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newPage := func(opts ...dpage.ReconstructOption) *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("l1")),
+		}, duser.Users{memberUser}, opts...)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	withUser := func(u *duser.User) context.Context {
+		return ctxtime.WithTime(ctxuser.WithUser(context.Background(), u), now)
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Link
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithChecklist(true)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *dpage.Page) (*dpage.Page, error) {
+						return p, nil
+					})
+			},
+			args: args{
+				ctx:    withUser(memberUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			want: func() *dpage.Link {
+				l := dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("l1"), dpage.WithLinkDone("2", now))
+				return &l
+			}(),
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:    withUser(creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_checklist",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    withUser(creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: dpage.ErrNotChecklist,
+		},
+		{
+			name: "not_joined",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithChecklist(true)), nil)
+				runTxn(m)
+			},
+			args: args{
+				ctx:    withUser(otherUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(dpage.WithChecklist(true)), nil)
+				runTxn(m)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{
+				ctx:    withUser(creatorUser),
+				pageID: "1",
+				linkID: "l1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewLinkToggleDoneUsecase(m.pageRepo, m.txn)
+			got, err := u.ToggleLinkDone(tt.args.ctx, tt.args.pageID, tt.args.linkID)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Link{})); diff != "" {
+				t.Errorf("ToggleLinkDone() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_unreact/link_unreact.go -source=./link_unreact.go -package=mocklinkunreactusecase
+type LinkUnreactUsecase interface {
+	// Unreact removes the user's reaction to the link and returns the link with its updated reactions.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Unreact(ctx context.Context, pageID string, linkID string) (*dpage.Link, error)
+}
+
+type linkUnreactUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		reaction dpage.ReactionRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewLinkUnreactUsecase(
+	pageRepo dpage.PageRepository,
+	reactionRepo dpage.ReactionRepository,
+	txnService service.TransactionService,
+) LinkUnreactUsecase {
+	return &linkUnreactUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			reaction dpage.ReactionRepository
+		}{
+			page:     pageRepo,
+			reaction: reactionRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *linkUnreactUsecase) Unreact(ctx context.Context, pageID string, linkID string) (*dpage.Link, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/linkUnreactUsecase.Unreact")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Removing reaction to link %s on page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.Unreact(user, linkID); err != nil {
+			return err
+		}
+		return u.repository.reaction.Delete(ctx, linkID, user.ID())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := page.Link(linkID)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
@@ -12,28 +12,33 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
 type ListUsecase interface {
-	// List returns a list of pages. The user is obtained from context via pkg/ctx/user.UserFromContext.
-	List(ctx context.Context, options ...dpage.SearchOption) ([]*dpage.Page, error)
+	// List returns a list of pages in the user's own order together with the user's page preferences.
+	// Pinned pages come first, then pages the user placed, then the rest.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, options ...dpage.SearchOption) ([]*dpage.Page, *dpage.Preferences, error)
 }
 
 type listUsecase struct {
 	repository struct {
-		page dpage.PageRepository
+		page       dpage.PageRepository
+		preference dpage.PreferenceRepository
 	}
 }
 
-func NewListUsecase(pageRepo dpage.PageRepository) ListUsecase {
+func NewListUsecase(pageRepo dpage.PageRepository, preferenceRepo dpage.PreferenceRepository) ListUsecase {
 	u := &listUsecase{
 		repository: struct {
-			page dpage.PageRepository
+			page       dpage.PageRepository
+			preference dpage.PreferenceRepository
 		}{
-			page: pageRepo,
+			page:       pageRepo,
+			preference: preferenceRepo,
 		},
 	}
 	return u
 }
 
-func (u *listUsecase) List(ctx context.Context, options ...dpage.SearchOption) ([]*dpage.Page, error) {
+func (u *listUsecase) List(ctx context.Context, options ...dpage.SearchOption) ([]*dpage.Page, *dpage.Preferences, error) {
 	ctx, end := trace.StartSpan(ctx, "usecase/page/listUsecase.List")
 	defer end()
 
@@ -41,15 +46,21 @@ func (u *listUsecase) List(ctx context.Context, options ...dpage.SearchOption) (
 
 	user, ok := ctxuser.UserFromContext(ctx)
 	if !ok {
-		return nil, duser.ErrUserNotFound
+		return nil, nil, duser.ErrUserNotFound
 	}
 
 	l.Sugar().Infof("Listing pages for user: %s options: %d", user.ID(), len(options))
 
 	pages, err := u.repository.page.List(ctx, options...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return pages, nil
+	preferences, err := u.repository.preference.Get(ctx, user.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	preferences.Sort(pages)
+
+	return pages, preferences, nil
 }
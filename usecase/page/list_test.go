@@ -17,15 +17,17 @@ import (
 
 func TestListUsecase_List(t *testing.T) {
 	type mocks struct {
-		pageRepo *mockpage.MockPageRepository
+		pageRepo       *mockpage.MockPageRepository
+		preferenceRepo *mockpage.MockPreferenceRepository
 	}
 	type args struct {
 		ctx     context.Context
 		options []dpage.SearchOption
 	}
 	type want struct {
-		pages []*dpage.Page
-		err   error
+		pages       []*dpage.Page
+		preferences *dpage.Preferences
+		err         error
 	}
 
 	creator := duser.ReconstructUser("user-id-1", "uid-1", "anonymous", nil)
@@ -54,14 +56,16 @@ func TestListUsecase_List(t *testing.T) {
 						return []*dpage.Page{p1, p2, p4}, nil
 					},
 				)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), creator.ID()).Return(dpage.ReconstructPreferences(creator.ID(), nil), nil)
 			},
 			args: args{
 				ctx:     ctxuser.WithUser(context.Background(), creator),
 				options: nil,
 			},
 			want: want{
-				pages: []*dpage.Page{p1, p2, p4},
-				err:   nil,
+				pages:       []*dpage.Page{p1, p2, p4},
+				preferences: dpage.ReconstructPreferences(creator.ID(), nil),
+				err:         nil,
 			},
 		},
 		{
@@ -75,14 +79,16 @@ func TestListUsecase_List(t *testing.T) {
 						return []*dpage.Page{p3}, nil
 					},
 				)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), invitedUser.ID()).Return(dpage.ReconstructPreferences(invitedUser.ID(), nil), nil)
 			},
 			args: args{
 				ctx:     ctxuser.WithUser(context.Background(), invitedUser),
 				options: nil,
 			},
 			want: want{
-				pages: []*dpage.Page{p3},
-				err:   nil,
+				pages:       []*dpage.Page{p3},
+				preferences: dpage.ReconstructPreferences(invitedUser.ID(), nil),
+				err:         nil,
 			},
 		},
 		{
@@ -96,14 +102,16 @@ func TestListUsecase_List(t *testing.T) {
 						return nil, nil
 					},
 				)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), otherUser.ID()).Return(dpage.ReconstructPreferences(otherUser.ID(), nil), nil)
 			},
 			args: args{
 				ctx:     ctxuser.WithUser(context.Background(), otherUser),
 				options: nil,
 			},
 			want: want{
-				pages: nil,
-				err:   nil,
+				pages:       nil,
+				preferences: dpage.ReconstructPreferences(otherUser.ID(), nil),
+				err:         nil,
 			},
 		},
 		{
@@ -159,14 +167,16 @@ func TestListUsecase_List(t *testing.T) {
 						return []*dpage.Page{p1, p4}, nil
 					},
 				)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), creator.ID()).Return(dpage.ReconstructPreferences(creator.ID(), nil), nil)
 			},
 			args: args{
 				ctx:     ctxuser.WithUser(context.Background(), creator),
 				options: []dpage.SearchOption{dpage.WithIDs([]string{"page-1", "page-4"})},
 			},
 			want: want{
-				pages: []*dpage.Page{p1, p4},
-				err:   nil,
+				pages:       []*dpage.Page{p1, p4},
+				preferences: dpage.ReconstructPreferences(creator.ID(), nil),
+				err:         nil,
 			},
 		},
 		{
@@ -184,14 +194,49 @@ func TestListUsecase_List(t *testing.T) {
 						return []*dpage.Page{p1, p2}, nil
 					},
 				)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), invitedUser.ID()).Return(dpage.ReconstructPreferences(invitedUser.ID(), nil), nil)
 			},
 			args: args{
 				ctx:     ctxuser.WithUser(context.Background(), invitedUser),
 				options: []dpage.SearchOption{dpage.WithCreatedByUserID(creator.ID())},
 			},
 			want: want{
-				pages: []*dpage.Page{p1, p2},
-				err:   nil,
+				pages:       []*dpage.Page{p1, p2},
+				preferences: dpage.ReconstructPreferences(invitedUser.ID(), nil),
+				err:         nil,
+			},
+		},
+		{
+			name: "success_sorted_by_preferences",
+			setup: func(f *mocks, t *testing.T) {
+				f.pageRepo.EXPECT().List(gomock.Any()).Return([]*dpage.Page{p1, p2, p4}, nil)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), creator.ID()).Return(dpage.ReconstructPreferences(creator.ID(), []dpage.Preference{
+					dpage.ReconstructPreference("page-4", true, 0, false),
+					dpage.ReconstructPreference("page-2", false, 1, false),
+				}), nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+			},
+			want: want{
+				pages: []*dpage.Page{p4, p2, p1},
+				preferences: dpage.ReconstructPreferences(creator.ID(), []dpage.Preference{
+					dpage.ReconstructPreference("page-4", true, 0, false),
+					dpage.ReconstructPreference("page-2", false, 1, false),
+				}),
+			},
+		},
+		{
+			name: "preference_repo_error",
+			setup: func(f *mocks, t *testing.T) {
+				f.pageRepo.EXPECT().List(gomock.Any()).Return([]*dpage.Page{p1}, nil)
+				f.preferenceRepo.EXPECT().Get(gomock.Any(), creator.ID()).Return(nil, errors.New("get preferences error"))
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), creator),
+			},
+			want: want{
+				err: errors.New("get preferences error"),
 			},
 		},
 	}
@@ -202,16 +247,22 @@ func TestListUsecase_List(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			f := &mocks{pageRepo: mockpage.NewMockPageRepository(ctrl)}
+			f := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				preferenceRepo: mockpage.NewMockPreferenceRepository(ctrl),
+			}
 			if tt.setup != nil {
 				tt.setup(f, t)
 			}
-			u := NewListUsecase(f.pageRepo)
-			got, err := u.List(tt.args.ctx, tt.args.options...)
+			u := NewListUsecase(f.pageRepo, f.preferenceRepo)
+			got, gotPreferences, err := u.List(tt.args.ctx, tt.args.options...)
 			testutil.EqualErr(t, tt.want.err, err)
-			if diff := cmp.Diff(tt.want.pages, got, cmp.AllowUnexported(dpage.Page{}, duser.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.pages, got, cmp.AllowUnexported(dpage.Page{}, duser.User{}, duser.Profile{})); diff != "" {
 				t.Errorf("List() page mismatch (-want +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.want.preferences, gotPreferences, cmp.AllowUnexported(dpage.Preferences{}, dpage.Preference{})); diff != "" {
+				t.Errorf("List() preferences mismatch (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./archive.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_archive/archive.go -source=./archive.go -package=mockarchiveusecase
+//
+
+// Package mockarchiveusecase is a generated GoMock package.
+package mockarchiveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArchiveUsecase is a mock of ArchiveUsecase interface.
+type MockArchiveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveUsecaseMockRecorder is the mock recorder for MockArchiveUsecase.
+type MockArchiveUsecaseMockRecorder struct {
+	mock *MockArchiveUsecase
+}
+
+// NewMockArchiveUsecase creates a new mock instance.
+func NewMockArchiveUsecase(ctrl *gomock.Controller) *MockArchiveUsecase {
+	mock := &MockArchiveUsecase{ctrl: ctrl}
+	mock.recorder = &MockArchiveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveUsecase) EXPECT() *MockArchiveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockArchiveUsecase) Archive(ctx context.Context, pageID string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, pageID)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockArchiveUsecaseMockRecorder) Archive(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockArchiveUsecase)(nil).Archive), ctx, pageID)
+}
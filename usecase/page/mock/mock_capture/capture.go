@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./capture.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_capture/capture.go -source=./capture.go -package=mockcaptureusecase
+//
+
+// Package mockcaptureusecase is a generated GoMock package.
+package mockcaptureusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	page0 "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCaptureUsecase is a mock of CaptureUsecase interface.
+type MockCaptureUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaptureUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCaptureUsecaseMockRecorder is the mock recorder for MockCaptureUsecase.
+type MockCaptureUsecaseMockRecorder struct {
+	mock *MockCaptureUsecase
+}
+
+// NewMockCaptureUsecase creates a new mock instance.
+func NewMockCaptureUsecase(ctrl *gomock.Controller) *MockCaptureUsecase {
+	mock := &MockCaptureUsecase{ctrl: ctrl}
+	mock.recorder = &MockCaptureUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaptureUsecase) EXPECT() *MockCaptureUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Capture mocks base method.
+func (m *MockCaptureUsecase) Capture(ctx context.Context, input page0.CaptureUsecaseInput) (*page.Page, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, input)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockCaptureUsecaseMockRecorder) Capture(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockCaptureUsecase)(nil).Capture), ctx, input)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./checklist_set.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_checklist_set/checklist_set.go -source=./checklist_set.go -package=mockchecklistsetusecase
+//
+
+// Package mockchecklistsetusecase is a generated GoMock package.
+package mockchecklistsetusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChecklistSetUsecase is a mock of ChecklistSetUsecase interface.
+type MockChecklistSetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistSetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistSetUsecaseMockRecorder is the mock recorder for MockChecklistSetUsecase.
+type MockChecklistSetUsecaseMockRecorder struct {
+	mock *MockChecklistSetUsecase
+}
+
+// NewMockChecklistSetUsecase creates a new mock instance.
+func NewMockChecklistSetUsecase(ctrl *gomock.Controller) *MockChecklistSetUsecase {
+	mock := &MockChecklistSetUsecase{ctrl: ctrl}
+	mock.recorder = &MockChecklistSetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistSetUsecase) EXPECT() *MockChecklistSetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SetChecklist mocks base method.
+func (m *MockChecklistSetUsecase) SetChecklist(ctx context.Context, pageID string, enabled bool) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChecklist", ctx, pageID, enabled)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChecklist indicates an expected call of SetChecklist.
+func (mr *MockChecklistSetUsecaseMockRecorder) SetChecklist(ctx, pageID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChecklist", reflect.TypeOf((*MockChecklistSetUsecase)(nil).SetChecklist), ctx, pageID, enabled)
+}
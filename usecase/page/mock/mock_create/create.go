@@ -42,16 +42,16 @@ func (m *MockCreateUsecase) EXPECT() *MockCreateUsecaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockCreateUsecase) Create(ctx context.Context, title string) (*page.Page, error) {
+func (m *MockCreateUsecase) Create(ctx context.Context, title, templateID string) (*page.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, title)
+	ret := m.ctrl.Call(m, "Create", ctx, title, templateID)
 	ret0, _ := ret[0].(*page.Page)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockCreateUsecaseMockRecorder) Create(ctx, title any) *gomock.Call {
+func (mr *MockCreateUsecaseMockRecorder) Create(ctx, title, templateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateUsecase)(nil).Create), ctx, title)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateUsecase)(nil).Create), ctx, title, templateID)
 }
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./duplicate.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_duplicate/duplicate.go -source=./duplicate.go -package=mockduplicateusecase
+//
+
+// Package mockduplicateusecase is a generated GoMock package.
+package mockduplicateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDuplicateUsecase is a mock of DuplicateUsecase interface.
+type MockDuplicateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDuplicateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDuplicateUsecaseMockRecorder is the mock recorder for MockDuplicateUsecase.
+type MockDuplicateUsecaseMockRecorder struct {
+	mock *MockDuplicateUsecase
+}
+
+// NewMockDuplicateUsecase creates a new mock instance.
+func NewMockDuplicateUsecase(ctrl *gomock.Controller) *MockDuplicateUsecase {
+	mock := &MockDuplicateUsecase{ctrl: ctrl}
+	mock.recorder = &MockDuplicateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDuplicateUsecase) EXPECT() *MockDuplicateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Duplicate mocks base method.
+func (m *MockDuplicateUsecase) Duplicate(ctx context.Context, pageID string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Duplicate", ctx, pageID)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Duplicate indicates an expected call of Duplicate.
+func (mr *MockDuplicateUsecaseMockRecorder) Duplicate(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Duplicate", reflect.TypeOf((*MockDuplicateUsecase)(nil).Duplicate), ctx, pageID)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	page "github.com/naka-sei/tsudzuri/domain/page"
+	page0 "github.com/naka-sei/tsudzuri/usecase/page"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,15 +43,20 @@ func (m *MockEditUsecase) EXPECT() *MockEditUsecaseMockRecorder {
 }
 
 // Edit mocks base method.
-func (m *MockEditUsecase) Edit(ctx context.Context, pageID, title string, links page.Links) error {
+func (m *MockEditUsecase) Edit(ctx context.Context, pageID, title string, links page.Links, options ...page0.EditOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Edit", ctx, pageID, title, links)
+	varargs := []any{ctx, pageID, title, links}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Edit", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Edit indicates an expected call of Edit.
-func (mr *MockEditUsecaseMockRecorder) Edit(ctx, pageID, title, links any) *gomock.Call {
+func (mr *MockEditUsecaseMockRecorder) Edit(ctx, pageID, title, links any, options ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockEditUsecase)(nil).Edit), ctx, pageID, title, links)
+	varargs := append([]any{ctx, pageID, title, links}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockEditUsecase)(nil).Edit), varargs...)
 }
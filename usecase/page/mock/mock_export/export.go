@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./export.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_export/export.go -source=./export.go -package=mockexportusecase
+//
+
+// Package mockexportusecase is a generated GoMock package.
+package mockexportusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportUsecase is a mock of ExportUsecase interface.
+type MockExportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockExportUsecaseMockRecorder is the mock recorder for MockExportUsecase.
+type MockExportUsecaseMockRecorder struct {
+	mock *MockExportUsecase
+}
+
+// NewMockExportUsecase creates a new mock instance.
+func NewMockExportUsecase(ctrl *gomock.Controller) *MockExportUsecase {
+	mock := &MockExportUsecase{ctrl: ctrl}
+	mock.recorder = &MockExportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUsecase) EXPECT() *MockExportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportUsecase) Export(ctx context.Context, pageID, format string) (*page.ExportUsecaseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, pageID, format)
+	ret0, _ := ret[0].(*page.ExportUsecaseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportUsecaseMockRecorder) Export(ctx, pageID, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportUsecase)(nil).Export), ctx, pageID, format)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_feed/feed.go -source=./feed.go -package=mockfeedusecase
+//
+
+// Package mockfeedusecase is a generated GoMock package.
+package mockfeedusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedUsecase is a mock of FeedUsecase interface.
+type MockFeedUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedUsecaseMockRecorder is the mock recorder for MockFeedUsecase.
+type MockFeedUsecaseMockRecorder struct {
+	mock *MockFeedUsecase
+}
+
+// NewMockFeedUsecase creates a new mock instance.
+func NewMockFeedUsecase(ctrl *gomock.Controller) *MockFeedUsecase {
+	mock := &MockFeedUsecase{ctrl: ctrl}
+	mock.recorder = &MockFeedUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedUsecase) EXPECT() *MockFeedUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Feed mocks base method.
+func (m *MockFeedUsecase) Feed(ctx context.Context, pageID, token string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, pageID, token)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockFeedUsecaseMockRecorder) Feed(ctx, pageID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockFeedUsecase)(nil).Feed), ctx, pageID, token)
+}
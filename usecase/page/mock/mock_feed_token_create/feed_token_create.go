@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed_token_create.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_feed_token_create/feed_token_create.go -source=./feed_token_create.go -package=mockfeedtokencreateusecase
+//
+
+// Package mockfeedtokencreateusecase is a generated GoMock package.
+package mockfeedtokencreateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedTokenCreateUsecase is a mock of FeedTokenCreateUsecase interface.
+type MockFeedTokenCreateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedTokenCreateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedTokenCreateUsecaseMockRecorder is the mock recorder for MockFeedTokenCreateUsecase.
+type MockFeedTokenCreateUsecaseMockRecorder struct {
+	mock *MockFeedTokenCreateUsecase
+}
+
+// NewMockFeedTokenCreateUsecase creates a new mock instance.
+func NewMockFeedTokenCreateUsecase(ctrl *gomock.Controller) *MockFeedTokenCreateUsecase {
+	mock := &MockFeedTokenCreateUsecase{ctrl: ctrl}
+	mock.recorder = &MockFeedTokenCreateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedTokenCreateUsecase) EXPECT() *MockFeedTokenCreateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateFeedToken mocks base method.
+func (m *MockFeedTokenCreateUsecase) CreateFeedToken(ctx context.Context, pageID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedToken", ctx, pageID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeedToken indicates an expected call of CreateFeedToken.
+func (mr *MockFeedTokenCreateUsecaseMockRecorder) CreateFeedToken(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedToken", reflect.TypeOf((*MockFeedTokenCreateUsecase)(nil).CreateFeedToken), ctx, pageID)
+}
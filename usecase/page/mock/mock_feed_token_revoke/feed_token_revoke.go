@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed_token_revoke.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_feed_token_revoke/feed_token_revoke.go -source=./feed_token_revoke.go -package=mockfeedtokenrevokeusecase
+//
+
+// Package mockfeedtokenrevokeusecase is a generated GoMock package.
+package mockfeedtokenrevokeusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedTokenRevokeUsecase is a mock of FeedTokenRevokeUsecase interface.
+type MockFeedTokenRevokeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedTokenRevokeUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedTokenRevokeUsecaseMockRecorder is the mock recorder for MockFeedTokenRevokeUsecase.
+type MockFeedTokenRevokeUsecaseMockRecorder struct {
+	mock *MockFeedTokenRevokeUsecase
+}
+
+// NewMockFeedTokenRevokeUsecase creates a new mock instance.
+func NewMockFeedTokenRevokeUsecase(ctrl *gomock.Controller) *MockFeedTokenRevokeUsecase {
+	mock := &MockFeedTokenRevokeUsecase{ctrl: ctrl}
+	mock.recorder = &MockFeedTokenRevokeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedTokenRevokeUsecase) EXPECT() *MockFeedTokenRevokeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// RevokeFeedToken mocks base method.
+func (m *MockFeedTokenRevokeUsecase) RevokeFeedToken(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFeedToken", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFeedToken indicates an expected call of RevokeFeedToken.
+func (mr *MockFeedTokenRevokeUsecaseMockRecorder) RevokeFeedToken(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeedToken", reflect.TypeOf((*MockFeedTokenRevokeUsecase)(nil).RevokeFeedToken), ctx, pageID)
+}
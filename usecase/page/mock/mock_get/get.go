@@ -42,16 +42,16 @@ func (m *MockGetUsecase) EXPECT() *MockGetUsecaseMockRecorder {
 }
 
 // Get mocks base method.
-func (m *MockGetUsecase) Get(ctx context.Context, pageID string) (*page.Page, error) {
+func (m *MockGetUsecase) Get(ctx context.Context, pageID string, order page.LinkOrder) (*page.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, pageID)
+	ret := m.ctrl.Call(m, "Get", ctx, pageID, order)
 	ret0, _ := ret[0].(*page.Page)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockGetUsecaseMockRecorder) Get(ctx, pageID any) *gomock.Call {
+func (mr *MockGetUsecaseMockRecorder) Get(ctx, pageID, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetUsecase)(nil).Get), ctx, pageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetUsecase)(nil).Get), ctx, pageID, order)
 }
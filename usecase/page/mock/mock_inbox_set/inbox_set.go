@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inbox_set.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_inbox_set/inbox_set.go -source=./inbox_set.go -package=mockinboxsetusecase
+//
+
+// Package mockinboxsetusecase is a generated GoMock package.
+package mockinboxsetusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInboxSetUsecase is a mock of InboxSetUsecase interface.
+type MockInboxSetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxSetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockInboxSetUsecaseMockRecorder is the mock recorder for MockInboxSetUsecase.
+type MockInboxSetUsecaseMockRecorder struct {
+	mock *MockInboxSetUsecase
+}
+
+// NewMockInboxSetUsecase creates a new mock instance.
+func NewMockInboxSetUsecase(ctrl *gomock.Controller) *MockInboxSetUsecase {
+	mock := &MockInboxSetUsecase{ctrl: ctrl}
+	mock.recorder = &MockInboxSetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboxSetUsecase) EXPECT() *MockInboxSetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SetInbox mocks base method.
+func (m *MockInboxSetUsecase) SetInbox(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInbox", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInbox indicates an expected call of SetInbox.
+func (mr *MockInboxSetUsecaseMockRecorder) SetInbox(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInbox", reflect.TypeOf((*MockInboxSetUsecase)(nil).SetInbox), ctx, pageID)
+}
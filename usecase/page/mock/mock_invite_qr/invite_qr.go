@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./invite_qr.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_invite_qr/invite_qr.go -source=./invite_qr.go -package=mockinviteqrusecase
+//
+
+// Package mockinviteqrusecase is a generated GoMock package.
+package mockinviteqrusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	qrcode "github.com/naka-sei/tsudzuri/pkg/qrcode"
+	page "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteQRCodeUsecase is a mock of InviteQRCodeUsecase interface.
+type MockInviteQRCodeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteQRCodeUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteQRCodeUsecaseMockRecorder is the mock recorder for MockInviteQRCodeUsecase.
+type MockInviteQRCodeUsecaseMockRecorder struct {
+	mock *MockInviteQRCodeUsecase
+}
+
+// NewMockInviteQRCodeUsecase creates a new mock instance.
+func NewMockInviteQRCodeUsecase(ctrl *gomock.Controller) *MockInviteQRCodeUsecase {
+	mock := &MockInviteQRCodeUsecase{ctrl: ctrl}
+	mock.recorder = &MockInviteQRCodeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteQRCodeUsecase) EXPECT() *MockInviteQRCodeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// QRCode mocks base method.
+func (m *MockInviteQRCodeUsecase) QRCode(ctx context.Context, pageID, format string, opts qrcode.Options) (*page.InviteQRCodeUsecaseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QRCode", ctx, pageID, format, opts)
+	ret0, _ := ret[0].(*page.InviteQRCodeUsecaseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QRCode indicates an expected call of QRCode.
+func (mr *MockInviteQRCodeUsecaseMockRecorder) QRCode(ctx, pageID, format, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QRCode", reflect.TypeOf((*MockInviteQRCodeUsecase)(nil).QRCode), ctx, pageID, format, opts)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_batch_add.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_batch_add/link_batch_add.go -source=./link_batch_add.go -package=mocklinkbatchaddusecase
+//
+
+// Package mocklinkbatchaddusecase is a generated GoMock package.
+package mocklinkbatchaddusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkBatchAddUsecase is a mock of LinkBatchAddUsecase interface.
+type MockLinkBatchAddUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkBatchAddUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkBatchAddUsecaseMockRecorder is the mock recorder for MockLinkBatchAddUsecase.
+type MockLinkBatchAddUsecaseMockRecorder struct {
+	mock *MockLinkBatchAddUsecase
+}
+
+// NewMockLinkBatchAddUsecase creates a new mock instance.
+func NewMockLinkBatchAddUsecase(ctrl *gomock.Controller) *MockLinkBatchAddUsecase {
+	mock := &MockLinkBatchAddUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkBatchAddUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkBatchAddUsecase) EXPECT() *MockLinkBatchAddUsecaseMockRecorder {
+	return m.recorder
+}
+
+// LinkBatchAdd mocks base method.
+func (m *MockLinkBatchAddUsecase) LinkBatchAdd(ctx context.Context, input page.LinkBatchAddUsecaseInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkBatchAdd", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkBatchAdd indicates an expected call of LinkBatchAdd.
+func (mr *MockLinkBatchAddUsecaseMockRecorder) LinkBatchAdd(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkBatchAdd", reflect.TypeOf((*MockLinkBatchAddUsecase)(nil).LinkBatchAdd), ctx, input)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_batch_remove.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_batch_remove/link_batch_remove.go -source=./link_batch_remove.go -package=mocklinkbatchremoveusecase
+//
+
+// Package mocklinkbatchremoveusecase is a generated GoMock package.
+package mocklinkbatchremoveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkBatchRemoveUsecase is a mock of LinkBatchRemoveUsecase interface.
+type MockLinkBatchRemoveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkBatchRemoveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkBatchRemoveUsecaseMockRecorder is the mock recorder for MockLinkBatchRemoveUsecase.
+type MockLinkBatchRemoveUsecaseMockRecorder struct {
+	mock *MockLinkBatchRemoveUsecase
+}
+
+// NewMockLinkBatchRemoveUsecase creates a new mock instance.
+func NewMockLinkBatchRemoveUsecase(ctrl *gomock.Controller) *MockLinkBatchRemoveUsecase {
+	mock := &MockLinkBatchRemoveUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkBatchRemoveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkBatchRemoveUsecase) EXPECT() *MockLinkBatchRemoveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// LinkBatchRemove mocks base method.
+func (m *MockLinkBatchRemoveUsecase) LinkBatchRemove(ctx context.Context, input page.LinkBatchRemoveUsecaseInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkBatchRemove", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkBatchRemove indicates an expected call of LinkBatchRemove.
+func (mr *MockLinkBatchRemoveUsecaseMockRecorder) LinkBatchRemove(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkBatchRemove", reflect.TypeOf((*MockLinkBatchRemoveUsecase)(nil).LinkBatchRemove), ctx, input)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_mark.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_mark/link_mark.go -source=./link_mark.go -package=mocklinkmarkusecase
+//
+
+// Package mocklinkmarkusecase is a generated GoMock package.
+package mocklinkmarkusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkMarkUsecase is a mock of LinkMarkUsecase interface.
+type MockLinkMarkUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkMarkUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkMarkUsecaseMockRecorder is the mock recorder for MockLinkMarkUsecase.
+type MockLinkMarkUsecaseMockRecorder struct {
+	mock *MockLinkMarkUsecase
+}
+
+// NewMockLinkMarkUsecase creates a new mock instance.
+func NewMockLinkMarkUsecase(ctrl *gomock.Controller) *MockLinkMarkUsecase {
+	mock := &MockLinkMarkUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkMarkUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkMarkUsecase) EXPECT() *MockLinkMarkUsecaseMockRecorder {
+	return m.recorder
+}
+
+// MarkLink mocks base method.
+func (m *MockLinkMarkUsecase) MarkLink(ctx context.Context, pageID, linkID string, status page.LinkStatus) (*page.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLink", ctx, pageID, linkID, status)
+	ret0, _ := ret[0].(*page.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLink indicates an expected call of MarkLink.
+func (mr *MockLinkMarkUsecaseMockRecorder) MarkLink(ctx, pageID, linkID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLink", reflect.TypeOf((*MockLinkMarkUsecase)(nil).MarkLink), ctx, pageID, linkID, status)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_mark_all_read.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_mark_all_read/link_mark_all_read.go -source=./link_mark_all_read.go -package=mocklinkmarkallreadusecase
+//
+
+// Package mocklinkmarkallreadusecase is a generated GoMock package.
+package mocklinkmarkallreadusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkMarkAllReadUsecase is a mock of LinkMarkAllReadUsecase interface.
+type MockLinkMarkAllReadUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkMarkAllReadUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkMarkAllReadUsecaseMockRecorder is the mock recorder for MockLinkMarkAllReadUsecase.
+type MockLinkMarkAllReadUsecaseMockRecorder struct {
+	mock *MockLinkMarkAllReadUsecase
+}
+
+// NewMockLinkMarkAllReadUsecase creates a new mock instance.
+func NewMockLinkMarkAllReadUsecase(ctrl *gomock.Controller) *MockLinkMarkAllReadUsecase {
+	mock := &MockLinkMarkAllReadUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkMarkAllReadUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkMarkAllReadUsecase) EXPECT() *MockLinkMarkAllReadUsecaseMockRecorder {
+	return m.recorder
+}
+
+// MarkAllRead mocks base method.
+func (m *MockLinkMarkAllReadUsecase) MarkAllRead(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockLinkMarkAllReadUsecaseMockRecorder) MarkAllRead(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockLinkMarkAllReadUsecase)(nil).MarkAllRead), ctx, pageID)
+}
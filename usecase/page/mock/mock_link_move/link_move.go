@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_move.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_move/link_move.go -source=./link_move.go -package=mocklinkmoveusecase
+//
+
+// Package mocklinkmoveusecase is a generated GoMock package.
+package mocklinkmoveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/usecase/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkMoveUsecase is a mock of LinkMoveUsecase interface.
+type MockLinkMoveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkMoveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkMoveUsecaseMockRecorder is the mock recorder for MockLinkMoveUsecase.
+type MockLinkMoveUsecaseMockRecorder struct {
+	mock *MockLinkMoveUsecase
+}
+
+// NewMockLinkMoveUsecase creates a new mock instance.
+func NewMockLinkMoveUsecase(ctrl *gomock.Controller) *MockLinkMoveUsecase {
+	mock := &MockLinkMoveUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkMoveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkMoveUsecase) EXPECT() *MockLinkMoveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// LinkMove mocks base method.
+func (m *MockLinkMoveUsecase) LinkMove(ctx context.Context, input page.LinkMoveUsecaseInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkMove", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkMove indicates an expected call of LinkMove.
+func (mr *MockLinkMoveUsecaseMockRecorder) LinkMove(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkMove", reflect.TypeOf((*MockLinkMoveUsecase)(nil).LinkMove), ctx, input)
+}
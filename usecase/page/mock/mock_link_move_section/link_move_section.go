@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_move_section.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_move_section/link_move_section.go -source=./link_move_section.go -package=mocklinkmovesectionusecase
+//
+
+// Package mocklinkmovesectionusecase is a generated GoMock package.
+package mocklinkmovesectionusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkMoveSectionUsecase is a mock of LinkMoveSectionUsecase interface.
+type MockLinkMoveSectionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkMoveSectionUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkMoveSectionUsecaseMockRecorder is the mock recorder for MockLinkMoveSectionUsecase.
+type MockLinkMoveSectionUsecaseMockRecorder struct {
+	mock *MockLinkMoveSectionUsecase
+}
+
+// NewMockLinkMoveSectionUsecase creates a new mock instance.
+func NewMockLinkMoveSectionUsecase(ctrl *gomock.Controller) *MockLinkMoveSectionUsecase {
+	mock := &MockLinkMoveSectionUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkMoveSectionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkMoveSectionUsecase) EXPECT() *MockLinkMoveSectionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// MoveLinksToSection mocks base method.
+func (m *MockLinkMoveSectionUsecase) MoveLinksToSection(ctx context.Context, pageID string, linkIDs []string, sectionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveLinksToSection", ctx, pageID, linkIDs, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveLinksToSection indicates an expected call of MoveLinksToSection.
+func (mr *MockLinkMoveSectionUsecaseMockRecorder) MoveLinksToSection(ctx, pageID, linkIDs, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveLinksToSection", reflect.TypeOf((*MockLinkMoveSectionUsecase)(nil).MoveLinksToSection), ctx, pageID, linkIDs, sectionID)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_react.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_react/link_react.go -source=./link_react.go -package=mocklinkreactusecase
+//
+
+// Package mocklinkreactusecase is a generated GoMock package.
+package mocklinkreactusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkReactUsecase is a mock of LinkReactUsecase interface.
+type MockLinkReactUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkReactUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkReactUsecaseMockRecorder is the mock recorder for MockLinkReactUsecase.
+type MockLinkReactUsecaseMockRecorder struct {
+	mock *MockLinkReactUsecase
+}
+
+// NewMockLinkReactUsecase creates a new mock instance.
+func NewMockLinkReactUsecase(ctrl *gomock.Controller) *MockLinkReactUsecase {
+	mock := &MockLinkReactUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkReactUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkReactUsecase) EXPECT() *MockLinkReactUsecaseMockRecorder {
+	return m.recorder
+}
+
+// React mocks base method.
+func (m *MockLinkReactUsecase) React(ctx context.Context, pageID, linkID, emoji string) (*page.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "React", ctx, pageID, linkID, emoji)
+	ret0, _ := ret[0].(*page.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// React indicates an expected call of React.
+func (mr *MockLinkReactUsecaseMockRecorder) React(ctx, pageID, linkID, emoji any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockLinkReactUsecase)(nil).React), ctx, pageID, linkID, emoji)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_redirect_accept.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_redirect_accept/link_redirect_accept.go -source=./link_redirect_accept.go -package=mocklinkredirectacceptusecase
+//
+
+// Package mocklinkredirectacceptusecase is a generated GoMock package.
+package mocklinkredirectacceptusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkRedirectAcceptUsecase is a mock of LinkRedirectAcceptUsecase interface.
+type MockLinkRedirectAcceptUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkRedirectAcceptUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkRedirectAcceptUsecaseMockRecorder is the mock recorder for MockLinkRedirectAcceptUsecase.
+type MockLinkRedirectAcceptUsecaseMockRecorder struct {
+	mock *MockLinkRedirectAcceptUsecase
+}
+
+// NewMockLinkRedirectAcceptUsecase creates a new mock instance.
+func NewMockLinkRedirectAcceptUsecase(ctrl *gomock.Controller) *MockLinkRedirectAcceptUsecase {
+	mock := &MockLinkRedirectAcceptUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkRedirectAcceptUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkRedirectAcceptUsecase) EXPECT() *MockLinkRedirectAcceptUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AcceptLinkRedirect mocks base method.
+func (m *MockLinkRedirectAcceptUsecase) AcceptLinkRedirect(ctx context.Context, pageID, linkID string) (*page.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptLinkRedirect", ctx, pageID, linkID)
+	ret0, _ := ret[0].(*page.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptLinkRedirect indicates an expected call of AcceptLinkRedirect.
+func (mr *MockLinkRedirectAcceptUsecaseMockRecorder) AcceptLinkRedirect(ctx, pageID, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptLinkRedirect", reflect.TypeOf((*MockLinkRedirectAcceptUsecase)(nil).AcceptLinkRedirect), ctx, pageID, linkID)
+}
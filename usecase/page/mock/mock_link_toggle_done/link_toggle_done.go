@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_toggle_done.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_toggle_done/link_toggle_done.go -source=./link_toggle_done.go -package=mocklinktoggledoneusecase
+//
+
+// Package mocklinktoggledoneusecase is a generated GoMock package.
+package mocklinktoggledoneusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkToggleDoneUsecase is a mock of LinkToggleDoneUsecase interface.
+type MockLinkToggleDoneUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkToggleDoneUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkToggleDoneUsecaseMockRecorder is the mock recorder for MockLinkToggleDoneUsecase.
+type MockLinkToggleDoneUsecaseMockRecorder struct {
+	mock *MockLinkToggleDoneUsecase
+}
+
+// NewMockLinkToggleDoneUsecase creates a new mock instance.
+func NewMockLinkToggleDoneUsecase(ctrl *gomock.Controller) *MockLinkToggleDoneUsecase {
+	mock := &MockLinkToggleDoneUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkToggleDoneUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkToggleDoneUsecase) EXPECT() *MockLinkToggleDoneUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ToggleLinkDone mocks base method.
+func (m *MockLinkToggleDoneUsecase) ToggleLinkDone(ctx context.Context, pageID, linkID string) (*page.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleLinkDone", ctx, pageID, linkID)
+	ret0, _ := ret[0].(*page.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToggleLinkDone indicates an expected call of ToggleLinkDone.
+func (mr *MockLinkToggleDoneUsecaseMockRecorder) ToggleLinkDone(ctx, pageID, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleLinkDone", reflect.TypeOf((*MockLinkToggleDoneUsecase)(nil).ToggleLinkDone), ctx, pageID, linkID)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_unreact.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_unreact/link_unreact.go -source=./link_unreact.go -package=mocklinkunreactusecase
+//
+
+// Package mocklinkunreactusecase is a generated GoMock package.
+package mocklinkunreactusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkUnreactUsecase is a mock of LinkUnreactUsecase interface.
+type MockLinkUnreactUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkUnreactUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkUnreactUsecaseMockRecorder is the mock recorder for MockLinkUnreactUsecase.
+type MockLinkUnreactUsecaseMockRecorder struct {
+	mock *MockLinkUnreactUsecase
+}
+
+// NewMockLinkUnreactUsecase creates a new mock instance.
+func NewMockLinkUnreactUsecase(ctrl *gomock.Controller) *MockLinkUnreactUsecase {
+	mock := &MockLinkUnreactUsecase{ctrl: ctrl}
+	mock.recorder = &MockLinkUnreactUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkUnreactUsecase) EXPECT() *MockLinkUnreactUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Unreact mocks base method.
+func (m *MockLinkUnreactUsecase) Unreact(ctx context.Context, pageID, linkID string) (*page.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unreact", ctx, pageID, linkID)
+	ret0, _ := ret[0].(*page.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unreact indicates an expected call of Unreact.
+func (mr *MockLinkUnreactUsecaseMockRecorder) Unreact(ctx, pageID, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unreact", reflect.TypeOf((*MockLinkUnreactUsecase)(nil).Unreact), ctx, pageID, linkID)
+}
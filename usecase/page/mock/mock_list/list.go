@@ -42,7 +42,7 @@ func (m *MockListUsecase) EXPECT() *MockListUsecaseMockRecorder {
 }
 
 // List mocks base method.
-func (m *MockListUsecase) List(ctx context.Context, options ...page.SearchOption) ([]*page.Page, error) {
+func (m *MockListUsecase) List(ctx context.Context, options ...page.SearchOption) ([]*page.Page, *page.Preferences, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range options {
@@ -50,8 +50,9 @@ func (m *MockListUsecase) List(ctx context.Context, options ...page.SearchOption
 	}
 	ret := m.ctrl.Call(m, "List", varargs...)
 	ret0, _ := ret[0].([]*page.Page)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*page.Preferences)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./my_pages_reorder.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_my_pages_reorder/my_pages_reorder.go -source=./my_pages_reorder.go -package=mockmypagesreorderusecase
+//
+
+// Package mockmypagesreorderusecase is a generated GoMock package.
+package mockmypagesreorderusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMyPagesReorderUsecase is a mock of MyPagesReorderUsecase interface.
+type MockMyPagesReorderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMyPagesReorderUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMyPagesReorderUsecaseMockRecorder is the mock recorder for MockMyPagesReorderUsecase.
+type MockMyPagesReorderUsecaseMockRecorder struct {
+	mock *MockMyPagesReorderUsecase
+}
+
+// NewMockMyPagesReorderUsecase creates a new mock instance.
+func NewMockMyPagesReorderUsecase(ctrl *gomock.Controller) *MockMyPagesReorderUsecase {
+	mock := &MockMyPagesReorderUsecase{ctrl: ctrl}
+	mock.recorder = &MockMyPagesReorderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMyPagesReorderUsecase) EXPECT() *MockMyPagesReorderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ReorderMyPages mocks base method.
+func (m *MockMyPagesReorderUsecase) ReorderMyPages(ctx context.Context, pageIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderMyPages", ctx, pageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderMyPages indicates an expected call of ReorderMyPages.
+func (mr *MockMyPagesReorderUsecaseMockRecorder) ReorderMyPages(ctx, pageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderMyPages", reflect.TypeOf((*MockMyPagesReorderUsecase)(nil).ReorderMyPages), ctx, pageIDs)
+}
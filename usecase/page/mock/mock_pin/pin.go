@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pin.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_pin/pin.go -source=./pin.go -package=mockpinusecase
+//
+
+// Package mockpinusecase is a generated GoMock package.
+package mockpinusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPinUsecase is a mock of PinUsecase interface.
+type MockPinUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPinUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPinUsecaseMockRecorder is the mock recorder for MockPinUsecase.
+type MockPinUsecaseMockRecorder struct {
+	mock *MockPinUsecase
+}
+
+// NewMockPinUsecase creates a new mock instance.
+func NewMockPinUsecase(ctrl *gomock.Controller) *MockPinUsecase {
+	mock := &MockPinUsecase{ctrl: ctrl}
+	mock.recorder = &MockPinUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPinUsecase) EXPECT() *MockPinUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Pin mocks base method.
+func (m *MockPinUsecase) Pin(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin.
+func (mr *MockPinUsecaseMockRecorder) Pin(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockPinUsecase)(nil).Pin), ctx, pageID)
+}
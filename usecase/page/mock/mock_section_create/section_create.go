@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./section_create.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_section_create/section_create.go -source=./section_create.go -package=mocksectioncreateusecase
+//
+
+// Package mocksectioncreateusecase is a generated GoMock package.
+package mocksectioncreateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSectionCreateUsecase is a mock of SectionCreateUsecase interface.
+type MockSectionCreateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionCreateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSectionCreateUsecaseMockRecorder is the mock recorder for MockSectionCreateUsecase.
+type MockSectionCreateUsecaseMockRecorder struct {
+	mock *MockSectionCreateUsecase
+}
+
+// NewMockSectionCreateUsecase creates a new mock instance.
+func NewMockSectionCreateUsecase(ctrl *gomock.Controller) *MockSectionCreateUsecase {
+	mock := &MockSectionCreateUsecase{ctrl: ctrl}
+	mock.recorder = &MockSectionCreateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionCreateUsecase) EXPECT() *MockSectionCreateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateSection mocks base method.
+func (m *MockSectionCreateUsecase) CreateSection(ctx context.Context, pageID, name string) (*page.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, pageID, name)
+	ret0, _ := ret[0].(*page.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockSectionCreateUsecaseMockRecorder) CreateSection(ctx, pageID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockSectionCreateUsecase)(nil).CreateSection), ctx, pageID, name)
+}
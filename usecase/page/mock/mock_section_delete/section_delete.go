@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./section_delete.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_section_delete/section_delete.go -source=./section_delete.go -package=mocksectiondeleteusecase
+//
+
+// Package mocksectiondeleteusecase is a generated GoMock package.
+package mocksectiondeleteusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSectionDeleteUsecase is a mock of SectionDeleteUsecase interface.
+type MockSectionDeleteUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionDeleteUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSectionDeleteUsecaseMockRecorder is the mock recorder for MockSectionDeleteUsecase.
+type MockSectionDeleteUsecaseMockRecorder struct {
+	mock *MockSectionDeleteUsecase
+}
+
+// NewMockSectionDeleteUsecase creates a new mock instance.
+func NewMockSectionDeleteUsecase(ctrl *gomock.Controller) *MockSectionDeleteUsecase {
+	mock := &MockSectionDeleteUsecase{ctrl: ctrl}
+	mock.recorder = &MockSectionDeleteUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionDeleteUsecase) EXPECT() *MockSectionDeleteUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteSection mocks base method.
+func (m *MockSectionDeleteUsecase) DeleteSection(ctx context.Context, pageID, sectionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSection", ctx, pageID, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSection indicates an expected call of DeleteSection.
+func (mr *MockSectionDeleteUsecaseMockRecorder) DeleteSection(ctx, pageID, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSection", reflect.TypeOf((*MockSectionDeleteUsecase)(nil).DeleteSection), ctx, pageID, sectionID)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./section_rename.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_section_rename/section_rename.go -source=./section_rename.go -package=mocksectionrenameusecase
+//
+
+// Package mocksectionrenameusecase is a generated GoMock package.
+package mocksectionrenameusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSectionRenameUsecase is a mock of SectionRenameUsecase interface.
+type MockSectionRenameUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionRenameUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSectionRenameUsecaseMockRecorder is the mock recorder for MockSectionRenameUsecase.
+type MockSectionRenameUsecaseMockRecorder struct {
+	mock *MockSectionRenameUsecase
+}
+
+// NewMockSectionRenameUsecase creates a new mock instance.
+func NewMockSectionRenameUsecase(ctrl *gomock.Controller) *MockSectionRenameUsecase {
+	mock := &MockSectionRenameUsecase{ctrl: ctrl}
+	mock.recorder = &MockSectionRenameUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionRenameUsecase) EXPECT() *MockSectionRenameUsecaseMockRecorder {
+	return m.recorder
+}
+
+// RenameSection mocks base method.
+func (m *MockSectionRenameUsecase) RenameSection(ctx context.Context, pageID, sectionID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSection", ctx, pageID, sectionID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameSection indicates an expected call of RenameSection.
+func (mr *MockSectionRenameUsecaseMockRecorder) RenameSection(ctx, pageID, sectionID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSection", reflect.TypeOf((*MockSectionRenameUsecase)(nil).RenameSection), ctx, pageID, sectionID, name)
+}
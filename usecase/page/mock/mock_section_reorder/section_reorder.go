@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./section_reorder.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_section_reorder/section_reorder.go -source=./section_reorder.go -package=mocksectionreorderusecase
+//
+
+// Package mocksectionreorderusecase is a generated GoMock package.
+package mocksectionreorderusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSectionReorderUsecase is a mock of SectionReorderUsecase interface.
+type MockSectionReorderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionReorderUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSectionReorderUsecaseMockRecorder is the mock recorder for MockSectionReorderUsecase.
+type MockSectionReorderUsecaseMockRecorder struct {
+	mock *MockSectionReorderUsecase
+}
+
+// NewMockSectionReorderUsecase creates a new mock instance.
+func NewMockSectionReorderUsecase(ctrl *gomock.Controller) *MockSectionReorderUsecase {
+	mock := &MockSectionReorderUsecase{ctrl: ctrl}
+	mock.recorder = &MockSectionReorderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionReorderUsecase) EXPECT() *MockSectionReorderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ReorderSections mocks base method.
+func (m *MockSectionReorderUsecase) ReorderSections(ctx context.Context, pageID string, sectionIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSections", ctx, pageID, sectionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderSections indicates an expected call of ReorderSections.
+func (mr *MockSectionReorderUsecaseMockRecorder) ReorderSections(ctx, pageID, sectionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSections", reflect.TypeOf((*MockSectionReorderUsecase)(nil).ReorderSections), ctx, pageID, sectionIDs)
+}
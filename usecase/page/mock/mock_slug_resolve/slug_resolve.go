@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./slug_resolve.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_slug_resolve/slug_resolve.go -source=./slug_resolve.go -package=mockslugresolveusecase
+//
+
+// Package mockslugresolveusecase is a generated GoMock package.
+package mockslugresolveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSlugResolveUsecase is a mock of SlugResolveUsecase interface.
+type MockSlugResolveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlugResolveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSlugResolveUsecaseMockRecorder is the mock recorder for MockSlugResolveUsecase.
+type MockSlugResolveUsecaseMockRecorder struct {
+	mock *MockSlugResolveUsecase
+}
+
+// NewMockSlugResolveUsecase creates a new mock instance.
+func NewMockSlugResolveUsecase(ctrl *gomock.Controller) *MockSlugResolveUsecase {
+	mock := &MockSlugResolveUsecase{ctrl: ctrl}
+	mock.recorder = &MockSlugResolveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlugResolveUsecase) EXPECT() *MockSlugResolveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockSlugResolveUsecase) Resolve(ctx context.Context, slug string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, slug)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockSlugResolveUsecaseMockRecorder) Resolve(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockSlugResolveUsecase)(nil).Resolve), ctx, slug)
+}
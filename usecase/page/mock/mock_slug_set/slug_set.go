@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./slug_set.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_slug_set/slug_set.go -source=./slug_set.go -package=mockslugsetusecase
+//
+
+// Package mockslugsetusecase is a generated GoMock package.
+package mockslugsetusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSlugSetUsecase is a mock of SlugSetUsecase interface.
+type MockSlugSetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlugSetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSlugSetUsecaseMockRecorder is the mock recorder for MockSlugSetUsecase.
+type MockSlugSetUsecaseMockRecorder struct {
+	mock *MockSlugSetUsecase
+}
+
+// NewMockSlugSetUsecase creates a new mock instance.
+func NewMockSlugSetUsecase(ctrl *gomock.Controller) *MockSlugSetUsecase {
+	mock := &MockSlugSetUsecase{ctrl: ctrl}
+	mock.recorder = &MockSlugSetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlugSetUsecase) EXPECT() *MockSlugSetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SetSlug mocks base method.
+func (m *MockSlugSetUsecase) SetSlug(ctx context.Context, pageID, slug string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSlug", ctx, pageID, slug)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSlug indicates an expected call of SetSlug.
+func (mr *MockSlugSetUsecaseMockRecorder) SetSlug(ctx, pageID, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlug", reflect.TypeOf((*MockSlugSetUsecase)(nil).SetSlug), ctx, pageID, slug)
+}
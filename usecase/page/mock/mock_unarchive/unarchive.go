@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./unarchive.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_unarchive/unarchive.go -source=./unarchive.go -package=mockunarchiveusecase
+//
+
+// Package mockunarchiveusecase is a generated GoMock package.
+package mockunarchiveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	page "github.com/naka-sei/tsudzuri/domain/page"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnarchiveUsecase is a mock of UnarchiveUsecase interface.
+type MockUnarchiveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnarchiveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnarchiveUsecaseMockRecorder is the mock recorder for MockUnarchiveUsecase.
+type MockUnarchiveUsecaseMockRecorder struct {
+	mock *MockUnarchiveUsecase
+}
+
+// NewMockUnarchiveUsecase creates a new mock instance.
+func NewMockUnarchiveUsecase(ctrl *gomock.Controller) *MockUnarchiveUsecase {
+	mock := &MockUnarchiveUsecase{ctrl: ctrl}
+	mock.recorder = &MockUnarchiveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnarchiveUsecase) EXPECT() *MockUnarchiveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Unarchive mocks base method.
+func (m *MockUnarchiveUsecase) Unarchive(ctx context.Context, pageID string) (*page.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, pageID)
+	ret0, _ := ret[0].(*page.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockUnarchiveUsecaseMockRecorder) Unarchive(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockUnarchiveUsecase)(nil).Unarchive), ctx, pageID)
+}
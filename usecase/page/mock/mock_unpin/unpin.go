@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./unpin.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_unpin/unpin.go -source=./unpin.go -package=mockunpinusecase
+//
+
+// Package mockunpinusecase is a generated GoMock package.
+package mockunpinusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnpinUsecase is a mock of UnpinUsecase interface.
+type MockUnpinUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnpinUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnpinUsecaseMockRecorder is the mock recorder for MockUnpinUsecase.
+type MockUnpinUsecaseMockRecorder struct {
+	mock *MockUnpinUsecase
+}
+
+// NewMockUnpinUsecase creates a new mock instance.
+func NewMockUnpinUsecase(ctrl *gomock.Controller) *MockUnpinUsecase {
+	mock := &MockUnpinUsecase{ctrl: ctrl}
+	mock.recorder = &MockUnpinUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnpinUsecase) EXPECT() *MockUnpinUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Unpin mocks base method.
+func (m *MockUnpinUsecase) Unpin(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin.
+func (mr *MockUnpinUsecaseMockRecorder) Unpin(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockUnpinUsecase)(nil).Unpin), ctx, pageID)
+}
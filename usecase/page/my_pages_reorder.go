@@ -0,0 +1,83 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_my_pages_reorder/my_pages_reorder.go -source=./my_pages_reorder.go -package=mockmypagesreorderusecase
+type MyPagesReorderUsecase interface {
+	// ReorderMyPages sets the user's own order of pages. The listed pages come first in the given order
+	// and the others keep the default order. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	ReorderMyPages(ctx context.Context, pageIDs []string) error
+}
+
+type myPagesReorderUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		preference dpage.PreferenceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewMyPagesReorderUsecase(
+	pageRepo dpage.PageRepository,
+	preferenceRepo dpage.PreferenceRepository,
+	txnService service.TransactionService,
+) MyPagesReorderUsecase {
+	return &myPagesReorderUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			preference dpage.PreferenceRepository
+		}{
+			page:       pageRepo,
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *myPagesReorderUsecase) ReorderMyPages(ctx context.Context, pageIDs []string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/myPagesReorderUsecase.ReorderMyPages")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Reordering %d pages", len(pageIDs))
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	var pages []*dpage.Page
+	if len(pageIDs) > 0 {
+		var err error
+		pages, err = u.repository.page.List(ctx, dpage.WithIDs(pageIDs), dpage.WithIncludeArchived(true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		preferences, err := u.repository.preference.Get(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if err := preferences.Reorder(user, pages, pageIDs); err != nil {
+			return err
+		}
+		return u.repository.preference.Save(ctx, preferences)
+	})
+}
@@ -0,0 +1,148 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestMyPagesReorderUsecase_ReorderMyPages(t *testing.T) {
+	type mocks struct {
+		pageRepo       *mockpage.MockPageRepository
+		preferenceRepo *mockpage.MockPreferenceRepository
+		txn            *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx     context.Context
+		pageIDs []string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	page1 := dpage.ReconstructPage("p1", "Page 1", *creatorUser, "invite-1", dpage.Links{}, duser.Users{memberUser})
+	page2 := dpage.ReconstructPage("p2", "Page 2", *creatorUser, "invite-2", dpage.Links{}, duser.Users{memberUser})
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*dpage.Page{page1, page2}, nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("p1", true, 0, false),
+				}), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("p1", true, 2, false),
+					dpage.ReconstructPreference("p2", false, 1, false),
+				})).Return(nil)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), memberUser),
+				pageIDs: []string{"p2", "p1"},
+			},
+		},
+		{
+			name: "success_clear_order",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("p1", false, 1, false),
+				}), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("p1", false, 0, false),
+				})).Return(nil)
+			},
+			args: args{
+				ctx: ctxuser.WithUser(context.Background(), memberUser),
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:     context.Background(),
+				pageIDs: []string{"p1"},
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "unknown_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*dpage.Page{page1}, nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", nil), nil)
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), memberUser),
+				pageIDs: []string{"p1", "p9"},
+			},
+			wantErr: dpage.ErrInvalidPageOrder,
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("list error"))
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), memberUser),
+				pageIDs: []string{"p1"},
+			},
+			wantErr: errors.New("list error"),
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*dpage.Page{page1}, nil)
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", nil), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+			},
+			args: args{
+				ctx:     ctxuser.WithUser(context.Background(), memberUser),
+				pageIDs: []string{"p1"},
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:       mockpage.NewMockPageRepository(ctrl),
+				preferenceRepo: mockpage.NewMockPreferenceRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewMyPagesReorderUsecase(m.pageRepo, m.preferenceRepo, m.txn)
+			err := u.ReorderMyPages(tt.args.ctx, tt.args.pageIDs)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_pin/pin.go -source=./pin.go -package=mockpinusecase
+type PinUsecase interface {
+	// Pin pins the page for the user only. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Pin(ctx context.Context, pageID string) error
+}
+
+type pinUsecase struct {
+	repository struct {
+		page       dpage.PageRepository
+		preference dpage.PreferenceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewPinUsecase(
+	pageRepo dpage.PageRepository,
+	preferenceRepo dpage.PreferenceRepository,
+	txnService service.TransactionService,
+) PinUsecase {
+	return &pinUsecase{
+		repository: struct {
+			page       dpage.PageRepository
+			preference dpage.PreferenceRepository
+		}{
+			page:       pageRepo,
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *pinUsecase) Pin(ctx context.Context, pageID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/pinUsecase.Pin")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Pinning page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		preferences, err := u.repository.preference.Get(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if err := preferences.Pin(user, page); err != nil {
+			return err
+		}
+		return u.repository.preference.Save(ctx, preferences)
+	})
+}
@@ -0,0 +1,87 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_section_create/section_create.go -source=./section_create.go -package=mocksectioncreateusecase
+type SectionCreateUsecase interface {
+	// CreateSection adds a new section to the end of the page and returns it.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	CreateSection(ctx context.Context, pageID string, name string) (*dpage.Section, error)
+}
+
+type sectionCreateUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSectionCreateUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) SectionCreateUsecase {
+	return &sectionCreateUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *sectionCreateUsecase) CreateSection(ctx context.Context, pageID string, name string) (*dpage.Section, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/sectionCreateUsecase.CreateSection")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Creating section %q on page %s", name, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var created dpage.Section
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.AddSection(user, name); err != nil {
+			return err
+		}
+		saved, err := u.repository.page.Save(ctx, page)
+		if err != nil {
+			return err
+		}
+		// New sections are added to the end, so the created one is the last section.
+		sections := saved.Sections()
+		created = sections[len(sections)-1]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
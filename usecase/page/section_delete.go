@@ -0,0 +1,75 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_section_delete/section_delete.go -source=./section_delete.go -package=mocksectiondeleteusecase
+type SectionDeleteUsecase interface {
+	// DeleteSection removes a section from the page. Its links stay on the page outside of any section.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	DeleteSection(ctx context.Context, pageID string, sectionID string) error
+}
+
+type sectionDeleteUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSectionDeleteUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) SectionDeleteUsecase {
+	return &sectionDeleteUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *sectionDeleteUsecase) DeleteSection(ctx context.Context, pageID string, sectionID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/sectionDeleteUsecase.DeleteSection")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Deleting section %s of page %s", sectionID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.RemoveSection(user, sectionID); err != nil {
+			return err
+		}
+		_, err := u.repository.page.Save(ctx, page)
+		return err
+	})
+}
@@ -0,0 +1,75 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_section_rename/section_rename.go -source=./section_rename.go -package=mocksectionrenameusecase
+type SectionRenameUsecase interface {
+	// RenameSection renames a section of the page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	RenameSection(ctx context.Context, pageID string, sectionID string, name string) error
+}
+
+type sectionRenameUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSectionRenameUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) SectionRenameUsecase {
+	return &sectionRenameUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *sectionRenameUsecase) RenameSection(ctx context.Context, pageID string, sectionID string, name string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/sectionRenameUsecase.RenameSection")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Renaming section %s of page %s", sectionID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.RenameSection(user, sectionID, name); err != nil {
+			return err
+		}
+		_, err := u.repository.page.Save(ctx, page)
+		return err
+	})
+}
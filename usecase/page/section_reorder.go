@@ -0,0 +1,75 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_section_reorder/section_reorder.go -source=./section_reorder.go -package=mocksectionreorderusecase
+type SectionReorderUsecase interface {
+	// ReorderSections orders the sections of the page as given. The IDs must list every section exactly once.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	ReorderSections(ctx context.Context, pageID string, sectionIDs []string) error
+}
+
+type sectionReorderUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSectionReorderUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) SectionReorderUsecase {
+	return &sectionReorderUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *sectionReorderUsecase) ReorderSections(ctx context.Context, pageID string, sectionIDs []string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/sectionReorderUsecase.ReorderSections")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Reordering %d sections of page %s", len(sectionIDs), pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.ReorderSections(user, sectionIDs); err != nil {
+			return err
+		}
+		_, err := u.repository.page.Save(ctx, page)
+		return err
+	})
+}
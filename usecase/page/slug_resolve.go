@@ -0,0 +1,70 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_slug_resolve/slug_resolve.go -source=./slug_resolve.go -package=mockslugresolveusecase
+type SlugResolveUsecase interface {
+	// Resolve returns the page that has or had the slug. Only members of the page can resolve it.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Resolve(ctx context.Context, slug string) (*dpage.Page, error)
+}
+
+type slugResolveUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+		slug dpage.SlugRepository
+	}
+}
+
+func NewSlugResolveUsecase(pageRepo dpage.PageRepository, slugRepo dpage.SlugRepository) SlugResolveUsecase {
+	return &slugResolveUsecase{
+		repository: struct {
+			page dpage.PageRepository
+			slug dpage.SlugRepository
+		}{
+			page: pageRepo,
+			slug: slugRepo,
+		},
+	}
+}
+
+func (u *slugResolveUsecase) Resolve(ctx context.Context, slug string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/slugResolveUsecase.Resolve")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Resolving page slug %s", slug)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	pageID, err := u.repository.slug.Resolve(ctx, dpage.NormalizeSlug(slug))
+	if err != nil {
+		return nil, err
+	}
+	if pageID == "" {
+		return nil, ErrPageNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
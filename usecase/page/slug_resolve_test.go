@@ -0,0 +1,124 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestSlugResolveUsecase_Resolve(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		slugRepo *mockpage.MockSlugRepository
+	}
+	type args struct {
+		ctx  context.Context
+		slug string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{memberUser}, dpage.WithSlug("trip-2026"))
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Page
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("1", nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), memberUser), slug: "Trip-2026"},
+			want: newPage(),
+		},
+		{
+			name: "replaced_slug",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return("1", nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creatorUser), slug: "abcdefgh23"},
+			want: newPage(),
+		},
+		{
+			name: "unknown_slug",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "unknown").Return("", nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), slug: "unknown"},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("1", nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), slug: "trip-2026"},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("1", nil)
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), otherUser), slug: "trip-2026"},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name:    "user_not_found_in_context",
+			args:    args{ctx: context.Background(), slug: "trip-2026"},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "resolve_error",
+			setup: func(m *mocks) {
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("", errors.New("resolve error"))
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), slug: "trip-2026"},
+			wantErr: errors.New("resolve error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				slugRepo: mockpage.NewMockSlugRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewSlugResolveUsecase(m.pageRepo, m.slugRepo)
+			got, err := u.Resolve(tt.args.ctx, tt.args.slug)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Errorf("Resolve() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_slug_set/slug_set.go -source=./slug_set.go -package=mockslugsetusecase
+type SlugSetUsecase interface {
+	// SetSlug makes the vanity slug the current slug of the page and returns the page.
+	// The previous slug keeps resolving to the page. A slug any other page has ever used is rejected.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	SetSlug(ctx context.Context, pageID string, slug string) (*dpage.Page, error)
+}
+
+type slugSetUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+		slug dpage.SlugRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSlugSetUsecase(
+	pageRepo dpage.PageRepository,
+	slugRepo dpage.SlugRepository,
+	txnService service.TransactionService,
+) SlugSetUsecase {
+	return &slugSetUsecase{
+		repository: struct {
+			page dpage.PageRepository
+			slug dpage.SlugRepository
+		}{
+			page: pageRepo,
+			slug: slugRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *slugSetUsecase) SetSlug(ctx context.Context, pageID string, slug string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/slugSetUsecase.SetSlug")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Setting slug of page %s to %s", pageID, slug)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var saved *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.SetSlug(user, slug); err != nil {
+			return err
+		}
+		// Going back to an earlier slug of the same page is allowed.
+		owner, err := u.repository.slug.Resolve(ctx, page.Slug())
+		if err != nil {
+			return err
+		}
+		if owner != "" && owner != page.ID() {
+			return dpage.ErrSlugTaken
+		}
+		saved, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
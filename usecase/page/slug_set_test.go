@@ -0,0 +1,160 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestSlugSetUsecase_SetSlug(t *testing.T) {
+	type mocks struct {
+		pageRepo *mockpage.MockPageRepository
+		slugRepo *mockpage.MockSlugRepository
+		txn      *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		slug   string
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	newPage := func(slug string) *dpage.Page {
+		return dpage.ReconstructPage("1", "Test Page", *creatorUser, "invite-code", dpage.Links{}, duser.Users{memberUser}, dpage.WithSlug(slug))
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		want    *dpage.Page
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("", nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), newPage("trip-2026")).Return(newPage("trip-2026"), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: " Trip-2026 "},
+			want: newPage("trip-2026"),
+		},
+		{
+			name: "earlier_slug_of_same_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("trip-2026"), nil)
+				runTxn(m)
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "abcdefgh23").Return("1", nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), newPage("abcdefgh23")).Return(newPage("abcdefgh23"), nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "abcdefgh23"},
+			want: newPage("abcdefgh23"),
+		},
+		{
+			name: "slug_taken",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("2", nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "trip-2026"},
+			wantErr: dpage.ErrSlugTaken,
+		},
+		{
+			name: "invalid_slug",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "trip 2026"},
+			wantErr: dpage.ErrInvalidSlug,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), memberUser), pageID: "1", slug: "trip-2026"},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name:    "user_not_found_in_context",
+			args:    args{ctx: context.Background(), pageID: "1", slug: "trip-2026"},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "trip-2026"},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name: "resolve_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("", errors.New("resolve error"))
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "trip-2026"},
+			wantErr: errors.New("resolve error"),
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage("abcdefgh23"), nil)
+				runTxn(m)
+				m.slugRepo.EXPECT().Resolve(gomock.Any(), "trip-2026").Return("", nil)
+				m.pageRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args:    args{ctx: ctxuser.WithUser(context.Background(), creatorUser), pageID: "1", slug: "trip-2026"},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo: mockpage.NewMockPageRepository(ctrl),
+				slugRepo: mockpage.NewMockSlugRepository(ctrl),
+				txn:      mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewSlugSetUsecase(m.pageRepo, m.slugRepo, m.txn)
+			got, err := u.SetSlug(tt.args.ctx, tt.args.pageID, tt.args.slug)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dpage.Page{}, dpage.Link{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Errorf("SetSlug() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
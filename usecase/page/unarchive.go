@@ -0,0 +1,80 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_unarchive/unarchive.go -source=./unarchive.go -package=mockunarchiveusecase
+type UnarchiveUsecase interface {
+	// Unarchive makes an archived page editable again and returns the page. Only the creator can unarchive the page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Unarchive(ctx context.Context, pageID string) (*dpage.Page, error)
+}
+
+type unarchiveUsecase struct {
+	repository struct {
+		page dpage.PageRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewUnarchiveUsecase(
+	pageRepo dpage.PageRepository,
+	txnService service.TransactionService,
+) UnarchiveUsecase {
+	return &unarchiveUsecase{
+		repository: struct {
+			page dpage.PageRepository
+		}{
+			page: pageRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *unarchiveUsecase) Unarchive(ctx context.Context, pageID string) (*dpage.Page, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/unarchiveUsecase.Unarchive")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Unarchiving page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, ErrPageNotFound
+	}
+
+	var saved *dpage.Page
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := page.Unarchive(user); err != nil {
+			return err
+		}
+		saved, err = u.repository.page.Save(ctx, page)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
@@ -0,0 +1,70 @@
+package page
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_unpin/unpin.go -source=./unpin.go -package=mockunpinusecase
+type UnpinUsecase interface {
+	// Unpin unpins the page for the user. Unpinning a page that is not pinned succeeds.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Unpin(ctx context.Context, pageID string) error
+}
+
+type unpinUsecase struct {
+	repository struct {
+		preference dpage.PreferenceRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewUnpinUsecase(
+	preferenceRepo dpage.PreferenceRepository,
+	txnService service.TransactionService,
+) UnpinUsecase {
+	return &unpinUsecase{
+		repository: struct {
+			preference dpage.PreferenceRepository
+		}{
+			preference: preferenceRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *unpinUsecase) Unpin(ctx context.Context, pageID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/page/unpinUsecase.Unpin")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Unpinning page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		preferences, err := u.repository.preference.Get(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if err := preferences.Unpin(user, pageID); err != nil {
+			return err
+		}
+		return u.repository.preference.Save(ctx, preferences)
+	})
+}
@@ -0,0 +1,113 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestUnpinUsecase_Unpin(t *testing.T) {
+	type mocks struct {
+		preferenceRepo *mockpage.MockPreferenceRepository
+		txn            *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+	}
+
+	memberUser := duser.ReconstructUser("2", "user2", "anonymous", nil)
+
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(m *mocks)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("1", true, 0, false),
+				}), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), dpage.ReconstructPreferences("2", []dpage.Preference{
+					dpage.ReconstructPreference("1", false, 0, false),
+				})).Return(nil)
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "1",
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "get_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(nil, errors.New("get error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+			wantErr: errors.New("get error"),
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				runTxn(m)
+				m.preferenceRepo.EXPECT().Get(gomock.Any(), "2").Return(dpage.ReconstructPreferences("2", nil), nil)
+				m.preferenceRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
+			},
+			args: args{
+				ctx:    ctxuser.WithUser(context.Background(), memberUser),
+				pageID: "1",
+			},
+			wantErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				preferenceRepo: mockpage.NewMockPreferenceRepository(ctrl),
+				txn:            mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewUnpinUsecase(m.preferenceRepo, m.txn)
+			err := u.Unpin(tt.args.ctx, tt.args.pageID)
+			testutil.EqualErr(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	dclick "github.com/naka-sei/tsudzuri/domain/click"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_click/click.go -source=./click.go -package=mockclick
+
+type ClickService interface {
+	// Record queues the click to be stored and returns without waiting for the write.
+	// It never fails the caller: clicks that cannot be queued are dropped.
+	Record(ctx context.Context, click *dclick.Click)
+}
@@ -0,0 +1,18 @@
+package service
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_link_checker/link_checker.go -source=./link_checker.go -package=mocklinkchecker
+
+// LinkCheckResult is the response to a link check.
+type LinkCheckResult struct {
+	StatusCode int
+	// Location is the absolute target of a redirect response, or empty.
+	Location string
+}
+
+type LinkChecker interface {
+	// Check requests the URL without following redirects and returns the response status. The error is for
+	// a request that got no response, e.g. an unknown host, a timeout or a refused non-public address.
+	Check(ctx context.Context, url string) (LinkCheckResult, error)
+}
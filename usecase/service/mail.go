@@ -0,0 +1,17 @@
+package service
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_mail/mail.go -source=./mail.go -package=mockmail
+
+// Mail is a plain text email.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type MailService interface {
+	// Send delivers the mail. It returns once the mail was handed to the delivery system.
+	Send(ctx context.Context, mail Mail) error
+}
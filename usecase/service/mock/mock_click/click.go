@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./click.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_click/click.go -source=./click.go -package=mockclick
+//
+
+// Package mockclick is a generated GoMock package.
+package mockclick
+
+import (
+	context "context"
+	reflect "reflect"
+
+	click "github.com/naka-sei/tsudzuri/domain/click"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClickService is a mock of ClickService interface.
+type MockClickService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClickServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockClickServiceMockRecorder is the mock recorder for MockClickService.
+type MockClickServiceMockRecorder struct {
+	mock *MockClickService
+}
+
+// NewMockClickService creates a new mock instance.
+func NewMockClickService(ctrl *gomock.Controller) *MockClickService {
+	mock := &MockClickService{ctrl: ctrl}
+	mock.recorder = &MockClickServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClickService) EXPECT() *MockClickServiceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockClickService) Record(ctx context.Context, arg1 *click.Click) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, arg1)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockClickServiceMockRecorder) Record(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockClickService)(nil).Record), ctx, arg1)
+}
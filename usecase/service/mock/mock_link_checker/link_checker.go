@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./link_checker.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_link_checker/link_checker.go -source=./link_checker.go -package=mocklinkchecker
+//
+
+// Package mocklinkchecker is a generated GoMock package.
+package mocklinkchecker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/naka-sei/tsudzuri/usecase/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLinkChecker is a mock of LinkChecker interface.
+type MockLinkChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockLinkCheckerMockRecorder is the mock recorder for MockLinkChecker.
+type MockLinkCheckerMockRecorder struct {
+	mock *MockLinkChecker
+}
+
+// NewMockLinkChecker creates a new mock instance.
+func NewMockLinkChecker(ctrl *gomock.Controller) *MockLinkChecker {
+	mock := &MockLinkChecker{ctrl: ctrl}
+	mock.recorder = &MockLinkCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkChecker) EXPECT() *MockLinkCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockLinkChecker) Check(ctx context.Context, url string) (service.LinkCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, url)
+	ret0, _ := ret[0].(service.LinkCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLinkCheckerMockRecorder) Check(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLinkChecker)(nil).Check), ctx, url)
+}
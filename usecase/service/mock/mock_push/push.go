@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./push.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_push/push.go -source=./push.go -package=mockpush
+//
+
+// Package mockpush is a generated GoMock package.
+package mockpush
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/naka-sei/tsudzuri/usecase/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPushSender is a mock of PushSender interface.
+type MockPushSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockPushSenderMockRecorder is the mock recorder for MockPushSender.
+type MockPushSenderMockRecorder struct {
+	mock *MockPushSender
+}
+
+// NewMockPushSender creates a new mock instance.
+func NewMockPushSender(ctrl *gomock.Controller) *MockPushSender {
+	mock := &MockPushSender{ctrl: ctrl}
+	mock.recorder = &MockPushSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushSender) EXPECT() *MockPushSenderMockRecorder {
+	return m.recorder
+}
+
+// MaxBatchSize mocks base method.
+func (m *MockPushSender) MaxBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxBatchSize indicates an expected call of MaxBatchSize.
+func (mr *MockPushSenderMockRecorder) MaxBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxBatchSize", reflect.TypeOf((*MockPushSender)(nil).MaxBatchSize))
+}
+
+// Send mocks base method.
+func (m *MockPushSender) Send(ctx context.Context, tokens []string, message service.PushMessage) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, tokens, message)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPushSenderMockRecorder) Send(ctx, tokens, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPushSender)(nil).Send), ctx, tokens, message)
+}
+
+// MockPushService is a mock of PushService interface.
+type MockPushService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPushServiceMockRecorder is the mock recorder for MockPushService.
+type MockPushServiceMockRecorder struct {
+	mock *MockPushService
+}
+
+// NewMockPushService creates a new mock instance.
+func NewMockPushService(ctrl *gomock.Controller) *MockPushService {
+	mock := &MockPushService{ctrl: ctrl}
+	mock.recorder = &MockPushServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushService) EXPECT() *MockPushServiceMockRecorder {
+	return m.recorder
+}
+
+// Push mocks base method.
+func (m *MockPushService) Push(ctx context.Context, userIDs []string, message service.PushMessage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Push", ctx, userIDs, message)
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockPushServiceMockRecorder) Push(ctx, userIDs, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockPushService)(nil).Push), ctx, userIDs, message)
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_webhook/webhook.go -source=./webhook.go -package=mockwebhook
+//
+
+// Package mockwebhook is a generated GoMock package.
+package mockwebhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	service "github.com/naka-sei/tsudzuri/usecase/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookClient is a mock of WebhookClient interface.
+type MockWebhookClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookClientMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookClientMockRecorder is the mock recorder for MockWebhookClient.
+type MockWebhookClientMockRecorder struct {
+	mock *MockWebhookClient
+}
+
+// NewMockWebhookClient creates a new mock instance.
+func NewMockWebhookClient(ctrl *gomock.Controller) *MockWebhookClient {
+	mock := &MockWebhookClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookClient) EXPECT() *MockWebhookClientMockRecorder {
+	return m.recorder
+}
+
+// Post mocks base method.
+func (m *MockWebhookClient) Post(ctx context.Context, request service.WebhookRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockWebhookClientMockRecorder) Post(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockWebhookClient)(nil).Post), ctx, request)
+}
+
+// MockWebhookService is a mock of WebhookService interface.
+type MockWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookServiceMockRecorder is the mock recorder for MockWebhookService.
+type MockWebhookServiceMockRecorder struct {
+	mock *MockWebhookService
+}
+
+// NewMockWebhookService creates a new mock instance.
+func NewMockWebhookService(ctrl *gomock.Controller) *MockWebhookService {
+	mock := &MockWebhookService{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookService) EXPECT() *MockWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method.
+func (m *MockWebhookService) Dispatch(ctx context.Context, event *webhook.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Dispatch", ctx, event)
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockWebhookServiceMockRecorder) Dispatch(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockWebhookService)(nil).Dispatch), ctx, event)
+}
+
+// Prepare mocks base method.
+func (m *MockWebhookService) Prepare(ctx context.Context, event *webhook.Event) func(context.Context) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prepare", ctx, event)
+	ret0, _ := ret[0].(func(context.Context))
+	return ret0
+}
+
+// Prepare indicates an expected call of Prepare.
+func (mr *MockWebhookServiceMockRecorder) Prepare(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockWebhookService)(nil).Prepare), ctx, event)
+}
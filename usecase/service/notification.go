@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	dnotification "github.com/naka-sei/tsudzuri/domain/notification"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_notification/notification.go -source=./notification.go -package=mocknotification
+
+type NotificationService interface {
+	// Notify tells the other members of the page about the event. Call it after the change was committed.
+	// It never fails the caller: the change has already happened, so errors are only logged.
+	Notify(ctx context.Context, event *dnotification.Event)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_push/push.go -source=./push.go -package=mockpush
+
+// ErrInvalidPushToken is reported for a device token the push service no longer accepts.
+// Devices with such a token never receive pushes again and should be removed.
+var ErrInvalidPushToken = errors.New("invalid push token")
+
+// PushMessage is the content of a push notification.
+type PushMessage struct {
+	Title string
+	Body  string
+	// Data is passed to the app as is, e.g. to open the page the push is about.
+	Data map[string]string
+}
+
+type PushSender interface {
+	// Send delivers the message to the devices with the tokens. It returns one error per token in the same order,
+	// nil for delivered ones and ErrInvalidPushToken for tokens to remove. The error is for a batch that could
+	// not be sent at all. MaxBatchSize limits the number of tokens.
+	Send(ctx context.Context, tokens []string, message PushMessage) ([]error, error)
+	// MaxBatchSize returns the number of tokens Send accepts at once.
+	MaxBatchSize() int
+}
+
+type PushService interface {
+	// Push queues the message for the devices of the users and returns without waiting for the delivery.
+	Push(ctx context.Context, userIDs []string, message PushMessage)
+}
@@ -0,0 +1,10 @@
+package service
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_signer/signer.go -source=./signer.go -package=mocksigner
+
+type TokenSigner interface {
+	// Sign returns the value with a signature attached so it can be handed out.
+	Sign(value string) string
+	// Verify checks the signature of a value returned by Sign and returns the value.
+	Verify(signed string) (string, error)
+}
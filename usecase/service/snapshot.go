@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_snapshot/snapshot.go -source=./snapshot.go -package=mocksnapshot
+
+var (
+	// ErrSnapshotUnavailable is reported when the web page behind a link cannot be archived, e.g. because it
+	// did not answer, answered with an error or is not an HTML page.
+	ErrSnapshotUnavailable = errors.New("snapshot unavailable")
+	// ErrBlobNotFound is reported for a key without stored content.
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+type SnapshotFetcher interface {
+	// Fetch downloads the HTML page at the URL and returns it with scripts and other active content removed.
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+type BlobStorage interface {
+	// Put stores the data under the key, replacing any content stored there before.
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	// Get returns the data stored under the key, or ErrBlobNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
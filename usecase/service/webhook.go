@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_webhook/webhook.go -source=./webhook.go -package=mockwebhook
+
+// WebhookRequest is a signed payload to post to a webhook.
+type WebhookRequest struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+type WebhookClient interface {
+	// Post posts the JSON body to the URL and returns the HTTP status of the response. Any response is returned
+	// without an error, whatever its status; the error is for a request that got no response.
+	Post(ctx context.Context, request WebhookRequest) (int, error)
+}
+
+type WebhookService interface {
+	// Dispatch queues the event for the webhooks of its page and returns without waiting for the delivery.
+	// Call it after the change was committed. It never fails the caller: errors are only logged.
+	Dispatch(ctx context.Context, event *dwebhook.Event)
+	// Prepare looks up the webhooks the event is for and returns a function that queues the deliveries to them.
+	// It is for events whose webhooks are gone once the change is committed, like page.deleted: call Prepare
+	// before the change and the returned function after it was committed. The function is never nil.
+	Prepare(ctx context.Context, event *dwebhook.Event) func(context.Context)
+}
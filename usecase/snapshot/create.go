@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+type CreateUsecase interface {
+	// Create archives the web page a link of the page points to. The page is downloaded and stored without scripts
+	// before the call returns. The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Create(ctx context.Context, pageID string, linkID string) (*dsnapshot.Snapshot, error)
+}
+
+type createUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		snapshot dsnapshot.SnapshotRepository
+	}
+	service struct {
+		txn     service.TransactionService
+		fetcher service.SnapshotFetcher
+		blob    service.BlobStorage
+	}
+}
+
+func NewCreateUsecase(
+	pageRepo dpage.PageRepository,
+	snapshotRepo dsnapshot.SnapshotRepository,
+	txnService service.TransactionService,
+	fetcher service.SnapshotFetcher,
+	blobStorage service.BlobStorage,
+) CreateUsecase {
+	return &createUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			snapshot dsnapshot.SnapshotRepository
+		}{
+			page:     pageRepo,
+			snapshot: snapshotRepo,
+		},
+		service: struct {
+			txn     service.TransactionService
+			fetcher service.SnapshotFetcher
+			blob    service.BlobStorage
+		}{
+			txn:     txnService,
+			fetcher: fetcher,
+			blob:    blobStorage,
+		},
+	}
+}
+
+func (u *createUsecase) Create(ctx context.Context, pageID string, linkID string) (*dsnapshot.Snapshot, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/snapshot/createUsecase.Create")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Creating snapshot of link %s of page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	snapshot, err := dsnapshot.NewSnapshot(page, user, linkID, ctxtime.Now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := u.service.fetcher.Fetch(ctx, snapshot.URL())
+	if err != nil {
+		return nil, err
+	}
+	snapshot.SetSize(int64(len(content)))
+
+	// The content is stored first so that a saved snapshot always has it. A failed save leaves an unreferenced blob behind.
+	if err := u.service.blob.Put(ctx, snapshot.StorageKey(), dsnapshot.ContentType, content); err != nil {
+		return nil, err
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		snapshot, err = u.repository.snapshot.Create(ctx, snapshot)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
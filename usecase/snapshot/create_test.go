@@ -0,0 +1,162 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	mocksnapshot "github.com/naka-sei/tsudzuri/domain/snapshot/mock/mock_snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mockservicesnapshot "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_snapshot"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestCreateUsecase_Create(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		snapshotRepo *mocksnapshot.MockSnapshotRepository
+		txn          *mocktxn.MockTransactionService
+		fetcher      *mockservicesnapshot.MockSnapshotFetcher
+		blob         *mockservicesnapshot.MockBlobStorage
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+	}
+	type want struct {
+		snapshot *dsnapshot.Snapshot
+		err      error
+	}
+
+	now := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	other := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+	ctx := ctxtime.WithTime(ctxuser.WithUser(context.Background(), creator), now)
+	content := []byte("<html><body>archived</body></html>")
+	saved := dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key", int64(len(content)), "1", now)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.fetcher.EXPECT().Fetch(gomock.Any(), "https://example.com").Return(content, nil)
+				var key string
+				m.blob.EXPECT().Put(gomock.Any(), gomock.Any(), dsnapshot.ContentType, content).
+					DoAndReturn(func(_ context.Context, k string, _ string, _ []byte) error {
+						key = k
+						return nil
+					})
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.snapshotRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *dsnapshot.Snapshot) (*dsnapshot.Snapshot, error) {
+						want := dsnapshot.ReconstructSnapshot("", "page-1", "link-1", "https://example.com", key, int64(len(content)), "1", now)
+						if diff := cmp.Diff(want, s, cmp.AllowUnexported(dsnapshot.Snapshot{})); diff != "" {
+							t.Errorf("created snapshot mismatch (-want +got):\n%s", diff)
+						}
+						return saved, nil
+					})
+			},
+			args: args{ctx: ctx, pageID: "page-1", linkID: "link-1"},
+			want: want{snapshot: saved},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", linkID: "link-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctx, pageID: "page-1", linkID: "link-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), other), pageID: "page-1", linkID: "link-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "link_not_on_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctx, pageID: "page-1", linkID: "link-x"},
+			want: want{err: dpage.ErrNotFoundLinkByID("link-x")},
+		},
+		{
+			name: "fetch_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.fetcher.EXPECT().Fetch(gomock.Any(), "https://example.com").Return(nil, service.ErrSnapshotUnavailable)
+			},
+			args: args{ctx: ctx, pageID: "page-1", linkID: "link-1"},
+			want: want{err: service.ErrSnapshotUnavailable},
+		},
+		{
+			name: "storage_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.fetcher.EXPECT().Fetch(gomock.Any(), "https://example.com").Return(content, nil)
+				m.blob.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("put error"))
+			},
+			args: args{ctx: ctx, pageID: "page-1", linkID: "link-1"},
+			want: want{err: errors.New("put error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				snapshotRepo: mocksnapshot.NewMockSnapshotRepository(ctrl),
+				txn:          mocktxn.NewMockTransactionService(ctrl),
+				fetcher:      mockservicesnapshot.NewMockSnapshotFetcher(ctrl),
+				blob:         mockservicesnapshot.NewMockBlobStorage(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewCreateUsecase(m.pageRepo, m.snapshotRepo, m.txn, m.fetcher, m.blob)
+			got, err := u.Create(tt.args.ctx, tt.args.pageID, tt.args.linkID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.snapshot, got, cmp.AllowUnexported(dsnapshot.Snapshot{})); diff != "" {
+				t.Fatalf("Create() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
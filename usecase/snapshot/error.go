@@ -0,0 +1,5 @@
+package snapshot
+
+import "errors"
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
@@ -0,0 +1,91 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_get/get.go -source=./get.go -package=mockgetusecase
+type GetUsecase interface {
+	// Get returns a snapshot of a link of the page with its archived HTML.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Get(ctx context.Context, pageID string, linkID string, snapshotID string) (*dsnapshot.Snapshot, []byte, error)
+}
+
+type getUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		snapshot dsnapshot.SnapshotRepository
+	}
+	service struct {
+		blob service.BlobStorage
+	}
+}
+
+func NewGetUsecase(pageRepo dpage.PageRepository, snapshotRepo dsnapshot.SnapshotRepository, blobStorage service.BlobStorage) GetUsecase {
+	return &getUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			snapshot dsnapshot.SnapshotRepository
+		}{
+			page:     pageRepo,
+			snapshot: snapshotRepo,
+		},
+		service: struct {
+			blob service.BlobStorage
+		}{
+			blob: blobStorage,
+		},
+	}
+}
+
+func (u *getUsecase) Get(ctx context.Context, pageID string, linkID string, snapshotID string) (*dsnapshot.Snapshot, []byte, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/snapshot/getUsecase.Get")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Getting snapshot %s of link %s of page %s", snapshotID, linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if page == nil {
+		return nil, nil, upage.ErrPageNotFound
+	}
+
+	snapshot, err := u.repository.snapshot.Get(ctx, snapshotID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot == nil || snapshot.LinkID() != linkID {
+		return nil, nil, ErrSnapshotNotFound
+	}
+	if err := snapshot.Authorize(page, user); err != nil {
+		return nil, nil, err
+	}
+
+	content, err := u.service.blob.Get(ctx, snapshot.StorageKey())
+	if errors.Is(err, service.ErrBlobNotFound) {
+		return nil, nil, ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return snapshot, content, nil
+}
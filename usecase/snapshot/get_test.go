@@ -0,0 +1,163 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	mocksnapshot "github.com/naka-sei/tsudzuri/domain/snapshot/mock/mock_snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+	mockservicesnapshot "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_snapshot"
+)
+
+func TestGetUsecase_Get(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		snapshotRepo *mocksnapshot.MockSnapshotRepository
+		blob         *mockservicesnapshot.MockBlobStorage
+	}
+	type args struct {
+		ctx        context.Context
+		linkID     string
+		snapshotID string
+	}
+	type want struct {
+		snapshot *dsnapshot.Snapshot
+		content  []byte
+		err      error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	other := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+	snapshot := dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key", 7, "1", time.Time{})
+	elsewhere := dsnapshot.ReconstructSnapshot("snapshot-2", "page-2", "link-1", "https://example.com", "key-2", 7, "2", time.Time{})
+	ctx := ctxuser.WithUser(context.Background(), creator)
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(snapshot, nil)
+				m.blob.EXPECT().Get(gomock.Any(), "key").Return([]byte("<p></p>"), nil)
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{snapshot: snapshot, content: []byte("<p></p>")},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "snapshot_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(nil, nil)
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: ErrSnapshotNotFound},
+		},
+		{
+			name: "snapshot_of_other_link",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(snapshot, nil)
+			},
+			args: args{ctx: ctx, linkID: "link-2", snapshotID: "snapshot-1"},
+			want: want{err: ErrSnapshotNotFound},
+		},
+		{
+			name: "snapshot_of_other_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-2").Return(elsewhere, nil)
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-2"},
+			want: want{err: dsnapshot.ErrPageMismatch},
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(snapshot, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), other), linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "content_missing",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(snapshot, nil)
+				m.blob.EXPECT().Get(gomock.Any(), "key").Return(nil, service.ErrBlobNotFound)
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: ErrSnapshotNotFound},
+		},
+		{
+			name: "storage_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().Get(gomock.Any(), "snapshot-1").Return(snapshot, nil)
+				m.blob.EXPECT().Get(gomock.Any(), "key").Return(nil, errors.New("get error"))
+			},
+			args: args{ctx: ctx, linkID: "link-1", snapshotID: "snapshot-1"},
+			want: want{err: errors.New("get error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				snapshotRepo: mocksnapshot.NewMockSnapshotRepository(ctrl),
+				blob:         mockservicesnapshot.NewMockBlobStorage(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewGetUsecase(m.pageRepo, m.snapshotRepo, m.blob)
+			got, content, err := u.Get(tt.args.ctx, "page-1", tt.args.linkID, tt.args.snapshotID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.snapshot, got, cmp.AllowUnexported(dsnapshot.Snapshot{})); diff != "" {
+				t.Fatalf("Get() mismatch (-want +got):\n%s", diff)
+			}
+			if string(content) != string(tt.want.content) {
+				t.Fatalf("Get() content = %q, want %q", content, tt.want.content)
+			}
+		})
+	}
+}
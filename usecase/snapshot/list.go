@@ -0,0 +1,68 @@
+package snapshot
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+type ListUsecase interface {
+	// List returns the snapshots of a link of the page, newest first.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, pageID string, linkID string) ([]*dsnapshot.Snapshot, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		snapshot dsnapshot.SnapshotRepository
+	}
+}
+
+func NewListUsecase(pageRepo dpage.PageRepository, snapshotRepo dsnapshot.SnapshotRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			snapshot dsnapshot.SnapshotRepository
+		}{
+			page:     pageRepo,
+			snapshot: snapshotRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context, pageID string, linkID string) ([]*dsnapshot.Snapshot, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/snapshot/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing snapshots of link %s of page %s", linkID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+	if err := page.Authorize(user); err != nil {
+		return nil, err
+	}
+	if !page.HasLink(linkID) {
+		return nil, dpage.ErrNotFoundLinkByID(linkID)
+	}
+
+	return u.repository.snapshot.ListByLink(ctx, linkID)
+}
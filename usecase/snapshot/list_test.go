@@ -0,0 +1,123 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	dsnapshot "github.com/naka-sei/tsudzuri/domain/snapshot"
+	mocksnapshot "github.com/naka-sei/tsudzuri/domain/snapshot/mock/mock_snapshot"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		snapshotRepo *mocksnapshot.MockSnapshotRepository
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		linkID string
+	}
+	type want struct {
+		snapshots []*dsnapshot.Snapshot
+		err       error
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	other := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", dpage.Links{
+		dpage.ReconstructLink("https://example.com", "", 1, dpage.WithLinkID("link-1")),
+	}, nil)
+	snapshots := []*dsnapshot.Snapshot{
+		dsnapshot.ReconstructSnapshot("snapshot-1", "page-1", "link-1", "https://example.com", "key", 10, "1", time.Time{}),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().ListByLink(gomock.Any(), "link-1").Return(snapshots, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{snapshots: snapshots},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", linkID: "link-1"},
+			want: want{err: duser.ErrUserNotFound},
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{err: upage.ErrPageNotFound},
+		},
+		{
+			name: "not_member",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), other), pageID: "page-1", linkID: "link-1"},
+			want: want{err: dpage.ErrNotCreatedByUser},
+		},
+		{
+			name: "link_not_on_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-x"},
+			want: want{err: dpage.ErrNotFoundLinkByID("link-x")},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.snapshotRepo.EXPECT().ListByLink(gomock.Any(), "link-1").Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), creator), pageID: "page-1", linkID: "link-1"},
+			want: want{err: errors.New("list error")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				snapshotRepo: mocksnapshot.NewMockSnapshotRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewListUsecase(m.pageRepo, m.snapshotRepo)
+			got, err := u.List(tt.args.ctx, tt.args.pageID, tt.args.linkID)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.snapshots, got, cmp.AllowUnexported(dsnapshot.Snapshot{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
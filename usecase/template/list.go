@@ -0,0 +1,49 @@
+package template
+
+import (
+	"context"
+
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+type ListUsecase interface {
+	// List returns the templates the user saved and the shared templates available to the user.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context) ([]*dtemplate.Template, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		template dtemplate.TemplateRepository
+	}
+}
+
+func NewListUsecase(templateRepo dtemplate.TemplateRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			template dtemplate.TemplateRepository
+		}{
+			template: templateRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context) ([]*dtemplate.Template, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/template/listUsecase.List")
+	defer end()
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing templates for user %s", user.ID())
+
+	return u.repository.template.ListAvailable(ctx, user.ID())
+}
@@ -0,0 +1,80 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	mocktemplate "github.com/naka-sei/tsudzuri/domain/template/mock/mock_template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	type want struct {
+		templates []*dtemplate.Template
+		err       error
+	}
+
+	user := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	templates := []*dtemplate.Template{
+		dtemplate.ReconstructTemplate("t1", "Trip", *user, dtemplate.VisibilityPrivate, nil, nil),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocktemplate.MockTemplateRepository)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(m *mocktemplate.MockTemplateRepository) {
+				m.EXPECT().ListAvailable(gomock.Any(), "1").Return(templates, nil)
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user)},
+			want: want{templates: templates},
+		},
+		{
+			name: "repository_error",
+			setup: func(m *mocktemplate.MockTemplateRepository) {
+				m.EXPECT().ListAvailable(gomock.Any(), "1").Return(nil, errors.New("list error"))
+			},
+			args: args{ctx: ctxuser.WithUser(context.Background(), user)},
+			want: want{err: errors.New("list error")},
+		},
+		{
+			name: "user_not_found",
+			args: args{ctx: context.Background()},
+			want: want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocktemplate.NewMockTemplateRepository(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			u := NewListUsecase(repo)
+			got, err := u.List(tt.args.ctx)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.templates, got, cmp.AllowUnexported(dtemplate.Template{}, duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("templates mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./save.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_save/save.go -source=./save.go -package=mocksaveusecase
+//
+
+// Package mocksaveusecase is a generated GoMock package.
+package mocksaveusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	template "github.com/naka-sei/tsudzuri/domain/template"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSaveUsecase is a mock of SaveUsecase interface.
+type MockSaveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSaveUsecaseMockRecorder is the mock recorder for MockSaveUsecase.
+type MockSaveUsecaseMockRecorder struct {
+	mock *MockSaveUsecase
+}
+
+// NewMockSaveUsecase creates a new mock instance.
+func NewMockSaveUsecase(ctrl *gomock.Controller) *MockSaveUsecase {
+	mock := &MockSaveUsecase{ctrl: ctrl}
+	mock.recorder = &MockSaveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaveUsecase) EXPECT() *MockSaveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Save mocks base method.
+func (m *MockSaveUsecase) Save(ctx context.Context, pageID, title string, visibility template.Visibility) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, pageID, title, visibility)
+	ret0, _ := ret[0].(*template.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSaveUsecaseMockRecorder) Save(ctx, pageID, title, visibility any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSaveUsecase)(nil).Save), ctx, pageID, title, visibility)
+}
@@ -0,0 +1,88 @@
+package template
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_save/save.go -source=./save.go -package=mocksaveusecase
+type SaveUsecase interface {
+	// Save saves the page as a template owned by the user. An empty title falls back to the page title.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Save(ctx context.Context, pageID string, title string, visibility dtemplate.Visibility) (*dtemplate.Template, error)
+}
+
+type saveUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		template dtemplate.TemplateRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewSaveUsecase(
+	pageRepo dpage.PageRepository,
+	templateRepo dtemplate.TemplateRepository,
+	txnService service.TransactionService,
+) SaveUsecase {
+	return &saveUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			template dtemplate.TemplateRepository
+		}{
+			page:     pageRepo,
+			template: templateRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *saveUsecase) Save(ctx context.Context, pageID string, title string, visibility dtemplate.Visibility) (*dtemplate.Template, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/template/saveUsecase.Save")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Saving page %s as a %s template", pageID, visibility)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	template, err := dtemplate.NewTemplate(page, user, title, visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		template, err = u.repository.template.Save(ctx, template)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
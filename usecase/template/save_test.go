@@ -0,0 +1,164 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	dtemplate "github.com/naka-sei/tsudzuri/domain/template"
+	mocktemplate "github.com/naka-sei/tsudzuri/domain/template/mock/mock_template"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestSaveUsecase_Save(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		templateRepo *mocktemplate.MockTemplateRepository
+		txn          *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx        context.Context
+		pageID     string
+		title      string
+		visibility dtemplate.Visibility
+	}
+
+	creatorUser := duser.ReconstructUser("1", "user1", "anonymous", nil)
+	otherUser := duser.ReconstructUser("3", "user3", "anonymous", nil)
+	errSave := errors.New("save error")
+
+	newPage := func() *dpage.Page {
+		return dpage.ReconstructPage("1", "Trip", *creatorUser, "invite-code", dpage.Links{
+			dpage.ReconstructLink("https://a.com", "A", 1, dpage.WithLinkID("link-a")),
+		}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(m *mocks)
+		args      args
+		wantTitle string
+		wantErr   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.templateRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tmpl *dtemplate.Template) (*dtemplate.Template, error) {
+						if tmpl.Visibility() != dtemplate.VisibilityShared || len(tmpl.Links()) != 1 {
+							t.Errorf("unexpected template: %+v", tmpl)
+						}
+						return dtemplate.ReconstructTemplate("10", tmpl.Title(), *tmpl.CreatedBy(), tmpl.Visibility(), tmpl.Links(), tmpl.SharedUsers()), nil
+					})
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:     "1",
+				visibility: dtemplate.VisibilityShared,
+			},
+			wantTitle: "Trip",
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{
+				ctx:        context.Background(),
+				pageID:     "1",
+				visibility: dtemplate.VisibilityPrivate,
+			},
+			wantErr: duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(nil, nil)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:     "1",
+				visibility: dtemplate.VisibilityPrivate,
+			},
+			wantErr: upage.ErrPageNotFound,
+		},
+		{
+			name: "unauthorized",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), otherUser),
+				pageID:     "1",
+				visibility: dtemplate.VisibilityPrivate,
+			},
+			wantErr: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "invalid_visibility",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:     "1",
+				visibility: dtemplate.Visibility("public"),
+			},
+			wantErr: dtemplate.ErrInvalidVisibility(dtemplate.Visibility("public")),
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "1").Return(newPage(), nil)
+				m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+				m.templateRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errSave)
+			},
+			args: args{
+				ctx:        ctxuser.WithUser(context.Background(), creatorUser),
+				pageID:     "1",
+				visibility: dtemplate.VisibilityPrivate,
+			},
+			wantErr: errSave,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				templateRepo: mocktemplate.NewMockTemplateRepository(ctrl),
+				txn:          mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewSaveUsecase(m.pageRepo, m.templateRepo, m.txn)
+			got, err := u.Save(tt.args.ctx, tt.args.pageID, tt.args.title, tt.args.visibility)
+			testutil.EqualErr(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+			if got == nil || got.ID() != "10" || got.Title() != tt.wantTitle {
+				t.Fatalf("unexpected template: %+v", got)
+			}
+		})
+	}
+}
@@ -135,7 +135,7 @@ func TestCreateUsecase_Create(t *testing.T) {
 			}
 			u := NewCreateUsecase(f.userRepo, f.txnService)
 			got, err := u.Create(tt.args.ctx, tt.args.uid)
-			if diff := cmp.Diff(tt.want.user, got, cmp.AllowUnexported(duser.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.user, got, cmp.AllowUnexported(duser.User{}, duser.Profile{})); diff != "" {
 				t.Errorf("user mismatch (-want +got):\n%s", diff)
 			}
 			testutil.EqualErr(t, tt.want.err, err)
@@ -91,7 +91,7 @@ func TestGetUsecase_Get(t *testing.T) {
 			u := NewGetUsecase(f.userRepo)
 			got, err := u.Get(tt.args.ctx)
 
-			if diff := cmp.Diff(tt.want.user, got, cmp.AllowUnexported(duser.User{})); diff != "" {
+			if diff := cmp.Diff(tt.want.user, got, cmp.AllowUnexported(duser.User{}, duser.Profile{})); diff != "" {
 				t.Fatalf("user mismatch (-want +got):\n%s", diff)
 			}
 			testutil.EqualErr(t, tt.want.err, err)
@@ -14,6 +14,7 @@ import (
 
 type LoginUsecase interface {
 	// Login authenticates and updates the user with provider and email.
+	// Empty profile fields are seeded from the claims of the identity provider.
 	Login(ctx context.Context, provider string, email *string) error
 }
 
@@ -57,6 +58,9 @@ func (u *loginUsecase) Login(ctx context.Context, provider string, email *string
 	if err := user.Login(provider, email); err != nil {
 		return err
 	}
+	if claimed, ok := ctxuser.ClaimedProfileFromContext(ctx); ok {
+		user.SeedProfile(claimed)
+	}
 	if err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
 		var err error
 		user, err = u.repository.user.Save(ctx, user)
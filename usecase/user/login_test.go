@@ -58,6 +58,32 @@ func TestLoginUsecase_Login(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "success_seeds_profile",
+			setup: func(f *fields) {
+				f.txnService.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				f.userRepo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&duser.User{})).Return(nil, nil)
+			},
+			args: args{
+				ctx: ctxuser.WithClaimedProfile(
+					ctxuser.WithUser(context.Background(), duser.NewUser("uid-2")),
+					duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja"),
+				),
+				provider: "google",
+				email:    ptr.Ptr("u@example.com"),
+			},
+			want: want{
+				user: func() *duser.User {
+					return duser.ReconstructUser("", "uid-2", "google", ptr.Ptr("u@example.com"),
+						duser.WithProfile(duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja")))
+				}(),
+				err: nil,
+			},
+		},
 		{
 			name: "user_save_error",
 			setup: func(f *fields) {
@@ -143,7 +169,7 @@ func TestLoginUsecase_Login(t *testing.T) {
 			err := u.Login(tt.args.ctx, tt.args.provider, tt.args.email)
 
 			if gotUser, ok := ctxuser.UserFromContext(tt.args.ctx); ok && tt.want.user != nil {
-				if diff := cmp.Diff(tt.want.user, gotUser, cmp.AllowUnexported(duser.User{})); diff != "" {
+				if diff := cmp.Diff(tt.want.user, gotUser, cmp.AllowUnexported(duser.User{}, duser.Profile{})); diff != "" {
 					t.Errorf("user mismatch (-want +got):\n%s", diff)
 				}
 			}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./profile_update.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_profile_update/profile_update.go -source=./profile_update.go -package=mockprofileupdateusecase
+//
+
+// Package mockprofileupdateusecase is a generated GoMock package.
+package mockprofileupdateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/naka-sei/tsudzuri/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProfileUpdateUsecase is a mock of ProfileUpdateUsecase interface.
+type MockProfileUpdateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileUpdateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockProfileUpdateUsecaseMockRecorder is the mock recorder for MockProfileUpdateUsecase.
+type MockProfileUpdateUsecaseMockRecorder struct {
+	mock *MockProfileUpdateUsecase
+}
+
+// NewMockProfileUpdateUsecase creates a new mock instance.
+func NewMockProfileUpdateUsecase(ctrl *gomock.Controller) *MockProfileUpdateUsecase {
+	mock := &MockProfileUpdateUsecase{ctrl: ctrl}
+	mock.recorder = &MockProfileUpdateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileUpdateUsecase) EXPECT() *MockProfileUpdateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateProfile mocks base method.
+func (m *MockProfileUpdateUsecase) UpdateProfile(ctx context.Context, displayName, avatarURL, locale string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, displayName, avatarURL, locale)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockProfileUpdateUsecaseMockRecorder) UpdateProfile(ctx, displayName, avatarURL, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockProfileUpdateUsecase)(nil).UpdateProfile), ctx, displayName, avatarURL, locale)
+}
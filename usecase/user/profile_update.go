@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_profile_update/profile_update.go -source=./profile_update.go -package=mockprofileupdateusecase
+
+type ProfileUpdateUsecase interface {
+	// UpdateProfile replaces the profile of the authenticated user and returns the updated user.
+	// Empty values clear the fields.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	UpdateProfile(ctx context.Context, displayName string, avatarURL string, locale string) (*duser.User, error)
+}
+
+type profileUpdateUsecase struct {
+	repository struct {
+		user duser.UserRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewProfileUpdateUsecase(userRepo duser.UserRepository, txnService service.TransactionService) ProfileUpdateUsecase {
+	return &profileUpdateUsecase{
+		repository: struct {
+			user duser.UserRepository
+		}{
+			user: userRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *profileUpdateUsecase) UpdateProfile(ctx context.Context, displayName string, avatarURL string, locale string) (*duser.User, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/user/profileUpdateUsecase.UpdateProfile")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	l.Sugar().Infof("Updating profile of user id: %s", user.ID())
+
+	if err := user.UpdateProfile(displayName, avatarURL, locale); err != nil {
+		return nil, err
+	}
+	if err := u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.repository.user.Save(ctx, user)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
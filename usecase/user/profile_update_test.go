@@ -0,0 +1,108 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	mockuser "github.com/naka-sei/tsudzuri/domain/user/mock/mock_user"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/ptr"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	mocktransaction "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProfileUpdateUsecase_UpdateProfile(t *testing.T) {
+	type fields struct {
+		userRepo   *mockuser.MockUserRepository
+		txnService *mocktransaction.MockTransactionService
+	}
+	type args struct {
+		ctx         context.Context
+		displayName string
+		avatarURL   string
+		locale      string
+	}
+	type want struct {
+		user *duser.User
+		err  error
+	}
+
+	newCtx := func() context.Context {
+		return ctxuser.WithUser(context.Background(), duser.ReconstructUser("user-1", "uid-1", "google", ptr.Ptr("u@example.com")))
+	}
+
+	tests := []struct {
+		name  string
+		setup func(f *fields)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(f *fields) {
+				f.txnService.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				f.userRepo.EXPECT().Save(gomock.Any(), gomock.AssignableToTypeOf(&duser.User{})).DoAndReturn(
+					func(_ context.Context, u *duser.User) (*duser.User, error) { return u, nil },
+				)
+			},
+			args: args{ctx: newCtx(), displayName: "Naka", avatarURL: "https://example.com/a.png", locale: "ja"},
+			want: want{
+				user: duser.ReconstructUser("user-1", "uid-1", "google", ptr.Ptr("u@example.com"),
+					duser.WithProfile(duser.ReconstructProfile("Naka", "https://example.com/a.png", "ja"))),
+			},
+		},
+		{
+			name:  "invalid_locale",
+			setup: func(f *fields) {},
+			args:  args{ctx: newCtx(), displayName: "Naka", locale: "??"},
+			want:  want{err: duser.ErrInvalidLocale},
+		},
+		{
+			name: "save_error",
+			setup: func(f *fields) {
+				f.txnService.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				f.userRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{ctx: newCtx(), displayName: "Naka"},
+			want: want{err: errors.New("save error")},
+		},
+		{
+			name:  "no_user_in_context",
+			setup: func(f *fields) {},
+			args:  args{ctx: context.Background(), displayName: "Naka"},
+			want:  want{err: duser.ErrUserNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			f := &fields{
+				userRepo:   mockuser.NewMockUserRepository(ctrl),
+				txnService: mocktransaction.NewMockTransactionService(ctrl),
+			}
+			tt.setup(f)
+
+			u := NewProfileUpdateUsecase(f.userRepo, f.txnService)
+			got, err := u.UpdateProfile(tt.args.ctx, tt.args.displayName, tt.args.avatarURL, tt.args.locale)
+			testutil.EqualErr(t, tt.want.err, err)
+			if diff := cmp.Diff(tt.want.user, got, cmp.AllowUnexported(duser.User{}, duser.Profile{})); diff != "" {
+				t.Fatalf("user mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_create/create.go -source=./create.go -package=mockcreateusecase
+
+type CreateUsecase interface {
+	// Create registers a webhook of the page that is called with the events; empty events mean all of them.
+	// The returned webhook carries the signing secret, which is not shown again.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Create(ctx context.Context, pageID string, url string, events []dwebhook.EventType) (*dwebhook.Webhook, error)
+}
+
+type createUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		webhook dwebhook.WebhookRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewCreateUsecase(
+	pageRepo dpage.PageRepository,
+	webhookRepo dwebhook.WebhookRepository,
+	txnService service.TransactionService,
+) CreateUsecase {
+	return &createUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			webhook dwebhook.WebhookRepository
+		}{
+			page:    pageRepo,
+			webhook: webhookRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *createUsecase) Create(ctx context.Context, pageID string, url string, events []dwebhook.EventType) (*dwebhook.Webhook, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/createUsecase.Create")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Creating webhook for page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	webhook, err := dwebhook.NewWebhook(page, user, url, events, ctxtime.Now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var created *dwebhook.Webhook
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		existing, err := u.repository.webhook.ListByPageID(ctx, page.ID())
+		if err != nil {
+			return err
+		}
+		if len(existing) >= dwebhook.MaxWebhooksPerPage {
+			return dwebhook.ErrTooManyWebhooks
+		}
+		created, err = u.repository.webhook.Save(ctx, webhook)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
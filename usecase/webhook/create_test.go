@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+var fixedNow = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestCreateUsecase_Create(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		webhookRepo *mockwebhook.MockWebhookRepository
+		txn         *mocktxn.MockTransactionService
+	}
+	type args struct {
+		ctx    context.Context
+		pageID string
+		url    string
+		events []dwebhook.EventType
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	withUser := func(u *duser.User) context.Context {
+		return ctxtime.WithTime(ctxuser.WithUser(context.Background(), u), fixedNow)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	existing := func(n int) []*dwebhook.Webhook {
+		webhooks := make([]*dwebhook.Webhook, 0, n)
+		for range n {
+			webhooks = append(webhooks, dwebhook.ReconstructWebhook("hook", "page-1", "https://example.com", "whsec", nil, "1"))
+		}
+		return webhooks
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		args  args
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return(existing(1), nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w *dwebhook.Webhook) (*dwebhook.Webhook, error) {
+						if w.URL() != "https://example.com/hook" || w.Secret() == "" || !w.Subscribes(dwebhook.EventLinkAdded) {
+							t.Errorf("unexpected webhook url=%s events=%v", w.URL(), w.Events())
+						}
+						return w, nil
+					})
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", url: "https://example.com/hook", events: []dwebhook.EventType{dwebhook.EventLinkAdded}},
+		},
+		{
+			name: "user_not_found_in_context",
+			args: args{ctx: context.Background(), pageID: "page-1", url: "https://example.com/hook"},
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", url: "https://example.com/hook"},
+			err:  upage.ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: withUser(member), pageID: "page-1", url: "https://example.com/hook"},
+			err:  dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "invalid_url",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", url: "not a url"},
+			err:  dwebhook.ErrInvalidURL,
+		},
+		{
+			name: "too_many_webhooks",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return(existing(dwebhook.MaxWebhooksPerPage), nil)
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", url: "https://example.com/hook"},
+			err:  dwebhook.ErrTooManyWebhooks,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return(nil, nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			args: args{ctx: withUser(creator), pageID: "page-1", url: "https://example.com/hook"},
+			err:  errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				webhookRepo: mockwebhook.NewMockWebhookRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewCreateUsecase(m.pageRepo, m.webhookRepo, m.txn)
+			got, err := u.Create(tt.args.ctx, tt.args.pageID, tt.args.url, tt.args.events)
+			testutil.EqualErr(t, tt.err, err)
+			if tt.err == nil && got == nil {
+				t.Fatalf("Create() returned no webhook")
+			}
+		})
+	}
+}
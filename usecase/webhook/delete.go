@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_delete/delete.go -source=./delete.go -package=mockdeleteusecase
+
+type DeleteUsecase interface {
+	// Delete removes a webhook of the page together with its delivery log.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Delete(ctx context.Context, pageID string, webhookID string) error
+}
+
+type deleteUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		webhook dwebhook.WebhookRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewDeleteUsecase(
+	pageRepo dpage.PageRepository,
+	webhookRepo dwebhook.WebhookRepository,
+	txnService service.TransactionService,
+) DeleteUsecase {
+	return &deleteUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			webhook dwebhook.WebhookRepository
+		}{
+			page:    pageRepo,
+			webhook: webhookRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *deleteUsecase) Delete(ctx context.Context, pageID string, webhookID string) error {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/deleteUsecase.Delete")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Deleting webhook %s of page %s", webhookID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return upage.ErrPageNotFound
+	}
+
+	return u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		webhook, err := getWebhookOfPage(ctx, u.repository.webhook, page, webhookID)
+		if err != nil {
+			return err
+		}
+		if err := webhook.Authorize(page, user); err != nil {
+			return err
+		}
+		return u.repository.webhook.Delete(ctx, webhook.ID())
+	})
+}
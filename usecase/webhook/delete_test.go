@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestDeleteUsecase_Delete(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		webhookRepo *mockwebhook.MockWebhookRepository
+		txn         *mocktxn.MockTransactionService
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	webhook := dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/hook", "whsec", nil, "1")
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+				m.webhookRepo.EXPECT().Delete(gomock.Any(), "hook-1").Return(nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: upage.ErrPageNotFound,
+		},
+		{
+			name: "webhook_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(nil, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: ErrWebhookNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), member),
+			err: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "delete_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+				m.webhookRepo.EXPECT().Delete(gomock.Any(), "hook-1").Return(errors.New("delete error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: errors.New("delete error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				webhookRepo: mockwebhook.NewMockWebhookRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewDeleteUsecase(m.pageRepo, m.webhookRepo, m.txn)
+			err := u.Delete(tt.ctx, "page-1", "hook-1")
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}
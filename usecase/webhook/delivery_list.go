@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_delivery_list/delivery_list.go -source=./delivery_list.go -package=mockdeliverylistusecase
+
+type DeliveryListUsecase interface {
+	// DeliveryList returns the recent delivery attempts of a webhook of the page, newest first.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	DeliveryList(ctx context.Context, pageID string, webhookID string) ([]*dwebhook.Delivery, error)
+}
+
+type deliveryListUsecase struct {
+	repository struct {
+		page     dpage.PageRepository
+		webhook  dwebhook.WebhookRepository
+		delivery dwebhook.DeliveryRepository
+	}
+}
+
+func NewDeliveryListUsecase(
+	pageRepo dpage.PageRepository,
+	webhookRepo dwebhook.WebhookRepository,
+	deliveryRepo dwebhook.DeliveryRepository,
+) DeliveryListUsecase {
+	return &deliveryListUsecase{
+		repository: struct {
+			page     dpage.PageRepository
+			webhook  dwebhook.WebhookRepository
+			delivery dwebhook.DeliveryRepository
+		}{
+			page:     pageRepo,
+			webhook:  webhookRepo,
+			delivery: deliveryRepo,
+		},
+	}
+}
+
+func (u *deliveryListUsecase) DeliveryList(ctx context.Context, pageID string, webhookID string) ([]*dwebhook.Delivery, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/deliveryListUsecase.DeliveryList")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing deliveries of webhook %s of page %s", webhookID, pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	webhook, err := getWebhookOfPage(ctx, u.repository.webhook, page, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if err := webhook.Authorize(page, user); err != nil {
+		return nil, err
+	}
+
+	return u.repository.delivery.ListByWebhookID(ctx, webhook.ID(), dwebhook.MaxDeliveries)
+}
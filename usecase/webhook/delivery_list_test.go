@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestDeliveryListUsecase_DeliveryList(t *testing.T) {
+	type mocks struct {
+		pageRepo     *mockpage.MockPageRepository
+		webhookRepo  *mockwebhook.MockWebhookRepository
+		deliveryRepo *mockwebhook.MockDeliveryRepository
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	webhook := dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/hook", "whsec", nil, "1")
+	deliveries := []*dwebhook.Delivery{
+		dwebhook.ReconstructDelivery("d-1", "hook-1", "event-1", dwebhook.EventLinkAdded, 1, 200, "", time.Second, fixedNow),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		want  []*dwebhook.Delivery
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+				m.deliveryRepo.EXPECT().ListByWebhookID(gomock.Any(), "hook-1", dwebhook.MaxDeliveries).Return(deliveries, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), creator),
+			want: deliveries,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: upage.ErrPageNotFound,
+		},
+		{
+			name: "webhook_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(nil, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: ErrWebhookNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), member),
+			err: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(webhook, nil)
+				m.deliveryRepo.EXPECT().ListByWebhookID(gomock.Any(), "hook-1", dwebhook.MaxDeliveries).Return(nil, errors.New("list error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: errors.New("list error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:     mockpage.NewMockPageRepository(ctrl),
+				webhookRepo:  mockwebhook.NewMockWebhookRepository(ctrl),
+				deliveryRepo: mockwebhook.NewMockDeliveryRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewDeliveryListUsecase(m.pageRepo, m.webhookRepo, m.deliveryRepo)
+			got, err := u.DeliveryList(tt.ctx, "page-1", "hook-1")
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dwebhook.Delivery{})); diff != "" {
+				t.Fatalf("DeliveryList() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
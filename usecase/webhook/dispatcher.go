@@ -0,0 +1,243 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	tsuuid "github.com/naka-sei/tsudzuri/pkg/uuid"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+const (
+	// dispatchQueueSize is the number of deliveries waiting before new ones are dropped.
+	dispatchQueueSize = 256
+	// dispatchConcurrency is the number of deliveries in flight at once.
+	dispatchConcurrency = 4
+	// deliveryMaxAttempts is the number of times a payload is posted before it is given up.
+	deliveryMaxAttempts = 4
+	// deliveryBaseBackoff is the wait before the first retry. It doubles with every further attempt.
+	deliveryBaseBackoff = 2 * time.Second
+)
+
+type deliveryJob struct {
+	webhook   *dwebhook.Webhook
+	eventID   string
+	eventType dwebhook.EventType
+	body      []byte
+}
+
+// Dispatcher delivers page events to webhooks in the background.
+// Events are queued by Dispatch and posted while Run is running; deliveries still queued when Run returns are lost.
+// Every attempt is recorded in the delivery log, and a webhook is disabled after too many failed deliveries in a row.
+type Dispatcher struct {
+	repository struct {
+		webhook  dwebhook.WebhookRepository
+		delivery dwebhook.DeliveryRepository
+	}
+	service struct {
+		client service.WebhookClient
+	}
+	queue       chan deliveryJob
+	concurrency int
+	maxAttempts int
+	backoff     time.Duration
+	// mu serializes the updates of the failures of webhooks between concurrent deliveries.
+	mu sync.Mutex
+}
+
+// NewDispatcher creates a Dispatcher posting through the client.
+func NewDispatcher(
+	webhookRepo dwebhook.WebhookRepository,
+	deliveryRepo dwebhook.DeliveryRepository,
+	client service.WebhookClient,
+) *Dispatcher {
+	return &Dispatcher{
+		repository: struct {
+			webhook  dwebhook.WebhookRepository
+			delivery dwebhook.DeliveryRepository
+		}{
+			webhook:  webhookRepo,
+			delivery: deliveryRepo,
+		},
+		service:     struct{ client service.WebhookClient }{client: client},
+		queue:       make(chan deliveryJob, dispatchQueueSize),
+		concurrency: dispatchConcurrency,
+		maxAttempts: deliveryMaxAttempts,
+		backoff:     deliveryBaseBackoff,
+	}
+}
+
+// Dispatch queues the event for the active webhooks of its page that subscribe to it.
+// It never blocks on delivery: when the queue is full the delivery is dropped.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *dwebhook.Event) {
+	d.Prepare(ctx, event)(ctx)
+}
+
+// Prepare looks up the active webhooks of the page of the event that subscribe to it and returns a function that
+// queues the deliveries to them. Calling Prepare before deleting a page and the function after the deletion was
+// committed lets the page.deleted event reach the webhooks that are deleted with the page.
+func (d *Dispatcher) Prepare(ctx context.Context, event *dwebhook.Event) func(context.Context) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/Dispatcher.Prepare")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	if err := event.Validate(); err != nil {
+		l.Sugar().Errorf("invalid webhook event: %v", err)
+		return func(context.Context) {}
+	}
+
+	webhooks, err := d.repository.webhook.ListByPageID(ctx, event.Page.ID())
+	if err != nil {
+		l.Sugar().Errorf("failed to list webhooks of page %s: %v", event.Page.ID(), err)
+		return func(context.Context) {}
+	}
+
+	var body []byte
+	var jobs []deliveryJob
+	eventID := eventIDGenerator()
+	for _, w := range webhooks {
+		if !w.Active() || !w.Subscribes(event.Type) {
+			continue
+		}
+		if body == nil {
+			if body, err = encodePayload(eventID, event, ctxtime.Now(ctx)); err != nil {
+				l.Sugar().Errorf("failed to encode webhook payload: %v", err)
+				return func(context.Context) {}
+			}
+		}
+		jobs = append(jobs, deliveryJob{webhook: w, eventID: eventID, eventType: event.Type, body: body})
+	}
+
+	return func(ctx context.Context) {
+		for _, job := range jobs {
+			select {
+			case d.queue <- job:
+			default:
+				log.LoggerFromContext(ctx).Sugar().Warnf("Webhook queue is full, dropping %s delivery to webhook %s", job.eventType, job.webhook.ID())
+			}
+		}
+	}
+}
+
+// Run delivers queued events until ctx is done, a few at a time.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range d.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-d.queue:
+					d.deliver(ctx, job)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deliver posts the payload and retries failed attempts with a growing backoff. The outcome counts towards
+// disabling the webhook. Deliveries of page.deleted are not recorded, since the webhook is deleted with its page.
+func (d *Dispatcher) deliver(ctx context.Context, job deliveryJob) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/Dispatcher.deliver")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	record := job.eventType != dwebhook.EventPageDeleted
+
+	succeeded := false
+	for attempt := 1; ; attempt++ {
+		started := ctxtime.Now(ctx)
+		begin := time.Now()
+		status, err := d.post(ctx, job, started)
+		elapsed := time.Since(begin)
+		if err == nil && (status < 200 || status >= 300) {
+			err = fmt.Errorf("webhook responded with status %d", status)
+		}
+		succeeded = err == nil
+
+		if record {
+			delivery := dwebhook.NewDelivery(job.webhook, job.eventID, job.eventType, attempt, status, err, elapsed, started)
+			if err := d.repository.delivery.Add(ctx, delivery); err != nil {
+				l.Sugar().Errorf("failed to record delivery to webhook %s: %v", job.webhook.ID(), err)
+			}
+		}
+
+		if succeeded {
+			break
+		}
+		l.Sugar().Warnf("failed to deliver %s to webhook %s attempt=%d: %v", job.eventType, job.webhook.ID(), attempt, err)
+		if attempt >= d.maxAttempts {
+			l.Sugar().Errorf("giving up %s delivery to webhook %s after %d attempts", job.eventType, job.webhook.ID(), attempt)
+			break
+		}
+
+		timer := time.NewTimer(d.backoff << (attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	if record {
+		d.recordOutcome(ctx, job.webhook.ID(), succeeded)
+	}
+}
+
+// post signs the payload at the given time and posts it.
+func (d *Dispatcher) post(ctx context.Context, job deliveryJob, now time.Time) (int, error) {
+	return d.service.client.Post(ctx, service.WebhookRequest{
+		URL: job.webhook.URL(),
+		Headers: map[string]string{
+			HeaderEvent:     string(job.eventType),
+			HeaderDelivery:  job.eventID,
+			HeaderTimestamp: strconv.FormatInt(now.Unix(), 10),
+			HeaderSignature: job.webhook.Sign(now, job.body),
+		},
+		Body: job.body,
+	})
+}
+
+// recordOutcome counts the delivery towards the failures of the stored webhook, which may have changed since
+// the event was dispatched, and disables it after too many failures in a row.
+func (d *Dispatcher) recordOutcome(ctx context.Context, webhookID string, succeeded bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	l := log.LoggerFromContext(ctx)
+	w, err := d.repository.webhook.Get(ctx, webhookID)
+	if err != nil {
+		l.Sugar().Errorf("failed to get webhook %s: %v", webhookID, err)
+		return
+	}
+	if w == nil {
+		return
+	}
+	if succeeded && w.FailureCount() == 0 {
+		return
+	}
+
+	wasActive := w.Active()
+	w.RecordDelivery(succeeded, ctxtime.Now(ctx))
+	if _, err := d.repository.webhook.Save(ctx, w); err != nil {
+		l.Sugar().Errorf("failed to save webhook %s: %v", webhookID, err)
+		return
+	}
+	if wasActive && !w.Active() {
+		l.Sugar().Warnf("Disabled webhook %s after %d failed deliveries in a row", webhookID, w.FailureCount())
+	}
+}
+
+var eventIDGenerator = func() string { return tsuuid.NewV7().String() }
@@ -0,0 +1,342 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+// httpClient posts through net/http so that the dispatcher can be tested against an httptest receiver.
+type httpClient struct{}
+
+func (httpClient) Post(ctx context.Context, request service.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, err
+	}
+	for k, v := range request.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// receiver is a webhook endpoint answering with the given statuses in turn and checking the signature of every request.
+type receiver struct {
+	t        *testing.T
+	webhook  *dwebhook.Webhook
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	ts, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		r.t.Errorf("invalid timestamp header %q", req.Header.Get(HeaderTimestamp))
+	}
+	if got, want := req.Header.Get(HeaderSignature), r.webhook.Sign(time.Unix(ts, 0), body); got != want {
+		r.t.Errorf("signature = %q, want %q", got, want)
+	}
+	if req.Header.Get(HeaderEvent) == "" || req.Header.Get(HeaderDelivery) == "" {
+		r.t.Errorf("missing event headers: %v", req.Header)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	originalGenerator := eventIDGenerator
+	t.Cleanup(func() { eventIDGenerator = originalGenerator })
+	eventIDGenerator = func() string { return "event-1" }
+
+	ctrl := gomock.NewController(t)
+	webhookRepo := mockwebhook.NewMockWebhookRepository(ctrl)
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, nil)
+	subscribed := dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/1", "whsec", []dwebhook.EventType{dwebhook.EventLinkAdded}, "1")
+	webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return([]*dwebhook.Webhook{
+		subscribed,
+		dwebhook.ReconstructWebhook("hook-2", "page-1", "https://example.com/2", "whsec", []dwebhook.EventType{dwebhook.EventPageDeleted}, "1"),
+		dwebhook.ReconstructWebhook("hook-3", "page-1", "https://example.com/3", "whsec", nil, "1",
+			dwebhook.WithFailures(dwebhook.MaxConsecutiveFailures, &fixedNow)),
+	}, nil)
+
+	d := NewDispatcher(webhookRepo, mockwebhook.NewMockDeliveryRepository(ctrl), httpClient{})
+	ctx := ctxtime.WithTime(context.Background(), fixedNow)
+	d.Dispatch(ctx, &dwebhook.Event{
+		Type:  dwebhook.EventLinkAdded,
+		Page:  page,
+		Actor: creator,
+		Links: dpage.Links{dpage.ReconstructLink("https://example.com/article", "memo", 1, dpage.WithLinkID("link-1"))},
+	})
+
+	if len(d.queue) != 1 {
+		t.Fatalf("queued %d deliveries, want 1", len(d.queue))
+	}
+	job := <-d.queue
+	if job.webhook != subscribed || job.eventID != "event-1" || job.eventType != dwebhook.EventLinkAdded {
+		t.Fatalf("unexpected job for webhook %s event %s %s", job.webhook.ID(), job.eventID, job.eventType)
+	}
+
+	var got payload
+	if err := json.Unmarshal(job.body, &got); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	want := payload{
+		ID:         "event-1",
+		Event:      "link.added",
+		OccurredAt: fixedNow,
+		Page:       payloadPage{ID: "page-1", Title: "Test Page"},
+		Actor:      payloadActor{ID: "1"},
+		Links:      []payloadLink{{ID: "link-1", URL: "https://example.com/article", Memo: "memo", Priority: 1}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("payload mismatch (-want +got):\n%s", diff)
+	}
+
+	// Invalid events are dropped without looking up webhooks.
+	d.Dispatch(ctx, &dwebhook.Event{Type: dwebhook.EventLinkAdded})
+	if len(d.queue) != 0 {
+		t.Fatalf("queued %d deliveries for an invalid event", len(d.queue))
+	}
+}
+
+func TestDispatcher_Prepare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	webhookRepo := mockwebhook.NewMockWebhookRepository(ctrl)
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, nil)
+	subscribed := dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/1", "whsec", []dwebhook.EventType{dwebhook.EventPageDeleted}, "1")
+	webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return([]*dwebhook.Webhook{subscribed}, nil)
+
+	d := NewDispatcher(webhookRepo, mockwebhook.NewMockDeliveryRepository(ctrl), httpClient{})
+	ctx := ctxtime.WithTime(context.Background(), fixedNow)
+	dispatch := d.Prepare(ctx, &dwebhook.Event{Type: dwebhook.EventPageDeleted, Page: page, Actor: creator})
+
+	// The webhooks are looked up by Prepare, but nothing is queued until the returned function is called.
+	if len(d.queue) != 0 {
+		t.Fatalf("queued %d deliveries before dispatch", len(d.queue))
+	}
+	dispatch(ctx)
+	if len(d.queue) != 1 {
+		t.Fatalf("queued %d deliveries, want 1", len(d.queue))
+	}
+	if job := <-d.queue; job.webhook != subscribed || job.eventType != dwebhook.EventPageDeleted {
+		t.Fatalf("unexpected job for webhook %s event %s", job.webhook.ID(), job.eventType)
+	}
+
+	// Invalid events prepare a dispatch that does nothing.
+	d.Prepare(ctx, &dwebhook.Event{Type: dwebhook.EventPageDeleted})(ctx)
+	if len(d.queue) != 0 {
+		t.Fatalf("queued %d deliveries for an invalid event", len(d.queue))
+	}
+}
+
+func TestDispatcher_deliver(t *testing.T) {
+	type mocks struct {
+		webhookRepo  *mockwebhook.MockWebhookRepository
+		deliveryRepo *mockwebhook.MockDeliveryRepository
+	}
+
+	newWebhook := func(url string, failures int) *dwebhook.Webhook {
+		return dwebhook.ReconstructWebhook("hook-1", "page-1", url, "whsec_secret", nil, "1", dwebhook.WithFailures(failures, nil))
+	}
+	expectDeliveries := func(m *mocks, statuses ...int) {
+		calls := make([]any, 0, len(statuses))
+		for i, status := range statuses {
+			attempt, status := i+1, status
+			calls = append(calls, m.deliveryRepo.EXPECT().Add(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, d *dwebhook.Delivery) error {
+					if d.Attempt() != attempt || d.StatusCode() != status || d.EventID() != "event-1" {
+						t.Errorf("delivery attempt=%d status=%d event=%s, want attempt=%d status=%d",
+							d.Attempt(), d.StatusCode(), d.EventID(), attempt, status)
+					}
+					return nil
+				}))
+		}
+		gomock.InOrder(calls...)
+	}
+
+	tests := []struct {
+		name      string
+		eventType dwebhook.EventType
+		statuses  []int
+		setup     func(m *mocks, url string)
+		wantPosts int
+	}{
+		{
+			name:      "success",
+			eventType: dwebhook.EventLinkAdded,
+			setup: func(m *mocks, url string) {
+				expectDeliveries(m, http.StatusOK)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(newWebhook(url, 0), nil)
+			},
+			wantPosts: 1,
+		},
+		{
+			name:      "retries_then_clears_failures",
+			eventType: dwebhook.EventLinkAdded,
+			statuses:  []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusNoContent},
+			setup: func(m *mocks, url string) {
+				expectDeliveries(m, http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusNoContent)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(newWebhook(url, 2), nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w *dwebhook.Webhook) (*dwebhook.Webhook, error) {
+						if w.FailureCount() != 0 {
+							t.Errorf("FailureCount() = %d, want 0", w.FailureCount())
+						}
+						return w, nil
+					})
+			},
+			wantPosts: 3,
+		},
+		{
+			name:      "disables_after_repeated_failures",
+			eventType: dwebhook.EventMemberJoined,
+			statuses:  []int{500, 500, 500, 500},
+			setup: func(m *mocks, url string) {
+				expectDeliveries(m, 500, 500, 500, 500)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(newWebhook(url, dwebhook.MaxConsecutiveFailures-1), nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w *dwebhook.Webhook) (*dwebhook.Webhook, error) {
+						if w.Active() {
+							t.Errorf("webhook still active after %d failures", w.FailureCount())
+						}
+						return w, nil
+					})
+			},
+			wantPosts: deliveryMaxAttempts,
+		},
+		{
+			name:      "webhook_deleted_meanwhile",
+			eventType: dwebhook.EventLinkRemoved,
+			statuses:  []int{http.StatusBadGateway, http.StatusOK},
+			setup: func(m *mocks, url string) {
+				expectDeliveries(m, http.StatusBadGateway, http.StatusOK)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(nil, nil)
+			},
+			wantPosts: 2,
+		},
+		{
+			name:      "page_deleted_is_not_recorded",
+			eventType: dwebhook.EventPageDeleted,
+			statuses:  []int{http.StatusServiceUnavailable},
+			wantPosts: 2,
+		},
+		{
+			name:      "record_error_is_ignored",
+			eventType: dwebhook.EventLinkEdited,
+			setup: func(m *mocks, url string) {
+				m.deliveryRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.New("add error"))
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(nil, errors.New("get error"))
+			},
+			wantPosts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := &receiver{t: t, statuses: tt.statuses}
+			server := httptest.NewServer(r)
+			t.Cleanup(server.Close)
+			webhook := newWebhook(server.URL, 0)
+			r.webhook = webhook
+
+			m := &mocks{
+				webhookRepo:  mockwebhook.NewMockWebhookRepository(ctrl),
+				deliveryRepo: mockwebhook.NewMockDeliveryRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m, server.URL)
+			}
+
+			d := NewDispatcher(m.webhookRepo, m.deliveryRepo, httpClient{})
+			d.backoff = time.Millisecond
+			ctx := ctxtime.WithTime(context.Background(), fixedNow)
+			d.deliver(ctx, deliveryJob{webhook: webhook, eventID: "event-1", eventType: tt.eventType, body: []byte(`{"id":"event-1"}`)})
+
+			if len(r.bodies) != tt.wantPosts {
+				t.Fatalf("receiver got %d posts, want %d", len(r.bodies), tt.wantPosts)
+			}
+		})
+	}
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	deliveryRepo := mockwebhook.NewMockDeliveryRepository(ctrl)
+	webhookRepo := mockwebhook.NewMockWebhookRepository(ctrl)
+
+	r := &receiver{t: t}
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	webhook := dwebhook.ReconstructWebhook("hook-1", "page-1", server.URL, "whsec_secret", nil, "1")
+	r.webhook = webhook
+
+	done := make(chan struct{})
+	deliveryRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+	webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").DoAndReturn(func(context.Context, string) (*dwebhook.Webhook, error) {
+		close(done)
+		return webhook, nil
+	})
+
+	d := NewDispatcher(webhookRepo, deliveryRepo, httpClient{})
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(stopped)
+	}()
+
+	d.queue <- deliveryJob{webhook: webhook, eventID: "event-1", eventType: dwebhook.EventLinkAdded, body: []byte(`{}`)}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued delivery was not processed")
+	}
+
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after ctx was cancelled")
+	}
+}
@@ -0,0 +1,5 @@
+package webhook
+
+import "errors"
+
+var ErrWebhookNotFound = errors.New("webhook not found")
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"context"
+
+	dinvitation "github.com/naka-sei/tsudzuri/domain/invitation"
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_list/list.go -source=./list.go -package=mocklistusecase
+
+type ListUsecase interface {
+	// List returns the webhooks of the page, oldest first. Only the creator of the page can list them.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	List(ctx context.Context, pageID string) ([]*dwebhook.Webhook, error)
+}
+
+type listUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		webhook dwebhook.WebhookRepository
+	}
+}
+
+func NewListUsecase(pageRepo dpage.PageRepository, webhookRepo dwebhook.WebhookRepository) ListUsecase {
+	return &listUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			webhook dwebhook.WebhookRepository
+		}{
+			page:    pageRepo,
+			webhook: webhookRepo,
+		},
+	}
+}
+
+func (u *listUsecase) List(ctx context.Context, pageID string) ([]*dwebhook.Webhook, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/listUsecase.List")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Listing webhooks of page %s", pageID)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+	if err := dinvitation.AuthorizeManage(page, user); err != nil {
+		return nil, err
+	}
+
+	return u.repository.webhook.ListByPageID(ctx, page.ID())
+}
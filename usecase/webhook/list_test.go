@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+)
+
+func TestListUsecase_List(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		webhookRepo *mockwebhook.MockWebhookRepository
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	webhooks := []*dwebhook.Webhook{
+		dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/hook", "whsec", nil, "1"),
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		want  []*dwebhook.Webhook
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return(webhooks, nil)
+			},
+			ctx:  ctxuser.WithUser(context.Background(), creator),
+			want: webhooks,
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: upage.ErrPageNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+			},
+			ctx: ctxuser.WithUser(context.Background(), member),
+			err: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "list_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				m.webhookRepo.EXPECT().ListByPageID(gomock.Any(), "page-1").Return(nil, errors.New("list error"))
+			},
+			ctx: ctxuser.WithUser(context.Background(), creator),
+			err: errors.New("list error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				webhookRepo: mockwebhook.NewMockWebhookRepository(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewListUsecase(m.pageRepo, m.webhookRepo)
+			got, err := u.List(tt.ctx, "page-1")
+			testutil.EqualErr(t, tt.err, err)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dwebhook.Webhook{})); diff != "" {
+				t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delete.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_delete/delete.go -source=./delete.go -package=mockdeleteusecase
+//
+
+// Package mockdeleteusecase is a generated GoMock package.
+package mockdeleteusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteUsecase is a mock of DeleteUsecase interface.
+type MockDeleteUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteUsecaseMockRecorder is the mock recorder for MockDeleteUsecase.
+type MockDeleteUsecaseMockRecorder struct {
+	mock *MockDeleteUsecase
+}
+
+// NewMockDeleteUsecase creates a new mock instance.
+func NewMockDeleteUsecase(ctrl *gomock.Controller) *MockDeleteUsecase {
+	mock := &MockDeleteUsecase{ctrl: ctrl}
+	mock.recorder = &MockDeleteUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUsecase) EXPECT() *MockDeleteUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeleteUsecase) Delete(ctx context.Context, pageID, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, pageID, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeleteUsecaseMockRecorder) Delete(ctx, pageID, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteUsecase)(nil).Delete), ctx, pageID, webhookID)
+}
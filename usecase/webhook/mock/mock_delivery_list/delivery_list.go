@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delivery_list.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_delivery_list/delivery_list.go -source=./delivery_list.go -package=mockdeliverylistusecase
+//
+
+// Package mockdeliverylistusecase is a generated GoMock package.
+package mockdeliverylistusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeliveryListUsecase is a mock of DeliveryListUsecase interface.
+type MockDeliveryListUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryListUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeliveryListUsecaseMockRecorder is the mock recorder for MockDeliveryListUsecase.
+type MockDeliveryListUsecaseMockRecorder struct {
+	mock *MockDeliveryListUsecase
+}
+
+// NewMockDeliveryListUsecase creates a new mock instance.
+func NewMockDeliveryListUsecase(ctrl *gomock.Controller) *MockDeliveryListUsecase {
+	mock := &MockDeliveryListUsecase{ctrl: ctrl}
+	mock.recorder = &MockDeliveryListUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryListUsecase) EXPECT() *MockDeliveryListUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeliveryList mocks base method.
+func (m *MockDeliveryListUsecase) DeliveryList(ctx context.Context, pageID, webhookID string) ([]*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliveryList", ctx, pageID, webhookID)
+	ret0, _ := ret[0].([]*webhook.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliveryList indicates an expected call of DeliveryList.
+func (mr *MockDeliveryListUsecaseMockRecorder) DeliveryList(ctx, pageID, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliveryList", reflect.TypeOf((*MockDeliveryListUsecase)(nil).DeliveryList), ctx, pageID, webhookID)
+}
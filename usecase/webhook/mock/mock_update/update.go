@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./update.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_update/update.go -source=./update.go -package=mockupdateusecase
+//
+
+// Package mockupdateusecase is a generated GoMock package.
+package mockupdateusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	webhook0 "github.com/naka-sei/tsudzuri/usecase/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUpdateUsecase is a mock of UpdateUsecase interface.
+type MockUpdateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateUsecaseMockRecorder is the mock recorder for MockUpdateUsecase.
+type MockUpdateUsecaseMockRecorder struct {
+	mock *MockUpdateUsecase
+}
+
+// NewMockUpdateUsecase creates a new mock instance.
+func NewMockUpdateUsecase(ctrl *gomock.Controller) *MockUpdateUsecase {
+	mock := &MockUpdateUsecase{ctrl: ctrl}
+	mock.recorder = &MockUpdateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUsecase) EXPECT() *MockUpdateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateUsecase) Update(ctx context.Context, input webhook0.UpdateUsecaseInput) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateUsecaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateUsecase)(nil).Update), ctx, input)
+}
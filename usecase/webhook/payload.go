@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+)
+
+// Headers sent with every payload.
+const (
+	HeaderEvent     = "X-Tsudzuri-Event"
+	HeaderDelivery  = "X-Tsudzuri-Delivery"
+	HeaderTimestamp = "X-Tsudzuri-Timestamp"
+	HeaderSignature = "X-Tsudzuri-Signature"
+)
+
+// payload is the JSON body posted to webhooks.
+type payload struct {
+	ID         string        `json:"id"`
+	Event      string        `json:"event"`
+	OccurredAt time.Time     `json:"occurred_at"`
+	Page       payloadPage   `json:"page"`
+	Actor      payloadActor  `json:"actor"`
+	Links      []payloadLink `json:"links,omitempty"`
+}
+
+type payloadPage struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type payloadActor struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+type payloadLink struct {
+	ID       string `json:"id,omitempty"`
+	URL      string `json:"url"`
+	Memo     string `json:"memo"`
+	Priority int    `json:"priority,omitempty"`
+}
+
+// encodePayload encodes the event as the body of a delivery.
+func encodePayload(eventID string, event *dwebhook.Event, occurredAt time.Time) ([]byte, error) {
+	p := payload{
+		ID:         eventID,
+		Event:      string(event.Type),
+		OccurredAt: occurredAt.UTC(),
+		Page:       payloadPage{ID: event.Page.ID(), Title: event.Page.Title()},
+		Actor:      payloadActor{ID: event.Actor.ID(), DisplayName: event.Actor.Profile().DisplayName()},
+	}
+	for _, l := range event.Links {
+		p.Links = append(p.Links, payloadLink{ID: l.ID(), URL: l.URL(), Memo: l.Memo(), Priority: l.Priority()})
+	}
+	return json.Marshal(p)
+}
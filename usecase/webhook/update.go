@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"context"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/log"
+	"github.com/naka-sei/tsudzuri/pkg/trace"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	"github.com/naka-sei/tsudzuri/usecase/service"
+)
+
+type UpdateUsecaseInput struct {
+	PageID    string
+	WebhookID string
+	URL       string
+	// Events replaces the subscribed event types. Empty events mean all of them.
+	Events []dwebhook.EventType
+	// Active enables or disables the webhook. Enabling a webhook clears its failures.
+	Active bool
+}
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -destination mock/mock_update/update.go -source=./update.go -package=mockupdateusecase
+
+type UpdateUsecase interface {
+	// Update replaces the settings of a webhook of the page.
+	// The user is obtained from context via pkg/ctx/user.UserFromContext.
+	Update(ctx context.Context, input UpdateUsecaseInput) (*dwebhook.Webhook, error)
+}
+
+type updateUsecase struct {
+	repository struct {
+		page    dpage.PageRepository
+		webhook dwebhook.WebhookRepository
+	}
+	service struct {
+		txn service.TransactionService
+	}
+}
+
+func NewUpdateUsecase(
+	pageRepo dpage.PageRepository,
+	webhookRepo dwebhook.WebhookRepository,
+	txnService service.TransactionService,
+) UpdateUsecase {
+	return &updateUsecase{
+		repository: struct {
+			page    dpage.PageRepository
+			webhook dwebhook.WebhookRepository
+		}{
+			page:    pageRepo,
+			webhook: webhookRepo,
+		},
+		service: struct {
+			txn service.TransactionService
+		}{
+			txn: txnService,
+		},
+	}
+}
+
+func (u *updateUsecase) Update(ctx context.Context, input UpdateUsecaseInput) (*dwebhook.Webhook, error) {
+	ctx, end := trace.StartSpan(ctx, "usecase/webhook/updateUsecase.Update")
+	defer end()
+
+	l := log.LoggerFromContext(ctx)
+	l.Sugar().Infof("Updating webhook %s of page %s active=%t", input.WebhookID, input.PageID, input.Active)
+
+	user, ok := ctxuser.UserFromContext(ctx)
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+
+	page, err := u.repository.page.Get(ctx, input.PageID)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, upage.ErrPageNotFound
+	}
+
+	var updated *dwebhook.Webhook
+	err = u.service.txn.RunInTransaction(ctx, func(ctx context.Context) error {
+		webhook, err := getWebhookOfPage(ctx, u.repository.webhook, page, input.WebhookID)
+		if err != nil {
+			return err
+		}
+		if err := webhook.Update(page, user, input.URL, input.Events, input.Active, ctxtime.Now(ctx)); err != nil {
+			return err
+		}
+		updated, err = u.repository.webhook.Save(ctx, webhook)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// getWebhookOfPage returns the webhook with the ID. A webhook of another page is reported as not found.
+func getWebhookOfPage(ctx context.Context, repo dwebhook.WebhookRepository, page *dpage.Page, webhookID string) (*dwebhook.Webhook, error) {
+	webhook, err := repo.Get(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil || webhook.PageID() != page.ID() {
+		return nil, ErrWebhookNotFound
+	}
+	return webhook, nil
+}
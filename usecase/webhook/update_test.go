@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	dpage "github.com/naka-sei/tsudzuri/domain/page"
+	mockpage "github.com/naka-sei/tsudzuri/domain/page/mock/mock_page"
+	duser "github.com/naka-sei/tsudzuri/domain/user"
+	dwebhook "github.com/naka-sei/tsudzuri/domain/webhook"
+	mockwebhook "github.com/naka-sei/tsudzuri/domain/webhook/mock/mock_webhook"
+	ctxtime "github.com/naka-sei/tsudzuri/pkg/ctx/time"
+	ctxuser "github.com/naka-sei/tsudzuri/pkg/ctx/user"
+	"github.com/naka-sei/tsudzuri/pkg/testutil"
+	upage "github.com/naka-sei/tsudzuri/usecase/page"
+	mocktxn "github.com/naka-sei/tsudzuri/usecase/service/mock/mock_transaction"
+)
+
+func TestUpdateUsecase_Update(t *testing.T) {
+	type mocks struct {
+		pageRepo    *mockpage.MockPageRepository
+		webhookRepo *mockwebhook.MockWebhookRepository
+		txn         *mocktxn.MockTransactionService
+	}
+
+	creator := duser.ReconstructUser("1", "user1", "google", nil)
+	member := duser.ReconstructUser("2", "user2", "anonymous", nil)
+	page := dpage.ReconstructPage("page-1", "Test Page", *creator, "invite-code", nil, duser.Users{member})
+	disabled := func() *dwebhook.Webhook {
+		return dwebhook.ReconstructWebhook("hook-1", "page-1", "https://example.com/old", "whsec", nil, "1",
+			dwebhook.WithFailures(dwebhook.MaxConsecutiveFailures, &fixedNow))
+	}
+	input := UpdateUsecaseInput{
+		PageID:    "page-1",
+		WebhookID: "hook-1",
+		URL:       "https://example.com/new",
+		Events:    []dwebhook.EventType{dwebhook.EventMemberJoined},
+		Active:    true,
+	}
+	withUser := func(u *duser.User) context.Context {
+		return ctxtime.WithTime(ctxuser.WithUser(context.Background(), u), fixedNow)
+	}
+	runTxn := func(m *mocks) {
+		m.txn.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *mocks)
+		ctx   context.Context
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(disabled(), nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w *dwebhook.Webhook) (*dwebhook.Webhook, error) {
+						if w.URL() != "https://example.com/new" || !w.Active() || w.FailureCount() != 0 {
+							t.Errorf("unexpected webhook url=%s active=%t failures=%d", w.URL(), w.Active(), w.FailureCount())
+						}
+						return w, nil
+					})
+			},
+			ctx: withUser(creator),
+		},
+		{
+			name: "user_not_found_in_context",
+			ctx:  context.Background(),
+			err:  duser.ErrUserNotFound,
+		},
+		{
+			name: "page_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(nil, nil)
+			},
+			ctx: withUser(creator),
+			err: upage.ErrPageNotFound,
+		},
+		{
+			name: "webhook_not_found",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(nil, nil)
+			},
+			ctx: withUser(creator),
+			err: ErrWebhookNotFound,
+		},
+		{
+			name: "webhook_of_another_page",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").
+					Return(dwebhook.ReconstructWebhook("hook-1", "page-2", "https://example.com", "whsec", nil, "1"), nil)
+			},
+			ctx: withUser(creator),
+			err: ErrWebhookNotFound,
+		},
+		{
+			name: "not_creator",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(disabled(), nil)
+			},
+			ctx: withUser(member),
+			err: dpage.ErrNotCreatedByUser,
+		},
+		{
+			name: "save_error",
+			setup: func(m *mocks) {
+				m.pageRepo.EXPECT().Get(gomock.Any(), "page-1").Return(page, nil)
+				runTxn(m)
+				m.webhookRepo.EXPECT().Get(gomock.Any(), "hook-1").Return(disabled(), nil)
+				m.webhookRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
+			},
+			ctx: withUser(creator),
+			err: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				pageRepo:    mockpage.NewMockPageRepository(ctrl),
+				webhookRepo: mockwebhook.NewMockWebhookRepository(ctrl),
+				txn:         mocktxn.NewMockTransactionService(ctrl),
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			u := NewUpdateUsecase(m.pageRepo, m.webhookRepo, m.txn)
+			_, err := u.Update(tt.ctx, input)
+			testutil.EqualErr(t, tt.err, err)
+		})
+	}
+}